@@ -43,4 +43,8 @@
 //   - pdf/extractor: Package extractor is used for quickly extracting PDF content
 //     through a simple interface. Currently offers functionality for extracting textual
 //     content.
+//
+//   - pdf/unipdf: The unipdf package offers the common workflows (opening documents,
+//     extracting text, reading form values and merging documents) through a small
+//     interface with option structs, building on the packages above.
 package unidoc
@@ -24,6 +24,9 @@ type CircleAnnotationDef struct {
 	BorderWidth   float64
 	BorderColor   *pdf.PdfColorDeviceRGB
 	Opacity       float64 // Alpha value (0-1).
+
+	// Cache of appearance streams, if set. Annotations with the same appearance share a single form XObject.
+	AppearanceCache *pdf.XObjectFormCache
 }
 
 // Creates a circle/ellipse annotation object with appearance stream that can be added to page PDF annotations.
@@ -94,6 +97,10 @@ func makeCircleAnnotationAppearanceStream(circDef CircleAnnotationDef) (*pdfcore
 	// Local bounding box for the XObject Form.
 	form.BBox = localBbox.ToPdfObject()
 
+	if circDef.AppearanceCache != nil {
+		form = circDef.AppearanceCache.Get(form)
+	}
+
 	apDict := pdfcore.MakeDict()
 	apDict.Set("N", form.ToPdfObject())
 
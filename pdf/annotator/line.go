@@ -24,6 +24,9 @@ type LineAnnotationDef struct {
 	LineWidth        float64
 	LineEndingStyle1 draw.LineEndingStyle // Line ending style of point 1.
 	LineEndingStyle2 draw.LineEndingStyle // Line ending style of point 2.
+
+	// Cache of appearance streams, if set. Annotations with the same appearance share a single form XObject.
+	AppearanceCache *pdf.XObjectFormCache
 }
 
 // Creates a line annotation object that can be added to page PDF annotations.
@@ -102,6 +105,10 @@ func makeLineAnnotationAppearanceStream(lineDef LineAnnotationDef) (*pdfcore.Pdf
 	// Local bounding box for the XObject Form.
 	form.BBox = localBbox.ToPdfObject()
 
+	if lineDef.AppearanceCache != nil {
+		form = lineDef.AppearanceCache.Get(form)
+	}
+
 	apDict := pdfcore.MakeDict()
 	apDict.Set("N", form.ToPdfObject())
 
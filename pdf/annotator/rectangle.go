@@ -27,6 +27,9 @@ type RectangleAnnotationDef struct {
 	BorderWidth   float64
 	BorderColor   *pdf.PdfColorDeviceRGB
 	Opacity       float64 // Alpha value (0-1).
+
+	// Cache of appearance streams, if set. Annotations with the same appearance share a single form XObject.
+	AppearanceCache *pdf.XObjectFormCache
 }
 
 // Creates a rectangle annotation object that can be added to page PDF annotations.
@@ -97,6 +100,10 @@ func makeRectangleAnnotationAppearanceStream(rectDef RectangleAnnotationDef) (*p
 	// Local bounding box for the XObject Form.
 	form.BBox = localBbox.ToPdfObject()
 
+	if rectDef.AppearanceCache != nil {
+		form = rectDef.AppearanceCache.Get(form)
+	}
+
 	apDict := pdfcore.MakeDict()
 	apDict.Set("N", form.ToPdfObject())
 
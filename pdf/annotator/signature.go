@@ -0,0 +1,346 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package annotator
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	pdfcore "github.com/unidoc/unidoc/pdf/core"
+	pdf "github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// SignatureAppearanceDef defines the appearance of a visible signature: the name of the signer, the reason, the
+// location and the date of the signing, shown as lines of text in a Width x Height box, with an optional logo
+// image to the left of the text. Empty entries are not shown.
+type SignatureAppearanceDef struct {
+	Width  float64
+	Height float64
+
+	Name     string
+	Reason   string
+	Location string
+	Date     time.Time
+
+	// Layout of the date, see time.Format. Defaults to "2006-01-02 15:04:05 -07:00".
+	DateFormat string
+
+	// Labels of the text lines, for localization. English labels are used for the labels not set.
+	Labels *SignatureLabels
+
+	// Template of the text (text/template syntax) replacing the default lines, executed with a
+	// SignatureTemplateData. Each line of the output is a line of text, e.g.
+	// "Signé par {{.Name}}\n{{if .Reason}}Motif : {{.Reason}}{{end}}". Empty lines are kept, except at the end.
+	Template string
+
+	// Font of the text, Helvetica if not set, and the encoder of its encoding, the encoder of the font if it has
+	// one (GetEncoder) or WinAnsiEncoding if not set. The font is not modified. The text is shown with font size
+	// FontSize, or the largest size (up to 12) that fits into the box if 0.
+	Font      fonts.Font
+	Encoder   textencoding.TextEncoder
+	FontSize  float64
+	TextColor *pdf.PdfColorDeviceRGB // Black if not set.
+
+	// Background of the box, filled with BackgroundColor if set, then covered by BackgroundImage (stretched to
+	// the box) if set. The logo and the text are drawn over the background.
+	BackgroundColor *pdf.PdfColorDeviceRGB
+	BackgroundImage *pdf.XObjectImage
+
+	// Logo image, scaled to fit the height of the box (keeping its aspect ratio) at most half of its width.
+	Logo *pdf.XObjectImage
+
+	// Border of the box, not drawn if BorderWidth is 0.
+	BorderWidth float64
+	BorderColor *pdf.PdfColorDeviceRGB
+
+	// Cache of appearance streams, if set. Signatures with the same appearance share a single form XObject.
+	AppearanceCache *pdf.XObjectFormCache
+}
+
+// SignatureLabels are the labels prefixing the default text lines of a signature appearance.
+type SignatureLabels struct {
+	SignedBy string
+	Reason   string
+	Location string
+	Date     string
+}
+
+// defaultSignatureLabels are the English labels of the signature text lines.
+var defaultSignatureLabels = SignatureLabels{
+	SignedBy: "Digitally signed by ",
+	Reason:   "Reason: ",
+	Location: "Location: ",
+	Date:     "Date: ",
+}
+
+// SignatureTemplateData are the fields of a signature available to the text template of its appearance.
+type SignatureTemplateData struct {
+	Name     string
+	Reason   string
+	Location string
+
+	// Date formatted with DateFormat, empty if not set, and the unformatted date.
+	Date string
+	Time time.Time
+}
+
+// Padding between the edges of a signature appearance and its contents.
+const signaturePadding = 2.0
+
+// CreateSignatureAppearance returns the appearance dictionary (AP entry) of a signature widget with the normal
+// appearance defined by `def`.
+func CreateSignatureAppearance(def SignatureAppearanceDef) (*pdfcore.PdfObjectDictionary, error) {
+	if def.Width <= 0 || def.Height <= 0 {
+		common.Log.Debug("ERROR: Invalid signature appearance size %f x %f", def.Width, def.Height)
+		return nil, errors.New("Invalid signature appearance size")
+	}
+
+	font := def.Font
+	if font == nil {
+		font = fonts.NewFontHelvetica()
+	}
+	encoder := def.Encoder
+	if f, ok := font.(interface {
+		GetEncoder() textencoding.TextEncoder
+	}); ok && encoder == nil {
+		encoder = f.GetEncoder()
+	}
+	if encoder == nil {
+		encoder = textencoding.NewWinAnsiTextEncoder()
+	}
+
+	lines, err := signatureLines(def)
+	if err != nil {
+		return nil, err
+	}
+
+	form := pdf.NewXObjectForm()
+	form.Resources = pdf.NewPdfPageResources()
+	cc := contentstream.NewContentCreator()
+
+	if def.BackgroundColor != nil {
+		color := def.BackgroundColor
+		cc.Add_q().
+			Add_rg(color.R(), color.G(), color.B()).
+			Add_re(0, 0, def.Width, def.Height).
+			Add_f().
+			Add_Q()
+	}
+	if def.BackgroundImage != nil {
+		if err := form.Resources.SetXObjectImageByName("Background", def.BackgroundImage); err != nil {
+			return nil, err
+		}
+		cc.Add_q().
+			Add_cm(def.Width, 0, 0, def.Height, 0, 0).
+			Add_Do("Background").
+			Add_Q()
+	}
+
+	if def.BorderWidth > 0 {
+		color := def.BorderColor
+		if color == nil {
+			color = pdf.NewPdfColorDeviceRGB(0, 0, 0)
+		}
+		w := def.BorderWidth
+		cc.Add_q().
+			Add_RG(color.R(), color.G(), color.B()).
+			Add_w(w).
+			Add_re(w/2, w/2, def.Width-w, def.Height-w).
+			Add_S().
+			Add_Q()
+	}
+
+	// The logo is on the left, the text on the right.
+	textX := signaturePadding
+	if def.Logo != nil && def.Logo.Width != nil && def.Logo.Height != nil && *def.Logo.Height > 0 {
+		boxHeight := def.Height - 2*signaturePadding
+		scale := boxHeight / float64(*def.Logo.Height)
+		logoWidth := float64(*def.Logo.Width) * scale
+		if maxWidth := def.Width/2 - signaturePadding; logoWidth > maxWidth {
+			logoWidth = maxWidth
+			scale = logoWidth / float64(*def.Logo.Width)
+		}
+		logoHeight := float64(*def.Logo.Height) * scale
+		if err := form.Resources.SetXObjectImageByName("Logo", def.Logo); err != nil {
+			return nil, err
+		}
+		cc.Add_q().
+			Add_cm(logoWidth, 0, 0, logoHeight, signaturePadding, (def.Height-logoHeight)/2).
+			Add_Do("Logo").
+			Add_Q()
+		textX += logoWidth + signaturePadding
+	}
+
+	if len(lines) > 0 {
+		encoded := make([]string, len(lines))
+		maxWidth := 0.0
+		for i, line := range lines {
+			width := 0.0
+			for _, r := range line {
+				glyph, found := encoder.RuneToGlyph(r)
+				if !found {
+					common.Log.Debug("Rune 0x%x not supported by text encoder", r)
+					return nil, errors.New("Unsupported rune in text encoding")
+				}
+				metrics, found := font.GetGlyphCharMetrics(glyph)
+				if !found {
+					common.Log.Debug("Glyph char metrics not found! %s", glyph)
+					return nil, errors.New("Unsupported text glyph")
+				}
+				width += metrics.Wx / 1000.0
+			}
+			maxWidth = math.Max(maxWidth, width)
+			encoded[i] = encoder.Encode(line)
+		}
+
+		// Lines are spaced by 1.2 times the font size.
+		availWidth := def.Width - textX - signaturePadding
+		availHeight := def.Height - 2*signaturePadding
+		fontSize := def.FontSize
+		if fontSize <= 0 {
+			fontSize = math.Min(12, availHeight/(1.2*float64(len(lines))))
+			if maxWidth > 0 {
+				fontSize = math.Min(fontSize, availWidth/maxWidth)
+			}
+		}
+		if fontSize <= 0 {
+			common.Log.Debug("ERROR: No room for the signature text")
+			return nil, errors.New("Signature appearance too small")
+		}
+
+		if err := form.Resources.SetFontByName("F1", font.ToPdfObject()); err != nil {
+			return nil, err
+		}
+		color := def.TextColor
+		if color == nil {
+			color = pdf.NewPdfColorDeviceRGB(0, 0, 0)
+		}
+
+		// Center the text block vertically.
+		leading := 1.2 * fontSize
+		blockHeight := leading * float64(len(lines))
+		y := (def.Height+blockHeight)/2 - fontSize
+		cc.Add_q().
+			Add_rg(color.R(), color.G(), color.B()).
+			Add_BT().
+			Add_Tf("F1", fontSize).
+			Add_TL(leading).
+			Add_Td(textX, y)
+		for i, line := range encoded {
+			if i > 0 {
+				cc.Add_Tstar()
+			}
+			cc.Add_Tj(pdfcore.PdfObjectString(line))
+		}
+		cc.Add_ET().Add_Q()
+	}
+
+	if err := form.SetContentStream(cc.Bytes(), nil); err != nil {
+		return nil, err
+	}
+	form.BBox = pdfcore.MakeArrayFromFloats([]float64{0, 0, def.Width, def.Height})
+
+	if def.AppearanceCache != nil {
+		form = def.AppearanceCache.Get(form)
+	}
+
+	apDict := pdfcore.MakeDict()
+	apDict.Set("N", form.ToPdfObject())
+	return apDict, nil
+}
+
+// signatureLines returns the lines of text of the signature appearance defined by `def`.
+func signatureLines(def SignatureAppearanceDef) ([]string, error) {
+	data := SignatureTemplateData{Name: def.Name, Reason: def.Reason, Location: def.Location, Time: def.Date}
+	if !def.Date.IsZero() {
+		layout := def.DateFormat
+		if layout == "" {
+			layout = "2006-01-02 15:04:05 -07:00"
+		}
+		data.Date = def.Date.Format(layout)
+	}
+
+	if def.Template != "" {
+		tmpl, err := template.New("signature").Parse(def.Template)
+		if err != nil {
+			common.Log.Debug("ERROR: Invalid signature text template: %v", err)
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			common.Log.Debug("ERROR: Failed to execute signature text template: %v", err)
+			return nil, err
+		}
+		text := strings.TrimRight(buf.String(), "\n")
+		if text == "" {
+			return []string{}, nil
+		}
+		return strings.Split(text, "\n"), nil
+	}
+
+	labels := defaultSignatureLabels
+	if def.Labels != nil {
+		if def.Labels.SignedBy != "" {
+			labels.SignedBy = def.Labels.SignedBy
+		}
+		if def.Labels.Reason != "" {
+			labels.Reason = def.Labels.Reason
+		}
+		if def.Labels.Location != "" {
+			labels.Location = def.Labels.Location
+		}
+		if def.Labels.Date != "" {
+			labels.Date = def.Labels.Date
+		}
+	}
+	lines := []string{}
+	if data.Name != "" {
+		lines = append(lines, labels.SignedBy+data.Name)
+	}
+	if data.Reason != "" {
+		lines = append(lines, labels.Reason+data.Reason)
+	}
+	if data.Location != "" {
+		lines = append(lines, labels.Location+data.Location)
+	}
+	if data.Date != "" {
+		lines = append(lines, labels.Date+data.Date)
+	}
+	return lines, nil
+}
+
+// SetSignatureAppearance sets the normal appearance of signature widget `widget` as defined by `def`. The size of
+// the appearance is the size of the widget rectangle if Width and Height are not set.
+func SetSignatureAppearance(widget *pdf.PdfAnnotationWidget, def SignatureAppearanceDef) error {
+	if def.Width == 0 && def.Height == 0 {
+		arr, ok := pdfcore.TraceToDirectObject(widget.Rect).(*pdfcore.PdfObjectArray)
+		if !ok {
+			return errors.New("Widget Rect missing")
+		}
+		rect, err := pdf.NewPdfRectangle(*arr)
+		if err != nil {
+			return err
+		}
+		def.Width = math.Abs(rect.Urx - rect.Llx)
+		def.Height = math.Abs(rect.Ury - rect.Lly)
+	}
+
+	apDict, err := CreateSignatureAppearance(def)
+	if err != nil {
+		return err
+	}
+	widget.AP = apDict
+	return nil
+}
@@ -0,0 +1,322 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package annotator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	pdfcore "github.com/unidoc/unidoc/pdf/core"
+	pdf "github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// getSignatureAppearance returns the normal appearance form of the signature appearance defined by `def` and
+// the operations of its content stream.
+func getSignatureAppearance(t *testing.T, def SignatureAppearanceDef) (*pdf.XObjectForm, []*contentstream.ContentStreamOperation) {
+	apDict, err := CreateSignatureAppearance(def)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	stream, ok := apDict.Get("N").(*pdfcore.PdfObjectStream)
+	if !ok {
+		t.Fatalf("Normal appearance not a stream (%T)", apDict.Get("N"))
+	}
+	form, err := pdf.NewXObjectFormFromStream(stream)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	content, err := form.GetContentStream()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ops, err := contentstream.NewContentStreamParser(string(content)).Parse()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return form, *ops
+}
+
+// getSignatureText returns the strings shown by the Tj operations of `ops` and the font size set by Tf.
+func getSignatureText(ops []*contentstream.ContentStreamOperation) ([]string, float64) {
+	lines := []string{}
+	fontSize := 0.0
+	for _, op := range ops {
+		switch op.Operand {
+		case "Tj":
+			if str, ok := op.Params[0].(*pdfcore.PdfObjectString); ok {
+				lines = append(lines, string(*str))
+			}
+		case "Tf":
+			if val, ok := op.Params[1].(*pdfcore.PdfObjectFloat); ok {
+				fontSize = float64(*val)
+			} else if val, ok := op.Params[1].(*pdfcore.PdfObjectInteger); ok {
+				fontSize = float64(*val)
+			}
+		}
+	}
+	return lines, fontSize
+}
+
+// getTextWidth returns the width of `text` in Helvetica at font size 1.
+func getTextWidth(t *testing.T, text string) float64 {
+	font := fonts.NewFontHelvetica()
+	encoder := textencoding.NewWinAnsiTextEncoder()
+	width := 0.0
+	for _, r := range text {
+		glyph, _ := encoder.RuneToGlyph(r)
+		metrics, found := font.GetGlyphCharMetrics(glyph)
+		if !found {
+			t.Fatalf("No metrics for %q", glyph)
+		}
+		width += metrics.Wx / 1000
+	}
+	return width
+}
+
+func TestSignatureAppearanceLayout(t *testing.T) {
+	def := SignatureAppearanceDef{
+		Width:    300,
+		Height:   50,
+		Name:     "Jane Doe",
+		Reason:   "Approval",
+		Location: "Oslo",
+		Date:     time.Date(2018, 3, 1, 12, 30, 0, 0, time.UTC),
+	}
+	_, ops := getSignatureAppearance(t, def)
+	lines, fontSize := getSignatureText(ops)
+
+	expected := []string{"Digitally signed by Jane Doe", "Reason: Approval", "Location: Oslo",
+		"Date: 2018-03-01 12:30:00 +00:00"}
+	if len(lines) != len(expected) {
+		t.Fatalf("Lines %q != %q", lines, expected)
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("Line %d: %q != %q", i+1, lines[i], expected[i])
+		}
+	}
+
+	// 4 lines with leading 1.2 fit into the height without padding.
+	if math.Abs(fontSize-(50-2*signaturePadding)/(1.2*4)) > 1e-4 {
+		t.Errorf("Font size %v", fontSize)
+	}
+
+	numNextLines := 0
+	for _, op := range ops {
+		if op.Operand == "T*" {
+			numNextLines++
+		}
+	}
+	if numNextLines != 3 {
+		t.Errorf("Expected 3 line breaks, got %d", numNextLines)
+	}
+}
+
+func TestSignatureAppearanceFontFitting(t *testing.T) {
+	// The font size of a long line in a narrow box is limited by the width.
+	def := SignatureAppearanceDef{Width: 100, Height: 80, Name: "Bartholomew Montgomery-Featherstonehaugh"}
+	_, ops := getSignatureAppearance(t, def)
+	lines, fontSize := getSignatureText(ops)
+	if len(lines) != 1 {
+		t.Fatalf("Unexpected lines %q", lines)
+	}
+	availWidth := def.Width - 2*signaturePadding
+	if math.Abs(fontSize*getTextWidth(t, lines[0])-availWidth) > 1e-3 {
+		t.Errorf("Text width %v != %v", fontSize*getTextWidth(t, lines[0]), availWidth)
+	}
+
+	// A short line in a large box is shown at 12 points.
+	def = SignatureAppearanceDef{Width: 300, Height: 100, Name: "Jo"}
+	_, ops = getSignatureAppearance(t, def)
+	if _, fontSize := getSignatureText(ops); fontSize != 12 {
+		t.Errorf("Font size %v != 12", fontSize)
+	}
+
+	// The font size is used if set.
+	def.FontSize = 7
+	_, ops = getSignatureAppearance(t, def)
+	if _, fontSize := getSignatureText(ops); fontSize != 7 {
+		t.Errorf("Font size %v != 7", fontSize)
+	}
+
+	// No room for the text.
+	def = SignatureAppearanceDef{Width: 100, Height: 3, Name: "Jane Doe"}
+	if _, err := CreateSignatureAppearance(def); err == nil {
+		t.Errorf("Should fail without room for the text")
+	}
+}
+
+// encoderFont is a font recording the encoder set with SetEncoder.
+type encoderFont struct {
+	fonts.Font
+	encoder textencoding.TextEncoder
+}
+
+func (font *encoderFont) SetEncoder(encoder textencoding.TextEncoder) {
+	font.encoder = encoder
+}
+
+func TestSignatureAppearanceFontNotModified(t *testing.T) {
+	font := &encoderFont{Font: fonts.NewFontHelvetica()}
+	def := SignatureAppearanceDef{
+		Width:   200,
+		Height:  50,
+		Name:    "Jane Doe",
+		Font:    font,
+		Encoder: textencoding.NewWinAnsiTextEncoder(),
+	}
+	if _, err := CreateSignatureAppearance(def); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if font.encoder != nil {
+		t.Errorf("Encoder of the font modified")
+	}
+}
+
+func TestSignatureAppearanceCache(t *testing.T) {
+	cache := pdf.NewXObjectFormCache()
+	def := SignatureAppearanceDef{Width: 200, Height: 50, Name: "Jane Doe", AppearanceCache: cache}
+
+	ap1, err := CreateSignatureAppearance(def)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ap2, err := CreateSignatureAppearance(def)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	def.Name = "John Smith"
+	ap3, err := CreateSignatureAppearance(def)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if ap1.Get("N") != ap2.Get("N") {
+		t.Errorf("Identical appearances not shared")
+	}
+	if ap1.Get("N") == ap3.Get("N") {
+		t.Errorf("Different appearances shared")
+	}
+	if cache.Len() != 2 || cache.NumReused() != 1 {
+		t.Errorf("Cache has %d appearances, reused %d times", cache.Len(), cache.NumReused())
+	}
+}
+
+func TestSignatureLines(t *testing.T) {
+	date := time.Date(2018, 3, 1, 12, 30, 0, 0, time.UTC)
+	testcases := []struct {
+		def      SignatureAppearanceDef
+		expected []string
+	}{
+		// Empty entries are not shown.
+		{SignatureAppearanceDef{Name: "Jane Doe", Location: "Oslo"},
+			[]string{"Digitally signed by Jane Doe", "Location: Oslo"}},
+		{SignatureAppearanceDef{Date: date, DateFormat: "02.01.2006"}, []string{"Date: 01.03.2018"}},
+		{SignatureAppearanceDef{}, []string{}},
+		// The labels not set are English.
+		{SignatureAppearanceDef{Name: "Jane Doe", Reason: "Freigabe", Labels: &SignatureLabels{Reason: "Grund: "}},
+			[]string{"Digitally signed by Jane Doe", "Grund: Freigabe"}},
+		{SignatureAppearanceDef{Name: "Jane Doe", Location: "Oslo", Date: date, DateFormat: "2006",
+			Labels: &SignatureLabels{SignedBy: "Signé par ", Location: "Lieu : ", Date: "Date : "}},
+			[]string{"Signé par Jane Doe", "Lieu : Oslo", "Date : 2018"}},
+	}
+	for i, tc := range testcases {
+		lines, err := signatureLines(tc.def)
+		if err != nil {
+			t.Fatalf("Case %d: %v", i+1, err)
+		}
+		if len(lines) != len(tc.expected) {
+			t.Errorf("Case %d: %q != %q", i+1, lines, tc.expected)
+			continue
+		}
+		for j := range lines {
+			if lines[j] != tc.expected[j] {
+				t.Errorf("Case %d: %q != %q", i+1, lines, tc.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestSignatureLinesTemplate(t *testing.T) {
+	def := SignatureAppearanceDef{
+		Name:     "Jane Doe",
+		Location: "Paris",
+		Date:     time.Date(2018, 3, 1, 12, 30, 0, 0, time.UTC),
+		Template: "Signé par {{.Name}}\n{{if .Reason}}Motif : {{.Reason}}{{end}}\n{{.Location}}, {{.Time.Year}}\n\n",
+	}
+	lines, err := signatureLines(def)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	// Empty lines are kept, except at the end.
+	expected := []string{"Signé par Jane Doe", "", "Paris, 2018"}
+	if len(lines) != len(expected) || lines[0] != expected[0] || lines[1] != expected[1] || lines[2] != expected[2] {
+		t.Errorf("%q != %q", lines, expected)
+	}
+
+	def.Template = "{{if .Reason}}{{.Reason}}{{end}}\n"
+	if lines, err := signatureLines(def); err != nil || len(lines) != 0 {
+		t.Errorf("Expected no lines, got %q (%v)", lines, err)
+	}
+
+	def.Template = "{{.Name"
+	if _, err := signatureLines(def); err == nil {
+		t.Errorf("Should fail on an invalid template")
+	}
+	def.Template = "{{.Missing}}"
+	if _, err := signatureLines(def); err == nil {
+		t.Errorf("Should fail on an unknown field")
+	}
+}
+
+func TestSignatureAppearanceBackground(t *testing.T) {
+	img := &pdf.Image{Width: 1, Height: 1, BitsPerComponent: 8, ColorComponents: 3, Data: []byte{0, 0, 255}}
+	ximg, err := pdf.NewXObjectImageFromImage(img, pdf.NewPdfColorspaceDeviceRGB(), nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	def := SignatureAppearanceDef{
+		Width:           200,
+		Height:          50,
+		Name:            "Jane Doe",
+		BackgroundColor: pdf.NewPdfColorDeviceRGB(1, 1, 0.8),
+		BackgroundImage: ximg,
+		BorderWidth:     1,
+	}
+	form, ops := getSignatureAppearance(t, def)
+	if !form.Resources.HasXObjectByName("Background") {
+		t.Errorf("Background image not in the resources")
+	}
+
+	// The background color is filled first, then the image is drawn over it, then the border and the text.
+	order := []string{}
+	for _, op := range ops {
+		switch op.Operand {
+		case "f", "Do", "S", "Tj":
+			order = append(order, op.Operand)
+		}
+	}
+	if len(order) != 4 || order[0] != "f" || order[1] != "Do" || order[2] != "S" || order[3] != "Tj" {
+		t.Errorf("Unexpected drawing order %q", order)
+	}
+
+	// The background image is stretched to the box.
+	for _, op := range ops {
+		if op.Operand == "cm" {
+			arr := pdfcore.PdfObjectArray(op.Params)
+			vals, err := arr.ToFloat64Array()
+			if err != nil || vals[0] != def.Width || vals[3] != def.Height {
+				t.Errorf("Unexpected background image matrix %v", op.Params)
+			}
+			break
+		}
+	}
+}
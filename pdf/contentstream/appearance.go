@@ -0,0 +1,177 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package contentstream
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// DefaultAppearance is a parsed default appearance string (DA) of variable text form fields and free text
+// annotations (12.7.3.3): the text state and color operators to use when generating their appearance streams,
+// e.g. "/Helv 12 Tf 0 g".
+type DefaultAppearance struct {
+	// Font resource name (in the DR resources of the AcroForm) and font size of the Tf operator.
+	// A font size of 0 means that the text is auto sized to fit the field.
+	FontName PdfObjectName
+	FontSize float64
+
+	// Nonstroking color components: gray (g), RGB (rg) or CMYK (k) depending on the number of components.
+	// Empty if the string does not set a color.
+	Color []float64
+
+	// Other operators of the string, e.g. character spacing (Tc) or stroking color, kept in order.
+	Other ContentStreamOperations
+}
+
+// daColorComponents is the number of components of the nonstroking color operators.
+var daColorComponents = map[string]int{"g": 1, "rg": 3, "k": 4}
+
+// NewDefaultAppearance returns a default appearance with font `fontName` of size `fontSize` (0 for auto size)
+// and color `color` (1, 3 or 4 components for gray, RGB or CMYK, or none).
+func NewDefaultAppearance(fontName string, fontSize float64, color ...float64) *DefaultAppearance {
+	return &DefaultAppearance{
+		FontName: PdfObjectName(fontName),
+		FontSize: fontSize,
+		Color:    color,
+	}
+}
+
+// ParseDefaultAppearance parses default appearance string `da`. The last Tf and nonstroking color operators of
+// the string take effect, as when processing it.
+func ParseDefaultAppearance(da string) (*DefaultAppearance, error) {
+	operations, err := NewContentStreamParser(da).Parse()
+	if err != nil {
+		common.Log.Debug("Invalid DA string %q: %v", da, err)
+		return nil, err
+	}
+
+	appearance := &DefaultAppearance{}
+	for _, op := range *operations {
+		switch op.Operand {
+		case "Tf":
+			if len(op.Params) != 2 {
+				common.Log.Debug("Invalid Tf operands in DA %q", da)
+				return nil, errors.New("invalid Tf operands")
+			}
+			name, ok := op.Params[0].(*PdfObjectName)
+			if !ok {
+				common.Log.Debug("Invalid Tf font name in DA %q", da)
+				return nil, errors.New("invalid Tf operands")
+			}
+			size, err := getNumberAsFloat(op.Params[1])
+			if err != nil {
+				common.Log.Debug("Invalid Tf font size in DA %q", da)
+				return nil, err
+			}
+			appearance.FontName = *name
+			appearance.FontSize = size
+		case "g", "rg", "k":
+			color, err := getNumbersAsFloat(op.Params)
+			if err != nil {
+				common.Log.Debug("Invalid %s operands in DA %q", op.Operand, da)
+				return nil, err
+			}
+			if len(color) != daColorComponents[op.Operand] {
+				common.Log.Debug("Invalid number of %s operands in DA %q", op.Operand, da)
+				return nil, errors.New("invalid color operands")
+			}
+			appearance.Color = color
+		default:
+			appearance.Other = append(appearance.Other, op)
+		}
+	}
+	return appearance, nil
+}
+
+// ParseDefaultAppearanceObject parses the default appearance string object `obj`, e.g. the DA entry of a field.
+func ParseDefaultAppearanceObject(obj PdfObject) (*DefaultAppearance, error) {
+	str, ok := TraceToDirectObject(obj).(*PdfObjectString)
+	if !ok {
+		common.Log.Debug("DA not a string (%T)", obj)
+		return nil, errors.New("type check error")
+	}
+	return ParseDefaultAppearance(string(*str))
+}
+
+// SetGray sets the nonstroking color to gray level `g` (0-1).
+func (da *DefaultAppearance) SetGray(g float64) {
+	da.Color = []float64{g}
+}
+
+// SetRGB sets the nonstroking color to `r`, `g`, `b` (0-1 each).
+func (da *DefaultAppearance) SetRGB(r, g, b float64) {
+	da.Color = []float64{r, g, b}
+}
+
+// SetCMYK sets the nonstroking color to `c`, `m`, `y`, `k` (0-1 each).
+func (da *DefaultAppearance) SetCMYK(c, m, y, k float64) {
+	da.Color = []float64{c, m, y, k}
+}
+
+// ColorOperator returns the operator that sets the color of the appearance: g, rg or k. Returns an empty string
+// if no color is set.
+func (da *DefaultAppearance) ColorOperator() string {
+	switch len(da.Color) {
+	case 1:
+		return "g"
+	case 3:
+		return "rg"
+	case 4:
+		return "k"
+	}
+	return ""
+}
+
+// Operations returns the content stream operations of the appearance: Tf, the color operator and the other
+// operators, e.g. for adding to an appearance stream inside BT ... ET.
+func (da *DefaultAppearance) Operations() *ContentStreamOperations {
+	operations := ContentStreamOperations{}
+	if da.FontName != "" {
+		name := da.FontName
+		operations = append(operations, &ContentStreamOperation{
+			Operand: "Tf",
+			Params:  []PdfObject{&name, MakeFloat(da.FontSize)},
+		})
+	}
+	if operand := da.ColorOperator(); operand != "" {
+		op := &ContentStreamOperation{Operand: operand}
+		for _, c := range da.Color {
+			op.Params = append(op.Params, MakeFloat(c))
+		}
+		operations = append(operations, op)
+	}
+	operations = append(operations, da.Other...)
+	return &operations
+}
+
+// String returns the default appearance string on a single line with numbers written without trailing zeros,
+// e.g. "/Helv 12 Tf 0 g".
+func (da *DefaultAppearance) String() string {
+	var buf bytes.Buffer
+	for _, op := range *da.Operations() {
+		for _, param := range op.Params {
+			if num, ok := param.(*PdfObjectFloat); ok {
+				buf.WriteString(strconv.FormatFloat(float64(*num), 'f', -1, 64))
+			} else {
+				buf.WriteString(param.DefaultWriteString())
+			}
+			buf.WriteString(" ")
+		}
+		buf.WriteString(op.Operand + " ")
+	}
+	return string(bytes.TrimSpace(buf.Bytes()))
+}
+
+// ToPdfObject returns the default appearance string as a string object for the DA entry of a field, form or
+// annotation.
+func (da *DefaultAppearance) ToPdfObject() PdfObject {
+	return MakeString(da.String())
+}
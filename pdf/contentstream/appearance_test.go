@@ -0,0 +1,78 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package contentstream
+
+import (
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestParseDefaultAppearance(t *testing.T) {
+	testcases := []struct {
+		DA       string
+		FontName string
+		FontSize float64
+		Color    []float64
+		Output   string
+	}{
+		{"/Helv 0 Tf 0 g", "Helv", 0, []float64{0}, "/Helv 0 Tf 0 g"},
+		{"0.5 0 1 rg /TiRo 12.5 Tf", "TiRo", 12.5, []float64{0.5, 0, 1}, "/TiRo 12.5 Tf 0.5 0 1 rg"},
+		{"/F1 9 Tf 0 0 0 1 k 2 Tc", "F1", 9, []float64{0, 0, 0, 1}, "/F1 9 Tf 0 0 0 1 k 2 Tc"},
+		{"/F1 9 Tf", "F1", 9, nil, "/F1 9 Tf"},
+		{"1 g /F1 8 Tf 0.2 g", "F1", 8, []float64{0.2}, "/F1 8 Tf 0.2 g"},
+	}
+
+	for _, tcase := range testcases {
+		da, err := ParseDefaultAppearance(tcase.DA)
+		if err != nil {
+			t.Errorf("Error parsing %q: %v", tcase.DA, err)
+			continue
+		}
+		if string(da.FontName) != tcase.FontName || da.FontSize != tcase.FontSize {
+			t.Errorf("%q: font %s %f", tcase.DA, da.FontName, da.FontSize)
+		}
+		if len(da.Color) != len(tcase.Color) {
+			t.Errorf("%q: color %v != %v", tcase.DA, da.Color, tcase.Color)
+		} else {
+			for i := range da.Color {
+				if da.Color[i] != tcase.Color[i] {
+					t.Errorf("%q: color %v != %v", tcase.DA, da.Color, tcase.Color)
+				}
+			}
+		}
+		if da.String() != tcase.Output {
+			t.Errorf("%q: output %q != %q", tcase.DA, da.String(), tcase.Output)
+		}
+	}
+
+	for _, invalid := range []string{"/F1 Tf", "12 /F1 Tf", "1 0 rg", "/F1 (a) Tf"} {
+		if _, err := ParseDefaultAppearance(invalid); err == nil {
+			t.Errorf("%q should be invalid", invalid)
+		}
+	}
+}
+
+func TestBuildDefaultAppearance(t *testing.T) {
+	da := NewDefaultAppearance("Helv", 10)
+	da.SetRGB(1, 0, 0)
+	obj := da.ToPdfObject()
+	if obj.DefaultWriteString() != "(/Helv 10 Tf 1 0 0 rg)" {
+		t.Errorf("Unexpected DA: %s", obj.DefaultWriteString())
+	}
+
+	parsed, err := ParseDefaultAppearanceObject(obj)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if parsed.FontName != "Helv" || parsed.FontSize != 10 || parsed.ColorOperator() != "rg" {
+		t.Errorf("Round trip failed: %+v", parsed)
+	}
+
+	if _, err := ParseDefaultAppearanceObject(MakeInteger(1)); err == nil {
+		t.Errorf("DA must be a string")
+	}
+}
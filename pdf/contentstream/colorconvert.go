@@ -0,0 +1,428 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package contentstream
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	. "github.com/unidoc/unidoc/pdf/model"
+)
+
+// ColorConverter converts the colors of pages to DeviceGray or DeviceCMYK, e.g. to prepare documents for print.
+//
+// The color operators of the content streams of the pages, their form XObjects and annotation appearances are
+// rewritten to the target colorspace, images are converted and the colorspaces of transparency groups (including
+// those of soft masks in ExtGState dictionaries) are set to the target colorspace. Colors already in the target
+// colorspace are kept, as are DeviceGray colors when converting to DeviceCMYK. Patterns and shadings are not
+// converted.
+// The conversion is done with the device-independent formulas (via RGB), i.e. without color management.
+//
+// Resources shared between pages are converted only once, so the same converter should be used for all pages
+// of a document.
+type ColorConverter struct {
+	target         PdfColorspace
+	toCMYK         bool
+	keepSpotColors bool
+
+	// Streams which have already been converted.
+	converted map[*PdfObjectStream]bool
+}
+
+// NewColorConverter returns a new converter to colorspace `target` which must be DeviceGray or DeviceCMYK.
+func NewColorConverter(target PdfColorspace) (*ColorConverter, error) {
+	cc := &ColorConverter{target: target, converted: map[*PdfObjectStream]bool{}}
+	switch target.(type) {
+	case *PdfColorspaceDeviceGray:
+	case *PdfColorspaceDeviceCMYK:
+		cc.toCMYK = true
+	default:
+		common.Log.Debug("ERROR: Unsupported target colorspace %T", target)
+		return nil, errors.New("Unsupported target colorspace")
+	}
+	return cc, nil
+}
+
+// SetKeepSpotColors sets whether colors in Separation and DeviceN colorspaces (spot colors) are kept rather than
+// converted to the target colorspace. Disabled by default.
+func (cc *ColorConverter) SetKeepSpotColors(keep bool) {
+	cc.keepSpotColors = keep
+}
+
+// ConvertPage converts the colors of `page`. The page contents are replaced with a single flate encoded
+// content stream.
+func (cc *ColorConverter) ConvertPage(page *PdfPage) error {
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		return err
+	}
+
+	content, err := cc.convertContent(contents, page.Resources)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to convert page contents: %v", err)
+		return err
+	}
+	err = page.SetContentStreamsBytes([][]byte{content}, NewFlateEncoder())
+	if err != nil {
+		return err
+	}
+
+	cc.convertGroup(page.Group)
+
+	for _, annot := range page.Annotations {
+		err = cc.convertAppearance(annot.AP)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertContent converts content stream `content` with `resources`, including the resources, and returns the
+// converted content stream.
+func (cc *ColorConverter) convertContent(content string, resources *PdfPageResources) ([]byte, error) {
+	operations, err := NewContentStreamParser(content).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	if resources == nil {
+		resources = NewPdfPageResources()
+	}
+
+	processor := NewContentStreamProcessor(*operations)
+	processor.AddHandler(HandlerConditionEnumAllOperands, "",
+		func(op *ContentStreamOperation, gs GraphicsState, resources *PdfPageResources) error {
+			switch op.Operand {
+			case "CS", "SC", "SCN", "G", "RG", "K":
+				return cc.convertColorOperation(op, gs.ColorspaceStroking, gs.ColorStroking, true)
+			case "cs", "sc", "scn", "g", "rg", "k":
+				return cc.convertColorOperation(op, gs.ColorspaceNonStroking, gs.ColorNonStroking, false)
+			case "BI":
+				return cc.convertInlineImage(op, resources)
+			}
+			return nil
+		})
+	err = processor.Process(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cc.convertResources(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return operations.Bytes(), nil
+}
+
+// isTargetColorspace returns true if colors in `cs` are kept as is.
+func (cc *ColorConverter) isTargetColorspace(cs PdfColorspace) bool {
+	switch cs.(type) {
+	case *PdfColorspaceDeviceGray:
+		return true
+	case *PdfColorspaceDeviceCMYK:
+		return cc.toCMYK
+	case *PdfColorspaceSpecialSeparation, *PdfColorspaceDeviceN:
+		return cc.keepSpotColors
+	}
+	return false
+}
+
+// convertColor converts `color` in colorspace `cs` to the components of the target colorspace.
+func (cc *ColorConverter) convertColor(cs PdfColorspace, color PdfColor) ([]float64, error) {
+	rgb, err := cs.ColorToRGB(color)
+	if err != nil {
+		return nil, err
+	}
+	rgbColor, ok := rgb.(*PdfColorDeviceRGB)
+	if !ok {
+		return nil, errors.New("Type check error")
+	}
+
+	if cc.toCMYK {
+		cmyk := rgbColor.ToCMYK()
+		return []float64{cmyk.C(), cmyk.M(), cmyk.Y(), cmyk.K()}, nil
+	}
+	return []float64{rgbColor.ToGray().Val()}, nil
+}
+
+// convertColorOperation replaces color operation `op` with the equivalent operation in the target colorspace.
+// `cs` and `color` are the colorspace and color after the operation.
+func (cc *ColorConverter) convertColorOperation(op *ContentStreamOperation, cs PdfColorspace, color PdfColor, stroking bool) error {
+	if cs == nil || color == nil || isPatternCS(cs) || cc.isTargetColorspace(cs) {
+		return nil
+	}
+
+	vals, err := cc.convertColor(cs, color)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to convert color (%s): %v", op.Operand, err)
+		return err
+	}
+
+	operand := "g"
+	if cc.toCMYK {
+		operand = "k"
+	}
+	if stroking {
+		operand = map[string]string{"g": "G", "k": "K"}[operand]
+	}
+	op.Operand = operand
+	op.Params = makeParamsFromFloats(vals)
+	return nil
+}
+
+// convertImage converts image `img` in colorspace `cs` to the target colorspace.
+func (cc *ColorConverter) convertImage(img *Image, cs PdfColorspace) (*Image, error) {
+	rgbImg, err := cs.ImageToRGB(*img)
+	if err != nil {
+		return nil, err
+	}
+
+	rgbCS := NewPdfColorspaceDeviceRGB()
+	var converted Image
+	if cc.toCMYK {
+		converted, err = rgbCS.ImageToCMYK(rgbImg)
+	} else {
+		converted, err = rgbCS.ImageToGray(rgbImg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &converted, nil
+}
+
+// convertInlineImage converts the inline image of BI operation `op`.
+func (cc *ColorConverter) convertInlineImage(op *ContentStreamOperation, resources *PdfPageResources) error {
+	if len(op.Params) != 1 {
+		return nil
+	}
+	inlineImg, ok := op.Params[0].(*ContentStreamInlineImage)
+	if !ok {
+		return nil
+	}
+	isMask, err := inlineImg.IsMask()
+	if err != nil || isMask {
+		return err
+	}
+
+	cs, err := inlineImg.GetColorSpace(resources)
+	if err != nil {
+		return err
+	}
+	if cc.isTargetColorspace(cs) {
+		return nil
+	}
+
+	img, err := inlineImg.ToImage(resources)
+	if err != nil {
+		return err
+	}
+	converted, err := cc.convertImage(img, cs)
+	if err != nil {
+		return err
+	}
+	newImg, err := NewInlineImageFromImage(*converted, NewFlateEncoder())
+	if err != nil {
+		return err
+	}
+	op.Params[0] = newImg
+	return nil
+}
+
+// convertResources converts the XObjects and soft masks of `resources`.
+func (cc *ColorConverter) convertResources(resources *PdfPageResources) error {
+	if xobjDict, ok := TraceToDirectObject(resources.XObject).(*PdfObjectDictionary); ok {
+		for _, name := range xobjDict.Keys() {
+			stream, xtype := resources.GetXObjectByName(name)
+			if stream == nil || cc.converted[stream] {
+				continue
+			}
+			cc.converted[stream] = true
+
+			var err error
+			switch xtype {
+			case XObjectTypeImage:
+				err = cc.convertXObjectImage(resources, name)
+			case XObjectTypeForm:
+				err = cc.convertForm(stream)
+			}
+			if err != nil {
+				common.Log.Debug("ERROR: Unable to convert XObject %s: %v", name, err)
+				return err
+			}
+		}
+	}
+
+	if gsDict, ok := TraceToDirectObject(resources.ExtGState).(*PdfObjectDictionary); ok {
+		for _, name := range gsDict.Keys() {
+			gs, ok := TraceToDirectObject(gsDict.Get(name)).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			smask, ok := TraceToDirectObject(gs.Get("SMask")).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			cc.convertSoftMask(smask)
+			stream, ok := TraceToDirectObject(smask.Get("G")).(*PdfObjectStream)
+			if !ok || cc.converted[stream] {
+				continue
+			}
+			cc.converted[stream] = true
+			if err := cc.convertForm(stream); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// convertXObjectImage converts the image XObject `name` of `resources` in place.
+func (cc *ColorConverter) convertXObjectImage(resources *PdfPageResources, name PdfObjectName) error {
+	ximg, err := resources.GetXObjectImageByName(name)
+	if err != nil {
+		return err
+	}
+	if mask, ok := TraceToDirectObject(ximg.ImageMask).(*PdfObjectBool); ok && bool(*mask) {
+		// Stencil masks are painted with the current color.
+		return nil
+	}
+	if ximg.ColorSpace == nil || cc.isTargetColorspace(ximg.ColorSpace) {
+		return nil
+	}
+
+	img, err := ximg.ToImage()
+	if err != nil {
+		return err
+	}
+	converted, err := cc.convertImage(img, ximg.ColorSpace)
+	if err != nil {
+		return err
+	}
+
+	// The original encoding (e.g. DCT) may be specific to the number of color components.
+	ximg.Filter = NewFlateEncoder()
+	ximg.Decode = nil
+	err = ximg.SetImage(converted, cc.target)
+	if err != nil {
+		return err
+	}
+	ximg.ToPdfObject()
+	return nil
+}
+
+// convertForm converts form XObject `stream` in place, including its resources.
+func (cc *ColorConverter) convertForm(stream *PdfObjectStream) error {
+	xform, err := NewXObjectFormFromStream(stream)
+	if err != nil {
+		return err
+	}
+	content, err := xform.GetContentStream()
+	if err != nil {
+		return err
+	}
+
+	converted, err := cc.convertContent(string(content), xform.Resources)
+	if err != nil {
+		return err
+	}
+
+	encoder := NewFlateEncoder()
+	xform.Filter = encoder
+	err = xform.SetContentStream(converted, encoder)
+	if err != nil {
+		return err
+	}
+	cc.convertGroup(xform.Group)
+	xform.ToPdfObject()
+	return nil
+}
+
+// convertAppearance converts the appearance streams of appearance dictionary `ap`.
+func (cc *ColorConverter) convertAppearance(ap PdfObject) error {
+	apDict, ok := TraceToDirectObject(ap).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+
+	streams := []*PdfObjectStream{}
+	for _, key := range []PdfObjectName{"N", "R", "D"} {
+		switch t := TraceToDirectObject(apDict.Get(key)).(type) {
+		case *PdfObjectStream:
+			streams = append(streams, t)
+		case *PdfObjectDictionary:
+			// Appearance states.
+			for _, state := range t.Keys() {
+				if stream, ok := TraceToDirectObject(t.Get(state)).(*PdfObjectStream); ok {
+					streams = append(streams, stream)
+				}
+			}
+		}
+	}
+
+	for _, stream := range streams {
+		if cc.converted[stream] {
+			continue
+		}
+		cc.converted[stream] = true
+		err := cc.convertForm(stream)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertGroup sets the colorspace of transparency group dictionary `group` to the target colorspace.
+func (cc *ColorConverter) convertGroup(group PdfObject) {
+	groupDict, ok := TraceToDirectObject(group).(*PdfObjectDictionary)
+	if !ok || groupDict.Get("CS") == nil {
+		return
+	}
+	groupDict.Set("CS", cc.target.ToPdfObject())
+}
+
+// convertSoftMask converts the backdrop color of soft mask dictionary `smask`, which is specified in the
+// colorspace of the mask's transparency group.
+func (cc *ColorConverter) convertSoftMask(smask *PdfObjectDictionary) {
+	bc, ok := TraceToDirectObject(smask.Get("BC")).(*PdfObjectArray)
+	if !ok {
+		return
+	}
+	vals, err := bc.GetAsFloat64Slice()
+	if err != nil {
+		return
+	}
+
+	var rgb *PdfColorDeviceRGB
+	switch len(vals) {
+	case 3:
+		rgb = NewPdfColorDeviceRGB(vals[0], vals[1], vals[2])
+	case 4:
+		if cc.toCMYK {
+			return
+		}
+		color, err := NewPdfColorspaceDeviceCMYK().ColorToRGB(NewPdfColorDeviceCMYK(vals[0], vals[1], vals[2], vals[3]))
+		if err != nil {
+			return
+		}
+		rgb = color.(*PdfColorDeviceRGB)
+	default:
+		// DeviceGray backdrops are kept.
+		return
+	}
+
+	if cc.toCMYK {
+		cmyk := rgb.ToCMYK()
+		smask.Set("BC", MakeArrayFromFloats([]float64{cmyk.C(), cmyk.M(), cmyk.Y(), cmyk.K()}))
+	} else {
+		smask.Set("BC", MakeArrayFromFloats([]float64{rgb.ToGray().Val()}))
+	}
+}
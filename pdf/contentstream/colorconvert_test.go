@@ -0,0 +1,74 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package contentstream
+
+import (
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestColorConverter(t *testing.T) {
+	img := &model.Image{Width: 2, Height: 1, BitsPerComponent: 8, ColorComponents: 3, Data: []byte{255, 0, 0, 0, 0, 255}}
+
+	for _, toCMYK := range []bool{false, true} {
+		ximg, err := model.NewXObjectImageFromImage(img, model.NewPdfColorspaceDeviceRGB(), nil)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		page := model.NewPdfPage()
+		page.Resources = model.NewPdfPageResources()
+		page.Resources.SetXObjectImageByName("Im1", ximg)
+		err = page.SetContentStreams([]string{"1 0 0 rg 0 0 1 RG 0.5 g 0 0 0 1 K /DeviceRGB cs 0 1 0 sc /Im1 Do"}, nil)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+
+		var target model.PdfColorspace = model.NewPdfColorspaceDeviceGray()
+		expected := "0.300000 g\n0.110000 G\n0.500000 g\n0.000000 G\n0.000000 g\n0.590000 g\n/Im1 Do\n"
+		if toCMYK {
+			target = model.NewPdfColorspaceDeviceCMYK()
+			expected = "0.000000 1.000000 1.000000 0.000000 k\n1.000000 1.000000 0.000000 0.000000 K\n0.500000 g\n" +
+				"0 0 0 1 K\n0.000000 0.000000 0.000000 1.000000 k\n1.000000 0.000000 1.000000 0.000000 k\n/Im1 Do\n"
+		}
+		cc, err := NewColorConverter(target)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		err = cc.ConvertPage(page)
+		if err != nil {
+			t.Fatalf("Error converting: %v", err)
+		}
+
+		contents, err := page.GetAllContentStreams()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if contents != expected {
+			t.Errorf("CMYK %v: content mismatch:\n%s\n!=\n%s", toCMYK, contents, expected)
+		}
+
+		converted, err := page.Resources.GetXObjectImageByName("Im1")
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if converted.ColorSpace.GetNumComponents() != target.GetNumComponents() {
+			t.Errorf("Image colorspace not converted: %T", converted.ColorSpace)
+		}
+		data, err := core.DecodeStream(converted.GetContainingPdfObject().(*core.PdfObjectStream))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		expectedData := []byte{76, 28}
+		if toCMYK {
+			expectedData = []byte{0, 255, 255, 0, 255, 255, 0, 0}
+		}
+		if string(data) != string(expectedData) {
+			t.Errorf("CMYK %v: image data mismatch: %v", toCMYK, data)
+		}
+	}
+}
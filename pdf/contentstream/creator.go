@@ -616,3 +616,34 @@ func (this *ContentCreator) Add_TJ(vals ...PdfObject) *ContentCreator {
 	this.operands = append(this.operands, &op)
 	return this
 }
+
+/* Marked content operators */
+
+// BMC: Begin a marked-content sequence with the tag specified by `tag`.
+func (this *ContentCreator) Add_BMC(tag PdfObjectName) *ContentCreator {
+	op := ContentStreamOperation{}
+	op.Operand = "BMC"
+	op.Params = makeParamsFromNames([]PdfObjectName{tag})
+	this.operands = append(this.operands, &op)
+	return this
+}
+
+// BDC: Begin a marked-content sequence with an associated property list. The property list can be either
+// an inline dictionary or the name of an entry in the Properties resource subdictionary.
+// Typical properties are ActualText (replacement text), Alt and Lang for accessibility.
+func (this *ContentCreator) Add_BDC(tag PdfObjectName, propertyList PdfObject) *ContentCreator {
+	op := ContentStreamOperation{}
+	op.Operand = "BDC"
+	op.Params = makeParamsFromNames([]PdfObjectName{tag})
+	op.Params = append(op.Params, propertyList)
+	this.operands = append(this.operands, &op)
+	return this
+}
+
+// EMC: End a marked-content sequence.
+func (this *ContentCreator) Add_EMC() *ContentCreator {
+	op := ContentStreamOperation{}
+	op.Operand = "EMC"
+	this.operands = append(this.operands, &op)
+	return this
+}
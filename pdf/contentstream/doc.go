@@ -13,5 +13,8 @@
 // For creating content streams, see NewContentCreator.  It allows adding multiple operands and then can
 // be converted to a string for embedding in a PDF file.
 //
+// Default appearance (DA) strings of form fields and free text annotations can be parsed and built with
+// ParseDefaultAppearance and NewDefaultAppearance.
+//
 // The contentstream package uses the core and model packages.
 package contentstream
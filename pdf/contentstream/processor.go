@@ -7,6 +7,7 @@ package contentstream
 
 import (
 	"errors"
+	"math"
 
 	"github.com/unidoc/unidoc/common"
 	. "github.com/unidoc/unidoc/pdf/core"
@@ -20,6 +21,41 @@ type GraphicsState struct {
 	ColorspaceNonStroking PdfColorspace
 	ColorStroking         PdfColor
 	ColorNonStroking      PdfColor
+
+	// Current transformation matrix, maps user space to device (default page) space.
+	CTM       Matrix
+	LineWidth float64
+
+	// Text state parameters (section 9.3 p. 243).
+	Text TextState
+
+	// Bounding box of the current clipping path in device space. Nil if no clipping has been applied.
+	// The clipping path is approximated by the bounding box of the paths used for clipping.
+	ClipBox *PdfRectangle
+}
+
+// TextState holds the text state parameters and the text matrices.
+// The text matrices are only meaningful within a BT/ET text object and are reset by BT.
+type TextState struct {
+	CharSpacing       float64       // Tc
+	WordSpacing       float64       // Tw
+	HorizontalScaling float64       // Tz, as a fraction (1.0 is 100%).
+	Leading           float64       // TL
+	FontName          PdfObjectName // Font resource name set by Tf.
+	FontSize          float64       // Tf
+	RenderMode        int64         // Tr
+	Rise              float64       // Ts
+
+	TextMatrix     Matrix // Tm
+	TextLineMatrix Matrix // Tlm
+}
+
+// TextRenderingMatrix returns the text rendering matrix which maps text space to device space (section 9.4.2
+// p. 252), i.e. the transformation of a glyph shown at the current text position.
+func (gs GraphicsState) TextRenderingMatrix() Matrix {
+	ts := gs.Text
+	m := NewMatrix(ts.FontSize*ts.HorizontalScaling, 0, 0, ts.FontSize, 0, ts.Rise)
+	return m.Mult(ts.TextMatrix).Mult(gs.CTM)
 }
 
 type GraphicStateStack []GraphicsState
@@ -44,6 +80,17 @@ type ContentStreamProcessor struct {
 
 	handlers     []HandlerEntry
 	currentIndex int
+
+	// Current path in device space, as the bounding box of its points (including curve control points).
+	pathBBox *PdfRectangle
+	// Current point and start of the current subpath in user space.
+	pathX, pathY            float64
+	subpathX, subpathY      float64
+	pendingClip             bool
+	fontMetrics             map[PdfObject]*textFontMetrics
+	recurseFormXObjects     bool
+	maxFormXObjectDepth     int
+	currentFormXObjectDepth int
 }
 
 type HandlerFunc func(op *ContentStreamOperation, gs GraphicsState, resources *PdfPageResources) error
@@ -64,11 +111,54 @@ func (this HandlerConditionEnum) Operand() bool {
 	return this == HandlerConditionEnumOperand
 }
 
+// Class returns true if the condition matches a class of operators rather than specific operands.
+func (this HandlerConditionEnum) Class() bool {
+	return this == HandlerConditionEnumText || this == HandlerConditionEnumPath || this == HandlerConditionEnumImage
+}
+
 const (
 	HandlerConditionEnumOperand     HandlerConditionEnum = iota
 	HandlerConditionEnumAllOperands HandlerConditionEnum = iota
+
+	// Text showing operators: Tj, TJ, ' and ".
+	HandlerConditionEnumText HandlerConditionEnum = iota
+	// Path painting operators: S, s, f, F, f*, B, B*, b, b* and n.
+	// The current path is available via ContentStreamProcessor.CurrentPathBBox within the handler.
+	HandlerConditionEnumPath HandlerConditionEnum = iota
+	// Image painting: Do operators referring to image XObjects and inline images (BI).
+	// The image occupies the unit square in user space, i.e. gs.CTM maps it onto the page.
+	HandlerConditionEnumImage HandlerConditionEnum = iota
 )
 
+// Default limit on nesting of form XObjects when recursing into them.
+const defaultMaxFormXObjectDepth = 20
+
+// getOperationClass returns the class of operation `op` as matched by HandlerConditionEnumText/Path/Image, or
+// HandlerConditionEnumOperand if the operation does not belong to any of the classes.
+func getOperationClass(op *ContentStreamOperation, resources *PdfPageResources) HandlerConditionEnum {
+	switch op.Operand {
+	case "Tj", "TJ", "'", "\"":
+		return HandlerConditionEnumText
+	case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+		return HandlerConditionEnumPath
+	case "BI":
+		return HandlerConditionEnumImage
+	case "Do":
+		if len(op.Params) != 1 || resources == nil {
+			return HandlerConditionEnumOperand
+		}
+		name, ok := op.Params[0].(*PdfObjectName)
+		if !ok {
+			return HandlerConditionEnumOperand
+		}
+		_, xtype := resources.GetXObjectByName(*name)
+		if xtype == XObjectTypeImage {
+			return HandlerConditionEnumImage
+		}
+	}
+	return HandlerConditionEnumOperand
+}
+
 func NewContentStreamProcessor(ops []*ContentStreamOperation) *ContentStreamProcessor {
 	csp := ContentStreamProcessor{}
 	csp.graphicsStack = GraphicStateStack{}
@@ -82,9 +172,29 @@ func NewContentStreamProcessor(ops []*ContentStreamOperation) *ContentStreamProc
 	csp.currentIndex = 0
 	csp.operations = ops
 
+	csp.fontMetrics = map[PdfObject]*textFontMetrics{}
+	csp.maxFormXObjectDepth = defaultMaxFormXObjectDepth
+
 	return &csp
 }
 
+// SetFormXObjectRecursion sets whether the processor descends into form XObjects painted with Do, processing their
+// content streams with the form's resources and matrix applied. Disabled by default, in which case only the Do
+// operation itself is visible to handlers.
+func (csp *ContentStreamProcessor) SetFormXObjectRecursion(recurse bool) {
+	csp.recurseFormXObjects = recurse
+}
+
+// CurrentPathBBox returns the bounding box of the current path in device space. Returns false if no path is
+// under construction. Typically called from path painting handlers (HandlerConditionEnumPath) to get the
+// extent of the painted area.
+func (csp *ContentStreamProcessor) CurrentPathBBox() (PdfRectangle, bool) {
+	if csp.pathBBox == nil {
+		return PdfRectangle{}, false
+	}
+	return *csp.pathBBox, true
+}
+
 func (csp *ContentStreamProcessor) AddHandler(condition HandlerConditionEnum, operand string, handler HandlerFunc) {
 	entry := HandlerEntry{}
 	entry.Condition = condition
@@ -106,9 +216,11 @@ func (csp *ContentStreamProcessor) getColorspace(name string, resources *PdfPage
 	}
 
 	// Next check the colorspace dictionary.
-	cs, has := resources.ColorSpace.Colorspaces[name]
-	if has {
-		return cs, nil
+	if resources != nil && resources.ColorSpace != nil {
+		cs, has := resources.ColorSpace.Colorspaces[name]
+		if has {
+			return cs, nil
+		}
 	}
 
 	// Lastly check other potential colormaps.
@@ -200,8 +312,25 @@ func (this *ContentStreamProcessor) Process(resources *PdfPageResources) error {
 	this.graphicsState.ColorspaceNonStroking = NewPdfColorspaceDeviceGray()
 	this.graphicsState.ColorStroking = NewPdfColorDeviceGray(0)
 	this.graphicsState.ColorNonStroking = NewPdfColorDeviceGray(0)
+	this.graphicsState.CTM = IdentityMatrix()
+	this.graphicsState.LineWidth = 1.0
+	this.graphicsState.Text = TextState{
+		HorizontalScaling: 1.0,
+		TextMatrix:        IdentityMatrix(),
+		TextLineMatrix:    IdentityMatrix(),
+	}
+	this.graphicsState.ClipBox = nil
+	this.pathBBox = nil
+	this.pendingClip = false
+	this.currentFormXObjectDepth = 0
 
-	for _, op := range this.operations {
+	return this.processOperations(this.operations, resources)
+}
+
+// processOperations processes `operations` with `resources`, updating the graphics state and invoking the
+// handlers.
+func (this *ContentStreamProcessor) processOperations(operations []*ContentStreamOperation, resources *PdfPageResources) error {
+	for _, op := range operations {
 		var err error
 
 		// Internal handling.
@@ -209,8 +338,40 @@ func (this *ContentStreamProcessor) Process(resources *PdfPageResources) error {
 		case "q":
 			this.graphicsStack.Push(this.graphicsState)
 		case "Q":
+			if len(this.graphicsStack) == 0 {
+				common.Log.Debug("Q without matching q - ignoring")
+				break
+			}
 			this.graphicsState = this.graphicsStack.Pop()
 
+		// General graphics state and special graphics state operators (Tables 57, 58 p. 127-128)
+		case "cm":
+			this.handleCommand_cm(op)
+		case "w":
+			this.handleCommand_w(op)
+
+		// Path construction operators (Table 59 p. 133)
+		case "m", "l", "c", "v", "y", "h", "re":
+			this.handleCommand_path(op)
+		case "W", "W*":
+			this.pendingClip = true
+
+		// Text object and text state operators (Tables 105, 107 p. 243, 250)
+		case "BT":
+			this.graphicsState.Text.TextMatrix = IdentityMatrix()
+			this.graphicsState.Text.TextLineMatrix = IdentityMatrix()
+		case "Tc", "Tw", "Tz", "TL", "Tf", "Tr", "Ts":
+			this.handleCommand_textState(op)
+		case "Td", "TD", "Tm", "T*":
+			this.handleCommand_textPosition(op)
+		case "'", "\"":
+			// Move to the next line prior to showing the text, setting the spacings first for ".
+			if op.Operand == "\"" && len(op.Params) == 3 {
+				this.graphicsState.Text.WordSpacing, _ = getNumberAsFloat(op.Params[0])
+				this.graphicsState.Text.CharSpacing, _ = getNumberAsFloat(op.Params[1])
+			}
+			this.nextLine()
+
 		// Color operations (Table 74 p. 179)
 		case "CS":
 			err = this.handleCommand_CS(op, resources)
@@ -244,23 +405,526 @@ func (this *ContentStreamProcessor) Process(resources *PdfPageResources) error {
 		}
 
 		// Check if have external handler also, and process if so.
+		class := HandlerConditionEnumOperand
+		if this.hasClassHandlers() {
+			class = getOperationClass(op, resources)
+		}
 		for _, entry := range this.handlers {
 			var err error
 			if entry.Condition.All() {
 				err = entry.Handler(op, this.graphicsState, resources)
 			} else if entry.Condition.Operand() && op.Operand == entry.Operand {
 				err = entry.Handler(op, this.graphicsState, resources)
+			} else if entry.Condition.Class() && entry.Condition == class {
+				err = entry.Handler(op, this.graphicsState, resources)
 			}
 			if err != nil {
 				common.Log.Debug("Processor handler error: %v", err)
 				return err
 			}
 		}
+
+		// Effects taking place after the operation has been handled.
+		switch op.Operand {
+		case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+			this.endPath()
+		case "Tj", "TJ", "'", "\"":
+			this.advanceText(op, resources)
+		case "Do":
+			if this.recurseFormXObjects {
+				err = this.processFormXObject(op, resources)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	return nil
 }
 
+// hasClassHandlers returns true if any of the handlers match operator classes.
+func (this *ContentStreamProcessor) hasClassHandlers() bool {
+	for _, entry := range this.handlers {
+		if entry.Condition.Class() {
+			return true
+		}
+	}
+	return false
+}
+
+// processFormXObject processes the content stream of the form XObject painted by Do operation `op`, if it refers
+// to a form.
+func (this *ContentStreamProcessor) processFormXObject(op *ContentStreamOperation, resources *PdfPageResources) error {
+	if len(op.Params) != 1 || resources == nil {
+		return nil
+	}
+	name, ok := op.Params[0].(*PdfObjectName)
+	if !ok {
+		return nil
+	}
+	_, xtype := resources.GetXObjectByName(*name)
+	if xtype != XObjectTypeForm {
+		return nil
+	}
+	if this.currentFormXObjectDepth >= this.maxFormXObjectDepth {
+		common.Log.Debug("Form XObject nesting too deep (%d) - skipping %s", this.currentFormXObjectDepth, *name)
+		return nil
+	}
+
+	xform, err := resources.GetXObjectFormByName(*name)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to load form XObject %s: %v", *name, err)
+		return err
+	}
+	content, err := xform.GetContentStream()
+	if err != nil {
+		return err
+	}
+	operations, err := NewContentStreamParser(string(content)).Parse()
+	if err != nil {
+		return err
+	}
+
+	formResources := xform.Resources
+	if formResources == nil {
+		// Forms without resources inherit those of the page (deprecated, but common).
+		formResources = resources
+	}
+
+	// The form is painted within an implicit q/Q, with its matrix concatenated to the CTM.
+	this.graphicsStack.Push(this.graphicsState)
+	depth := len(this.graphicsStack)
+	if xform.Matrix != nil {
+		m, err := NewMatrixFromPdfObject(xform.Matrix)
+		if err != nil {
+			common.Log.Debug("Invalid form matrix: %v", err)
+		} else {
+			this.graphicsState.CTM = m.Mult(this.graphicsState.CTM)
+		}
+	}
+	if bboxArr, ok := TraceToDirectObject(xform.BBox).(*PdfObjectArray); ok {
+		if bbox, err := NewPdfRectangle(*bboxArr); err == nil {
+			this.intersectClip(this.graphicsState.CTM.TransformRect(*bbox))
+		}
+	}
+
+	this.currentFormXObjectDepth++
+	err = this.processOperations(*operations, formResources)
+	this.currentFormXObjectDepth--
+	if err != nil {
+		return err
+	}
+
+	// Drop any unbalanced q within the form.
+	for len(this.graphicsStack) > depth {
+		this.graphicsStack.Pop()
+	}
+	if len(this.graphicsStack) == depth {
+		this.graphicsState = this.graphicsStack.Pop()
+	}
+	this.pathBBox = nil
+	this.pendingClip = false
+
+	return nil
+}
+
+// cm: Concatenate matrix to the current transformation matrix.
+// a b c d e f cm
+func (this *ContentStreamProcessor) handleCommand_cm(op *ContentStreamOperation) {
+	if len(op.Params) != 6 {
+		common.Log.Debug("Invalid number of parameters for cm: %d", len(op.Params))
+		return
+	}
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+		return
+	}
+	m := NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+	this.graphicsState.CTM = m.Mult(this.graphicsState.CTM)
+}
+
+// w: Set the line width.
+// lineWidth w
+func (this *ContentStreamProcessor) handleCommand_w(op *ContentStreamOperation) {
+	if len(op.Params) != 1 {
+		common.Log.Debug("Invalid number of parameters for w: %d", len(op.Params))
+		return
+	}
+	lw, err := getNumberAsFloat(op.Params[0])
+	if err != nil {
+		common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+		return
+	}
+	this.graphicsState.LineWidth = lw
+}
+
+// handleCommand_path handles the path construction operators m, l, c, v, y, h and re, extending the bounding
+// box of the current path. Like the other state tracking handlers, it skips operations with invalid operands, which
+// are common in real-world content streams, leaving the state unchanged.
+func (this *ContentStreamProcessor) handleCommand_path(op *ContentStreamOperation) {
+	numParams := map[string]int{"m": 2, "l": 2, "c": 6, "v": 4, "y": 4, "h": 0, "re": 4}[op.Operand]
+	if len(op.Params) != numParams {
+		common.Log.Debug("Invalid number of parameters for %s: %d", op.Operand, len(op.Params))
+		return
+	}
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+		return
+	}
+
+	switch op.Operand {
+	case "m":
+		this.subpathX, this.subpathY = vals[0], vals[1]
+		this.addPathPoint(vals[0], vals[1])
+	case "l", "c", "v", "y":
+		if op.Operand == "v" {
+			// The current point is the first control point.
+			this.addPathPoint(this.pathX, this.pathY)
+		}
+		for i := 0; i < len(vals); i += 2 {
+			this.addPathPoint(vals[i], vals[i+1])
+		}
+	case "h":
+		this.pathX, this.pathY = this.subpathX, this.subpathY
+	case "re":
+		x, y, w, h := vals[0], vals[1], vals[2], vals[3]
+		this.addPathPoint(x, y)
+		this.addPathPoint(x+w, y)
+		this.addPathPoint(x+w, y+h)
+		this.addPathPoint(x, y+h)
+		this.subpathX, this.subpathY = x, y
+		this.pathX, this.pathY = x, y
+	}
+}
+
+// addPathPoint adds user space point (x, y) to the current path and makes it the current point.
+func (this *ContentStreamProcessor) addPathPoint(x, y float64) {
+	this.pathX, this.pathY = x, y
+	dx, dy := this.graphicsState.CTM.Transform(x, y)
+	if this.pathBBox == nil {
+		this.pathBBox = &PdfRectangle{Llx: dx, Lly: dy, Urx: dx, Ury: dy}
+		return
+	}
+	this.pathBBox.Llx = math.Min(this.pathBBox.Llx, dx)
+	this.pathBBox.Lly = math.Min(this.pathBBox.Lly, dy)
+	this.pathBBox.Urx = math.Max(this.pathBBox.Urx, dx)
+	this.pathBBox.Ury = math.Max(this.pathBBox.Ury, dy)
+}
+
+// endPath ends the current path after painting, applying a pending clip (W/W*).
+func (this *ContentStreamProcessor) endPath() {
+	if this.pendingClip && this.pathBBox != nil {
+		this.intersectClip(*this.pathBBox)
+	}
+	this.pendingClip = false
+	this.pathBBox = nil
+}
+
+// intersectClip intersects the clipping path with `rect` (device space).
+func (this *ContentStreamProcessor) intersectClip(rect PdfRectangle) {
+	clip := rect
+	if this.graphicsState.ClipBox != nil {
+		cur := this.graphicsState.ClipBox
+		clip.Llx = math.Max(clip.Llx, cur.Llx)
+		clip.Lly = math.Max(clip.Lly, cur.Lly)
+		clip.Urx = math.Min(clip.Urx, cur.Urx)
+		clip.Ury = math.Min(clip.Ury, cur.Ury)
+	}
+	if clip.Urx < clip.Llx {
+		clip.Urx = clip.Llx
+	}
+	if clip.Ury < clip.Lly {
+		clip.Ury = clip.Lly
+	}
+	// Always a new rectangle, as the previous one may be referenced by saved graphics states.
+	this.graphicsState.ClipBox = &clip
+}
+
+// handleCommand_textState handles the text state operators Tc, Tw, Tz, TL, Tf, Tr and Ts.
+func (this *ContentStreamProcessor) handleCommand_textState(op *ContentStreamOperation) {
+	ts := &this.graphicsState.Text
+	if op.Operand == "Tf" {
+		if len(op.Params) != 2 {
+			common.Log.Debug("Invalid number of parameters for Tf: %d", len(op.Params))
+			return
+		}
+		name, ok := op.Params[0].(*PdfObjectName)
+		if !ok {
+			common.Log.Debug("ERROR: Tf font input not a name")
+			return
+		}
+		size, err := getNumberAsFloat(op.Params[1])
+		if err != nil {
+			common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+			return
+		}
+		ts.FontName = *name
+		ts.FontSize = size
+		return
+	}
+
+	if len(op.Params) != 1 {
+		common.Log.Debug("Invalid number of parameters for %s: %d", op.Operand, len(op.Params))
+		return
+	}
+	val, err := getNumberAsFloat(op.Params[0])
+	if err != nil {
+		common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+		return
+	}
+	switch op.Operand {
+	case "Tc":
+		ts.CharSpacing = val
+	case "Tw":
+		ts.WordSpacing = val
+	case "Tz":
+		ts.HorizontalScaling = val / 100.0
+	case "TL":
+		ts.Leading = val
+	case "Tr":
+		ts.RenderMode = int64(val)
+	case "Ts":
+		ts.Rise = val
+	}
+}
+
+// handleCommand_textPosition handles the text positioning operators Td, TD, Tm and T*.
+func (this *ContentStreamProcessor) handleCommand_textPosition(op *ContentStreamOperation) {
+	ts := &this.graphicsState.Text
+	numParams := map[string]int{"Td": 2, "TD": 2, "Tm": 6, "T*": 0}[op.Operand]
+	if len(op.Params) != numParams {
+		common.Log.Debug("Invalid number of parameters for %s: %d", op.Operand, len(op.Params))
+		return
+	}
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid parameters for %s: %v", op.Operand, err)
+		return
+	}
+
+	switch op.Operand {
+	case "Td", "TD":
+		if op.Operand == "TD" {
+			ts.Leading = -vals[1]
+		}
+		ts.TextLineMatrix = TranslationMatrix(vals[0], vals[1]).Mult(ts.TextLineMatrix)
+		ts.TextMatrix = ts.TextLineMatrix
+	case "Tm":
+		ts.TextLineMatrix = NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+		ts.TextMatrix = ts.TextLineMatrix
+	case "T*":
+		this.nextLine()
+	}
+}
+
+// nextLine moves to the start of the next text line (T*).
+func (this *ContentStreamProcessor) nextLine() {
+	ts := &this.graphicsState.Text
+	ts.TextLineMatrix = TranslationMatrix(0, -ts.Leading).Mult(ts.TextLineMatrix)
+	ts.TextMatrix = ts.TextLineMatrix
+}
+
+// advanceText advances the text matrix past the text shown by text showing operation `op` (section 9.4.4 p. 254).
+func (this *ContentStreamProcessor) advanceText(op *ContentStreamOperation, resources *PdfPageResources) {
+	tx := this.GetTextDisplacement(op, resources)
+	ts := &this.graphicsState.Text
+	ts.TextMatrix = TranslationMatrix(tx, 0).Mult(ts.TextMatrix)
+}
+
+// GetTextDisplacement returns the horizontal displacement in text space of the text shown by text showing
+// operation `op` (Tj, TJ, ' or ") with the current text state, i.e. the distance the text matrix is advanced by
+// the operation. Can be called from handlers to get the extent of the shown text.
+// The glyph widths are taken from the font dictionary. If the font cannot be loaded, only the positioning values
+// of TJ and the character and word spacing are accounted for.
+func (this *ContentStreamProcessor) GetTextDisplacement(op *ContentStreamOperation, resources *PdfPageResources) float64 {
+	if len(op.Params) < 1 {
+		return 0
+	}
+	ts := this.graphicsState.Text
+	metrics := this.getFontMetrics(ts.FontName, resources)
+
+	var tx float64
+	showString := func(s *PdfObjectString) {
+		for _, code := range metrics.codes([]byte(*s)) {
+			w := metrics.width(code) / 1000.0
+			spacing := ts.CharSpacing
+			if code == 32 && metrics.bytesPerCode == 1 {
+				spacing += ts.WordSpacing
+			}
+			tx += (w*ts.FontSize + spacing) * ts.HorizontalScaling
+		}
+	}
+
+	switch op.Operand {
+	case "TJ":
+		arr, ok := op.Params[0].(*PdfObjectArray)
+		if !ok {
+			return 0
+		}
+		for _, obj := range *arr {
+			if s, isString := obj.(*PdfObjectString); isString {
+				showString(s)
+				continue
+			}
+			if num, err := getNumberAsFloat(obj); err == nil {
+				tx -= num / 1000.0 * ts.FontSize * ts.HorizontalScaling
+			}
+		}
+	default:
+		// The string is the last parameter for Tj, ' and ".
+		if s, ok := op.Params[len(op.Params)-1].(*PdfObjectString); ok {
+			showString(s)
+		}
+	}
+
+	return tx
+}
+
+// textFontMetrics holds the glyph widths of a font in glyph space units (1/1000 text space units).
+type textFontMetrics struct {
+	bytesPerCode int
+	widths       map[int]float64
+	defaultWidth float64
+}
+
+func (metrics *textFontMetrics) codes(data []byte) []int {
+	codes := []int{}
+	if metrics.bytesPerCode == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			codes = append(codes, int(data[i])<<8|int(data[i+1]))
+		}
+		return codes
+	}
+	for _, b := range data {
+		codes = append(codes, int(b))
+	}
+	return codes
+}
+
+func (metrics *textFontMetrics) width(code int) float64 {
+	if w, has := metrics.widths[code]; has {
+		return w
+	}
+	return metrics.defaultWidth
+}
+
+// getFontMetrics returns the glyph widths of font `name` in `resources`. The metrics are cached per font object.
+// Simple fonts are loaded from the Widths array. Composite (Type0) fonts are assumed to use 2-byte codes mapped
+// to CIDs by an identity CMap, with widths loaded from the W and DW entries of the descendant font.
+// A font which cannot be loaded gets zero widths.
+func (this *ContentStreamProcessor) getFontMetrics(name PdfObjectName, resources *PdfPageResources) *textFontMetrics {
+	metrics := &textFontMetrics{bytesPerCode: 1, widths: map[int]float64{}}
+	if resources == nil {
+		return metrics
+	}
+	fontObj, has := resources.GetFontByName(name)
+	if !has {
+		return metrics
+	}
+	if cached, has := this.fontMetrics[fontObj]; has {
+		return cached
+	}
+	this.fontMetrics[fontObj] = metrics
+
+	fontDict, ok := TraceToDirectObject(fontObj).(*PdfObjectDictionary)
+	if !ok {
+		return metrics
+	}
+
+	subtype, _ := TraceToDirectObject(fontDict.Get("Subtype")).(*PdfObjectName)
+	if subtype != nil && *subtype == "Type0" {
+		metrics.bytesPerCode = 2
+		metrics.defaultWidth = 1000
+		descendants, ok := TraceToDirectObject(fontDict.Get("DescendantFonts")).(*PdfObjectArray)
+		if !ok || len(*descendants) == 0 {
+			return metrics
+		}
+		cidFont, ok := TraceToDirectObject((*descendants)[0]).(*PdfObjectDictionary)
+		if !ok {
+			return metrics
+		}
+		if dw, err := getNumberAsFloat(TraceToDirectObject(cidFont.Get("DW"))); err == nil {
+			metrics.defaultWidth = dw
+		}
+		if w, ok := TraceToDirectObject(cidFont.Get("W")).(*PdfObjectArray); ok {
+			loadCIDWidths(metrics.widths, w)
+		}
+		return metrics
+	}
+
+	if descriptor, ok := TraceToDirectObject(fontDict.Get("FontDescriptor")).(*PdfObjectDictionary); ok {
+		if mw, err := getNumberAsFloat(TraceToDirectObject(descriptor.Get("MissingWidth"))); err == nil {
+			metrics.defaultWidth = mw
+		}
+	}
+	firstChar, err := getNumberAsFloat(TraceToDirectObject(fontDict.Get("FirstChar")))
+	if err != nil {
+		return metrics
+	}
+	widths, ok := TraceToDirectObject(fontDict.Get("Widths")).(*PdfObjectArray)
+	if !ok {
+		return metrics
+	}
+	vals, err := widths.GetAsFloat64Slice()
+	if err != nil {
+		common.Log.Debug("Invalid font widths: %v", err)
+		return metrics
+	}
+	for i, w := range vals {
+		metrics.widths[int(firstChar)+i] = w
+	}
+	return metrics
+}
+
+// maxCID is the largest CID of the 2-byte CID space.
+const maxCID = 0xFFFF
+
+// loadCIDWidths loads the widths specified by a CIDFont W array into `widths`. The array consists of entries of
+// the forms `c [w1 w2 ...]` and `cFirst cLast w` (section 9.7.4.3 p. 271).
+func loadCIDWidths(widths map[int]float64, w *PdfObjectArray) {
+	arr := *w
+	for i := 0; i < len(arr); {
+		first, err := getNumberAsFloat(TraceToDirectObject(arr[i]))
+		if err != nil || i+1 >= len(arr) {
+			return
+		}
+		if list, ok := TraceToDirectObject(arr[i+1]).(*PdfObjectArray); ok {
+			vals, err := list.GetAsFloat64Slice()
+			if err != nil {
+				return
+			}
+			for j, val := range vals {
+				widths[int(first)+j] = val
+			}
+			i += 2
+			continue
+		}
+		if i+2 >= len(arr) {
+			return
+		}
+		last, err := getNumberAsFloat(TraceToDirectObject(arr[i+1]))
+		if err != nil {
+			return
+		}
+		val, err := getNumberAsFloat(TraceToDirectObject(arr[i+2]))
+		if err != nil {
+			return
+		}
+		// The range is clamped to the 2-byte CID space, as hostile arrays can specify huge ranges.
+		if first < 0 || last < first {
+			common.Log.Debug("Invalid CID width range: %v %v", first, last)
+		} else {
+			for cid := int(first); cid <= int(math.Min(last, maxCID)); cid++ {
+				widths[cid] = val
+			}
+		}
+		i += 3
+	}
+}
+
 // CS: Set the current color space for stroking operations.
 func (csp *ContentStreamProcessor) handleCommand_CS(op *ContentStreamOperation, resources *PdfPageResources) error {
 	if len(op.Params) < 1 {
@@ -0,0 +1,153 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package contentstream
+
+import (
+	"math"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestProcessorGraphicsStateTracking(t *testing.T) {
+	content := `q
+2 0 0 2 10 20 cm
+0 0 100 50 re W n
+1 0 0 rg
+0 0 10 10 re f
+BT
+/F1 12 Tf
+5 6 Td
+(ab) Tj
+(c) Tj
+ET
+q 30 0 0 40 0 0 cm /Im1 Do Q
+Q
+0 0 5 5 re S
+`
+	font := core.MakeDict()
+	font.Set("Type", core.MakeName("Font"))
+	font.Set("Subtype", core.MakeName("Type1"))
+	font.Set("FirstChar", core.MakeInteger(97))
+	font.Set("Widths", core.MakeArrayFromFloats([]float64{500, 600, 700}))
+	fonts := core.MakeDict()
+	fonts.Set("F1", font)
+
+	imgStream, err := core.MakeStream([]byte{0}, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	imgStream.PdfObjectDictionary.Set("Subtype", core.MakeName("Image"))
+	xobjects := core.MakeDict()
+	xobjects.Set("Im1", imgStream)
+
+	resources := model.NewPdfPageResources()
+	resources.Font = fonts
+	resources.XObject = xobjects
+
+	operations, err := NewContentStreamParser(content).Parse()
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	processor := NewContentStreamProcessor(*operations)
+
+	fills := []model.PdfRectangle{}
+	clips := []*model.PdfRectangle{}
+	processor.AddHandler(HandlerConditionEnumPath, "",
+		func(op *ContentStreamOperation, gs GraphicsState, resources *model.PdfPageResources) error {
+			bbox, ok := processor.CurrentPathBBox()
+			if !ok {
+				t.Errorf("%s: no current path", op.Operand)
+			}
+			fills = append(fills, bbox)
+			clips = append(clips, gs.ClipBox)
+			return nil
+		})
+
+	textPositions := [][2]float64{}
+	processor.AddHandler(HandlerConditionEnumText, "",
+		func(op *ContentStreamOperation, gs GraphicsState, resources *model.PdfPageResources) error {
+			if gs.Text.FontName != "F1" || gs.Text.FontSize != 12 {
+				t.Errorf("Text state mismatch: %+v", gs.Text)
+			}
+			x, y := gs.TextRenderingMatrix().Transform(0, 0)
+			textPositions = append(textPositions, [2]float64{x, y})
+			return nil
+		})
+
+	images := []model.PdfRectangle{}
+	processor.AddHandler(HandlerConditionEnumImage, "",
+		func(op *ContentStreamOperation, gs GraphicsState, resources *model.PdfPageResources) error {
+			images = append(images, gs.CTM.TransformRect(model.PdfRectangle{Llx: 0, Lly: 0, Urx: 1, Ury: 1}))
+			return nil
+		})
+
+	err = processor.Process(resources)
+	if err != nil {
+		t.Fatalf("Error processing: %v", err)
+	}
+
+	expectedFills := []model.PdfRectangle{
+		{Llx: 10, Lly: 20, Urx: 210, Ury: 120}, // Clipping path (n).
+		{Llx: 10, Lly: 20, Urx: 30, Ury: 40},
+		{Llx: 0, Lly: 0, Urx: 5, Ury: 5}, // After Q, CTM and clipping restored.
+	}
+	if len(fills) != len(expectedFills) {
+		t.Fatalf("Expected %d paths, got %d", len(expectedFills), len(fills))
+	}
+	for i := range fills {
+		if !rectsEqual(fills[i], expectedFills[i]) {
+			t.Errorf("Path %d: %+v != %+v", i, fills[i], expectedFills[i])
+		}
+	}
+	if clips[0] != nil || clips[1] == nil || !rectsEqual(*clips[1], expectedFills[0]) || clips[2] != nil {
+		t.Errorf("Clipping mismatch: %v", clips)
+	}
+
+	// Second string starts after "ab": (500+600)/1000*12 = 13.2 in text space, scaled by the CTM.
+	expectedText := [][2]float64{{20, 32}, {20 + 2*13.2, 32}}
+	if len(textPositions) != 2 {
+		t.Fatalf("Expected 2 text operations, got %d", len(textPositions))
+	}
+	for i := range textPositions {
+		if math.Abs(textPositions[i][0]-expectedText[i][0]) > 1e-6 ||
+			math.Abs(textPositions[i][1]-expectedText[i][1]) > 1e-6 {
+			t.Errorf("Text %d position: %v != %v", i, textPositions[i], expectedText[i])
+		}
+	}
+
+	if len(images) != 1 || !rectsEqual(images[0], model.PdfRectangle{Llx: 10, Lly: 20, Urx: 70, Ury: 100}) {
+		t.Errorf("Image placement mismatch: %v", images)
+	}
+}
+
+func rectsEqual(a, b model.PdfRectangle) bool {
+	const tol = 1e-6
+	return math.Abs(a.Llx-b.Llx) < tol && math.Abs(a.Lly-b.Lly) < tol &&
+		math.Abs(a.Urx-b.Urx) < tol && math.Abs(a.Ury-b.Ury) < tol
+}
+
+func TestLoadCIDWidthsRanges(t *testing.T) {
+	w := core.PdfObjectArray{
+		core.MakeInteger(1), core.MakeArray(core.MakeInteger(300), core.MakeInteger(400)),
+		core.MakeInteger(10), core.MakeInteger(12), core.MakeInteger(500),
+		core.MakeInteger(20), core.MakeInteger(10), core.MakeInteger(600),
+		core.MakeInteger(0xFFFE), core.MakeInteger(2147483647), core.MakeInteger(700),
+	}
+	widths := map[int]float64{}
+	loadCIDWidths(widths, &w)
+
+	expected := map[int]float64{1: 300, 2: 400, 10: 500, 11: 500, 12: 500, 0xFFFE: 700, 0xFFFF: 700}
+	if len(widths) != len(expected) {
+		t.Fatalf("Unexpected widths: %v", widths)
+	}
+	for cid, val := range expected {
+		if widths[cid] != val {
+			t.Errorf("Width of %d: %v != %v", cid, widths[cid], val)
+		}
+	}
+}
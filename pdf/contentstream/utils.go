@@ -93,3 +93,27 @@ func newIndexedColorspaceFromPdfObject(obj core.PdfObject) (model.PdfColorspace,
 
 	return model.NewPdfColorspaceFromPdfObject(csArr)
 }
+
+// getNumberAsFloat returns the value of a numeric object (integer or float).
+func getNumberAsFloat(obj core.PdfObject) (float64, error) {
+	switch t := obj.(type) {
+	case *core.PdfObjectFloat:
+		return float64(*t), nil
+	case *core.PdfObjectInteger:
+		return float64(*t), nil
+	}
+	return 0, errors.New("Not a number")
+}
+
+// getNumbersAsFloat returns the values of a list of numeric objects, e.g. operation parameters.
+func getNumbersAsFloat(objs []core.PdfObject) ([]float64, error) {
+	vals := []float64{}
+	for _, obj := range objs {
+		val, err := getNumberAsFloat(obj)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
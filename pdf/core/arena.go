@@ -0,0 +1,81 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+// Number of objects of each type allocated at once by an objectArena. The chunks grow from the minimum to the
+// maximum size, so that small documents do not allocate large chunks.
+const (
+	arenaMinChunkSize = 16
+	arenaMaxChunkSize = 512
+)
+
+// arenaChunkSize returns the size of the chunk following a chunk of capacity `capacity`.
+func arenaChunkSize(capacity int) int {
+	if capacity < arenaMinChunkSize {
+		return arenaMinChunkSize
+	}
+	if capacity >= arenaMaxChunkSize/2 {
+		return arenaMaxChunkSize
+	}
+	return 2 * capacity
+}
+
+// objectArena allocates the small primitive objects created when parsing (numbers, names and references) in
+// chunks, reducing the number of allocations and the GC pressure when parsing large documents.
+// A chunk is kept alive as long as any of its objects is referenced. The zero value is ready to use.
+type objectArena struct {
+	ints   []PdfObjectInteger
+	floats []PdfObjectFloat
+	names  []PdfObjectName
+	refs   []PdfObjectReference
+}
+
+// newInteger returns a new integer object with value `val`.
+func (arena *objectArena) newInteger(val int64) *PdfObjectInteger {
+	if len(arena.ints) == cap(arena.ints) {
+		arena.ints = make([]PdfObjectInteger, 0, arenaChunkSize(cap(arena.ints)))
+	}
+	arena.ints = append(arena.ints, PdfObjectInteger(val))
+	return &arena.ints[len(arena.ints)-1]
+}
+
+// newFloat returns a new float object with value `val`.
+func (arena *objectArena) newFloat(val float64) *PdfObjectFloat {
+	if len(arena.floats) == cap(arena.floats) {
+		arena.floats = make([]PdfObjectFloat, 0, arenaChunkSize(cap(arena.floats)))
+	}
+	arena.floats = append(arena.floats, PdfObjectFloat(val))
+	return &arena.floats[len(arena.floats)-1]
+}
+
+// newName returns a new name object `name`.
+func (arena *objectArena) newName(name PdfObjectName) *PdfObjectName {
+	if len(arena.names) == cap(arena.names) {
+		arena.names = make([]PdfObjectName, 0, arenaChunkSize(cap(arena.names)))
+	}
+	arena.names = append(arena.names, name)
+	return &arena.names[len(arena.names)-1]
+}
+
+// newReference returns a new reference object `ref`.
+func (arena *objectArena) newReference(ref PdfObjectReference) *PdfObjectReference {
+	if len(arena.refs) == cap(arena.refs) {
+		arena.refs = make([]PdfObjectReference, 0, arenaChunkSize(cap(arena.refs)))
+	}
+	arena.refs = append(arena.refs, ref)
+	return &arena.refs[len(arena.refs)-1]
+}
+
+// Release drops the caches of the parser: the parsed objects, the decoded object streams, the interned names and
+// the partially used allocation chunks, so that the memory can be reclaimed once the objects obtained from the parser are no longer
+// referenced. Objects obtained before remain valid. The parser can still be used afterwards, but parses objects
+// anew, returning objects that are distinct from those obtained before.
+func (parser *PdfParser) Release() {
+	parser.ObjCache = ObjectCache{}
+	parser.objstms = ObjectStreams{}
+	parser.arena = objectArena{}
+	parser.interner = objectInterner{}
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import "testing"
+
+func TestObjectArena(t *testing.T) {
+	arena := objectArena{}
+	ints := []*PdfObjectInteger{}
+	for i := 0; i < 3*arenaMaxChunkSize; i++ {
+		ints = append(ints, arena.newInteger(int64(i)))
+	}
+	// Objects are distinct and keep their values across chunks.
+	*ints[0] = 42
+	for i, obj := range ints[1:] {
+		if int(*obj) != i+1 {
+			t.Fatalf("Integer %d has value %d", i+1, *obj)
+		}
+	}
+	if cap(arena.ints) != arenaMaxChunkSize {
+		t.Errorf("Chunk size %d, expected %d", cap(arena.ints), arenaMaxChunkSize)
+	}
+
+	name := arena.newName("Type")
+	ref := arena.newReference(PdfObjectReference{ObjectNumber: 5})
+	f := arena.newFloat(1.5)
+	if *name != "Type" || ref.ObjectNumber != 5 || *f != 1.5 {
+		t.Errorf("Invalid values %v %v %v", *name, *ref, *f)
+	}
+}
+
+func TestParserRelease(t *testing.T) {
+	parser := NewParserFromString("<< /Type /Test /Count 3 /Ref 12 0 R >>")
+	obj, err := parser.parseObject()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	parser.ObjCache = ObjectCache{1: obj}
+
+	parser.Release()
+	if len(parser.ObjCache) != 0 || len(parser.arena.names) != 0 {
+		t.Errorf("Caches not released")
+	}
+	// Objects obtained before remain valid.
+	if s := obj.DefaultWriteString(); s != "<</Type /Test/Count 3/Ref 12 0 R>>" {
+		t.Errorf("Object modified: %s", s)
+	}
+}
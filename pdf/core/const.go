@@ -5,14 +5,36 @@
 
 package core
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+// Error categories. The errors of the parser, the stream decoders and the encryption are wrapped in these
+// categories with details such as the operation and the object number, e.g.
+// "lookup object 12: Corrupt document: Invalid object stream offset table", so that callers can branch on the
+// category with errors.Is.
+var (
+	// ErrEncrypted indicates that an operation needs the document to be decrypted first.
+	ErrEncrypted = errors.New("File needs to be decrypted first")
+
+	// ErrCorrupt indicates that the document structure is invalid, e.g. a broken cross reference table or
+	// object stream.
+	ErrCorrupt = errors.New("Corrupt document")
+
+	// ErrUnsupportedFilter indicates a stream filter or crypt filter that is not supported.
+	ErrUnsupportedFilter = errors.New("Unsupported filter")
+
+	// ErrNotImplemented indicates a feature of the PDF format that is not implemented yet.
+	ErrNotImplemented = errors.New("Not implemented")
+)
 
 var (
 	// ErrUnsupportedEncodingParameters error indicates that encoding/decoding was attempted with unsupported
 	// encoding parameters.
 	// For example when trying to encode with an unsupported Predictor (flate).
 	ErrUnsupportedEncodingParameters = errors.New("Unsupported encoding parameters")
-	ErrNoCCITTFaxDecode              = errors.New("CCITTFaxDecode encoding is not yet implemented")
-	ErrNoJBIG2Decode                 = errors.New("JBIG2Decode encoding is not yet implemented")
-	ErrNoJPXDecode                   = errors.New("JPXDecode encoding is not yet implemented")
+	ErrNoCCITTFaxDecode              = fmt.Errorf("%w: CCITTFaxDecode encoding", ErrNotImplemented)
+	ErrNoJBIG2Decode                 = fmt.Errorf("%w: JBIG2Decode encoding", ErrNotImplemented)
+	ErrNoJPXDecode                   = fmt.Errorf("%w: JPXDecode encoding", ErrNotImplemented)
 )
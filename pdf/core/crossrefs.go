@@ -9,6 +9,7 @@ import (
 	"bufio"
 	"bytes"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -79,11 +80,11 @@ func (parser *PdfParser) lookupObjectViaOS(sobjNumber int, objNum int) (PdfObjec
 
 		so, ok := soi.(*PdfObjectStream)
 		if !ok {
-			return nil, errors.New("Invalid object stream")
+			return nil, fmt.Errorf("%w: Invalid object stream", ErrCorrupt)
 		}
 
 		if parser.crypter != nil && !parser.crypter.isDecrypted(so) {
-			return nil, errors.New("Need to decrypt the stream")
+			return nil, fmt.Errorf("%w: object stream %d", ErrEncrypted, sobjNumber)
 		}
 
 		sod := so.PdfObjectDictionary
@@ -91,20 +92,20 @@ func (parser *PdfParser) lookupObjectViaOS(sobjNumber int, objNum int) (PdfObjec
 		name, ok := sod.Get("Type").(*PdfObjectName)
 		if !ok {
 			common.Log.Debug("ERROR: Object stream should always have a Type")
-			return nil, errors.New("Object stream missing Type")
+			return nil, fmt.Errorf("%w: Object stream missing Type", ErrCorrupt)
 		}
 		if strings.ToLower(string(*name)) != "objstm" {
 			common.Log.Debug("ERROR: Object stream type shall always be ObjStm !")
-			return nil, errors.New("Object stream type != ObjStm")
+			return nil, fmt.Errorf("%w: Object stream type != ObjStm", ErrCorrupt)
 		}
 
 		N, ok := sod.Get("N").(*PdfObjectInteger)
 		if !ok {
-			return nil, errors.New("Invalid N in stream dictionary")
+			return nil, fmt.Errorf("%w: Invalid N in stream dictionary", ErrCorrupt)
 		}
 		firstOffset, ok := sod.Get("First").(*PdfObjectInteger)
 		if !ok {
-			return nil, errors.New("Invalid First in stream dictionary")
+			return nil, fmt.Errorf("%w: Invalid First in stream dictionary", ErrCorrupt)
 		}
 
 		common.Log.Trace("type: %s number of objects: %d", name, *N)
@@ -136,7 +137,7 @@ func (parser *PdfParser) lookupObjectViaOS(sobjNumber int, objNum int) (PdfObjec
 			}
 			onum, ok := obj.(*PdfObjectInteger)
 			if !ok {
-				return nil, errors.New("Invalid object stream offset table")
+				return nil, fmt.Errorf("%w: Invalid object stream offset table", ErrCorrupt)
 			}
 
 			parser.skipSpaces()
@@ -147,7 +148,7 @@ func (parser *PdfParser) lookupObjectViaOS(sobjNumber int, objNum int) (PdfObjec
 			}
 			offset, ok := obj.(*PdfObjectInteger)
 			if !ok {
-				return nil, errors.New("Invalid object stream offset table")
+				return nil, fmt.Errorf("%w: Invalid object stream offset table", ErrCorrupt)
 			}
 
 			common.Log.Trace("obj %d offset %d", *onum, *offset)
@@ -205,7 +206,7 @@ func (parser *PdfParser) LookupByNumber(objNumber int) (PdfObject, error) {
 func (parser *PdfParser) lookupByNumberWrapper(objNumber int, attemptRepairs bool) (PdfObject, bool, error) {
 	obj, inObjStream, err := parser.lookupByNumber(objNumber, attemptRepairs)
 	if err != nil {
-		return nil, inObjStream, err
+		return nil, inObjStream, fmt.Errorf("lookup object %d: %w", objNumber, err)
 	}
 
 	// If encrypted, decrypt it prior to returning.
@@ -213,7 +214,7 @@ func (parser *PdfParser) lookupByNumberWrapper(objNumber int, attemptRepairs boo
 	if !inObjStream && parser.crypter != nil && !parser.crypter.isDecrypted(obj) {
 		err := parser.crypter.Decrypt(obj, 0, 0)
 		if err != nil {
-			return nil, inObjStream, err
+			return nil, inObjStream, fmt.Errorf("decrypt object %d: %w", objNumber, err)
 		}
 	}
 
@@ -303,7 +304,7 @@ func (parser *PdfParser) lookupByNumber(objNumber int, attemptRepairs bool) (Pdf
 
 		if xref.osObjNumber == objNumber {
 			common.Log.Debug("ERROR Circular reference!?!")
-			return nil, true, errors.New("Xref circular reference")
+			return nil, true, fmt.Errorf("%w: Xref circular reference", ErrCorrupt)
 		}
 		_, exists := parser.xrefs[xref.osObjNumber]
 		if exists {
@@ -322,10 +323,10 @@ func (parser *PdfParser) lookupByNumber(objNumber int, attemptRepairs bool) (Pdf
 			return optr, true, nil
 		} else {
 			common.Log.Debug("?? Belongs to a non-cross referenced object ...!")
-			return nil, true, errors.New("OS belongs to a non cross referenced object")
+			return nil, true, fmt.Errorf("%w: OS belongs to a non cross referenced object", ErrCorrupt)
 		}
 	}
-	return nil, false, errors.New("Unknown xref type")
+	return nil, false, fmt.Errorf("%w: Unknown xref type", ErrCorrupt)
 }
 
 // LookupByReference looks up a PdfObject by a reference.
@@ -139,11 +139,11 @@ func (crypt *PdfCrypt) LoadCryptFilters(ed *PdfObjectDictionary) error {
 			} else if *cfm == "AESV2" {
 				cfMethod = "AESV2"
 			} else {
-				return fmt.Errorf("Unsupported crypt filter (%s)", *cfm)
+				return fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, *cfm)
 			}
 		}
 		if cfMethod != "V2" && cfMethod != "AESV2" {
-			return fmt.Errorf("Unsupported crypt filter (%s)", cfMethod)
+			return fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, cfMethod)
 		}
 		cf.Cfm = cfMethod
 
@@ -210,7 +210,7 @@ func PdfCryptMakeNew(parser *PdfParser, ed, trailer *PdfObjectDictionary) (PdfCr
 	}
 	if *filter != "Standard" {
 		common.Log.Debug("ERROR Unsupported filter (%s)", *filter)
-		return crypter, errors.New("Unsupported Filter")
+		return crypter, fmt.Errorf("%w: security handler %s", ErrNotImplemented, *filter)
 	}
 	crypter.Filter = string(*filter)
 
@@ -244,7 +244,7 @@ func PdfCryptMakeNew(parser *PdfParser, ed, trailer *PdfObjectDictionary) (PdfCr
 			}
 		} else {
 			common.Log.Debug("ERROR Unsupported encryption algo V = %d", *V)
-			return crypter, errors.New("Unsupported algorithm")
+			return crypter, fmt.Errorf("%w: encryption algorithm V = %d", ErrNotImplemented, *V)
 		}
 	} else {
 		crypter.V = 0
@@ -473,7 +473,7 @@ func (crypt *PdfCrypt) makeKey(filter string, objNum, genNum uint32, ekey []byte
 	cf, ok := crypt.CryptFilters[filter]
 	if !ok {
 		common.Log.Debug("ERROR Unsupported crypt filter (%s)", filter)
-		return nil, fmt.Errorf("Unsupported crypt filter (%s)", filter)
+		return nil, fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, filter)
 	}
 	isAES := false
 	if cf.Cfm == "AESV2" {
@@ -526,13 +526,50 @@ func (crypt *PdfCrypt) isDecrypted(obj PdfObject) bool {
 	return false
 }
 
+// getStreamFilter returns the name of the crypt filter used for stream `so`: "Default" (RC4) before V4,
+// otherwise the StmF filter unless overridden by a Crypt filter, which shall be the first entry of the Filter
+// array. Returns "Identity" for streams that are not encrypted.
+func (crypt *PdfCrypt) getStreamFilter(so *PdfObjectStream) string {
+	if crypt.V < 4 {
+		return "Default"
+	}
+
+	dict := so.PdfObjectDictionary
+	streamFilter := crypt.StreamFilter
+	common.Log.Trace("this.StreamFilter = %s", crypt.StreamFilter)
+
+	if filters, ok := dict.Get("Filter").(*PdfObjectArray); ok && len(*filters) > 0 {
+		// Crypt filter can only be the first entry.
+		if firstFilter, ok := (*filters)[0].(*PdfObjectName); ok {
+			if *firstFilter == "Crypt" {
+				// Crypt filter overriding the default.
+				// Default option is Identity.
+				streamFilter = "Identity"
+
+				// Check if valid crypt filter specified in the decode params.
+				if decodeParams, ok := dict.Get("DecodeParms").(*PdfObjectDictionary); ok {
+					if filterName, ok := decodeParams.Get("Name").(*PdfObjectName); ok {
+						if _, ok := crypt.CryptFilters[string(*filterName)]; ok {
+							common.Log.Trace("Using stream filter %s", *filterName)
+							streamFilter = string(*filterName)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	common.Log.Trace("with %s filter", streamFilter)
+	return streamFilter
+}
+
 // Decrypt a buffer with a selected crypt filter.
 func (crypt *PdfCrypt) decryptBytes(buf []byte, filter string, okey []byte) ([]byte, error) {
 	common.Log.Trace("Decrypt bytes")
 	cf, ok := crypt.CryptFilters[filter]
 	if !ok {
 		common.Log.Debug("ERROR Unsupported crypt filter (%s)", filter)
-		return nil, fmt.Errorf("Unsupported crypt filter (%s)", filter)
+		return nil, fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, filter)
 	}
 
 	cfMethod := cf.Cfm
@@ -606,7 +643,7 @@ func (crypt *PdfCrypt) decryptBytes(buf []byte, filter string, okey []byte) ([]b
 
 		return buf, nil
 	}
-	return nil, fmt.Errorf("Unsupported crypt filter method (%s)", cfMethod)
+	return nil, fmt.Errorf("%w: crypt filter method (%s)", ErrUnsupportedFilter, cfMethod)
 }
 
 // Decrypt an object with specified key. For numbered objects,
@@ -643,42 +680,12 @@ func (crypt *PdfCrypt) Decrypt(obj PdfObject, parentObjNum, parentGenNum int64)
 		genNum := (*so).GenerationNumber
 		common.Log.Trace("Decrypting stream %d %d !", objNum, genNum)
 
-		// TODO: Check for crypt filter (V4).
-		// The Crypt filter shall be the first filter in the Filter array entry.
-
 		dict := so.PdfObjectDictionary
 
-		streamFilter := "Default" // Default RC4.
-		if crypt.V >= 4 {
-			streamFilter = crypt.StreamFilter
-			common.Log.Trace("this.StreamFilter = %s", crypt.StreamFilter)
-
-			if filters, ok := dict.Get("Filter").(*PdfObjectArray); ok {
-				// Crypt filter can only be the first entry.
-				if firstFilter, ok := (*filters)[0].(*PdfObjectName); ok {
-					if *firstFilter == "Crypt" {
-						// Crypt filter overriding the default.
-						// Default option is Identity.
-						streamFilter = "Identity"
-
-						// Check if valid crypt filter specified in the decode params.
-						if decodeParams, ok := dict.Get("DecodeParms").(*PdfObjectDictionary); ok {
-							if filterName, ok := decodeParams.Get("Name").(*PdfObjectName); ok {
-								if _, ok := crypt.CryptFilters[string(*filterName)]; ok {
-									common.Log.Trace("Using stream filter %s", *filterName)
-									streamFilter = string(*filterName)
-								}
-							}
-						}
-					}
-				}
-			}
-
-			common.Log.Trace("with %s filter", streamFilter)
-			if streamFilter == "Identity" {
-				// Identity: pass unchanged.
-				return nil
-			}
+		streamFilter := crypt.getStreamFilter(so)
+		if streamFilter == "Identity" {
+			// Identity: pass unchanged.
+			return nil
 		}
 
 		err := crypt.Decrypt(so.PdfObjectDictionary, objNum, genNum)
@@ -793,7 +800,7 @@ func (crypt *PdfCrypt) encryptBytes(buf []byte, filter string, okey []byte) ([]b
 	cf, ok := crypt.CryptFilters[filter]
 	if !ok {
 		common.Log.Debug("ERROR Unsupported crypt filter (%s)", filter)
-		return nil, fmt.Errorf("Unsupported crypt filter (%s)", filter)
+		return nil, fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, filter)
 	}
 
 	cfMethod := cf.Cfm
@@ -854,7 +861,7 @@ func (crypt *PdfCrypt) encryptBytes(buf []byte, filter string, okey []byte) ([]b
 
 		return buf, nil
 	}
-	return nil, fmt.Errorf("Unsupported crypt filter method (%s)", cfMethod)
+	return nil, fmt.Errorf("%w: crypt filter method (%s)", ErrUnsupportedFilter, cfMethod)
 }
 
 // Encrypt an object with specified key. For numbered objects,
@@ -890,44 +897,12 @@ func (crypt *PdfCrypt) Encrypt(obj PdfObject, parentObjNum, parentGenNum int64)
 		genNum := (*so).GenerationNumber
 		common.Log.Trace("Encrypting stream %d %d !", objNum, genNum)
 
-		// TODO: Check for crypt filter (V4).
-		// The Crypt filter shall be the first filter in the Filter array entry.
-
 		dict := so.PdfObjectDictionary
 
-		streamFilter := "Default" // Default RC4.
-		if crypt.V >= 4 {
-			// For now.  Need to change when we add support for more than
-			// Identity / RC4.
-			streamFilter = crypt.StreamFilter
-			common.Log.Trace("this.StreamFilter = %s", crypt.StreamFilter)
-
-			if filters, ok := dict.Get("Filter").(*PdfObjectArray); ok {
-				// Crypt filter can only be the first entry.
-				if firstFilter, ok := (*filters)[0].(*PdfObjectName); ok {
-					if *firstFilter == "Crypt" {
-						// Crypt filter overriding the default.
-						// Default option is Identity.
-						streamFilter = "Identity"
-
-						// Check if valid crypt filter specified in the decode params.
-						if decodeParams, ok := dict.Get("DecodeParms").(*PdfObjectDictionary); ok {
-							if filterName, ok := decodeParams.Get("Name").(*PdfObjectName); ok {
-								if _, ok := crypt.CryptFilters[string(*filterName)]; ok {
-									common.Log.Trace("Using stream filter %s", *filterName)
-									streamFilter = string(*filterName)
-								}
-							}
-						}
-					}
-				}
-			}
-
-			common.Log.Trace("with %s filter", streamFilter)
-			if streamFilter == "Identity" {
-				// Identity: pass unchanged.
-				return nil
-			}
+		streamFilter := crypt.getStreamFilter(so)
+		if streamFilter == "Identity" {
+			// Identity: pass unchanged.
+			return nil
 		}
 
 		err := crypt.Encrypt(so.PdfObjectDictionary, objNum, genNum)
@@ -0,0 +1,267 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rc4"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Size of the chunks read from the underlying reader when decrypting AES streams.
+const cryptStreamChunkSize = 32 * 1024
+
+// EncryptedStreamLength returns the length of the encrypted data of stream `so` with `length` bytes of
+// unencrypted data, for writing the Length entry before encrypting the data with EncryptStreamWriter.
+func (crypt *PdfCrypt) EncryptedStreamLength(so *PdfObjectStream, length int64) (int64, error) {
+	streamFilter := crypt.getStreamFilter(so)
+	if streamFilter == "Identity" {
+		return length, nil
+	}
+	cf, ok := crypt.CryptFilters[streamFilter]
+	if !ok {
+		common.Log.Debug("ERROR Unsupported crypt filter (%s)", streamFilter)
+		return 0, fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, streamFilter)
+	}
+	switch cf.Cfm {
+	case "V2":
+		return length, nil
+	case "AESV2":
+		// Initialization vector and padding to the next full block.
+		return aes.BlockSize + (length/aes.BlockSize+1)*aes.BlockSize, nil
+	}
+	return 0, fmt.Errorf("%w: crypt filter method (%s)", ErrUnsupportedFilter, cf.Cfm)
+}
+
+// EncryptStreamWriter returns a writer that encrypts the data of stream `so` written to it and writes the
+// encrypted data to `w`, using the crypt filter and object key that Encrypt would use for the stream. This
+// allows encrypting very large streams (e.g. embedded files) piece by piece rather than in memory.
+// Close must be called after the last write to write the final (padded) block. It does not close `w`.
+func (crypt *PdfCrypt) EncryptStreamWriter(so *PdfObjectStream, w io.Writer) (io.WriteCloser, error) {
+	streamFilter := crypt.getStreamFilter(so)
+	if streamFilter == "Identity" {
+		return &nopWriteCloser{w}, nil
+	}
+	cfMethod, okey, err := crypt.streamKey(so, streamFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfMethod {
+	case "V2":
+		ciph, err := rc4.NewCipher(okey)
+		if err != nil {
+			return nil, err
+		}
+		return &nopWriteCloser{cipher.StreamWriter{S: ciph, W: w}}, nil
+	case "AESV2":
+		ciph, err := aes.NewCipher(okey)
+		if err != nil {
+			return nil, err
+		}
+		// The initialization vector is a random number stored as the first 16 bytes of the encrypted stream.
+		iv := make([]byte, aes.BlockSize)
+		if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(iv); err != nil {
+			return nil, err
+		}
+		return &aesStreamWriter{mode: cipher.NewCBCEncrypter(ciph, iv), w: w}, nil
+	}
+	return nil, fmt.Errorf("%w: crypt filter method (%s)", ErrUnsupportedFilter, cfMethod)
+}
+
+// DecryptStreamReader returns a reader of the decrypted data of stream `so` with the encrypted data read from
+// `r`, using the crypt filter and object key that Decrypt would use for the stream. This allows decrypting very
+// large streams piece by piece rather than in memory.
+func (crypt *PdfCrypt) DecryptStreamReader(so *PdfObjectStream, r io.Reader) (io.Reader, error) {
+	streamFilter := crypt.getStreamFilter(so)
+	if streamFilter == "Identity" {
+		return r, nil
+	}
+	cfMethod, okey, err := crypt.streamKey(so, streamFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfMethod {
+	case "V2":
+		ciph, err := rc4.NewCipher(okey)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.StreamReader{S: ciph, R: r}, nil
+	case "AESV2":
+		ciph, err := aes.NewCipher(okey)
+		if err != nil {
+			return nil, err
+		}
+		return &aesStreamReader{block: ciph, r: r}, nil
+	}
+	return nil, fmt.Errorf("%w: crypt filter method (%s)", ErrUnsupportedFilter, cfMethod)
+}
+
+// streamKey returns the crypt filter method and the object key of stream `so` for crypt filter `streamFilter`.
+func (crypt *PdfCrypt) streamKey(so *PdfObjectStream, streamFilter string) (string, []byte, error) {
+	cf, ok := crypt.CryptFilters[streamFilter]
+	if !ok {
+		common.Log.Debug("ERROR Unsupported crypt filter (%s)", streamFilter)
+		return "", nil, fmt.Errorf("%w: crypt filter (%s)", ErrUnsupportedFilter, streamFilter)
+	}
+	okey, err := crypt.makeKey(streamFilter, uint32(so.ObjectNumber), uint32(so.GenerationNumber), crypt.EncryptionKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return cf.Cfm, okey, nil
+}
+
+// nopWriteCloser adds a Close method that does nothing to a writer.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (w *nopWriteCloser) Close() error {
+	return nil
+}
+
+// aesStreamWriter encrypts data with AES in CBC mode, adding the PKCS #5 padding when closed.
+type aesStreamWriter struct {
+	mode    cipher.BlockMode
+	w       io.Writer
+	pending []byte // Data of the incomplete block.
+	closed  bool
+}
+
+func (w *aesStreamWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("write to closed stream")
+	}
+	w.pending = append(w.pending, p...)
+	full := len(w.pending) - len(w.pending)%aes.BlockSize
+	if full == 0 {
+		return len(p), nil
+	}
+	out := make([]byte, full)
+	w.mode.CryptBlocks(out, w.pending[:full])
+	w.pending = append(w.pending[:0], w.pending[full:]...)
+	if _, err := w.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close pads and writes the final block. For a message length M, the pad consists of 16 - (M mod 16) bytes with
+// that value (a full block of 0x10 when M is a multiple of 16).
+func (w *aesStreamWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	pad := aes.BlockSize - len(w.pending)
+	for i := 0; i < pad; i++ {
+		w.pending = append(w.pending, byte(pad))
+	}
+	out := make([]byte, aes.BlockSize)
+	w.mode.CryptBlocks(out, w.pending)
+	_, err := w.w.Write(out)
+	return err
+}
+
+// aesStreamReader decrypts data encrypted with AES in CBC mode, with the initialization vector in the first
+// 16 bytes. The last decrypted block is held back until the end of the data so that the padding can be removed.
+type aesStreamReader struct {
+	block   cipher.Block
+	mode    cipher.BlockMode
+	r       io.Reader
+	in      []byte // Encrypted data not decrypted yet.
+	out     []byte // Decrypted data ready to be read.
+	held    []byte // Last decrypted block.
+	eof     bool
+	readErr error
+}
+
+func (d *aesStreamReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.readErr != nil {
+			return 0, d.readErr
+		}
+		if d.eof {
+			d.readErr = d.finish()
+			continue
+		}
+		if err := d.fill(); err != nil {
+			d.readErr = err
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// fill reads the next chunk of encrypted data and decrypts its full blocks.
+func (d *aesStreamReader) fill() error {
+	chunk := make([]byte, cryptStreamChunkSize)
+	n, err := d.r.Read(chunk)
+	d.in = append(d.in, chunk[:n]...)
+	if err == io.EOF {
+		d.eof = true
+	} else if err != nil {
+		return err
+	}
+
+	if d.mode == nil {
+		if len(d.in) < aes.BlockSize {
+			if d.eof {
+				common.Log.Debug("ERROR AES invalid buf (%d bytes)", len(d.in))
+				return fmt.Errorf("AES: Buf len < 16 (%d)", len(d.in))
+			}
+			return nil
+		}
+		d.mode = cipher.NewCBCDecrypter(d.block, d.in[:aes.BlockSize])
+		d.in = d.in[aes.BlockSize:]
+	}
+
+	full := len(d.in) - len(d.in)%aes.BlockSize
+	if full == 0 {
+		return nil
+	}
+	plain := make([]byte, full)
+	d.mode.CryptBlocks(plain, d.in[:full])
+	d.in = append(d.in[:0:0], d.in[full:]...)
+
+	plain = append(d.held, plain...)
+	d.held = plain[len(plain)-aes.BlockSize:]
+	d.out = plain[:len(plain)-aes.BlockSize]
+	return nil
+}
+
+// finish removes the padding from the last block at the end of the data. Returns io.EOF when done.
+func (d *aesStreamReader) finish() error {
+	if len(d.in) != 0 {
+		return fmt.Errorf("AES buf length not multiple of 16 (%d)", len(d.in))
+	}
+	if d.held == nil {
+		return io.EOF
+	}
+	padLen := int(d.held[len(d.held)-1])
+	if padLen > aes.BlockSize {
+		common.Log.Debug("Illegal pad length")
+		return fmt.Errorf("Invalid pad length")
+	}
+	d.out = d.held[:aes.BlockSize-padLen]
+	d.held = nil
+	if len(d.out) == 0 {
+		return io.EOF
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+)
+
+// makeTestCrypt returns a crypt with a fixed encryption key, using crypt filter method `cfm` for streams.
+func makeTestCrypt(cfm string) *PdfCrypt {
+	crypt := &PdfCrypt{}
+	crypt.DecryptedObjects = map[PdfObject]bool{}
+	crypt.EncryptedObjects = map[PdfObject]bool{}
+	crypt.EncryptionKey = []byte("0123456789abcdef")
+	crypt.CryptFilters = CryptFilters{}
+	if cfm == "V2" {
+		crypt.V = 2
+		crypt.CryptFilters["Default"] = CryptFilter{Cfm: "V2", Length: 128}
+	} else {
+		crypt.V = 4
+		crypt.CryptFilters["StdCF"] = CryptFilter{Cfm: cfm, Length: 128}
+		crypt.StreamFilter = "StdCF"
+		crypt.StringFilter = "StdCF"
+	}
+	return crypt
+}
+
+func TestCryptStreamRoundTrip(t *testing.T) {
+	for _, cfm := range []string{"V2", "AESV2"} {
+		crypt := makeTestCrypt(cfm)
+		for _, size := range []int{0, 1, 15, 16, 17, 100, 100000} {
+			data := make([]byte, size)
+			for i := range data {
+				data[i] = byte(i * 7)
+			}
+			so := &PdfObjectStream{PdfObjectDictionary: MakeDict()}
+			so.ObjectNumber = 12
+
+			var encrypted bytes.Buffer
+			w, err := crypt.EncryptStreamWriter(so, &encrypted)
+			if err != nil {
+				t.Fatalf("%s: %v", cfm, err)
+			}
+			// Write in uneven pieces.
+			for off := 0; off < len(data); off += 1000 {
+				end := off + 1000
+				if end > len(data) {
+					end = len(data)
+				}
+				if _, err := w.Write(data[off:end]); err != nil {
+					t.Fatalf("%s: %v", cfm, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("%s: %v", cfm, err)
+			}
+
+			length, err := crypt.EncryptedStreamLength(so, int64(size))
+			if err != nil || length != int64(encrypted.Len()) {
+				t.Errorf("%s %d: length %d != %d (%v)", cfm, size, length, encrypted.Len(), err)
+			}
+
+			// Streaming decryption, reading one byte at a time from the source.
+			r, err := crypt.DecryptStreamReader(so, iotest.OneByteReader(bytes.NewReader(encrypted.Bytes())))
+			if err != nil {
+				t.Fatalf("%s: %v", cfm, err)
+			}
+			decrypted, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("%s %d: %v", cfm, size, err)
+			}
+			if !bytes.Equal(decrypted, data) {
+				t.Errorf("%s %d: streaming round trip mismatch (%d bytes)", cfm, size, len(decrypted))
+			}
+
+			// Decryption in memory of the streamed encryption. Empty AES data is rejected by decryptBytes.
+			if cfm == "AESV2" && size == 0 {
+				continue
+			}
+			so.Stream = append([]byte{}, encrypted.Bytes()...)
+			if err := crypt.Decrypt(so, 0, 0); err != nil {
+				t.Fatalf("%s: %v", cfm, err)
+			}
+			if !bytes.Equal(so.Stream, data) {
+				t.Errorf("%s %d: in memory decryption mismatch", cfm, size)
+			}
+		}
+	}
+}
+
+func TestCryptStreamDecryptInMemoryEncrypted(t *testing.T) {
+	crypt := makeTestCrypt("AESV2")
+	data := bytes.Repeat([]byte("embedded file contents "), 1000)
+	so := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: append([]byte{}, data...)}
+	so.ObjectNumber = 5
+	if err := crypt.Encrypt(so, 0, 0); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	r, err := crypt.DecryptStreamReader(so, bytes.NewReader(so.Stream))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	decrypted, err := ioutil.ReadAll(r)
+	if err != nil || !bytes.Equal(decrypted, data) {
+		t.Errorf("Decryption mismatch (%v)", err)
+	}
+
+	// Truncated data.
+	r, _ = crypt.DecryptStreamReader(so, bytes.NewReader(so.Stream[:len(so.Stream)-3]))
+	if _, err := ioutil.ReadAll(r); err == nil || err == io.EOF {
+		t.Errorf("Expected error for truncated data")
+	}
+}
+
+func TestCryptStreamUnsupportedFilter(t *testing.T) {
+	so := &PdfObjectStream{PdfObjectDictionary: MakeDict()}
+	crypt := makeTestCrypt("AESV3")
+	if _, err := crypt.EncryptStreamWriter(so, ioutil.Discard); !errors.Is(err, ErrUnsupportedFilter) {
+		t.Errorf("Expected unsupported filter error, got %v", err)
+	}
+	if _, err := crypt.DecryptStreamReader(so, bytes.NewReader(nil)); !errors.Is(err, ErrUnsupportedFilter) {
+		t.Errorf("Expected unsupported filter error, got %v", err)
+	}
+	if _, err := crypt.EncryptedStreamLength(so, 10); !errors.Is(err, ErrUnsupportedFilter) {
+		t.Errorf("Expected unsupported filter error, got %v", err)
+	}
+
+	crypt.StreamFilter = "Missing"
+	if _, err := crypt.EncryptStreamWriter(so, ioutil.Discard); !errors.Is(err, ErrUnsupportedFilter) {
+		t.Errorf("Expected unsupported filter error, got %v", err)
+	}
+}
@@ -70,6 +70,10 @@ type FlateEncoder struct {
 	// For predictors
 	Columns int
 	Colors  int
+	// Compression level for encoding as in compress/zlib, from zlib.BestSpeed to zlib.BestCompression or
+	// zlib.DefaultCompression. The zero value is zlib.DefaultCompression, not zlib.NoCompression, as it is the
+	// level of encoders not created by NewFlateEncoder (use a raw encoder for uncompressed streams).
+	CompressionLevel int
 }
 
 // Make a new flate encoder with default parameters, predictor 1 and bits per component 8.
@@ -84,6 +88,7 @@ func NewFlateEncoder() *FlateEncoder {
 
 	encoder.Colors = 1
 	encoder.Columns = 1
+	encoder.CompressionLevel = zlib.DefaultCompression
 
 	return encoder
 }
@@ -437,8 +442,16 @@ func (this *FlateEncoder) EncodeBytes(data []byte) ([]byte, error) {
 		data = pOutBuffer.Bytes()
 	}
 
+	level := this.CompressionLevel
+	if level == 0 {
+		level = zlib.DefaultCompression
+	}
 	var b bytes.Buffer
-	w := zlib.NewWriter(&b)
+	w, err := zlib.NewWriterLevel(&b, level)
+	if err != nil {
+		common.Log.Debug("Encoding error: Invalid FlateEncoder CompressionLevel %d", this.CompressionLevel)
+		return nil, ErrUnsupportedEncodingParameters
+	}
 	w.Write(data)
 	w.Close()
 
@@ -1752,7 +1765,7 @@ func newMultiEncoderFromStream(streamObj *PdfObjectStream) (*MultiEncoder, error
 			common.Log.Trace("Multi encoder: %#v", mencoder)
 		} else {
 			common.Log.Error("Unsupported filter %s", *name)
-			return nil, fmt.Errorf("Invalid filter in multi filter array")
+			return nil, fmt.Errorf("%w: Invalid filter in multi filter array", ErrUnsupportedFilter)
 		}
 	}
 
@@ -1798,7 +1811,11 @@ func (this *MultiEncoder) AddEncoder(encoder StreamEncoder) {
 
 func (this *MultiEncoder) MakeStreamDict() *PdfObjectDictionary {
 	dict := MakeDict()
-	dict.Set("Filter", MakeName(this.GetFilterName()))
+	filters := MakeArray()
+	for _, encoder := range this.encoders {
+		filters.Append(MakeName(encoder.GetFilterName()))
+	}
+	dict.Set("Filter", filters)
 
 	// Pass all values from children, except Filter and DecodeParms.
 	for _, encoder := range this.encoders {
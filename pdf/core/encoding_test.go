@@ -6,6 +6,8 @@
 package core
 
 import (
+	"bytes"
+	"compress/zlib"
 	"encoding/base64"
 	"testing"
 
@@ -244,4 +246,46 @@ func TestMultiEncoder(t *testing.T) {
 		t.Errorf("Raw     (%d): % x", len(rawStream), rawStream)
 		return
 	}
+
+	filter := encoder.MakeStreamDict().Get("Filter")
+	if filter == nil || filter.DefaultWriteString() != "[/FlateDecode /ASCIIHexDecode]" {
+		t.Errorf("Invalid Filter entry: %v", filter)
+	}
+}
+
+// Test flate encoding at different compression levels.
+func TestFlateCompressionLevel(t *testing.T) {
+	rawStream := bytes.Repeat([]byte("compressible text with repetitions "), 100)
+
+	for _, level := range []int{zlib.BestSpeed, zlib.DefaultCompression, zlib.BestCompression} {
+		encoder := NewFlateEncoder()
+		encoder.CompressionLevel = level
+		encoded, err := encoder.EncodeBytes(rawStream)
+		if err != nil {
+			t.Fatalf("Level %d: failed to encode data: %v", level, err)
+		}
+		if len(encoded) >= len(rawStream) {
+			t.Errorf("Level %d: data not compressed (%d bytes)", level, len(encoded))
+		}
+		decoded, err := encoder.DecodeBytes(encoded)
+		if err != nil || !compareSlices(decoded, rawStream) {
+			t.Errorf("Level %d: round trip failed (%v)", level, err)
+		}
+	}
+
+	// The zero value is the default level, not no compression.
+	zero := &FlateEncoder{Predictor: 1}
+	encoded, err := zero.EncodeBytes(rawStream)
+	if err != nil {
+		t.Fatalf("Failed to encode data: %v", err)
+	}
+	if len(encoded) >= len(rawStream)/10 {
+		t.Errorf("Data not compressed at level 0 (%d bytes)", len(encoded))
+	}
+
+	encoder := NewFlateEncoder()
+	encoder.CompressionLevel = 42
+	if _, err := encoder.EncodeBytes(rawStream); err != ErrUnsupportedEncodingParameters {
+		t.Errorf("Expected error for invalid level, got %v", err)
+	}
 }
@@ -0,0 +1,38 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestErrorCategories(t *testing.T) {
+	stream := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: []byte("data")}
+	stream.ObjectNumber = 7
+	stream.Set("Filter", MakeName("UnknownDecode"))
+	_, err := DecodeStream(stream)
+	if !errors.Is(err, ErrUnsupportedFilter) || !strings.Contains(err.Error(), "decode stream 7") {
+		t.Errorf("Unexpected error for unsupported filter: %v", err)
+	}
+
+	stream.Set("Filter", MakeName(StreamEncodingFilterNameJPX))
+	_, err = DecodeStream(stream)
+	if !errors.Is(err, ErrNotImplemented) || !errors.Is(err, ErrNoJPXDecode) {
+		t.Errorf("Unexpected error for JPX: %v", err)
+	}
+
+	// Object 2 is in object stream 1, which is not a stream.
+	parser := NewParserFromString("")
+	parser.ObjCache = ObjectCache{}
+	parser.ObjCache[1] = MakeIndirectObject(MakeDict())
+	parser.xrefs = XrefTable{2: XrefObject{xtype: XREF_OBJECT_STREAM, objectNumber: 2, osObjNumber: 1}}
+	_, err = parser.LookupByNumber(2)
+	if !errors.Is(err, ErrCorrupt) || !strings.Contains(err.Error(), "lookup object 2") {
+		t.Errorf("Unexpected error for invalid object stream: %v", err)
+	}
+}
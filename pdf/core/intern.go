@@ -0,0 +1,69 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+const (
+	// Maximum number of distinct names interned by a parser, so that documents with huge numbers of distinct
+	// names do not grow the table without bound. Further names are allocated individually.
+	maxInternedNames = 8192
+
+	// Integers in the range [0, numSharedIntegers) are shared.
+	numSharedIntegers = 256
+)
+
+// objectInterner reduces the memory footprint of parsed documents by sharing equal values: name strings are
+// interned, and small integers and booleans are represented by a single object per value within a document.
+// As shared integer and boolean objects can be referenced from many places, they must not be modified in place;
+// dictionary and array entries are to be replaced instead (e.g. with PdfObjectDictionary.Set).
+// The zero value is ready to use.
+type objectInterner struct {
+	names    map[string]PdfObjectName
+	ints     *[numSharedIntegers]PdfObjectInteger
+	trueObj  *PdfObjectBool
+	falseObj *PdfObjectBool
+}
+
+// name returns name `b`, sharing the string data with previous occurrences of the name.
+func (in *objectInterner) name(b []byte) PdfObjectName {
+	if name, has := in.names[string(b)]; has {
+		return name
+	}
+	name := PdfObjectName(b)
+	if in.names == nil {
+		in.names = map[string]PdfObjectName{}
+	}
+	if len(in.names) < maxInternedNames {
+		in.names[string(name)] = name
+	}
+	return name
+}
+
+// integer returns an integer object with value `val`, shared for small values and otherwise allocated from
+// `arena`.
+func (in *objectInterner) integer(val int64, arena *objectArena) *PdfObjectInteger {
+	if val < 0 || val >= numSharedIntegers {
+		return arena.newInteger(val)
+	}
+	if in.ints == nil {
+		in.ints = &[numSharedIntegers]PdfObjectInteger{}
+		for i := range in.ints {
+			in.ints[i] = PdfObjectInteger(i)
+		}
+	}
+	return &in.ints[val]
+}
+
+// boolean returns the shared boolean object with value `val`.
+func (in *objectInterner) boolean(val bool) *PdfObjectBool {
+	if in.trueObj == nil {
+		trueObj, falseObj := PdfObjectBool(true), PdfObjectBool(false)
+		in.trueObj, in.falseObj = &trueObj, &falseObj
+	}
+	if val {
+		return in.trueObj
+	}
+	return in.falseObj
+}
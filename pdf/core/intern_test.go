@@ -0,0 +1,46 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import "testing"
+
+func TestParserInterning(t *testing.T) {
+	parser := NewParserFromString("[/Type /Type 5 5 300 300 true true false]")
+	obj, err := parser.parseObject()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	arr := *(obj.(*PdfObjectArray))
+	if len(arr) != 9 {
+		t.Fatalf("Invalid array: %v", arr)
+	}
+
+	if arr[2] != arr[3] || *(arr[2].(*PdfObjectInteger)) != 5 {
+		t.Errorf("Small integers not shared")
+	}
+	if arr[4] == arr[5] || *(arr[4].(*PdfObjectInteger)) != 300 {
+		t.Errorf("Large integers shared")
+	}
+	if arr[6] != arr[7] || arr[6] == arr[8] || !bool(*(arr[6].(*PdfObjectBool))) {
+		t.Errorf("Invalid booleans")
+	}
+	if len(parser.interner.names) != 1 || *(arr[0].(*PdfObjectName)) != "Type" {
+		t.Errorf("Names not interned: %v", parser.interner.names)
+	}
+}
+
+func TestInternedNamesLimit(t *testing.T) {
+	in := objectInterner{}
+	for i := 0; i < maxInternedNames+10; i++ {
+		in.name([]byte{byte(i >> 16), byte(i >> 8), byte(i)})
+	}
+	if len(in.names) != maxInternedNames {
+		t.Errorf("Interned %d names, expected %d", len(in.names), maxInternedNames)
+	}
+	if name := in.name([]byte("new")); name != "new" {
+		t.Errorf("Invalid name %q", name)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// DecodeStreams decodes `streams` concurrently with up to `workers` goroutines (runtime.NumCPU() if `workers` is
+// 0 or less) and returns the decoded data in the order of the streams. This speeds up documents with many large
+// Flate or DCT encoded streams on multi-core machines.
+// Decoding only reads the stream objects, so the streams (including referenced DecodeParms) must be fully loaded
+// beforehand and not be modified concurrently. Returns the error of the first stream that fails to decode.
+func DecodeStreams(streams []*PdfObjectStream, workers int) ([][]byte, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(streams) {
+		workers = len(streams)
+	}
+
+	decoded := make([][]byte, len(streams))
+	errs := make([]error, len(streams))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				decoded[i], errs[i] = DecodeStream(streams[i])
+			}
+		}()
+	}
+	for i := range streams {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			common.Log.Debug("ERROR: Decoding stream %d failed: %v", i, err)
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,45 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDecodeStreams(t *testing.T) {
+	streams := []*PdfObjectStream{}
+	expected := []string{}
+	for i := 0; i < 20; i++ {
+		data := fmt.Sprintf("stream %d data", i)
+		encoder := NewFlateEncoder()
+		encoded, err := encoder.EncodeBytes([]byte(data))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		stream := &PdfObjectStream{PdfObjectDictionary: encoder.MakeStreamDict(), Stream: encoded}
+		streams = append(streams, stream)
+		expected = append(expected, data)
+	}
+
+	for _, workers := range []int{0, 1, 4, 100} {
+		decoded, err := DecodeStreams(streams, workers)
+		if err != nil {
+			t.Fatalf("Workers %d: %v", workers, err)
+		}
+		for i := range expected {
+			if string(decoded[i]) != expected[i] {
+				t.Errorf("Workers %d: stream %d mismatch (%q)", workers, i, decoded[i])
+			}
+		}
+	}
+
+	invalid := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: []byte("x")}
+	invalid.Set("Filter", MakeName("UnknownDecode"))
+	if _, err := DecodeStreams(append(streams, invalid), 4); err == nil {
+		t.Errorf("Expected error for invalid stream")
+	}
+}
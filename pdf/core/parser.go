@@ -47,11 +47,20 @@ type PdfParser struct {
 	crypter          *PdfCrypt
 	repairsAttempted bool // Avoid multiple attempts for repair.
 
+	// Offsets of the xref sections of each revision, starting with the latest one.
+	xrefOffsets []int64
+
 	// Tracker for reference lookups when looking up Length entry of stream objects.
 	// The Length entries of stream objects are a special case, as they can require recursive parsing, i.e. look up
 	// the length reference (if not object) prior to reading the actual stream.  This has risks of endless looping.
 	// Tracking is necessary to avoid recursive loops.
 	streamLengthReferenceLookupInProgress map[int64]bool
+
+	// Chunked allocation of the primitive objects parsed.
+	arena objectArena
+
+	// Sharing of names, small integers and booleans.
+	interner objectInterner
 }
 
 // GetCrypter returns the PdfCrypt instance which has information about the PDFs encryption.
@@ -217,7 +226,7 @@ func (parser *PdfParser) parseName() (PdfObjectName, error) {
 			}
 		}
 	}
-	return PdfObjectName(r.String()), nil
+	return parser.interner.name(r.Bytes()), nil
 }
 
 // Numeric objects.
@@ -287,12 +296,10 @@ func (parser *PdfParser) parseNumber() (PdfObject, error) {
 			fVal = 0.0
 			err = nil
 		}
-		o := PdfObjectFloat(fVal)
-		return &o, err
+		return parser.arena.newFloat(fVal), err
 	} else {
 		intVal, err := strconv.ParseInt(r.String(), 10, 64)
-		o := PdfObjectInteger(intVal)
-		return &o, err
+		return parser.interner.integer(intVal, &parser.arena), err
 	}
 }
 
@@ -502,7 +509,7 @@ func (parser *PdfParser) parseObject() (PdfObject, error) {
 		if bb[0] == '/' {
 			name, err := parser.parseName()
 			common.Log.Trace("->Name: '%s'", name)
-			return &name, err
+			return parser.arena.newName(name), err
 		} else if bb[0] == '(' {
 			common.Log.Trace("->String!")
 			str, err := parser.parseString()
@@ -535,10 +542,10 @@ func (parser *PdfParser) parseObject() (PdfObject, error) {
 				return &null, err
 			} else if (len(peekStr) > 4) && (peekStr[:5] == "false") {
 				b, err := parser.parseBool()
-				return &b, err
+				return parser.interner.boolean(bool(b)), err
 			} else if (len(peekStr) > 3) && (peekStr[:4] == "true") {
 				b, err := parser.parseBool()
-				return &b, err
+				return parser.interner.boolean(bool(b)), err
 			}
 
 			// Match reference.
@@ -547,7 +554,7 @@ func (parser *PdfParser) parseObject() (PdfObject, error) {
 				bb, _ = parser.reader.ReadBytes('R')
 				common.Log.Trace("-> !Ref: '%s'", string(bb[:]))
 				ref, err := parseReference(string(bb))
-				return &ref, err
+				return parser.arena.newReference(ref), err
 			}
 
 			result2 := reNumeric.FindStringSubmatch(string(peekStr))
@@ -720,7 +727,7 @@ func (parser *PdfParser) parseXrefTable() (*PdfObjectDictionary, error) {
 		if len(result2) == 4 {
 			if insideSubsection == false {
 				common.Log.Debug("ERROR Xref invalid format!\n")
-				return nil, errors.New("Xref invalid format")
+				return nil, fmt.Errorf("%w: Xref invalid format", ErrCorrupt)
 			}
 
 			first, _ := strconv.ParseInt(result2[1], 10, 64)
@@ -778,7 +785,7 @@ func (parser *PdfParser) parseXrefTable() (*PdfObjectDictionary, error) {
 
 		if txt == "%%EOF" {
 			common.Log.Debug("ERROR: end of file - trailer not found - error!")
-			return nil, errors.New("End of file - trailer not found")
+			return nil, fmt.Errorf("%w: End of file - trailer not found", ErrCorrupt)
 		}
 
 		common.Log.Trace("xref more : %s", txt)
@@ -800,14 +807,14 @@ func (parser *PdfParser) parseXrefStream(xstm *PdfObjectInteger) (*PdfObjectDict
 	xrefObj, err := parser.ParseIndirectObject()
 	if err != nil {
 		common.Log.Debug("ERROR: Failed to read xref object")
-		return nil, errors.New("Failed to read xref object")
+		return nil, fmt.Errorf("%w: Failed to read xref object", ErrCorrupt)
 	}
 
 	common.Log.Trace("XRefStm object: %s", xrefObj)
 	xs, ok := xrefObj.(*PdfObjectStream)
 	if !ok {
 		common.Log.Debug("ERROR: XRefStm pointing to non-stream object!")
-		return nil, errors.New("XRefStm pointing to a non-stream object")
+		return nil, fmt.Errorf("%w: XRefStm pointing to a non-stream object", ErrCorrupt)
 	}
 
 	trailerDict := xs.PdfObjectDictionary
@@ -815,35 +822,35 @@ func (parser *PdfParser) parseXrefStream(xstm *PdfObjectInteger) (*PdfObjectDict
 	sizeObj, ok := xs.PdfObjectDictionary.Get("Size").(*PdfObjectInteger)
 	if !ok {
 		common.Log.Debug("ERROR: Missing size from xref stm")
-		return nil, errors.New("Missing Size from xref stm")
+		return nil, fmt.Errorf("%w: Missing Size from xref stm", ErrCorrupt)
 	}
 	// Sanity check to avoid DoS attacks. Maximum number of indirect objects on 32 bit system.
 	if int64(*sizeObj) > 8388607 {
 		common.Log.Debug("ERROR: xref Size exceeded limit, over 8388607 (%d)", *sizeObj)
-		return nil, errors.New("Range check error")
+		return nil, fmt.Errorf("%w: Range check error", ErrCorrupt)
 	}
 
 	wObj := xs.PdfObjectDictionary.Get("W")
 	wArr, ok := wObj.(*PdfObjectArray)
 	if !ok {
-		return nil, errors.New("Invalid W in xref stream")
+		return nil, fmt.Errorf("%w: Invalid W in xref stream", ErrCorrupt)
 	}
 
 	wLen := len(*wArr)
 	if wLen != 3 {
 		common.Log.Debug("ERROR: Unsupported xref stm (len(W) != 3 - %d)", wLen)
-		return nil, errors.New("Unsupported xref stm len(W) != 3")
+		return nil, fmt.Errorf("%w: Unsupported xref stm len(W) != 3", ErrCorrupt)
 	}
 
 	var b []int64
 	for i := 0; i < 3; i++ {
 		w, ok := (*wArr)[i].(PdfObject)
 		if !ok {
-			return nil, errors.New("Invalid W")
+			return nil, fmt.Errorf("%w: Invalid W", ErrCorrupt)
 		}
 		wVal, ok := w.(*PdfObjectInteger)
 		if !ok {
-			return nil, errors.New("Invalid w object type")
+			return nil, fmt.Errorf("%w: Invalid w object type", ErrCorrupt)
 		}
 
 		b = append(b, int64(*wVal))
@@ -862,7 +869,7 @@ func (parser *PdfParser) parseXrefStream(xstm *PdfObjectInteger) (*PdfObjectDict
 
 	if s0 < 0 || s1 < 0 || s2 < 0 {
 		common.Log.Debug("Error s value < 0 (%d,%d,%d)", s0, s1, s2)
-		return nil, errors.New("Range check error")
+		return nil, fmt.Errorf("%w: Range check error", ErrCorrupt)
 	}
 	if deltab == 0 {
 		common.Log.Debug("No xref objects in stream (deltab == 0)")
@@ -891,13 +898,13 @@ func (parser *PdfParser) parseXrefStream(xstm *PdfObjectInteger) (*PdfObjectDict
 		indicesArray, ok := indexObj.(*PdfObjectArray)
 		if !ok {
 			common.Log.Debug("Invalid Index object (should be an array)")
-			return nil, errors.New("Invalid Index object")
+			return nil, fmt.Errorf("%w: Invalid Index object", ErrCorrupt)
 		}
 
 		// Expect indLen to be a multiple of 2.
 		if len(*indicesArray)%2 != 0 {
 			common.Log.Debug("WARNING Failure loading xref stm index not multiple of 2.")
-			return nil, errors.New("Range check error")
+			return nil, fmt.Errorf("%w: Range check error", ErrCorrupt)
 		}
 
 		objCount = 0
@@ -936,7 +943,7 @@ func (parser *PdfParser) parseXrefStream(xstm *PdfObjectInteger) (*PdfObjectDict
 	if entries != len(indexList) {
 		// If mismatch -> error (already allowing mismatch of 1 if Index not specified).
 		common.Log.Debug("ERROR: xref stm: num entries != len(indices) (%d != %d)", entries, len(indexList))
-		return nil, errors.New("Xref stm num entries != len(indices)")
+		return nil, fmt.Errorf("%w: Xref stm num entries != len(indices)", ErrCorrupt)
 	}
 
 	common.Log.Trace("Objects count %d", objCount)
@@ -1113,7 +1120,7 @@ func (parser *PdfParser) seekToEOFMarker(fSize int64) error {
 	}
 
 	common.Log.Debug("Error: EOF marker was not found.")
-	return errors.New("EOF not found")
+	return fmt.Errorf("%w: EOF not found", ErrCorrupt)
 }
 
 //
@@ -1181,11 +1188,11 @@ func (parser *PdfParser) loadXrefs() (*PdfObjectDictionary, error) {
 	result := reStartXref.FindStringSubmatch(string(b2))
 	if len(result) < 2 {
 		common.Log.Debug("Error: startxref not found!")
-		return nil, errors.New("Startxref not found")
+		return nil, fmt.Errorf("%w: Startxref not found", ErrCorrupt)
 	}
 	if len(result) > 2 {
 		common.Log.Debug("ERROR: Multiple startxref (%s)!", b2)
-		return nil, errors.New("Multiple startxref entries?")
+		return nil, fmt.Errorf("%w: Multiple startxref entries?", ErrCorrupt)
 	}
 	offsetXref, _ := strconv.ParseInt(result[1], 10, 64)
 	common.Log.Trace("startxref at %d", offsetXref)
@@ -1200,6 +1207,7 @@ func (parser *PdfParser) loadXrefs() (*PdfObjectDictionary, error) {
 		}
 	}
 	// Read the xref.
+	parser.xrefOffsets = []int64{offsetXref}
 	parser.rs.Seek(int64(offsetXref), io.SeekStart)
 	parser.reader = bufio.NewReader(parser.rs)
 
@@ -1213,7 +1221,7 @@ func (parser *PdfParser) loadXrefs() (*PdfObjectDictionary, error) {
 	if xx != nil {
 		xo, ok := xx.(*PdfObjectInteger)
 		if !ok {
-			return nil, errors.New("XRefStm != int")
+			return nil, fmt.Errorf("%w: XRefStm != int", ErrCorrupt)
 		}
 		_, err = parser.parseXrefStream(xo)
 		if err != nil {
@@ -1257,6 +1265,7 @@ func (parser *PdfParser) loadXrefs() (*PdfObjectDictionary, error) {
 			common.Log.Debug("Attempting to continue by ignoring it")
 			break
 		}
+		parser.xrefOffsets = append(parser.xrefOffsets, int64(off))
 
 		xx = ptrailerDict.Get("Prev")
 		if xx != nil {
@@ -1292,7 +1301,7 @@ func (parser *PdfParser) traceStreamLength(lengthObj PdfObject) (PdfObject, erro
 		lookupInProgress, has := parser.streamLengthReferenceLookupInProgress[lengthRef.ObjectNumber]
 		if has && lookupInProgress {
 			common.Log.Debug("Stream Length reference unresolved (illegal)")
-			return nil, errors.New("Illegal recursive loop")
+			return nil, fmt.Errorf("%w: Illegal recursive loop", ErrCorrupt)
 		}
 		// Mark lookup as in progress.
 		parser.streamLengthReferenceLookupInProgress[lengthRef.ObjectNumber] = true
@@ -1329,7 +1338,7 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 	indices := reIndirectObject.FindStringSubmatchIndex(string(bb))
 	if len(indices) < 6 {
 		common.Log.Debug("ERROR: Unable to find object signature (%s)", string(bb))
-		return &indirect, errors.New("Unable to detect indirect object signature")
+		return &indirect, fmt.Errorf("%w: Unable to detect indirect object signature", ErrCorrupt)
 	}
 	parser.reader.Discard(indices[0]) // Take care of any small offset.
 	common.Log.Trace("Offsets % d", indices)
@@ -1347,7 +1356,7 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 	result := reIndirectObject.FindStringSubmatch(string(hb))
 	if len(result) < 3 {
 		common.Log.Debug("ERROR: Unable to find object signature (%s)", string(hb))
-		return &indirect, errors.New("Unable to detect indirect object signature")
+		return &indirect, fmt.Errorf("%w: Unable to detect indirect object signature", ErrCorrupt)
 	}
 
 	on, _ := strconv.Atoi(result[1])
@@ -1414,7 +1423,7 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 
 					dict, isDict := indirect.PdfObject.(*PdfObjectDictionary)
 					if !isDict {
-						return nil, errors.New("Stream object missing dictionary")
+						return nil, fmt.Errorf("%w: Stream object missing dictionary", ErrCorrupt)
 					}
 					common.Log.Trace("Stream dict %s", dict)
 
@@ -1428,11 +1437,11 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 
 					pstreamLength, ok := slo.(*PdfObjectInteger)
 					if !ok {
-						return nil, errors.New("Stream length needs to be an integer")
+						return nil, fmt.Errorf("%w: Stream length needs to be an integer", ErrCorrupt)
 					}
 					streamLength := *pstreamLength
 					if streamLength < 0 {
-						return nil, errors.New("Stream needs to be longer than 0")
+						return nil, fmt.Errorf("%w: Stream needs to be longer than 0", ErrCorrupt)
 					}
 
 					// Validate the stream length based on the cross references.
@@ -1446,7 +1455,7 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 						// endstream + "\n" endobj + "\n" (17)
 						newLength := nextObjectOffset - streamStartOffset - 17
 						if newLength < 0 {
-							return nil, errors.New("Invalid stream length, going past boundaries")
+							return nil, fmt.Errorf("%w: Invalid stream length, going past boundaries", ErrCorrupt)
 						}
 
 						common.Log.Debug("Attempting a length correction to %d...", newLength)
@@ -1457,7 +1466,7 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 					// Make sure is less than actual file size.
 					if int64(streamLength) > parser.fileSize {
 						common.Log.Debug("ERROR: Stream length cannot be larger than file size")
-						return nil, errors.New("Invalid stream length, larger than file size")
+						return nil, fmt.Errorf("%w: Invalid stream length, larger than file size", ErrCorrupt)
 					}
 
 					stream := make([]byte, streamLength)
@@ -1525,7 +1534,7 @@ func NewParser(rs io.ReadSeeker) (*PdfParser, error) {
 	common.Log.Trace("Trailer: %s", trailer)
 
 	if len(parser.xrefs) == 0 {
-		return nil, fmt.Errorf("Empty XREF table - Invalid")
+		return nil, fmt.Errorf("%w: Empty XREF table - Invalid", ErrCorrupt)
 	}
 
 	majorVersion, minorVersion, err := parser.parsePdfVersion()
@@ -83,11 +83,20 @@ func MakeName(s string) *PdfObjectName {
 }
 
 // MakeInteger creates a PdfObjectInteger from an int64.
+// Small integer objects of parsed documents are shared by all the entries with the same value, so they must not
+// be mutated: to change an entry, set a new object created with MakeInteger instead.
 func MakeInteger(val int64) *PdfObjectInteger {
 	num := PdfObjectInteger(val)
 	return &num
 }
 
+// MakeBool creates a PdfObjectBool from a bool.
+// The boolean objects of parsed documents are shared (see MakeInteger) and must not be mutated.
+func MakeBool(val bool) *PdfObjectBool {
+	b := PdfObjectBool(val)
+	return &b
+}
+
 // MakeArray creates an PdfObjectArray from a list of PdfObjects.
 func MakeArray(objects ...PdfObject) *PdfObjectArray {
 	array := PdfObjectArray{}
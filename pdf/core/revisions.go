@@ -0,0 +1,186 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// GetObjectRawBytes returns the serialization of object `objNum` exactly as stored in the file, from the
+// "N G obj" header up to and including the "endobj" keyword. The data is not decrypted.
+// Objects stored within object streams do not have an on-disk serialization of their own, in that case the
+// serialization of the object within the decoded object stream is returned (without obj/endobj keywords).
+func (parser *PdfParser) GetObjectRawBytes(objNum int) ([]byte, error) {
+	xref, ok := parser.xrefs[objNum]
+	if !ok {
+		return nil, fmt.Errorf("Object %d not in xref table", objNum)
+	}
+
+	if xref.xtype == XREF_OBJECT_STREAM {
+		// Make sure the object stream is loaded.
+		_, err := parser.lookupObjectViaOS(xref.osObjNumber, objNum)
+		if err != nil {
+			return nil, err
+		}
+		objstm := parser.objstms[xref.osObjNumber]
+		start := objstm.offsets[objNum]
+		end := int64(len(objstm.ds))
+		for _, offset := range objstm.offsets {
+			if offset > start && offset < end {
+				end = offset
+			}
+		}
+		if start > end {
+			return nil, errors.New("Invalid object stream offset")
+		}
+		return bytes.TrimSpace(objstm.ds[start:end]), nil
+	}
+
+	// Parse the object to find where it ends.
+	parser.SetFileOffset(xref.offset)
+	obj, err := parser.ParseIndirectObject()
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to parse object %d: %v", objNum, err)
+		return nil, err
+	}
+	parsedEnd := parser.GetFileOffset()
+
+	// Allow for the endobj keyword following streams and some trailing whitespace.
+	readEnd := parsedEnd + 64
+	if readEnd > parser.fileSize {
+		readEnd = parser.fileSize
+	}
+	data, err := parser.readBytesAt(xref.offset, readEnd-xref.offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip whitespace prior to the object header.
+	start := len(data) - len(bytes.TrimLeft(data, "\x00\t\n\f\r "))
+
+	var end int
+	if _, isStream := obj.(*PdfObjectStream); isStream {
+		// The parsed position is just after endstream, endobj follows.
+		pos := int(parsedEnd - xref.offset)
+		idx := bytes.Index(data[pos:], []byte("endobj"))
+		if idx < 0 {
+			return nil, fmt.Errorf("Object %d: endobj not found", objNum)
+		}
+		end = pos + idx + 6
+	} else {
+		// The endobj line has been consumed by the parser.
+		pos := int(parsedEnd - xref.offset)
+		if pos > len(data) {
+			pos = len(data)
+		}
+		idx := bytes.LastIndex(data[:pos], []byte("endobj"))
+		if idx < 0 {
+			return nil, fmt.Errorf("Object %d: endobj not found", objNum)
+		}
+		end = idx + 6
+	}
+	if end < start {
+		return nil, fmt.Errorf("Object %d: invalid serialization", objNum)
+	}
+
+	return data[start:end], nil
+}
+
+// GetNumRevisions returns the number of revisions of the file, i.e. the original revision plus the number of
+// incremental updates.
+func (parser *PdfParser) GetNumRevisions() int {
+	return len(parser.xrefOffsets)
+}
+
+// GetRevisionBytes returns the file contents of revision `n` exactly as stored, from the beginning of the file
+// up to and including the end-of-file marker of the revision. Revisions are numbered from 0 (original
+// document) to GetNumRevisions()-1 (latest incremental update).
+func (parser *PdfParser) GetRevisionBytes(n int) ([]byte, error) {
+	if n < 0 || n >= len(parser.xrefOffsets) {
+		return nil, fmt.Errorf("Revision %d out of range (%d revisions)", n, len(parser.xrefOffsets))
+	}
+
+	offsets := make([]int64, len(parser.xrefOffsets))
+	copy(offsets, parser.xrefOffsets)
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	end, err := parser.findEOFMarkerEnd(offsets[n])
+	if err != nil {
+		return nil, err
+	}
+	return parser.readBytesAt(0, end)
+}
+
+// findEOFMarkerEnd returns the offset just past the first %%EOF marker (and its end-of-line) following `offset`.
+// Returns the file size if no marker is found.
+func (parser *PdfParser) findEOFMarkerEnd(offset int64) (int64, error) {
+	const bufLen = 4096
+	// Overlap the reads so a marker on the boundary is not missed.
+	const overlap = 8
+
+	pos := offset
+	for pos < parser.fileSize {
+		n := int64(bufLen)
+		if pos+n > parser.fileSize {
+			n = parser.fileSize - pos
+		}
+		buf, err := parser.readBytesAt(pos, n)
+		if err != nil {
+			return 0, err
+		}
+
+		idx := bytes.Index(buf, []byte("%%EOF"))
+		if idx >= 0 {
+			end := pos + int64(idx) + 5
+			// Include the end-of-line marker if present.
+			eol, _ := parser.readBytesAt(end, 2)
+			if len(eol) > 0 && eol[0] == '\r' {
+				end++
+				eol = eol[1:]
+			}
+			if len(eol) > 0 && eol[0] == '\n' {
+				end++
+			}
+			return end, nil
+		}
+
+		if pos+n >= parser.fileSize {
+			break
+		}
+		pos += n - overlap
+	}
+
+	common.Log.Debug("Warning: %%%%EOF marker not found after offset %d", offset)
+	return parser.fileSize, nil
+}
+
+// readBytesAt reads `n` bytes starting at file offset `offset`. Fewer bytes are returned if the end of the
+// file is reached.
+func (parser *PdfParser) readBytesAt(offset int64, n int64) ([]byte, error) {
+	bakOffset, err := parser.rs.Seek(0, os.SEEK_CUR)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.rs.Seek(bakOffset, os.SEEK_SET)
+
+	_, err = parser.rs.Seek(offset, os.SEEK_SET)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	nRead, err := io.ReadFull(parser.rs, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return buf[:nRead], nil
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// buildTestRevision appends objects `objs` (object number -> serialization) and a classic xref section with
+// trailer to `buf`. Returns the offset of the xref section.
+func buildTestRevision(buf *bytes.Buffer, objs map[int]string, size int, prev int64) int64 {
+	offsets := map[int]int{}
+	for num := 1; num < size; num++ {
+		obj, has := objs[num]
+		if !has {
+			continue
+		}
+		offsets[num] = buf.Len()
+		buf.WriteString(obj)
+	}
+
+	xrefOffset := int64(buf.Len())
+	buf.WriteString("xref\n")
+	if prev == 0 {
+		buf.WriteString(fmt.Sprintf("0 %d\n", size))
+		buf.WriteString("0000000000 65535 f\r\n")
+		for num := 1; num < size; num++ {
+			buf.WriteString(fmt.Sprintf("%.10d 00000 n\r\n", offsets[num]))
+		}
+	} else {
+		for num := range objs {
+			buf.WriteString(fmt.Sprintf("%d 1\n%.10d 00000 n\r\n", num, offsets[num]))
+		}
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R", size))
+	if prev > 0 {
+		buf.WriteString(fmt.Sprintf(" /Prev %d", prev))
+	}
+	buf.WriteString(" >>\n")
+	buf.WriteString(fmt.Sprintf("startxref\n%d\n%%%%EOF\n", xrefOffset))
+	return xrefOffset
+}
+
+func TestRawBytesAndRevisions(t *testing.T) {
+	obj1 := "1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n"
+	obj2 := "2 0 obj\n<< /Type /Pages /Kids [] /Count 0 >>\nendobj\n"
+	obj3 := "3 0 obj\n<< /Length 17 >>\nstream\nBT (endobj) Tj ET\nendstream\nendobj\n"
+	obj2upd := "2 0 obj\n<< /Type /Pages /Kids [] /Count 0 /Updated true >>\nendobj\n"
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	prev := buildTestRevision(&buf, map[int]string{1: obj1, 2: obj2, 3: obj3}, 4, 0)
+	rev0 := buf.String()
+	buildTestRevision(&buf, map[int]string{2: obj2upd}, 4, prev)
+
+	parser, err := NewParser(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if parser.GetNumRevisions() != 2 {
+		t.Fatalf("Expected 2 revisions, got %d", parser.GetNumRevisions())
+	}
+	data, err := parser.GetRevisionBytes(0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if string(data) != rev0 {
+		t.Errorf("Revision 0 mismatch: %q", data)
+	}
+	data, err = parser.GetRevisionBytes(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !bytes.Equal(data, buf.Bytes()) {
+		t.Errorf("Revision 1 mismatch")
+	}
+	if _, err := parser.GetRevisionBytes(2); err == nil {
+		t.Errorf("Revision 2 should be out of range")
+	}
+
+	expected := map[int]string{1: obj1, 2: obj2upd, 3: obj3}
+	for num, obj := range expected {
+		data, err := parser.GetObjectRawBytes(num)
+		if err != nil {
+			t.Errorf("Object %d: error: %v", num, err)
+			continue
+		}
+		if string(data)+"\n" != obj {
+			t.Errorf("Object %d mismatch: %q", num, data)
+		}
+	}
+	if _, err := parser.GetObjectRawBytes(10); err == nil {
+		t.Errorf("Object 10 should not exist")
+	}
+}
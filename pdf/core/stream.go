@@ -75,7 +75,7 @@ func NewEncoderFromStream(streamObj *PdfObjectStream) (StreamEncoder, error) {
 		return NewJPXEncoder(), nil
 	} else {
 		common.Log.Debug("ERROR: Unsupported encoding method!")
-		return nil, fmt.Errorf("Unsupported encoding method (%s)", *method)
+		return nil, fmt.Errorf("%w: encoding method (%s)", ErrUnsupportedFilter, *method)
 	}
 }
 
@@ -87,14 +87,14 @@ func DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
 	encoder, err := NewEncoderFromStream(streamObj)
 	if err != nil {
 		common.Log.Debug("Stream decoding failed: %v", err)
-		return nil, err
+		return nil, fmt.Errorf("decode stream %d: %w", streamObj.ObjectNumber, err)
 	}
 	common.Log.Trace("Encoder: %#v\n", encoder)
 
 	decoded, err := encoder.DecodeStream(streamObj)
 	if err != nil {
 		common.Log.Debug("Stream decoding failed: %v", err)
-		return nil, err
+		return nil, fmt.Errorf("decode stream %d: %w", streamObj.ObjectNumber, err)
 	}
 
 	return decoded, nil
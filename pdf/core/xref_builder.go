@@ -0,0 +1,184 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// xrefSectionEntry is an entry of a cross-reference section.
+type xrefSectionEntry struct {
+	offset     int64
+	generation int
+	free       bool
+}
+
+// XrefSection builds a classic cross-reference section (7.5.4): the entries are grouped into subsections of
+// consecutive object numbers and the free entries are linked into the free list starting at object 0.
+// Used for complete files as well as for the sections of incremental updates, which only contain the entries of
+// the objects changed by the update.
+type XrefSection struct {
+	// Incremental sections only include object 0, the head of the free list, if they have free entries.
+	Incremental bool
+
+	entries map[int]xrefSectionEntry
+}
+
+// NewXrefSection returns a new empty cross-reference section for a complete file.
+func NewXrefSection() *XrefSection {
+	return &XrefSection{entries: map[int]xrefSectionEntry{}}
+}
+
+// AddObject adds an in-use entry for object `objNum` with generation `generation` at byte offset `offset`.
+func (s *XrefSection) AddObject(objNum int, generation int, offset int64) error {
+	if objNum < 1 || generation < 0 || generation > 65535 || offset < 0 {
+		common.Log.Debug("ERROR: Invalid xref entry %d %d at %d", objNum, generation, offset)
+		return errors.New("invalid xref entry")
+	}
+	s.entries[objNum] = xrefSectionEntry{offset: offset, generation: generation}
+	return nil
+}
+
+// AddFree adds a free entry for object `objNum`, where `generation` is the generation number to use if the object
+// number is used again, i.e. the generation of the deleted object plus one.
+func (s *XrefSection) AddFree(objNum int, generation int) error {
+	if objNum < 1 || generation < 0 || generation > 65535 {
+		common.Log.Debug("ERROR: Invalid free xref entry %d %d", objNum, generation)
+		return errors.New("invalid xref entry")
+	}
+	s.entries[objNum] = xrefSectionEntry{generation: generation, free: true}
+	return nil
+}
+
+// Size returns the Size entry of the trailer for the section: one more than the highest object number.
+func (s *XrefSection) Size() int64 {
+	size := int64(1)
+	for objNum := range s.entries {
+		if int64(objNum) >= size {
+			size = int64(objNum) + 1
+		}
+	}
+	return size
+}
+
+// Subsections returns the first object number and number of entries of the subsections of the section.
+func (s *XrefSection) Subsections() [][2]int {
+	subsections := [][2]int{}
+	for _, objNum := range s.objectNumbers() {
+		if n := len(subsections); n > 0 && subsections[n-1][0]+subsections[n-1][1] == objNum {
+			subsections[n-1][1]++
+			continue
+		}
+		subsections = append(subsections, [2]int{objNum, 1})
+	}
+	return subsections
+}
+
+// objectNumbers returns the sorted object numbers of the entries of the section, including 0 when written.
+func (s *XrefSection) objectNumbers() []int {
+	nums := []int{}
+	hasFree := false
+	for objNum, entry := range s.entries {
+		nums = append(nums, objNum)
+		hasFree = hasFree || entry.free
+	}
+	if !s.Incremental || hasFree {
+		nums = append(nums, 0)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// Bytes returns the serialized section, from the xref keyword to the last entry. The entries are 20 bytes long
+// with CRLF line endings as required.
+func (s *XrefSection) Bytes() []byte {
+	nums := s.objectNumbers()
+
+	// Each free entry refers to the next free object, the last one to 0.
+	nextFree := map[int]int{}
+	prevFree := 0
+	for _, objNum := range nums {
+		if objNum != 0 && s.entries[objNum].free {
+			nextFree[prevFree] = objNum
+			prevFree = objNum
+		}
+	}
+	nextFree[prevFree] = 0
+
+	var buf bytes.Buffer
+	buf.WriteString("xref\r\n")
+	i := 0
+	for _, sub := range s.Subsections() {
+		buf.WriteString(fmt.Sprintf("%d %d\r\n", sub[0], sub[1]))
+		for ; i < len(nums) && nums[i] < sub[0]+sub[1]; i++ {
+			objNum := nums[i]
+			if objNum == 0 {
+				buf.WriteString(fmt.Sprintf("%.10d %.5d f\r\n", nextFree[0], 65535))
+				continue
+			}
+			entry := s.entries[objNum]
+			if entry.free {
+				buf.WriteString(fmt.Sprintf("%.10d %.5d f\r\n", nextFree[objNum], entry.generation))
+			} else {
+				buf.WriteString(fmt.Sprintf("%.10d %.5d n\r\n", entry.offset, entry.generation))
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+// WriteTo writes the serialized section to `w`.
+func (s *XrefSection) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(s.Bytes())
+	return int64(n), err
+}
+
+// XrefTrailer contains the entries of a file trailer (7.5.5 - Table 15).
+type XrefTrailer struct {
+	Size    int64
+	Root    PdfObject
+	Info    PdfObject
+	Encrypt PdfObject
+	ID      *PdfObjectArray
+
+	// Byte offset of the previous cross-reference section, for incremental updates.
+	Prev *int64
+
+	// Byte offset of the cross-reference stream of a hybrid-reference file (7.5.8.4).
+	XRefStm *int64
+}
+
+// ToPdfObject returns the trailer dictionary.
+func (t *XrefTrailer) ToPdfObject() *PdfObjectDictionary {
+	trailer := MakeDict()
+	trailer.Set("Size", MakeInteger(t.Size))
+	trailer.SetIfNotNil("Root", t.Root)
+	trailer.SetIfNotNil("Info", t.Info)
+	trailer.SetIfNotNil("Encrypt", t.Encrypt)
+	if t.ID != nil {
+		trailer.Set("ID", t.ID)
+	}
+	if t.Prev != nil {
+		trailer.Set("Prev", MakeInteger(*t.Prev))
+	}
+	if t.XRefStm != nil {
+		trailer.Set("XRefStm", MakeInteger(*t.XRefStm))
+	}
+	return trailer
+}
+
+// WriteTrailer writes the trailer dictionary `trailer` followed by the startxref keyword with the byte offset
+// `xrefOffset` of the last cross-reference section and the end-of-file marker to `w`.
+func WriteTrailer(w io.Writer, trailer *PdfObjectDictionary, xrefOffset int64) error {
+	_, err := fmt.Fprintf(w, "trailer\n%s\nstartxref\n%d\n%%%%EOF\n", trailer.DefaultWriteString(), xrefOffset)
+	return err
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestXrefSection(t *testing.T) {
+	xref := NewXrefSection()
+	xref.AddObject(1, 0, 15)
+	xref.AddObject(2, 0, 80)
+	xref.AddFree(3, 1)
+	xref.AddObject(5, 2, 200)
+	xref.AddFree(6, 1)
+	if err := xref.AddObject(0, 0, 0); err == nil {
+		t.Errorf("Object 0 should not be allowed")
+	}
+
+	expected := "xref\r\n" +
+		"0 4\r\n" +
+		"0000000003 65535 f\r\n" +
+		"0000000015 00000 n\r\n" +
+		"0000000080 00000 n\r\n" +
+		"0000000006 00001 f\r\n" +
+		"5 2\r\n" +
+		"0000000200 00002 n\r\n" +
+		"0000000000 00001 f\r\n"
+	if got := string(xref.Bytes()); got != expected {
+		t.Errorf("Unexpected section:\n%q\nexpected:\n%q", got, expected)
+	}
+	if xref.Size() != 7 {
+		t.Errorf("Size %d", xref.Size())
+	}
+
+	// Parse the section back.
+	var buf bytes.Buffer
+	xref.WriteTo(&buf)
+	prev := int64(1234)
+	trailer := &XrefTrailer{Size: xref.Size(), Root: MakeIndirectObject(MakeDict()), Prev: &prev}
+	if err := WriteTrailer(&buf, trailer.ToPdfObject(), 500); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\nstartxref\n500\n%%EOF\n")) {
+		t.Errorf("Unexpected trailer: %q", buf.String())
+	}
+
+	parser := NewParserFromString(buf.String())
+	parser.xrefs = XrefTable{}
+	parsedTrailer, err := parser.parseXrefTable()
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	if len(parser.xrefs) != 3 || parser.xrefs[5].offset != 200 || parser.xrefs[5].generation != 2 {
+		t.Errorf("Unexpected xrefs: %v", parser.xrefs)
+	}
+	if p, ok := parsedTrailer.Get("Prev").(*PdfObjectInteger); !ok || *p != 1234 {
+		t.Errorf("Unexpected trailer: %s", parsedTrailer)
+	}
+}
+
+func TestXrefSectionIncremental(t *testing.T) {
+	xref := NewXrefSection()
+	xref.Incremental = true
+	xref.AddObject(4, 0, 1000)
+	xref.AddObject(9, 1, 1100)
+
+	expected := "xref\r\n4 1\r\n0000001000 00000 n\r\n9 1\r\n0000001100 00001 n\r\n"
+	if got := string(xref.Bytes()); got != expected {
+		t.Errorf("Unexpected section:\n%q", got)
+	}
+
+	// Object 0 is included with free entries.
+	xref.AddFree(3, 1)
+	if subs := xref.Subsections(); len(subs) != 3 || subs[0] != [2]int{0, 1} || subs[1] != [2]int{3, 2} {
+		t.Errorf("Unexpected subsections %v", subs)
+	}
+}
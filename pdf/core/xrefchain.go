@@ -0,0 +1,211 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Kinds of problems found in the Prev chain of cross-reference sections by VerifyXrefChain.
+const (
+	XrefChainLoop         = "loop"          // A Prev entry refers to a section already in the chain.
+	XrefChainPastEOF      = "past-eof"      // A Prev entry refers to an offset past the end of the file.
+	XrefChainInvalidPrev  = "invalid-prev"  // A Prev entry is not a non-negative integer.
+	XrefChainUnparsable   = "unparsable"    // No valid cross-reference section at the offset.
+	XrefChainOverlap      = "overlap"       // The section overlaps another section of the chain.
+	XrefChainMissingXrefs = "missing-xrefs" // Objects in the file are not in any section of the chain.
+)
+
+// XrefChainIssue is a problem found in the chain of cross-reference sections of a file.
+type XrefChainIssue struct {
+	Kind    string // One of the XrefChain constants.
+	Offset  int64  // Offset of the section (or the offset referred to) where the problem was found.
+	Message string
+}
+
+func (issue XrefChainIssue) String() string {
+	return fmt.Sprintf("[%s] offset %d: %s", issue.Kind, issue.Offset, issue.Message)
+}
+
+// xrefChainSection is a cross-reference section of the chain with its byte range.
+type xrefChainSection struct {
+	start, end int64
+}
+
+// VerifyXrefChain follows the chain of cross-reference sections from the last one (startxref) through the Prev
+// entries of their trailers and returns the problems found: loops, offsets past the end of the file, sections
+// that cannot be parsed or overlap, and, for a broken chain, the objects in the file that no section refers to,
+// e.g. the objects of the revisions cut from the chain. Returns no issues for a consistent chain.
+// The objects loaded by the parser are not affected.
+func (parser *PdfParser) VerifyXrefChain() []XrefChainIssue {
+	issues := []XrefChainIssue{}
+	if len(parser.xrefOffsets) == 0 {
+		return issues
+	}
+
+	bakOffset, _ := parser.rs.Seek(0, os.SEEK_CUR)
+	defer parser.SetFileOffset(bakOffset)
+
+	// Parse the sections with a separate parser so the xref table of the parser is not modified.
+	tmp := parser.newTemporaryParser()
+	tmp.xrefs = XrefTable{}
+	tmp.objstms = ObjectStreams{}
+
+	sections := []xrefChainSection{}
+	visited := map[int64]bool{}
+	offset := parser.xrefOffsets[0]
+	for {
+		if offset >= parser.fileSize {
+			issues = append(issues, XrefChainIssue{XrefChainPastEOF, offset,
+				fmt.Sprintf("section offset past end of file (%d bytes)", parser.fileSize)})
+			break
+		}
+		if visited[offset] {
+			issues = append(issues, XrefChainIssue{XrefChainLoop, offset, "Prev refers to a section already in the chain"})
+			break
+		}
+		visited[offset] = true
+
+		// A section must start at the offset, the repair of parseXref looking for other sections is not wanted.
+		tmp.SetFileOffset(offset)
+		if bb, _ := tmp.reader.Peek(20); !reIndirectObject.MatchString(string(bb)) && !reXrefTable.MatchString(string(bb)) {
+			issues = append(issues, XrefChainIssue{XrefChainUnparsable, offset, "no cross-reference section at the offset"})
+			break
+		}
+		trailer, err := tmp.parseXref()
+		if err != nil || trailer == nil {
+			issues = append(issues, XrefChainIssue{XrefChainUnparsable, offset,
+				fmt.Sprintf("no valid cross-reference section (%v)", err)})
+			break
+		}
+		section := xrefChainSection{start: offset, end: tmp.GetFileOffset()}
+		for _, other := range sections {
+			if section.start < other.end && other.start < section.end {
+				issues = append(issues, XrefChainIssue{XrefChainOverlap, offset,
+					fmt.Sprintf("section overlaps the section at offset %d", other.start)})
+			}
+		}
+		sections = append(sections, section)
+
+		prevObj := trailer.Get("Prev")
+		if prevObj == nil {
+			break
+		}
+		prev, ok := prevObj.(*PdfObjectInteger)
+		if !ok || *prev < 0 {
+			issues = append(issues, XrefChainIssue{XrefChainInvalidPrev, offset, fmt.Sprintf("invalid Prev entry %v", prevObj)})
+			break
+		}
+		offset = int64(*prev)
+	}
+
+	// Objects defined in the file but not referred to by the sections that could be loaded, i.e. lost with the
+	// broken part of the chain. Objects not referred to by a consistent chain are deleted objects.
+	if len(issues) == 0 {
+		return issues
+	}
+	if scanned, err := parser.scanObjects(); err == nil {
+		missing := 0
+		for objNum := range scanned {
+			if _, has := tmp.xrefs[objNum]; !has {
+				missing++
+			}
+		}
+		if missing > 0 {
+			issues = append(issues, XrefChainIssue{XrefChainMissingXrefs, parser.xrefOffsets[0],
+				fmt.Sprintf("%d object(s) in the file are not in the cross-reference sections", missing)})
+		}
+	}
+
+	return issues
+}
+
+// newTemporaryParser returns a parser of the file of `parser` with its own state, initialized as by NewParser.
+func (parser *PdfParser) newTemporaryParser() *PdfParser {
+	tmp := &PdfParser{rs: parser.rs, fileSize: parser.fileSize}
+	tmp.ObjCache = make(ObjectCache)
+	tmp.streamLengthReferenceLookupInProgress = map[int64]bool{}
+	return tmp
+}
+
+// scanObjects returns the objects found by scanning the file for object headers, see repairRebuildXrefsTopDown.
+// The state of the parser is not affected.
+func (parser *PdfParser) scanObjects() (XrefTable, error) {
+	bakOffset, _ := parser.rs.Seek(0, os.SEEK_CUR)
+	defer parser.SetFileOffset(bakOffset)
+
+	tmp := parser.newTemporaryParser()
+	xrefs, err := tmp.repairRebuildXrefsTopDown()
+	if err != nil {
+		return nil, err
+	}
+	return *xrefs, nil
+}
+
+// RepairXrefChain writes the file to `w` followed by a new cross-reference section for all the objects of the
+// file with a trailer without Prev, replacing the chain of sections by a consistent one. The new section refers to
+// the objects loaded by the parser, and to the objects found by scanning the file that no section refers to.
+// Only files with cross-reference tables are supported, files with objects in object streams are not.
+func (parser *PdfParser) RepairXrefChain(w io.Writer) error {
+	if parser.trailer == nil {
+		return errors.New("trailer not loaded")
+	}
+	xrefs := XrefTable{}
+	for objNum, xref := range parser.xrefs {
+		if xref.xtype != XREF_TABLE_ENTRY {
+			common.Log.Debug("ERROR: Object %d in object stream, unable to repair xref chain", objNum)
+			return errors.New("object streams not supported")
+		}
+		xrefs[objNum] = xref
+	}
+	scanned, err := parser.scanObjects()
+	if err != nil {
+		return err
+	}
+	for objNum, xref := range scanned {
+		if _, has := xrefs[objNum]; !has {
+			xrefs[objNum] = xref
+		}
+	}
+
+	data, err := parser.readBytesAt(0, parser.fileSize)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' && data[len(data)-1] != '\r' {
+		data = append(data, '\n')
+	}
+
+	xref := NewXrefSection()
+	for objNum, entry := range xrefs {
+		if objNum == 0 {
+			continue
+		}
+		if err := xref.AddObject(objNum, entry.generation, entry.offset); err != nil {
+			return err
+		}
+	}
+
+	// The trailer of the last section, which can be the dictionary of a cross-reference stream.
+	trailer := MakeDict()
+	trailer.Set("Size", MakeInteger(xref.Size()))
+	for _, key := range []PdfObjectName{"Root", "Info", "Encrypt", "ID"} {
+		trailer.SetIfNotNil(key, parser.trailer.Get(key))
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if _, err := xref.WriteTo(w); err != nil {
+		return err
+	}
+	return WriteTrailer(w, trailer, int64(len(data)))
+}
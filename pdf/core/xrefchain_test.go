@@ -0,0 +1,103 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// makeTwoRevisionPdf returns a file with an original revision and an incremental update whose trailer Prev entry
+// is returned by `prev` from the offsets of the two xref sections and of a stream object with an indirect Length.
+func makeTwoRevisionPdf(prev func(xref1, xref2, stream int64) int64) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	xref1 := NewXrefSection()
+	xref1.AddObject(1, 0, int64(buf.Len()))
+	buf.WriteString("1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	xref1.AddObject(2, 0, int64(buf.Len()))
+	buf.WriteString("2 0 obj\n<< /Type /Pages /Kids [] /Count 0 >>\nendobj\n")
+	offset1 := int64(buf.Len())
+	xref1.WriteTo(&buf)
+	trailer := &XrefTrailer{Size: xref1.Size(), Root: &PdfObjectReference{ObjectNumber: 1}}
+	WriteTrailer(&buf, trailer.ToPdfObject(), offset1)
+
+	xref2 := NewXrefSection()
+	xref2.Incremental = true
+	xref2.AddObject(3, 0, int64(buf.Len()))
+	buf.WriteString("3 0 obj\n(update)\nendobj\n")
+	stream := int64(buf.Len())
+	xref2.AddObject(4, 0, stream)
+	buf.WriteString("4 0 obj\n<< /Length 5 0 R >>\nstream\nabc\nendstream\nendobj\n")
+	xref2.AddObject(5, 0, int64(buf.Len()))
+	buf.WriteString("5 0 obj\n3\nendobj\n")
+	offset2 := int64(buf.Len())
+	xref2.WriteTo(&buf)
+	prevOffset := prev(offset1, offset2, stream)
+	trailer = &XrefTrailer{Size: xref2.Size(), Root: &PdfObjectReference{ObjectNumber: 1}, Prev: &prevOffset}
+	WriteTrailer(&buf, trailer.ToPdfObject(), offset2)
+
+	return buf.Bytes()
+}
+
+func issueKinds(issues []XrefChainIssue) string {
+	kinds := ""
+	for _, issue := range issues {
+		kinds += issue.Kind + " "
+	}
+	return kinds
+}
+
+func TestVerifyXrefChain(t *testing.T) {
+	testcases := []struct {
+		name  string
+		prev  func(xref1, xref2, stream int64) int64
+		kinds string
+	}{
+		{"valid", func(xref1, xref2, stream int64) int64 { return xref1 }, ""},
+		{"loop", func(xref1, xref2, stream int64) int64 { return xref2 }, "loop missing-xrefs "},
+		{"past EOF", func(xref1, xref2, stream int64) int64 { return 1 << 20 }, "past-eof missing-xrefs "},
+		{"unparsable", func(xref1, xref2, stream int64) int64 { return 9 }, "unparsable missing-xrefs "},
+		{"inside section", func(xref1, xref2, stream int64) int64 { return xref1 + 1 }, "unparsable missing-xrefs "},
+		{"stream", func(xref1, xref2, stream int64) int64 { return stream }, "unparsable missing-xrefs "},
+	}
+
+	for _, tcase := range testcases {
+		data := makeTwoRevisionPdf(tcase.prev)
+		parser, err := NewParser(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: error: %v", tcase.name, err)
+		}
+		issues := parser.VerifyXrefChain()
+		if kinds := issueKinds(issues); kinds != tcase.kinds {
+			t.Errorf("%s: issues %v, expected %s", tcase.name, issues, tcase.kinds)
+		}
+		if tcase.kinds == "" {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := parser.RepairXrefChain(&buf); err != nil {
+			t.Fatalf("%s: error repairing: %v", tcase.name, err)
+		}
+		repaired, err := NewParser(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("%s: error: %v", tcase.name, err)
+		}
+		if issues := repaired.VerifyXrefChain(); len(issues) != 0 {
+			t.Errorf("%s: issues after repair: %v", tcase.name, issues)
+		}
+		for objNum := 1; objNum <= 3; objNum++ {
+			if obj, err := repaired.LookupByNumber(objNum); err != nil {
+				t.Errorf("%s: object %d not found after repair: %v", tcase.name, objNum, err)
+			} else if objNum == 3 && fmt.Sprintf("%s", obj.(*PdfIndirectObject).PdfObject) != "update" {
+				t.Errorf("%s: unexpected object 3: %s", tcase.name, obj)
+			}
+		}
+	}
+}
@@ -0,0 +1,303 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// AssemblySpec is a declarative description of an assembly job: the input documents and the pages taken from
+// each of them, stamps drawn on the output pages, document metadata and encryption of the output.
+// A spec can be built in code or loaded from JSON with LoadAssemblySpec, and is executed with Assemble.
+//
+// Example JSON spec:
+//
+//	{
+//	  "inputs": [
+//	    {"path": "cover.pdf"},
+//	    {"path": "report.pdf", "pages": "2-5,8-", "rotate": 90}
+//	  ],
+//	  "stamps": [{"text": "CONFIDENTIAL", "x": 40, "y": 20, "font_size": 10, "color": "#ff0000"}],
+//	  "metadata": {"Title": "Quarterly report", "Author": "Finance"},
+//	  "encryption": {"owner_password": "secret"}
+//	}
+type AssemblySpec struct {
+	Inputs     []*AssemblyInput    `json:"inputs"`
+	Stamps     []*AssemblyStamp    `json:"stamps,omitempty"`
+	Metadata   map[string]string   `json:"metadata,omitempty"` // Document information entries, e.g. Title or Author.
+	Encryption *AssemblyEncryption `json:"encryption,omitempty"`
+}
+
+// AssemblyInput is an input document of an assembly job.
+type AssemblyInput struct {
+	Path     string        `json:"path,omitempty"`
+	Reader   io.ReadSeeker `json:"-"` // Can be used instead of Path for documents already in memory.
+	Password string        `json:"password,omitempty"`
+
+	// Pages to take from the document, as a comma separated list of page numbers and ranges, e.g. "1-3,5,9-".
+	// All pages are taken if empty.
+	Pages string `json:"pages,omitempty"`
+
+	// Rotation in degrees (multiple of 90) applied to each of the pages taken from the document.
+	Rotate int64 `json:"rotate,omitempty"`
+}
+
+// AssemblyStamp is a text stamp drawn on the output pages of an assembly job.
+// The position is specified relative to the upper left corner of the page, same as for other creator drawables.
+// Position and font size are in points, also on pages with a UserUnit.
+type AssemblyStamp struct {
+	Text     string  `json:"text"`
+	Pages    string  `json:"pages,omitempty"` // Output pages to stamp, same syntax as AssemblyInput.Pages.
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	FontSize float64 `json:"font_size,omitempty"`
+	Color    string  `json:"color,omitempty"` // Hex color code, e.g. #ff0000.
+	Angle    float64 `json:"angle,omitempty"`
+}
+
+// AssemblyEncryption specifies the encryption of the output document of an assembly job.
+type AssemblyEncryption struct {
+	UserPassword  string                  `json:"user_password,omitempty"`
+	OwnerPassword string                  `json:"owner_password"`
+	Permissions   *core.AccessPermissions `json:"permissions,omitempty"` // Full permissions if not set.
+}
+
+// LoadAssemblySpec loads an assembly spec in JSON format from `r`.
+func LoadAssemblySpec(r io.Reader) (*AssemblySpec, error) {
+	spec := &AssemblySpec{}
+	err := json.NewDecoder(r).Decode(spec)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to decode assembly spec: %v", err)
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate checks the spec for errors that can be detected prior to executing it.
+func (spec *AssemblySpec) Validate() error {
+	if len(spec.Inputs) == 0 {
+		return errors.New("No inputs specified")
+	}
+	for i, input := range spec.Inputs {
+		if input.Reader == nil && len(input.Path) == 0 {
+			return fmt.Errorf("Input %d: path not specified", i+1)
+		}
+		if input.Rotate%90 != 0 {
+			return fmt.Errorf("Input %d: rotation not a multiple of 90", i+1)
+		}
+		if _, err := parsePageRanges(input.Pages, -1); err != nil {
+			return fmt.Errorf("Input %d: %v", i+1, err)
+		}
+	}
+	for i, stamp := range spec.Stamps {
+		if _, err := parsePageRanges(stamp.Pages, -1); err != nil {
+			return fmt.Errorf("Stamp %d: %v", i+1, err)
+		}
+	}
+	if spec.Encryption != nil && len(spec.Encryption.OwnerPassword) == 0 {
+		return errors.New("Encryption: owner password not specified")
+	}
+	return nil
+}
+
+// Assemble executes the assembly job described by `spec` and writes the output document to `ws`.
+func Assemble(spec *AssemblySpec, ws io.WriteSeeker) error {
+	err := spec.Validate()
+	if err != nil {
+		common.Log.Debug("ERROR: Invalid assembly spec: %v", err)
+		return err
+	}
+
+	c := New()
+	for i, input := range spec.Inputs {
+		rs := input.Reader
+		if rs == nil {
+			f, err := os.Open(input.Path)
+			if err != nil {
+				return err
+			}
+			// The pages are read lazily, so the inputs are kept open until the output is written.
+			defer f.Close()
+			rs = f
+		}
+
+		err = addAssemblyInput(c, input, rs)
+		if err != nil {
+			common.Log.Debug("ERROR: Input %d: %v", i+1, err)
+			return err
+		}
+	}
+
+	for _, stamp := range spec.Stamps {
+		err = drawAssemblyStamp(c, stamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(spec.Metadata) > 0 || spec.Encryption != nil {
+		c.SetPdfWriterAccessFunc(func(w *model.PdfWriter) error {
+			for key, val := range spec.Metadata {
+				w.SetDocInfo(key, val)
+			}
+			if spec.Encryption == nil {
+				return nil
+			}
+
+			var opts *model.EncryptOptions
+			if spec.Encryption.Permissions != nil {
+				opts = &model.EncryptOptions{Permissions: *spec.Encryption.Permissions}
+			}
+			return w.Encrypt([]byte(spec.Encryption.UserPassword), []byte(spec.Encryption.OwnerPassword), opts)
+		})
+	}
+
+	return c.Write(ws)
+}
+
+// addAssemblyInput adds the pages selected by `input` from the document in `rs` to the creator.
+func addAssemblyInput(c *Creator, input *AssemblyInput, rs io.ReadSeeker) error {
+	reader, err := model.NewPdfReaderWith(rs, model.WithPassword(input.Password))
+	if err != nil {
+		return err
+	}
+
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return err
+	}
+	pageNums, err := parsePageRanges(input.Pages, numPages)
+	if err != nil {
+		return err
+	}
+
+	for _, pageNum := range pageNums {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return err
+		}
+		err = c.AddPage(page)
+		if err != nil {
+			return err
+		}
+		if input.Rotate != 0 {
+			c.setActivePage(page)
+			err = c.RotateDeg(input.Rotate)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	c.setActivePage(nil)
+
+	return nil
+}
+
+// drawAssemblyStamp draws `stamp` on the selected pages of the creator.
+func drawAssemblyStamp(c *Creator, stamp *AssemblyStamp) error {
+	pageNums, err := parsePageRanges(stamp.Pages, len(c.pages))
+	if err != nil {
+		return err
+	}
+
+	// The context is set up for each stamped page, and restored afterwards.
+	context := c.context
+	defer func() { c.context = context }()
+
+	for _, pageNum := range pageNums {
+		page := c.pages[pageNum-1]
+		mbox, err := page.GetMediaBox()
+		if err != nil {
+			return err
+		}
+
+		// Stamps have the same physical size on pages with a UserUnit.
+		scale := 1 / page.GetUserUnit()
+
+		p := NewParagraph(stamp.Text)
+		if stamp.FontSize > 0 {
+			p.SetFontSize(stamp.FontSize * scale)
+		} else {
+			p.SetFontSize(p.fontSize * scale)
+		}
+		if len(stamp.Color) > 0 {
+			p.SetColor(ColorRGBFromHex(stamp.Color))
+		}
+		p.SetAngle(stamp.Angle)
+		p.SetPos(stamp.X*scale, stamp.Y*scale)
+
+		c.setActivePage(page)
+		c.context.PageWidth = mbox.Urx - mbox.Llx
+		c.context.PageHeight = mbox.Ury - mbox.Lly
+		err = c.Draw(p)
+		if err != nil {
+			return err
+		}
+	}
+	c.setActivePage(nil)
+
+	return nil
+}
+
+// parsePageRanges parses a comma separated list of 1-based page numbers and ranges, e.g. "1-3,5,9-", into a list
+// of page numbers. An empty spec selects all pages. A range without an end extends to the last page. Pages may not
+// be selected more than once. If `numPages` is negative, only the syntax is checked.
+func parsePageRanges(spec string, numPages int) ([]int, error) {
+	pages := []int{}
+	selected := map[int]bool{}
+	if len(strings.TrimSpace(spec)) == 0 {
+		for i := 1; i <= numPages; i++ {
+			pages = append(pages, i)
+		}
+		return pages, nil
+	}
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		from, to := part, part
+		if idx := strings.Index(part, "-"); idx >= 0 {
+			from, to = strings.TrimSpace(part[:idx]), strings.TrimSpace(part[idx+1:])
+		}
+
+		start, err := strconv.Atoi(from)
+		if err != nil || start < 1 {
+			return nil, fmt.Errorf("Invalid page range: %q", part)
+		}
+		end := numPages
+		if len(to) > 0 {
+			end, err = strconv.Atoi(to)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("Invalid page range: %q", part)
+			}
+		}
+		if numPages < 0 {
+			continue
+		}
+		if start > numPages || end > numPages {
+			return nil, fmt.Errorf("Page range out of bounds: %q (%d pages)", part, numPages)
+		}
+
+		for i := start; i <= end; i++ {
+			if selected[i] {
+				return nil, fmt.Errorf("Page %d selected more than once: %q", i, spec)
+			}
+			selected[i] = true
+			pages = append(pages, i)
+		}
+	}
+
+	return pages, nil
+}
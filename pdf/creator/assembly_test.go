@@ -0,0 +1,99 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestParsePageRanges(t *testing.T) {
+	testcases := []struct {
+		Spec     string
+		NumPages int
+		Expected []int
+	}{
+		{"", 3, []int{1, 2, 3}},
+		{"2", 3, []int{2}},
+		{"1-2, 3", 5, []int{1, 2, 3}},
+		{"4-", 6, []int{4, 5, 6}},
+		{"3,1", 3, []int{3, 1}},
+	}
+
+	for _, tcase := range testcases {
+		pages, err := parsePageRanges(tcase.Spec, tcase.NumPages)
+		if err != nil {
+			t.Errorf("%q: error: %v", tcase.Spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(pages, tcase.Expected) {
+			t.Errorf("%q: %v != %v", tcase.Spec, pages, tcase.Expected)
+		}
+	}
+
+	for _, spec := range []string{"0", "a-b", "3-1", "1-10", "7-", "1,1", "1-3,2"} {
+		if _, err := parsePageRanges(spec, 5); err == nil {
+			t.Errorf("%q: should fail", spec)
+		}
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	spec, err := LoadAssemblySpec(strings.NewReader(`{
+		"inputs": [
+			{"path": "` + testPdfFile1 + `"},
+			{"path": "` + testPdfLoremIpsumFile + `", "pages": "1", "rotate": 90}
+		],
+		"stamps": [{"text": "CONFIDENTIAL", "x": 40, "y": 20, "font_size": 10, "color": "#ff0000"}],
+		"metadata": {"Title": "Assembled"}
+	}`))
+	if err != nil {
+		t.Fatalf("Error loading spec: %v", err)
+	}
+
+	outPath := "/tmp/assembly_1.pdf"
+	f, err := os.Create(outPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+
+	err = Assemble(spec, f)
+	if err != nil {
+		t.Fatalf("Error assembling: %v", err)
+	}
+
+	f.Seek(0, os.SEEK_SET)
+	reader, err := model.NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading output: %v", err)
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if numPages != 2 {
+		t.Fatalf("Expected 2 pages, got %d", numPages)
+	}
+	page, err := reader.GetPage(2)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if page.Rotate == nil || *page.Rotate%360 != 90 {
+		t.Errorf("Rotation not applied: %v", page.Rotate)
+	}
+}
+
+func TestAssembleInvalidSpec(t *testing.T) {
+	spec := &AssemblySpec{Inputs: []*AssemblyInput{{Path: testPdfFile1, Rotate: 45}}}
+	if err := Assemble(spec, nil); err == nil {
+		t.Errorf("Should fail on invalid rotation")
+	}
+}
@@ -8,6 +8,7 @@ package creator
 import (
 	"errors"
 	"io"
+	"math"
 	"os"
 
 	"github.com/unidoc/unidoc/common"
@@ -22,6 +23,10 @@ type Creator struct {
 
 	pagesize PageSize
 
+	// Size of user space units of created pages in points, greater than 1 for pages exceeding the maximum
+	// page dimension.
+	userUnit float64
+
 	context DrawContext
 
 	pageMargins margins
@@ -148,11 +153,15 @@ func (c *Creator) getActivePage() *model.PdfPage {
 // 1. 10x15 sq. mm: SetPageSize(PageSize{10*creator.PPMM, 15*creator.PPMM}) where PPMM is points per mm.
 // 2. 3x2 sq. inches: SetPageSize(PageSize{3*creator.PPI, 2*creator.PPI}) where PPI is points per inch.
 //
+// Pages larger than model.MaxPageDimension (200 inches), e.g. engineering drawings, are created with a UserUnit:
+// the page is scaled by the smallest integer factor which fits and all drawing coordinates and sizes (including
+// margins and font sizes) are in the scaled user space units.
 func (c *Creator) SetPageSize(size PageSize) {
 	c.pagesize = size
 
-	c.pageWidth = size[0]
-	c.pageHeight = size[1]
+	c.userUnit = math.Max(1, math.Ceil(math.Max(size[0], size[1])/model.MaxPageDimension))
+	c.pageWidth = size[0] / c.userUnit
+	c.pageHeight = size[1] / c.userUnit
 
 	// Update default margins to 10% of width.
 	m := 0.1 * c.pageWidth
@@ -186,11 +195,12 @@ func (c *Creator) CreateTableOfContents(genTOCFunc func(toc *TableOfContents) (*
 func (c *Creator) newPage() *model.PdfPage {
 	page := model.NewPdfPage()
 
-	width := c.pagesize[0]
-	height := c.pagesize[1]
+	width := c.pagesize[0] / c.userUnit
+	height := c.pagesize[1] / c.userUnit
 
 	bbox := model.PdfRectangle{Llx: 0, Lly: 0, Urx: width, Ury: height}
 	page.MediaBox = &bbox
+	page.SetUserUnit(c.userUnit)
 
 	c.pageWidth = width
 	c.pageHeight = height
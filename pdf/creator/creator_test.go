@@ -524,6 +524,34 @@ func TestParagraphFonts(t *testing.T) {
 	}
 }
 
+// Test writing text outside of WinAnsiEncoding with a composite font.
+func TestParagraphCompositeFont(t *testing.T) {
+	creator := New()
+
+	roboto, err := model.NewCompositePdfFontFromTTFFile(testRobotoRegularTTFFile)
+	if err != nil {
+		t.Errorf("Fail: %v\n", err)
+		return
+	}
+
+	p := NewParagraph("Съешь же ещё этих мягких французских булок. Ξεσκεπάζω την ψυχοφθόρα βδελυγμία.")
+	p.SetFont(roboto)
+	p.SetEncoder(roboto.GetEncoder())
+	p.SetFontSize(14)
+
+	err = creator.Draw(p)
+	if err != nil {
+		t.Errorf("Fail: %v\n", err)
+		return
+	}
+
+	err = creator.WriteToFile("/tmp/2_pComposite.pdf")
+	if err != nil {
+		t.Errorf("Fail: %v\n", err)
+		return
+	}
+}
+
 // Test writing with the 14 built in fonts.
 func TestParagraphStandardFonts(t *testing.T) {
 	creator := New()
@@ -1429,3 +1457,26 @@ func TestEncrypting1(t *testing.T) {
 		return
 	}
 }
+
+// Test creating a page larger than the maximum page dimension (UserUnit).
+func TestLargePageUserUnit(t *testing.T) {
+	c := New()
+	c.SetPageSize(PageSize{300 * PPI, 40 * PPI})
+	c.NewPage()
+
+	p := NewParagraph("Engineering drawing")
+	c.Draw(p)
+
+	page := c.pages[0]
+	if page.GetUserUnit() != 2 {
+		t.Fatalf("UserUnit mismatch: %f", page.GetUserUnit())
+	}
+	if c.Width() != 150*PPI || page.MediaBox.Urx != 150*PPI || page.MediaBox.Ury != 20*PPI {
+		t.Errorf("Page size mismatch: %f %+v", c.Width(), page.MediaBox)
+	}
+
+	err := c.WriteToFile("/tmp/large_page_userunit.pdf")
+	if err != nil {
+		t.Errorf("Fail: %v\n", err)
+	}
+}
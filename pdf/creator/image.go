@@ -50,6 +50,10 @@ type Image struct {
 
 	// Encoder
 	encoder core.StreamEncoder
+
+	// Use a stencil mask (hard transparency) instead of a soft mask, with the alpha threshold.
+	stencilMask          bool
+	stencilMaskThreshold byte
 }
 
 // NewImage create a new image from a unidoc image (model.Image).
@@ -114,6 +118,16 @@ func (img *Image) SetEncoder(encoder core.StreamEncoder) {
 	img.encoder = encoder
 }
 
+// SetStencilMask sets the transparency of an image with an alpha channel to be applied as a stencil mask
+// (hard transparency): pixels with an alpha value below `threshold` are fully transparent and the others fully
+// opaque. Stencil masks are supported where soft masks are not, e.g. PDF/X-1a, and suit watermarks and stamps.
+// By default the alpha channel is applied as a soft mask.
+func (img *Image) SetStencilMask(threshold byte) {
+	img.stencilMask = true
+	img.stencilMaskThreshold = threshold
+	img.xobj = nil
+}
+
 // Height returns Image's document height.
 func (img *Image) Height() float64 {
 	return img.height
@@ -157,6 +171,17 @@ func (img *Image) makeXObject() error {
 		return err
 	}
 
+	if img.stencilMask && img.img.HasAlpha() {
+		mask, err := model.NewXObjectImageMask(img.img.GetStencilMask(img.stencilMaskThreshold), encoder)
+		if err != nil {
+			common.Log.Error("Failed to create stencil mask: %s", err)
+			return err
+		}
+		if err := ximg.SetStencilMask(mask); err != nil {
+			return err
+		}
+	}
+
 	img.xobj = ximg
 	return nil
 }
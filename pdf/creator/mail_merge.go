@@ -0,0 +1,444 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/extractor"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// mailMergeToken matches the {{name}} placeholders in the text of mail merge templates.
+var mailMergeToken = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.]*)\s*\}\}`)
+
+// ReadMailMergeCSV reads the rows of a mail merge from a CSV file whose first record is a header with the names
+// of the columns. Each row maps the column names to the values of a record.
+func ReadMailMergeCSV(r io.Reader) ([]map[string]string, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to read mail merge CSV: %v", err)
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("Missing CSV header")
+	}
+
+	rows := []map[string]string{}
+	header := records[0]
+	for _, record := range records[1:] {
+		row := map[string]string{}
+		for i, name := range header {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// MailMerge fills the template document in `template` with each row of `rows` and writes the filled document of
+// row `i` (0-based) to the writer returned by `output`.
+//
+// The template has placeholders of two kinds:
+//   - {{name}} tokens in the page text, which are removed from the page contents and replaced by the value of the
+//     row. Tokens that cannot be removed, i.e. split across several strings, shown with ' or " or in form
+//     XObjects, or in fonts with multi-byte codes, are covered with a white box, but remain in the extracted text,
+//   - text fields whose fully qualified names are names of the row, whose values are set to the values of the
+//     row. The NeedAppearances flag of the form is set for viewers to regenerate the field appearances.
+//
+// `rows` is a slice of map[string]string (e.g. from ReadMailMergeCSV), map[string]interface{}, or structs (or
+// pointers to structs) whose exported fields are the values, named by their `merge` tag or otherwise by their
+// field name.
+func MailMerge(template io.ReadSeeker, rows interface{}, output func(i int) (io.WriteSeeker, error)) error {
+	data, values, err := loadMailMerge(template, rows)
+	if err != nil {
+		return err
+	}
+
+	for i, row := range values {
+		c := New()
+		form, err := mergeMailMergeRow(c, data, row, true)
+		if err != nil {
+			common.Log.Debug("ERROR: Row %d: %v", i+1, err)
+			return err
+		}
+		if form != nil {
+			c.SetForms(form)
+		}
+
+		ws, err := output(i)
+		if err != nil {
+			return err
+		}
+		err = c.Write(ws)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MailMergeConcat fills the template document in `template` with each row of `rows` as MailMerge, and writes
+// the filled documents concatenated in a single document to `ws`. As the fields of the filled documents would
+// have the same names, the values of the form field placeholders are drawn as text in their widget rectangles and
+// the output has no form: the widgets of the fields are removed from the pages.
+func MailMergeConcat(template io.ReadSeeker, rows interface{}, ws io.WriteSeeker) error {
+	data, values, err := loadMailMerge(template, rows)
+	if err != nil {
+		return err
+	}
+
+	c := New()
+	for i, row := range values {
+		_, err = mergeMailMergeRow(c, data, row, false)
+		if err != nil {
+			common.Log.Debug("ERROR: Row %d: %v", i+1, err)
+			return err
+		}
+	}
+	return c.Write(ws)
+}
+
+// loadMailMerge returns the contents of the template document and the values of `rows` by name.
+func loadMailMerge(template io.ReadSeeker, rows interface{}) ([]byte, []map[string]string, error) {
+	data, err := ioutil.ReadAll(template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return nil, nil, fmt.Errorf("Rows not a slice (%T)", rows)
+	}
+	values := []map[string]string{}
+	for i := 0; i < v.Len(); i++ {
+		row, err := getMailMergeValues(v.Index(i))
+		if err != nil {
+			return nil, nil, fmt.Errorf("Row %d: %v", i+1, err)
+		}
+		values = append(values, row)
+	}
+	return data, values, nil
+}
+
+// getMailMergeValues returns the values of mail merge row `v` by name.
+func getMailMergeValues(v reflect.Value) (map[string]string, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, errors.New("nil row")
+		}
+		v = v.Elem()
+	}
+
+	values := map[string]string{}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("Map keys not strings (%s)", v.Type())
+		}
+		for _, key := range v.MapKeys() {
+			values[key.String()] = fmt.Sprint(v.MapIndex(key).Interface())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("merge"); tag != "" {
+				name = tag
+			}
+			values[name] = fmt.Sprint(v.Field(i).Interface())
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported row type %s", v.Type())
+	}
+	return values, nil
+}
+
+// mergeMailMergeRow adds the pages of the template document `data`, filled with the values of `row`, to the
+// creator. If `fillForm` is true, the text fields of the form are filled and the form is returned, otherwise the
+// field values are drawn on the pages.
+func mergeMailMergeRow(c *Creator, data []byte, row map[string]string, fillForm bool) (*model.PdfAcroForm, error) {
+	// The template is read for each row, as its pages are modified.
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	isEncrypted, err := reader.IsEncrypted()
+	if err != nil {
+		return nil, err
+	}
+	if isEncrypted {
+		return nil, errors.New("Encrypted templates not supported")
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+
+	firstPage := len(c.pages)
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return nil, err
+		}
+
+		// The tokens are located before the page is modified.
+		e, err := extractor.New(page)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := e.FindTextRegexp(mailMergeToken)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) > 0 {
+			if err := removeMailMergeTokens(page); err != nil {
+				return nil, err
+			}
+		}
+
+		err = c.AddPage(page)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			name := mailMergeToken.FindStringSubmatch(match.Text)[1]
+			value, ok := row[name]
+			if !ok {
+				common.Log.Debug("Mail merge value %s missing, token removed", name)
+			}
+			// Token boxes span from 0.1 below the baseline to 0.7 above it, in units of the font size.
+			bbox := match.BBox
+			fontSize := (bbox.Ury - bbox.Lly) / 0.8
+			err = drawMailMergeValue(c, page, value, bbox, bbox.Lly+1.1*fontSize, fontSize, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	form := reader.AcroForm
+	if form == nil {
+		c.setActivePage(nil)
+		return nil, nil
+	}
+	for _, terminal := range form.FieldsFlattened() {
+		value, ok := row[terminal.FullName]
+		if !ok {
+			continue
+		}
+		if fillForm {
+			if !isTextField(terminal.Field) {
+				common.Log.Debug("Mail merge of non text field %s not supported", terminal.FullName)
+				continue
+			}
+			if err := setTextFieldValue(form, terminal, value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for i, widget := range terminal.Widgets {
+			pageNum := terminal.WidgetPages[i]
+			rect, err := getWidgetRect(widget)
+			if err != nil {
+				return nil, err
+			}
+			if rect == nil || pageNum < 1 || pageNum > numPages {
+				common.Log.Debug("Widget %d of %s not on a page", i, terminal.FullName)
+				continue
+			}
+			page := c.pages[firstPage+pageNum-1]
+			err = drawMailMergeValue(c, page, value, *rect, rect.Ury, 10, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	c.setActivePage(nil)
+
+	if !fillForm {
+		// The fields are not in the output, nor their widgets.
+		for _, page := range c.pages[firstPage:] {
+			annotations := []*model.PdfAnnotation{}
+			for _, annot := range page.Annotations {
+				if _, isWidget := annot.GetContext().(*model.PdfAnnotationWidget); !isWidget {
+					annotations = append(annotations, annot)
+				}
+			}
+			page.Annotations = annotations
+		}
+		return nil, nil
+	}
+	return form, nil
+}
+
+// isTextField returns true if `field` is a text field. The field type can be inherited from an ancestor.
+func isTextField(field *model.PdfField) bool {
+	ft := field.FT
+	for f := field.Parent; ft == nil && f != nil; f = f.Parent {
+		ft = f.FT
+	}
+	return ft != nil && *ft == "Tx"
+}
+
+// setTextFieldValue sets the value of text field `terminal` of `form` to `value`, and the NeedAppearances flag of
+// the form for viewers to regenerate the field appearance. Returns ErrFieldLocked if the field is locked by a
+// signature.
+func setTextFieldValue(form *model.PdfAcroForm, terminal *model.PdfTerminalField, value string) error {
+	if err := form.CheckFieldEdit(terminal.FullName); err != nil {
+		return err
+	}
+	terminal.Field.V = model.EncodeTextString(value)
+	form.NeedAppearances = core.MakeBool(true)
+	return nil
+}
+
+// getWidgetRect returns the rectangle of `widget` in the default user space of its page, with the lower left
+// corner first, or nil if the widget has no rectangle.
+func getWidgetRect(widget *model.PdfAnnotation) (*model.PdfRectangle, error) {
+	arr, ok := core.TraceToDirectObject(widget.Rect).(*core.PdfObjectArray)
+	if !ok {
+		return nil, nil
+	}
+	rect, err := model.NewPdfRectangle(*arr)
+	if err != nil {
+		return nil, err
+	}
+	if rect.Llx > rect.Urx {
+		rect.Llx, rect.Urx = rect.Urx, rect.Llx
+	}
+	if rect.Lly > rect.Ury {
+		rect.Lly, rect.Ury = rect.Ury, rect.Lly
+	}
+	return rect, nil
+}
+
+// removeMailMergeTokens removes the {{name}} tokens from the strings shown by the Tj and TJ operators of the page
+// contents, replacing them with TJ positioning values of the same width, so that the following text is not moved.
+func removeMailMergeTokens(page *model.PdfPage) error {
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		return err
+	}
+	ops, err := contentstream.NewContentStreamParser(contents).Parse()
+	if err != nil {
+		return err
+	}
+
+	removed := false
+	processor := contentstream.NewContentStreamProcessor(*ops)
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+			resources *model.PdfPageResources) error {
+			var parts core.PdfObjectArray
+			switch op.Operand {
+			case "Tj":
+				parts = op.Params
+			case "TJ":
+				if arr, ok := core.TraceToDirectObject(op.Params[0]).(*core.PdfObjectArray); ok && len(op.Params) == 1 {
+					parts = *arr
+				}
+			}
+			scale := gs.Text.FontSize * gs.Text.HorizontalScaling
+			if len(parts) == 0 || scale == 0 {
+				return nil
+			}
+
+			shown := core.PdfObjectArray{}
+			changed := false
+			for _, obj := range parts {
+				str, ok := obj.(*core.PdfObjectString)
+				if !ok {
+					shown = append(shown, obj)
+					continue
+				}
+				text := string(*str)
+				prev := 0
+				for _, loc := range mailMergeToken.FindAllStringIndex(text, -1) {
+					if loc[0] > prev {
+						shown = append(shown, core.MakeString(text[prev:loc[0]]))
+					}
+					token := &contentstream.ContentStreamOperation{
+						Operand: "Tj",
+						Params:  []core.PdfObject{core.MakeString(text[loc[0]:loc[1]])},
+					}
+					width := processor.GetTextDisplacement(token, resources)
+					shown = append(shown, core.MakeFloat(-width*1000/scale))
+					prev = loc[1]
+					changed = true
+				}
+				if prev == 0 {
+					shown = append(shown, obj)
+				} else if prev < len(text) {
+					shown = append(shown, core.MakeString(text[prev:]))
+				}
+			}
+			if changed {
+				op.Operand = "TJ"
+				op.Params = []core.PdfObject{&shown}
+				removed = true
+			}
+			return nil
+		})
+	if err := processor.Process(page.Resources); err != nil {
+		return err
+	}
+
+	if !removed {
+		return nil
+	}
+	return page.SetContentStreamsBytes([][]byte{ops.Bytes()}, core.NewFlateEncoder())
+}
+
+// drawMailMergeValue draws `value` on `page` in box `bbox` (in the page space), starting at height `top` with font
+// size `fontSize`. The box is first covered with a white rectangle if `cover` is true.
+func drawMailMergeValue(c *Creator, page *model.PdfPage, value string, bbox model.PdfRectangle, top, fontSize float64,
+	cover bool) error {
+	mbox, err := page.GetMediaBox()
+	if err != nil {
+		return err
+	}
+	c.setActivePage(page)
+	c.context.PageWidth = mbox.Urx - mbox.Llx
+	c.context.PageHeight = mbox.Ury - mbox.Lly
+
+	if cover {
+		rect := NewRectangle(bbox.Llx-mbox.Llx, mbox.Ury-bbox.Ury, bbox.Urx-bbox.Llx, bbox.Ury-bbox.Lly)
+		rect.SetFillColor(ColorWhite)
+		rect.SetBorderColor(ColorWhite)
+		rect.SetBorderWidth(0)
+		err = c.Draw(rect)
+		if err != nil {
+			return err
+		}
+	}
+	if len(value) == 0 {
+		return nil
+	}
+
+	p := NewParagraph(value)
+	p.SetFontSize(fontSize)
+	p.SetEnableWrap(false)
+	p.SetPos(bbox.Llx-mbox.Llx, mbox.Ury-top)
+	return c.Draw(p)
+}
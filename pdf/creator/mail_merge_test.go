@@ -0,0 +1,181 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/extractor"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// makeMailMergeTemplate returns a one page template with a {{name}} token and a text field "city".
+func makeMailMergeTemplate(t *testing.T) []byte {
+	c := New()
+	c.NewPage()
+	p := NewParagraph("Dear {{name}}, welcome")
+	p.SetPos(72, 72)
+	if err := c.Draw(p); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	page := c.pages[0]
+	field := model.NewPdfField()
+	field.FT = core.MakeName("Tx")
+	field.T = core.MakeString("city")
+	widget := model.NewPdfAnnotationWidget()
+	widget.Rect = core.MakeArrayFromFloats([]float64{72, 600, 272, 620})
+	widget.P = page.GetContainingPdfObject()
+	widget.Parent = field.GetContainingPdfObject()
+	field.KidsA = []*model.PdfAnnotation{widget.PdfAnnotation}
+	page.Annotations = append(page.Annotations, widget.PdfAnnotation)
+	form := model.NewPdfAcroForm()
+	form.Fields = &[]*model.PdfField{field}
+	c.SetForms(form)
+
+	return writeMailMergeTest(t, "/tmp/mail_merge_template.pdf", func(f io.WriteSeeker) error { return c.Write(f) })
+}
+
+// writeMailMergeTest writes a document to `path` with `write` and returns its contents.
+func writeMailMergeTest(t *testing.T, path string, write func(f io.WriteSeeker) error) []byte {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+	if err := write(f); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return data
+}
+
+// getMailMergeContents returns the contents of the pages of document `data`.
+func getMailMergeContents(t *testing.T, data []byte) (*model.PdfReader, []string) {
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	contents := []string{}
+	for _, page := range reader.PageList {
+		str, err := page.GetAllContentStreams()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		contents = append(contents, str)
+	}
+	return reader, contents
+}
+
+type mailMergeTestRow struct {
+	Name string `merge:"name"`
+	City string `merge:"city"`
+	age  int
+}
+
+func TestMailMerge(t *testing.T) {
+	template := makeMailMergeTemplate(t)
+	rows := []*mailMergeTestRow{{Name: "Jane", City: "Paris"}, {Name: "John", City: "Oslo"}}
+
+	var outputs []*os.File
+	err := MailMerge(bytes.NewReader(template), rows, func(i int) (io.WriteSeeker, error) {
+		f, err := os.Create(fmt.Sprintf("/tmp/mail_merge_%d.pdf", i+1))
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, f)
+		return f, nil
+	})
+	for _, f := range outputs {
+		f.Close()
+	}
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(outputs) != 2 {
+		t.Fatalf("Expected 2 outputs, got %d", len(outputs))
+	}
+
+	for i, row := range rows {
+		data, err := ioutil.ReadFile(fmt.Sprintf("/tmp/mail_merge_%d.pdf", i+1))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		reader, contents := getMailMergeContents(t, data)
+		if len(contents) != 1 || !strings.Contains(contents[0], "("+row.Name+")") {
+			t.Errorf("Row %d: name not merged in %v", i+1, contents)
+		}
+		if len(contents) == 1 && strings.Contains(contents[0], "{{name}}") {
+			t.Errorf("Row %d: token not removed", i+1)
+		}
+		text, err := extractor.New(reader.PageList[0])
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		extracted, err := text.ExtractText()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if strings.Contains(extracted, "{{name}}") || !strings.Contains(extracted, "welcome") {
+			t.Errorf("Row %d: extracted %q", i+1, extracted)
+		}
+		if reader.AcroForm == nil {
+			t.Fatalf("Row %d: form missing", i+1)
+		}
+		values := reader.AcroForm.FieldValues()
+		if values["city"] != row.City {
+			t.Errorf("Row %d: city %q != %q", i+1, values["city"], row.City)
+		}
+	}
+}
+
+func TestMailMergeConcat(t *testing.T) {
+	rows, err := ReadMailMergeCSV(strings.NewReader("name,city\nJane,Paris\nJohn,Oslo\n"))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	expected := []map[string]string{{"name": "Jane", "city": "Paris"}, {"name": "John", "city": "Oslo"}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("%v != %v", rows, expected)
+	}
+
+	template := makeMailMergeTemplate(t)
+	data := writeMailMergeTest(t, "/tmp/mail_merge_concat.pdf", func(f io.WriteSeeker) error {
+		return MailMergeConcat(bytes.NewReader(template), rows, f)
+	})
+	reader, contents := getMailMergeContents(t, data)
+	if len(contents) != 2 {
+		t.Fatalf("Expected 2 pages, got %d", len(contents))
+	}
+	for i, row := range expected {
+		for _, value := range []string{row["name"], row["city"]} {
+			if !strings.Contains(contents[i], "("+value+")") {
+				t.Errorf("Page %d: %s not merged", i+1, value)
+			}
+		}
+		if strings.Contains(contents[i], "{{name}}") {
+			t.Errorf("Page %d: token not removed", i+1)
+		}
+		if len(reader.PageList[i].Annotations) != 0 {
+			t.Errorf("Page %d: %d annotations left", i+1, len(reader.PageList[i].Annotations))
+		}
+	}
+
+	if err := MailMergeConcat(bytes.NewReader(template), "rows", nil); err == nil {
+		t.Errorf("Should fail on rows not a slice")
+	}
+}
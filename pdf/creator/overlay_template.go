@@ -0,0 +1,370 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+// Overlay element types.
+const (
+	OverlayElementText  = "text"
+	OverlayElementImage = "image"
+	OverlayElementField = "field"
+)
+
+// OverlayTemplate is a declarative description of the text boxes and images drawn over the pages of an existing
+// document, e.g. to personalize a letter or a contract for each recipient. A template can be built in code or loaded
+// from JSON with LoadOverlayTemplate, and is rendered with ApplyOverlayTemplate.
+//
+// The texts are Go text templates executed with the data passed to ApplyOverlayTemplate, e.g. "Dear {{.name}},".
+//
+// Example JSON template:
+//
+//	{
+//	  "elements": [
+//	    {"type": "text", "pages": "1", "text": "Dear {{.name}},", "x": 72, "y": 150, "font": "Times-Roman"},
+//	    {"type": "image", "pages": "1-", "image": "logo.png", "x": 450, "y": 30, "width": 100},
+//	    {"type": "field", "field": "customer.id", "text": "{{.id}}", "font_size": 9, "color": "#0000ff"}
+//	  ]
+//	}
+type OverlayTemplate struct {
+	Elements []*OverlayElement `json:"elements"`
+}
+
+// OverlayElement is an element of an overlay template.
+// The position is specified relative to the upper left corner of the page, same as for other creator drawables.
+// Position and sizes are in points, also on pages with a UserUnit.
+type OverlayElement struct {
+	Type string `json:"type"` // OverlayElementText, OverlayElementImage or OverlayElementField.
+
+	// Pages to draw the element on, as a comma separated list of page numbers and ranges, e.g. "1-3,5,9-".
+	// All pages if empty. Not used for field elements, which are drawn on the pages of the field widgets.
+	Pages string `json:"pages,omitempty"`
+
+	X float64 `json:"x,omitempty"`
+	Y float64 `json:"y,omitempty"`
+
+	// Size of the element. The text of text boxes is wrapped to the width if set. Images are scaled to the width
+	// and height, keeping the aspect ratio if only one is set.
+	Width  float64 `json:"width,omitempty"`
+	Height float64 `json:"height,omitempty"`
+
+	// Text of text and field elements, executed as a Go text template.
+	Text string `json:"text,omitempty"`
+
+	// Path of the image file (JPEG or PNG) of image elements.
+	Image string `json:"image,omitempty"`
+
+	// Fully qualified name of the form field of field elements. Text fields are filled with the text, displayed by
+	// viewers in the field appearance (font, size, color and alignment of the element are not used). The text is
+	// drawn in the widget rectangles of other fields, e.g. signature fields, ignoring the position and size of the
+	// element.
+	Field string `json:"field,omitempty"`
+
+	Font      string  `json:"font,omitempty"`      // Name of a standard 14 text font, Helvetica if empty.
+	FontSize  float64 `json:"font_size,omitempty"` // 10 if not set.
+	Color     string  `json:"color,omitempty"`     // Hex color code, e.g. #ff0000.
+	Alignment string  `json:"alignment,omitempty"` // left, right, center or justify.
+	Angle     float64 `json:"angle,omitempty"`
+}
+
+// overlayFonts are the text fonts that can be used in overlay templates, by name.
+var overlayFonts = map[string]func() fonts.Font{
+	"Courier":               func() fonts.Font { return fonts.NewFontCourier() },
+	"Courier-Bold":          func() fonts.Font { return fonts.NewFontCourierBold() },
+	"Courier-BoldOblique":   func() fonts.Font { return fonts.NewFontCourierBoldOblique() },
+	"Courier-Oblique":       func() fonts.Font { return fonts.NewFontCourierOblique() },
+	"Helvetica":             func() fonts.Font { return fonts.NewFontHelvetica() },
+	"Helvetica-Bold":        func() fonts.Font { return fonts.NewFontHelveticaBold() },
+	"Helvetica-BoldOblique": func() fonts.Font { return fonts.NewFontHelveticaBoldOblique() },
+	"Helvetica-Oblique":     func() fonts.Font { return fonts.NewFontHelveticaOblique() },
+	"Times-Bold":            func() fonts.Font { return fonts.NewFontTimesBold() },
+	"Times-BoldItalic":      func() fonts.Font { return fonts.NewFontTimesBoldItalic() },
+	"Times-Italic":          func() fonts.Font { return fonts.NewFontTimesItalic() },
+	"Times-Roman":           func() fonts.Font { return fonts.NewFontTimesRoman() },
+}
+
+// overlayAlignments are the text alignments of overlay templates, by name.
+var overlayAlignments = map[string]TextAlignment{
+	"left":    TextAlignmentLeft,
+	"right":   TextAlignmentRight,
+	"center":  TextAlignmentCenter,
+	"justify": TextAlignmentJustify,
+}
+
+// LoadOverlayTemplate loads an overlay template in JSON format from `r`.
+func LoadOverlayTemplate(r io.Reader) (*OverlayTemplate, error) {
+	tpl := &OverlayTemplate{}
+	err := json.NewDecoder(r).Decode(tpl)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to decode overlay template: %v", err)
+		return nil, err
+	}
+	return tpl, nil
+}
+
+// Validate checks the template for errors that can be detected prior to rendering it.
+func (tpl *OverlayTemplate) Validate() error {
+	for i, elem := range tpl.Elements {
+		switch elem.Type {
+		case OverlayElementText:
+		case OverlayElementImage:
+			if len(elem.Image) == 0 {
+				return fmt.Errorf("Element %d: image not specified", i+1)
+			}
+		case OverlayElementField:
+			if len(elem.Field) == 0 {
+				return fmt.Errorf("Element %d: field not specified", i+1)
+			}
+		default:
+			return fmt.Errorf("Element %d: unsupported type %q", i+1, elem.Type)
+		}
+		if _, err := parsePageRanges(elem.Pages, -1); err != nil {
+			return fmt.Errorf("Element %d: %v", i+1, err)
+		}
+		if _, ok := overlayFonts[elem.Font]; len(elem.Font) > 0 && !ok {
+			return fmt.Errorf("Element %d: unsupported font %q", i+1, elem.Font)
+		}
+		if _, ok := overlayAlignments[elem.Alignment]; len(elem.Alignment) > 0 && !ok {
+			return fmt.Errorf("Element %d: invalid alignment %q", i+1, elem.Alignment)
+		}
+		if elem.Width < 0 || elem.Height < 0 {
+			return fmt.Errorf("Element %d: negative size", i+1)
+		}
+		if _, err := template.New("").Parse(elem.Text); err != nil {
+			return fmt.Errorf("Element %d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+// ApplyOverlayTemplate renders `tpl` over the pages of the document in `rs` and writes the output document, with
+// the form of the input document, to `ws`. The texts of the elements are executed with `data`, e.g. a
+// map[string]string or a struct with the values of a record.
+func ApplyOverlayTemplate(tpl *OverlayTemplate, rs io.ReadSeeker, data interface{}, ws io.WriteSeeker) error {
+	err := tpl.Validate()
+	if err != nil {
+		common.Log.Debug("ERROR: Invalid overlay template: %v", err)
+		return err
+	}
+
+	reader, err := model.NewPdfReader(rs)
+	if err != nil {
+		return err
+	}
+	isEncrypted, err := reader.IsEncrypted()
+	if err != nil {
+		return err
+	}
+	if isEncrypted {
+		return errors.New("Encrypted documents not supported")
+	}
+
+	c := New()
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return err
+	}
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return err
+		}
+		err = c.AddPage(page)
+		if err != nil {
+			return err
+		}
+	}
+	if reader.AcroForm != nil {
+		c.SetForms(reader.AcroForm)
+	}
+
+	for i, elem := range tpl.Elements {
+		err = drawOverlayElement(c, elem, reader.AcroForm, data)
+		if err != nil {
+			common.Log.Debug("ERROR: Element %d: %v", i+1, err)
+			return err
+		}
+	}
+	c.setActivePage(nil)
+
+	return c.Write(ws)
+}
+
+// overlayPlacement is the position and size of an overlay element on a page, in points from the upper left corner.
+type overlayPlacement struct {
+	pageNum             int
+	x, y, width, height float64
+}
+
+// drawOverlayElement draws `elem` on the pages of the creator, or fills the form fields of `form`.
+func drawOverlayElement(c *Creator, elem *OverlayElement, form *model.PdfAcroForm, data interface{}) error {
+	text := ""
+	if elem.Type != OverlayElementImage {
+		tmpl, err := template.New("").Parse(elem.Text)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		err = tmpl.Execute(&buf, data)
+		if err != nil {
+			return err
+		}
+		text = buf.String()
+	}
+
+	var placements []overlayPlacement
+	var err error
+	if elem.Type == OverlayElementField {
+		placements, err = fillOverlayField(c, elem.Field, form, text)
+	} else {
+		placements, err = getOverlayPlacements(c, elem)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, pl := range placements {
+		page := c.pages[pl.pageNum-1]
+		mbox, err := page.GetMediaBox()
+		if err != nil {
+			return err
+		}
+
+		// Elements have the same physical size on pages with a UserUnit.
+		scale := 1 / page.GetUserUnit()
+
+		var d Drawable
+		if elem.Type == OverlayElementImage {
+			img, err := NewImageFromFile(elem.Image)
+			if err != nil {
+				return err
+			}
+			switch {
+			case pl.width > 0 && pl.height > 0:
+				img.SetWidth(pl.width * scale)
+				img.SetHeight(pl.height * scale)
+			case pl.width > 0:
+				img.ScaleToWidth(pl.width * scale)
+			case pl.height > 0:
+				img.ScaleToHeight(pl.height * scale)
+			}
+			img.SetAngle(elem.Angle)
+			img.SetPos(pl.x*scale, pl.y*scale)
+			d = img
+		} else {
+			p := NewParagraph(text)
+			if font, ok := overlayFonts[elem.Font]; ok {
+				p.SetFont(font())
+				// Sync the encoder of the new font.
+				p.SetEncoder(p.encoder)
+			}
+			if elem.FontSize > 0 {
+				p.SetFontSize(elem.FontSize * scale)
+			} else {
+				p.SetFontSize(p.fontSize * scale)
+			}
+			if len(elem.Color) > 0 {
+				p.SetColor(ColorRGBFromHex(elem.Color))
+			}
+			if align, ok := overlayAlignments[elem.Alignment]; ok {
+				p.SetTextAlignment(align)
+			}
+			if pl.width > 0 {
+				p.SetEnableWrap(true)
+				p.SetWidth(pl.width * scale)
+			}
+			p.SetAngle(elem.Angle)
+			p.SetPos(pl.x*scale, pl.y*scale)
+			d = p
+		}
+
+		c.setActivePage(page)
+		c.context.PageWidth = mbox.Urx - mbox.Llx
+		c.context.PageHeight = mbox.Ury - mbox.Lly
+		err = c.Draw(d)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOverlayPlacements returns the placements of text or image element `elem` at its position on the selected
+// pages of the creator.
+func getOverlayPlacements(c *Creator, elem *OverlayElement) ([]overlayPlacement, error) {
+	pageNums, err := parsePageRanges(elem.Pages, len(c.pages))
+	if err != nil {
+		return nil, err
+	}
+	placements := []overlayPlacement{}
+	for _, pageNum := range pageNums {
+		placements = append(placements, overlayPlacement{pageNum, elem.X, elem.Y, elem.Width, elem.Height})
+	}
+	return placements, nil
+}
+
+// fillOverlayField sets the value of field `name` of `form` to `text` if it is a text field. Returns the placements
+// of the text in the widget rectangles of other fields.
+func fillOverlayField(c *Creator, name string, form *model.PdfAcroForm, text string) ([]overlayPlacement, error) {
+	if form == nil {
+		return nil, fmt.Errorf("Field %q not found", name)
+	}
+
+	found := false
+	placements := []overlayPlacement{}
+	for _, terminal := range form.FieldsFlattened() {
+		if terminal.FullName != name {
+			continue
+		}
+		found = true
+		if isTextField(terminal.Field) {
+			if err := setTextFieldValue(form, terminal, text); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for i, widget := range terminal.Widgets {
+			pageNum := terminal.WidgetPages[i]
+			rect, err := getWidgetRect(widget)
+			if err != nil {
+				return nil, err
+			}
+			if rect == nil || pageNum < 1 || pageNum > len(c.pages) {
+				common.Log.Debug("Widget %d of %s not on a page", i, terminal.FullName)
+				continue
+			}
+			mbox, err := c.pages[pageNum-1].GetMediaBox()
+			if err != nil {
+				return nil, err
+			}
+			unit := c.pages[pageNum-1].GetUserUnit()
+			placements = append(placements, overlayPlacement{
+				pageNum: pageNum,
+				x:       (rect.Llx - mbox.Llx) * unit,
+				y:       (mbox.Ury - rect.Ury) * unit,
+				width:   (rect.Urx - rect.Llx) * unit,
+				height:  (rect.Ury - rect.Lly) * unit,
+			})
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("Field %q not found", name)
+	}
+	return placements, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// makeOverlayTestPdf returns a one page document with a signature field "approver" and a text field "reference".
+func makeOverlayTestPdf(t *testing.T) []byte {
+	page := model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+	page.Resources = model.NewPdfPageResources()
+
+	form := model.NewPdfAcroForm()
+	if _, err := form.AddSignatureField("approver", page, model.PdfRectangle{Llx: 400, Lly: 50, Urx: 550, Ury: 100}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	field := model.NewPdfField()
+	field.FT = core.MakeName("Tx")
+	field.T = core.MakeString("reference")
+	widget := model.NewPdfAnnotationWidget()
+	widget.Rect = core.MakeArrayFromFloats([]float64{72, 600, 272, 620})
+	widget.P = page.GetContainingPdfObject()
+	widget.Parent = field.GetContainingPdfObject()
+	field.KidsA = []*model.PdfAnnotation{widget.PdfAnnotation}
+	page.Annotations = append(page.Annotations, widget.PdfAnnotation)
+	*form.Fields = append(*form.Fields, field)
+
+	w := model.NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := w.SetForms(form); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	outPath := "/tmp/overlay_template_input.pdf"
+	f, err := os.Create(outPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(f); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestApplyOverlayTemplate(t *testing.T) {
+	tpl, err := LoadOverlayTemplate(strings.NewReader(`{
+		"elements": [
+			{"type": "text", "text": "Dear {{.name}},", "x": 72, "y": 100, "font": "Times-Roman", "width": 300},
+			{"type": "image", "image": "` + testImageFile1 + `", "x": 450, "y": 30, "width": 100},
+			{"type": "field", "field": "approver", "text": "Approved by {{.approver}}", "color": "#0000ff"},
+			{"type": "field", "field": "reference", "text": "Ref. {{.ref}}"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Error loading template: %v", err)
+	}
+
+	outPath := "/tmp/overlay_template_1.pdf"
+	f, err := os.Create(outPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+
+	data := map[string]string{"name": "Jane Doe", "approver": "John Smith", "ref": "4711"}
+	err = ApplyOverlayTemplate(tpl, bytes.NewReader(makeOverlayTestPdf(t)), data, f)
+	if err != nil {
+		t.Fatalf("Error applying template: %v", err)
+	}
+
+	f.Seek(0, os.SEEK_SET)
+	reader, err := model.NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading output: %v", err)
+	}
+	if reader.AcroForm == nil {
+		t.Fatalf("Form not kept")
+	}
+	if value := reader.AcroForm.FieldValues()["reference"]; value != "Ref. 4711" {
+		t.Errorf("Text field value %q", value)
+	}
+	if needAppearances := reader.AcroForm.NeedAppearances; needAppearances == nil || !bool(*needAppearances) {
+		t.Errorf("NeedAppearances not set")
+	}
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	for _, text := range []string{"(Jane)", "(Smith)", "/Img1 Do"} {
+		if !strings.Contains(contents, text) {
+			t.Errorf("Missing %q in contents: %s", text, contents)
+		}
+	}
+	if strings.Contains(contents, "4711") {
+		t.Errorf("Text field value drawn in contents: %s", contents)
+	}
+}
+
+func TestOverlayTemplateValidate(t *testing.T) {
+	invalid := []*OverlayElement{
+		{Type: "barcode"},
+		{Type: OverlayElementImage},
+		{Type: OverlayElementField},
+		{Type: OverlayElementText, Pages: "0"},
+		{Type: OverlayElementText, Font: "Arial"},
+		{Type: OverlayElementText, Alignment: "middle"},
+		{Type: OverlayElementText, Text: "{{.name"},
+	}
+	for _, elem := range invalid {
+		tpl := &OverlayTemplate{Elements: []*OverlayElement{elem}}
+		if err := tpl.Validate(); err == nil {
+			t.Errorf("%+v: should fail", elem)
+		}
+	}
+
+	tpl := &OverlayTemplate{Elements: []*OverlayElement{{Type: OverlayElementField, Field: "missing"}}}
+	if err := ApplyOverlayTemplate(tpl, bytes.NewReader(makeOverlayTestPdf(t)), nil, nil); err == nil {
+		t.Errorf("Should fail on missing field")
+	}
+}
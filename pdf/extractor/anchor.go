@@ -0,0 +1,53 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"strings"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// FindText returns the bounding boxes of the occurrences of `text` on the page, in reading order, e.g. to
+// position a signature field next to a "Signature:" label. `text` is matched word by word against the tokens of
+// ExtractIndexTokens, so whitespace differences are ignored. The bounding boxes are in the same space as the
+// annotation rectangles of the page.
+func (e *Extractor) FindText(text string) ([]model.PdfRectangle, error) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	tokens, err := e.extractIndexTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []model.PdfRectangle
+	for i := 0; i+len(words) <= len(tokens); i++ {
+		match := true
+		for k, word := range words {
+			if tokens[i+k].Text != word {
+				match = false
+				break
+			}
+		}
+		if !match {
+			continue
+		}
+		bbox := tokens[i].BBox
+		for _, token := range tokens[i+1 : i+len(words)] {
+			bbox.Llx = math.Min(bbox.Llx, token.BBox.Llx)
+			bbox.Lly = math.Min(bbox.Lly, token.BBox.Lly)
+			bbox.Urx = math.Max(bbox.Urx, token.BBox.Urx)
+			bbox.Ury = math.Max(bbox.Ury, token.BBox.Ury)
+		}
+		matches = append(matches, bbox)
+		i += len(words) - 1
+	}
+	return matches, nil
+}
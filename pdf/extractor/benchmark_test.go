@@ -0,0 +1,56 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// benchmarkCorpus are the bundled files the extraction benchmarks run on.
+var benchmarkCorpus = []string{
+	"../../testfiles/minimal.pdf",
+	"../../testfiles/templates1.pdf",
+	"../../testfiles/lorem.pdf",
+}
+
+func BenchmarkExtractText(b *testing.B) {
+	isTesting = true
+	for _, path := range benchmarkCorpus {
+		f, err := os.Open(path)
+		if err != nil {
+			b.Fatalf("Error: %v", err)
+		}
+		defer f.Close()
+		reader, err := model.NewPdfReader(f)
+		if err != nil {
+			b.Fatalf("Error reading %s: %v", path, err)
+		}
+
+		extractors := []*Extractor{}
+		for _, page := range reader.PageList {
+			e, err := New(page)
+			if err != nil {
+				b.Fatalf("Error: %v", err)
+			}
+			extractors = append(extractors, e)
+		}
+
+		b.Run(filepath.Base(path), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				for _, e := range extractors {
+					if _, err := e.ExtractText(); err != nil {
+						b.Fatalf("Error: %v", err)
+					}
+				}
+			}
+		})
+	}
+}
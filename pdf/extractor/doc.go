@@ -5,6 +5,7 @@
 
 //
 // Package extractor is used for quickly extracting PDF content through a simple interface.
-// Currently offers functionality for extracting textual content.
+// Currently offers functionality for extracting textual content, the Unicode scripts used and estimating ink
+// coverage.
 //
 package extractor
@@ -11,6 +11,9 @@ import "github.com/unidoc/unidoc/pdf/model"
 type Extractor struct {
 	contents  string
 	resources *model.PdfPageResources
+
+	// Visible area of the page (CropBox, or MediaBox if not set). Nil if not defined.
+	pageBox *model.PdfRectangle
 }
 
 // New returns an Extractor instance for extracting content from the input PDF page.
@@ -19,10 +22,19 @@ func New(page *model.PdfPage) (*Extractor, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newExtractor(page, contents), nil
+}
 
+// newExtractor returns an Extractor for `page` with the already decoded `contents`.
+func newExtractor(page *model.PdfPage, contents string) *Extractor {
 	e := &Extractor{}
 	e.contents = contents
 	e.resources = page.Resources
 
-	return e, nil
+	e.pageBox = page.CropBox
+	if e.pageBox == nil {
+		e.pageBox, _ = page.GetMediaBox()
+	}
+
+	return e
 }
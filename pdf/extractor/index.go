@@ -0,0 +1,162 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"sort"
+	"unicode"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// IndexToken is a word of page text with its location, for feeding search indexes.
+type IndexToken struct {
+	Text     string
+	Position int                // Index of the token on its page, in reading order.
+	BBox     model.PdfRectangle // Bounding box in device space.
+}
+
+// IndexPageFunc is called by BuildTextIndex with the tokens of page `pageNum` (1-based).
+// Returning an error stops the indexing.
+type IndexPageFunc func(pageNum int, tokens []IndexToken) error
+
+// BuildTextIndex walks the pages of `reader` in order and passes the tokens of each page to `fn`. Only the
+// tokens of the current page are held in memory, so this is suited for indexing very large documents.
+// Returns the first error encountered, either while extracting or returned by `fn`.
+func BuildTextIndex(reader *model.PdfReader, fn IndexPageFunc) error {
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return err
+	}
+
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return err
+		}
+		e, err := New(page)
+		if err != nil {
+			return err
+		}
+		tokens, err := e.ExtractIndexTokens()
+		if err != nil {
+			common.Log.Debug("ERROR: Extracting tokens from page %d failed: %v", pageNum, err)
+			return err
+		}
+		if err := fn(pageNum, tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractIndexTokens returns the whitespace separated words of the page text in reading order (as determined
+// by recursive XY-cut), with their bounding boxes. Words shown by several text showing operations without a
+// gap in between are merged into a single token.
+func (e *Extractor) ExtractIndexTokens() ([]IndexToken, error) {
+	tokens, err := e.extractIndexTokens()
+	if err != nil {
+		return nil, err
+	}
+	return procTokens(tokens), nil
+}
+
+// extractIndexTokens returns the tokens of ExtractIndexTokens without applying the license restrictions, for
+// functions which do not return the text.
+func (e *Extractor) extractIndexTokens() ([]IndexToken, error) {
+	cstreamParser := contentstream.NewContentStreamParser(e.contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	spaceThreshold := TextOptions{}.spaceThreshold()
+	fragments, err := collectTextFragments(*operations, e.resources, spaceThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	b := tokenBuilder{}
+	for _, line := range xyCut(fragments) {
+		sort.SliceStable(line, func(i, j int) bool { return line[i].bbox.Llx < line[j].bbox.Llx })
+		for i, f := range line {
+			if i == 0 || fragmentsSeparated(line[i-1], f, spaceThreshold) {
+				b.flush()
+			}
+			b.addFragment(f)
+		}
+		b.flush()
+	}
+	return b.tokens, nil
+}
+
+// procTokens applies the license restrictions of procBuf to `tokens`: the trailing tokens may be truncated and the
+// watermark is added as last token.
+func procTokens(tokens []IndexToken) []IndexToken {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Text
+	}
+	kept, watermark := procTexts(texts)
+	if watermark == "" {
+		return tokens
+	}
+	tokens = tokens[:len(kept)]
+	if n := len(kept); n > 0 {
+		tokens[n-1].Text = kept[n-1]
+	}
+	return append(tokens, IndexToken{Text: watermark, Position: len(tokens)})
+}
+
+// tokenBuilder splits the text of fragments into tokens.
+type tokenBuilder struct {
+	tokens  []IndexToken
+	current []rune
+	bbox    model.PdfRectangle
+}
+
+// addFragment adds the words of `f` to the tokens, continuing the current token if `f` does not start with
+// whitespace. The bounding boxes of the words are interpolated from the fragment's bounding box assuming
+// equally wide glyphs.
+func (b *tokenBuilder) addFragment(f *textFragment) {
+	runes := []rune(f.text)
+	width := (f.bbox.Urx - f.bbox.Llx) / float64(len(runes))
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			b.flush()
+			continue
+		}
+		bbox := model.PdfRectangle{
+			Llx: f.bbox.Llx + float64(i)*width,
+			Lly: f.bbox.Lly,
+			Urx: f.bbox.Llx + float64(i+1)*width,
+			Ury: f.bbox.Ury,
+		}
+		if len(b.current) == 0 {
+			b.bbox = bbox
+		} else {
+			b.bbox = model.PdfRectangle{
+				Llx: math.Min(b.bbox.Llx, bbox.Llx),
+				Lly: math.Min(b.bbox.Lly, bbox.Lly),
+				Urx: math.Max(b.bbox.Urx, bbox.Urx),
+				Ury: math.Max(b.bbox.Ury, bbox.Ury),
+			}
+		}
+		b.current = append(b.current, r)
+	}
+}
+
+// flush ends the current token, if any.
+func (b *tokenBuilder) flush() {
+	if len(b.current) == 0 {
+		return
+	}
+	b.tokens = append(b.tokens, IndexToken{Text: string(b.current), Position: len(b.tokens), BBox: b.bbox})
+	b.current = nil
+}
@@ -0,0 +1,163 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestExtractIndexTokens(t *testing.T) {
+	isTesting = true
+	e := Extractor{}
+	e.contents = testContentsColumns
+
+	tokens, err := e.ExtractIndexTokens()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	expected := []string{"Title", "of", "the", "paper", "Left", "one", "Left", "two", "Left", "three",
+		"Right", "one", "Right", "two"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Got %d tokens, expected %d: %v", len(tokens), len(expected), tokens)
+	}
+	for i, token := range tokens {
+		if token.Text != expected[i] || token.Position != i {
+			t.Errorf("Token %d: %q at %d, expected %q", i, token.Text, token.Position, expected[i])
+		}
+	}
+
+	// "Title" starts the title line at x=100, y=700 with font size 20.
+	title := tokens[0].BBox
+	if title.Llx != 100 || title.Lly >= 700 || title.Ury <= 700 || title.Urx <= title.Llx {
+		t.Errorf("Unexpected bbox of first token: %+v", title)
+	}
+	// "Right" tokens are in the right column.
+	if tokens[10].BBox.Llx != 300 {
+		t.Errorf("Unexpected bbox of right column token: %+v", tokens[10].BBox)
+	}
+}
+
+func TestExtractIndexTokensMergeAdjacent(t *testing.T) {
+	e := Extractor{}
+	// Word split over two text showing operations without a gap in between.
+	e.contents = `BT /F1 10 Tf 1 0 0 1 50 700 Tm (Hyper)Tj (link text)Tj ET`
+
+	tokens, err := e.ExtractIndexTokens()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(tokens) != 2 || tokens[0].Text != "Hyperlink" || tokens[1].Text != "text" {
+		t.Fatalf("Unexpected tokens: %v", tokens)
+	}
+	if tokens[0].BBox.Llx != 50 || tokens[0].BBox.Urx <= tokens[0].BBox.Llx {
+		t.Errorf("Unexpected bboxes: %+v", tokens)
+	}
+}
+
+func TestBuildTextIndex(t *testing.T) {
+	f, err := os.Open("../../testfiles/lorem.pdf")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+	reader, err := model.NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	pages := []int{}
+	found := false
+	err = BuildTextIndex(reader, func(pageNum int, tokens []IndexToken) error {
+		pages = append(pages, pageNum)
+		for _, token := range tokens {
+			if strings.Contains(token.Text, "Lorem") {
+				found = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(pages) != numPages || pages[0] != 1 {
+		t.Errorf("Unexpected pages passed: %v", pages)
+	}
+	if !found {
+		t.Errorf("Expected a Lorem token")
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = BuildTextIndex(reader, func(pageNum int, tokens []IndexToken) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("Expected indexing to stop after first page (err %v, calls %d)", err, calls)
+	}
+}
+
+func TestFindText(t *testing.T) {
+	e := Extractor{}
+	e.contents = `BT /F1 10 Tf 1 0 0 1 50 100 Tm (Name:) Tj 1 0 0 1 50 60 Tm (Signature:) Tj ET`
+
+	if matches, err := e.FindText("Signature:"); err != nil || len(matches) != 1 || matches[0].Llx != 50 ||
+		matches[0].Lly >= 60 || matches[0].Ury <= 60 {
+		t.Errorf("Unexpected matches %v (%v)", matches, err)
+	}
+	if matches, err := e.FindText("Date:"); err != nil || len(matches) != 0 {
+		t.Errorf("Unexpected matches %v (%v)", matches, err)
+	}
+}
+
+func TestExtractIndexTokensUnlicensed(t *testing.T) {
+	isTesting = false
+	defer func() { isTesting = true }()
+
+	e := Extractor{}
+	e.contents = `BT /F1 10 Tf 1 0 0 1 50 700 Tm
+(Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et )Tj
+(dolore magna aliqua. Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip)Tj ET`
+
+	tokens, err := e.ExtractIndexTokens()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	last := tokens[len(tokens)-1]
+	if !strings.Contains(last.Text, "Unlicensed") || len(tokens) > 25 {
+		t.Errorf("Tokens not truncated: %v", tokens)
+	}
+	matches, err := e.FindTextRegexp(regexp.MustCompile("aliquip"))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("Truncated text found: %v", matches)
+	}
+
+	analysis, err := e.ExtractTextAnalysis()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	text := ""
+	for _, run := range analysis.Runs {
+		text += run.Text
+	}
+	if strings.Contains(text, "aliquip") || !strings.Contains(text, "Unlicensed") {
+		t.Errorf("Runs not truncated: %q", text)
+	}
+}
@@ -0,0 +1,459 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+const (
+	// Size of the cells of the grid on which the page is sampled for ink coverage estimation, in points.
+	inkCoverageCellSize = 2.0
+
+	// Maximum number of cells of the grid. The cells are enlarged for pages too large for this at 2 points.
+	inkCoverageMaxCells = 1 << 20
+
+	// Fraction of the area covered by shown text which is actually inked by the glyphs.
+	textInkDensity = 0.3
+)
+
+// InkCoverage is the estimated ink coverage of a page for each of the process colors, as a fraction of the page
+// area in the range [0,1], e.g. 0.05 is 5% coverage.
+type InkCoverage struct {
+	C float64
+	M float64
+	Y float64
+	K float64
+}
+
+// Total returns the total ink coverage, i.e. the sum of the coverage of all process colors (up to 4.0).
+func (ic InkCoverage) Total() float64 {
+	return ic.C + ic.M + ic.Y + ic.K
+}
+
+// InkCartridge describes the cost and yield of a cartridge (or toner) of a process color.
+type InkCartridge struct {
+	Price float64 // Price of the cartridge.
+	Yield float64 // Number of pages the cartridge prints at YieldCoverage.
+
+	// Coverage at which the yield is rated, typically 0.05 (5%) as per ISO/IEC 19752 and 24711.
+	YieldCoverage float64
+}
+
+// costPerPage returns the cost of printing a page with coverage `coverage`.
+func (cartridge InkCartridge) costPerPage(coverage float64) float64 {
+	if cartridge.Yield <= 0 || cartridge.YieldCoverage <= 0 {
+		return 0
+	}
+	return cartridge.Price / cartridge.Yield * coverage / cartridge.YieldCoverage
+}
+
+// InkCostModel is used to estimate the ink cost of printing pages from their ink coverage.
+type InkCostModel struct {
+	Cyan    InkCartridge
+	Magenta InkCartridge
+	Yellow  InkCartridge
+	Black   InkCartridge
+}
+
+// PageCost returns the estimated ink cost of printing a page with ink coverage `coverage`.
+func (m InkCostModel) PageCost(coverage InkCoverage) float64 {
+	return m.Cyan.costPerPage(coverage.C) + m.Magenta.costPerPage(coverage.M) +
+		m.Yellow.costPerPage(coverage.Y) + m.Black.costPerPage(coverage.K)
+}
+
+// ExtractInkCoverage estimates the CMYK ink coverage of the page, as used for toner and print-cost estimation.
+//
+// The page is sampled on a grid with a resolution of 2 points (coarser for pages over about 2000x2000 points), onto which the page content is painted in
+// content stream order. Later paint replaces earlier paint, so overlapping objects are not counted twice.
+// Filled paths are approximated by their bounding boxes and stroked paths by bands along the edges of their
+// bounding boxes, text is counted as a fraction of the area it covers, and images are sampled pixel by pixel.
+// Colors are converted to CMYK with the device-independent conversion (via RGB for other than device color
+// spaces). Transparency, overprinting, patterns and shadings are not taken into account.
+func (e *Extractor) ExtractInkCoverage() (*InkCoverage, error) {
+	if e.pageBox == nil {
+		return nil, errors.New("Page box not defined")
+	}
+	box := *e.pageBox
+	w, h := box.Urx-box.Llx, box.Ury-box.Lly
+	if !(w > 0 && h > 0) || math.IsInf(w, 0) || math.IsInf(h, 0) {
+		common.Log.Debug("ERROR: Invalid page box: %+v", box)
+		return nil, errors.New("Invalid page box")
+	}
+
+	cstreamParser := contentstream.NewContentStreamParser(e.contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := newInkCanvas(box, inkCoverageCellSize, inkCoverageMaxCells)
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.SetFormXObjectRecursion(true)
+
+	processor.AddHandler(contentstream.HandlerConditionEnumPath, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			bbox, ok := processor.CurrentPathBBox()
+			if !ok {
+				return nil
+			}
+			switch op.Operand {
+			case "f", "F", "f*":
+				canvas.paintColor(bbox, gs.ClipBox, 1.0, gs.ColorspaceNonStroking, gs.ColorNonStroking)
+			case "S", "s":
+				canvas.paintStroke(bbox, gs)
+			case "B", "B*", "b", "b*":
+				canvas.paintColor(bbox, gs.ClipBox, 1.0, gs.ColorspaceNonStroking, gs.ColorNonStroking)
+				canvas.paintStroke(bbox, gs)
+			}
+			return nil
+		})
+
+	processor.AddHandler(contentstream.HandlerConditionEnumText, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			scale := gs.Text.FontSize * gs.Text.HorizontalScaling
+			if scale == 0 {
+				return nil
+			}
+			// Extent of the shown text in glyph space: from the descent to the ascent of a typical font.
+			width := processor.GetTextDisplacement(op, resources) / scale
+			bbox := gs.TextRenderingMatrix().TransformRect(model.PdfRectangle{Llx: 0, Lly: -0.2, Urx: width, Ury: 0.8})
+
+			switch gs.Text.RenderMode {
+			case 0, 4:
+				canvas.paintColor(bbox, gs.ClipBox, textInkDensity, gs.ColorspaceNonStroking, gs.ColorNonStroking)
+			case 1, 5:
+				canvas.paintColor(bbox, gs.ClipBox, textInkDensity, gs.ColorspaceStroking, gs.ColorStroking)
+			case 2, 6:
+				canvas.paintColor(bbox, gs.ClipBox, textInkDensity, gs.ColorspaceNonStroking, gs.ColorNonStroking)
+				canvas.paintColor(bbox, gs.ClipBox, textInkDensity/2, gs.ColorspaceStroking, gs.ColorStroking)
+			}
+			return nil
+		})
+
+	processor.AddHandler(contentstream.HandlerConditionEnumImage, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			img, err := loadInkImage(op, gs, resources)
+			if err != nil {
+				// Skip images which cannot be decoded rather than failing the whole page.
+				common.Log.Debug("Unable to load image for ink coverage: %v", err)
+				return nil
+			}
+			canvas.paintImage(img, gs.CTM, gs.ClipBox)
+			return nil
+		})
+
+	err = processor.Process(e.resources)
+	if err != nil {
+		common.Log.Debug("ERROR: Processing failed: %v", err)
+		return nil, err
+	}
+
+	return canvas.coverage(), nil
+}
+
+// inkCanvas is a grid of cells covering the page, each holding the CMYK ink painted on it.
+type inkCanvas struct {
+	box        model.PdfRectangle
+	cellSize   float64
+	cols, rows int
+	cells      [][4]float64
+}
+
+// newInkCanvas returns a canvas covering `box` with cells of `cellSize`, or larger cells if more than `maxCells`
+// would be needed.
+func newInkCanvas(box model.PdfRectangle, cellSize float64, maxCells int) *inkCanvas {
+	w, h := box.Urx-box.Llx, box.Ury-box.Lly
+	cellSize = math.Max(cellSize, math.Sqrt(w*h/float64(maxCells)))
+	cellSize = math.Max(cellSize, math.Max(w, h)/float64(maxCells))
+	for math.Ceil(w/cellSize)*math.Ceil(h/cellSize) > float64(maxCells) {
+		cellSize *= 1.1
+	}
+
+	canvas := &inkCanvas{box: box, cellSize: cellSize}
+	canvas.cols = int(math.Max(1, math.Ceil(w/cellSize)))
+	canvas.rows = int(math.Max(1, math.Ceil(h/cellSize)))
+	canvas.cells = make([][4]float64, canvas.cols*canvas.rows)
+	return canvas
+}
+
+// coverage returns the average ink coverage over all cells.
+func (canvas *inkCanvas) coverage() *InkCoverage {
+	var sum [4]float64
+	for _, cell := range canvas.cells {
+		for k := range sum {
+			sum[k] += cell[k]
+		}
+	}
+	n := float64(len(canvas.cells))
+	return &InkCoverage{C: sum[0] / n, M: sum[1] / n, Y: sum[2] / n, K: sum[3] / n}
+}
+
+// paint paints the cells whose centers lie within `rect` (device space) and the clipping box `clip`.
+// The ink of each cell is obtained from `ink` called with the cell center; cells for which it returns false are
+// left unchanged. The ink is composited with coverage `alpha` in [0,1].
+// Shapes thinner than a cell are widened to the cell size, with the coverage reduced accordingly.
+func (canvas *inkCanvas) paint(rect model.PdfRectangle, clip *model.PdfRectangle, alpha float64,
+	ink func(x, y float64) ([4]float64, bool)) {
+	if clip != nil {
+		rect.Llx = math.Max(rect.Llx, clip.Llx)
+		rect.Lly = math.Max(rect.Lly, clip.Lly)
+		rect.Urx = math.Min(rect.Urx, clip.Urx)
+		rect.Ury = math.Min(rect.Ury, clip.Ury)
+	}
+	w, h := rect.Urx-rect.Llx, rect.Ury-rect.Lly
+	if w < 0 || h < 0 || alpha <= 0 {
+		return
+	}
+	if w < canvas.cellSize {
+		cx := (rect.Llx + rect.Urx) / 2
+		rect.Llx, rect.Urx = cx-canvas.cellSize/2, cx+canvas.cellSize/2
+		alpha *= w / canvas.cellSize
+	}
+	if h < canvas.cellSize {
+		cy := (rect.Lly + rect.Ury) / 2
+		rect.Lly, rect.Ury = cy-canvas.cellSize/2, cy+canvas.cellSize/2
+		alpha *= h / canvas.cellSize
+	}
+	alpha = math.Min(alpha, 1.0)
+
+	i0 := int(math.Max(0, math.Ceil((rect.Llx-canvas.box.Llx)/canvas.cellSize-0.5)))
+	i1 := int(math.Min(float64(canvas.cols-1), math.Floor((rect.Urx-canvas.box.Llx)/canvas.cellSize-0.5)))
+	j0 := int(math.Max(0, math.Ceil((rect.Lly-canvas.box.Lly)/canvas.cellSize-0.5)))
+	j1 := int(math.Min(float64(canvas.rows-1), math.Floor((rect.Ury-canvas.box.Lly)/canvas.cellSize-0.5)))
+
+	for j := j0; j <= j1; j++ {
+		y := canvas.box.Lly + (float64(j)+0.5)*canvas.cellSize
+		for i := i0; i <= i1; i++ {
+			x := canvas.box.Llx + (float64(i)+0.5)*canvas.cellSize
+			val, ok := ink(x, y)
+			if !ok {
+				continue
+			}
+			cell := &canvas.cells[j*canvas.cols+i]
+			for k := range cell {
+				cell[k] = cell[k]*(1-alpha) + val[k]*alpha
+			}
+		}
+	}
+}
+
+// paintColor paints `rect` with the color `color` in colorspace `cs`.
+func (canvas *inkCanvas) paintColor(rect model.PdfRectangle, clip *model.PdfRectangle, alpha float64,
+	cs model.PdfColorspace, color model.PdfColor) {
+	cmyk, ok := colorToCMYK(cs, color)
+	if !ok {
+		return
+	}
+	canvas.paint(rect, clip, alpha, func(x, y float64) ([4]float64, bool) {
+		return cmyk, true
+	})
+}
+
+// paintStroke paints the stroke of a path with bounding box `bbox`, approximated by bands along its edges.
+func (canvas *inkCanvas) paintStroke(bbox model.PdfRectangle, gs contentstream.GraphicsState) {
+	ctm := gs.CTM
+	// Line width in device space, a width of 0 denotes the thinnest line that can be rendered.
+	lw := gs.LineWidth * math.Sqrt(math.Abs(ctm[0]*ctm[3]-ctm[1]*ctm[2]))
+	lw = math.Max(lw, 0.25)
+	hw := lw / 2
+
+	bands := []model.PdfRectangle{
+		{Llx: bbox.Llx - hw, Lly: bbox.Lly - hw, Urx: bbox.Urx + hw, Ury: bbox.Lly + hw},
+		{Llx: bbox.Llx - hw, Lly: bbox.Ury - hw, Urx: bbox.Urx + hw, Ury: bbox.Ury + hw},
+	}
+	if bbox.Ury-bbox.Lly > lw {
+		// Vertical edges, excluding the corners covered by the horizontal bands.
+		bands = append(bands,
+			model.PdfRectangle{Llx: bbox.Llx - hw, Lly: bbox.Lly + hw, Urx: bbox.Llx + hw, Ury: bbox.Ury - hw},
+			model.PdfRectangle{Llx: bbox.Urx - hw, Lly: bbox.Lly + hw, Urx: bbox.Urx + hw, Ury: bbox.Ury - hw})
+	} else {
+		// Degenerate (horizontal) path, only a single band.
+		bands = bands[:1]
+	}
+	for _, band := range bands {
+		canvas.paintColor(band, gs.ClipBox, 1.0, gs.ColorspaceStroking, gs.ColorStroking)
+	}
+}
+
+// paintImage paints image `img` which is mapped onto the page by `ctm` from the unit square.
+func (canvas *inkCanvas) paintImage(img *inkImage, ctm model.Matrix, clip *model.PdfRectangle) {
+	inv, ok := ctm.Inverse()
+	if !ok || img.width == 0 || img.height == 0 {
+		return
+	}
+	bbox := ctm.TransformRect(model.PdfRectangle{Llx: 0, Lly: 0, Urx: 1, Ury: 1})
+	canvas.paint(bbox, clip, 1.0, func(x, y float64) ([4]float64, bool) {
+		u, v := inv.Transform(x, y)
+		if u < 0 || u >= 1 || v < 0 || v >= 1 {
+			return [4]float64{}, false
+		}
+		// The first row of the image is at the top of the unit square.
+		col := int(u * float64(img.width))
+		row := int((1 - v) * float64(img.height))
+		if row >= img.height {
+			row = img.height - 1
+		}
+		return img.ink(row*img.width + col)
+	})
+}
+
+// inkImage provides the CMYK ink of each of the pixels of an image.
+type inkImage struct {
+	width, height int
+	ink           func(pixel int) ([4]float64, bool)
+}
+
+// loadInkImage loads the image painted by operation `op` (Do or BI).
+func loadInkImage(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources) (*inkImage, error) {
+	if len(op.Params) != 1 {
+		return nil, errors.New("Invalid number of parameters")
+	}
+
+	var img *model.Image
+	var cs model.PdfColorspace
+	var isMask bool
+
+	switch param := op.Params[0].(type) {
+	case *contentstream.ContentStreamInlineImage:
+		var err error
+		isMask, err = param.IsMask()
+		if err != nil {
+			return nil, err
+		}
+		if !isMask {
+			cs, err = param.GetColorSpace(resources)
+			if err != nil {
+				return nil, err
+			}
+		}
+		img, err = param.ToImage(resources)
+		if err != nil {
+			return nil, err
+		}
+	case *core.PdfObjectName:
+		ximg, err := resources.GetXObjectImageByName(*param)
+		if err != nil {
+			return nil, err
+		}
+		if ximg == nil {
+			return nil, errors.New("Image not found")
+		}
+		if mask, ok := core.TraceToDirectObject(ximg.ImageMask).(*core.PdfObjectBool); ok && bool(*mask) {
+			isMask = true
+			// Stencil masks are 1 bit per pixel without a colorspace.
+			ximg.ColorSpace = model.NewPdfColorspaceDeviceGray()
+			bpc := int64(1)
+			ximg.BitsPerComponent = &bpc
+		}
+		if ximg.ColorSpace == nil {
+			return nil, errors.New("Image colorspace not specified")
+		}
+		cs = ximg.ColorSpace
+		img, err = ximg.ToImage()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("Invalid image parameter")
+	}
+
+	result := &inkImage{width: int(img.Width), height: int(img.Height)}
+
+	if isMask {
+		// Stencil mask: painted with the fill color where the samples are 0.
+		fill, ok := colorToCMYK(gs.ColorspaceNonStroking, gs.ColorNonStroking)
+		if !ok {
+			return nil, errors.New("Unsupported fill color")
+		}
+		samples := img.GetSamples()
+		if len(samples) < result.width*result.height {
+			return nil, errors.New("Image data too short")
+		}
+		result.ink = func(pixel int) ([4]float64, bool) {
+			return fill, samples[pixel] == 0
+		}
+		return result, nil
+	}
+
+	switch cs.(type) {
+	case *model.PdfColorspaceDeviceCMYK, *model.PdfColorspaceDeviceGray:
+	default:
+		rgbImg, err := cs.ImageToRGB(*img)
+		if err != nil {
+			return nil, err
+		}
+		img = &rgbImg
+	}
+
+	samples := img.GetSamples()
+	numComps := img.ColorComponents
+	if len(samples) < result.width*result.height*numComps {
+		return nil, errors.New("Image data too short")
+	}
+	maxVal := math.Pow(2, float64(img.BitsPerComponent)) - 1
+
+	switch numComps {
+	case 1:
+		result.ink = func(pixel int) ([4]float64, bool) {
+			return [4]float64{0, 0, 0, 1 - float64(samples[pixel])/maxVal}, true
+		}
+	case 3:
+		result.ink = func(pixel int) ([4]float64, bool) {
+			s := samples[3*pixel : 3*pixel+3]
+			rgb := model.NewPdfColorDeviceRGB(float64(s[0])/maxVal, float64(s[1])/maxVal, float64(s[2])/maxVal)
+			cmyk := rgb.ToCMYK()
+			return [4]float64{cmyk.C(), cmyk.M(), cmyk.Y(), cmyk.K()}, true
+		}
+	case 4:
+		result.ink = func(pixel int) ([4]float64, bool) {
+			s := samples[4*pixel : 4*pixel+4]
+			return [4]float64{float64(s[0]) / maxVal, float64(s[1]) / maxVal, float64(s[2]) / maxVal,
+				float64(s[3]) / maxVal}, true
+		}
+	default:
+		return nil, errors.New("Unsupported number of color components")
+	}
+
+	return result, nil
+}
+
+// colorToCMYK converts `color` in colorspace `cs` to CMYK components. Returns false if the color cannot be
+// converted, e.g. for patterns.
+func colorToCMYK(cs model.PdfColorspace, color model.PdfColor) ([4]float64, bool) {
+	if cs == nil || color == nil {
+		return [4]float64{}, false
+	}
+
+	switch c := color.(type) {
+	case *model.PdfColorDeviceCMYK:
+		return [4]float64{c.C(), c.M(), c.Y(), c.K()}, true
+	case *model.PdfColorDeviceGray:
+		return [4]float64{0, 0, 0, 1 - c.Val()}, true
+	}
+	if _, isPattern := cs.(*model.PdfColorspaceSpecialPattern); isPattern {
+		return [4]float64{}, false
+	}
+
+	rgb, err := cs.ColorToRGB(color)
+	if err != nil {
+		common.Log.Debug("Unable to convert color to RGB: %v", err)
+		return [4]float64{}, false
+	}
+	rgbColor, ok := rgb.(*model.PdfColorDeviceRGB)
+	if !ok {
+		return [4]float64{}, false
+	}
+	cmyk := rgbColor.ToCMYK()
+	return [4]float64{cmyk.C(), cmyk.M(), cmyk.Y(), cmyk.K()}, true
+}
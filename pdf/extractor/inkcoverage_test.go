@@ -0,0 +1,83 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestInkCoverage(t *testing.T) {
+	// Left half black, bottom half of the right half red (overlapping black is painted over), and a blue stroke
+	// clipped away entirely.
+	contents := `
+0 0 0 1 k
+0 0 50 100 re f
+1 0 0 rg
+0 0 100 50 re f
+q
+0 0 10 10 re W n
+0 0 1 RG
+50 50 m 100 100 l S
+Q
+`
+	e := Extractor{}
+	e.contents = contents
+	e.pageBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 100, Ury: 100}
+
+	coverage, err := e.ExtractInkCoverage()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	expected := InkCoverage{C: 0, M: 0.5, Y: 0.5, K: 0.25}
+	if math.Abs(coverage.C-expected.C) > 1e-6 || math.Abs(coverage.M-expected.M) > 1e-6 ||
+		math.Abs(coverage.Y-expected.Y) > 1e-6 || math.Abs(coverage.K-expected.K) > 1e-6 {
+		t.Errorf("Coverage mismatch: %+v != %+v", *coverage, expected)
+	}
+
+	costs := InkCostModel{
+		Magenta: InkCartridge{Price: 50, Yield: 1000, YieldCoverage: 0.05},
+		Black:   InkCartridge{Price: 20, Yield: 2000, YieldCoverage: 0.05},
+	}
+	cost := costs.PageCost(*coverage)
+	if math.Abs(cost-(0.05*10+0.01*5)) > 1e-9 {
+		t.Errorf("Page cost mismatch: %v", cost)
+	}
+}
+
+func TestInkCoverageLargePage(t *testing.T) {
+	// The grid of a huge page is coarsened to the maximum number of cells, and does not exhaust memory.
+	e := Extractor{}
+	e.contents = "0 0 0 1 k\n0 0 1000000 500000 re f\n"
+	e.pageBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 1000000, Ury: 1000000}
+
+	coverage, err := e.ExtractInkCoverage()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if math.Abs(coverage.K-0.5) > 0.01 {
+		t.Errorf("Coverage mismatch: %+v", *coverage)
+	}
+
+	for _, box := range []model.PdfRectangle{
+		{Llx: 0, Lly: 0, Urx: 1e6, Ury: 1e6},
+		{Llx: 0, Lly: 0, Urx: 1e12, Ury: 1},
+		{Llx: 0, Lly: 0, Urx: 3, Ury: 1e15},
+	} {
+		canvas := newInkCanvas(box, inkCoverageCellSize, inkCoverageMaxCells)
+		if len(canvas.cells) > inkCoverageMaxCells || len(canvas.cells) != canvas.cols*canvas.rows {
+			t.Errorf("Box %+v: %d x %d cells", box, canvas.cols, canvas.rows)
+		}
+	}
+
+	e.pageBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: math.Inf(1), Ury: 100}
+	if _, err := e.ExtractInkCoverage(); err == nil {
+		t.Errorf("Should fail on an infinite page box")
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+const (
+	// Minimum width of a gutter between columns, relative to the median font size of the text being split.
+	layoutColumnGap = 1.0
+
+	// Width of a glyph in text space units (fraction of the font size) assumed when the font widths are not
+	// available.
+	layoutFallbackGlyphWidth = 0.5
+)
+
+// textFragment is the text shown by a text showing operation, with its bounding box in device space.
+type textFragment struct {
+	text     string
+	bbox     model.PdfRectangle
+	fontSize float64 // Font size in device space.
+}
+
+// extractLayoutText extracts the text shown by `operations` in reading order as determined by recursive XY-cut.
+// A space is inserted between fragments of a line separated by more than `spaceThreshold` thousandths of the
+// font size.
+func extractLayoutText(operations contentstream.ContentStreamOperations, resources *model.PdfPageResources,
+	spaceThreshold float64) (string, error) {
+	fragments, err := collectTextFragments(operations, resources, spaceThreshold)
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{}
+	for _, line := range xyCut(fragments) {
+		lines = append(lines, joinLineFragments(line, spaceThreshold))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// collectTextFragments returns the fragments of text shown by `operations` in content stream order.
+func collectTextFragments(operations contentstream.ContentStreamOperations, resources *model.PdfPageResources,
+	spaceThreshold float64) ([]*textFragment, error) {
+	var codemap *cmap.CMap
+	fragments := []*textFragment{}
+
+	processor := contentstream.NewContentStreamProcessor(operations)
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			switch op.Operand {
+			case "Tf":
+				if len(op.Params) != 2 {
+					common.Log.Debug("Error Tf should only get 2 input params, got %d", len(op.Params))
+					return errors.New("Incorrect parameter count")
+				}
+				fontName, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					common.Log.Debug("Error Tf font input not a name")
+					return errors.New("Tf range error")
+				}
+				var err error
+				codemap, err = loadFontCMap(*fontName, resources)
+				return err
+			case "Tj", "TJ", "'", "\"":
+				text, err := decodeTextShow(op, codemap, spaceThreshold)
+				if err != nil || text == "" {
+					return err
+				}
+				scale := gs.Text.FontSize * gs.Text.HorizontalScaling
+				if scale == 0 {
+					return nil
+				}
+				width := processor.GetTextDisplacement(op, resources) / scale
+				if width <= 0 {
+					width = layoutFallbackGlyphWidth * float64(utf8.RuneCountInString(text))
+				}
+				trm := gs.TextRenderingMatrix()
+				bbox := trm.TransformRect(model.PdfRectangle{Llx: 0, Lly: -0.1, Urx: width, Ury: 0.7})
+				fragments = append(fragments, &textFragment{
+					text:     text,
+					bbox:     bbox,
+					fontSize: (bbox.Ury - bbox.Lly) / 0.8,
+				})
+			}
+			return nil
+		})
+
+	err := processor.Process(resources)
+	if err != nil {
+		common.Log.Error("Error processing: %v", err)
+		return nil, err
+	}
+	return fragments, nil
+}
+
+// xyCut orders `fragments` by recursively splitting them into columns (left to right) at the widest vertical
+// gutter free of text, or otherwise into rows (top to bottom) at the widest horizontal gap. Returns the
+// resulting lines in reading order.
+func xyCut(fragments []*textFragment) [][]*textFragment {
+	if len(fragments) <= 1 {
+		return [][]*textFragment{fragments}
+	}
+
+	// Only splits leaving fragments on both sides are made, so that the recursion ends, e.g. for fragments of
+	// zero size.
+	if x, gap := widestGap(fragments, xInterval); gap > 0 && gap >= layoutColumnGap*medianFontSize(fragments) {
+		left, right := []*textFragment{}, []*textFragment{}
+		for _, f := range fragments {
+			if f.bbox.Urx <= x {
+				left = append(left, f)
+			} else {
+				right = append(right, f)
+			}
+		}
+		if len(left) > 0 && len(right) > 0 {
+			return append(xyCut(left), xyCut(right)...)
+		}
+	}
+
+	if y, gap := widestGap(fragments, yInterval); gap > 0 {
+		top, bottom := []*textFragment{}, []*textFragment{}
+		for _, f := range fragments {
+			if f.bbox.Lly >= y {
+				top = append(top, f)
+			} else {
+				bottom = append(bottom, f)
+			}
+		}
+		if len(top) > 0 && len(bottom) > 0 {
+			return append(xyCut(top), xyCut(bottom)...)
+		}
+	}
+
+	return [][]*textFragment{fragments}
+}
+
+// widestGap returns the middle and width of the widest gap between the intervals of `fragments` given by
+// `interval`. The width is 0 if the intervals leave no gap.
+func widestGap(fragments []*textFragment, interval func(f *textFragment) (float64, float64)) (float64, float64) {
+	spans := make([][2]float64, len(fragments))
+	for i, f := range fragments {
+		spans[i][0], spans[i][1] = interval(f)
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+
+	var mid, widest float64
+	end := spans[0][1]
+	for _, span := range spans[1:] {
+		if gap := span[0] - end; gap > widest {
+			widest = gap
+			mid = end + gap/2
+		}
+		if span[1] > end {
+			end = span[1]
+		}
+	}
+	return mid, widest
+}
+
+func xInterval(f *textFragment) (float64, float64) { return f.bbox.Llx, f.bbox.Urx }
+
+func yInterval(f *textFragment) (float64, float64) { return f.bbox.Lly, f.bbox.Ury }
+
+// medianFontSize returns the median font size of `fragments`.
+func medianFontSize(fragments []*textFragment) float64 {
+	sizes := make([]float64, len(fragments))
+	for i, f := range fragments {
+		sizes[i] = f.fontSize
+	}
+	sort.Float64s(sizes)
+	return sizes[len(sizes)/2]
+}
+
+// joinLineFragments joins the fragments of a line from left to right, separating fragments further apart than
+// `spaceThreshold` thousandths of the font size by a space.
+func joinLineFragments(line []*textFragment, spaceThreshold float64) string {
+	sort.SliceStable(line, func(i, j int) bool { return line[i].bbox.Llx < line[j].bbox.Llx })
+
+	var b strings.Builder
+	for i, f := range line {
+		if i > 0 {
+			prev := line[i-1]
+			if fragmentsSeparated(prev, f, spaceThreshold) &&
+				!strings.HasSuffix(prev.text, " ") && !strings.HasPrefix(f.text, " ") {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(f.text)
+	}
+	return b.String()
+}
+
+// fragmentsSeparated returns true if the gap between consecutive fragments `prev` and `f` of a line is wider than
+// `spaceThreshold` thousandths of the font size.
+func fragmentsSeparated(prev, f *textFragment, spaceThreshold float64) bool {
+	return f.bbox.Llx-prev.bbox.Urx > spaceThreshold/1000*f.fontSize
+}
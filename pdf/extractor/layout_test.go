@@ -0,0 +1,78 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Two-column page with a full-width title, with the lines of both columns interleaved in the content stream.
+const testContentsColumns = `
+BT
+/F1 20 Tf
+1 0 0 1 100 700 Tm
+(Title of the paper)Tj
+/F1 10 Tf
+1 0 0 1 50 650 Tm
+(Left one)Tj
+1 0 0 1 300 650 Tm
+(Right one)Tj
+1 0 0 1 50 638 Tm
+(Left two)Tj
+1 0 0 1 300 638 Tm
+(Right two)Tj
+1 0 0 1 50 626 Tm
+(Left)Tj
+1 0 0 1 75 626 Tm
+(three)Tj
+ET
+`
+
+func TestExtractTextColumnLayout(t *testing.T) {
+	isTesting = true
+	e := Extractor{}
+	e.contents = testContentsColumns
+
+	s, err := e.ExtractTextWithOptions(TextOptions{ColumnLayout: true})
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	expected := "Title of the paper\nLeft one\nLeft two\nLeft three\nRight one\nRight two"
+	if s != expected {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+}
+
+func TestXYCutSingleLine(t *testing.T) {
+	fragments := []*textFragment{
+		{text: "world", fontSize: 10, bbox: model.PdfRectangle{Llx: 42, Lly: 0, Urx: 70, Ury: 8}},
+		{text: "Hello", fontSize: 10, bbox: model.PdfRectangle{Llx: 10, Lly: 0, Urx: 40, Ury: 8}},
+	}
+	lines := xyCut(fragments)
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 line, got %d", len(lines))
+	}
+	if s := joinLineFragments(lines[0], defaultSpaceThreshold); s != "Hello world" {
+		t.Errorf("Line mismatch (%q)", s)
+	}
+}
+
+// Text with a degenerate text matrix has zero-size fragments, which cannot be split.
+func TestExtractTextColumnLayoutDegenerateMatrix(t *testing.T) {
+	isTesting = true
+	e := Extractor{}
+	e.contents = "BT /F1 12 Tf 1 0 0 0 10 10 Tm (a) Tj 1 0 0 0 10 10 Tm (a) Tj ET"
+
+	s, err := e.ExtractTextWithOptions(TextOptions{ColumnLayout: true})
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	if s != "aa" {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"unicode"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// TextOrientation is the orientation of the text of a page.
+type TextOrientation struct {
+	// Number of characters (excluding white space) with baselines at 0, 90, 180 and 270 degrees counterclockwise
+	// in user space, rounded to the nearest multiple of 90 degrees.
+	Chars [4]int
+}
+
+// Total returns the number of characters.
+func (o *TextOrientation) Total() int {
+	return o.Chars[0] + o.Chars[1] + o.Chars[2] + o.Chars[3]
+}
+
+// Dominant returns the angle (0, 90, 180 or 270) of the baselines of most characters and the fraction of the
+// characters with this angle. Returns 0, 0 if there is no text.
+func (o *TextOrientation) Dominant() (int, float64) {
+	total := o.Total()
+	if total == 0 {
+		return 0, 0
+	}
+	best := 0
+	for i := 1; i < 4; i++ {
+		if o.Chars[i] > o.Chars[best] {
+			best = i
+		}
+	}
+	return best * 90, float64(o.Chars[best]) / float64(total)
+}
+
+// ExtractTextOrientation returns the orientation of the text shown on the page, including text in form XObjects
+// and invisible text such as the OCR layer of scanned pages.
+func (e *Extractor) ExtractTextOrientation() (*TextOrientation, error) {
+	orientation := &TextOrientation{}
+
+	cstreamParser := contentstream.NewContentStreamParser(e.contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return orientation, err
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.SetFormXObjectRecursion(true)
+	processor.AddHandler(contentstream.HandlerConditionEnumText, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			switch op.Operand {
+			case "Tj", "TJ", "'", "\"":
+			default:
+				return nil
+			}
+
+			// Only the number of characters matters, the character codes are not mapped to unicode.
+			text, err := decodeTextShow(op, nil, defaultSpaceThreshold)
+			if err != nil {
+				common.Log.Debug("Invalid text showing operation: %v", err)
+				return nil
+			}
+			numChars := 0
+			for _, r := range text {
+				if !unicode.IsSpace(r) {
+					numChars++
+				}
+			}
+
+			quadrant := int(math.Floor(gs.TextRenderingMatrix().Angle()/90+0.5)) % 4
+			if quadrant < 0 {
+				quadrant += 4
+			}
+			orientation.Chars[quadrant] += numChars
+			return nil
+		})
+
+	err = processor.Process(e.resources)
+	if err != nil {
+		common.Log.Debug("Error processing: %v", err)
+		return orientation, err
+	}
+
+	return orientation, nil
+}
+
+// CorrectPageOrientation detects whether the text of `page` is predominantly shown sideways or upside down, e.g.
+// for pages scanned sideways, and if so sets the Rotate entry of the page to display the text upright. The text
+// is considered predominantly rotated if at least `minFraction` of its characters (e.g. 0.6) have the same
+// orientation. Returns true if the page was rotated.
+func CorrectPageOrientation(page *model.PdfPage, minFraction float64) (bool, error) {
+	e, err := New(page)
+	if err != nil {
+		return false, err
+	}
+	orientation, err := e.ExtractTextOrientation()
+	if err != nil {
+		return false, err
+	}
+
+	// Rotating the page clockwise by the angle of the baselines shows them horizontally.
+	angle, fraction := orientation.Dominant()
+	if fraction == 0 || fraction < minFraction || angle == page.GetRotation() {
+		return false, nil
+	}
+	common.Log.Debug("Correcting page rotation from %d to %d (%.0f%% of %d characters)", page.GetRotation(), angle,
+		100*fraction, orientation.Total())
+	rotate := int64(angle)
+	page.Rotate = &rotate
+	return true, nil
+}
+
+// CorrectOrientation applies CorrectPageOrientation to each page of the document of `reader`, returning the
+// numbers (1-based) of the rotated pages. The pages can then be written with model.PdfWriter.
+func CorrectOrientation(reader *model.PdfReader, minFraction float64) ([]int, error) {
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+	rotated := []int{}
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := CorrectPageOrientation(page, minFraction)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rotated = append(rotated, pageNum)
+		}
+	}
+	return rotated, nil
+}
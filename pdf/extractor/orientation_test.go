@@ -0,0 +1,54 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestCorrectPageOrientation(t *testing.T) {
+	testcases := []struct {
+		contents string
+		minimum  float64
+		rotate   int
+		rotated  bool
+	}{
+		// Upright text.
+		{"BT /F1 10 Tf 1 0 0 1 50 700 Tm (Upright text) Tj ET", 0.6, 0, false},
+		// Text going up the page (scanned sideways) with an upright page number.
+		{"BT /F1 10 Tf 0 1 -1 0 100 50 Tm (Sideways text) Tj 1 0 0 1 300 20 Tm (1) Tj ET", 0.6, 90, true},
+		// Upside down text.
+		{"BT /F1 10 Tf -1 0 0 -1 500 700 Tm (Upside down) Tj ET", 0.6, 180, true},
+		// Text going down the page, rotated by the CTM.
+		{"0 -1 1 0 0 792 cm BT /F1 10 Tf 1 0 0 1 50 100 Tm (Rotated by CTM) Tj ET", 0.6, 270, true},
+		// Mixed orientations below the minimum fraction.
+		{"BT /F1 10 Tf 0 1 -1 0 100 50 Tm (abcd) Tj 1 0 0 1 300 20 Tm (abc) Tj ET", 0.9, 0, false},
+		// No text.
+		{"0 0 100 100 re f", 0.6, 0, false},
+	}
+
+	for i, tc := range testcases {
+		page := model.NewPdfPage()
+		page.Contents = makeTestContentStream(t, tc.contents)
+		rotated, err := CorrectPageOrientation(page, tc.minimum)
+		if err != nil {
+			t.Fatalf("Case %d: error: %v", i, err)
+		}
+		if rotated != tc.rotated || page.GetRotation() != tc.rotate {
+			t.Errorf("Case %d: rotated %v to %d, expected %v to %d", i, rotated, page.GetRotation(), tc.rotated,
+				tc.rotate)
+		}
+
+		// Already corrected pages are left alone.
+		if rotated {
+			if again, err := CorrectPageOrientation(page, tc.minimum); err != nil || again {
+				t.Errorf("Case %d: rotated again (%v)", i, err)
+			}
+		}
+	}
+}
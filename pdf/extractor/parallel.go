@@ -0,0 +1,113 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// NewPages returns Extractor instances for `pages`, decoding their content streams concurrently with up to
+// `workers` goroutines (runtime.NumCPU() if `workers` is 0 or less).
+// The page objects are only read, the pages should be fully loaded (e.g. via PdfReader.GetPage) beforehand.
+func NewPages(pages []*model.PdfPage, workers int) ([]*Extractor, error) {
+	// Gather the content stream parts of all pages. Literal string parts are used as is, stream parts are decoded
+	// in parallel below.
+	parts := make([][]string, len(pages))
+	streams := []*PdfObjectStream{}
+	type streamPart struct {
+		page, part int
+	}
+	locations := []streamPart{}
+
+	for i, page := range pages {
+		if page.Contents == nil {
+			continue
+		}
+		holders := []PdfObject{page.Contents}
+		if arr, isArray := TraceToDirectObject(page.Contents).(*PdfObjectArray); isArray {
+			holders = *arr
+		}
+		parts[i] = make([]string, len(holders))
+		for j, holder := range holders {
+			switch t := TraceToDirectObject(holder).(type) {
+			case *PdfObjectString:
+				parts[i][j] = string(*t)
+			case *PdfObjectStream:
+				streams = append(streams, t)
+				locations = append(locations, streamPart{i, j})
+			default:
+				common.Log.Debug("ERROR: Invalid content stream object holder (%T)", t)
+				return nil, fmt.Errorf("Invalid content stream object holder (%T)", t)
+			}
+		}
+	}
+
+	decoded, err := DecodeStreams(streams, workers)
+	if err != nil {
+		return nil, err
+	}
+	for k, loc := range locations {
+		parts[loc.page][loc.part] = string(decoded[k])
+	}
+
+	extractors := make([]*Extractor, len(pages))
+	for i, page := range pages {
+		extractors[i] = newExtractor(page, strings.Join(parts[i], " "))
+	}
+	return extractors, nil
+}
+
+// ExtractTextPages extracts the text of each of `pages` concurrently with up to `workers` goroutines
+// (runtime.NumCPU() if `workers` is 0 or less). Both the content stream decoding and the text extraction are
+// performed in parallel. The texts are returned in the order of the pages.
+func ExtractTextPages(pages []*model.PdfPage, workers int) ([]string, error) {
+	extractors, err := NewPages(pages, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(extractors) {
+		workers = len(extractors)
+	}
+
+	texts := make([]string, len(extractors))
+	errs := make([]error, len(extractors))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				texts[i], errs[i] = extractors[i].ExtractText()
+			}
+		}()
+	}
+	for i := range extractors {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			common.Log.Debug("ERROR: Extracting text from page %d failed: %v", i+1, err)
+			return nil, err
+		}
+	}
+	return texts, nil
+}
@@ -0,0 +1,75 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func makeTestContentStream(t *testing.T, contents string) *core.PdfObjectStream {
+	encoder := core.NewFlateEncoder()
+	encoded, err := encoder.EncodeBytes([]byte(contents))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return &core.PdfObjectStream{PdfObjectDictionary: encoder.MakeStreamDict(), Stream: encoded}
+}
+
+func TestExtractTextPages(t *testing.T) {
+	isTesting = true
+
+	pages := []*model.PdfPage{}
+	expected := []string{}
+	for i := 0; i < 12; i++ {
+		page := model.NewPdfPage()
+		line := fmt.Sprintf("BT /F1 10 Tf 1 0 0 1 50 700 Tm (Page %d)Tj ET", i+1)
+		if i%2 == 0 {
+			page.Contents = makeTestContentStream(t, line)
+		} else {
+			// Contents split over a stream and a literal string.
+			page.Contents = &core.PdfObjectArray{
+				makeTestContentStream(t, line),
+				core.MakeString("BT 1 0 0 1 50 600 Tm (second)Tj ET"),
+			}
+		}
+		pages = append(pages, page)
+
+		e, err := New(page)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		text, err := e.ExtractText()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		expected = append(expected, text)
+	}
+
+	for _, workers := range []int{0, 1, 5} {
+		texts, err := ExtractTextPages(pages, workers)
+		if err != nil {
+			t.Fatalf("Workers %d: %v", workers, err)
+		}
+		if len(texts) != len(expected) {
+			t.Fatalf("Workers %d: %d texts, expected %d", workers, len(texts), len(expected))
+		}
+		for i := range expected {
+			if texts[i] != expected[i] {
+				t.Errorf("Workers %d: page %d text %q != %q", workers, i+1, texts[i], expected[i])
+			}
+		}
+	}
+
+	invalid := model.NewPdfPage()
+	invalid.Contents = core.MakeInteger(1)
+	if _, err := ExtractTextPages(append(pages, invalid), 2); err == nil {
+		t.Errorf("Expected error for invalid contents")
+	}
+}
@@ -0,0 +1,332 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"sort"
+	"unicode"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Script names for characters that are shared between scripts (unicode.Scripts).
+const (
+	ScriptCommon    = "Common"    // Digits, punctuation, symbols and spaces.
+	ScriptInherited = "Inherited" // Combining marks.
+)
+
+// ScriptStats contains the number of characters shown on a page per Unicode script, e.g. for routing documents to
+// language specific processing.
+type ScriptStats struct {
+	// Number of characters per script, keyed by the script names of unicode.Scripts, e.g. "Latin", "Cyrillic",
+	// "Greek", "Arabic", "Han".
+	Scripts map[string]int
+
+	// Number of character codes that could not be mapped to Unicode, e.g. in fonts with Identity encoding and
+	// without a ToUnicode CMap.
+	Unknown int
+
+	// Script of the runes seen, as looking up unicode.Scripts is relatively slow.
+	runeScripts map[rune]string
+}
+
+func newScriptStats() *ScriptStats {
+	return &ScriptStats{Scripts: map[string]int{}, runeScripts: map[rune]string{}}
+}
+
+// Total returns the total number of characters, including unknown.
+func (s *ScriptStats) Total() int {
+	total := s.Unknown
+	for _, n := range s.Scripts {
+		total += n
+	}
+	return total
+}
+
+// Add adds the counts of `other` to the statistics, e.g. to aggregate the statistics of all pages of a document.
+func (s *ScriptStats) Add(other *ScriptStats) {
+	if s.Scripts == nil {
+		s.Scripts = map[string]int{}
+	}
+	for script, n := range other.Scripts {
+		s.Scripts[script] += n
+	}
+	s.Unknown += other.Unknown
+}
+
+// Dominant returns the script with the most characters, not counting the Common and Inherited scripts.
+// Returns an empty string if there are no such characters.
+func (s *ScriptStats) Dominant() string {
+	dominant := ""
+	for _, script := range s.SortedScripts() {
+		if script != ScriptCommon && script != ScriptInherited {
+			dominant = script
+			break
+		}
+	}
+	return dominant
+}
+
+// SortedScripts returns the scripts used, ordered by decreasing character count.
+func (s *ScriptStats) SortedScripts() []string {
+	scripts := []string{}
+	for script := range s.Scripts {
+		scripts = append(scripts, script)
+	}
+	sort.Slice(scripts, func(i, j int) bool {
+		ni, nj := s.Scripts[scripts[i]], s.Scripts[scripts[j]]
+		if ni != nj {
+			return ni > nj
+		}
+		return scripts[i] < scripts[j]
+	})
+	return scripts
+}
+
+// addRune counts rune `r`.
+func (s *ScriptStats) addRune(r rune) {
+	if r == unicode.ReplacementChar {
+		s.Unknown++
+		return
+	}
+	script, ok := s.runeScripts[r]
+	if !ok {
+		script = getRuneScript(r)
+		s.runeScripts[r] = script
+	}
+	s.Scripts[script]++
+}
+
+// ExtractScriptStats returns the number of characters per Unicode script shown by the text operators of the page,
+// including text in form XObjects. The character codes are mapped to Unicode via the ToUnicode CMaps of the fonts
+// and the font encodings, without performing text layout.
+func (e *Extractor) ExtractScriptStats() (*ScriptStats, error) {
+	stats := newScriptStats()
+
+	cstreamParser := contentstream.NewContentStreamParser(e.contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return stats, err
+	}
+
+	decoders := map[core.PdfObject]*scriptFontDecoder{}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.SetFormXObjectRecursion(true)
+	processor.AddHandler(contentstream.HandlerConditionEnumText, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			var strs []*core.PdfObjectString
+			switch op.Operand {
+			case "Tj", "'", "\"":
+				if len(op.Params) > 0 {
+					if str, ok := op.Params[len(op.Params)-1].(*core.PdfObjectString); ok {
+						strs = append(strs, str)
+					}
+				}
+			case "TJ":
+				if len(op.Params) > 0 {
+					if arr, ok := op.Params[0].(*core.PdfObjectArray); ok {
+						for _, obj := range *arr {
+							if str, ok := obj.(*core.PdfObjectString); ok {
+								strs = append(strs, str)
+							}
+						}
+					}
+				}
+			}
+			if len(strs) == 0 || resources == nil {
+				return nil
+			}
+
+			fontObj, found := resources.GetFontByName(gs.Text.FontName)
+			if !found {
+				common.Log.Debug("Font %s not found", gs.Text.FontName)
+				for _, str := range strs {
+					stats.Unknown += len(*str)
+				}
+				return nil
+			}
+			decoder, has := decoders[fontObj]
+			if !has {
+				decoder = newScriptFontDecoder(fontObj)
+				decoders[fontObj] = decoder
+			}
+			for _, str := range strs {
+				decoder.decode([]byte(*str), stats)
+			}
+			return nil
+		})
+
+	err = processor.Process(e.resources)
+	if err != nil {
+		common.Log.Debug("Error processing: %v", err)
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// scriptFontDecoder maps the character codes of a font to Unicode.
+type scriptFontDecoder struct {
+	toUnicode *cmap.CMap
+
+	// Simple fonts: character code to rune.
+	codeToRune map[byte]rune
+
+	// Composite fonts without ToUnicode: script of the character collection if it is specific to a script.
+	cidScript string
+	isCID     bool
+}
+
+// newScriptFontDecoder creates a decoder for font dictionary `fontObj`.
+func newScriptFontDecoder(fontObj core.PdfObject) *scriptFontDecoder {
+	decoder := &scriptFontDecoder{}
+
+	fontDict, ok := core.TraceToDirectObject(fontObj).(*core.PdfObjectDictionary)
+	if !ok {
+		return decoder
+	}
+
+	if stream, ok := core.TraceToDirectObject(fontDict.Get("ToUnicode")).(*core.PdfObjectStream); ok {
+		decoded, err := core.DecodeStream(stream)
+		if err == nil {
+			decoder.toUnicode, err = cmap.LoadCmapFromData(decoded)
+		}
+		if err != nil {
+			common.Log.Debug("Invalid ToUnicode CMap: %v", err)
+			decoder.toUnicode = nil
+		}
+	}
+
+	subtype, _ := core.TraceToDirectObject(fontDict.Get("Subtype")).(*core.PdfObjectName)
+	if subtype != nil && *subtype == "Type0" {
+		decoder.isCID = true
+		decoder.cidScript = getCIDFontScript(fontDict)
+		return decoder
+	}
+
+	// Simple font: the base encoding is approximated by WinAnsiEncoding, except for symbolic fonts.
+	var encoder textencoding.TextEncoder = textencoding.NewWinAnsiTextEncoder()
+	if baseFont, ok := core.TraceToDirectObject(fontDict.Get("BaseFont")).(*core.PdfObjectName); ok {
+		switch *baseFont {
+		case "Symbol":
+			encoder = textencoding.NewSymbolEncoder()
+		case "ZapfDingbats":
+			encoder = textencoding.NewZapfDingbatsEncoder()
+		}
+	}
+	decoder.codeToRune = map[byte]rune{}
+	for code := 0; code < 256; code++ {
+		if r, ok := encoder.CharcodeToRune(byte(code)); ok {
+			decoder.codeToRune[byte(code)] = r
+		}
+	}
+
+	// Differences of the encoding dictionary.
+	if encDict, ok := core.TraceToDirectObject(fontDict.Get("Encoding")).(*core.PdfObjectDictionary); ok {
+		if diffs, ok := core.TraceToDirectObject(encDict.Get("Differences")).(*core.PdfObjectArray); ok {
+			code := 0
+			for _, obj := range *diffs {
+				switch t := core.TraceToDirectObject(obj).(type) {
+				case *core.PdfObjectInteger:
+					code = int(*t)
+				case *core.PdfObjectName:
+					if code >= 0 && code < 256 {
+						if r, ok := encoder.GlyphToRune(string(*t)); ok {
+							decoder.codeToRune[byte(code)] = r
+						} else {
+							delete(decoder.codeToRune, byte(code))
+						}
+					}
+					code++
+				}
+			}
+		}
+	}
+
+	return decoder
+}
+
+// decode maps the character codes in `data` to Unicode and adds the characters to `stats`.
+func (decoder *scriptFontDecoder) decode(data []byte, stats *ScriptStats) {
+	if decoder.toUnicode != nil {
+		for _, r := range decoder.toUnicode.CharcodeBytesToUnicode(data) {
+			stats.addRune(r)
+		}
+		return
+	}
+
+	if decoder.isCID {
+		// Assume 2 byte codes (Identity-H/V and the UCS2 CMaps).
+		numChars := (len(data) + 1) / 2
+		if decoder.cidScript != "" {
+			stats.Scripts[decoder.cidScript] += numChars
+		} else {
+			stats.Unknown += numChars
+		}
+		return
+	}
+
+	for _, code := range data {
+		if r, ok := decoder.codeToRune[code]; ok {
+			stats.addRune(r)
+		} else {
+			stats.Unknown++
+		}
+	}
+}
+
+// getCIDFontScript returns the script of the character collection (CIDSystemInfo Ordering) of the descendant
+// font of Type0 font `fontDict`, for collections that are specific to one script. Returns an empty string
+// otherwise, e.g. for Adobe-Identity and Adobe-Japan1 (which mixes Han, Hiragana and Katakana).
+func getCIDFontScript(fontDict *core.PdfObjectDictionary) string {
+	descendants, ok := core.TraceToDirectObject(fontDict.Get("DescendantFonts")).(*core.PdfObjectArray)
+	if !ok || len(*descendants) == 0 {
+		return ""
+	}
+	cidFont, ok := core.TraceToDirectObject((*descendants)[0]).(*core.PdfObjectDictionary)
+	if !ok {
+		return ""
+	}
+	info, ok := core.TraceToDirectObject(cidFont.Get("CIDSystemInfo")).(*core.PdfObjectDictionary)
+	if !ok {
+		return ""
+	}
+	ordering, ok := core.TraceToDirectObject(info.Get("Ordering")).(*core.PdfObjectString)
+	if !ok {
+		return ""
+	}
+	switch string(*ordering) {
+	case "GB1", "CNS1":
+		return "Han"
+	case "Korea1":
+		return "Hangul"
+	}
+	return ""
+}
+
+// frequentScripts are checked first when looking up the script of a rune.
+var frequentScripts = []string{"Latin", ScriptCommon, "Cyrillic", "Greek", "Han", "Arabic", "Hebrew"}
+
+// getRuneScript returns the name of the Unicode script of `r`, or ScriptCommon if not in any script.
+func getRuneScript(r rune) string {
+	for _, name := range frequentScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ScriptCommon
+}
@@ -0,0 +1,83 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestExtractScriptStats(t *testing.T) {
+	latin := core.MakeDict()
+	latin.Set("Type", core.MakeName("Font"))
+	latin.Set("Subtype", core.MakeName("Type1"))
+	latin.Set("BaseFont", core.MakeName("Helvetica"))
+	latin.Set("Encoding", core.MakeName("WinAnsiEncoding"))
+
+	// Greek glyphs mapped via Differences.
+	encoding := core.MakeDict()
+	encoding.Set("Differences", core.MakeArray(core.MakeInteger(65), core.MakeName("alpha"), core.MakeName("beta"),
+		core.MakeName("gamma")))
+	greek := core.MakeDict()
+	greek.Set("Type", core.MakeName("Font"))
+	greek.Set("Subtype", core.MakeName("Type1"))
+	greek.Set("BaseFont", core.MakeName("GreekFont"))
+	greek.Set("Encoding", encoding)
+
+	// Chinese CID font without ToUnicode.
+	sysInfo := core.MakeDict()
+	sysInfo.Set("Registry", core.MakeString("Adobe"))
+	sysInfo.Set("Ordering", core.MakeString("GB1"))
+	sysInfo.Set("Supplement", core.MakeInteger(4))
+	cidFont := core.MakeDict()
+	cidFont.Set("Subtype", core.MakeName("CIDFontType0"))
+	cidFont.Set("CIDSystemInfo", sysInfo)
+	han := core.MakeDict()
+	han.Set("Type", core.MakeName("Font"))
+	han.Set("Subtype", core.MakeName("Type0"))
+	han.Set("Encoding", core.MakeName("Identity-H"))
+	han.Set("DescendantFonts", core.MakeArray(cidFont))
+
+	fonts := core.MakeDict()
+	fonts.Set("F1", latin)
+	fonts.Set("F2", greek)
+	fonts.Set("F3", han)
+
+	e := Extractor{}
+	e.resources = model.NewPdfPageResources()
+	e.resources.Font = fonts
+	e.contents = `BT /F1 12 Tf (Hello, world) Tj /F2 12 Tf [(ABC) -100 (AB)] TJ /F3 12 Tf <00010002> Tj ET`
+
+	stats, err := e.ExtractScriptStats()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	expected := map[string]int{"Latin": 10, "Common": 2, "Greek": 5, "Han": 2}
+	if len(stats.Scripts) != len(expected) {
+		t.Errorf("Scripts mismatch: %v != %v", stats.Scripts, expected)
+	}
+	for script, n := range expected {
+		if stats.Scripts[script] != n {
+			t.Errorf("%s: %d != %d", script, stats.Scripts[script], n)
+		}
+	}
+	if stats.Unknown != 0 || stats.Total() != 19 {
+		t.Errorf("Unknown %d, total %d", stats.Unknown, stats.Total())
+	}
+	if stats.Dominant() != "Latin" {
+		t.Errorf("Dominant script: %s", stats.Dominant())
+	}
+
+	total := &ScriptStats{}
+	total.Add(stats)
+	total.Add(stats)
+	if total.Scripts["Greek"] != 10 {
+		t.Errorf("Add mismatch: %v", total.Scripts)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// TextMatch is a match of a text search with its location, e.g. for positioning a stamp, form field or overlay
+// relative to existing text.
+type TextMatch struct {
+	PageNum int // Page of the match (starting from 1) when searching a document with SearchText.
+	Text    string
+	BBox    model.PdfRectangle // Bounding box in the same space as the annotation rectangles of the page.
+}
+
+// FindTextRegexp returns the matches of `re` in the page text, in reading order. The text searched is the tokens
+// of ExtractIndexTokens separated by single spaces, so patterns should match whitespace with `\s+` or ` `.
+// The bounding box of a match covering part of a token is interpolated assuming equally wide glyphs.
+func (e *Extractor) FindTextRegexp(re *regexp.Regexp) ([]TextMatch, error) {
+	tokens, err := e.ExtractIndexTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	// Byte offsets of the tokens in the text.
+	starts := make([]int, len(tokens))
+	var sb strings.Builder
+	for i, token := range tokens {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		starts[i] = sb.Len()
+		sb.WriteString(token.Text)
+	}
+	text := sb.String()
+
+	matches := []TextMatch{}
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+		var bbox *model.PdfRectangle
+		for i, token := range tokens {
+			start, end := starts[i], starts[i]+len(token.Text)
+			if end <= loc[0] || start >= loc[1] {
+				continue
+			}
+			// Part of the token in the match, in runes.
+			partStart, partEnd := 0, len(token.Text)
+			if loc[0] > start {
+				partStart = loc[0] - start
+			}
+			if loc[1] < end {
+				partEnd = loc[1] - start
+			}
+			n := utf8.RuneCountInString(token.Text)
+			from := utf8.RuneCountInString(token.Text[:partStart])
+			to := utf8.RuneCountInString(token.Text[:partEnd])
+			width := (token.BBox.Urx - token.BBox.Llx) / float64(n)
+			part := model.PdfRectangle{
+				Llx: token.BBox.Llx + float64(from)*width,
+				Lly: token.BBox.Lly,
+				Urx: token.BBox.Llx + float64(to)*width,
+				Ury: token.BBox.Ury,
+			}
+			if bbox == nil {
+				bbox = &part
+				continue
+			}
+			bbox.Llx = math.Min(bbox.Llx, part.Llx)
+			bbox.Lly = math.Min(bbox.Lly, part.Lly)
+			bbox.Urx = math.Max(bbox.Urx, part.Urx)
+			bbox.Ury = math.Max(bbox.Ury, part.Ury)
+		}
+		if bbox == nil {
+			// Only the spaces between tokens matched.
+			continue
+		}
+		matches = append(matches, TextMatch{Text: text[loc[0]:loc[1]], BBox: *bbox})
+	}
+	return matches, nil
+}
+
+// SearchText returns the matches of `re` in the text of the pages of `reader` (see Extractor.FindTextRegexp), in
+// page order.
+func SearchText(reader *model.PdfReader, re *regexp.Regexp) ([]TextMatch, error) {
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []TextMatch{}
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return nil, err
+		}
+		e, err := New(page)
+		if err != nil {
+			return nil, err
+		}
+		pageMatches, err := e.FindTextRegexp(re)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range pageMatches {
+			m.PageNum = pageNum
+			matches = append(matches, m)
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,41 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"regexp"
+	"testing"
+)
+
+func TestFindTextRegexp(t *testing.T) {
+	e := Extractor{}
+	e.contents = `BT /F1 10 Tf 1 0 0 1 50 700 Tm (Agreed price: 1200 EUR) Tj 1 0 0 1 50 600 Tm (Signed by:) Tj ET`
+
+	matches, err := e.FindTextRegexp(regexp.MustCompile(`price:\s+\d+`))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Text != "price: 1200" {
+		t.Fatalf("Unexpected matches %v", matches)
+	}
+
+	// The match starts within the first token and ends with the second one.
+	all, err := e.FindTextRegexp(regexp.MustCompile(`Agreed price: 1200 EUR`))
+	if err != nil || len(all) != 1 {
+		t.Fatalf("Unexpected matches %v (%v)", all, err)
+	}
+	bbox := matches[0].BBox
+	if bbox.Llx <= all[0].BBox.Llx || bbox.Urx >= all[0].BBox.Urx || bbox.Lly >= 700 || bbox.Ury <= 700 {
+		t.Errorf("Unexpected bbox %+v in line %+v", bbox, all[0].BBox)
+	}
+
+	matches, err = e.FindTextRegexp(regexp.MustCompile(`(?i)signed by:`))
+	if err != nil || len(matches) != 1 || math.Abs(matches[0].BBox.Llx-50) > 1e-9 || matches[0].BBox.Ury <= 600 ||
+		matches[0].BBox.Lly >= 600 {
+		t.Errorf("Unexpected matches %v (%v)", matches, err)
+	}
+}
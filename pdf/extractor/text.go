@@ -22,7 +22,13 @@ import (
 // The text is processed linearly e.g. in the order in which it appears. A best effort is done to add
 // spaces and newlines.
 func (e *Extractor) ExtractText() (string, error) {
+	return e.extractText(TextOptions{})
+}
+
+// extractText extracts the text of the content streams, post-processed according to `opts`.
+func (e *Extractor) extractText(opts TextOptions) (string, error) {
 	var buf bytes.Buffer
+	spaceThreshold := opts.spaceThreshold()
 
 	cstreamParser := contentstream.NewContentStreamParser(e.contents)
 	operations, err := cstreamParser.Parse()
@@ -30,16 +36,51 @@ func (e *Extractor) ExtractText() (string, error) {
 		return buf.String(), err
 	}
 
+	if opts.ColumnLayout {
+		text, err := extractLayoutText(*operations, e.resources, spaceThreshold)
+		if err != nil {
+			return text, err
+		}
+		buf.WriteString(text)
+		postProcessText(&buf, opts)
+		return buf.String(), nil
+	}
+
 	processor := contentstream.NewContentStreamProcessor(*operations)
 
 	var codemap *cmap.CMap
 	inText := false
 	xPos, yPos := float64(-1), float64(-1)
 
+	// Marked-content sequences with an ActualText entry replace the text shown within them.
+	// Each entry of the stack indicates whether the sequence at that level has replacement text.
+	markedContent := []bool{}
+	actualTextDepth := 0
+
 	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
 		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
 			operand := op.Operand
 			switch operand {
+			case "BMC":
+				markedContent = append(markedContent, false)
+			case "BDC":
+				actualText := getMarkedContentActualText(op, resources)
+				if actualText != nil && actualTextDepth == 0 {
+					buf.WriteString(model.DecodeTextString(*actualText))
+				}
+				if actualText != nil {
+					actualTextDepth++
+				}
+				markedContent = append(markedContent, actualText != nil)
+			case "EMC":
+				if len(markedContent) == 0 {
+					common.Log.Debug("EMC without matching BMC/BDC")
+					return nil
+				}
+				if markedContent[len(markedContent)-1] {
+					actualTextDepth--
+				}
+				markedContent = markedContent[:len(markedContent)-1]
 			case "BT":
 				inText = true
 			case "ET":
@@ -63,36 +104,8 @@ func (e *Extractor) ExtractText() (string, error) {
 					return errors.New("Tf range error")
 				}
 
-				if resources == nil {
-					return nil
-				}
-
-				fontObj, found := resources.GetFontByName(*fontName)
-				if !found {
-					common.Log.Debug("Font not found...")
-					return errors.New("Font not in resources")
-				}
-
-				fontObj = core.TraceToDirectObject(fontObj)
-				if fontDict, isDict := fontObj.(*core.PdfObjectDictionary); isDict {
-					toUnicode := fontDict.Get("ToUnicode")
-					if toUnicode != nil {
-						toUnicode = core.TraceToDirectObject(toUnicode)
-						toUnicodeStream, ok := toUnicode.(*core.PdfObjectStream)
-						if !ok {
-							return errors.New("Invalid ToUnicode entry - not a stream")
-						}
-						decoded, err := core.DecodeStream(toUnicodeStream)
-						if err != nil {
-							return err
-						}
-
-						codemap, err = cmap.LoadCmapFromData(decoded)
-						if err != nil {
-							return err
-						}
-					}
-				}
+				codemap, err = loadFontCMap(*fontName, resources)
+				return err
 			case "T*":
 				if !inText {
 					common.Log.Debug("T* operand outside text")
@@ -169,53 +182,19 @@ func (e *Extractor) ExtractText() (string, error) {
 					buf.WriteString("\t")
 					xPos = float64(*xfloat)
 				}
-			case "TJ":
-				if !inText {
-					common.Log.Debug("TJ operand outside text")
-					return nil
-				}
-				if len(op.Params) < 1 {
-					return nil
-				}
-				paramList, ok := op.Params[0].(*core.PdfObjectArray)
-				if !ok {
-					return fmt.Errorf("Invalid parameter type, no array (%T)", op.Params[0])
-				}
-				for _, obj := range *paramList {
-					switch v := obj.(type) {
-					case *core.PdfObjectString:
-						if codemap != nil {
-							buf.WriteString(codemap.CharcodeBytesToUnicode([]byte(*v)))
-						} else {
-							buf.WriteString(string(*v))
-						}
-					case *core.PdfObjectFloat:
-						if *v < -100 {
-							buf.WriteString(" ")
-						}
-					case *core.PdfObjectInteger:
-						if *v < -100 {
-							buf.WriteString(" ")
-						}
-					}
-				}
-			case "Tj":
+			case "TJ", "Tj":
 				if !inText {
-					common.Log.Debug("Tj operand outside text")
+					common.Log.Debug("%s operand outside text", operand)
 					return nil
 				}
-				if len(op.Params) < 1 {
+				if actualTextDepth > 0 {
 					return nil
 				}
-				param, ok := op.Params[0].(*core.PdfObjectString)
-				if !ok {
-					return fmt.Errorf("Invalid parameter type, not string (%T)", op.Params[0])
-				}
-				if codemap != nil {
-					buf.WriteString(codemap.CharcodeBytesToUnicode([]byte(*param)))
-				} else {
-					buf.WriteString(string(*param))
+				text, err := decodeTextShow(op, codemap, spaceThreshold)
+				if err != nil {
+					return err
 				}
+				buf.WriteString(text)
 			}
 
 			return nil
@@ -227,7 +206,95 @@ func (e *Extractor) ExtractText() (string, error) {
 		return buf.String(), err
 	}
 
-	procBuf(&buf)
+	postProcessText(&buf, opts)
 
 	return buf.String(), nil
 }
+
+// postProcessText applies the options `opts` to the extracted text in `buf`.
+func postProcessText(buf *bytes.Buffer, opts TextOptions) {
+	if opts != (TextOptions{}) {
+		text := applyTextOptions(buf.String(), opts)
+		buf.Reset()
+		buf.WriteString(text)
+	}
+
+	procBuf(buf)
+}
+
+// loadFontCMap loads the ToUnicode CMap of font `fontName` in `resources`. Returns nil if the font has no
+// ToUnicode entry.
+func loadFontCMap(fontName core.PdfObjectName, resources *model.PdfPageResources) (*cmap.CMap, error) {
+	if resources == nil {
+		return nil, nil
+	}
+
+	fontObj, found := resources.GetFontByName(fontName)
+	if !found {
+		common.Log.Debug("Font not found...")
+		return nil, errors.New("Font not in resources")
+	}
+
+	fontDict, isDict := core.TraceToDirectObject(fontObj).(*core.PdfObjectDictionary)
+	if !isDict {
+		return nil, nil
+	}
+	toUnicode := fontDict.Get("ToUnicode")
+	if toUnicode == nil {
+		return nil, nil
+	}
+	toUnicodeStream, ok := core.TraceToDirectObject(toUnicode).(*core.PdfObjectStream)
+	if !ok {
+		return nil, errors.New("Invalid ToUnicode entry - not a stream")
+	}
+	decoded, err := core.DecodeStream(toUnicodeStream)
+	if err != nil {
+		return nil, err
+	}
+	return cmap.LoadCmapFromData(decoded)
+}
+
+// decodeTextShow returns the text shown by text showing operation `op` (Tj, TJ, ' or "), decoded with `codemap` if not nil.
+// A space is inserted for TJ displacements larger than `spaceThreshold`.
+func decodeTextShow(op *contentstream.ContentStreamOperation, codemap *cmap.CMap, spaceThreshold float64) (string, error) {
+	if len(op.Params) < 1 {
+		return "", nil
+	}
+
+	decode := func(s *core.PdfObjectString) string {
+		if codemap != nil {
+			return codemap.CharcodeBytesToUnicode([]byte(*s))
+		}
+		return string(*s)
+	}
+
+	if op.Operand != "TJ" {
+		// The string is the last parameter for Tj, ' and ".
+		param, ok := op.Params[len(op.Params)-1].(*core.PdfObjectString)
+		if !ok {
+			return "", fmt.Errorf("Invalid parameter type, not string (%T)", op.Params[len(op.Params)-1])
+		}
+		return decode(param), nil
+	}
+
+	paramList, ok := op.Params[0].(*core.PdfObjectArray)
+	if !ok {
+		return "", fmt.Errorf("Invalid parameter type, no array (%T)", op.Params[0])
+	}
+	var buf bytes.Buffer
+	for _, obj := range *paramList {
+		switch v := obj.(type) {
+		case *core.PdfObjectString:
+			buf.WriteString(decode(v))
+		case *core.PdfObjectFloat:
+			if float64(*v) < -spaceThreshold {
+				buf.WriteString(" ")
+			}
+		case *core.PdfObjectInteger:
+			if float64(*v) < -spaceThreshold {
+				buf.WriteString(" ")
+			}
+		}
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,239 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TextNormalization specifies the normalization applied to extracted text. It covers only the compositions and
+// compatibility mappings commonly produced by PDF fonts, not full Unicode normalization (NFC or NFKC).
+type TextNormalization int
+
+const (
+	// NormalizeNone leaves the text as extracted.
+	NormalizeNone TextNormalization = iota
+
+	// NormalizeLatinDiacritics composes Latin letters followed by combining diacritics into the precomposed
+	// characters of U+00C0-U+017F.
+	NormalizeLatinDiacritics
+
+	// NormalizeCompatibilityChars replaces Latin ligatures, fullwidth ASCII forms, typographic spaces and a few
+	// other compatibility characters with their plain equivalents, and composes Latin diacritics as
+	// NormalizeLatinDiacritics.
+	NormalizeCompatibilityChars
+)
+
+// defaultSpaceThreshold is the negative TJ displacement (in thousandths of text space units) beyond which
+// a space is inserted between glyphs.
+const defaultSpaceThreshold = 100
+
+// TextOptions controls how extracted text is post-processed. The zero value gives the same output as
+// ExtractText.
+type TextOptions struct {
+	// Normalization is the normalization applied to the text.
+	Normalization TextNormalization
+
+	// ExpandLigatures replaces ligature glyphs such as U+FB01 with their component letters ("fi").
+	// Implied by NormalizeCompatibilityChars.
+	ExpandLigatures bool
+
+	// MergeHyphenation joins words hyphenated at line ends ("extrac-\ntion" becomes "extraction").
+	MergeHyphenation bool
+
+	// SpaceThreshold is the gap between glyphs in a TJ array, in thousandths of text space units, above
+	// which a space is inserted. Zero means the default of 100.
+	SpaceThreshold float64
+
+	// ColumnLayout orders the text by analysing the page layout instead of following the content stream
+	// order, so that multi-column text is read column by column. See ExtractTextWithOptions.
+	ColumnLayout bool
+}
+
+// spaceThreshold returns the effective space insertion threshold.
+func (opts TextOptions) spaceThreshold() float64 {
+	if opts.SpaceThreshold <= 0 {
+		return defaultSpaceThreshold
+	}
+	return opts.SpaceThreshold
+}
+
+// ExtractTextWithOptions extracts text like ExtractText and post-processes it according to `opts`.
+//
+// With ColumnLayout the reading order is determined by recursive XY-cut: the text fragments are split at the
+// widest gutter free of text (into columns, left to right) or at the widest gap between lines (top to bottom),
+// until only single lines remain. Marked-content ActualText replacements are not applied in this mode.
+func (e *Extractor) ExtractTextWithOptions(opts TextOptions) (string, error) {
+	return e.extractText(opts)
+}
+
+// applyTextOptions applies the normalization, ligature and hyphenation options to `text`.
+func applyTextOptions(text string, opts TextOptions) string {
+	if opts.MergeHyphenation {
+		text = mergeHyphenation(text)
+	}
+	switch opts.Normalization {
+	case NormalizeCompatibilityChars:
+		text = composeLatinDiacritics(compatibilityMap(text))
+	case NormalizeLatinDiacritics:
+		text = composeLatinDiacritics(text)
+	}
+	if opts.ExpandLigatures {
+		text = expandLigatures(text)
+	}
+	return text
+}
+
+// ligatures maps Latin ligature glyphs to their component letters.
+var ligatures = map[rune]string{
+	0xFB00: "ff",
+	0xFB01: "fi",
+	0xFB02: "fl",
+	0xFB03: "ffi",
+	0xFB04: "ffl",
+	0xFB05: "st",
+	0xFB06: "st",
+}
+
+// expandLigatures replaces ligature glyphs in `text` with their component letters.
+func expandLigatures(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if s, has := ligatures[r]; has {
+			b.WriteString(s)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// compatibility maps compatibility characters to their plain equivalents (in addition to the ligatures
+// and the fullwidth ASCII block, which are handled separately).
+var compatibility = map[rune]string{
+	0x00A0: " ",
+	0x2002: " ",
+	0x2003: " ",
+	0x2004: " ",
+	0x2005: " ",
+	0x2006: " ",
+	0x2007: " ",
+	0x2008: " ",
+	0x2009: " ",
+	0x200A: " ",
+	0x202F: " ",
+	0x3000: " ",
+	0x2024: ".",
+	0x2025: "..",
+	0x2026: "...",
+	0x00B2: "2",
+	0x00B3: "3",
+	0x00B9: "1",
+	0x2122: "TM",
+	0x0132: "IJ",
+	0x0133: "ij",
+}
+
+// compatibilityMap replaces the compatibility characters of `text` with their plain equivalents.
+func compatibilityMap(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if s, has := ligatures[r]; has {
+			b.WriteString(s)
+		} else if s, has := compatibility[r]; has {
+			b.WriteString(s)
+		} else if r >= 0xFF01 && r <= 0xFF5E {
+			// Fullwidth ASCII variants.
+			b.WriteRune(r - 0xFEE0)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// compositions maps a base letter followed by a combining mark to the precomposed character.
+var compositions = map[[2]rune]rune{
+	{'A', 0x0300}: 0x00C0, {'A', 0x0301}: 0x00C1, {'A', 0x0302}: 0x00C2, {'A', 0x0303}: 0x00C3,
+	{'A', 0x0308}: 0x00C4, {'A', 0x030A}: 0x00C5, {'C', 0x0327}: 0x00C7, {'E', 0x0300}: 0x00C8,
+	{'E', 0x0301}: 0x00C9, {'E', 0x0302}: 0x00CA, {'E', 0x0308}: 0x00CB, {'I', 0x0300}: 0x00CC,
+	{'I', 0x0301}: 0x00CD, {'I', 0x0302}: 0x00CE, {'I', 0x0308}: 0x00CF, {'N', 0x0303}: 0x00D1,
+	{'O', 0x0300}: 0x00D2, {'O', 0x0301}: 0x00D3, {'O', 0x0302}: 0x00D4, {'O', 0x0303}: 0x00D5,
+	{'O', 0x0308}: 0x00D6, {'U', 0x0300}: 0x00D9, {'U', 0x0301}: 0x00DA, {'U', 0x0302}: 0x00DB,
+	{'U', 0x0308}: 0x00DC, {'Y', 0x0301}: 0x00DD, {'a', 0x0300}: 0x00E0, {'a', 0x0301}: 0x00E1,
+	{'a', 0x0302}: 0x00E2, {'a', 0x0303}: 0x00E3, {'a', 0x0308}: 0x00E4, {'a', 0x030A}: 0x00E5,
+	{'c', 0x0327}: 0x00E7, {'e', 0x0300}: 0x00E8, {'e', 0x0301}: 0x00E9, {'e', 0x0302}: 0x00EA,
+	{'e', 0x0308}: 0x00EB, {'i', 0x0300}: 0x00EC, {'i', 0x0301}: 0x00ED, {'i', 0x0302}: 0x00EE,
+	{'i', 0x0308}: 0x00EF, {'n', 0x0303}: 0x00F1, {'o', 0x0300}: 0x00F2, {'o', 0x0301}: 0x00F3,
+	{'o', 0x0302}: 0x00F4, {'o', 0x0303}: 0x00F5, {'o', 0x0308}: 0x00F6, {'u', 0x0300}: 0x00F9,
+	{'u', 0x0301}: 0x00FA, {'u', 0x0302}: 0x00FB, {'u', 0x0308}: 0x00FC, {'y', 0x0301}: 0x00FD,
+	{'y', 0x0308}: 0x00FF, {'A', 0x0304}: 0x0100, {'a', 0x0304}: 0x0101, {'A', 0x0306}: 0x0102,
+	{'a', 0x0306}: 0x0103, {'A', 0x0328}: 0x0104, {'a', 0x0328}: 0x0105, {'C', 0x0301}: 0x0106,
+	{'c', 0x0301}: 0x0107, {'C', 0x0302}: 0x0108, {'c', 0x0302}: 0x0109, {'C', 0x0307}: 0x010A,
+	{'c', 0x0307}: 0x010B, {'C', 0x030C}: 0x010C, {'c', 0x030C}: 0x010D, {'D', 0x030C}: 0x010E,
+	{'d', 0x030C}: 0x010F, {'E', 0x0304}: 0x0112, {'e', 0x0304}: 0x0113, {'E', 0x0306}: 0x0114,
+	{'e', 0x0306}: 0x0115, {'E', 0x0307}: 0x0116, {'e', 0x0307}: 0x0117, {'E', 0x0328}: 0x0118,
+	{'e', 0x0328}: 0x0119, {'E', 0x030C}: 0x011A, {'e', 0x030C}: 0x011B, {'G', 0x0302}: 0x011C,
+	{'g', 0x0302}: 0x011D, {'G', 0x0306}: 0x011E, {'g', 0x0306}: 0x011F, {'G', 0x0307}: 0x0120,
+	{'g', 0x0307}: 0x0121, {'G', 0x0327}: 0x0122, {'g', 0x0327}: 0x0123, {'H', 0x0302}: 0x0124,
+	{'h', 0x0302}: 0x0125, {'I', 0x0303}: 0x0128, {'i', 0x0303}: 0x0129, {'I', 0x0304}: 0x012A,
+	{'i', 0x0304}: 0x012B, {'I', 0x0306}: 0x012C, {'i', 0x0306}: 0x012D, {'I', 0x0328}: 0x012E,
+	{'i', 0x0328}: 0x012F, {'I', 0x0307}: 0x0130, {'J', 0x0302}: 0x0134, {'j', 0x0302}: 0x0135,
+	{'K', 0x0327}: 0x0136, {'k', 0x0327}: 0x0137, {'L', 0x0301}: 0x0139, {'l', 0x0301}: 0x013A,
+	{'L', 0x0327}: 0x013B, {'l', 0x0327}: 0x013C, {'L', 0x030C}: 0x013D, {'l', 0x030C}: 0x013E,
+	{'N', 0x0301}: 0x0143, {'n', 0x0301}: 0x0144, {'N', 0x0327}: 0x0145, {'n', 0x0327}: 0x0146,
+	{'N', 0x030C}: 0x0147, {'n', 0x030C}: 0x0148, {'O', 0x0304}: 0x014C, {'o', 0x0304}: 0x014D,
+	{'O', 0x0306}: 0x014E, {'o', 0x0306}: 0x014F, {'O', 0x030B}: 0x0150, {'o', 0x030B}: 0x0151,
+	{'R', 0x0301}: 0x0154, {'r', 0x0301}: 0x0155, {'R', 0x0327}: 0x0156, {'r', 0x0327}: 0x0157,
+	{'R', 0x030C}: 0x0158, {'r', 0x030C}: 0x0159, {'S', 0x0301}: 0x015A, {'s', 0x0301}: 0x015B,
+	{'S', 0x0302}: 0x015C, {'s', 0x0302}: 0x015D, {'S', 0x0327}: 0x015E, {'s', 0x0327}: 0x015F,
+	{'S', 0x030C}: 0x0160, {'s', 0x030C}: 0x0161, {'T', 0x0327}: 0x0162, {'t', 0x0327}: 0x0163,
+	{'T', 0x030C}: 0x0164, {'t', 0x030C}: 0x0165, {'U', 0x0303}: 0x0168, {'u', 0x0303}: 0x0169,
+	{'U', 0x0304}: 0x016A, {'u', 0x0304}: 0x016B, {'U', 0x0306}: 0x016C, {'u', 0x0306}: 0x016D,
+	{'U', 0x030A}: 0x016E, {'u', 0x030A}: 0x016F, {'U', 0x030B}: 0x0170, {'u', 0x030B}: 0x0171,
+	{'U', 0x0328}: 0x0172, {'u', 0x0328}: 0x0173, {'W', 0x0302}: 0x0174, {'w', 0x0302}: 0x0175,
+	{'Y', 0x0302}: 0x0176, {'y', 0x0302}: 0x0177, {'Y', 0x0308}: 0x0178, {'Z', 0x0301}: 0x0179,
+	{'z', 0x0301}: 0x017A, {'Z', 0x0307}: 0x017B, {'z', 0x0307}: 0x017C, {'Z', 0x030C}: 0x017D,
+	{'z', 0x030C}: 0x017E,
+}
+
+// composeLatinDiacritics composes Latin letters followed by combining diacritics into precomposed characters.
+func composeLatinDiacritics(text string) string {
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		if n := len(out); n > 0 && unicode.Is(unicode.Mn, r) {
+			if c, has := compositions[[2]rune{out[n-1], r}]; has {
+				out[n-1] = c
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// mergeHyphenation removes hyphens at line ends that split a word, joining the word parts. A soft hyphen
+// (U+00AD) at a line end is always removed; a hyphen-minus or U+2010 hyphen only when letters surround it.
+func mergeHyphenation(text string) string {
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if (r == '-' || r == 0x2010 || r == 0x00AD) && i+1 < len(runes) && runes[i+1] == '\n' {
+			prevLetter := len(out) > 0 && unicode.IsLetter(out[len(out)-1])
+			nextLetter := i+2 < len(runes) && unicode.IsLetter(runes[i+2])
+			if r == 0x00AD || (prevLetter && nextLetter) {
+				// Skip the hyphen and the line break.
+				i++
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
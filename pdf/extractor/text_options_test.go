@@ -0,0 +1,65 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import "testing"
+
+func TestApplyTextOptions(t *testing.T) {
+	testcases := []struct {
+		text     string
+		opts     TextOptions
+		expected string
+	}{
+		{"été", TextOptions{}, "été"},
+		{"été", TextOptions{Normalization: NormalizeLatinDiacritics}, "été"},
+		{"ﬁle", TextOptions{Normalization: NormalizeLatinDiacritics}, "ﬁle"},
+		{"ﬁle", TextOptions{ExpandLigatures: true}, "file"},
+		{"ﬃce Ａ…", TextOptions{Normalization: NormalizeCompatibilityChars}, "ffice A..."},
+		{"extrac-\ntion", TextOptions{MergeHyphenation: true}, "extraction"},
+		{"self-\n2018", TextOptions{MergeHyphenation: true}, "self-\n2018"},
+		{"co­\noperate", TextOptions{MergeHyphenation: true}, "cooperate"},
+	}
+
+	for _, tcase := range testcases {
+		text := applyTextOptions(tcase.text, tcase.opts)
+		if text != tcase.expected {
+			t.Errorf("%q with %+v: got %q, expected %q", tcase.text, tcase.opts, text, tcase.expected)
+		}
+	}
+}
+
+const testContentsSpacing = `
+BT
+/F1 24 Tf
+[(Wide)-150(gap)-50(narrow)]TJ
+0 -10 Td
+(in-)Tj
+0 -10 Td
+(line)Tj
+ET
+`
+
+func TestExtractTextWithOptions(t *testing.T) {
+	isTesting = true
+	e := Extractor{}
+	e.contents = testContentsSpacing
+
+	s, err := e.ExtractText()
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	if s != "Wide gapnarrow\nin-\nline" {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+
+	s, err = e.ExtractTextWithOptions(TextOptions{MergeHyphenation: true, SpaceThreshold: 40})
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	if s != "Wide gap narrow\ninline" {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+}
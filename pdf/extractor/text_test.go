@@ -41,3 +41,32 @@ func TestTextExtraction1(t *testing.T) {
 		return
 	}
 }
+
+// Malformed state operators (wrong number or types of operands) are skipped, as in many real-world documents.
+const testContentsMalformed = `
+10 Td
+1 0 0 1 cm
+/GS0 w
+10 10 re
+BT
+/F1 24 Tf
+(Hello World!)Tj
+/F1 Tc
+0 -10 Td
+(Doink)Tj
+ET
+`
+
+func TestTextExtractionMalformedOperands(t *testing.T) {
+	isTesting = true
+	e := Extractor{}
+	e.contents = testContentsMalformed
+
+	s, err := e.ExtractText()
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	if s != testExpected1 {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+}
@@ -0,0 +1,282 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Font descriptor flags (section 9.8.2 p. 283).
+const (
+	fontFlagItalic    = 1 << 6
+	fontFlagForceBold = 1 << 18
+)
+
+// TextStyle describes the font properties of shown text.
+type TextStyle struct {
+	FontName string  // BaseFont of the font without subset prefix, e.g. "Helvetica-Bold".
+	FontSize float64 // Effective font size in points on the page, rounded to 0.1 point.
+	Bold     bool
+	Italic   bool
+}
+
+// TextRun is text shown consecutively with the same style, e.g. a heading or a bold phrase in a paragraph.
+type TextRun struct {
+	TextStyle
+	Text string
+}
+
+// TextAnalysis contains statistics of the text shown on a page, as a basis for heading detection and document
+// segmentation.
+type TextAnalysis struct {
+	// Number of characters per Unicode script. The dominant script indicates the language of the page.
+	Scripts *ScriptStats
+
+	// Number of characters (excluding whitespace) per effective font size.
+	FontSizes map[float64]int
+
+	// Number of characters (excluding whitespace) shown in bold and italic fonts.
+	BoldChars   int
+	ItalicChars int
+
+	// Text runs in content stream order.
+	Runs []TextRun
+}
+
+// Add adds the statistics of `other` to the analysis, e.g. to aggregate the analysis of all pages of a document.
+func (a *TextAnalysis) Add(other *TextAnalysis) {
+	if a.Scripts == nil {
+		a.Scripts = newScriptStats()
+	}
+	if other.Scripts != nil {
+		a.Scripts.Add(other.Scripts)
+	}
+	if a.FontSizes == nil {
+		a.FontSizes = map[float64]int{}
+	}
+	for size, n := range other.FontSizes {
+		a.FontSizes[size] += n
+	}
+	a.BoldChars += other.BoldChars
+	a.ItalicChars += other.ItalicChars
+	a.Runs = append(a.Runs, other.Runs...)
+}
+
+// DominantFontSizes returns the font sizes used, ordered by decreasing character count.
+func (a *TextAnalysis) DominantFontSizes() []float64 {
+	sizes := []float64{}
+	for size := range a.FontSizes {
+		sizes = append(sizes, size)
+	}
+	sort.Slice(sizes, func(i, j int) bool {
+		ni, nj := a.FontSizes[sizes[i]], a.FontSizes[sizes[j]]
+		if ni != nj {
+			return ni > nj
+		}
+		return sizes[i] > sizes[j]
+	})
+	return sizes
+}
+
+// BodyFontSize returns the font size of most characters, i.e. the size of the body text. Returns 0 if there is
+// no text.
+func (a *TextAnalysis) BodyFontSize() float64 {
+	sizes := a.DominantFontSizes()
+	if len(sizes) == 0 {
+		return 0
+	}
+	return sizes[0]
+}
+
+// ExtractTextAnalysis returns the font size histogram, bold and italic character counts, text runs and script
+// statistics of the text shown on the page, including text in form XObjects.
+// Bold and italic are determined from the font descriptor flags, weight and italic angle and from the font name.
+// Text shown with fill and stroke rendering (a common way of emboldening) is also counted as bold.
+func (e *Extractor) ExtractTextAnalysis() (*TextAnalysis, error) {
+	scripts, err := e.ExtractScriptStats()
+	if err != nil {
+		return nil, err
+	}
+	analysis := &TextAnalysis{Scripts: scripts, FontSizes: map[float64]int{}}
+
+	cstreamParser := contentstream.NewContentStreamParser(e.contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return analysis, err
+	}
+
+	fonts := map[core.PdfObject]*analysisFont{}
+	var runEnd [2]float64
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.SetFormXObjectRecursion(true)
+	processor.AddHandler(contentstream.HandlerConditionEnumText, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			switch op.Operand {
+			case "Tj", "TJ", "'", "\"":
+			default:
+				return nil
+			}
+
+			font := &analysisFont{}
+			if resources != nil {
+				if fontObj, found := resources.GetFontByName(gs.Text.FontName); found {
+					if font = fonts[fontObj]; font == nil {
+						font = newAnalysisFont(fontObj)
+						fonts[fontObj] = font
+					}
+				}
+			}
+
+			text, err := decodeTextShow(op, font.toUnicode, defaultSpaceThreshold)
+			if err != nil {
+				common.Log.Debug("Invalid text showing operation: %v", err)
+				return nil
+			}
+
+			trm := gs.TextRenderingMatrix()
+			style := TextStyle{
+				FontName: font.name,
+				FontSize: math.Round(math.Hypot(trm[2], trm[3])*10) / 10,
+				Bold:     font.bold || gs.Text.RenderMode == 2 || gs.Text.RenderMode == 6,
+				Italic:   font.italic,
+			}
+
+			numChars := 0
+			for _, r := range text {
+				if !unicode.IsSpace(r) {
+					numChars++
+				}
+			}
+			analysis.FontSizes[style.FontSize] += numChars
+			if style.Bold {
+				analysis.BoldChars += numChars
+			}
+			if style.Italic {
+				analysis.ItalicChars += numChars
+			}
+
+			start := [2]float64{trm[4], trm[5]}
+			if n := len(analysis.Runs); n > 0 && analysis.Runs[n-1].TextStyle == style {
+				run := &analysis.Runs[n-1]
+				// Separate text positioned away from the end of the previous text.
+				if math.Hypot(start[0]-runEnd[0], start[1]-runEnd[1]) > 0.1*style.FontSize &&
+					!strings.HasSuffix(run.Text, " ") && !strings.HasPrefix(text, " ") {
+					run.Text += " "
+				}
+				run.Text += text
+			} else {
+				analysis.Runs = append(analysis.Runs, TextRun{TextStyle: style, Text: text})
+			}
+
+			end := model.TranslationMatrix(processor.GetTextDisplacement(op, resources), 0).
+				Mult(gs.Text.TextMatrix).Mult(gs.CTM)
+			runEnd = [2]float64{end[4], end[5]}
+			return nil
+		})
+
+	err = processor.Process(e.resources)
+	analysis.Runs = procRuns(analysis.Runs)
+	if err != nil {
+		common.Log.Debug("Error processing: %v", err)
+		return analysis, err
+	}
+
+	return analysis, nil
+}
+
+// procRuns applies the license restrictions of procBuf to the text of `runs`: the trailing runs may be truncated
+// and the watermark is appended to the text of the last run.
+func procRuns(runs []TextRun) []TextRun {
+	texts := make([]string, len(runs))
+	for i, run := range runs {
+		texts[i] = run.Text
+	}
+	kept, watermark := procTexts(texts)
+	if watermark == "" {
+		return runs
+	}
+	runs = runs[:len(kept)]
+	if n := len(kept); n > 0 {
+		runs[n-1].Text = kept[n-1] + watermark
+		return runs
+	}
+	return append(runs, TextRun{Text: watermark})
+}
+
+// analysisFont holds the properties of a font used for text analysis.
+type analysisFont struct {
+	name      string
+	bold      bool
+	italic    bool
+	toUnicode *cmap.CMap
+}
+
+// newAnalysisFont loads the name, style and ToUnicode CMap of font dictionary `fontObj`.
+func newAnalysisFont(fontObj core.PdfObject) *analysisFont {
+	font := &analysisFont{}
+
+	fontDict, ok := core.TraceToDirectObject(fontObj).(*core.PdfObjectDictionary)
+	if !ok {
+		return font
+	}
+
+	if stream, ok := core.TraceToDirectObject(fontDict.Get("ToUnicode")).(*core.PdfObjectStream); ok {
+		decoded, err := core.DecodeStream(stream)
+		if err == nil {
+			font.toUnicode, err = cmap.LoadCmapFromData(decoded)
+		}
+		if err != nil {
+			common.Log.Debug("Invalid ToUnicode CMap: %v", err)
+			font.toUnicode = nil
+		}
+	}
+
+	if baseFont, ok := core.TraceToDirectObject(fontDict.Get("BaseFont")).(*core.PdfObjectName); ok {
+		font.name = string(*baseFont)
+		// Strip the subset prefix, e.g. "ABCDEF+".
+		if i := strings.IndexByte(font.name, '+'); i == 6 {
+			font.name = font.name[7:]
+		}
+	}
+	lower := strings.ToLower(font.name)
+	font.bold = strings.Contains(lower, "bold") || strings.Contains(lower, "black") ||
+		strings.Contains(lower, "heavy")
+	font.italic = strings.Contains(lower, "italic") || strings.Contains(lower, "oblique")
+
+	// The font descriptor is in the descendant font for composite fonts.
+	descriptorParent := fontDict
+	if descendants, ok := core.TraceToDirectObject(fontDict.Get("DescendantFonts")).(*core.PdfObjectArray); ok && len(*descendants) > 0 {
+		if cidFont, ok := core.TraceToDirectObject((*descendants)[0]).(*core.PdfObjectDictionary); ok {
+			descriptorParent = cidFont
+		}
+	}
+	descriptor, ok := core.TraceToDirectObject(descriptorParent.Get("FontDescriptor")).(*core.PdfObjectDictionary)
+	if !ok {
+		return font
+	}
+	if flags, ok := core.TraceToDirectObject(descriptor.Get("Flags")).(*core.PdfObjectInteger); ok {
+		font.bold = font.bold || *flags&fontFlagForceBold != 0
+		font.italic = font.italic || *flags&fontFlagItalic != 0
+	}
+	if weight, err := getNumberAsFloat(core.TraceToDirectObject(descriptor.Get("FontWeight"))); err == nil {
+		font.bold = font.bold || weight >= 600
+	}
+	if angle, err := getNumberAsFloat(core.TraceToDirectObject(descriptor.Get("ItalicAngle"))); err == nil {
+		font.italic = font.italic || angle != 0
+	}
+
+	return font
+}
@@ -0,0 +1,79 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestExtractTextAnalysis(t *testing.T) {
+	regular := core.MakeDict()
+	regular.Set("Type", core.MakeName("Font"))
+	regular.Set("Subtype", core.MakeName("Type1"))
+	regular.Set("BaseFont", core.MakeName("Helvetica"))
+
+	descriptor := core.MakeDict()
+	descriptor.Set("Flags", core.MakeInteger(fontFlagItalic|32))
+	descriptor.Set("ItalicAngle", core.MakeInteger(-12))
+	italic := core.MakeDict()
+	italic.Set("Type", core.MakeName("Font"))
+	italic.Set("Subtype", core.MakeName("TrueType"))
+	italic.Set("BaseFont", core.MakeName("ABCDEF+Garamond"))
+	italic.Set("FontDescriptor", descriptor)
+
+	bold := core.MakeDict()
+	bold.Set("Type", core.MakeName("Font"))
+	bold.Set("Subtype", core.MakeName("Type1"))
+	bold.Set("BaseFont", core.MakeName("Helvetica-Bold"))
+
+	fonts := core.MakeDict()
+	fonts.Set("F1", regular)
+	fonts.Set("F2", italic)
+	fonts.Set("F3", bold)
+
+	e := Extractor{}
+	e.resources = model.NewPdfPageResources()
+	e.resources.Font = fonts
+	e.contents = `
+BT
+/F3 9 Tf 2 0 0 2 50 700 Tm (Heading) Tj
+/F1 10 Tf 1 0 0 1 50 650 Tm (Body text) Tj
+0 -12 Td (continues) Tj
+/F2 10 Tf (emphasis) Tj
+ET
+`
+
+	analysis, err := e.ExtractTextAnalysis()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if body := analysis.BodyFontSize(); body != 10 {
+		t.Errorf("Body font size %v, expected 10", body)
+	}
+	if sizes := analysis.DominantFontSizes(); !reflect.DeepEqual(sizes, []float64{10, 18}) {
+		t.Errorf("Font sizes %v", sizes)
+	}
+	if analysis.BoldChars != 7 || analysis.ItalicChars != 8 {
+		t.Errorf("Bold %d, italic %d", analysis.BoldChars, analysis.ItalicChars)
+	}
+	if dominant := analysis.Scripts.Dominant(); dominant != "Latin" {
+		t.Errorf("Dominant script %q", dominant)
+	}
+
+	expected := []TextRun{
+		{TextStyle{FontName: "Helvetica-Bold", FontSize: 18, Bold: true}, "Heading"},
+		{TextStyle{FontName: "Helvetica", FontSize: 10}, "Body text continues"},
+		{TextStyle{FontName: "Garamond", FontSize: 10, Italic: true}, "emphasis"},
+	}
+	if !reflect.DeepEqual(analysis.Runs, expected) {
+		t.Errorf("Runs mismatch: %+v", analysis.Runs)
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/unidoc/unidoc/common"
 	"github.com/unidoc/unidoc/common/license"
+	"github.com/unidoc/unidoc/pdf/contentstream"
 	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
 )
 
 // getNumberAsFloat can retrieve numeric values from PdfObject (both integer/float).
@@ -27,6 +30,38 @@ func getNumberAsFloat(obj core.PdfObject) (float64, error) {
 	return 0, errors.New("Not a number")
 }
 
+// getMarkedContentActualText returns the ActualText entry of the property list of a BDC marked-content
+// operation, or nil if not present. The property list is either inline or a named entry in the Properties
+// resources.
+func getMarkedContentActualText(op *contentstream.ContentStreamOperation, resources *model.PdfPageResources) *core.PdfObjectString {
+	if len(op.Params) != 2 {
+		common.Log.Debug("BDC: Invalid number of inputs (%d)", len(op.Params))
+		return nil
+	}
+
+	props := op.Params[1]
+	if name, isName := props.(*core.PdfObjectName); isName {
+		if resources == nil || resources.Properties == nil {
+			return nil
+		}
+		propsDict, ok := core.TraceToDirectObject(resources.Properties).(*core.PdfObjectDictionary)
+		if !ok {
+			return nil
+		}
+		props = propsDict.Get(*name)
+	}
+
+	dict, ok := core.TraceToDirectObject(props).(*core.PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	actualText, ok := core.TraceToDirectObject(dict.Get("ActualText")).(*core.PdfObjectString)
+	if !ok {
+		return nil
+	}
+	return actualText
+}
+
 func procBuf(buf *bytes.Buffer) {
 	if isTesting {
 		return
@@ -46,3 +81,35 @@ func procBuf(buf *bytes.Buffer) {
 	}
 	buf.WriteString(s)
 }
+
+// procTexts applies procBuf to the concatenation of `texts`. Returns the texts that are kept, the last one possibly
+// truncated, and the watermark appended to them.
+func procTexts(texts []string) ([]string, string) {
+	var buf bytes.Buffer
+	for _, text := range texts {
+		buf.WriteString(text)
+	}
+	length := buf.Len()
+	procBuf(&buf)
+	if buf.Len() == length {
+		return texts, ""
+	}
+
+	cut := length
+	if cut > 100 {
+		cut -= 100
+	}
+	watermark := buf.String()[cut:]
+	kept := []string{}
+	for _, text := range texts {
+		if cut <= 0 {
+			break
+		}
+		if len(text) > cut {
+			text = text[:cut]
+		}
+		kept = append(kept, text)
+		cut -= len(text)
+	}
+	return kept, watermark
+}
@@ -5,7 +5,7 @@
 
 package cmap
 
-import "bytes"
+import "unicode/utf16"
 
 func hexToUint64(shex cmapHexString) uint64 {
 	val := uint64(0)
@@ -19,16 +19,12 @@ func hexToUint64(shex cmapHexString) uint64 {
 }
 
 func hexToString(shex cmapHexString) string {
-	var buf bytes.Buffer
-
-	// Assumes unicode in format <HHLL> with 2 bytes HH and LL representing a rune.
+	// Assumes unicode in UTF-16BE format <HHLL> with 2 bytes HH and LL representing a code unit. Characters
+	// outside the Basic Multilingual Plane are represented by surrogate pairs <HHLLHHLL>.
+	units := []uint16{}
 	for i := 0; i < len(shex.b)-1; i += 2 {
-		b1 := uint64(shex.b[i])
-		b2 := uint64(shex.b[i+1])
-		r := rune((b1 << 8) | b2)
-
-		buf.WriteRune(r)
+		units = append(units, uint16(shex.b[i])<<8|uint16(shex.b[i+1]))
 	}
 
-	return buf.String()
+	return string(utf16.Decode(units))
 }
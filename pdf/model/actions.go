@@ -0,0 +1,327 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Action types (12.6.4 - Table 198).
+const (
+	ActionTypeGoTo       = "GoTo"
+	ActionTypeGoToR      = "GoToR"
+	ActionTypeLaunch     = "Launch"
+	ActionTypeURI        = "URI"
+	ActionTypeNamed      = "Named"
+	ActionTypeSubmitForm = "SubmitForm"
+	ActionTypeResetForm  = "ResetForm"
+	ActionTypeJavaScript = "JavaScript"
+)
+
+// PdfAction represents an action dictionary (12.6.2 - Table 193). The entries of the common action types are
+// available as fields, the entries of other types are kept in the dictionary and written unchanged.
+type PdfAction struct {
+	S *PdfObjectName // Action type, e.g. JavaScript.
+
+	D   PdfObject        // Destination (GoTo and GoToR).
+	URI *PdfObjectString // Uniform resource identifier (URI).
+	N   *PdfObjectName   // Named action, e.g. NextPage (Named).
+	JS  PdfObject        // Script as a text string or text stream (JavaScript).
+
+	// Actions performed after this action, in order.
+	Next []*PdfAction
+
+	primitive *PdfIndirectObject
+}
+
+// NewPdfAction returns a new action of type `actionType`, e.g. ActionTypeGoTo.
+func NewPdfAction(actionType string) *PdfAction {
+	action := &PdfAction{}
+	action.S = MakeName(actionType)
+	action.primitive = MakeIndirectObject(MakeDict())
+	return action
+}
+
+// NewPdfActionJavaScript returns a JavaScript action running `js`.
+func NewPdfActionJavaScript(js string) *PdfAction {
+	action := NewPdfAction(ActionTypeJavaScript)
+	action.JS = EncodeTextString(js)
+	return action
+}
+
+// NewPdfActionURI returns a URI action resolving `uri`.
+func NewPdfActionURI(uri string) *PdfAction {
+	action := NewPdfAction(ActionTypeURI)
+	action.URI = MakeString(uri)
+	return action
+}
+
+// NewPdfActionGoTo returns a GoTo action to destination `dest`, e.g. [page /Fit].
+func NewPdfActionGoTo(dest PdfObject) *PdfAction {
+	action := NewPdfAction(ActionTypeGoTo)
+	action.D = dest
+	return action
+}
+
+// NewPdfActionFromPdfObject loads an action from an action dictionary or an indirect object containing one.
+func NewPdfActionFromPdfObject(obj PdfObject) (*PdfAction, error) {
+	return newPdfActionFromPdfObject(obj, map[PdfObject]bool{})
+}
+
+// newPdfActionFromPdfObject loads action `obj`, where `traversed` contains the actions already loaded to guard
+// against circular Next chains.
+func newPdfActionFromPdfObject(obj PdfObject, traversed map[PdfObject]bool) (*PdfAction, error) {
+	container, ok := obj.(*PdfIndirectObject)
+	if !ok {
+		container = MakeIndirectObject(obj)
+	} else if traversed[container] {
+		common.Log.Debug("ERROR: Circular action Next chain")
+		return nil, ErrRangeError
+	}
+	traversed[container] = true
+
+	d, ok := TraceToDirectObject(container).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: Action not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	action := &PdfAction{}
+	action.primitive = container
+	if name, ok := TraceToDirectObject(d.Get("S")).(*PdfObjectName); ok {
+		action.S = name
+	} else {
+		common.Log.Debug("ERROR: Action type (S) missing or invalid")
+		return nil, ErrRequiredAttributeMissing
+	}
+	action.D = d.Get("D")
+	if str, ok := TraceToDirectObject(d.Get("URI")).(*PdfObjectString); ok {
+		action.URI = str
+	}
+	if name, ok := TraceToDirectObject(d.Get("N")).(*PdfObjectName); ok {
+		action.N = name
+	}
+	action.JS = d.Get("JS")
+
+	// Next is a single action or an array of actions.
+	nextObjs := []PdfObject{}
+	switch t := TraceToDirectObject(d.Get("Next")).(type) {
+	case *PdfObjectDictionary:
+		nextObjs = append(nextObjs, d.Get("Next"))
+	case *PdfObjectArray:
+		nextObjs = append(nextObjs, *t...)
+	}
+	for _, nextObj := range nextObjs {
+		next, err := newPdfActionFromPdfObject(nextObj, traversed)
+		if err != nil {
+			return nil, err
+		}
+		action.Next = append(action.Next, next)
+	}
+
+	return action, nil
+}
+
+// GetType returns the action type, e.g. "JavaScript".
+func (this *PdfAction) GetType() string {
+	if this.S == nil {
+		return ""
+	}
+	return string(*this.S)
+}
+
+// GetJavaScript returns the script of a JavaScript action, which is either a text string or a text stream.
+func (this *PdfAction) GetJavaScript() (string, error) {
+	switch t := TraceToDirectObject(this.JS).(type) {
+	case *PdfObjectString:
+		return DecodeTextString(*t), nil
+	case *PdfObjectStream:
+		data, err := DecodeStream(t)
+		if err != nil {
+			return "", err
+		}
+		return DecodeTextString(PdfObjectString(data)), nil
+	case nil:
+		return "", nil
+	}
+	common.Log.Debug("ERROR: JS not a string or stream (%T)", this.JS)
+	return "", ErrTypeError
+}
+
+// GetContainingPdfObject returns the indirect object containing the action dictionary.
+func (this *PdfAction) GetContainingPdfObject() PdfObject {
+	return this.primitive
+}
+
+// ToPdfObject returns the action dictionary in an indirect object.
+func (this *PdfAction) ToPdfObject() PdfObject {
+	container := this.primitive
+	d, ok := container.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		d = MakeDict()
+		container.PdfObject = d
+	}
+
+	d.Set("Type", MakeName("Action"))
+	d.SetIfNotNil("S", this.S)
+	d.SetIfNotNil("D", this.D)
+	d.SetIfNotNil("URI", this.URI)
+	d.SetIfNotNil("N", this.N)
+	d.SetIfNotNil("JS", this.JS)
+
+	switch len(this.Next) {
+	case 0:
+		d.Remove("Next")
+	case 1:
+		d.Set("Next", this.Next[0].ToPdfObject())
+	default:
+		next := MakeArray()
+		for _, action := range this.Next {
+			next.Append(action.ToPdfObject())
+		}
+		d.Set("Next", next)
+	}
+
+	return container
+}
+
+// getAdditionalAction loads the action of additional-actions dictionary `aa` for trigger event `key`. Returns nil
+// if there is no action for the event.
+func getAdditionalAction(aa *PdfObjectDictionary, key PdfObjectName) (*PdfAction, error) {
+	obj := aa.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	return NewPdfActionFromPdfObject(obj)
+}
+
+// setAdditionalAction sets the action for trigger event `key` in additional-actions dictionary `aa`.
+func setAdditionalAction(aa *PdfObjectDictionary, key PdfObjectName, action *PdfAction) {
+	if action != nil {
+		aa.Set(key, action.ToPdfObject())
+	}
+}
+
+// PdfPageAdditionalActions represents the additional-actions dictionary of a page (12.6.3 - Table 195).
+type PdfPageAdditionalActions struct {
+	O *PdfAction // Performed when the page is opened.
+	C *PdfAction // Performed when the page is closed.
+}
+
+// newPdfPageAdditionalActionsFromPdfObject loads the additional actions of a page from its AA entry `obj`.
+func newPdfPageAdditionalActionsFromPdfObject(obj PdfObject) (*PdfPageAdditionalActions, error) {
+	d, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: Page AA not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	aa := &PdfPageAdditionalActions{}
+	var err error
+	if aa.O, err = getAdditionalAction(d, "O"); err != nil {
+		return nil, err
+	}
+	if aa.C, err = getAdditionalAction(d, "C"); err != nil {
+		return nil, err
+	}
+	return aa, nil
+}
+
+// ToPdfObject returns the additional-actions dictionary.
+func (this *PdfPageAdditionalActions) ToPdfObject() PdfObject {
+	d := MakeDict()
+	setAdditionalAction(d, "O", this.O)
+	setAdditionalAction(d, "C", this.C)
+	return d
+}
+
+// GetAdditionalActions returns the additional actions of the page (AA entry), or nil if the page has none.
+func (this *PdfPage) GetAdditionalActions() (*PdfPageAdditionalActions, error) {
+	if this.AA == nil {
+		return nil, nil
+	}
+	return newPdfPageAdditionalActionsFromPdfObject(this.AA)
+}
+
+// SetAdditionalActions sets the additional actions of the page, or removes them if `aa` is nil.
+func (this *PdfPage) SetAdditionalActions(aa *PdfPageAdditionalActions) {
+	if aa == nil {
+		this.AA = nil
+		return
+	}
+	this.AA = aa.ToPdfObject()
+}
+
+// PdfDocumentAdditionalActions represents the additional-actions dictionary of the document catalog (12.6.3 -
+// Table 197). The actions are typically JavaScript actions.
+type PdfDocumentAdditionalActions struct {
+	WC *PdfAction // Performed before closing the document.
+	WS *PdfAction // Performed before saving the document.
+	DS *PdfAction // Performed after saving the document.
+	WP *PdfAction // Performed before printing the document.
+	DP *PdfAction // Performed after printing the document.
+}
+
+// newPdfDocumentAdditionalActionsFromPdfObject loads the additional actions of a document from its AA entry `obj`.
+func newPdfDocumentAdditionalActionsFromPdfObject(obj PdfObject) (*PdfDocumentAdditionalActions, error) {
+	d, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: Catalog AA not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	aa := &PdfDocumentAdditionalActions{}
+	for _, entry := range []struct {
+		key    PdfObjectName
+		action **PdfAction
+	}{{"WC", &aa.WC}, {"WS", &aa.WS}, {"DS", &aa.DS}, {"WP", &aa.WP}, {"DP", &aa.DP}} {
+		action, err := getAdditionalAction(d, entry.key)
+		if err != nil {
+			return nil, err
+		}
+		*entry.action = action
+	}
+	return aa, nil
+}
+
+// ToPdfObject returns the additional-actions dictionary.
+func (this *PdfDocumentAdditionalActions) ToPdfObject() PdfObject {
+	d := MakeDict()
+	setAdditionalAction(d, "WC", this.WC)
+	setAdditionalAction(d, "WS", this.WS)
+	setAdditionalAction(d, "DS", this.DS)
+	setAdditionalAction(d, "WP", this.WP)
+	setAdditionalAction(d, "DP", this.DP)
+	return d
+}
+
+// GetAdditionalActions returns the additional actions of the document (catalog AA entry), or nil if it has none.
+func (this *PdfReader) GetAdditionalActions() (*PdfDocumentAdditionalActions, error) {
+	obj, err := this.traceToObject(this.catalog.Get("AA"))
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	if err := this.traverseObjectData(obj); err != nil {
+		return nil, err
+	}
+	return newPdfDocumentAdditionalActionsFromPdfObject(obj)
+}
+
+// SetAdditionalActions sets the additional actions of the document (catalog AA entry), e.g. those of a document
+// read with PdfReader.GetAdditionalActions, which are not carried over otherwise.
+func (this *PdfWriter) SetAdditionalActions(aa *PdfDocumentAdditionalActions) error {
+	if aa == nil {
+		this.catalog.Remove("AA")
+		return nil
+	}
+	obj := aa.ToPdfObject()
+	this.catalog.Set("AA", obj)
+	return this.addObjects(obj)
+}
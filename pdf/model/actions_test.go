@@ -0,0 +1,108 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// testActionsObjects are the objects of a document with page and document additional actions.
+var testActionsObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /AA << /WS 4 0 R /DP << /S /URI /URI (https://example.com/printed) >> >> >>",
+	"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /AA << /O << /S /JavaScript /JS (app.alert\\('open'\\);) /Next [5 0 R] >> >> >>",
+	"<< /Type /Action /S /JavaScript /JS 6 0 R >>",
+	"<< /S /Named /N /NextPage >>",
+	"<< /Length 20 >>\nstream\nthis.dirty = false;\n\nendstream",
+}
+
+// checkTestActions checks the additional actions of the test document loaded by `reader`.
+func checkTestActions(t *testing.T, reader *PdfReader) {
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	pageAA, err := page.GetAdditionalActions()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if pageAA == nil || pageAA.O == nil || pageAA.C != nil {
+		t.Fatalf("Unexpected page actions %+v", pageAA)
+	}
+	if js, err := pageAA.O.GetJavaScript(); err != nil || js != "app.alert('open');" {
+		t.Errorf("Unexpected open action %q (%v)", js, err)
+	}
+	if len(pageAA.O.Next) != 1 || pageAA.O.Next[0].GetType() != ActionTypeNamed || string(*pageAA.O.Next[0].N) != "NextPage" {
+		t.Errorf("Unexpected next actions %v", pageAA.O.Next)
+	}
+
+	docAA, err := reader.GetAdditionalActions()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if docAA == nil || docAA.WS == nil || docAA.DP == nil || docAA.WC != nil {
+		t.Fatalf("Unexpected document actions %+v", docAA)
+	}
+	if js, err := docAA.WS.GetJavaScript(); err != nil || js != "this.dirty = false;\n" {
+		t.Errorf("Unexpected will save action %q (%v)", js, err)
+	}
+	if docAA.DP.GetType() != ActionTypeURI || string(*docAA.DP.URI) != "https://example.com/printed" {
+		t.Errorf("Unexpected did print action %+v", docAA.DP)
+	}
+}
+
+func TestAdditionalActions(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testActionsObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	checkTestActions(t, reader)
+
+	// Rewrite the document.
+	w := NewPdfWriter()
+	page, _ := reader.GetPage(1)
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	docAA, _ := reader.GetAdditionalActions()
+	if err := w.SetAdditionalActions(docAA); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	f, err := ioutil.TempFile("", "actions")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+	written, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	checkTestActions(t, written)
+}
+
+func TestSetPageAdditionalActions(t *testing.T) {
+	page := NewPdfPage()
+	page.SetAdditionalActions(&PdfPageAdditionalActions{C: NewPdfActionJavaScript("app.beep(0);")})
+	aa, err := page.GetAdditionalActions()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if aa.O != nil || aa.C == nil {
+		t.Fatalf("Unexpected actions %+v", aa)
+	}
+	if js, _ := aa.C.GetJavaScript(); js != "app.beep(0);" {
+		t.Errorf("Unexpected script %q", js)
+	}
+}
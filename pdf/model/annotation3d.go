@@ -0,0 +1,436 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Subtypes of 3D streams (13.6.3).
+const (
+	Pdf3DSubtypeU3D = "U3D"
+	Pdf3DSubtypePRC = "PRC"
+)
+
+// Pdf3DStream represents a 3D stream (13.6.3 - Table 300), containing the 3D artwork data in U3D or PRC
+// format along with the predefined views of the artwork.
+type Pdf3DStream struct {
+	Filter StreamEncoder
+
+	Subtype       *PdfObjectName
+	VA            []*Pdf3DView
+	DV            PdfObject // Default view: index into VA, name of a view or a 3D view dictionary.
+	Resources     PdfObject
+	OnInstantiate PdfObject
+	AN            PdfObject
+
+	// Stream data (encoded).
+	Stream []byte
+
+	primitive *PdfObjectStream
+}
+
+// NewPdf3DStream creates a new 3D stream from the raw U3D or PRC `data`, where `subtype` is Pdf3DSubtypeU3D or
+// Pdf3DSubtypePRC. The data is encoded with `encoder`, if nil the data is flate encoded.
+func NewPdf3DStream(subtype string, data []byte, encoder StreamEncoder) (*Pdf3DStream, error) {
+	if subtype != Pdf3DSubtypeU3D && subtype != Pdf3DSubtypePRC {
+		common.Log.Debug("ERROR: Unsupported 3D stream subtype: %s", subtype)
+		return nil, ErrInvalidAttribute
+	}
+	if encoder == nil {
+		encoder = NewFlateEncoder()
+	}
+
+	encoded, err := encoder.EncodeBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Pdf3DStream{}
+	s.Subtype = MakeName(subtype)
+	s.Filter = encoder
+	s.Stream = encoded
+	s.primitive = &PdfObjectStream{}
+	s.primitive.PdfObjectDictionary = MakeDict()
+	return s, nil
+}
+
+// NewPdf3DStreamFromStream loads a 3D stream model from a stream object.
+func NewPdf3DStreamFromStream(stream *PdfObjectStream) (*Pdf3DStream, error) {
+	dict := stream.PdfObjectDictionary
+
+	if obj := dict.Get("Type"); obj != nil {
+		name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok || *name != "3D" {
+			common.Log.Debug("Incompatibility: 3D stream Type != 3D (%v)", obj)
+		}
+	}
+
+	encoder, err := NewEncoderFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Pdf3DStream{}
+	s.primitive = stream
+	s.Filter = encoder
+	s.Stream = stream.Stream
+
+	if obj := dict.Get("Subtype"); obj != nil {
+		name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok {
+			common.Log.Debug("ERROR: 3D stream Subtype not a name (%T)", obj)
+			return nil, ErrTypeError
+		}
+		s.Subtype = name
+	} else {
+		common.Log.Debug("ERROR: 3D stream missing Subtype (required)")
+		return nil, ErrRequiredAttributeMissing
+	}
+
+	if obj := dict.Get("VA"); obj != nil {
+		arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+		if !ok {
+			common.Log.Debug("ERROR: 3D stream VA not an array (%T)", obj)
+			return nil, ErrTypeError
+		}
+		for _, vObj := range *arr {
+			vDict, ok := TraceToDirectObject(vObj).(*PdfObjectDictionary)
+			if !ok {
+				common.Log.Debug("ERROR: 3D view not a dictionary (%T) - skipping", vObj)
+				continue
+			}
+			view, err := newPdf3DViewFromDict(vDict)
+			if err != nil {
+				return nil, err
+			}
+			s.VA = append(s.VA, view)
+		}
+	}
+
+	s.DV = dict.Get("DV")
+	s.Resources = dict.Get("Resources")
+	s.OnInstantiate = dict.Get("OnInstantiate")
+	s.AN = dict.Get("AN")
+
+	return s, nil
+}
+
+// GetData returns the decoded 3D artwork data (U3D or PRC), e.g. for extraction to a file.
+func (s *Pdf3DStream) GetData() ([]byte, error) {
+	if s.Filter == nil {
+		return s.Stream, nil
+	}
+	return s.Filter.DecodeBytes(s.Stream)
+}
+
+// AddView appends a predefined view of the 3D artwork.
+func (s *Pdf3DStream) AddView(view *Pdf3DView) {
+	s.VA = append(s.VA, view)
+}
+
+// SetDefaultView sets the view that is initially shown by index into the predefined views.
+func (s *Pdf3DStream) SetDefaultView(index int) {
+	s.DV = MakeInteger(int64(index))
+}
+
+// GetContainingPdfObject returns the stream object containing the 3D stream.
+func (s *Pdf3DStream) GetContainingPdfObject() PdfObject {
+	return s.primitive
+}
+
+// ToPdfObject returns the 3D stream as a PDF stream object.
+func (s *Pdf3DStream) ToPdfObject() PdfObject {
+	stream := s.primitive
+
+	dict := stream.PdfObjectDictionary
+	if s.Filter != nil {
+		// Pre-populate the stream dictionary with the encoding related fields.
+		dict = s.Filter.MakeStreamDict()
+		stream.PdfObjectDictionary = dict
+	}
+	dict.Set("Type", MakeName("3D"))
+	dict.SetIfNotNil("Subtype", s.Subtype)
+	if len(s.VA) > 0 {
+		arr := PdfObjectArray{}
+		for _, view := range s.VA {
+			arr = append(arr, view.ToPdfObject())
+		}
+		dict.Set("VA", &arr)
+	}
+	dict.SetIfNotNil("DV", s.DV)
+	dict.SetIfNotNil("Resources", s.Resources)
+	dict.SetIfNotNil("OnInstantiate", s.OnInstantiate)
+	dict.SetIfNotNil("AN", s.AN)
+
+	dict.Set("Length", MakeInteger(int64(len(s.Stream))))
+	stream.Stream = s.Stream
+
+	return stream
+}
+
+// Pdf3DView represents a 3D view dictionary (13.6.4 - Table 304), specifying the camera position, projection,
+// background, render mode and lighting used to show the 3D artwork.
+type Pdf3DView struct {
+	XN      *PdfObjectString // External name, shown in the user interface.
+	IN      *PdfObjectString // Internal name.
+	MS      *PdfObjectName   // Matrix specification: M or U3D.
+	C2W     []float64        // Camera to world transformation matrix (12 elements), if MS is M.
+	U3DPath PdfObject
+	CO      *float64 // Distance from the camera to the center of orbit.
+	P       PdfObject
+	O       PdfObject
+	BG      PdfObject
+	RM      PdfObject
+	LS      PdfObject
+	SA      PdfObject
+	NA      PdfObject
+	NR      PdfObject
+
+	primitive *PdfObjectDictionary
+}
+
+// NewPdf3DView returns a new 3D view with external name `name`.
+func NewPdf3DView(name string) *Pdf3DView {
+	view := &Pdf3DView{}
+	view.XN = MakeString(name)
+	view.primitive = MakeDict()
+	return view
+}
+
+// SetCamera sets the camera to world transformation matrix `c2w` as 12 numbers in column order
+// (3x3 rotation followed by the translation), and the distance `co` from the camera to the center of orbit.
+func (view *Pdf3DView) SetCamera(c2w [12]float64, co float64) {
+	view.MS = MakeName("M")
+	view.C2W = c2w[:]
+	view.CO = &co
+}
+
+// SetPerspectiveProjection sets a perspective projection with field of view angle `fov` in degrees.
+func (view *Pdf3DView) SetPerspectiveProjection(fov float64) {
+	proj := MakeDict()
+	proj.Set("Subtype", MakeName("P"))
+	proj.Set("FOV", MakeFloat(fov))
+	view.P = proj
+}
+
+// SetOrthographicProjection sets an orthographic projection with the specified scale factor.
+func (view *Pdf3DView) SetOrthographicProjection(scale float64) {
+	proj := MakeDict()
+	proj.Set("Subtype", MakeName("O"))
+	proj.Set("OS", MakeFloat(scale))
+	view.P = proj
+}
+
+// SetBackgroundColor sets an RGB background color for the view, with components in the range [0,1].
+func (view *Pdf3DView) SetBackgroundColor(r, g, b float64) {
+	bg := MakeDict()
+	bg.Set("Type", MakeName("3DBG"))
+	bg.Set("CS", MakeName("DeviceRGB"))
+	bg.Set("C", MakeArrayFromFloats([]float64{r, g, b}))
+	view.BG = bg
+}
+
+// SetRenderMode sets the render mode of the view, e.g. "Solid", "Wireframe" or "Transparent"
+// (13.6.4.4 - Table 309).
+func (view *Pdf3DView) SetRenderMode(mode string) {
+	rm := MakeDict()
+	rm.Set("Type", MakeName("3DRenderMode"))
+	rm.Set("Subtype", MakeName(mode))
+	view.RM = rm
+}
+
+// SetLighting sets the lighting scheme of the view, e.g. "Artwork", "White", "Day" or "CAD"
+// (13.6.4.5 - Table 310).
+func (view *Pdf3DView) SetLighting(scheme string) {
+	ls := MakeDict()
+	ls.Set("Type", MakeName("3DLightingScheme"))
+	ls.Set("Subtype", MakeName(scheme))
+	view.LS = ls
+}
+
+func newPdf3DViewFromDict(d *PdfObjectDictionary) (*Pdf3DView, error) {
+	view := &Pdf3DView{}
+	view.primitive = d
+
+	if obj := d.Get("XN"); obj != nil {
+		str, ok := TraceToDirectObject(obj).(*PdfObjectString)
+		if !ok {
+			return nil, fmt.Errorf("3D view XN not a string (%T)", obj)
+		}
+		view.XN = str
+	}
+	if obj := d.Get("IN"); obj != nil {
+		str, ok := TraceToDirectObject(obj).(*PdfObjectString)
+		if !ok {
+			return nil, fmt.Errorf("3D view IN not a string (%T)", obj)
+		}
+		view.IN = str
+	}
+	if obj := d.Get("MS"); obj != nil {
+		name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok {
+			return nil, fmt.Errorf("3D view MS not a name (%T)", obj)
+		}
+		view.MS = name
+	}
+	if obj := d.Get("C2W"); obj != nil {
+		arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+		if !ok {
+			return nil, fmt.Errorf("3D view C2W not an array (%T)", obj)
+		}
+		c2w, err := getNumbersAsFloat(*arr)
+		if err != nil {
+			return nil, err
+		}
+		if len(c2w) != 12 {
+			common.Log.Debug("ERROR: 3D view C2W invalid length (%d != 12)", len(c2w))
+			return nil, errors.New("Invalid C2W length")
+		}
+		view.C2W = c2w
+	}
+	if obj := d.Get("CO"); obj != nil {
+		co, err := getNumberAsFloat(TraceToDirectObject(obj))
+		if err != nil {
+			return nil, err
+		}
+		view.CO = &co
+	}
+
+	view.U3DPath = d.Get("U3DPath")
+	view.P = d.Get("P")
+	view.O = d.Get("O")
+	view.BG = d.Get("BG")
+	view.RM = d.Get("RM")
+	view.LS = d.Get("LS")
+	view.SA = d.Get("SA")
+	view.NA = d.Get("NA")
+	view.NR = d.Get("NR")
+
+	return view, nil
+}
+
+// GetContainingPdfObject returns the 3D view dictionary.
+func (view *Pdf3DView) GetContainingPdfObject() PdfObject {
+	return view.primitive
+}
+
+// ToPdfObject returns the 3D view as a PDF dictionary.
+func (view *Pdf3DView) ToPdfObject() PdfObject {
+	d := view.primitive
+	d.Set("Type", MakeName("3DView"))
+	d.SetIfNotNil("XN", view.XN)
+	d.SetIfNotNil("IN", view.IN)
+	d.SetIfNotNil("MS", view.MS)
+	if len(view.C2W) > 0 {
+		d.Set("C2W", MakeArrayFromFloats(view.C2W))
+	}
+	d.SetIfNotNil("U3DPath", view.U3DPath)
+	if view.CO != nil {
+		d.Set("CO", MakeFloat(*view.CO))
+	}
+	d.SetIfNotNil("P", view.P)
+	d.SetIfNotNil("O", view.O)
+	d.SetIfNotNil("BG", view.BG)
+	d.SetIfNotNil("RM", view.RM)
+	d.SetIfNotNil("LS", view.LS)
+	d.SetIfNotNil("SA", view.SA)
+	d.SetIfNotNil("NA", view.NA)
+	d.SetIfNotNil("NR", view.NR)
+	return d
+}
+
+// Pdf3DActivation represents a 3D activation dictionary (13.6.2 - Table 299), specifying when the 3D
+// artwork of an annotation is activated and deactivated and how it is presented.
+type Pdf3DActivation struct {
+	A  string // Activation: PO (page opened), PV (page visible) or XA (explicit activation).
+	D  string // Deactivation: PC (page closed), PI (page invisible) or XD (explicit deactivation).
+	TB *bool  // Show the toolbar.
+	NP *bool  // Show the model tree (navigation panel).
+}
+
+// ToPdfObject returns the 3D activation as a PDF dictionary.
+func (act *Pdf3DActivation) ToPdfObject() PdfObject {
+	d := MakeDict()
+	if len(act.A) > 0 {
+		d.Set("A", MakeName(act.A))
+	}
+	if len(act.D) > 0 {
+		d.Set("D", MakeName(act.D))
+	}
+	if act.TB != nil {
+		d.Set("TB", MakeBool(*act.TB))
+	}
+	if act.NP != nil {
+		d.Set("NP", MakeBool(*act.NP))
+	}
+	return d
+}
+
+func newPdf3DActivationFromDict(d *PdfObjectDictionary) *Pdf3DActivation {
+	act := &Pdf3DActivation{}
+	if name, ok := TraceToDirectObject(d.Get("A")).(*PdfObjectName); ok {
+		act.A = string(*name)
+	}
+	if name, ok := TraceToDirectObject(d.Get("D")).(*PdfObjectName); ok {
+		act.D = string(*name)
+	}
+	if b, ok := TraceToDirectObject(d.Get("TB")).(*PdfObjectBool); ok {
+		val := bool(*b)
+		act.TB = &val
+	}
+	if b, ok := TraceToDirectObject(d.Get("NP")).(*PdfObjectBool); ok {
+		val := bool(*b)
+		act.NP = &val
+	}
+	return act
+}
+
+// Set3DStream sets the 3D artwork of the annotation. The initial view of the annotation is the default view
+// of the stream. The stream is converted to its PDF representation when set, so any views should be added
+// beforehand.
+func (this *PdfAnnotation3D) Set3DStream(s *Pdf3DStream) {
+	this.T3DD = s.ToPdfObject()
+}
+
+// Get3DStream returns the 3D stream of the annotation, e.g. to extract the U3D or PRC data.
+// Supports both direct 3D streams and 3D reference dictionaries (13.6.3.3).
+func (this *PdfAnnotation3D) Get3DStream() (*Pdf3DStream, error) {
+	obj := TraceToDirectObject(this.T3DD)
+	if d, isDict := obj.(*PdfObjectDictionary); isDict {
+		// 3D reference dictionary.
+		obj = TraceToDirectObject(d.Get("3DD"))
+	}
+	stream, ok := obj.(*PdfObjectStream)
+	if !ok {
+		common.Log.Debug("ERROR: 3DD not a stream (%T)", obj)
+		return nil, ErrTypeError
+	}
+	return NewPdf3DStreamFromStream(stream)
+}
+
+// Set3DActivation sets the activation settings of the annotation.
+func (this *PdfAnnotation3D) Set3DActivation(act *Pdf3DActivation) {
+	this.T3DA = act.ToPdfObject()
+}
+
+// Get3DActivation returns the activation settings of the annotation or nil if not set.
+func (this *PdfAnnotation3D) Get3DActivation() *Pdf3DActivation {
+	d, ok := TraceToDirectObject(this.T3DA).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	return newPdf3DActivationFromDict(d)
+}
+
+// SetInitialView sets the initial view of the annotation by index into the views of the 3D stream.
+func (this *PdfAnnotation3D) SetInitialView(index int) {
+	this.T3DV = MakeInteger(int64(index))
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Test embedding a 3D stream in an annotation and extracting it again.
+func Test3DAnnotationRoundtrip(t *testing.T) {
+	data := []byte("U3D\x00 dummy artwork data")
+
+	s, err := NewPdf3DStream(Pdf3DSubtypeU3D, data, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	view := NewPdf3DView("Front")
+	view.SetCamera([12]float64{1, 0, 0, 0, 0, -1, 0, 1, 0, 0, -100, 0}, 100)
+	view.SetPerspectiveProjection(30)
+	view.SetBackgroundColor(1, 1, 1)
+	view.SetRenderMode("Solid")
+	s.AddView(view)
+	s.SetDefaultView(0)
+
+	annot := NewPdfAnnotation3D()
+	annot.Rect = MakeArrayFromFloats([]float64{100, 100, 400, 400})
+	annot.Set3DStream(s)
+	showToolbar := true
+	annot.Set3DActivation(&Pdf3DActivation{A: "PO", D: "PC", TB: &showToolbar})
+
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{0, 0, 612, 792}
+	page.Resources = NewPdfPageResources()
+	page.Annotations = append(page.Annotations, annot.PdfAnnotation)
+
+	w := NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+
+	f, err := ioutil.TempFile("", "annot3d")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+
+	reader, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	rpage, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(rpage.Annotations) != 1 {
+		t.Fatalf("Expected 1 annotation, got %d", len(rpage.Annotations))
+	}
+	rannot, ok := rpage.Annotations[0].GetContext().(*PdfAnnotation3D)
+	if !ok {
+		t.Fatalf("Not a 3D annotation (%T)", rpage.Annotations[0].GetContext())
+	}
+
+	rs, err := rannot.Get3DStream()
+	if err != nil {
+		t.Fatalf("Error loading 3D stream: %v", err)
+	}
+	if *rs.Subtype != Pdf3DSubtypeU3D {
+		t.Errorf("Subtype mismatch: %s", *rs.Subtype)
+	}
+	extracted, err := rs.GetData()
+	if err != nil {
+		t.Fatalf("Error decoding: %v", err)
+	}
+	if string(extracted) != string(data) {
+		t.Errorf("Data mismatch: %q", extracted)
+	}
+	if len(rs.VA) != 1 || rs.VA[0].XN == nil || string(*rs.VA[0].XN) != "Front" {
+		t.Fatalf("View mismatch: %+v", rs.VA)
+	}
+	if len(rs.VA[0].C2W) != 12 || rs.VA[0].CO == nil || *rs.VA[0].CO != 100 {
+		t.Errorf("Camera mismatch: %v %v", rs.VA[0].C2W, rs.VA[0].CO)
+	}
+
+	act := rannot.Get3DActivation()
+	if act == nil || act.A != "PO" || act.D != "PC" || act.TB == nil || !*act.TB || act.NP != nil {
+		t.Errorf("Activation mismatch: %+v", act)
+	}
+}
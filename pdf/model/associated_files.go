@@ -0,0 +1,104 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Relationships of associated files (AFRelationship) to the document.
+const (
+	AFRelationshipSource      = "Source"      // The original content the document was created from.
+	AFRelationshipData        = "Data"        // Data used to derive a visual presentation, e.g. a table or graph.
+	AFRelationshipAlternative = "Alternative" // An alternative representation of the content, e.g. audio.
+	AFRelationshipSupplement  = "Supplement"  // A supplemental representation of the content, e.g. a report.
+	AFRelationshipUnspecified = "Unspecified" // The relationship is not known or cannot be described.
+)
+
+// reportMimeTypes are the MIME types of report files by file name extension.
+var reportMimeTypes = map[string]string{
+	".json": "application/json",
+	".pdf":  "application/pdf",
+	".xml":  "application/xml",
+	".txt":  "text/plain",
+}
+
+// NewReportFileSpec returns a file specification embedding report `data` with file name `name`, e.g. a preflight
+// or signature validation report, for associating with the document as evidence with PdfWriter.AddAssociatedFile.
+// The MIME type is derived from the file name extension (.json, .pdf, .xml or .txt) and the relationship to the
+// document is Supplement.
+func NewReportFileSpec(name string, data []byte, description string) (*PdfFileSpec, error) {
+	ef, err := NewPdfEmbeddedFile(data, reportMimeTypes[strings.ToLower(filepath.Ext(name))])
+	if err != nil {
+		return nil, err
+	}
+	fs := NewPdfFileSpecFromEmbeddedFile(name, ef)
+	fs.UF = EncodeTextString(name)
+	if description != "" {
+		fs.Desc = EncodeTextString(description)
+	}
+	fs.AFRelationship = MakeName(AFRelationshipSupplement)
+	return fs, nil
+}
+
+// AddAssociatedFile associates file `fs` with the document (14.13): it is listed in the AF array of the catalog,
+// and in the embedded files name tree so that viewers show it as an attachment. Associated files are a PDF 2.0
+// and PDF/A-3 feature, other readers only see the attachment.
+func (this *PdfWriter) AddAssociatedFile(fs *PdfFileSpec) {
+	this.associatedFiles = append(this.associatedFiles, fs)
+}
+
+// getAssociatedFiles returns the AF array and the embedded files name tree of the associated files. The name
+// tree keys are the file names, made unique with a number suffix.
+func (this *PdfWriter) getAssociatedFiles() (*PdfObjectArray, *PdfObjectDictionary) {
+	af := MakeArray()
+	files := map[string]PdfObject{}
+	for _, fs := range this.associatedFiles {
+		obj := fs.ToPdfObject()
+		af.Append(obj)
+
+		key := fs.GetFileName()
+		for i := 2; files[key] != nil; i++ {
+			key = fmt.Sprintf("%s (%d)", fs.GetFileName(), i)
+		}
+		files[key] = obj
+	}
+	return af, makeNameTree(files)
+}
+
+// GetAssociatedFiles returns the files associated with the document, in the AF array of the catalog.
+func (this *PdfReader) GetAssociatedFiles() ([]*PdfFileSpec, error) {
+	files := []*PdfFileSpec{}
+	obj, err := this.traceToObject(this.catalog.Get("AF"))
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+	if !ok {
+		return files, nil
+	}
+	for _, elem := range *arr {
+		elem, err := this.traceToObject(elem)
+		if err != nil {
+			return nil, err
+		}
+		if err := this.traverseObjectData(elem); err != nil {
+			return nil, err
+		}
+		fs, err := NewPdfFileSpecFromPdfObject(elem)
+		if err != nil {
+			common.Log.Debug("ERROR: Invalid associated file: %v", err)
+			return nil, err
+		}
+		files = append(files, fs)
+	}
+	return files, nil
+}
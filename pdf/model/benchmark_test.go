@@ -0,0 +1,151 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// benchmarkCorpus are the bundled files the performance benchmarks run on. Files reported as slow can be added to
+// the testfiles directory and listed here to get a baseline.
+var benchmarkCorpus = []string{
+	"../../testfiles/minimal.pdf",
+	"../../testfiles/templates1.pdf",
+	"../../testfiles/lorem.pdf",
+}
+
+// Allocation budgets for parsing the corpus files (loading the document and all pages). Parsing a file within
+// its budget guards against accidental allocation regressions; lower the budget when improving performance.
+var parseAllocationBudgets = map[string]float64{
+	"minimal.pdf":    2000,
+	"templates1.pdf": 8000,
+	"lorem.pdf":      20000,
+}
+
+// memWriteSeeker is an in-memory io.WriteSeeker for writing documents without touching disk.
+type memWriteSeeker struct {
+	buf []byte
+	off int
+}
+
+func (ws *memWriteSeeker) Write(p []byte) (int, error) {
+	if end := ws.off + len(p); end > len(ws.buf) {
+		ws.buf = append(ws.buf, make([]byte, end-len(ws.buf))...)
+	}
+	copy(ws.buf[ws.off:], p)
+	ws.off += len(p)
+	return len(p), nil
+}
+
+func (ws *memWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += int64(ws.off)
+	case io.SeekEnd:
+		offset += int64(len(ws.buf))
+	}
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	ws.off = int(offset)
+	return offset, nil
+}
+
+// loadBenchmarkFile returns the contents of corpus file `path`.
+func loadBenchmarkFile(tb testing.TB, path string) []byte {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("Error reading %s: %v", path, err)
+	}
+	return data
+}
+
+// parseDocument reads `data` and loads all its pages.
+func parseDocument(data []byte) (*PdfReader, error) {
+	reader, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i <= numPages; i++ {
+		if _, err := reader.GetPageAsIndirectObject(i); err != nil {
+			return nil, err
+		}
+	}
+	return reader, nil
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, path := range benchmarkCorpus {
+		data := loadBenchmarkFile(b, path)
+		b.Run(filepath.Base(path), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				if _, err := parseDocument(data); err != nil {
+					b.Fatalf("Error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWrite(b *testing.B) {
+	for _, path := range benchmarkCorpus {
+		data := loadBenchmarkFile(b, path)
+		b.Run(filepath.Base(path), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				// The pages are modified when added to a writer, so the document is parsed for each iteration.
+				b.StopTimer()
+				reader, err := parseDocument(data)
+				if err != nil {
+					b.Fatalf("Error: %v", err)
+				}
+				b.StartTimer()
+
+				w := NewPdfWriter()
+				for _, page := range reader.PageList {
+					if err := w.AddPage(page); err != nil {
+						b.Fatalf("Error: %v", err)
+					}
+				}
+				if err := w.Write(&memWriteSeeker{}); err != nil {
+					b.Fatalf("Error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseAllocationBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping allocation budget test in short mode")
+	}
+	for _, path := range benchmarkCorpus {
+		data := loadBenchmarkFile(t, path)
+		name := filepath.Base(path)
+		allocs := testing.AllocsPerRun(3, func() {
+			if _, err := parseDocument(data); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		})
+		t.Logf("%s: %.0f allocations", name, allocs)
+		if budget := parseAllocationBudgets[name]; allocs > budget {
+			t.Errorf("%s: %.0f allocations exceed the budget of %.0f", name, allocs, budget)
+		}
+	}
+}
@@ -348,6 +348,22 @@ func (this *PdfColorDeviceRGB) ToGray() *PdfColorDeviceGray {
 	return NewPdfColorDeviceGray(grayValue)
 }
 
+// ToCMYK converts the color to DeviceCMYK using the device-independent conversion with full black generation and
+// undercolor removal (section 10.3.5 p. 308), i.e. K = 1 - max(R,G,B).
+func (this *PdfColorDeviceRGB) ToCMYK() *PdfColorDeviceCMYK {
+	r, g, b := this.R(), this.G(), this.B()
+
+	k := 1 - math.Max(r, math.Max(g, b))
+	if k >= 1.0 {
+		return NewPdfColorDeviceCMYK(0, 0, 0, 1)
+	}
+	c := (1 - r - k) / (1 - k)
+	m := (1 - g - k) / (1 - k)
+	y := (1 - b - k) / (1 - k)
+
+	return NewPdfColorDeviceCMYK(c, m, y, k)
+}
+
 // RGB colorspace.
 
 type PdfColorspaceDeviceRGB struct{}
@@ -457,6 +473,32 @@ func (this *PdfColorspaceDeviceRGB) ImageToGray(img Image) (Image, error) {
 	return grayImage, nil
 }
 
+// ImageToCMYK converts an RGB image to DeviceCMYK, using the same conversion as PdfColorDeviceRGB.ToCMYK.
+func (this *PdfColorspaceDeviceRGB) ImageToCMYK(img Image) (Image, error) {
+	cmykImage := img
+
+	samples := img.GetSamples()
+
+	maxVal := math.Pow(2, float64(img.BitsPerComponent)) - 1
+	cmykSamples := []uint32{}
+	for i := 0; i+2 < len(samples); i += 3 {
+		// Normalized data, range 0-1.
+		r := float64(samples[i]) / maxVal
+		g := float64(samples[i+1]) / maxVal
+		b := float64(samples[i+2]) / maxVal
+
+		cmyk := NewPdfColorDeviceRGB(r, g, b).ToCMYK()
+		for _, val := range []float64{cmyk.C(), cmyk.M(), cmyk.Y(), cmyk.K()} {
+			val = math.Min(math.Max(val, 0.0), 1.0)
+			cmykSamples = append(cmykSamples, uint32(val*maxVal+0.5))
+		}
+	}
+	cmykImage.SetSamples(cmykSamples)
+	cmykImage.ColorComponents = 4
+
+	return cmykImage, nil
+}
+
 //////////////////////
 // DeviceCMYK
 // C, M, Y, K components.
@@ -14,4 +14,8 @@ var (
 	ErrInvalidAttribute         = errors.New("Invalid attribute")
 	ErrTypeError                = errors.New("Type check error")
 	ErrRangeError               = errors.New("Range check error")
+	ErrFieldNotFound            = errors.New("Field not found")
+	ErrPermissionDenied         = errors.New("Operation not allowed by the document permissions")
+	ErrEmbeddedFileCorrupted    = errors.New("Embedded file size or checksum mismatch")
+	ErrFieldLocked              = errors.New("Field locked by a signature")
 )
@@ -0,0 +1,264 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfDSS is the document security store (DSS, ISO 32000-2 12.8.4.3) of a document: the validation material of
+// its signatures (certificates, OCSP responses and CRLs) needed to validate them in the long term (PAdES-LT).
+// The material is DER encoded.
+type PdfDSS struct {
+	Certs [][]byte
+	OCSPs [][]byte
+	CRLs  [][]byte
+
+	// Validation related information of the signatures, keyed by the VRI key of their Contents (see VRIKey).
+	VRI map[string]*PdfVRI
+}
+
+// PdfVRI is the validation related information (VRI) of a signature: the subset of the validation material of the
+// DSS used to validate it.
+type PdfVRI struct {
+	Certs [][]byte
+	OCSPs [][]byte
+	CRLs  [][]byte
+
+	// Time at which the information was created (TU entry), zero if not known.
+	TU time.Time
+}
+
+// NewPdfDSS returns an empty document security store.
+func NewPdfDSS() *PdfDSS {
+	return &PdfDSS{VRI: map[string]*PdfVRI{}}
+}
+
+// VRIKey returns the key of the VRI entry of a signature with Contents `contents`: the upper case hexadecimal
+// SHA-1 digest of the Contents string.
+func VRIKey(contents []byte) string {
+	digest := sha1.Sum(contents)
+	return strings.ToUpper(hex.EncodeToString(digest[:]))
+}
+
+// AddValidationData adds certificates `certs`, OCSP responses `ocsps` and CRLs `crls` to the store, and records
+// them in the VRI entry of the signature with Contents `contents` if not nil, with creation time `tu`. Material
+// already in the store is not added again.
+func (dss *PdfDSS) AddValidationData(contents []byte, certs, ocsps, crls [][]byte, tu time.Time) {
+	dss.Certs = appendUniqueBytes(dss.Certs, certs...)
+	dss.OCSPs = appendUniqueBytes(dss.OCSPs, ocsps...)
+	dss.CRLs = appendUniqueBytes(dss.CRLs, crls...)
+	if contents == nil {
+		return
+	}
+
+	if dss.VRI == nil {
+		dss.VRI = map[string]*PdfVRI{}
+	}
+	key := VRIKey(contents)
+	vri, ok := dss.VRI[key]
+	if !ok {
+		vri = &PdfVRI{}
+		dss.VRI[key] = vri
+	}
+	vri.Certs = appendUniqueBytes(vri.Certs, certs...)
+	vri.OCSPs = appendUniqueBytes(vri.OCSPs, ocsps...)
+	vri.CRLs = appendUniqueBytes(vri.CRLs, crls...)
+	vri.TU = tu
+}
+
+// appendUniqueBytes appends the elements of `add` not yet in `list` to it.
+func appendUniqueBytes(list [][]byte, add ...[]byte) [][]byte {
+	for _, b := range add {
+		found := false
+		for _, existing := range list {
+			if bytes.Equal(existing, b) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, b)
+		}
+	}
+	return list
+}
+
+// GetDSS returns the document security store of the document (catalog DSS entry). Returns nil if not present.
+func (this *PdfReader) GetDSS() (*PdfDSS, error) {
+	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
+		return nil, ErrEncrypted
+	}
+	obj, err := this.traceToObject(this.catalog.Get("DSS"))
+	if err != nil {
+		return nil, err
+	}
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return nil, nil
+	}
+
+	dss := NewPdfDSS()
+	if dss.Certs, err = this.loadDSSStreams(dict.Get("Certs")); err != nil {
+		return nil, err
+	}
+	if dss.OCSPs, err = this.loadDSSStreams(dict.Get("OCSPs")); err != nil {
+		return nil, err
+	}
+	if dss.CRLs, err = this.loadDSSStreams(dict.Get("CRLs")); err != nil {
+		return nil, err
+	}
+
+	obj, err = this.traceToObject(dict.Get("VRI"))
+	if err != nil {
+		return nil, err
+	}
+	vriDict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return dss, nil
+	}
+	for _, key := range vriDict.Keys() {
+		obj, err := this.traceToObject(vriDict.Get(key))
+		if err != nil {
+			return nil, err
+		}
+		entry, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+		if !ok {
+			common.Log.Debug("Invalid VRI entry %s (%T)", key, obj)
+			continue
+		}
+		vri := &PdfVRI{}
+		if vri.Certs, err = this.loadDSSStreams(entry.Get("Cert")); err != nil {
+			return nil, err
+		}
+		if vri.OCSPs, err = this.loadDSSStreams(entry.Get("OCSP")); err != nil {
+			return nil, err
+		}
+		if vri.CRLs, err = this.loadDSSStreams(entry.Get("CRL")); err != nil {
+			return nil, err
+		}
+		if tu, err := this.traceToObject(entry.Get("TU")); err == nil {
+			if str, ok := TraceToDirectObject(tu).(*PdfObjectString); ok {
+				if date, err := NewPdfDate(string(*str)); err == nil {
+					vri.TU = date.ToGoTime()
+				}
+			}
+		}
+		dss.VRI[strings.ToUpper(string(key))] = vri
+	}
+	return dss, nil
+}
+
+// loadDSSStreams returns the decoded data of the array of streams `obj`.
+func (this *PdfReader) loadDSSStreams(obj PdfObject) ([][]byte, error) {
+	obj, err := this.traceToObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+	if !ok {
+		return nil, nil
+	}
+	var data [][]byte
+	for _, elem := range *arr {
+		elem, err := this.traceToObject(elem)
+		if err != nil {
+			return nil, err
+		}
+		stream, ok := elem.(*PdfObjectStream)
+		if !ok {
+			common.Log.Debug("Invalid DSS entry %T, expecting a stream", elem)
+			continue
+		}
+		decoded, err := DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, decoded)
+	}
+	return data, nil
+}
+
+// ToPdfObject returns the DSS dictionary of the store. Each certificate, OCSP response and CRL is written as a
+// single stream shared by the arrays of the DSS and its VRI entries.
+func (dss *PdfDSS) ToPdfObject() PdfObject {
+	streams := map[string]*PdfObjectStream{}
+	makeStreams := func(list [][]byte) *PdfObjectArray {
+		arr := &PdfObjectArray{}
+		for _, data := range list {
+			stream, ok := streams[string(data)]
+			if !ok {
+				var err error
+				stream, err = MakeStream(data, NewFlateEncoder())
+				if err != nil {
+					// The flate encoder does not fail on valid data, fall back to raw data just in case.
+					common.Log.Debug("ERROR: Failed to encode DSS stream: %v", err)
+					stream, _ = MakeStream(data, NewRawEncoder())
+				}
+				streams[string(data)] = stream
+			}
+			arr.Append(stream)
+		}
+		return arr
+	}
+
+	dict := MakeDict()
+	dict.Set("Type", MakeName("DSS"))
+	if len(dss.Certs) > 0 {
+		dict.Set("Certs", makeStreams(dss.Certs))
+	}
+	if len(dss.OCSPs) > 0 {
+		dict.Set("OCSPs", makeStreams(dss.OCSPs))
+	}
+	if len(dss.CRLs) > 0 {
+		dict.Set("CRLs", makeStreams(dss.CRLs))
+	}
+
+	if len(dss.VRI) > 0 {
+		keys := make([]string, 0, len(dss.VRI))
+		for key := range dss.VRI {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		vriDict := MakeDict()
+		for _, key := range keys {
+			vri := dss.VRI[key]
+			entry := MakeDict()
+			entry.Set("Type", MakeName("VRI"))
+			if len(vri.Certs) > 0 {
+				entry.Set("Cert", makeStreams(vri.Certs))
+			}
+			if len(vri.OCSPs) > 0 {
+				entry.Set("OCSP", makeStreams(vri.OCSPs))
+			}
+			if len(vri.CRLs) > 0 {
+				entry.Set("CRL", makeStreams(vri.CRLs))
+			}
+			if !vri.TU.IsZero() {
+				date := NewPdfDateFromTime(vri.TU)
+				entry.Set("TU", date.ToPdfObject())
+			}
+			vriDict.Set(PdfObjectName(key), entry)
+		}
+		dict.Set("VRI", vriDict)
+	}
+	return MakeIndirectObject(dict)
+}
+
+// SetDSS sets the document security store of the document (catalog DSS entry), e.g. obtained from
+// PdfReader.GetDSS and extended with AddValidationData.
+func (this *PdfWriter) SetDSS(dss *PdfDSS) {
+	this.dss = dss
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDSS(t *testing.T) {
+	contents := []byte{0x30, 0x80, 0x00, 0x00}
+	cert := []byte("certificate")
+	ocsp := []byte("ocsp response")
+	crl := []byte("crl")
+	tu := time.Date(2018, 1, 2, 3, 4, 5, 0, time.FixedZone("", -5*3600-30*60))
+
+	dss := NewPdfDSS()
+	dss.AddValidationData(contents, [][]byte{cert}, [][]byte{ocsp}, nil, tu)
+	dss.AddValidationData(nil, [][]byte{cert}, nil, [][]byte{crl}, time.Time{})
+	if len(dss.Certs) != 1 || len(dss.OCSPs) != 1 || len(dss.CRLs) != 1 {
+		t.Fatalf("Unexpected DSS contents %d %d %d", len(dss.Certs), len(dss.OCSPs), len(dss.CRLs))
+	}
+
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+	page.Resources = NewPdfPageResources()
+	w := NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	w.SetDSS(dss)
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err := NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	read, err := reader.GetDSS()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if read == nil {
+		t.Fatalf("DSS missing")
+	}
+	if len(read.Certs) != 1 || !bytes.Equal(read.Certs[0], cert) {
+		t.Errorf("Unexpected certificates %q", read.Certs)
+	}
+	if len(read.OCSPs) != 1 || !bytes.Equal(read.OCSPs[0], ocsp) {
+		t.Errorf("Unexpected OCSP responses %q", read.OCSPs)
+	}
+	if len(read.CRLs) != 1 || !bytes.Equal(read.CRLs[0], crl) {
+		t.Errorf("Unexpected CRLs %q", read.CRLs)
+	}
+
+	key := VRIKey(contents)
+	if len(key) != 40 || key != strings.ToUpper(key) {
+		t.Errorf("Invalid VRI key %s", key)
+	}
+	if len(read.VRI) != 1 {
+		t.Fatalf("Unexpected VRI %v", read.VRI)
+	}
+	vri, ok := read.VRI[key]
+	if !ok {
+		t.Fatalf("VRI entry %s missing: %v", key, read.VRI)
+	}
+	if len(vri.Certs) != 1 || !bytes.Equal(vri.Certs[0], cert) || len(vri.OCSPs) != 1 || len(vri.CRLs) != 0 {
+		t.Errorf("Unexpected VRI entry %+v", vri)
+	}
+	if !vri.TU.Equal(tu) {
+		t.Errorf("TU %v != %v", vri.TU, tu)
+	}
+
+	// A document without DSS.
+	reader, err = NewPdfReader(bytes.NewReader(makeTestPdf(testFieldHierarchyObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if read, err := reader.GetDSS(); err != nil || read != nil {
+		t.Errorf("Expected no DSS, got %v (%v)", read, err)
+	}
+}
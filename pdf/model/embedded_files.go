@@ -0,0 +1,127 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/md5"
+	"io"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfNamedFile is a file of the embedded files name tree of the document (EmbeddedFiles entry of the catalog
+// Names dictionary).
+type PdfNamedFile struct {
+	Name string
+	File *PdfFileSpec
+}
+
+// GetEmbeddedFiles returns the files of the embedded files name tree of the document, the attachments shown by
+// viewers, in name tree order. Their contents can be extracted with PdfEmbeddedFile.WriteTo.
+func (this *PdfReader) GetEmbeddedFiles() ([]*PdfNamedFile, error) {
+	files := []*PdfNamedFile{}
+	err := this.collectNamedPages("EmbeddedFiles", func(name string, obj PdfObject) error {
+		fs, err := NewPdfFileSpecFromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("ERROR: Invalid embedded file %s: %v", name, err)
+			return err
+		}
+		files = append(files, &PdfNamedFile{Name: name, File: fs})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// GetSize returns the size of the decoded file contents (Params Size entry), or -1 if not known.
+func (ef *PdfEmbeddedFile) GetSize() int64 {
+	params, ok := TraceToDirectObject(ef.Params).(*PdfObjectDictionary)
+	if !ok {
+		return -1
+	}
+	size, ok := TraceToDirectObject(params.Get("Size")).(*PdfObjectInteger)
+	if !ok {
+		return -1
+	}
+	return int64(*size)
+}
+
+// GetCheckSum returns the MD5 digest of the decoded file contents (Params CheckSum entry), or nil if not known.
+func (ef *PdfEmbeddedFile) GetCheckSum() []byte {
+	params, ok := TraceToDirectObject(ef.Params).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	checkSum, ok := TraceToDirectObject(params.Get("CheckSum")).(*PdfObjectString)
+	if !ok || len(*checkSum) != md5.Size {
+		return nil
+	}
+	return []byte(*checkSum)
+}
+
+// WriteTo writes the decoded file contents to `w`, returning the number of bytes written. Flate encoded and
+// unencoded contents are decoded as they are written rather than in memory. The size and checksum of the
+// contents are verified against the Size and CheckSum parameters if present: ErrEmbeddedFileCorrupted is
+// returned on mismatch, after all the contents have been written.
+func (ef *PdfEmbeddedFile) WriteTo(w io.Writer) (int64, error) {
+	var r io.Reader
+	switch enc := ef.Filter.(type) {
+	case nil, *RawEncoder:
+		r = bytes.NewReader(ef.Stream)
+	case *FlateEncoder:
+		if enc.Predictor > 1 {
+			return ef.writeDecoded(w)
+		}
+		zr, err := zlib.NewReader(bytes.NewReader(ef.Stream))
+		if err != nil {
+			common.Log.Debug("ERROR: Invalid embedded file stream: %v", err)
+			return 0, err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return ef.writeDecoded(w)
+	}
+
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(w, h), r)
+	if err != nil {
+		return n, err
+	}
+	return n, ef.verify(n, h.Sum(nil))
+}
+
+// writeDecoded writes the contents decoded in memory to `w`, for filters that are not decoded as a stream.
+func (ef *PdfEmbeddedFile) writeDecoded(w io.Writer) (int64, error) {
+	data, err := ef.GetData()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(data)
+	if err != nil {
+		return int64(n), err
+	}
+	digest := md5.Sum(data)
+	return int64(n), ef.verify(int64(n), digest[:])
+}
+
+// verify checks decoded size `size` and MD5 digest `digest` of the contents against the file parameters.
+func (ef *PdfEmbeddedFile) verify(size int64, digest []byte) error {
+	if expected := ef.GetSize(); expected >= 0 && expected != size {
+		common.Log.Debug("ERROR: Embedded file size %d, expected %d", size, expected)
+		return ErrEmbeddedFileCorrupted
+	}
+	if expected := ef.GetCheckSum(); expected != nil && !bytes.Equal(expected, digest) {
+		common.Log.Debug("ERROR: Embedded file checksum % x, expected % x", digest, expected)
+		return ErrEmbeddedFileCorrupted
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestEmbeddedFiles(t *testing.T) {
+	data := bytes.Repeat([]byte("name,value\n"), 1000)
+	fs, err := NewReportFileSpec("report.csv", data, "Report data")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	page := NewPdfPage()
+	page.Resources = NewPdfPageResources()
+	w := NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	w.AddAssociatedFile(fs)
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err := NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	files, err := reader.GetEmbeddedFiles()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(files) != 1 || files[0].Name != "report.csv" || files[0].File.EF == nil {
+		t.Fatalf("Unexpected embedded files %v", files)
+	}
+	ef := files[0].File.EF
+	if ef.GetSize() != int64(len(data)) || ef.GetCheckSum() == nil {
+		t.Errorf("Unexpected parameters %v", ef.Params)
+	}
+
+	var buf bytes.Buffer
+	n, err := ef.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if n != int64(len(data)) || !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("Extracted %d bytes, expected %d", n, len(data))
+	}
+
+	// Corrupted contents are written, but reported.
+	params := ef.Params.(*PdfObjectDictionary)
+	params.Set("CheckSum", MakeString(string(bytes.Repeat([]byte{0}, 16))))
+	buf.Reset()
+	if _, err := ef.WriteTo(&buf); err != ErrEmbeddedFileCorrupted || buf.Len() != len(data) {
+		t.Errorf("Expected checksum mismatch, got %v (%d bytes)", err, buf.Len())
+	}
+	params.Remove("CheckSum")
+	params.Set("Size", MakeInteger(10))
+	if _, err := ef.WriteTo(&bytes.Buffer{}); err != ErrEmbeddedFileCorrupted {
+		t.Errorf("Expected size mismatch, got %v", err)
+	}
+
+	// Unencoded contents without parameters.
+	ef.Filter = NewRawEncoder()
+	ef.Stream = []byte("raw")
+	ef.Params = nil
+	buf.Reset()
+	if _, err := ef.WriteTo(&buf); err != nil || buf.String() != "raw" {
+		t.Errorf("Unexpected raw contents %q (%v)", buf.String(), err)
+	}
+}
@@ -0,0 +1,321 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Rendering intents (section 8.6.5.8 p. 160).
+const (
+	RenderingIntentAbsoluteColorimetric PdfObjectName = "AbsoluteColorimetric"
+	RenderingIntentRelativeColorimetric PdfObjectName = "RelativeColorimetric"
+	RenderingIntentSaturation           PdfObjectName = "Saturation"
+	RenderingIntentPerceptual           PdfObjectName = "Perceptual"
+)
+
+// PdfXVersion identifies a PDF/X conformance level (ISO 15930).
+type PdfXVersion int
+
+const (
+	PdfX1a PdfXVersion = iota // PDF/X-1a: CMYK and spot colors only, no transparency.
+	PdfX3  PdfXVersion = iota // PDF/X-3: color managed workflows, no transparency.
+	PdfX4  PdfXVersion = iota // PDF/X-4: transparency and optional content allowed.
+)
+
+func (v PdfXVersion) String() string {
+	switch v {
+	case PdfX1a:
+		return "PDF/X-1a"
+	case PdfX3:
+		return "PDF/X-3"
+	case PdfX4:
+		return "PDF/X-4"
+	}
+	return "PDF/X"
+}
+
+// PdfExtGState represents a graphics state parameter dictionary (section 8.4.5 - Table 58 p. 128).
+// The parameters used in prepress (overprinting, rendering intent, halftones, transfer functions, black generation
+// and undercolor removal) and the transparency parameters are available as typed fields. Other entries of a loaded
+// dictionary, such as the line style parameters, are retained when written back with ToPdfObject.
+type PdfExtGState struct {
+	OverprintStroking    *bool          // OP
+	OverprintNonStroking *bool          // op, same as OverprintStroking if not set.
+	OverprintMode        *int64         // OPM, 0 or 1 (nonzero overprint mode).
+	RenderingIntent      *PdfObjectName // RI
+
+	Halftone           PdfObject // HT: halftone dictionary or stream, or /Default.
+	TransferFunction   PdfObject // TR: function, array of 4 functions or /Identity.
+	TransferFunction2  PdfObject // TR2: same as TR, or /Default.
+	BlackGeneration    PdfObject // BG: function.
+	BlackGeneration2   PdfObject // BG2: function or /Default.
+	UndercolorRemoval  PdfObject // UCR: function.
+	UndercolorRemoval2 PdfObject // UCR2: function or /Default.
+
+	StrokeAlpha *float64  // CA
+	FillAlpha   *float64  // ca
+	BlendMode   PdfObject // BM: name or array of names.
+	SoftMask    PdfObject // SMask: soft mask dictionary or /None.
+
+	primitive *PdfObjectDictionary
+}
+
+// NewPdfExtGState returns a new empty graphics state parameter dictionary.
+func NewPdfExtGState() *PdfExtGState {
+	egs := &PdfExtGState{}
+	egs.primitive = MakeDict()
+	egs.primitive.Set("Type", MakeName("ExtGState"))
+	return egs
+}
+
+// NewPdfExtGStateFromPdfObject loads a graphics state parameter dictionary from `obj` (dictionary or indirect
+// object containing a dictionary).
+func NewPdfExtGStateFromPdfObject(obj PdfObject) (*PdfExtGState, error) {
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: ExtGState not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	egs := &PdfExtGState{}
+	egs.primitive = dict
+
+	var err error
+	if egs.OverprintStroking, err = getExtGStateBool(dict, "OP"); err != nil {
+		return nil, err
+	}
+	if egs.OverprintNonStroking, err = getExtGStateBool(dict, "op"); err != nil {
+		return nil, err
+	}
+	if obj := dict.Get("OPM"); obj != nil {
+		opm, ok := TraceToDirectObject(obj).(*PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("ERROR: ExtGState OPM not an integer (%T)", obj)
+			return nil, ErrTypeError
+		}
+		val := int64(*opm)
+		egs.OverprintMode = &val
+	}
+	if obj := dict.Get("RI"); obj != nil {
+		ri, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok {
+			common.Log.Debug("ERROR: ExtGState RI not a name (%T)", obj)
+			return nil, ErrTypeError
+		}
+		egs.RenderingIntent = ri
+	}
+
+	egs.Halftone = dict.Get("HT")
+	egs.TransferFunction = dict.Get("TR")
+	egs.TransferFunction2 = dict.Get("TR2")
+	egs.BlackGeneration = dict.Get("BG")
+	egs.BlackGeneration2 = dict.Get("BG2")
+	egs.UndercolorRemoval = dict.Get("UCR")
+	egs.UndercolorRemoval2 = dict.Get("UCR2")
+
+	if obj := dict.Get("CA"); obj != nil {
+		val, err := getNumberAsFloat(TraceToDirectObject(obj))
+		if err != nil {
+			common.Log.Debug("ERROR: ExtGState CA not a number (%T)", obj)
+			return nil, ErrTypeError
+		}
+		egs.StrokeAlpha = &val
+	}
+	if obj := dict.Get("ca"); obj != nil {
+		val, err := getNumberAsFloat(TraceToDirectObject(obj))
+		if err != nil {
+			common.Log.Debug("ERROR: ExtGState ca not a number (%T)", obj)
+			return nil, ErrTypeError
+		}
+		egs.FillAlpha = &val
+	}
+	egs.BlendMode = dict.Get("BM")
+	egs.SoftMask = dict.Get("SMask")
+
+	return egs, nil
+}
+
+// getExtGStateBool loads boolean entry `key` of `dict`. Returns nil if not present.
+func getExtGStateBool(dict *PdfObjectDictionary, key PdfObjectName) (*bool, error) {
+	obj := dict.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	b, ok := TraceToDirectObject(obj).(*PdfObjectBool)
+	if !ok {
+		common.Log.Debug("ERROR: ExtGState %s not a boolean (%T)", key, obj)
+		return nil, ErrTypeError
+	}
+	val := bool(*b)
+	return &val, nil
+}
+
+// SetOverprint sets the overprint flags for stroking and non-stroking operations and the overprint mode.
+func (egs *PdfExtGState) SetOverprint(stroking, nonStroking bool, mode int64) {
+	egs.OverprintStroking = &stroking
+	egs.OverprintNonStroking = &nonStroking
+	egs.OverprintMode = &mode
+}
+
+// IsOverprintNonStroking returns the effective overprint flag for non-stroking operations, which defaults to the
+// stroking flag when not set.
+func (egs *PdfExtGState) IsOverprintNonStroking() bool {
+	if egs.OverprintNonStroking != nil {
+		return *egs.OverprintNonStroking
+	}
+	return egs.OverprintStroking != nil && *egs.OverprintStroking
+}
+
+// HasTransparency returns true if the graphics state enables transparency, i.e. an alpha below 1, a blend mode
+// other than Normal/Compatible or a soft mask.
+func (egs *PdfExtGState) HasTransparency() bool {
+	if egs.StrokeAlpha != nil && *egs.StrokeAlpha < 1.0 {
+		return true
+	}
+	if egs.FillAlpha != nil && *egs.FillAlpha < 1.0 {
+		return true
+	}
+	if egs.BlendMode != nil {
+		isNormal := func(obj PdfObject) bool {
+			name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+			return ok && (*name == "Normal" || *name == "Compatible")
+		}
+		if arr, ok := TraceToDirectObject(egs.BlendMode).(*PdfObjectArray); ok {
+			// The first supported blend mode in the array is used.
+			if len(*arr) > 0 && !isNormal((*arr)[0]) {
+				return true
+			}
+		} else if !isNormal(egs.BlendMode) {
+			return true
+		}
+	}
+	if egs.SoftMask != nil {
+		name, ok := TraceToDirectObject(egs.SoftMask).(*PdfObjectName)
+		if !ok || *name != "None" {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePdfX checks the graphics state against the restrictions of PDF/X conformance level `version` and returns
+// a list of warnings describing the violations. An empty list is returned if the graphics state conforms.
+func (egs *PdfExtGState) ValidatePdfX(version PdfXVersion) []string {
+	warnings := []string{}
+
+	if egs.TransferFunction != nil {
+		warnings = append(warnings, fmt.Sprintf("%s: transfer function (TR) not allowed", version))
+	}
+	if egs.TransferFunction2 != nil && !isPdfName(egs.TransferFunction2, "Default") {
+		warnings = append(warnings, fmt.Sprintf("%s: transfer function (TR2) other than /Default not allowed", version))
+	}
+
+	if egs.RenderingIntent != nil {
+		switch *egs.RenderingIntent {
+		case RenderingIntentAbsoluteColorimetric, RenderingIntentRelativeColorimetric, RenderingIntentSaturation,
+			RenderingIntentPerceptual:
+		default:
+			warnings = append(warnings, fmt.Sprintf("%s: unknown rendering intent /%s", version, *egs.RenderingIntent))
+		}
+	}
+
+	if egs.Halftone != nil && !isPdfName(egs.Halftone, "Default") {
+		var htDict *PdfObjectDictionary
+		switch t := TraceToDirectObject(egs.Halftone).(type) {
+		case *PdfObjectDictionary:
+			htDict = t
+		case *PdfObjectStream:
+			htDict = t.PdfObjectDictionary
+		}
+		if htDict == nil {
+			warnings = append(warnings, fmt.Sprintf("%s: invalid halftone (HT)", version))
+		} else {
+			if htType, ok := TraceToDirectObject(htDict.Get("HalftoneType")).(*PdfObjectInteger); ok &&
+				*htType != 1 && *htType != 5 {
+				warnings = append(warnings, fmt.Sprintf("%s: halftone type %d not allowed", version, *htType))
+			}
+			if htDict.Get("TransferFunction") != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: halftone transfer function not allowed", version))
+			}
+		}
+	}
+
+	if version != PdfX4 && egs.HasTransparency() {
+		warnings = append(warnings, fmt.Sprintf("%s: transparency (CA/ca/BM/SMask) not allowed", version))
+	}
+
+	return warnings
+}
+
+// isPdfName returns true if `obj` is the name `name`.
+func isPdfName(obj PdfObject, name PdfObjectName) bool {
+	n, ok := TraceToDirectObject(obj).(*PdfObjectName)
+	return ok && *n == name
+}
+
+// GetContainingPdfObject returns the underlying dictionary.
+func (egs *PdfExtGState) GetContainingPdfObject() PdfObject {
+	return egs.primitive
+}
+
+// ToPdfObject updates the underlying dictionary with the typed fields and returns it.
+func (egs *PdfExtGState) ToPdfObject() PdfObject {
+	d := egs.primitive
+
+	setBool := func(key PdfObjectName, val *bool) {
+		if val == nil {
+			d.Remove(key)
+			return
+		}
+		d.Set(key, MakeBool(*val))
+	}
+	setFloat := func(key PdfObjectName, val *float64) {
+		if val == nil {
+			d.Remove(key)
+			return
+		}
+		d.Set(key, MakeFloat(*val))
+	}
+	setObject := func(key PdfObjectName, obj PdfObject) {
+		if obj == nil {
+			d.Remove(key)
+			return
+		}
+		d.Set(key, obj)
+	}
+
+	setBool("OP", egs.OverprintStroking)
+	setBool("op", egs.OverprintNonStroking)
+	if egs.OverprintMode != nil {
+		d.Set("OPM", MakeInteger(*egs.OverprintMode))
+	} else {
+		d.Remove("OPM")
+	}
+	if egs.RenderingIntent != nil {
+		d.Set("RI", MakeName(string(*egs.RenderingIntent)))
+	} else {
+		d.Remove("RI")
+	}
+
+	setObject("HT", egs.Halftone)
+	setObject("TR", egs.TransferFunction)
+	setObject("TR2", egs.TransferFunction2)
+	setObject("BG", egs.BlackGeneration)
+	setObject("BG2", egs.BlackGeneration2)
+	setObject("UCR", egs.UndercolorRemoval)
+	setObject("UCR2", egs.UndercolorRemoval2)
+
+	setFloat("CA", egs.StrokeAlpha)
+	setFloat("ca", egs.FillAlpha)
+	setObject("BM", egs.BlendMode)
+	setObject("SMask", egs.SoftMask)
+
+	return d
+}
@@ -0,0 +1,64 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestExtGStateRoundtrip(t *testing.T) {
+	rawText := `<< /Type /ExtGState /LW 2 /OP true /OPM 1 /RI /Perceptual /TR /Identity /ca 0.5 >>`
+	parser := NewParserFromString(rawText)
+	obj, err := parser.ParseDict()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	egs, err := NewPdfExtGStateFromPdfObject(obj)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if egs.OverprintStroking == nil || !*egs.OverprintStroking || !egs.IsOverprintNonStroking() {
+		t.Errorf("Overprint mismatch")
+	}
+	if egs.OverprintMode == nil || *egs.OverprintMode != 1 {
+		t.Errorf("OPM mismatch")
+	}
+	if egs.RenderingIntent == nil || *egs.RenderingIntent != RenderingIntentPerceptual {
+		t.Errorf("RI mismatch")
+	}
+	if !egs.HasTransparency() {
+		t.Errorf("Transparency not detected")
+	}
+
+	warnings := egs.ValidatePdfX(PdfX1a)
+	if len(warnings) != 2 {
+		t.Errorf("Expected 2 PDF/X-1a warnings (TR, transparency), got %v", warnings)
+	}
+	if warnings := egs.ValidatePdfX(PdfX4); len(warnings) != 1 {
+		t.Errorf("Expected 1 PDF/X-4 warning (TR), got %v", warnings)
+	}
+
+	egs.TransferFunction = nil
+	egs.FillAlpha = nil
+	egs.SetOverprint(true, false, 0)
+	if warnings := egs.ValidatePdfX(PdfX1a); len(warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", warnings)
+	}
+
+	dict := egs.ToPdfObject().(*PdfObjectDictionary)
+	if dict.Get("TR") != nil || dict.Get("ca") != nil {
+		t.Errorf("Removed entries still present: %s", dict.DefaultWriteString())
+	}
+	if op, ok := dict.Get("op").(*PdfObjectBool); !ok || bool(*op) {
+		t.Errorf("op mismatch: %s", dict.DefaultWriteString())
+	}
+	if lw, ok := dict.Get("LW").(*PdfObjectInteger); !ok || *lw != 2 {
+		t.Errorf("Other entries not retained: %s", dict.DefaultWriteString())
+	}
+}
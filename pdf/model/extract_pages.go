@@ -0,0 +1,242 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// SignatureHandling specifies what happens to the signatures of a signed document when pages are extracted into
+// a new document. The signatures cannot remain valid, as they cover the bytes of the original file.
+type SignatureHandling int
+
+const (
+	// SignaturesRemove removes the signature fields and their widgets.
+	SignaturesRemove SignatureHandling = iota
+
+	// SignaturesKeepUnsigned keeps the signature fields and their widgets, e.g. the appearance of visible
+	// signatures, but removes the signature values so that the fields are unsigned.
+	SignaturesKeepUnsigned
+
+	// SignaturesCarryOver keeps the signature values, which viewers report as invalid in the new document.
+	SignaturesCarryOver
+)
+
+// ExtractedSignature describes a signature of the original document in a PageExtractionReport.
+type ExtractedSignature struct {
+	// Fully qualified name of the signature field.
+	FieldName string
+
+	// Entries of the signature dictionary (12.8.1): the name of the signer, the reason and location of the
+	// signing, and the signing time as a PDF date string.
+	Name        string
+	Reason      string
+	Location    string
+	SigningTime string
+
+	// Pages (in the original document) of the widgets of the field.
+	Pages []int
+
+	// Removed is true if the signature field was removed, either because it has no widgets on the extracted
+	// pages or as requested by SignaturesRemove.
+	Removed bool
+
+	// Invalid is true if the signature value was carried over (SignaturesCarryOver). It no longer matches the
+	// signed bytes.
+	Invalid bool
+}
+
+// PageExtractionReport reports how the signatures of a document were handled when extracting pages with
+// PdfReader.ExtractPages.
+type PageExtractionReport struct {
+	// Pages extracted, in the original document.
+	Pages []int
+
+	// Signed signature fields of the original document.
+	Signatures []*ExtractedSignature
+
+	// Certified is true if the original document has a certification signature (DocMDP), which does not
+	// apply to the extracted document.
+	Certified bool
+}
+
+// String returns a human readable summary of the report.
+func (r *PageExtractionReport) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("Extracted pages %v", r.Pages))
+	if r.Certified {
+		buf.WriteString(", original document certified (certification not carried over)")
+	}
+	buf.WriteString(fmt.Sprintf(", %d signature(s)\n", len(r.Signatures)))
+	for _, sig := range r.Signatures {
+		status := "kept unsigned"
+		if sig.Removed {
+			status = "removed"
+		} else if sig.Invalid {
+			status = "carried over, INVALID"
+		}
+		buf.WriteString(fmt.Sprintf("  %s (signer %q, time %s, pages %v): %s\n", sig.FieldName, sig.Name,
+			sig.SigningTime, sig.Pages, status))
+	}
+	return buf.String()
+}
+
+// ExtractPages returns a writer with pages `pageNums` of the document (starting from 1) and the form fields with
+// widgets on those pages. As the signatures of a signed document cannot stay valid in a new document, the
+// signature fields are handled according to `handling` and reported in the returned report, rather than silently
+// producing a document with broken signatures.
+// The pages and form of the reader are modified, e.g. the widgets of removed fields are removed from the pages.
+// Returns ErrPermissionDenied if assembling the document is not allowed by the enforced permissions policy.
+func (this *PdfReader) ExtractPages(pageNums []int, handling SignatureHandling) (*PdfWriter, *PageExtractionReport, error) {
+	if err := this.policy.check("Extracting pages", (*PermissionsPolicy).CanAssemble); err != nil {
+		return nil, nil, err
+	}
+	report := &PageExtractionReport{Pages: pageNums}
+
+	extracted := map[int]bool{}
+	for _, num := range pageNums {
+		if num < 1 || num > len(this.PageList) || extracted[num] {
+			common.Log.Debug("Invalid or repeated page number %d", num)
+			return nil, nil, ErrRangeError
+		}
+		extracted[num] = true
+	}
+
+	report.Certified = this.GetCertificationLevel() != NotCertified
+
+	form := this.AcroForm
+	if form != nil {
+		signed, hasSigFields := false, false
+		for _, terminal := range form.FieldsFlattened() {
+			onPages := len(terminal.Widgets) == 0
+			for _, page := range terminal.WidgetPages {
+				onPages = onPages || extracted[page]
+			}
+
+			var sig *ExtractedSignature
+			if sigDict, ok := TraceToDirectObject(terminal.Field.getInheritedV()).(*PdfObjectDictionary); ok && terminal.Field.getFieldType() == "Sig" {
+				sig = newExtractedSignature(terminal, sigDict)
+				report.Signatures = append(report.Signatures, sig)
+				onPages = onPages && len(terminal.Widgets) > 0
+			}
+
+			remove := !onPages || (sig != nil && handling == SignaturesRemove)
+			if remove {
+				if err := form.removeField(terminal.FullName); err != nil {
+					return nil, nil, err
+				}
+				if sig != nil {
+					sig.Removed = true
+				}
+				continue
+			}
+
+			// Widgets on pages that are not extracted.
+			terminal.Field.removeWidgets(func(widget *PdfAnnotation) bool {
+				return !extracted[form.getWidgetPage(widget)]
+			})
+
+			if terminal.Field.getFieldType() == "Sig" {
+				hasSigFields = true
+			}
+			if sig != nil {
+				switch handling {
+				case SignaturesKeepUnsigned:
+					terminal.Field.V = nil
+				case SignaturesCarryOver:
+					sig.Invalid = true
+					signed = true
+				}
+			}
+		}
+
+		// Without signatures, only SignaturesExist is kept for the remaining (unsigned) signature fields.
+		if !signed && form.SigFlags != nil {
+			form.SigFlags = nil
+			if hasSigFields {
+				form.SigFlags = MakeInteger(sigFlagSignaturesExist)
+			}
+		}
+	}
+
+	w := NewPdfWriter()
+	for _, num := range pageNums {
+		if err := w.AddPage(this.PageList[num-1]); err != nil {
+			return nil, nil, err
+		}
+	}
+	if form != nil && form.Fields != nil && len(*form.Fields) > 0 {
+		if err := w.SetForms(form); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &w, report, nil
+}
+
+// newExtractedSignature returns the description of signature `sigDict` of terminal field `terminal`.
+func newExtractedSignature(terminal *PdfTerminalField, sigDict *PdfObjectDictionary) *ExtractedSignature {
+	getText := func(key PdfObjectName) string {
+		if str, ok := TraceToDirectObject(sigDict.Get(key)).(*PdfObjectString); ok {
+			return DecodeTextString(*str)
+		}
+		return ""
+	}
+	return &ExtractedSignature{
+		FieldName:   terminal.FullName,
+		Name:        getText("Name"),
+		Reason:      getText("Reason"),
+		Location:    getText("Location"),
+		SigningTime: getText("M"),
+		Pages:       terminal.WidgetPages,
+	}
+}
+
+// getFieldType returns the field type (FT) of the field, which is inheritable, e.g. "Tx" or "Sig". Returns an
+// empty string if not set.
+func (this *PdfField) getFieldType() string {
+	for field := this; field != nil; field = field.Parent {
+		if field.FT != nil {
+			return string(*field.FT)
+		}
+	}
+	return ""
+}
+
+// getInheritedV returns the value (V) of the field, which is inheritable.
+func (this *PdfField) getInheritedV() PdfObject {
+	for field := this; field != nil; field = field.Parent {
+		if field.V != nil {
+			return field.V
+		}
+	}
+	return nil
+}
+
+// removeWidgets removes the separate widget annotations in Kids of the field for which `remove` returns true.
+func (this *PdfField) removeWidgets(remove func(widget *PdfAnnotation) bool) {
+	kids := []PdfModel{}
+	for _, kid := range this.KidsF {
+		if kidField, ok := kid.(*PdfField); ok && kidField.isWidget() {
+			widgets := []*PdfAnnotation{}
+			for _, widget := range kidField.KidsA {
+				if !remove(widget) {
+					widgets = append(widgets, widget)
+				}
+			}
+			if len(widgets) == 0 {
+				continue
+			}
+			kidField.KidsA = widgets
+		}
+		kids = append(kids, kid)
+	}
+	this.KidsF = kids
+}
@@ -0,0 +1,128 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// testSignedObjects are the objects of a two page document with a signature field on page 1 and a text field on
+// both pages.
+var testSignedObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R >>",
+	"<< /Type /Pages /Kids [4 0 R 5 0 R] /Count 2 >>",
+	"<< /Fields [6 0 R 8 0 R] /SigFlags 3 >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [6 0 R 9 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [10 0 R] >>",
+	"<< /T (sig) /FT /Sig /V 7 0 R /Type /Annot /Subtype /Widget /Rect [0 0 100 50] /P 4 0 R >>",
+	"<< /Type /Sig /Filter /Adobe.PPKLite /SubFilter /adbe.pkcs7.detached /Name (Jane Doe) /Reason (Approval) /M (D:20180101120000Z) /ByteRange [0 10 20 10] /Contents <00> >>",
+	"<< /T (text) /FT /Tx /Kids [9 0 R 10 0 R] >>",
+	"<< /Type /Annot /Subtype /Widget /Parent 8 0 R /Rect [0 100 100 120] /P 4 0 R >>",
+	"<< /Type /Annot /Subtype /Widget /Parent 8 0 R /Rect [0 100 100 120] /P 5 0 R >>",
+}
+
+// extractAndRead extracts pages `pageNums` of the test document and loads the output.
+func extractAndRead(t *testing.T, pageNums []int, handling SignatureHandling) (*PdfReader, *PageExtractionReport, func()) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testSignedObjects)))
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	w, report, err := reader.ExtractPages(pageNums, handling)
+	if err != nil {
+		t.Fatalf("Error extracting: %v", err)
+	}
+
+	f, err := ioutil.TempFile("", "extract")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	if err := w.Write(f); err != nil {
+		cleanup()
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+	extracted, err := NewPdfReader(f)
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error reading output: %v", err)
+	}
+	return extracted, report, cleanup
+}
+
+func TestExtractPagesSignatures(t *testing.T) {
+	testcases := []struct {
+		handling SignatureHandling
+		fields   int
+		signed   bool
+		removed  bool
+		invalid  bool
+		sigFlags int64 // 0 if not set.
+	}{
+		{SignaturesRemove, 1, false, true, false, 0},
+		{SignaturesKeepUnsigned, 2, false, false, false, 1},
+		{SignaturesCarryOver, 2, true, false, true, 3},
+	}
+
+	for _, tcase := range testcases {
+		extracted, report, cleanup := extractAndRead(t, []int{1}, tcase.handling)
+		defer cleanup()
+
+		if len(report.Signatures) != 1 {
+			t.Fatalf("Expected 1 signature in report: %s", report)
+		}
+		sig := report.Signatures[0]
+		if sig.FieldName != "sig" || sig.Name != "Jane Doe" || sig.Reason != "Approval" || len(sig.Pages) != 1 {
+			t.Errorf("Unexpected signature: %+v", sig)
+		}
+		if sig.Removed != tcase.removed || sig.Invalid != tcase.invalid {
+			t.Errorf("Handling %d: unexpected status %+v", tcase.handling, sig)
+		}
+
+		terminals := extracted.AcroForm.FieldsFlattened()
+		if len(terminals) != tcase.fields {
+			t.Fatalf("Handling %d: %d fields, expected %d", tcase.handling, len(terminals), tcase.fields)
+		}
+		// The text field keeps only its widget on the extracted page.
+		text := terminals[len(terminals)-1]
+		if text.FullName != "text" || len(text.Widgets) != 1 {
+			t.Errorf("Unexpected text field %s with %d widgets", text.FullName, len(text.Widgets))
+		}
+		if tcase.fields == 2 && (terminals[0].Field.V != nil) != tcase.signed {
+			t.Errorf("Handling %d: signature value %v", tcase.handling, terminals[0].Field.V)
+		}
+		sigFlags := int64(0)
+		if extracted.AcroForm.SigFlags != nil {
+			sigFlags = int64(*extracted.AcroForm.SigFlags)
+		}
+		if sigFlags != tcase.sigFlags {
+			t.Errorf("Handling %d: SigFlags %v", tcase.handling, extracted.AcroForm.SigFlags)
+		}
+		page, err := extracted.GetPage(1)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if len(page.Annotations) != tcase.fields {
+			t.Errorf("Handling %d: %d annotations", tcase.handling, len(page.Annotations))
+		}
+	}
+
+	// The signature field is removed if its widget is not on the extracted pages.
+	extracted, report, cleanup := extractAndRead(t, []int{2}, SignaturesCarryOver)
+	defer cleanup()
+	if len(report.Signatures) != 1 || !report.Signatures[0].Removed {
+		t.Errorf("Signature should be removed: %s", report)
+	}
+	if numPages, _ := extracted.GetNumPages(); numPages != 1 || len(extracted.AcroForm.FieldsFlattened()) != 1 {
+		t.Errorf("Unexpected output")
+	}
+}
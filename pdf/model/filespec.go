@@ -0,0 +1,233 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"crypto/md5"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfEmbeddedFile represents an embedded file stream (7.11.4 - Table 45).
+type PdfEmbeddedFile struct {
+	Filter StreamEncoder
+
+	Subtype *PdfObjectName // MIME media type, e.g. video/mp4.
+	Params  PdfObject
+
+	// Stream data (encoded).
+	Stream []byte
+
+	primitive *PdfObjectStream
+}
+
+// NewPdfEmbeddedFile creates a new flate encoded embedded file stream from `data` with MIME type `mimeType`, with
+// the size and MD5 checksum of the data in its parameters. The MIME type is optional and omitted if empty.
+func NewPdfEmbeddedFile(data []byte, mimeType string) (*PdfEmbeddedFile, error) {
+	encoder := NewFlateEncoder()
+	encoded, err := encoder.EncodeBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ef := &PdfEmbeddedFile{}
+	ef.Filter = encoder
+	ef.Stream = encoded
+	if len(mimeType) > 0 {
+		ef.Subtype = MakeName(mimeType)
+	}
+	params := MakeDict()
+	params.Set("Size", MakeInteger(int64(len(data))))
+	checkSum := md5.Sum(data)
+	params.Set("CheckSum", MakeString(string(checkSum[:])))
+	ef.Params = params
+
+	ef.primitive = &PdfObjectStream{}
+	ef.primitive.PdfObjectDictionary = MakeDict()
+	return ef, nil
+}
+
+// NewPdfEmbeddedFileFromStream loads an embedded file model from a stream object.
+func NewPdfEmbeddedFileFromStream(stream *PdfObjectStream) (*PdfEmbeddedFile, error) {
+	encoder, err := NewEncoderFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	ef := &PdfEmbeddedFile{}
+	ef.primitive = stream
+	ef.Filter = encoder
+	ef.Stream = stream.Stream
+
+	dict := stream.PdfObjectDictionary
+	if obj := dict.Get("Subtype"); obj != nil {
+		name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok {
+			common.Log.Debug("ERROR: Embedded file Subtype not a name (%T)", obj)
+			return nil, ErrTypeError
+		}
+		ef.Subtype = name
+	}
+	ef.Params = dict.Get("Params")
+
+	return ef, nil
+}
+
+// GetData returns the decoded file contents.
+func (ef *PdfEmbeddedFile) GetData() ([]byte, error) {
+	if ef.Filter == nil {
+		return ef.Stream, nil
+	}
+	return ef.Filter.DecodeBytes(ef.Stream)
+}
+
+// GetContainingPdfObject returns the stream object containing the embedded file.
+func (ef *PdfEmbeddedFile) GetContainingPdfObject() PdfObject {
+	return ef.primitive
+}
+
+// ToPdfObject returns the embedded file as a PDF stream object.
+func (ef *PdfEmbeddedFile) ToPdfObject() PdfObject {
+	stream := ef.primitive
+
+	dict := stream.PdfObjectDictionary
+	if ef.Filter != nil {
+		// Pre-populate the stream dictionary with the encoding related fields.
+		dict = ef.Filter.MakeStreamDict()
+		stream.PdfObjectDictionary = dict
+	}
+	dict.Set("Type", MakeName("EmbeddedFile"))
+	dict.SetIfNotNil("Subtype", ef.Subtype)
+	dict.SetIfNotNil("Params", ef.Params)
+
+	dict.Set("Length", MakeInteger(int64(len(ef.Stream))))
+	stream.Stream = ef.Stream
+
+	return stream
+}
+
+// PdfFileSpec represents a file specification dictionary (7.11.3 - Table 44). Only embedded files are
+// supported, external file references are kept as the file name only.
+type PdfFileSpec struct {
+	F    *PdfObjectString // File name.
+	UF   *PdfObjectString // Unicode file name.
+	Desc *PdfObjectString // Description.
+	EF   *PdfEmbeddedFile // Embedded file stream (EF /F entry).
+
+	// Relationship of an associated file to the object it is associated with (14.13.2), e.g. Source, Data or
+	// Supplement.
+	AFRelationship *PdfObjectName
+
+	primitive *PdfIndirectObject
+}
+
+// NewPdfFileSpecFromEmbeddedFile returns a file specification for embedded file `ef` with file name `name`.
+func NewPdfFileSpecFromEmbeddedFile(name string, ef *PdfEmbeddedFile) *PdfFileSpec {
+	fs := &PdfFileSpec{}
+	fs.F = MakeString(name)
+	fs.UF = EncodeTextString(name)
+	fs.EF = ef
+	fs.primitive = MakeIndirectObject(MakeDict())
+	return fs
+}
+
+// NewPdfFileSpecFromPdfObject loads a file specification from a PDF object, which can be either a file
+// specification dictionary or a string (file name only).
+func NewPdfFileSpecFromPdfObject(obj PdfObject) (*PdfFileSpec, error) {
+	fs := &PdfFileSpec{}
+
+	var container *PdfIndirectObject
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		container = t
+	case *PdfObjectString:
+		fs.F = t
+		fs.primitive = MakeIndirectObject(MakeDict())
+		return fs, nil
+	default:
+		container = MakeIndirectObject(obj)
+	}
+	fs.primitive = container
+
+	dict, ok := TraceToDirectObject(container).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: File specification not a dictionary (%T)", container.PdfObject)
+		return nil, ErrTypeError
+	}
+
+	if str, ok := TraceToDirectObject(dict.Get("F")).(*PdfObjectString); ok {
+		fs.F = str
+	}
+	if str, ok := TraceToDirectObject(dict.Get("UF")).(*PdfObjectString); ok {
+		fs.UF = str
+	}
+	if str, ok := TraceToDirectObject(dict.Get("Desc")).(*PdfObjectString); ok {
+		fs.Desc = str
+	}
+	if name, ok := TraceToDirectObject(dict.Get("AFRelationship")).(*PdfObjectName); ok {
+		fs.AFRelationship = name
+	}
+
+	if efDict, ok := TraceToDirectObject(dict.Get("EF")).(*PdfObjectDictionary); ok {
+		// Prefer the unicode file name entry if present.
+		efObj := efDict.Get("UF")
+		if efObj == nil {
+			efObj = efDict.Get("F")
+		}
+		if stream, ok := TraceToDirectObject(efObj).(*PdfObjectStream); ok {
+			ef, err := NewPdfEmbeddedFileFromStream(stream)
+			if err != nil {
+				return nil, err
+			}
+			fs.EF = ef
+		} else if efObj != nil {
+			common.Log.Debug("ERROR: Embedded file not a stream (%T)", efObj)
+		}
+	}
+
+	return fs, nil
+}
+
+// GetFileName returns the file name, preferring the unicode file name (UF) if present.
+func (fs *PdfFileSpec) GetFileName() string {
+	if fs.UF != nil {
+		return DecodeTextString(*fs.UF)
+	}
+	if fs.F != nil {
+		return string(*fs.F)
+	}
+	return ""
+}
+
+// GetContainingPdfObject returns the indirect object containing the file specification.
+func (fs *PdfFileSpec) GetContainingPdfObject() PdfObject {
+	return fs.primitive
+}
+
+// ToPdfObject returns the file specification as a PDF indirect object.
+func (fs *PdfFileSpec) ToPdfObject() PdfObject {
+	container := fs.primitive
+	dict, ok := container.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		dict = MakeDict()
+		container.PdfObject = dict
+	}
+
+	dict.Set("Type", MakeName("Filespec"))
+	dict.SetIfNotNil("F", fs.F)
+	dict.SetIfNotNil("UF", fs.UF)
+	dict.SetIfNotNil("Desc", fs.Desc)
+	dict.SetIfNotNil("AFRelationship", fs.AFRelationship)
+	if fs.EF != nil {
+		efDict := MakeDict()
+		efDict.Set("F", fs.EF.ToPdfObject())
+		efDict.Set("UF", fs.EF.ToPdfObject())
+		dict.Set("EF", efDict)
+	}
+
+	return container
+}
@@ -6,6 +6,7 @@
 package model
 
 import (
+	"bytes"
 	"errors"
 
 	"io/ioutil"
@@ -30,18 +31,54 @@ func (font PdfFont) SetEncoder(encoder textencoding.TextEncoder) {
 	switch t := font.context.(type) {
 	case *pdfFontTrueType:
 		t.SetEncoder(encoder)
+	case *pdfFontType0:
+		t.SetEncoder(encoder)
 	}
 }
 
+// GetEncoder returns the encoder of the font, which converts text to the character codes of the font.
+// Returns nil if not available.
+func (font PdfFont) GetEncoder() textencoding.TextEncoder {
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		return t.Encoder
+	case *pdfFontType0:
+		return t.Encoder
+	}
+	return nil
+}
+
 func (font PdfFont) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
 	switch t := font.context.(type) {
 	case *pdfFontTrueType:
 		return t.GetGlyphCharMetrics(glyph)
+	case *pdfFontType0:
+		return t.GetGlyphCharMetrics(glyph)
 	}
 
 	return fonts.CharMetrics{}, false
 }
 
+// MeasureString returns the metrics of string `s` shown at font size `size`, including the kerning of the font
+// (see fonts.MeasureString), e.g. for aligning text. The kerning is available for TrueType fonts loaded from font
+// files with GPOS or kern tables. The standard 14 fonts of package fonts are not PdfFonts: measure them with
+// fonts.MeasureString, which applies the kerning of the Helvetica and Times fonts.
+func (font PdfFont) MeasureString(s string, size float64) fonts.StringMetrics {
+	return font.MeasureStringWithSpacing(s, size, fonts.TextSpacing{})
+}
+
+// MeasureStringWithSpacing returns the metrics of string `s` shown at font size `size` with the character and word
+// spacing of `spacing`, including the kerning of the font.
+func (font PdfFont) MeasureStringWithSpacing(s string, size float64, spacing fonts.TextSpacing) fonts.StringMetrics {
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		return fonts.MeasureString(t, t.Encoder, s, size, spacing)
+	case *pdfFontType0:
+		return fonts.MeasureString(t, t.Encoder, s, size, spacing)
+	}
+	return fonts.StringMetrics{NumMissing: len([]rune(s))}
+}
+
 func newPdfFontFromPdfObject(obj core.PdfObject) (*PdfFont, error) {
 	font := &PdfFont{}
 
@@ -100,6 +137,8 @@ func (font PdfFont) ToPdfObject() core.PdfObject {
 	switch f := font.context.(type) {
 	case *pdfFontTrueType:
 		return f.ToPdfObject()
+	case *pdfFontType0:
+		return f.ToPdfObject()
 	}
 
 	// If not supported, return null..
@@ -114,6 +153,11 @@ type pdfFontTrueType struct {
 	lastChar   int
 	charWidths []float64
 
+	// Glyph indices and kerning of TrueType fonts loaded from font files.
+	runeToGID    map[rune]uint16
+	kerning      fonts.TtfKerning
+	kerningScale float64
+
 	// Subtype shall be TrueType.
 	// Encoding is subject to limitations that are described in 9.6.6, "Character Encoding".
 	// BaseFont is derived differently.
@@ -162,6 +206,31 @@ func (font pdfFontTrueType) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font pdfFontTrueType) GetGlyphKerning(left, right string) (float64, bool) {
+	if font.kerning.IsEmpty() || font.Encoder == nil {
+		return 0, false
+	}
+	gids := [2]uint16{}
+	for i, glyph := range []string{left, right} {
+		r, found := font.Encoder.GlyphToRune(glyph)
+		if !found {
+			return 0, false
+		}
+		gids[i], found = font.runeToGID[r]
+		if !found {
+			return 0, false
+		}
+	}
+	kx, found := font.kerning.GetKerning(gids[0], gids[1])
+	return font.kerningScale * float64(kx), found
+}
+
+// GetAscentDescent returns the ascent and descent of the font descriptor in glyph space units.
+func (font pdfFontTrueType) GetAscentDescent() (float64, float64) {
+	return font.FontDescriptor.getAscentDescent()
+}
+
 func newPdfFontTrueTypeFromPdfObject(obj core.PdfObject) (*pdfFontTrueType, error) {
 	font := &pdfFontTrueType{}
 
@@ -299,7 +368,29 @@ func (this *pdfFontTrueType) ToPdfObject() core.PdfObject {
 }
 
 func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
-	ttf, err := fonts.TtfParse(filePath)
+	return NewPdfFontFromTTCFile(filePath, 0)
+}
+
+// NewPdfFontFromTTCFile loads face `faceIndex` of a TrueType collection (.ttc) file, or of a TrueType font file
+// if `faceIndex` is 0, as a TrueType font with WinAnsiEncoding. The face is embedded as a standalone font.
+func NewPdfFontFromTTCFile(filePath string, faceIndex int) (*PdfFont, error) {
+	data, err := readFontFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewPdfFontFromTTCData(data, faceIndex)
+}
+
+// NewPdfFontFromTTFData loads the TrueType font `data` like NewPdfFontFromTTFFile, for fonts held in memory,
+// e.g. when no file system is available.
+func NewPdfFontFromTTFData(data []byte) (*PdfFont, error) {
+	return NewPdfFontFromTTCData(data, 0)
+}
+
+// NewPdfFontFromTTCData loads face `faceIndex` of the TrueType collection or TrueType font `data` like
+// NewPdfFontFromTTCFile.
+func NewPdfFontFromTTCData(data []byte, faceIndex int) (*PdfFont, error) {
+	ttf, err := fonts.TtfParseFaceReader(bytes.NewReader(data), faceIndex)
 	if err != nil {
 		common.Log.Debug("Error loading ttf font: %v", err)
 		return nil, err
@@ -352,6 +443,9 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	}
 
 	truefont.charWidths = vals[:255-32+1]
+	truefont.runeToGID = ttf.CharsFull
+	truefont.kerning = ttf.Kerning
+	truefont.kerningScale = k
 
 	// Default.
 	// XXX/FIXME TODO: Only use the encoder object.
@@ -366,9 +460,8 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	descriptor.ItalicAngle = core.MakeFloat(float64(ttf.ItalicAngle))
 	descriptor.MissingWidth = core.MakeFloat(k * float64(ttf.Widths[0]))
 
-	ttfBytes, err := ioutil.ReadFile(filePath)
+	ttfBytes, err := extractTTFFace(data, faceIndex)
 	if err != nil {
-		common.Log.Debug("Unable to read file contents: %v", err)
 		return nil, err
 	}
 
@@ -406,6 +499,26 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	return font, nil
 }
 
+// readFontFile returns the contents of font file `filePath`.
+func readFontFile(filePath string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		common.Log.Debug("Unable to read file contents: %v", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// extractTTFFace returns the font data of face `faceIndex` of the TrueType font or collection `data`.
+func extractTTFFace(data []byte, faceIndex int) ([]byte, error) {
+	face, err := fonts.ExtractTtcFace(data, faceIndex)
+	if err != nil {
+		common.Log.Debug("Unable to extract face %d: %v", faceIndex, err)
+		return nil, err
+	}
+	return face, nil
+}
+
 // Font descriptors specifies metrics and other attributes of a font.
 type PdfFontDescriptor struct {
 	FontName     core.PdfObject
@@ -440,6 +553,27 @@ type PdfFontDescriptor struct {
 	container *core.PdfIndirectObject
 }
 
+// getAscentDescent returns the Ascent and Descent of the descriptor, or the ascent and descent of the font
+// bounding box if not set. Returns 750 and -250 if neither are available.
+func (this *PdfFontDescriptor) getAscentDescent() (float64, float64) {
+	ascent, descent := 750.0, -250.0
+	if this == nil {
+		return ascent, descent
+	}
+	if bbox, ok := core.TraceToDirectObject(this.FontBBox).(*core.PdfObjectArray); ok && len(*bbox) == 4 {
+		if vals, err := bbox.ToFloat64Array(); err == nil {
+			ascent, descent = vals[3], vals[1]
+		}
+	}
+	if val, err := getNumberAsFloat(core.TraceToDirectObject(this.Ascent)); err == nil {
+		ascent = val
+	}
+	if val, err := getNumberAsFloat(core.TraceToDirectObject(this.Descent)); err == nil {
+		descent = val
+	}
+	return ascent, descent
+}
+
 // Load the font descriptor from a PdfObject.  Can either be a *PdfIndirectObject or
 // a *PdfObjectDictionary.
 func newPdfFontDescriptorFromPdfObject(obj core.PdfObject) (*PdfFontDescriptor, error) {
@@ -0,0 +1,311 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// pdfFontType0 represents a composite font (Type0) with a single CIDFontType2 descendant font using the
+// Identity-H encoding (9.7).
+type pdfFontType0 struct {
+	Encoder textencoding.IdentityEncoder
+
+	// Widths of the glyphs in glyph space units (1/1000 em) keyed by CID and default width.
+	cidWidths    map[uint16]float64
+	defaultWidth float64
+
+	// Kerning keyed by glyph index (= CID), scaled to glyph space units by kerningScale.
+	kerning      fonts.TtfKerning
+	kerningScale float64
+
+	BaseFont       core.PdfObject
+	Encoding       core.PdfObject
+	ToUnicode      core.PdfObject
+	CIDSystemInfo  core.PdfObject
+	DW             core.PdfObject
+	W              core.PdfObject
+	CIDToGIDMap    core.PdfObject
+	FontDescriptor *PdfFontDescriptor
+
+	container           *core.PdfIndirectObject
+	descendantContainer *core.PdfIndirectObject
+}
+
+// The encoding of a composite font is fixed (Identity-H), SetEncoder has no effect.
+func (font pdfFontType0) SetEncoder(encoder textencoding.TextEncoder) {
+}
+
+func (font pdfFontType0) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
+	metrics := fonts.CharMetrics{GlyphName: glyph}
+
+	r, found := font.Encoder.GlyphToRune(glyph)
+	if !found {
+		return metrics, false
+	}
+	cid, found := font.Encoder.RuneToCID(r)
+	if !found {
+		return metrics, false
+	}
+
+	metrics.Wx = font.defaultWidth
+	if w, has := font.cidWidths[cid]; has {
+		metrics.Wx = w
+	}
+	return metrics, true
+}
+
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font pdfFontType0) GetGlyphKerning(left, right string) (float64, bool) {
+	if font.kerning.IsEmpty() {
+		return 0, false
+	}
+	cids := [2]uint16{}
+	for i, glyph := range []string{left, right} {
+		r, found := font.Encoder.GlyphToRune(glyph)
+		if !found {
+			return 0, false
+		}
+		cids[i], found = font.Encoder.RuneToCID(r)
+		if !found {
+			return 0, false
+		}
+	}
+	kx, found := font.kerning.GetKerning(cids[0], cids[1])
+	return font.kerningScale * float64(kx), found
+}
+
+// GetAscentDescent returns the ascent and descent of the font descriptor in glyph space units.
+func (font pdfFontType0) GetAscentDescent() (float64, float64) {
+	return font.FontDescriptor.getAscentDescent()
+}
+
+func (this *pdfFontType0) ToPdfObject() core.PdfObject {
+	if this.container == nil {
+		this.container = &core.PdfIndirectObject{}
+	}
+	if this.descendantContainer == nil {
+		this.descendantContainer = &core.PdfIndirectObject{}
+	}
+
+	cidFont := core.MakeDict()
+	cidFont.Set("Type", core.MakeName("Font"))
+	cidFont.Set("Subtype", core.MakeName("CIDFontType2"))
+	cidFont.SetIfNotNil("BaseFont", this.BaseFont)
+	cidFont.SetIfNotNil("CIDSystemInfo", this.CIDSystemInfo)
+	if this.FontDescriptor != nil {
+		cidFont.Set("FontDescriptor", this.FontDescriptor.ToPdfObject())
+	}
+	cidFont.SetIfNotNil("DW", this.DW)
+	cidFont.SetIfNotNil("W", this.W)
+	cidFont.SetIfNotNil("CIDToGIDMap", this.CIDToGIDMap)
+	this.descendantContainer.PdfObject = cidFont
+
+	d := core.MakeDict()
+	d.Set("Type", core.MakeName("Font"))
+	d.Set("Subtype", core.MakeName("Type0"))
+	d.SetIfNotNil("BaseFont", this.BaseFont)
+	d.SetIfNotNil("Encoding", this.Encoding)
+	d.Set("DescendantFonts", core.MakeArray(this.descendantContainer))
+	d.SetIfNotNil("ToUnicode", this.ToUnicode)
+	this.container.PdfObject = d
+
+	return this.container
+}
+
+// NewCompositePdfFontFromTTFFile loads a TrueType font from `filePath` as a composite font (Type0) with the
+// Identity-H encoding, which supports all the glyphs of the font, unlike the single byte encoding of
+// NewPdfFontFromTTFFile. Runes outside the Basic Multilingual Plane (e.g. emoji, CJK Extension B) are supported
+// if the font has a format 12 cmap subtable. The font file is embedded in full.
+// Text is encoded with the font's encoder (GetEncoder), which is a textencoding.IdentityEncoder.
+func NewCompositePdfFontFromTTFFile(filePath string) (*PdfFont, error) {
+	return NewCompositePdfFontFromTTCFile(filePath, 0)
+}
+
+// NewCompositePdfFontFromTTCFile loads face `faceIndex` of a TrueType collection (.ttc) file, or of a TrueType
+// font file if `faceIndex` is 0, as a composite font (see NewCompositePdfFontFromTTFFile). CJK system fonts are
+// commonly distributed as collections. The face is embedded as a standalone font.
+func NewCompositePdfFontFromTTCFile(filePath string, faceIndex int) (*PdfFont, error) {
+	data, err := readFontFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewCompositePdfFontFromTTCData(data, faceIndex)
+}
+
+// NewCompositePdfFontFromTTFData loads the TrueType font `data` as a composite font like
+// NewCompositePdfFontFromTTFFile, for fonts held in memory, e.g. when no file system is available.
+func NewCompositePdfFontFromTTFData(data []byte) (*PdfFont, error) {
+	return NewCompositePdfFontFromTTCData(data, 0)
+}
+
+// NewCompositePdfFontFromTTCData loads face `faceIndex` of the TrueType collection or TrueType font `data` as a
+// composite font like NewCompositePdfFontFromTTCFile.
+func NewCompositePdfFontFromTTCData(data []byte, faceIndex int) (*PdfFont, error) {
+	ttf, err := fonts.TtfParseFaceReader(bytes.NewReader(data), faceIndex)
+	if err != nil {
+		common.Log.Debug("Error loading ttf font: %v", err)
+		return nil, err
+	}
+	if len(ttf.Widths) <= 0 {
+		return nil, errors.New("Missing required attribute (Widths)")
+	}
+
+	ttfBytes, err := extractTTFFace(data, faceIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	type0 := &pdfFontType0{}
+	type0.Encoder = textencoding.NewIdentityTextEncoder(ttf.CharsFull)
+	type0.BaseFont = core.MakeName(ttf.PostScriptName)
+	type0.Encoding = type0.Encoder.ToPdfObject()
+	type0.CIDToGIDMap = core.MakeName("Identity")
+
+	sysInfo := core.MakeDict()
+	sysInfo.Set("Registry", core.MakeString("Adobe"))
+	sysInfo.Set("Ordering", core.MakeString("Identity"))
+	sysInfo.Set("Supplement", core.MakeInteger(0))
+	type0.CIDSystemInfo = sysInfo
+
+	// Widths of the mapped glyphs, CID = GID.
+	k := 1000.0 / float64(ttf.UnitsPerEm)
+	type0.defaultWidth = k * float64(ttf.Widths[0])
+	type0.cidWidths = map[uint16]float64{}
+	for _, gid := range ttf.CharsFull {
+		if int(gid) < len(ttf.Widths) {
+			type0.cidWidths[gid] = k * float64(ttf.Widths[gid])
+		}
+	}
+	type0.kerning = ttf.Kerning
+	type0.kerningScale = k
+	type0.DW = core.MakeInteger(int64(type0.defaultWidth + 0.5))
+	type0.W = &core.PdfIndirectObject{PdfObject: makeCIDWidthsArray(type0.cidWidths)}
+
+	toUnicode, err := core.MakeStream(makeToUnicodeCMap(type0.Encoder.CIDToRuneMap()), core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return nil, err
+	}
+	type0.ToUnicode = toUnicode
+
+	descriptor := &PdfFontDescriptor{}
+	descriptor.FontName = core.MakeName(ttf.PostScriptName)
+	descriptor.Ascent = core.MakeFloat(k * float64(ttf.TypoAscender))
+	descriptor.Descent = core.MakeFloat(k * float64(ttf.TypoDescender))
+	descriptor.CapHeight = core.MakeFloat(k * float64(ttf.CapHeight))
+	descriptor.FontBBox = core.MakeArrayFromFloats([]float64{k * float64(ttf.Xmin), k * float64(ttf.Ymin), k * float64(ttf.Xmax), k * float64(ttf.Ymax)})
+	descriptor.ItalicAngle = core.MakeFloat(float64(ttf.ItalicAngle))
+	descriptor.MissingWidth = core.MakeFloat(type0.defaultWidth)
+
+	stream, err := core.MakeStream(ttfBytes, core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return nil, err
+	}
+	stream.PdfObjectDictionary.Set("Length1", core.MakeInteger(int64(len(ttfBytes))))
+	descriptor.FontFile2 = stream
+
+	if ttf.Bold {
+		descriptor.StemV = core.MakeInteger(120)
+	} else {
+		descriptor.StemV = core.MakeInteger(70)
+	}
+
+	// Flags: symbolic, as the glyphs are not in the standard Latin character set.
+	flags := 1 << 2
+	if ttf.IsFixedPitch {
+		flags |= 1
+	}
+	if ttf.ItalicAngle != 0 {
+		flags |= 1 << 6
+	}
+	descriptor.Flags = core.MakeInteger(int64(flags))
+
+	type0.FontDescriptor = descriptor
+
+	font := &PdfFont{}
+	font.context = type0
+
+	return font, nil
+}
+
+// makeCIDWidthsArray returns a W array (9.7.4.3) for the glyph widths `cidWidths`, with the widths of consecutive
+// CIDs grouped as c [w1 w2 ...].
+func makeCIDWidthsArray(cidWidths map[uint16]float64) *core.PdfObjectArray {
+	cids := []int{}
+	for cid := range cidWidths {
+		cids = append(cids, int(cid))
+	}
+	sort.Ints(cids)
+
+	arr := core.MakeArray()
+	var group *core.PdfObjectArray
+	prev := -2
+	for _, cid := range cids {
+		if cid != prev+1 {
+			group = core.MakeArray()
+			arr.Append(core.MakeInteger(int64(cid)))
+			arr.Append(group)
+		}
+		group.Append(core.MakeInteger(int64(cidWidths[uint16(cid)] + 0.5)))
+		prev = cid
+	}
+	return arr
+}
+
+// makeToUnicodeCMap returns a ToUnicode CMap (9.10.3) mapping the 2 byte codes of `cidToRune` to Unicode, with
+// runes outside the Basic Multilingual Plane encoded as UTF-16 surrogate pairs.
+func makeToUnicodeCMap(cidToRune map[uint16]rune) []byte {
+	cids := []int{}
+	for cid := range cidToRune {
+		cids = append(cids, int(cid))
+	}
+	sort.Ints(cids)
+
+	var buf bytes.Buffer
+	buf.WriteString("/CIDInit /ProcSet findresource begin\n")
+	buf.WriteString("12 dict begin\n")
+	buf.WriteString("begincmap\n")
+	buf.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	buf.WriteString("/CMapName /Adobe-Identity-UCS def\n")
+	buf.WriteString("/CMapType 2 def\n")
+	buf.WriteString("1 begincodespacerange\n")
+	buf.WriteString("<0000> <FFFF>\n")
+	buf.WriteString("endcodespacerange\n")
+
+	// At most 100 entries per bfchar block.
+	for i := 0; i < len(cids); i += 100 {
+		end := i + 100
+		if end > len(cids) {
+			end = len(cids)
+		}
+		buf.WriteString(fmt.Sprintf("%d beginbfchar\n", end-i))
+		for _, cid := range cids[i:end] {
+			buf.WriteString(fmt.Sprintf("<%04X> <", cid))
+			for _, u := range utf16.Encode([]rune{cidToRune[uint16(cid)]}) {
+				buf.WriteString(fmt.Sprintf("%04X", u))
+			}
+			buf.WriteString(">\n")
+		}
+		buf.WriteString("endbfchar\n")
+	}
+
+	buf.WriteString("endcmap\n")
+	buf.WriteString("CMapName currentdict /CMap defineresource pop\n")
+	buf.WriteString("end\n")
+	buf.WriteString("end\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,268 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+func TestCompositeFontFromTTF(t *testing.T) {
+	font, err := NewCompositePdfFontFromTTFFile("../../testfiles/roboto/Roboto-Regular.ttf")
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+
+	encoder, ok := font.GetEncoder().(textencoding.IdentityEncoder)
+	if !ok {
+		t.Fatalf("Encoder should be an IdentityEncoder (%T)", font.GetEncoder())
+	}
+	cidA, found := encoder.RuneToCID('A')
+	if !found {
+		t.Fatalf("A not in font")
+	}
+	encoded := encoder.Encode("AA")
+	if len(encoded) != 4 || encoded[0] != byte(cidA>>8) || encoded[1] != byte(cidA) {
+		t.Errorf("Invalid encoding: % x (CID %d)", encoded, cidA)
+	}
+
+	metrics, found := font.GetGlyphCharMetrics("A")
+	if !found || metrics.Wx < 500 || metrics.Wx > 800 {
+		t.Errorf("Invalid metrics for A: %+v", metrics)
+	}
+
+	ind, ok := font.ToPdfObject().(*core.PdfIndirectObject)
+	if !ok {
+		t.Fatalf("Font should be an indirect object")
+	}
+	dict := ind.PdfObject.(*core.PdfObjectDictionary)
+	if dict.Get("Subtype").String() != "Type0" || dict.Get("Encoding").String() != "Identity-H" {
+		t.Errorf("Invalid Type0 font: %s", dict)
+	}
+	descendants, ok := dict.Get("DescendantFonts").(*core.PdfObjectArray)
+	if !ok || len(*descendants) != 1 {
+		t.Fatalf("Invalid DescendantFonts")
+	}
+	cidFont := core.TraceToDirectObject((*descendants)[0]).(*core.PdfObjectDictionary)
+	if cidFont.Get("Subtype").String() != "CIDFontType2" || cidFont.Get("CIDToGIDMap").String() != "Identity" {
+		t.Errorf("Invalid CID font: %s", cidFont)
+	}
+}
+
+func TestIdentityEncoderSupplementaryPlanes(t *testing.T) {
+	encoder := textencoding.NewIdentityTextEncoder(map[rune]uint16{'a': 3, 0x1F600: 0x1234, 0x20000: 7})
+
+	if encoded := encoder.Encode("a\U0001F600\U00020000b"); encoded != "\x00\x03\x12\x34\x00\x07" {
+		t.Errorf("Invalid encoding: % x", encoded)
+	}
+	glyph, found := encoder.RuneToGlyph(0x1F600)
+	if !found || glyph != "u1F600" {
+		t.Errorf("Invalid glyph: %s", glyph)
+	}
+	if r, found := encoder.GlyphToRune(glyph); !found || r != 0x1F600 {
+		t.Errorf("Invalid rune for %s: %x", glyph, r)
+	}
+
+	data := makeToUnicodeCMap(encoder.CIDToRuneMap())
+	if !strings.Contains(string(data), "<1234> <D83DDE00>") {
+		t.Errorf("Surrogate pair missing in ToUnicode CMap:\n%s", data)
+	}
+	toUnicode, err := cmap.LoadCmapFromData(data)
+	if err != nil {
+		t.Fatalf("Error loading CMap: %v", err)
+	}
+	if text := toUnicode.CharcodeBytesToUnicode([]byte("\x00\x03\x12\x34\x00\x07")); text != "a\U0001F600\U00020000" {
+		t.Errorf("ToUnicode mismatch: %q", text)
+	}
+
+	widths := makeCIDWidthsArray(map[uint16]float64{3: 500, 4: 600, 10: 250.4})
+	if widths.DefaultWriteString() != "[3 [500 600] 10 [250]]" {
+		t.Errorf("Invalid W array: %s", widths)
+	}
+}
+
+// makeTestTTC returns a TrueType collection of the fonts in `files`.
+func makeTestTTC(t *testing.T, files ...string) []byte {
+	header := []byte("ttcf\x00\x01\x00\x00")
+	header = append(header, 0, 0, 0, byte(len(files)))
+	headerLen := len(header) + 4*len(files)
+
+	dirs := []byte{}
+	tables := []byte{}
+	fontDirs := [][]byte{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		numTables := int(binary.BigEndian.Uint16(data[4:]))
+		fontDirs = append(fontDirs, append([]byte{}, data[:12+16*numTables]...))
+		dirs = append(dirs, make([]byte, 12+16*numTables)...)
+	}
+	for i, file := range files {
+		data, _ := ioutil.ReadFile(file)
+		dir := fontDirs[i]
+		for j := 0; j < (len(dir)-12)/16; j++ {
+			entry := dir[12+16*j:]
+			offset := binary.BigEndian.Uint32(entry[8:])
+			length := binary.BigEndian.Uint32(entry[12:])
+			binary.BigEndian.PutUint32(entry[8:], uint32(headerLen+len(dirs)+len(tables)))
+			tables = append(tables, data[offset:offset+length]...)
+			for len(tables)%4 != 0 {
+				tables = append(tables, 0)
+			}
+		}
+	}
+
+	ttc := append([]byte{}, header...)
+	offset := headerLen
+	for _, dir := range fontDirs {
+		ttc = append(ttc, byte(offset>>24), byte(offset>>16), byte(offset>>8), byte(offset))
+		offset += len(dir)
+	}
+	for _, dir := range fontDirs {
+		ttc = append(ttc, dir...)
+	}
+	return append(ttc, tables...)
+}
+
+func TestFontFromTTC(t *testing.T) {
+	ttc := makeTestTTC(t, "../../testfiles/roboto/Roboto-Regular.ttf", "../../testfiles/roboto/Roboto-Bold.ttf")
+	f, err := ioutil.TempFile("", "ttc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.Write(ttc)
+	f.Close()
+
+	if n, err := fonts.TtcNumFaces(f.Name()); err != nil || n != 2 {
+		t.Fatalf("Number of faces: %d (%v)", n, err)
+	}
+
+	ttf, err := fonts.TtfParseFace(f.Name(), 1)
+	if err != nil {
+		t.Fatalf("Error parsing face: %v", err)
+	}
+	if ttf.PostScriptName != "Roboto-Bold" {
+		t.Errorf("Face mismatch: %s", ttf.PostScriptName)
+	}
+	if _, err := fonts.TtfParseFace(f.Name(), 2); err == nil {
+		t.Errorf("Face index should be out of range")
+	}
+
+	// The extracted face is a standalone font with the same metrics.
+	face, err := fonts.ExtractTtcFace(ttc, 1)
+	if err != nil {
+		t.Fatalf("Error extracting face: %v", err)
+	}
+	faceFile, err := ioutil.TempFile("", "ttf")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(faceFile.Name())
+	faceFile.Write(face)
+	faceFile.Close()
+	faceTtf, err := fonts.TtfParse(faceFile.Name())
+	if err != nil {
+		t.Fatalf("Error parsing extracted face: %v", err)
+	}
+	if faceTtf.PostScriptName != "Roboto-Bold" || len(faceTtf.Chars) != len(ttf.Chars) {
+		t.Errorf("Extracted face mismatch: %s", faceTtf.PostScriptName)
+	}
+
+	for _, composite := range []bool{false, true} {
+		var font *PdfFont
+		if composite {
+			font, err = NewCompositePdfFontFromTTCFile(f.Name(), 1)
+		} else {
+			font, err = NewPdfFontFromTTCFile(f.Name(), 1)
+		}
+		if err != nil {
+			t.Fatalf("Error loading font (composite %v): %v", composite, err)
+		}
+		dict := font.ToPdfObject().(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary)
+		if dict.Get("BaseFont").String() != "Roboto-Bold" {
+			t.Errorf("BaseFont mismatch: %s", dict.Get("BaseFont"))
+		}
+	}
+}
+
+func TestMeasureStringTTFKerning(t *testing.T) {
+	simple, err := NewPdfFontFromTTFFile("../../testfiles/roboto/Roboto-Regular.ttf")
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+	composite, err := NewCompositePdfFontFromTTFFile("../../testfiles/roboto/Roboto-Regular.ttf")
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+
+	for _, font := range []*PdfFont{simple, composite} {
+		// Roboto kerns A V by -87 font units (2048 per em) in its GPOS table.
+		m := font.MeasureString("AV", 1000)
+		if m.NumGlyphs != 2 || math.Abs(m.Kerning-(-87*1000.0/2048)) > 0.01 {
+			t.Errorf("Unexpected kerning (%T): %+v", font.context, m)
+		}
+		unkerned := font.MeasureString("A", 1000).Advance + font.MeasureString("V", 1000).Advance
+		if math.Abs(m.Advance-(unkerned+m.Kerning)) > 1e-6 {
+			t.Errorf("Advance %f != %f + %f", m.Advance, unkerned, m.Kerning)
+		}
+		if m.Ury <= 0 || m.Lly >= 0 {
+			t.Errorf("Invalid bbox: %+v", m)
+		}
+
+		spaced := font.MeasureStringWithSpacing("AV", 1000, fonts.TextSpacing{CharSpacing: 10})
+		if math.Abs(spaced.Advance-m.Advance-20) > 1e-6 {
+			t.Errorf("Character spacing not applied: %f vs %f", spaced.Advance, m.Advance)
+		}
+	}
+}
+
+func TestFontFromTTFData(t *testing.T) {
+	data, err := ioutil.ReadFile("../../testfiles/roboto/Roboto-Regular.ttf")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	fromFile, err := NewPdfFontFromTTFFile("../../testfiles/roboto/Roboto-Regular.ttf")
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+
+	simple, err := NewPdfFontFromTTFData(data)
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+	composite, err := NewCompositePdfFontFromTTFData(data)
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+
+	expected, _ := fromFile.GetGlyphCharMetrics("A")
+	for _, font := range []*PdfFont{simple, composite} {
+		metrics, found := font.GetGlyphCharMetrics("A")
+		if !found || math.Abs(metrics.Wx-expected.Wx) > 0.5 {
+			t.Errorf("Metrics mismatch (%T): %+v vs %+v", font.context, metrics, expected)
+		}
+		dict := font.ToPdfObject().(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary)
+		if dict.Get("BaseFont").String() != "Roboto-Regular" {
+			t.Errorf("BaseFont mismatch: %s", dict.Get("BaseFont"))
+		}
+	}
+
+	if _, err := NewPdfFontFromTTFData([]byte("not a font")); err == nil {
+		t.Errorf("Expected error for invalid font data")
+	}
+}
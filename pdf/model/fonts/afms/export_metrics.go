@@ -21,7 +21,7 @@ import (
 
 func main() {
 	filepath := flag.String("file", "", "AFM input file")
-	method := flag.String("method", "charmetrics", "charmetrics/charcodes/glyph-to-charcode")
+	method := flag.String("method", "charmetrics", "charmetrics/charcodes/glyph-to-charcode/kerning")
 
 	flag.Parse()
 
@@ -38,6 +38,8 @@ func main() {
 		err = runCharcodeToGlyphRetrievalOnFile(*filepath)
 	case "glyph-to-charcode":
 		err = runGlyphToCharcodeRetrievalOnFile(*filepath)
+	case "kerning":
+		err = runKerningOnFile(*filepath)
 	}
 
 	if err != nil {
@@ -72,6 +74,21 @@ func runCharmetricsOnFile(path string) error {
 	return nil
 }
 
+// Generate a glyph pair to kerning map from the KPX entries.
+func runKerningOnFile(path string) error {
+	pairs, kerning, err := GetKerningFromAfmFile(path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("var xxfontKerning map[GlyphPair]float64 = map[GlyphPair]float64{\n")
+	for _, pair := range pairs {
+		fmt.Printf("\t{\"%s\", \"%s\"}:\t%f,\n", pair.Left, pair.Right, kerning[pair])
+	}
+	fmt.Printf("}\n")
+	return nil
+}
+
 func runCharcodeToGlyphRetrievalOnFile(afmpath string) error {
 	charcodeToGlyphMap, err := GetCharcodeToGlyphEncodingFromAfmFile(afmpath)
 	if err != nil {
@@ -293,3 +310,42 @@ func GetCharcodeToGlyphEncodingFromAfmFile(filename string) (map[byte]string, er
 
 	return charcodeToGlypMap, nil
 }
+
+// GetKerningFromAfmFile returns the kerning pairs (KPX) of an AFM file in file order and the kerning map.
+func GetKerningFromAfmFile(filename string) ([]fonts.GlyphPair, map[fonts.GlyphPair]float64, error) {
+	pairs := []fonts.GlyphPair{}
+	kerning := map[fonts.GlyphPair]float64{}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+		if len(parts) < 1 || parts[0] != "KPX" {
+			continue
+		}
+		if len(parts) != 4 {
+			pdfcommon.Log.Debug("KPX: Invalid number of args != 3 (%s)\n", scanner.Text())
+			return nil, nil, errors.New("Invalid KPX line")
+		}
+		kx, err := strconv.ParseFloat(parts[3], 64)
+		if err != nil {
+			return nil, nil, err
+		}
+		pair := fonts.GlyphPair{Left: parts[1], Right: parts[2]}
+		if _, has := kerning[pair]; !has {
+			pairs = append(pairs, pair)
+		}
+		kerning[pair] = kx
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return pairs, kerning, nil
+}
@@ -39,6 +39,16 @@ func (font fontCourier) GetGlyphCharMetrics(glyph string) (CharMetrics, bool) {
 	return metrics, true
 }
 
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontCourier) GetAscentDescent() (float64, float64) {
+	return 629, -157
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`.
+func (font fontCourier) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontCourier) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -39,6 +39,16 @@ func (font fontCourierBoldOblique) GetGlyphCharMetrics(glyph string) (CharMetric
 	return metrics, true
 }
 
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontCourierBoldOblique) GetAscentDescent() (float64, float64) {
+	return 629, -157
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`.
+func (font fontCourierBoldOblique) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontCourierBoldOblique) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -39,6 +39,22 @@ func (font fontHelvetica) GetGlyphCharMetrics(glyph string) (CharMetrics, bool)
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font fontHelvetica) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := helveticaKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontHelvetica) GetAscentDescent() (float64, float64) {
+	return 718, -207
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontHelvetica) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontHelvetica) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -370,3 +386,2712 @@ var helveticaCharMetrics map[string]CharMetrics = map[string]CharMetrics{
 	"zdotaccent":     {GlyphName: "zdotaccent", Wx: 500.000000, Wy: 0.000000},
 	"zero":           {GlyphName: "zero", Wx: 556.000000, Wy: 0.000000},
 }
+
+// Helvetica kerning pairs loaded from afms/Helvetica.afm.  See afms/MustRead.html for license information.
+var helveticaKerning map[GlyphPair]float64 = map[GlyphPair]float64{
+	{"A", "C"}:                        -30.000000,
+	{"A", "Cacute"}:                   -30.000000,
+	{"A", "Ccaron"}:                   -30.000000,
+	{"A", "Ccedilla"}:                 -30.000000,
+	{"A", "G"}:                        -30.000000,
+	{"A", "Gbreve"}:                   -30.000000,
+	{"A", "Gcommaaccent"}:             -30.000000,
+	{"A", "O"}:                        -30.000000,
+	{"A", "Oacute"}:                   -30.000000,
+	{"A", "Ocircumflex"}:              -30.000000,
+	{"A", "Odieresis"}:                -30.000000,
+	{"A", "Ograve"}:                   -30.000000,
+	{"A", "Ohungarumlaut"}:            -30.000000,
+	{"A", "Omacron"}:                  -30.000000,
+	{"A", "Oslash"}:                   -30.000000,
+	{"A", "Otilde"}:                   -30.000000,
+	{"A", "Q"}:                        -30.000000,
+	{"A", "T"}:                        -120.000000,
+	{"A", "Tcaron"}:                   -120.000000,
+	{"A", "Tcommaaccent"}:             -120.000000,
+	{"A", "U"}:                        -50.000000,
+	{"A", "Uacute"}:                   -50.000000,
+	{"A", "Ucircumflex"}:              -50.000000,
+	{"A", "Udieresis"}:                -50.000000,
+	{"A", "Ugrave"}:                   -50.000000,
+	{"A", "Uhungarumlaut"}:            -50.000000,
+	{"A", "Umacron"}:                  -50.000000,
+	{"A", "Uogonek"}:                  -50.000000,
+	{"A", "Uring"}:                    -50.000000,
+	{"A", "V"}:                        -70.000000,
+	{"A", "W"}:                        -50.000000,
+	{"A", "Y"}:                        -100.000000,
+	{"A", "Yacute"}:                   -100.000000,
+	{"A", "Ydieresis"}:                -100.000000,
+	{"A", "u"}:                        -30.000000,
+	{"A", "uacute"}:                   -30.000000,
+	{"A", "ucircumflex"}:              -30.000000,
+	{"A", "udieresis"}:                -30.000000,
+	{"A", "ugrave"}:                   -30.000000,
+	{"A", "uhungarumlaut"}:            -30.000000,
+	{"A", "umacron"}:                  -30.000000,
+	{"A", "uogonek"}:                  -30.000000,
+	{"A", "uring"}:                    -30.000000,
+	{"A", "v"}:                        -40.000000,
+	{"A", "w"}:                        -40.000000,
+	{"A", "y"}:                        -40.000000,
+	{"A", "yacute"}:                   -40.000000,
+	{"A", "ydieresis"}:                -40.000000,
+	{"Aacute", "C"}:                   -30.000000,
+	{"Aacute", "Cacute"}:              -30.000000,
+	{"Aacute", "Ccaron"}:              -30.000000,
+	{"Aacute", "Ccedilla"}:            -30.000000,
+	{"Aacute", "G"}:                   -30.000000,
+	{"Aacute", "Gbreve"}:              -30.000000,
+	{"Aacute", "Gcommaaccent"}:        -30.000000,
+	{"Aacute", "O"}:                   -30.000000,
+	{"Aacute", "Oacute"}:              -30.000000,
+	{"Aacute", "Ocircumflex"}:         -30.000000,
+	{"Aacute", "Odieresis"}:           -30.000000,
+	{"Aacute", "Ograve"}:              -30.000000,
+	{"Aacute", "Ohungarumlaut"}:       -30.000000,
+	{"Aacute", "Omacron"}:             -30.000000,
+	{"Aacute", "Oslash"}:              -30.000000,
+	{"Aacute", "Otilde"}:              -30.000000,
+	{"Aacute", "Q"}:                   -30.000000,
+	{"Aacute", "T"}:                   -120.000000,
+	{"Aacute", "Tcaron"}:              -120.000000,
+	{"Aacute", "Tcommaaccent"}:        -120.000000,
+	{"Aacute", "U"}:                   -50.000000,
+	{"Aacute", "Uacute"}:              -50.000000,
+	{"Aacute", "Ucircumflex"}:         -50.000000,
+	{"Aacute", "Udieresis"}:           -50.000000,
+	{"Aacute", "Ugrave"}:              -50.000000,
+	{"Aacute", "Uhungarumlaut"}:       -50.000000,
+	{"Aacute", "Umacron"}:             -50.000000,
+	{"Aacute", "Uogonek"}:             -50.000000,
+	{"Aacute", "Uring"}:               -50.000000,
+	{"Aacute", "V"}:                   -70.000000,
+	{"Aacute", "W"}:                   -50.000000,
+	{"Aacute", "Y"}:                   -100.000000,
+	{"Aacute", "Yacute"}:              -100.000000,
+	{"Aacute", "Ydieresis"}:           -100.000000,
+	{"Aacute", "u"}:                   -30.000000,
+	{"Aacute", "uacute"}:              -30.000000,
+	{"Aacute", "ucircumflex"}:         -30.000000,
+	{"Aacute", "udieresis"}:           -30.000000,
+	{"Aacute", "ugrave"}:              -30.000000,
+	{"Aacute", "uhungarumlaut"}:       -30.000000,
+	{"Aacute", "umacron"}:             -30.000000,
+	{"Aacute", "uogonek"}:             -30.000000,
+	{"Aacute", "uring"}:               -30.000000,
+	{"Aacute", "v"}:                   -40.000000,
+	{"Aacute", "w"}:                   -40.000000,
+	{"Aacute", "y"}:                   -40.000000,
+	{"Aacute", "yacute"}:              -40.000000,
+	{"Aacute", "ydieresis"}:           -40.000000,
+	{"Abreve", "C"}:                   -30.000000,
+	{"Abreve", "Cacute"}:              -30.000000,
+	{"Abreve", "Ccaron"}:              -30.000000,
+	{"Abreve", "Ccedilla"}:            -30.000000,
+	{"Abreve", "G"}:                   -30.000000,
+	{"Abreve", "Gbreve"}:              -30.000000,
+	{"Abreve", "Gcommaaccent"}:        -30.000000,
+	{"Abreve", "O"}:                   -30.000000,
+	{"Abreve", "Oacute"}:              -30.000000,
+	{"Abreve", "Ocircumflex"}:         -30.000000,
+	{"Abreve", "Odieresis"}:           -30.000000,
+	{"Abreve", "Ograve"}:              -30.000000,
+	{"Abreve", "Ohungarumlaut"}:       -30.000000,
+	{"Abreve", "Omacron"}:             -30.000000,
+	{"Abreve", "Oslash"}:              -30.000000,
+	{"Abreve", "Otilde"}:              -30.000000,
+	{"Abreve", "Q"}:                   -30.000000,
+	{"Abreve", "T"}:                   -120.000000,
+	{"Abreve", "Tcaron"}:              -120.000000,
+	{"Abreve", "Tcommaaccent"}:        -120.000000,
+	{"Abreve", "U"}:                   -50.000000,
+	{"Abreve", "Uacute"}:              -50.000000,
+	{"Abreve", "Ucircumflex"}:         -50.000000,
+	{"Abreve", "Udieresis"}:           -50.000000,
+	{"Abreve", "Ugrave"}:              -50.000000,
+	{"Abreve", "Uhungarumlaut"}:       -50.000000,
+	{"Abreve", "Umacron"}:             -50.000000,
+	{"Abreve", "Uogonek"}:             -50.000000,
+	{"Abreve", "Uring"}:               -50.000000,
+	{"Abreve", "V"}:                   -70.000000,
+	{"Abreve", "W"}:                   -50.000000,
+	{"Abreve", "Y"}:                   -100.000000,
+	{"Abreve", "Yacute"}:              -100.000000,
+	{"Abreve", "Ydieresis"}:           -100.000000,
+	{"Abreve", "u"}:                   -30.000000,
+	{"Abreve", "uacute"}:              -30.000000,
+	{"Abreve", "ucircumflex"}:         -30.000000,
+	{"Abreve", "udieresis"}:           -30.000000,
+	{"Abreve", "ugrave"}:              -30.000000,
+	{"Abreve", "uhungarumlaut"}:       -30.000000,
+	{"Abreve", "umacron"}:             -30.000000,
+	{"Abreve", "uogonek"}:             -30.000000,
+	{"Abreve", "uring"}:               -30.000000,
+	{"Abreve", "v"}:                   -40.000000,
+	{"Abreve", "w"}:                   -40.000000,
+	{"Abreve", "y"}:                   -40.000000,
+	{"Abreve", "yacute"}:              -40.000000,
+	{"Abreve", "ydieresis"}:           -40.000000,
+	{"Acircumflex", "C"}:              -30.000000,
+	{"Acircumflex", "Cacute"}:         -30.000000,
+	{"Acircumflex", "Ccaron"}:         -30.000000,
+	{"Acircumflex", "Ccedilla"}:       -30.000000,
+	{"Acircumflex", "G"}:              -30.000000,
+	{"Acircumflex", "Gbreve"}:         -30.000000,
+	{"Acircumflex", "Gcommaaccent"}:   -30.000000,
+	{"Acircumflex", "O"}:              -30.000000,
+	{"Acircumflex", "Oacute"}:         -30.000000,
+	{"Acircumflex", "Ocircumflex"}:    -30.000000,
+	{"Acircumflex", "Odieresis"}:      -30.000000,
+	{"Acircumflex", "Ograve"}:         -30.000000,
+	{"Acircumflex", "Ohungarumlaut"}:  -30.000000,
+	{"Acircumflex", "Omacron"}:        -30.000000,
+	{"Acircumflex", "Oslash"}:         -30.000000,
+	{"Acircumflex", "Otilde"}:         -30.000000,
+	{"Acircumflex", "Q"}:              -30.000000,
+	{"Acircumflex", "T"}:              -120.000000,
+	{"Acircumflex", "Tcaron"}:         -120.000000,
+	{"Acircumflex", "Tcommaaccent"}:   -120.000000,
+	{"Acircumflex", "U"}:              -50.000000,
+	{"Acircumflex", "Uacute"}:         -50.000000,
+	{"Acircumflex", "Ucircumflex"}:    -50.000000,
+	{"Acircumflex", "Udieresis"}:      -50.000000,
+	{"Acircumflex", "Ugrave"}:         -50.000000,
+	{"Acircumflex", "Uhungarumlaut"}:  -50.000000,
+	{"Acircumflex", "Umacron"}:        -50.000000,
+	{"Acircumflex", "Uogonek"}:        -50.000000,
+	{"Acircumflex", "Uring"}:          -50.000000,
+	{"Acircumflex", "V"}:              -70.000000,
+	{"Acircumflex", "W"}:              -50.000000,
+	{"Acircumflex", "Y"}:              -100.000000,
+	{"Acircumflex", "Yacute"}:         -100.000000,
+	{"Acircumflex", "Ydieresis"}:      -100.000000,
+	{"Acircumflex", "u"}:              -30.000000,
+	{"Acircumflex", "uacute"}:         -30.000000,
+	{"Acircumflex", "ucircumflex"}:    -30.000000,
+	{"Acircumflex", "udieresis"}:      -30.000000,
+	{"Acircumflex", "ugrave"}:         -30.000000,
+	{"Acircumflex", "uhungarumlaut"}:  -30.000000,
+	{"Acircumflex", "umacron"}:        -30.000000,
+	{"Acircumflex", "uogonek"}:        -30.000000,
+	{"Acircumflex", "uring"}:          -30.000000,
+	{"Acircumflex", "v"}:              -40.000000,
+	{"Acircumflex", "w"}:              -40.000000,
+	{"Acircumflex", "y"}:              -40.000000,
+	{"Acircumflex", "yacute"}:         -40.000000,
+	{"Acircumflex", "ydieresis"}:      -40.000000,
+	{"Adieresis", "C"}:                -30.000000,
+	{"Adieresis", "Cacute"}:           -30.000000,
+	{"Adieresis", "Ccaron"}:           -30.000000,
+	{"Adieresis", "Ccedilla"}:         -30.000000,
+	{"Adieresis", "G"}:                -30.000000,
+	{"Adieresis", "Gbreve"}:           -30.000000,
+	{"Adieresis", "Gcommaaccent"}:     -30.000000,
+	{"Adieresis", "O"}:                -30.000000,
+	{"Adieresis", "Oacute"}:           -30.000000,
+	{"Adieresis", "Ocircumflex"}:      -30.000000,
+	{"Adieresis", "Odieresis"}:        -30.000000,
+	{"Adieresis", "Ograve"}:           -30.000000,
+	{"Adieresis", "Ohungarumlaut"}:    -30.000000,
+	{"Adieresis", "Omacron"}:          -30.000000,
+	{"Adieresis", "Oslash"}:           -30.000000,
+	{"Adieresis", "Otilde"}:           -30.000000,
+	{"Adieresis", "Q"}:                -30.000000,
+	{"Adieresis", "T"}:                -120.000000,
+	{"Adieresis", "Tcaron"}:           -120.000000,
+	{"Adieresis", "Tcommaaccent"}:     -120.000000,
+	{"Adieresis", "U"}:                -50.000000,
+	{"Adieresis", "Uacute"}:           -50.000000,
+	{"Adieresis", "Ucircumflex"}:      -50.000000,
+	{"Adieresis", "Udieresis"}:        -50.000000,
+	{"Adieresis", "Ugrave"}:           -50.000000,
+	{"Adieresis", "Uhungarumlaut"}:    -50.000000,
+	{"Adieresis", "Umacron"}:          -50.000000,
+	{"Adieresis", "Uogonek"}:          -50.000000,
+	{"Adieresis", "Uring"}:            -50.000000,
+	{"Adieresis", "V"}:                -70.000000,
+	{"Adieresis", "W"}:                -50.000000,
+	{"Adieresis", "Y"}:                -100.000000,
+	{"Adieresis", "Yacute"}:           -100.000000,
+	{"Adieresis", "Ydieresis"}:        -100.000000,
+	{"Adieresis", "u"}:                -30.000000,
+	{"Adieresis", "uacute"}:           -30.000000,
+	{"Adieresis", "ucircumflex"}:      -30.000000,
+	{"Adieresis", "udieresis"}:        -30.000000,
+	{"Adieresis", "ugrave"}:           -30.000000,
+	{"Adieresis", "uhungarumlaut"}:    -30.000000,
+	{"Adieresis", "umacron"}:          -30.000000,
+	{"Adieresis", "uogonek"}:          -30.000000,
+	{"Adieresis", "uring"}:            -30.000000,
+	{"Adieresis", "v"}:                -40.000000,
+	{"Adieresis", "w"}:                -40.000000,
+	{"Adieresis", "y"}:                -40.000000,
+	{"Adieresis", "yacute"}:           -40.000000,
+	{"Adieresis", "ydieresis"}:        -40.000000,
+	{"Agrave", "C"}:                   -30.000000,
+	{"Agrave", "Cacute"}:              -30.000000,
+	{"Agrave", "Ccaron"}:              -30.000000,
+	{"Agrave", "Ccedilla"}:            -30.000000,
+	{"Agrave", "G"}:                   -30.000000,
+	{"Agrave", "Gbreve"}:              -30.000000,
+	{"Agrave", "Gcommaaccent"}:        -30.000000,
+	{"Agrave", "O"}:                   -30.000000,
+	{"Agrave", "Oacute"}:              -30.000000,
+	{"Agrave", "Ocircumflex"}:         -30.000000,
+	{"Agrave", "Odieresis"}:           -30.000000,
+	{"Agrave", "Ograve"}:              -30.000000,
+	{"Agrave", "Ohungarumlaut"}:       -30.000000,
+	{"Agrave", "Omacron"}:             -30.000000,
+	{"Agrave", "Oslash"}:              -30.000000,
+	{"Agrave", "Otilde"}:              -30.000000,
+	{"Agrave", "Q"}:                   -30.000000,
+	{"Agrave", "T"}:                   -120.000000,
+	{"Agrave", "Tcaron"}:              -120.000000,
+	{"Agrave", "Tcommaaccent"}:        -120.000000,
+	{"Agrave", "U"}:                   -50.000000,
+	{"Agrave", "Uacute"}:              -50.000000,
+	{"Agrave", "Ucircumflex"}:         -50.000000,
+	{"Agrave", "Udieresis"}:           -50.000000,
+	{"Agrave", "Ugrave"}:              -50.000000,
+	{"Agrave", "Uhungarumlaut"}:       -50.000000,
+	{"Agrave", "Umacron"}:             -50.000000,
+	{"Agrave", "Uogonek"}:             -50.000000,
+	{"Agrave", "Uring"}:               -50.000000,
+	{"Agrave", "V"}:                   -70.000000,
+	{"Agrave", "W"}:                   -50.000000,
+	{"Agrave", "Y"}:                   -100.000000,
+	{"Agrave", "Yacute"}:              -100.000000,
+	{"Agrave", "Ydieresis"}:           -100.000000,
+	{"Agrave", "u"}:                   -30.000000,
+	{"Agrave", "uacute"}:              -30.000000,
+	{"Agrave", "ucircumflex"}:         -30.000000,
+	{"Agrave", "udieresis"}:           -30.000000,
+	{"Agrave", "ugrave"}:              -30.000000,
+	{"Agrave", "uhungarumlaut"}:       -30.000000,
+	{"Agrave", "umacron"}:             -30.000000,
+	{"Agrave", "uogonek"}:             -30.000000,
+	{"Agrave", "uring"}:               -30.000000,
+	{"Agrave", "v"}:                   -40.000000,
+	{"Agrave", "w"}:                   -40.000000,
+	{"Agrave", "y"}:                   -40.000000,
+	{"Agrave", "yacute"}:              -40.000000,
+	{"Agrave", "ydieresis"}:           -40.000000,
+	{"Amacron", "C"}:                  -30.000000,
+	{"Amacron", "Cacute"}:             -30.000000,
+	{"Amacron", "Ccaron"}:             -30.000000,
+	{"Amacron", "Ccedilla"}:           -30.000000,
+	{"Amacron", "G"}:                  -30.000000,
+	{"Amacron", "Gbreve"}:             -30.000000,
+	{"Amacron", "Gcommaaccent"}:       -30.000000,
+	{"Amacron", "O"}:                  -30.000000,
+	{"Amacron", "Oacute"}:             -30.000000,
+	{"Amacron", "Ocircumflex"}:        -30.000000,
+	{"Amacron", "Odieresis"}:          -30.000000,
+	{"Amacron", "Ograve"}:             -30.000000,
+	{"Amacron", "Ohungarumlaut"}:      -30.000000,
+	{"Amacron", "Omacron"}:            -30.000000,
+	{"Amacron", "Oslash"}:             -30.000000,
+	{"Amacron", "Otilde"}:             -30.000000,
+	{"Amacron", "Q"}:                  -30.000000,
+	{"Amacron", "T"}:                  -120.000000,
+	{"Amacron", "Tcaron"}:             -120.000000,
+	{"Amacron", "Tcommaaccent"}:       -120.000000,
+	{"Amacron", "U"}:                  -50.000000,
+	{"Amacron", "Uacute"}:             -50.000000,
+	{"Amacron", "Ucircumflex"}:        -50.000000,
+	{"Amacron", "Udieresis"}:          -50.000000,
+	{"Amacron", "Ugrave"}:             -50.000000,
+	{"Amacron", "Uhungarumlaut"}:      -50.000000,
+	{"Amacron", "Umacron"}:            -50.000000,
+	{"Amacron", "Uogonek"}:            -50.000000,
+	{"Amacron", "Uring"}:              -50.000000,
+	{"Amacron", "V"}:                  -70.000000,
+	{"Amacron", "W"}:                  -50.000000,
+	{"Amacron", "Y"}:                  -100.000000,
+	{"Amacron", "Yacute"}:             -100.000000,
+	{"Amacron", "Ydieresis"}:          -100.000000,
+	{"Amacron", "u"}:                  -30.000000,
+	{"Amacron", "uacute"}:             -30.000000,
+	{"Amacron", "ucircumflex"}:        -30.000000,
+	{"Amacron", "udieresis"}:          -30.000000,
+	{"Amacron", "ugrave"}:             -30.000000,
+	{"Amacron", "uhungarumlaut"}:      -30.000000,
+	{"Amacron", "umacron"}:            -30.000000,
+	{"Amacron", "uogonek"}:            -30.000000,
+	{"Amacron", "uring"}:              -30.000000,
+	{"Amacron", "v"}:                  -40.000000,
+	{"Amacron", "w"}:                  -40.000000,
+	{"Amacron", "y"}:                  -40.000000,
+	{"Amacron", "yacute"}:             -40.000000,
+	{"Amacron", "ydieresis"}:          -40.000000,
+	{"Aogonek", "C"}:                  -30.000000,
+	{"Aogonek", "Cacute"}:             -30.000000,
+	{"Aogonek", "Ccaron"}:             -30.000000,
+	{"Aogonek", "Ccedilla"}:           -30.000000,
+	{"Aogonek", "G"}:                  -30.000000,
+	{"Aogonek", "Gbreve"}:             -30.000000,
+	{"Aogonek", "Gcommaaccent"}:       -30.000000,
+	{"Aogonek", "O"}:                  -30.000000,
+	{"Aogonek", "Oacute"}:             -30.000000,
+	{"Aogonek", "Ocircumflex"}:        -30.000000,
+	{"Aogonek", "Odieresis"}:          -30.000000,
+	{"Aogonek", "Ograve"}:             -30.000000,
+	{"Aogonek", "Ohungarumlaut"}:      -30.000000,
+	{"Aogonek", "Omacron"}:            -30.000000,
+	{"Aogonek", "Oslash"}:             -30.000000,
+	{"Aogonek", "Otilde"}:             -30.000000,
+	{"Aogonek", "Q"}:                  -30.000000,
+	{"Aogonek", "T"}:                  -120.000000,
+	{"Aogonek", "Tcaron"}:             -120.000000,
+	{"Aogonek", "Tcommaaccent"}:       -120.000000,
+	{"Aogonek", "U"}:                  -50.000000,
+	{"Aogonek", "Uacute"}:             -50.000000,
+	{"Aogonek", "Ucircumflex"}:        -50.000000,
+	{"Aogonek", "Udieresis"}:          -50.000000,
+	{"Aogonek", "Ugrave"}:             -50.000000,
+	{"Aogonek", "Uhungarumlaut"}:      -50.000000,
+	{"Aogonek", "Umacron"}:            -50.000000,
+	{"Aogonek", "Uogonek"}:            -50.000000,
+	{"Aogonek", "Uring"}:              -50.000000,
+	{"Aogonek", "V"}:                  -70.000000,
+	{"Aogonek", "W"}:                  -50.000000,
+	{"Aogonek", "Y"}:                  -100.000000,
+	{"Aogonek", "Yacute"}:             -100.000000,
+	{"Aogonek", "Ydieresis"}:          -100.000000,
+	{"Aogonek", "u"}:                  -30.000000,
+	{"Aogonek", "uacute"}:             -30.000000,
+	{"Aogonek", "ucircumflex"}:        -30.000000,
+	{"Aogonek", "udieresis"}:          -30.000000,
+	{"Aogonek", "ugrave"}:             -30.000000,
+	{"Aogonek", "uhungarumlaut"}:      -30.000000,
+	{"Aogonek", "umacron"}:            -30.000000,
+	{"Aogonek", "uogonek"}:            -30.000000,
+	{"Aogonek", "uring"}:              -30.000000,
+	{"Aogonek", "v"}:                  -40.000000,
+	{"Aogonek", "w"}:                  -40.000000,
+	{"Aogonek", "y"}:                  -40.000000,
+	{"Aogonek", "yacute"}:             -40.000000,
+	{"Aogonek", "ydieresis"}:          -40.000000,
+	{"Aring", "C"}:                    -30.000000,
+	{"Aring", "Cacute"}:               -30.000000,
+	{"Aring", "Ccaron"}:               -30.000000,
+	{"Aring", "Ccedilla"}:             -30.000000,
+	{"Aring", "G"}:                    -30.000000,
+	{"Aring", "Gbreve"}:               -30.000000,
+	{"Aring", "Gcommaaccent"}:         -30.000000,
+	{"Aring", "O"}:                    -30.000000,
+	{"Aring", "Oacute"}:               -30.000000,
+	{"Aring", "Ocircumflex"}:          -30.000000,
+	{"Aring", "Odieresis"}:            -30.000000,
+	{"Aring", "Ograve"}:               -30.000000,
+	{"Aring", "Ohungarumlaut"}:        -30.000000,
+	{"Aring", "Omacron"}:              -30.000000,
+	{"Aring", "Oslash"}:               -30.000000,
+	{"Aring", "Otilde"}:               -30.000000,
+	{"Aring", "Q"}:                    -30.000000,
+	{"Aring", "T"}:                    -120.000000,
+	{"Aring", "Tcaron"}:               -120.000000,
+	{"Aring", "Tcommaaccent"}:         -120.000000,
+	{"Aring", "U"}:                    -50.000000,
+	{"Aring", "Uacute"}:               -50.000000,
+	{"Aring", "Ucircumflex"}:          -50.000000,
+	{"Aring", "Udieresis"}:            -50.000000,
+	{"Aring", "Ugrave"}:               -50.000000,
+	{"Aring", "Uhungarumlaut"}:        -50.000000,
+	{"Aring", "Umacron"}:              -50.000000,
+	{"Aring", "Uogonek"}:              -50.000000,
+	{"Aring", "Uring"}:                -50.000000,
+	{"Aring", "V"}:                    -70.000000,
+	{"Aring", "W"}:                    -50.000000,
+	{"Aring", "Y"}:                    -100.000000,
+	{"Aring", "Yacute"}:               -100.000000,
+	{"Aring", "Ydieresis"}:            -100.000000,
+	{"Aring", "u"}:                    -30.000000,
+	{"Aring", "uacute"}:               -30.000000,
+	{"Aring", "ucircumflex"}:          -30.000000,
+	{"Aring", "udieresis"}:            -30.000000,
+	{"Aring", "ugrave"}:               -30.000000,
+	{"Aring", "uhungarumlaut"}:        -30.000000,
+	{"Aring", "umacron"}:              -30.000000,
+	{"Aring", "uogonek"}:              -30.000000,
+	{"Aring", "uring"}:                -30.000000,
+	{"Aring", "v"}:                    -40.000000,
+	{"Aring", "w"}:                    -40.000000,
+	{"Aring", "y"}:                    -40.000000,
+	{"Aring", "yacute"}:               -40.000000,
+	{"Aring", "ydieresis"}:            -40.000000,
+	{"Atilde", "C"}:                   -30.000000,
+	{"Atilde", "Cacute"}:              -30.000000,
+	{"Atilde", "Ccaron"}:              -30.000000,
+	{"Atilde", "Ccedilla"}:            -30.000000,
+	{"Atilde", "G"}:                   -30.000000,
+	{"Atilde", "Gbreve"}:              -30.000000,
+	{"Atilde", "Gcommaaccent"}:        -30.000000,
+	{"Atilde", "O"}:                   -30.000000,
+	{"Atilde", "Oacute"}:              -30.000000,
+	{"Atilde", "Ocircumflex"}:         -30.000000,
+	{"Atilde", "Odieresis"}:           -30.000000,
+	{"Atilde", "Ograve"}:              -30.000000,
+	{"Atilde", "Ohungarumlaut"}:       -30.000000,
+	{"Atilde", "Omacron"}:             -30.000000,
+	{"Atilde", "Oslash"}:              -30.000000,
+	{"Atilde", "Otilde"}:              -30.000000,
+	{"Atilde", "Q"}:                   -30.000000,
+	{"Atilde", "T"}:                   -120.000000,
+	{"Atilde", "Tcaron"}:              -120.000000,
+	{"Atilde", "Tcommaaccent"}:        -120.000000,
+	{"Atilde", "U"}:                   -50.000000,
+	{"Atilde", "Uacute"}:              -50.000000,
+	{"Atilde", "Ucircumflex"}:         -50.000000,
+	{"Atilde", "Udieresis"}:           -50.000000,
+	{"Atilde", "Ugrave"}:              -50.000000,
+	{"Atilde", "Uhungarumlaut"}:       -50.000000,
+	{"Atilde", "Umacron"}:             -50.000000,
+	{"Atilde", "Uogonek"}:             -50.000000,
+	{"Atilde", "Uring"}:               -50.000000,
+	{"Atilde", "V"}:                   -70.000000,
+	{"Atilde", "W"}:                   -50.000000,
+	{"Atilde", "Y"}:                   -100.000000,
+	{"Atilde", "Yacute"}:              -100.000000,
+	{"Atilde", "Ydieresis"}:           -100.000000,
+	{"Atilde", "u"}:                   -30.000000,
+	{"Atilde", "uacute"}:              -30.000000,
+	{"Atilde", "ucircumflex"}:         -30.000000,
+	{"Atilde", "udieresis"}:           -30.000000,
+	{"Atilde", "ugrave"}:              -30.000000,
+	{"Atilde", "uhungarumlaut"}:       -30.000000,
+	{"Atilde", "umacron"}:             -30.000000,
+	{"Atilde", "uogonek"}:             -30.000000,
+	{"Atilde", "uring"}:               -30.000000,
+	{"Atilde", "v"}:                   -40.000000,
+	{"Atilde", "w"}:                   -40.000000,
+	{"Atilde", "y"}:                   -40.000000,
+	{"Atilde", "yacute"}:              -40.000000,
+	{"Atilde", "ydieresis"}:           -40.000000,
+	{"B", "U"}:                        -10.000000,
+	{"B", "Uacute"}:                   -10.000000,
+	{"B", "Ucircumflex"}:              -10.000000,
+	{"B", "Udieresis"}:                -10.000000,
+	{"B", "Ugrave"}:                   -10.000000,
+	{"B", "Uhungarumlaut"}:            -10.000000,
+	{"B", "Umacron"}:                  -10.000000,
+	{"B", "Uogonek"}:                  -10.000000,
+	{"B", "Uring"}:                    -10.000000,
+	{"B", "comma"}:                    -20.000000,
+	{"B", "period"}:                   -20.000000,
+	{"C", "comma"}:                    -30.000000,
+	{"C", "period"}:                   -30.000000,
+	{"Cacute", "comma"}:               -30.000000,
+	{"Cacute", "period"}:              -30.000000,
+	{"Ccaron", "comma"}:               -30.000000,
+	{"Ccaron", "period"}:              -30.000000,
+	{"Ccedilla", "comma"}:             -30.000000,
+	{"Ccedilla", "period"}:            -30.000000,
+	{"D", "A"}:                        -40.000000,
+	{"D", "Aacute"}:                   -40.000000,
+	{"D", "Abreve"}:                   -40.000000,
+	{"D", "Acircumflex"}:              -40.000000,
+	{"D", "Adieresis"}:                -40.000000,
+	{"D", "Agrave"}:                   -40.000000,
+	{"D", "Amacron"}:                  -40.000000,
+	{"D", "Aogonek"}:                  -40.000000,
+	{"D", "Aring"}:                    -40.000000,
+	{"D", "Atilde"}:                   -40.000000,
+	{"D", "V"}:                        -70.000000,
+	{"D", "W"}:                        -40.000000,
+	{"D", "Y"}:                        -90.000000,
+	{"D", "Yacute"}:                   -90.000000,
+	{"D", "Ydieresis"}:                -90.000000,
+	{"D", "comma"}:                    -70.000000,
+	{"D", "period"}:                   -70.000000,
+	{"Dcaron", "A"}:                   -40.000000,
+	{"Dcaron", "Aacute"}:              -40.000000,
+	{"Dcaron", "Abreve"}:              -40.000000,
+	{"Dcaron", "Acircumflex"}:         -40.000000,
+	{"Dcaron", "Adieresis"}:           -40.000000,
+	{"Dcaron", "Agrave"}:              -40.000000,
+	{"Dcaron", "Amacron"}:             -40.000000,
+	{"Dcaron", "Aogonek"}:             -40.000000,
+	{"Dcaron", "Aring"}:               -40.000000,
+	{"Dcaron", "Atilde"}:              -40.000000,
+	{"Dcaron", "V"}:                   -70.000000,
+	{"Dcaron", "W"}:                   -40.000000,
+	{"Dcaron", "Y"}:                   -90.000000,
+	{"Dcaron", "Yacute"}:              -90.000000,
+	{"Dcaron", "Ydieresis"}:           -90.000000,
+	{"Dcaron", "comma"}:               -70.000000,
+	{"Dcaron", "period"}:              -70.000000,
+	{"Dcroat", "A"}:                   -40.000000,
+	{"Dcroat", "Aacute"}:              -40.000000,
+	{"Dcroat", "Abreve"}:              -40.000000,
+	{"Dcroat", "Acircumflex"}:         -40.000000,
+	{"Dcroat", "Adieresis"}:           -40.000000,
+	{"Dcroat", "Agrave"}:              -40.000000,
+	{"Dcroat", "Amacron"}:             -40.000000,
+	{"Dcroat", "Aogonek"}:             -40.000000,
+	{"Dcroat", "Aring"}:               -40.000000,
+	{"Dcroat", "Atilde"}:              -40.000000,
+	{"Dcroat", "V"}:                   -70.000000,
+	{"Dcroat", "W"}:                   -40.000000,
+	{"Dcroat", "Y"}:                   -90.000000,
+	{"Dcroat", "Yacute"}:              -90.000000,
+	{"Dcroat", "Ydieresis"}:           -90.000000,
+	{"Dcroat", "comma"}:               -70.000000,
+	{"Dcroat", "period"}:              -70.000000,
+	{"F", "A"}:                        -80.000000,
+	{"F", "Aacute"}:                   -80.000000,
+	{"F", "Abreve"}:                   -80.000000,
+	{"F", "Acircumflex"}:              -80.000000,
+	{"F", "Adieresis"}:                -80.000000,
+	{"F", "Agrave"}:                   -80.000000,
+	{"F", "Amacron"}:                  -80.000000,
+	{"F", "Aogonek"}:                  -80.000000,
+	{"F", "Aring"}:                    -80.000000,
+	{"F", "Atilde"}:                   -80.000000,
+	{"F", "a"}:                        -50.000000,
+	{"F", "aacute"}:                   -50.000000,
+	{"F", "abreve"}:                   -50.000000,
+	{"F", "acircumflex"}:              -50.000000,
+	{"F", "adieresis"}:                -50.000000,
+	{"F", "agrave"}:                   -50.000000,
+	{"F", "amacron"}:                  -50.000000,
+	{"F", "aogonek"}:                  -50.000000,
+	{"F", "aring"}:                    -50.000000,
+	{"F", "atilde"}:                   -50.000000,
+	{"F", "comma"}:                    -150.000000,
+	{"F", "e"}:                        -30.000000,
+	{"F", "eacute"}:                   -30.000000,
+	{"F", "ecaron"}:                   -30.000000,
+	{"F", "ecircumflex"}:              -30.000000,
+	{"F", "edieresis"}:                -30.000000,
+	{"F", "edotaccent"}:               -30.000000,
+	{"F", "egrave"}:                   -30.000000,
+	{"F", "emacron"}:                  -30.000000,
+	{"F", "eogonek"}:                  -30.000000,
+	{"F", "o"}:                        -30.000000,
+	{"F", "oacute"}:                   -30.000000,
+	{"F", "ocircumflex"}:              -30.000000,
+	{"F", "odieresis"}:                -30.000000,
+	{"F", "ograve"}:                   -30.000000,
+	{"F", "ohungarumlaut"}:            -30.000000,
+	{"F", "omacron"}:                  -30.000000,
+	{"F", "oslash"}:                   -30.000000,
+	{"F", "otilde"}:                   -30.000000,
+	{"F", "period"}:                   -150.000000,
+	{"F", "r"}:                        -45.000000,
+	{"F", "racute"}:                   -45.000000,
+	{"F", "rcaron"}:                   -45.000000,
+	{"F", "rcommaaccent"}:             -45.000000,
+	{"J", "A"}:                        -20.000000,
+	{"J", "Aacute"}:                   -20.000000,
+	{"J", "Abreve"}:                   -20.000000,
+	{"J", "Acircumflex"}:              -20.000000,
+	{"J", "Adieresis"}:                -20.000000,
+	{"J", "Agrave"}:                   -20.000000,
+	{"J", "Amacron"}:                  -20.000000,
+	{"J", "Aogonek"}:                  -20.000000,
+	{"J", "Aring"}:                    -20.000000,
+	{"J", "Atilde"}:                   -20.000000,
+	{"J", "a"}:                        -20.000000,
+	{"J", "aacute"}:                   -20.000000,
+	{"J", "abreve"}:                   -20.000000,
+	{"J", "acircumflex"}:              -20.000000,
+	{"J", "adieresis"}:                -20.000000,
+	{"J", "agrave"}:                   -20.000000,
+	{"J", "amacron"}:                  -20.000000,
+	{"J", "aogonek"}:                  -20.000000,
+	{"J", "aring"}:                    -20.000000,
+	{"J", "atilde"}:                   -20.000000,
+	{"J", "comma"}:                    -30.000000,
+	{"J", "period"}:                   -30.000000,
+	{"J", "u"}:                        -20.000000,
+	{"J", "uacute"}:                   -20.000000,
+	{"J", "ucircumflex"}:              -20.000000,
+	{"J", "udieresis"}:                -20.000000,
+	{"J", "ugrave"}:                   -20.000000,
+	{"J", "uhungarumlaut"}:            -20.000000,
+	{"J", "umacron"}:                  -20.000000,
+	{"J", "uogonek"}:                  -20.000000,
+	{"J", "uring"}:                    -20.000000,
+	{"K", "O"}:                        -50.000000,
+	{"K", "Oacute"}:                   -50.000000,
+	{"K", "Ocircumflex"}:              -50.000000,
+	{"K", "Odieresis"}:                -50.000000,
+	{"K", "Ograve"}:                   -50.000000,
+	{"K", "Ohungarumlaut"}:            -50.000000,
+	{"K", "Omacron"}:                  -50.000000,
+	{"K", "Oslash"}:                   -50.000000,
+	{"K", "Otilde"}:                   -50.000000,
+	{"K", "e"}:                        -40.000000,
+	{"K", "eacute"}:                   -40.000000,
+	{"K", "ecaron"}:                   -40.000000,
+	{"K", "ecircumflex"}:              -40.000000,
+	{"K", "edieresis"}:                -40.000000,
+	{"K", "edotaccent"}:               -40.000000,
+	{"K", "egrave"}:                   -40.000000,
+	{"K", "emacron"}:                  -40.000000,
+	{"K", "eogonek"}:                  -40.000000,
+	{"K", "o"}:                        -40.000000,
+	{"K", "oacute"}:                   -40.000000,
+	{"K", "ocircumflex"}:              -40.000000,
+	{"K", "odieresis"}:                -40.000000,
+	{"K", "ograve"}:                   -40.000000,
+	{"K", "ohungarumlaut"}:            -40.000000,
+	{"K", "omacron"}:                  -40.000000,
+	{"K", "oslash"}:                   -40.000000,
+	{"K", "otilde"}:                   -40.000000,
+	{"K", "u"}:                        -30.000000,
+	{"K", "uacute"}:                   -30.000000,
+	{"K", "ucircumflex"}:              -30.000000,
+	{"K", "udieresis"}:                -30.000000,
+	{"K", "ugrave"}:                   -30.000000,
+	{"K", "uhungarumlaut"}:            -30.000000,
+	{"K", "umacron"}:                  -30.000000,
+	{"K", "uogonek"}:                  -30.000000,
+	{"K", "uring"}:                    -30.000000,
+	{"K", "y"}:                        -50.000000,
+	{"K", "yacute"}:                   -50.000000,
+	{"K", "ydieresis"}:                -50.000000,
+	{"Kcommaaccent", "O"}:             -50.000000,
+	{"Kcommaaccent", "Oacute"}:        -50.000000,
+	{"Kcommaaccent", "Ocircumflex"}:   -50.000000,
+	{"Kcommaaccent", "Odieresis"}:     -50.000000,
+	{"Kcommaaccent", "Ograve"}:        -50.000000,
+	{"Kcommaaccent", "Ohungarumlaut"}: -50.000000,
+	{"Kcommaaccent", "Omacron"}:       -50.000000,
+	{"Kcommaaccent", "Oslash"}:        -50.000000,
+	{"Kcommaaccent", "Otilde"}:        -50.000000,
+	{"Kcommaaccent", "e"}:             -40.000000,
+	{"Kcommaaccent", "eacute"}:        -40.000000,
+	{"Kcommaaccent", "ecaron"}:        -40.000000,
+	{"Kcommaaccent", "ecircumflex"}:   -40.000000,
+	{"Kcommaaccent", "edieresis"}:     -40.000000,
+	{"Kcommaaccent", "edotaccent"}:    -40.000000,
+	{"Kcommaaccent", "egrave"}:        -40.000000,
+	{"Kcommaaccent", "emacron"}:       -40.000000,
+	{"Kcommaaccent", "eogonek"}:       -40.000000,
+	{"Kcommaaccent", "o"}:             -40.000000,
+	{"Kcommaaccent", "oacute"}:        -40.000000,
+	{"Kcommaaccent", "ocircumflex"}:   -40.000000,
+	{"Kcommaaccent", "odieresis"}:     -40.000000,
+	{"Kcommaaccent", "ograve"}:        -40.000000,
+	{"Kcommaaccent", "ohungarumlaut"}: -40.000000,
+	{"Kcommaaccent", "omacron"}:       -40.000000,
+	{"Kcommaaccent", "oslash"}:        -40.000000,
+	{"Kcommaaccent", "otilde"}:        -40.000000,
+	{"Kcommaaccent", "u"}:             -30.000000,
+	{"Kcommaaccent", "uacute"}:        -30.000000,
+	{"Kcommaaccent", "ucircumflex"}:   -30.000000,
+	{"Kcommaaccent", "udieresis"}:     -30.000000,
+	{"Kcommaaccent", "ugrave"}:        -30.000000,
+	{"Kcommaaccent", "uhungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "umacron"}:       -30.000000,
+	{"Kcommaaccent", "uogonek"}:       -30.000000,
+	{"Kcommaaccent", "uring"}:         -30.000000,
+	{"Kcommaaccent", "y"}:             -50.000000,
+	{"Kcommaaccent", "yacute"}:        -50.000000,
+	{"Kcommaaccent", "ydieresis"}:     -50.000000,
+	{"L", "T"}:                        -110.000000,
+	{"L", "Tcaron"}:                   -110.000000,
+	{"L", "Tcommaaccent"}:             -110.000000,
+	{"L", "V"}:                        -110.000000,
+	{"L", "W"}:                        -70.000000,
+	{"L", "Y"}:                        -140.000000,
+	{"L", "Yacute"}:                   -140.000000,
+	{"L", "Ydieresis"}:                -140.000000,
+	{"L", "quotedblright"}:            -140.000000,
+	{"L", "quoteright"}:               -160.000000,
+	{"L", "y"}:                        -30.000000,
+	{"L", "yacute"}:                   -30.000000,
+	{"L", "ydieresis"}:                -30.000000,
+	{"Lacute", "T"}:                   -110.000000,
+	{"Lacute", "Tcaron"}:              -110.000000,
+	{"Lacute", "Tcommaaccent"}:        -110.000000,
+	{"Lacute", "V"}:                   -110.000000,
+	{"Lacute", "W"}:                   -70.000000,
+	{"Lacute", "Y"}:                   -140.000000,
+	{"Lacute", "Yacute"}:              -140.000000,
+	{"Lacute", "Ydieresis"}:           -140.000000,
+	{"Lacute", "quotedblright"}:       -140.000000,
+	{"Lacute", "quoteright"}:          -160.000000,
+	{"Lacute", "y"}:                   -30.000000,
+	{"Lacute", "yacute"}:              -30.000000,
+	{"Lacute", "ydieresis"}:           -30.000000,
+	{"Lcaron", "T"}:                   -110.000000,
+	{"Lcaron", "Tcaron"}:              -110.000000,
+	{"Lcaron", "Tcommaaccent"}:        -110.000000,
+	{"Lcaron", "V"}:                   -110.000000,
+	{"Lcaron", "W"}:                   -70.000000,
+	{"Lcaron", "Y"}:                   -140.000000,
+	{"Lcaron", "Yacute"}:              -140.000000,
+	{"Lcaron", "Ydieresis"}:           -140.000000,
+	{"Lcaron", "quotedblright"}:       -140.000000,
+	{"Lcaron", "quoteright"}:          -160.000000,
+	{"Lcaron", "y"}:                   -30.000000,
+	{"Lcaron", "yacute"}:              -30.000000,
+	{"Lcaron", "ydieresis"}:           -30.000000,
+	{"Lcommaaccent", "T"}:             -110.000000,
+	{"Lcommaaccent", "Tcaron"}:        -110.000000,
+	{"Lcommaaccent", "Tcommaaccent"}:  -110.000000,
+	{"Lcommaaccent", "V"}:             -110.000000,
+	{"Lcommaaccent", "W"}:             -70.000000,
+	{"Lcommaaccent", "Y"}:             -140.000000,
+	{"Lcommaaccent", "Yacute"}:        -140.000000,
+	{"Lcommaaccent", "Ydieresis"}:     -140.000000,
+	{"Lcommaaccent", "quotedblright"}: -140.000000,
+	{"Lcommaaccent", "quoteright"}:    -160.000000,
+	{"Lcommaaccent", "y"}:             -30.000000,
+	{"Lcommaaccent", "yacute"}:        -30.000000,
+	{"Lcommaaccent", "ydieresis"}:     -30.000000,
+	{"Lslash", "T"}:                   -110.000000,
+	{"Lslash", "Tcaron"}:              -110.000000,
+	{"Lslash", "Tcommaaccent"}:        -110.000000,
+	{"Lslash", "V"}:                   -110.000000,
+	{"Lslash", "W"}:                   -70.000000,
+	{"Lslash", "Y"}:                   -140.000000,
+	{"Lslash", "Yacute"}:              -140.000000,
+	{"Lslash", "Ydieresis"}:           -140.000000,
+	{"Lslash", "quotedblright"}:       -140.000000,
+	{"Lslash", "quoteright"}:          -160.000000,
+	{"Lslash", "y"}:                   -30.000000,
+	{"Lslash", "yacute"}:              -30.000000,
+	{"Lslash", "ydieresis"}:           -30.000000,
+	{"O", "A"}:                        -20.000000,
+	{"O", "Aacute"}:                   -20.000000,
+	{"O", "Abreve"}:                   -20.000000,
+	{"O", "Acircumflex"}:              -20.000000,
+	{"O", "Adieresis"}:                -20.000000,
+	{"O", "Agrave"}:                   -20.000000,
+	{"O", "Amacron"}:                  -20.000000,
+	{"O", "Aogonek"}:                  -20.000000,
+	{"O", "Aring"}:                    -20.000000,
+	{"O", "Atilde"}:                   -20.000000,
+	{"O", "T"}:                        -40.000000,
+	{"O", "Tcaron"}:                   -40.000000,
+	{"O", "Tcommaaccent"}:             -40.000000,
+	{"O", "V"}:                        -50.000000,
+	{"O", "W"}:                        -30.000000,
+	{"O", "X"}:                        -60.000000,
+	{"O", "Y"}:                        -70.000000,
+	{"O", "Yacute"}:                   -70.000000,
+	{"O", "Ydieresis"}:                -70.000000,
+	{"O", "comma"}:                    -40.000000,
+	{"O", "period"}:                   -40.000000,
+	{"Oacute", "A"}:                   -20.000000,
+	{"Oacute", "Aacute"}:              -20.000000,
+	{"Oacute", "Abreve"}:              -20.000000,
+	{"Oacute", "Acircumflex"}:         -20.000000,
+	{"Oacute", "Adieresis"}:           -20.000000,
+	{"Oacute", "Agrave"}:              -20.000000,
+	{"Oacute", "Amacron"}:             -20.000000,
+	{"Oacute", "Aogonek"}:             -20.000000,
+	{"Oacute", "Aring"}:               -20.000000,
+	{"Oacute", "Atilde"}:              -20.000000,
+	{"Oacute", "T"}:                   -40.000000,
+	{"Oacute", "Tcaron"}:              -40.000000,
+	{"Oacute", "Tcommaaccent"}:        -40.000000,
+	{"Oacute", "V"}:                   -50.000000,
+	{"Oacute", "W"}:                   -30.000000,
+	{"Oacute", "X"}:                   -60.000000,
+	{"Oacute", "Y"}:                   -70.000000,
+	{"Oacute", "Yacute"}:              -70.000000,
+	{"Oacute", "Ydieresis"}:           -70.000000,
+	{"Oacute", "comma"}:               -40.000000,
+	{"Oacute", "period"}:              -40.000000,
+	{"Ocircumflex", "A"}:              -20.000000,
+	{"Ocircumflex", "Aacute"}:         -20.000000,
+	{"Ocircumflex", "Abreve"}:         -20.000000,
+	{"Ocircumflex", "Acircumflex"}:    -20.000000,
+	{"Ocircumflex", "Adieresis"}:      -20.000000,
+	{"Ocircumflex", "Agrave"}:         -20.000000,
+	{"Ocircumflex", "Amacron"}:        -20.000000,
+	{"Ocircumflex", "Aogonek"}:        -20.000000,
+	{"Ocircumflex", "Aring"}:          -20.000000,
+	{"Ocircumflex", "Atilde"}:         -20.000000,
+	{"Ocircumflex", "T"}:              -40.000000,
+	{"Ocircumflex", "Tcaron"}:         -40.000000,
+	{"Ocircumflex", "Tcommaaccent"}:   -40.000000,
+	{"Ocircumflex", "V"}:              -50.000000,
+	{"Ocircumflex", "W"}:              -30.000000,
+	{"Ocircumflex", "X"}:              -60.000000,
+	{"Ocircumflex", "Y"}:              -70.000000,
+	{"Ocircumflex", "Yacute"}:         -70.000000,
+	{"Ocircumflex", "Ydieresis"}:      -70.000000,
+	{"Ocircumflex", "comma"}:          -40.000000,
+	{"Ocircumflex", "period"}:         -40.000000,
+	{"Odieresis", "A"}:                -20.000000,
+	{"Odieresis", "Aacute"}:           -20.000000,
+	{"Odieresis", "Abreve"}:           -20.000000,
+	{"Odieresis", "Acircumflex"}:      -20.000000,
+	{"Odieresis", "Adieresis"}:        -20.000000,
+	{"Odieresis", "Agrave"}:           -20.000000,
+	{"Odieresis", "Amacron"}:          -20.000000,
+	{"Odieresis", "Aogonek"}:          -20.000000,
+	{"Odieresis", "Aring"}:            -20.000000,
+	{"Odieresis", "Atilde"}:           -20.000000,
+	{"Odieresis", "T"}:                -40.000000,
+	{"Odieresis", "Tcaron"}:           -40.000000,
+	{"Odieresis", "Tcommaaccent"}:     -40.000000,
+	{"Odieresis", "V"}:                -50.000000,
+	{"Odieresis", "W"}:                -30.000000,
+	{"Odieresis", "X"}:                -60.000000,
+	{"Odieresis", "Y"}:                -70.000000,
+	{"Odieresis", "Yacute"}:           -70.000000,
+	{"Odieresis", "Ydieresis"}:        -70.000000,
+	{"Odieresis", "comma"}:            -40.000000,
+	{"Odieresis", "period"}:           -40.000000,
+	{"Ograve", "A"}:                   -20.000000,
+	{"Ograve", "Aacute"}:              -20.000000,
+	{"Ograve", "Abreve"}:              -20.000000,
+	{"Ograve", "Acircumflex"}:         -20.000000,
+	{"Ograve", "Adieresis"}:           -20.000000,
+	{"Ograve", "Agrave"}:              -20.000000,
+	{"Ograve", "Amacron"}:             -20.000000,
+	{"Ograve", "Aogonek"}:             -20.000000,
+	{"Ograve", "Aring"}:               -20.000000,
+	{"Ograve", "Atilde"}:              -20.000000,
+	{"Ograve", "T"}:                   -40.000000,
+	{"Ograve", "Tcaron"}:              -40.000000,
+	{"Ograve", "Tcommaaccent"}:        -40.000000,
+	{"Ograve", "V"}:                   -50.000000,
+	{"Ograve", "W"}:                   -30.000000,
+	{"Ograve", "X"}:                   -60.000000,
+	{"Ograve", "Y"}:                   -70.000000,
+	{"Ograve", "Yacute"}:              -70.000000,
+	{"Ograve", "Ydieresis"}:           -70.000000,
+	{"Ograve", "comma"}:               -40.000000,
+	{"Ograve", "period"}:              -40.000000,
+	{"Ohungarumlaut", "A"}:            -20.000000,
+	{"Ohungarumlaut", "Aacute"}:       -20.000000,
+	{"Ohungarumlaut", "Abreve"}:       -20.000000,
+	{"Ohungarumlaut", "Acircumflex"}:  -20.000000,
+	{"Ohungarumlaut", "Adieresis"}:    -20.000000,
+	{"Ohungarumlaut", "Agrave"}:       -20.000000,
+	{"Ohungarumlaut", "Amacron"}:      -20.000000,
+	{"Ohungarumlaut", "Aogonek"}:      -20.000000,
+	{"Ohungarumlaut", "Aring"}:        -20.000000,
+	{"Ohungarumlaut", "Atilde"}:       -20.000000,
+	{"Ohungarumlaut", "T"}:            -40.000000,
+	{"Ohungarumlaut", "Tcaron"}:       -40.000000,
+	{"Ohungarumlaut", "Tcommaaccent"}: -40.000000,
+	{"Ohungarumlaut", "V"}:            -50.000000,
+	{"Ohungarumlaut", "W"}:            -30.000000,
+	{"Ohungarumlaut", "X"}:            -60.000000,
+	{"Ohungarumlaut", "Y"}:            -70.000000,
+	{"Ohungarumlaut", "Yacute"}:       -70.000000,
+	{"Ohungarumlaut", "Ydieresis"}:    -70.000000,
+	{"Ohungarumlaut", "comma"}:        -40.000000,
+	{"Ohungarumlaut", "period"}:       -40.000000,
+	{"Omacron", "A"}:                  -20.000000,
+	{"Omacron", "Aacute"}:             -20.000000,
+	{"Omacron", "Abreve"}:             -20.000000,
+	{"Omacron", "Acircumflex"}:        -20.000000,
+	{"Omacron", "Adieresis"}:          -20.000000,
+	{"Omacron", "Agrave"}:             -20.000000,
+	{"Omacron", "Amacron"}:            -20.000000,
+	{"Omacron", "Aogonek"}:            -20.000000,
+	{"Omacron", "Aring"}:              -20.000000,
+	{"Omacron", "Atilde"}:             -20.000000,
+	{"Omacron", "T"}:                  -40.000000,
+	{"Omacron", "Tcaron"}:             -40.000000,
+	{"Omacron", "Tcommaaccent"}:       -40.000000,
+	{"Omacron", "V"}:                  -50.000000,
+	{"Omacron", "W"}:                  -30.000000,
+	{"Omacron", "X"}:                  -60.000000,
+	{"Omacron", "Y"}:                  -70.000000,
+	{"Omacron", "Yacute"}:             -70.000000,
+	{"Omacron", "Ydieresis"}:          -70.000000,
+	{"Omacron", "comma"}:              -40.000000,
+	{"Omacron", "period"}:             -40.000000,
+	{"Oslash", "A"}:                   -20.000000,
+	{"Oslash", "Aacute"}:              -20.000000,
+	{"Oslash", "Abreve"}:              -20.000000,
+	{"Oslash", "Acircumflex"}:         -20.000000,
+	{"Oslash", "Adieresis"}:           -20.000000,
+	{"Oslash", "Agrave"}:              -20.000000,
+	{"Oslash", "Amacron"}:             -20.000000,
+	{"Oslash", "Aogonek"}:             -20.000000,
+	{"Oslash", "Aring"}:               -20.000000,
+	{"Oslash", "Atilde"}:              -20.000000,
+	{"Oslash", "T"}:                   -40.000000,
+	{"Oslash", "Tcaron"}:              -40.000000,
+	{"Oslash", "Tcommaaccent"}:        -40.000000,
+	{"Oslash", "V"}:                   -50.000000,
+	{"Oslash", "W"}:                   -30.000000,
+	{"Oslash", "X"}:                   -60.000000,
+	{"Oslash", "Y"}:                   -70.000000,
+	{"Oslash", "Yacute"}:              -70.000000,
+	{"Oslash", "Ydieresis"}:           -70.000000,
+	{"Oslash", "comma"}:               -40.000000,
+	{"Oslash", "period"}:              -40.000000,
+	{"Otilde", "A"}:                   -20.000000,
+	{"Otilde", "Aacute"}:              -20.000000,
+	{"Otilde", "Abreve"}:              -20.000000,
+	{"Otilde", "Acircumflex"}:         -20.000000,
+	{"Otilde", "Adieresis"}:           -20.000000,
+	{"Otilde", "Agrave"}:              -20.000000,
+	{"Otilde", "Amacron"}:             -20.000000,
+	{"Otilde", "Aogonek"}:             -20.000000,
+	{"Otilde", "Aring"}:               -20.000000,
+	{"Otilde", "Atilde"}:              -20.000000,
+	{"Otilde", "T"}:                   -40.000000,
+	{"Otilde", "Tcaron"}:              -40.000000,
+	{"Otilde", "Tcommaaccent"}:        -40.000000,
+	{"Otilde", "V"}:                   -50.000000,
+	{"Otilde", "W"}:                   -30.000000,
+	{"Otilde", "X"}:                   -60.000000,
+	{"Otilde", "Y"}:                   -70.000000,
+	{"Otilde", "Yacute"}:              -70.000000,
+	{"Otilde", "Ydieresis"}:           -70.000000,
+	{"Otilde", "comma"}:               -40.000000,
+	{"Otilde", "period"}:              -40.000000,
+	{"P", "A"}:                        -120.000000,
+	{"P", "Aacute"}:                   -120.000000,
+	{"P", "Abreve"}:                   -120.000000,
+	{"P", "Acircumflex"}:              -120.000000,
+	{"P", "Adieresis"}:                -120.000000,
+	{"P", "Agrave"}:                   -120.000000,
+	{"P", "Amacron"}:                  -120.000000,
+	{"P", "Aogonek"}:                  -120.000000,
+	{"P", "Aring"}:                    -120.000000,
+	{"P", "Atilde"}:                   -120.000000,
+	{"P", "a"}:                        -40.000000,
+	{"P", "aacute"}:                   -40.000000,
+	{"P", "abreve"}:                   -40.000000,
+	{"P", "acircumflex"}:              -40.000000,
+	{"P", "adieresis"}:                -40.000000,
+	{"P", "agrave"}:                   -40.000000,
+	{"P", "amacron"}:                  -40.000000,
+	{"P", "aogonek"}:                  -40.000000,
+	{"P", "aring"}:                    -40.000000,
+	{"P", "atilde"}:                   -40.000000,
+	{"P", "comma"}:                    -180.000000,
+	{"P", "e"}:                        -50.000000,
+	{"P", "eacute"}:                   -50.000000,
+	{"P", "ecaron"}:                   -50.000000,
+	{"P", "ecircumflex"}:              -50.000000,
+	{"P", "edieresis"}:                -50.000000,
+	{"P", "edotaccent"}:               -50.000000,
+	{"P", "egrave"}:                   -50.000000,
+	{"P", "emacron"}:                  -50.000000,
+	{"P", "eogonek"}:                  -50.000000,
+	{"P", "o"}:                        -50.000000,
+	{"P", "oacute"}:                   -50.000000,
+	{"P", "ocircumflex"}:              -50.000000,
+	{"P", "odieresis"}:                -50.000000,
+	{"P", "ograve"}:                   -50.000000,
+	{"P", "ohungarumlaut"}:            -50.000000,
+	{"P", "omacron"}:                  -50.000000,
+	{"P", "oslash"}:                   -50.000000,
+	{"P", "otilde"}:                   -50.000000,
+	{"P", "period"}:                   -180.000000,
+	{"Q", "U"}:                        -10.000000,
+	{"Q", "Uacute"}:                   -10.000000,
+	{"Q", "Ucircumflex"}:              -10.000000,
+	{"Q", "Udieresis"}:                -10.000000,
+	{"Q", "Ugrave"}:                   -10.000000,
+	{"Q", "Uhungarumlaut"}:            -10.000000,
+	{"Q", "Umacron"}:                  -10.000000,
+	{"Q", "Uogonek"}:                  -10.000000,
+	{"Q", "Uring"}:                    -10.000000,
+	{"R", "O"}:                        -20.000000,
+	{"R", "Oacute"}:                   -20.000000,
+	{"R", "Ocircumflex"}:              -20.000000,
+	{"R", "Odieresis"}:                -20.000000,
+	{"R", "Ograve"}:                   -20.000000,
+	{"R", "Ohungarumlaut"}:            -20.000000,
+	{"R", "Omacron"}:                  -20.000000,
+	{"R", "Oslash"}:                   -20.000000,
+	{"R", "Otilde"}:                   -20.000000,
+	{"R", "T"}:                        -30.000000,
+	{"R", "Tcaron"}:                   -30.000000,
+	{"R", "Tcommaaccent"}:             -30.000000,
+	{"R", "U"}:                        -40.000000,
+	{"R", "Uacute"}:                   -40.000000,
+	{"R", "Ucircumflex"}:              -40.000000,
+	{"R", "Udieresis"}:                -40.000000,
+	{"R", "Ugrave"}:                   -40.000000,
+	{"R", "Uhungarumlaut"}:            -40.000000,
+	{"R", "Umacron"}:                  -40.000000,
+	{"R", "Uogonek"}:                  -40.000000,
+	{"R", "Uring"}:                    -40.000000,
+	{"R", "V"}:                        -50.000000,
+	{"R", "W"}:                        -30.000000,
+	{"R", "Y"}:                        -50.000000,
+	{"R", "Yacute"}:                   -50.000000,
+	{"R", "Ydieresis"}:                -50.000000,
+	{"Racute", "O"}:                   -20.000000,
+	{"Racute", "Oacute"}:              -20.000000,
+	{"Racute", "Ocircumflex"}:         -20.000000,
+	{"Racute", "Odieresis"}:           -20.000000,
+	{"Racute", "Ograve"}:              -20.000000,
+	{"Racute", "Ohungarumlaut"}:       -20.000000,
+	{"Racute", "Omacron"}:             -20.000000,
+	{"Racute", "Oslash"}:              -20.000000,
+	{"Racute", "Otilde"}:              -20.000000,
+	{"Racute", "T"}:                   -30.000000,
+	{"Racute", "Tcaron"}:              -30.000000,
+	{"Racute", "Tcommaaccent"}:        -30.000000,
+	{"Racute", "U"}:                   -40.000000,
+	{"Racute", "Uacute"}:              -40.000000,
+	{"Racute", "Ucircumflex"}:         -40.000000,
+	{"Racute", "Udieresis"}:           -40.000000,
+	{"Racute", "Ugrave"}:              -40.000000,
+	{"Racute", "Uhungarumlaut"}:       -40.000000,
+	{"Racute", "Umacron"}:             -40.000000,
+	{"Racute", "Uogonek"}:             -40.000000,
+	{"Racute", "Uring"}:               -40.000000,
+	{"Racute", "V"}:                   -50.000000,
+	{"Racute", "W"}:                   -30.000000,
+	{"Racute", "Y"}:                   -50.000000,
+	{"Racute", "Yacute"}:              -50.000000,
+	{"Racute", "Ydieresis"}:           -50.000000,
+	{"Rcaron", "O"}:                   -20.000000,
+	{"Rcaron", "Oacute"}:              -20.000000,
+	{"Rcaron", "Ocircumflex"}:         -20.000000,
+	{"Rcaron", "Odieresis"}:           -20.000000,
+	{"Rcaron", "Ograve"}:              -20.000000,
+	{"Rcaron", "Ohungarumlaut"}:       -20.000000,
+	{"Rcaron", "Omacron"}:             -20.000000,
+	{"Rcaron", "Oslash"}:              -20.000000,
+	{"Rcaron", "Otilde"}:              -20.000000,
+	{"Rcaron", "T"}:                   -30.000000,
+	{"Rcaron", "Tcaron"}:              -30.000000,
+	{"Rcaron", "Tcommaaccent"}:        -30.000000,
+	{"Rcaron", "U"}:                   -40.000000,
+	{"Rcaron", "Uacute"}:              -40.000000,
+	{"Rcaron", "Ucircumflex"}:         -40.000000,
+	{"Rcaron", "Udieresis"}:           -40.000000,
+	{"Rcaron", "Ugrave"}:              -40.000000,
+	{"Rcaron", "Uhungarumlaut"}:       -40.000000,
+	{"Rcaron", "Umacron"}:             -40.000000,
+	{"Rcaron", "Uogonek"}:             -40.000000,
+	{"Rcaron", "Uring"}:               -40.000000,
+	{"Rcaron", "V"}:                   -50.000000,
+	{"Rcaron", "W"}:                   -30.000000,
+	{"Rcaron", "Y"}:                   -50.000000,
+	{"Rcaron", "Yacute"}:              -50.000000,
+	{"Rcaron", "Ydieresis"}:           -50.000000,
+	{"Rcommaaccent", "O"}:             -20.000000,
+	{"Rcommaaccent", "Oacute"}:        -20.000000,
+	{"Rcommaaccent", "Ocircumflex"}:   -20.000000,
+	{"Rcommaaccent", "Odieresis"}:     -20.000000,
+	{"Rcommaaccent", "Ograve"}:        -20.000000,
+	{"Rcommaaccent", "Ohungarumlaut"}: -20.000000,
+	{"Rcommaaccent", "Omacron"}:       -20.000000,
+	{"Rcommaaccent", "Oslash"}:        -20.000000,
+	{"Rcommaaccent", "Otilde"}:        -20.000000,
+	{"Rcommaaccent", "T"}:             -30.000000,
+	{"Rcommaaccent", "Tcaron"}:        -30.000000,
+	{"Rcommaaccent", "Tcommaaccent"}:  -30.000000,
+	{"Rcommaaccent", "U"}:             -40.000000,
+	{"Rcommaaccent", "Uacute"}:        -40.000000,
+	{"Rcommaaccent", "Ucircumflex"}:   -40.000000,
+	{"Rcommaaccent", "Udieresis"}:     -40.000000,
+	{"Rcommaaccent", "Ugrave"}:        -40.000000,
+	{"Rcommaaccent", "Uhungarumlaut"}: -40.000000,
+	{"Rcommaaccent", "Umacron"}:       -40.000000,
+	{"Rcommaaccent", "Uogonek"}:       -40.000000,
+	{"Rcommaaccent", "Uring"}:         -40.000000,
+	{"Rcommaaccent", "V"}:             -50.000000,
+	{"Rcommaaccent", "W"}:             -30.000000,
+	{"Rcommaaccent", "Y"}:             -50.000000,
+	{"Rcommaaccent", "Yacute"}:        -50.000000,
+	{"Rcommaaccent", "Ydieresis"}:     -50.000000,
+	{"S", "comma"}:                    -20.000000,
+	{"S", "period"}:                   -20.000000,
+	{"Sacute", "comma"}:               -20.000000,
+	{"Sacute", "period"}:              -20.000000,
+	{"Scaron", "comma"}:               -20.000000,
+	{"Scaron", "period"}:              -20.000000,
+	{"Scedilla", "comma"}:             -20.000000,
+	{"Scedilla", "period"}:            -20.000000,
+	{"Scommaaccent", "comma"}:         -20.000000,
+	{"Scommaaccent", "period"}:        -20.000000,
+	{"T", "A"}:                        -120.000000,
+	{"T", "Aacute"}:                   -120.000000,
+	{"T", "Abreve"}:                   -120.000000,
+	{"T", "Acircumflex"}:              -120.000000,
+	{"T", "Adieresis"}:                -120.000000,
+	{"T", "Agrave"}:                   -120.000000,
+	{"T", "Amacron"}:                  -120.000000,
+	{"T", "Aogonek"}:                  -120.000000,
+	{"T", "Aring"}:                    -120.000000,
+	{"T", "Atilde"}:                   -120.000000,
+	{"T", "O"}:                        -40.000000,
+	{"T", "Oacute"}:                   -40.000000,
+	{"T", "Ocircumflex"}:              -40.000000,
+	{"T", "Odieresis"}:                -40.000000,
+	{"T", "Ograve"}:                   -40.000000,
+	{"T", "Ohungarumlaut"}:            -40.000000,
+	{"T", "Omacron"}:                  -40.000000,
+	{"T", "Oslash"}:                   -40.000000,
+	{"T", "Otilde"}:                   -40.000000,
+	{"T", "a"}:                        -120.000000,
+	{"T", "aacute"}:                   -120.000000,
+	{"T", "abreve"}:                   -60.000000,
+	{"T", "acircumflex"}:              -120.000000,
+	{"T", "adieresis"}:                -120.000000,
+	{"T", "agrave"}:                   -120.000000,
+	{"T", "amacron"}:                  -60.000000,
+	{"T", "aogonek"}:                  -120.000000,
+	{"T", "aring"}:                    -120.000000,
+	{"T", "atilde"}:                   -60.000000,
+	{"T", "colon"}:                    -20.000000,
+	{"T", "comma"}:                    -120.000000,
+	{"T", "e"}:                        -120.000000,
+	{"T", "eacute"}:                   -120.000000,
+	{"T", "ecaron"}:                   -120.000000,
+	{"T", "ecircumflex"}:              -120.000000,
+	{"T", "edieresis"}:                -120.000000,
+	{"T", "edotaccent"}:               -120.000000,
+	{"T", "egrave"}:                   -60.000000,
+	{"T", "emacron"}:                  -60.000000,
+	{"T", "eogonek"}:                  -120.000000,
+	{"T", "hyphen"}:                   -140.000000,
+	{"T", "o"}:                        -120.000000,
+	{"T", "oacute"}:                   -120.000000,
+	{"T", "ocircumflex"}:              -120.000000,
+	{"T", "odieresis"}:                -120.000000,
+	{"T", "ograve"}:                   -120.000000,
+	{"T", "ohungarumlaut"}:            -120.000000,
+	{"T", "omacron"}:                  -60.000000,
+	{"T", "oslash"}:                   -120.000000,
+	{"T", "otilde"}:                   -60.000000,
+	{"T", "period"}:                   -120.000000,
+	{"T", "r"}:                        -120.000000,
+	{"T", "racute"}:                   -120.000000,
+	{"T", "rcaron"}:                   -120.000000,
+	{"T", "rcommaaccent"}:             -120.000000,
+	{"T", "semicolon"}:                -20.000000,
+	{"T", "u"}:                        -120.000000,
+	{"T", "uacute"}:                   -120.000000,
+	{"T", "ucircumflex"}:              -120.000000,
+	{"T", "udieresis"}:                -120.000000,
+	{"T", "ugrave"}:                   -120.000000,
+	{"T", "uhungarumlaut"}:            -120.000000,
+	{"T", "umacron"}:                  -60.000000,
+	{"T", "uogonek"}:                  -120.000000,
+	{"T", "uring"}:                    -120.000000,
+	{"T", "w"}:                        -120.000000,
+	{"T", "y"}:                        -120.000000,
+	{"T", "yacute"}:                   -120.000000,
+	{"T", "ydieresis"}:                -60.000000,
+	{"Tcaron", "A"}:                   -120.000000,
+	{"Tcaron", "Aacute"}:              -120.000000,
+	{"Tcaron", "Abreve"}:              -120.000000,
+	{"Tcaron", "Acircumflex"}:         -120.000000,
+	{"Tcaron", "Adieresis"}:           -120.000000,
+	{"Tcaron", "Agrave"}:              -120.000000,
+	{"Tcaron", "Amacron"}:             -120.000000,
+	{"Tcaron", "Aogonek"}:             -120.000000,
+	{"Tcaron", "Aring"}:               -120.000000,
+	{"Tcaron", "Atilde"}:              -120.000000,
+	{"Tcaron", "O"}:                   -40.000000,
+	{"Tcaron", "Oacute"}:              -40.000000,
+	{"Tcaron", "Ocircumflex"}:         -40.000000,
+	{"Tcaron", "Odieresis"}:           -40.000000,
+	{"Tcaron", "Ograve"}:              -40.000000,
+	{"Tcaron", "Ohungarumlaut"}:       -40.000000,
+	{"Tcaron", "Omacron"}:             -40.000000,
+	{"Tcaron", "Oslash"}:              -40.000000,
+	{"Tcaron", "Otilde"}:              -40.000000,
+	{"Tcaron", "a"}:                   -120.000000,
+	{"Tcaron", "aacute"}:              -120.000000,
+	{"Tcaron", "abreve"}:              -60.000000,
+	{"Tcaron", "acircumflex"}:         -120.000000,
+	{"Tcaron", "adieresis"}:           -120.000000,
+	{"Tcaron", "agrave"}:              -120.000000,
+	{"Tcaron", "amacron"}:             -60.000000,
+	{"Tcaron", "aogonek"}:             -120.000000,
+	{"Tcaron", "aring"}:               -120.000000,
+	{"Tcaron", "atilde"}:              -60.000000,
+	{"Tcaron", "colon"}:               -20.000000,
+	{"Tcaron", "comma"}:               -120.000000,
+	{"Tcaron", "e"}:                   -120.000000,
+	{"Tcaron", "eacute"}:              -120.000000,
+	{"Tcaron", "ecaron"}:              -120.000000,
+	{"Tcaron", "ecircumflex"}:         -120.000000,
+	{"Tcaron", "edieresis"}:           -120.000000,
+	{"Tcaron", "edotaccent"}:          -120.000000,
+	{"Tcaron", "egrave"}:              -60.000000,
+	{"Tcaron", "emacron"}:             -60.000000,
+	{"Tcaron", "eogonek"}:             -120.000000,
+	{"Tcaron", "hyphen"}:              -140.000000,
+	{"Tcaron", "o"}:                   -120.000000,
+	{"Tcaron", "oacute"}:              -120.000000,
+	{"Tcaron", "ocircumflex"}:         -120.000000,
+	{"Tcaron", "odieresis"}:           -120.000000,
+	{"Tcaron", "ograve"}:              -120.000000,
+	{"Tcaron", "ohungarumlaut"}:       -120.000000,
+	{"Tcaron", "omacron"}:             -60.000000,
+	{"Tcaron", "oslash"}:              -120.000000,
+	{"Tcaron", "otilde"}:              -60.000000,
+	{"Tcaron", "period"}:              -120.000000,
+	{"Tcaron", "r"}:                   -120.000000,
+	{"Tcaron", "racute"}:              -120.000000,
+	{"Tcaron", "rcaron"}:              -120.000000,
+	{"Tcaron", "rcommaaccent"}:        -120.000000,
+	{"Tcaron", "semicolon"}:           -20.000000,
+	{"Tcaron", "u"}:                   -120.000000,
+	{"Tcaron", "uacute"}:              -120.000000,
+	{"Tcaron", "ucircumflex"}:         -120.000000,
+	{"Tcaron", "udieresis"}:           -120.000000,
+	{"Tcaron", "ugrave"}:              -120.000000,
+	{"Tcaron", "uhungarumlaut"}:       -120.000000,
+	{"Tcaron", "umacron"}:             -60.000000,
+	{"Tcaron", "uogonek"}:             -120.000000,
+	{"Tcaron", "uring"}:               -120.000000,
+	{"Tcaron", "w"}:                   -120.000000,
+	{"Tcaron", "y"}:                   -120.000000,
+	{"Tcaron", "yacute"}:              -120.000000,
+	{"Tcaron", "ydieresis"}:           -60.000000,
+	{"Tcommaaccent", "A"}:             -120.000000,
+	{"Tcommaaccent", "Aacute"}:        -120.000000,
+	{"Tcommaaccent", "Abreve"}:        -120.000000,
+	{"Tcommaaccent", "Acircumflex"}:   -120.000000,
+	{"Tcommaaccent", "Adieresis"}:     -120.000000,
+	{"Tcommaaccent", "Agrave"}:        -120.000000,
+	{"Tcommaaccent", "Amacron"}:       -120.000000,
+	{"Tcommaaccent", "Aogonek"}:       -120.000000,
+	{"Tcommaaccent", "Aring"}:         -120.000000,
+	{"Tcommaaccent", "Atilde"}:        -120.000000,
+	{"Tcommaaccent", "O"}:             -40.000000,
+	{"Tcommaaccent", "Oacute"}:        -40.000000,
+	{"Tcommaaccent", "Ocircumflex"}:   -40.000000,
+	{"Tcommaaccent", "Odieresis"}:     -40.000000,
+	{"Tcommaaccent", "Ograve"}:        -40.000000,
+	{"Tcommaaccent", "Ohungarumlaut"}: -40.000000,
+	{"Tcommaaccent", "Omacron"}:       -40.000000,
+	{"Tcommaaccent", "Oslash"}:        -40.000000,
+	{"Tcommaaccent", "Otilde"}:        -40.000000,
+	{"Tcommaaccent", "a"}:             -120.000000,
+	{"Tcommaaccent", "aacute"}:        -120.000000,
+	{"Tcommaaccent", "abreve"}:        -60.000000,
+	{"Tcommaaccent", "acircumflex"}:   -120.000000,
+	{"Tcommaaccent", "adieresis"}:     -120.000000,
+	{"Tcommaaccent", "agrave"}:        -120.000000,
+	{"Tcommaaccent", "amacron"}:       -60.000000,
+	{"Tcommaaccent", "aogonek"}:       -120.000000,
+	{"Tcommaaccent", "aring"}:         -120.000000,
+	{"Tcommaaccent", "atilde"}:        -60.000000,
+	{"Tcommaaccent", "colon"}:         -20.000000,
+	{"Tcommaaccent", "comma"}:         -120.000000,
+	{"Tcommaaccent", "e"}:             -120.000000,
+	{"Tcommaaccent", "eacute"}:        -120.000000,
+	{"Tcommaaccent", "ecaron"}:        -120.000000,
+	{"Tcommaaccent", "ecircumflex"}:   -120.000000,
+	{"Tcommaaccent", "edieresis"}:     -120.000000,
+	{"Tcommaaccent", "edotaccent"}:    -120.000000,
+	{"Tcommaaccent", "egrave"}:        -60.000000,
+	{"Tcommaaccent", "emacron"}:       -60.000000,
+	{"Tcommaaccent", "eogonek"}:       -120.000000,
+	{"Tcommaaccent", "hyphen"}:        -140.000000,
+	{"Tcommaaccent", "o"}:             -120.000000,
+	{"Tcommaaccent", "oacute"}:        -120.000000,
+	{"Tcommaaccent", "ocircumflex"}:   -120.000000,
+	{"Tcommaaccent", "odieresis"}:     -120.000000,
+	{"Tcommaaccent", "ograve"}:        -120.000000,
+	{"Tcommaaccent", "ohungarumlaut"}: -120.000000,
+	{"Tcommaaccent", "omacron"}:       -60.000000,
+	{"Tcommaaccent", "oslash"}:        -120.000000,
+	{"Tcommaaccent", "otilde"}:        -60.000000,
+	{"Tcommaaccent", "period"}:        -120.000000,
+	{"Tcommaaccent", "r"}:             -120.000000,
+	{"Tcommaaccent", "racute"}:        -120.000000,
+	{"Tcommaaccent", "rcaron"}:        -120.000000,
+	{"Tcommaaccent", "rcommaaccent"}:  -120.000000,
+	{"Tcommaaccent", "semicolon"}:     -20.000000,
+	{"Tcommaaccent", "u"}:             -120.000000,
+	{"Tcommaaccent", "uacute"}:        -120.000000,
+	{"Tcommaaccent", "ucircumflex"}:   -120.000000,
+	{"Tcommaaccent", "udieresis"}:     -120.000000,
+	{"Tcommaaccent", "ugrave"}:        -120.000000,
+	{"Tcommaaccent", "uhungarumlaut"}: -120.000000,
+	{"Tcommaaccent", "umacron"}:       -60.000000,
+	{"Tcommaaccent", "uogonek"}:       -120.000000,
+	{"Tcommaaccent", "uring"}:         -120.000000,
+	{"Tcommaaccent", "w"}:             -120.000000,
+	{"Tcommaaccent", "y"}:             -120.000000,
+	{"Tcommaaccent", "yacute"}:        -120.000000,
+	{"Tcommaaccent", "ydieresis"}:     -60.000000,
+	{"U", "A"}:                        -40.000000,
+	{"U", "Aacute"}:                   -40.000000,
+	{"U", "Abreve"}:                   -40.000000,
+	{"U", "Acircumflex"}:              -40.000000,
+	{"U", "Adieresis"}:                -40.000000,
+	{"U", "Agrave"}:                   -40.000000,
+	{"U", "Amacron"}:                  -40.000000,
+	{"U", "Aogonek"}:                  -40.000000,
+	{"U", "Aring"}:                    -40.000000,
+	{"U", "Atilde"}:                   -40.000000,
+	{"U", "comma"}:                    -40.000000,
+	{"U", "period"}:                   -40.000000,
+	{"Uacute", "A"}:                   -40.000000,
+	{"Uacute", "Aacute"}:              -40.000000,
+	{"Uacute", "Abreve"}:              -40.000000,
+	{"Uacute", "Acircumflex"}:         -40.000000,
+	{"Uacute", "Adieresis"}:           -40.000000,
+	{"Uacute", "Agrave"}:              -40.000000,
+	{"Uacute", "Amacron"}:             -40.000000,
+	{"Uacute", "Aogonek"}:             -40.000000,
+	{"Uacute", "Aring"}:               -40.000000,
+	{"Uacute", "Atilde"}:              -40.000000,
+	{"Uacute", "comma"}:               -40.000000,
+	{"Uacute", "period"}:              -40.000000,
+	{"Ucircumflex", "A"}:              -40.000000,
+	{"Ucircumflex", "Aacute"}:         -40.000000,
+	{"Ucircumflex", "Abreve"}:         -40.000000,
+	{"Ucircumflex", "Acircumflex"}:    -40.000000,
+	{"Ucircumflex", "Adieresis"}:      -40.000000,
+	{"Ucircumflex", "Agrave"}:         -40.000000,
+	{"Ucircumflex", "Amacron"}:        -40.000000,
+	{"Ucircumflex", "Aogonek"}:        -40.000000,
+	{"Ucircumflex", "Aring"}:          -40.000000,
+	{"Ucircumflex", "Atilde"}:         -40.000000,
+	{"Ucircumflex", "comma"}:          -40.000000,
+	{"Ucircumflex", "period"}:         -40.000000,
+	{"Udieresis", "A"}:                -40.000000,
+	{"Udieresis", "Aacute"}:           -40.000000,
+	{"Udieresis", "Abreve"}:           -40.000000,
+	{"Udieresis", "Acircumflex"}:      -40.000000,
+	{"Udieresis", "Adieresis"}:        -40.000000,
+	{"Udieresis", "Agrave"}:           -40.000000,
+	{"Udieresis", "Amacron"}:          -40.000000,
+	{"Udieresis", "Aogonek"}:          -40.000000,
+	{"Udieresis", "Aring"}:            -40.000000,
+	{"Udieresis", "Atilde"}:           -40.000000,
+	{"Udieresis", "comma"}:            -40.000000,
+	{"Udieresis", "period"}:           -40.000000,
+	{"Ugrave", "A"}:                   -40.000000,
+	{"Ugrave", "Aacute"}:              -40.000000,
+	{"Ugrave", "Abreve"}:              -40.000000,
+	{"Ugrave", "Acircumflex"}:         -40.000000,
+	{"Ugrave", "Adieresis"}:           -40.000000,
+	{"Ugrave", "Agrave"}:              -40.000000,
+	{"Ugrave", "Amacron"}:             -40.000000,
+	{"Ugrave", "Aogonek"}:             -40.000000,
+	{"Ugrave", "Aring"}:               -40.000000,
+	{"Ugrave", "Atilde"}:              -40.000000,
+	{"Ugrave", "comma"}:               -40.000000,
+	{"Ugrave", "period"}:              -40.000000,
+	{"Uhungarumlaut", "A"}:            -40.000000,
+	{"Uhungarumlaut", "Aacute"}:       -40.000000,
+	{"Uhungarumlaut", "Abreve"}:       -40.000000,
+	{"Uhungarumlaut", "Acircumflex"}:  -40.000000,
+	{"Uhungarumlaut", "Adieresis"}:    -40.000000,
+	{"Uhungarumlaut", "Agrave"}:       -40.000000,
+	{"Uhungarumlaut", "Amacron"}:      -40.000000,
+	{"Uhungarumlaut", "Aogonek"}:      -40.000000,
+	{"Uhungarumlaut", "Aring"}:        -40.000000,
+	{"Uhungarumlaut", "Atilde"}:       -40.000000,
+	{"Uhungarumlaut", "comma"}:        -40.000000,
+	{"Uhungarumlaut", "period"}:       -40.000000,
+	{"Umacron", "A"}:                  -40.000000,
+	{"Umacron", "Aacute"}:             -40.000000,
+	{"Umacron", "Abreve"}:             -40.000000,
+	{"Umacron", "Acircumflex"}:        -40.000000,
+	{"Umacron", "Adieresis"}:          -40.000000,
+	{"Umacron", "Agrave"}:             -40.000000,
+	{"Umacron", "Amacron"}:            -40.000000,
+	{"Umacron", "Aogonek"}:            -40.000000,
+	{"Umacron", "Aring"}:              -40.000000,
+	{"Umacron", "Atilde"}:             -40.000000,
+	{"Umacron", "comma"}:              -40.000000,
+	{"Umacron", "period"}:             -40.000000,
+	{"Uogonek", "A"}:                  -40.000000,
+	{"Uogonek", "Aacute"}:             -40.000000,
+	{"Uogonek", "Abreve"}:             -40.000000,
+	{"Uogonek", "Acircumflex"}:        -40.000000,
+	{"Uogonek", "Adieresis"}:          -40.000000,
+	{"Uogonek", "Agrave"}:             -40.000000,
+	{"Uogonek", "Amacron"}:            -40.000000,
+	{"Uogonek", "Aogonek"}:            -40.000000,
+	{"Uogonek", "Aring"}:              -40.000000,
+	{"Uogonek", "Atilde"}:             -40.000000,
+	{"Uogonek", "comma"}:              -40.000000,
+	{"Uogonek", "period"}:             -40.000000,
+	{"Uring", "A"}:                    -40.000000,
+	{"Uring", "Aacute"}:               -40.000000,
+	{"Uring", "Abreve"}:               -40.000000,
+	{"Uring", "Acircumflex"}:          -40.000000,
+	{"Uring", "Adieresis"}:            -40.000000,
+	{"Uring", "Agrave"}:               -40.000000,
+	{"Uring", "Amacron"}:              -40.000000,
+	{"Uring", "Aogonek"}:              -40.000000,
+	{"Uring", "Aring"}:                -40.000000,
+	{"Uring", "Atilde"}:               -40.000000,
+	{"Uring", "comma"}:                -40.000000,
+	{"Uring", "period"}:               -40.000000,
+	{"V", "A"}:                        -80.000000,
+	{"V", "Aacute"}:                   -80.000000,
+	{"V", "Abreve"}:                   -80.000000,
+	{"V", "Acircumflex"}:              -80.000000,
+	{"V", "Adieresis"}:                -80.000000,
+	{"V", "Agrave"}:                   -80.000000,
+	{"V", "Amacron"}:                  -80.000000,
+	{"V", "Aogonek"}:                  -80.000000,
+	{"V", "Aring"}:                    -80.000000,
+	{"V", "Atilde"}:                   -80.000000,
+	{"V", "G"}:                        -40.000000,
+	{"V", "Gbreve"}:                   -40.000000,
+	{"V", "Gcommaaccent"}:             -40.000000,
+	{"V", "O"}:                        -40.000000,
+	{"V", "Oacute"}:                   -40.000000,
+	{"V", "Ocircumflex"}:              -40.000000,
+	{"V", "Odieresis"}:                -40.000000,
+	{"V", "Ograve"}:                   -40.000000,
+	{"V", "Ohungarumlaut"}:            -40.000000,
+	{"V", "Omacron"}:                  -40.000000,
+	{"V", "Oslash"}:                   -40.000000,
+	{"V", "Otilde"}:                   -40.000000,
+	{"V", "a"}:                        -70.000000,
+	{"V", "aacute"}:                   -70.000000,
+	{"V", "abreve"}:                   -70.000000,
+	{"V", "acircumflex"}:              -70.000000,
+	{"V", "adieresis"}:                -70.000000,
+	{"V", "agrave"}:                   -70.000000,
+	{"V", "amacron"}:                  -70.000000,
+	{"V", "aogonek"}:                  -70.000000,
+	{"V", "aring"}:                    -70.000000,
+	{"V", "atilde"}:                   -70.000000,
+	{"V", "colon"}:                    -40.000000,
+	{"V", "comma"}:                    -125.000000,
+	{"V", "e"}:                        -80.000000,
+	{"V", "eacute"}:                   -80.000000,
+	{"V", "ecaron"}:                   -80.000000,
+	{"V", "ecircumflex"}:              -80.000000,
+	{"V", "edieresis"}:                -80.000000,
+	{"V", "edotaccent"}:               -80.000000,
+	{"V", "egrave"}:                   -80.000000,
+	{"V", "emacron"}:                  -80.000000,
+	{"V", "eogonek"}:                  -80.000000,
+	{"V", "hyphen"}:                   -80.000000,
+	{"V", "o"}:                        -80.000000,
+	{"V", "oacute"}:                   -80.000000,
+	{"V", "ocircumflex"}:              -80.000000,
+	{"V", "odieresis"}:                -80.000000,
+	{"V", "ograve"}:                   -80.000000,
+	{"V", "ohungarumlaut"}:            -80.000000,
+	{"V", "omacron"}:                  -80.000000,
+	{"V", "oslash"}:                   -80.000000,
+	{"V", "otilde"}:                   -80.000000,
+	{"V", "period"}:                   -125.000000,
+	{"V", "semicolon"}:                -40.000000,
+	{"V", "u"}:                        -70.000000,
+	{"V", "uacute"}:                   -70.000000,
+	{"V", "ucircumflex"}:              -70.000000,
+	{"V", "udieresis"}:                -70.000000,
+	{"V", "ugrave"}:                   -70.000000,
+	{"V", "uhungarumlaut"}:            -70.000000,
+	{"V", "umacron"}:                  -70.000000,
+	{"V", "uogonek"}:                  -70.000000,
+	{"V", "uring"}:                    -70.000000,
+	{"W", "A"}:                        -50.000000,
+	{"W", "Aacute"}:                   -50.000000,
+	{"W", "Abreve"}:                   -50.000000,
+	{"W", "Acircumflex"}:              -50.000000,
+	{"W", "Adieresis"}:                -50.000000,
+	{"W", "Agrave"}:                   -50.000000,
+	{"W", "Amacron"}:                  -50.000000,
+	{"W", "Aogonek"}:                  -50.000000,
+	{"W", "Aring"}:                    -50.000000,
+	{"W", "Atilde"}:                   -50.000000,
+	{"W", "O"}:                        -20.000000,
+	{"W", "Oacute"}:                   -20.000000,
+	{"W", "Ocircumflex"}:              -20.000000,
+	{"W", "Odieresis"}:                -20.000000,
+	{"W", "Ograve"}:                   -20.000000,
+	{"W", "Ohungarumlaut"}:            -20.000000,
+	{"W", "Omacron"}:                  -20.000000,
+	{"W", "Oslash"}:                   -20.000000,
+	{"W", "Otilde"}:                   -20.000000,
+	{"W", "a"}:                        -40.000000,
+	{"W", "aacute"}:                   -40.000000,
+	{"W", "abreve"}:                   -40.000000,
+	{"W", "acircumflex"}:              -40.000000,
+	{"W", "adieresis"}:                -40.000000,
+	{"W", "agrave"}:                   -40.000000,
+	{"W", "amacron"}:                  -40.000000,
+	{"W", "aogonek"}:                  -40.000000,
+	{"W", "aring"}:                    -40.000000,
+	{"W", "atilde"}:                   -40.000000,
+	{"W", "comma"}:                    -80.000000,
+	{"W", "e"}:                        -30.000000,
+	{"W", "eacute"}:                   -30.000000,
+	{"W", "ecaron"}:                   -30.000000,
+	{"W", "ecircumflex"}:              -30.000000,
+	{"W", "edieresis"}:                -30.000000,
+	{"W", "edotaccent"}:               -30.000000,
+	{"W", "egrave"}:                   -30.000000,
+	{"W", "emacron"}:                  -30.000000,
+	{"W", "eogonek"}:                  -30.000000,
+	{"W", "hyphen"}:                   -40.000000,
+	{"W", "o"}:                        -30.000000,
+	{"W", "oacute"}:                   -30.000000,
+	{"W", "ocircumflex"}:              -30.000000,
+	{"W", "odieresis"}:                -30.000000,
+	{"W", "ograve"}:                   -30.000000,
+	{"W", "ohungarumlaut"}:            -30.000000,
+	{"W", "omacron"}:                  -30.000000,
+	{"W", "oslash"}:                   -30.000000,
+	{"W", "otilde"}:                   -30.000000,
+	{"W", "period"}:                   -80.000000,
+	{"W", "u"}:                        -30.000000,
+	{"W", "uacute"}:                   -30.000000,
+	{"W", "ucircumflex"}:              -30.000000,
+	{"W", "udieresis"}:                -30.000000,
+	{"W", "ugrave"}:                   -30.000000,
+	{"W", "uhungarumlaut"}:            -30.000000,
+	{"W", "umacron"}:                  -30.000000,
+	{"W", "uogonek"}:                  -30.000000,
+	{"W", "uring"}:                    -30.000000,
+	{"W", "y"}:                        -20.000000,
+	{"W", "yacute"}:                   -20.000000,
+	{"W", "ydieresis"}:                -20.000000,
+	{"Y", "A"}:                        -110.000000,
+	{"Y", "Aacute"}:                   -110.000000,
+	{"Y", "Abreve"}:                   -110.000000,
+	{"Y", "Acircumflex"}:              -110.000000,
+	{"Y", "Adieresis"}:                -110.000000,
+	{"Y", "Agrave"}:                   -110.000000,
+	{"Y", "Amacron"}:                  -110.000000,
+	{"Y", "Aogonek"}:                  -110.000000,
+	{"Y", "Aring"}:                    -110.000000,
+	{"Y", "Atilde"}:                   -110.000000,
+	{"Y", "O"}:                        -85.000000,
+	{"Y", "Oacute"}:                   -85.000000,
+	{"Y", "Ocircumflex"}:              -85.000000,
+	{"Y", "Odieresis"}:                -85.000000,
+	{"Y", "Ograve"}:                   -85.000000,
+	{"Y", "Ohungarumlaut"}:            -85.000000,
+	{"Y", "Omacron"}:                  -85.000000,
+	{"Y", "Oslash"}:                   -85.000000,
+	{"Y", "Otilde"}:                   -85.000000,
+	{"Y", "a"}:                        -140.000000,
+	{"Y", "aacute"}:                   -140.000000,
+	{"Y", "abreve"}:                   -70.000000,
+	{"Y", "acircumflex"}:              -140.000000,
+	{"Y", "adieresis"}:                -140.000000,
+	{"Y", "agrave"}:                   -140.000000,
+	{"Y", "amacron"}:                  -70.000000,
+	{"Y", "aogonek"}:                  -140.000000,
+	{"Y", "aring"}:                    -140.000000,
+	{"Y", "atilde"}:                   -140.000000,
+	{"Y", "colon"}:                    -60.000000,
+	{"Y", "comma"}:                    -140.000000,
+	{"Y", "e"}:                        -140.000000,
+	{"Y", "eacute"}:                   -140.000000,
+	{"Y", "ecaron"}:                   -140.000000,
+	{"Y", "ecircumflex"}:              -140.000000,
+	{"Y", "edieresis"}:                -140.000000,
+	{"Y", "edotaccent"}:               -140.000000,
+	{"Y", "egrave"}:                   -140.000000,
+	{"Y", "emacron"}:                  -70.000000,
+	{"Y", "eogonek"}:                  -140.000000,
+	{"Y", "hyphen"}:                   -140.000000,
+	{"Y", "i"}:                        -20.000000,
+	{"Y", "iacute"}:                   -20.000000,
+	{"Y", "iogonek"}:                  -20.000000,
+	{"Y", "o"}:                        -140.000000,
+	{"Y", "oacute"}:                   -140.000000,
+	{"Y", "ocircumflex"}:              -140.000000,
+	{"Y", "odieresis"}:                -140.000000,
+	{"Y", "ograve"}:                   -140.000000,
+	{"Y", "ohungarumlaut"}:            -140.000000,
+	{"Y", "omacron"}:                  -140.000000,
+	{"Y", "oslash"}:                   -140.000000,
+	{"Y", "otilde"}:                   -140.000000,
+	{"Y", "period"}:                   -140.000000,
+	{"Y", "semicolon"}:                -60.000000,
+	{"Y", "u"}:                        -110.000000,
+	{"Y", "uacute"}:                   -110.000000,
+	{"Y", "ucircumflex"}:              -110.000000,
+	{"Y", "udieresis"}:                -110.000000,
+	{"Y", "ugrave"}:                   -110.000000,
+	{"Y", "uhungarumlaut"}:            -110.000000,
+	{"Y", "umacron"}:                  -110.000000,
+	{"Y", "uogonek"}:                  -110.000000,
+	{"Y", "uring"}:                    -110.000000,
+	{"Yacute", "A"}:                   -110.000000,
+	{"Yacute", "Aacute"}:              -110.000000,
+	{"Yacute", "Abreve"}:              -110.000000,
+	{"Yacute", "Acircumflex"}:         -110.000000,
+	{"Yacute", "Adieresis"}:           -110.000000,
+	{"Yacute", "Agrave"}:              -110.000000,
+	{"Yacute", "Amacron"}:             -110.000000,
+	{"Yacute", "Aogonek"}:             -110.000000,
+	{"Yacute", "Aring"}:               -110.000000,
+	{"Yacute", "Atilde"}:              -110.000000,
+	{"Yacute", "O"}:                   -85.000000,
+	{"Yacute", "Oacute"}:              -85.000000,
+	{"Yacute", "Ocircumflex"}:         -85.000000,
+	{"Yacute", "Odieresis"}:           -85.000000,
+	{"Yacute", "Ograve"}:              -85.000000,
+	{"Yacute", "Ohungarumlaut"}:       -85.000000,
+	{"Yacute", "Omacron"}:             -85.000000,
+	{"Yacute", "Oslash"}:              -85.000000,
+	{"Yacute", "Otilde"}:              -85.000000,
+	{"Yacute", "a"}:                   -140.000000,
+	{"Yacute", "aacute"}:              -140.000000,
+	{"Yacute", "abreve"}:              -70.000000,
+	{"Yacute", "acircumflex"}:         -140.000000,
+	{"Yacute", "adieresis"}:           -140.000000,
+	{"Yacute", "agrave"}:              -140.000000,
+	{"Yacute", "amacron"}:             -70.000000,
+	{"Yacute", "aogonek"}:             -140.000000,
+	{"Yacute", "aring"}:               -140.000000,
+	{"Yacute", "atilde"}:              -70.000000,
+	{"Yacute", "colon"}:               -60.000000,
+	{"Yacute", "comma"}:               -140.000000,
+	{"Yacute", "e"}:                   -140.000000,
+	{"Yacute", "eacute"}:              -140.000000,
+	{"Yacute", "ecaron"}:              -140.000000,
+	{"Yacute", "ecircumflex"}:         -140.000000,
+	{"Yacute", "edieresis"}:           -140.000000,
+	{"Yacute", "edotaccent"}:          -140.000000,
+	{"Yacute", "egrave"}:              -140.000000,
+	{"Yacute", "emacron"}:             -70.000000,
+	{"Yacute", "eogonek"}:             -140.000000,
+	{"Yacute", "hyphen"}:              -140.000000,
+	{"Yacute", "i"}:                   -20.000000,
+	{"Yacute", "iacute"}:              -20.000000,
+	{"Yacute", "iogonek"}:             -20.000000,
+	{"Yacute", "o"}:                   -140.000000,
+	{"Yacute", "oacute"}:              -140.000000,
+	{"Yacute", "ocircumflex"}:         -140.000000,
+	{"Yacute", "odieresis"}:           -140.000000,
+	{"Yacute", "ograve"}:              -140.000000,
+	{"Yacute", "ohungarumlaut"}:       -140.000000,
+	{"Yacute", "omacron"}:             -70.000000,
+	{"Yacute", "oslash"}:              -140.000000,
+	{"Yacute", "otilde"}:              -140.000000,
+	{"Yacute", "period"}:              -140.000000,
+	{"Yacute", "semicolon"}:           -60.000000,
+	{"Yacute", "u"}:                   -110.000000,
+	{"Yacute", "uacute"}:              -110.000000,
+	{"Yacute", "ucircumflex"}:         -110.000000,
+	{"Yacute", "udieresis"}:           -110.000000,
+	{"Yacute", "ugrave"}:              -110.000000,
+	{"Yacute", "uhungarumlaut"}:       -110.000000,
+	{"Yacute", "umacron"}:             -110.000000,
+	{"Yacute", "uogonek"}:             -110.000000,
+	{"Yacute", "uring"}:               -110.000000,
+	{"Ydieresis", "A"}:                -110.000000,
+	{"Ydieresis", "Aacute"}:           -110.000000,
+	{"Ydieresis", "Abreve"}:           -110.000000,
+	{"Ydieresis", "Acircumflex"}:      -110.000000,
+	{"Ydieresis", "Adieresis"}:        -110.000000,
+	{"Ydieresis", "Agrave"}:           -110.000000,
+	{"Ydieresis", "Amacron"}:          -110.000000,
+	{"Ydieresis", "Aogonek"}:          -110.000000,
+	{"Ydieresis", "Aring"}:            -110.000000,
+	{"Ydieresis", "Atilde"}:           -110.000000,
+	{"Ydieresis", "O"}:                -85.000000,
+	{"Ydieresis", "Oacute"}:           -85.000000,
+	{"Ydieresis", "Ocircumflex"}:      -85.000000,
+	{"Ydieresis", "Odieresis"}:        -85.000000,
+	{"Ydieresis", "Ograve"}:           -85.000000,
+	{"Ydieresis", "Ohungarumlaut"}:    -85.000000,
+	{"Ydieresis", "Omacron"}:          -85.000000,
+	{"Ydieresis", "Oslash"}:           -85.000000,
+	{"Ydieresis", "Otilde"}:           -85.000000,
+	{"Ydieresis", "a"}:                -140.000000,
+	{"Ydieresis", "aacute"}:           -140.000000,
+	{"Ydieresis", "abreve"}:           -70.000000,
+	{"Ydieresis", "acircumflex"}:      -140.000000,
+	{"Ydieresis", "adieresis"}:        -140.000000,
+	{"Ydieresis", "agrave"}:           -140.000000,
+	{"Ydieresis", "amacron"}:          -70.000000,
+	{"Ydieresis", "aogonek"}:          -140.000000,
+	{"Ydieresis", "aring"}:            -140.000000,
+	{"Ydieresis", "atilde"}:           -70.000000,
+	{"Ydieresis", "colon"}:            -60.000000,
+	{"Ydieresis", "comma"}:            -140.000000,
+	{"Ydieresis", "e"}:                -140.000000,
+	{"Ydieresis", "eacute"}:           -140.000000,
+	{"Ydieresis", "ecaron"}:           -140.000000,
+	{"Ydieresis", "ecircumflex"}:      -140.000000,
+	{"Ydieresis", "edieresis"}:        -140.000000,
+	{"Ydieresis", "edotaccent"}:       -140.000000,
+	{"Ydieresis", "egrave"}:           -140.000000,
+	{"Ydieresis", "emacron"}:          -70.000000,
+	{"Ydieresis", "eogonek"}:          -140.000000,
+	{"Ydieresis", "hyphen"}:           -140.000000,
+	{"Ydieresis", "i"}:                -20.000000,
+	{"Ydieresis", "iacute"}:           -20.000000,
+	{"Ydieresis", "iogonek"}:          -20.000000,
+	{"Ydieresis", "o"}:                -140.000000,
+	{"Ydieresis", "oacute"}:           -140.000000,
+	{"Ydieresis", "ocircumflex"}:      -140.000000,
+	{"Ydieresis", "odieresis"}:        -140.000000,
+	{"Ydieresis", "ograve"}:           -140.000000,
+	{"Ydieresis", "ohungarumlaut"}:    -140.000000,
+	{"Ydieresis", "omacron"}:          -140.000000,
+	{"Ydieresis", "oslash"}:           -140.000000,
+	{"Ydieresis", "otilde"}:           -140.000000,
+	{"Ydieresis", "period"}:           -140.000000,
+	{"Ydieresis", "semicolon"}:        -60.000000,
+	{"Ydieresis", "u"}:                -110.000000,
+	{"Ydieresis", "uacute"}:           -110.000000,
+	{"Ydieresis", "ucircumflex"}:      -110.000000,
+	{"Ydieresis", "udieresis"}:        -110.000000,
+	{"Ydieresis", "ugrave"}:           -110.000000,
+	{"Ydieresis", "uhungarumlaut"}:    -110.000000,
+	{"Ydieresis", "umacron"}:          -110.000000,
+	{"Ydieresis", "uogonek"}:          -110.000000,
+	{"Ydieresis", "uring"}:            -110.000000,
+	{"a", "v"}:                        -20.000000,
+	{"a", "w"}:                        -20.000000,
+	{"a", "y"}:                        -30.000000,
+	{"a", "yacute"}:                   -30.000000,
+	{"a", "ydieresis"}:                -30.000000,
+	{"aacute", "v"}:                   -20.000000,
+	{"aacute", "w"}:                   -20.000000,
+	{"aacute", "y"}:                   -30.000000,
+	{"aacute", "yacute"}:              -30.000000,
+	{"aacute", "ydieresis"}:           -30.000000,
+	{"abreve", "v"}:                   -20.000000,
+	{"abreve", "w"}:                   -20.000000,
+	{"abreve", "y"}:                   -30.000000,
+	{"abreve", "yacute"}:              -30.000000,
+	{"abreve", "ydieresis"}:           -30.000000,
+	{"acircumflex", "v"}:              -20.000000,
+	{"acircumflex", "w"}:              -20.000000,
+	{"acircumflex", "y"}:              -30.000000,
+	{"acircumflex", "yacute"}:         -30.000000,
+	{"acircumflex", "ydieresis"}:      -30.000000,
+	{"adieresis", "v"}:                -20.000000,
+	{"adieresis", "w"}:                -20.000000,
+	{"adieresis", "y"}:                -30.000000,
+	{"adieresis", "yacute"}:           -30.000000,
+	{"adieresis", "ydieresis"}:        -30.000000,
+	{"agrave", "v"}:                   -20.000000,
+	{"agrave", "w"}:                   -20.000000,
+	{"agrave", "y"}:                   -30.000000,
+	{"agrave", "yacute"}:              -30.000000,
+	{"agrave", "ydieresis"}:           -30.000000,
+	{"amacron", "v"}:                  -20.000000,
+	{"amacron", "w"}:                  -20.000000,
+	{"amacron", "y"}:                  -30.000000,
+	{"amacron", "yacute"}:             -30.000000,
+	{"amacron", "ydieresis"}:          -30.000000,
+	{"aogonek", "v"}:                  -20.000000,
+	{"aogonek", "w"}:                  -20.000000,
+	{"aogonek", "y"}:                  -30.000000,
+	{"aogonek", "yacute"}:             -30.000000,
+	{"aogonek", "ydieresis"}:          -30.000000,
+	{"aring", "v"}:                    -20.000000,
+	{"aring", "w"}:                    -20.000000,
+	{"aring", "y"}:                    -30.000000,
+	{"aring", "yacute"}:               -30.000000,
+	{"aring", "ydieresis"}:            -30.000000,
+	{"atilde", "v"}:                   -20.000000,
+	{"atilde", "w"}:                   -20.000000,
+	{"atilde", "y"}:                   -30.000000,
+	{"atilde", "yacute"}:              -30.000000,
+	{"atilde", "ydieresis"}:           -30.000000,
+	{"b", "b"}:                        -10.000000,
+	{"b", "comma"}:                    -40.000000,
+	{"b", "l"}:                        -20.000000,
+	{"b", "lacute"}:                   -20.000000,
+	{"b", "lcommaaccent"}:             -20.000000,
+	{"b", "lslash"}:                   -20.000000,
+	{"b", "period"}:                   -40.000000,
+	{"b", "u"}:                        -20.000000,
+	{"b", "uacute"}:                   -20.000000,
+	{"b", "ucircumflex"}:              -20.000000,
+	{"b", "udieresis"}:                -20.000000,
+	{"b", "ugrave"}:                   -20.000000,
+	{"b", "uhungarumlaut"}:            -20.000000,
+	{"b", "umacron"}:                  -20.000000,
+	{"b", "uogonek"}:                  -20.000000,
+	{"b", "uring"}:                    -20.000000,
+	{"b", "v"}:                        -20.000000,
+	{"b", "y"}:                        -20.000000,
+	{"b", "yacute"}:                   -20.000000,
+	{"b", "ydieresis"}:                -20.000000,
+	{"c", "comma"}:                    -15.000000,
+	{"c", "k"}:                        -20.000000,
+	{"c", "kcommaaccent"}:             -20.000000,
+	{"cacute", "comma"}:               -15.000000,
+	{"cacute", "k"}:                   -20.000000,
+	{"cacute", "kcommaaccent"}:        -20.000000,
+	{"ccaron", "comma"}:               -15.000000,
+	{"ccaron", "k"}:                   -20.000000,
+	{"ccaron", "kcommaaccent"}:        -20.000000,
+	{"ccedilla", "comma"}:             -15.000000,
+	{"ccedilla", "k"}:                 -20.000000,
+	{"ccedilla", "kcommaaccent"}:      -20.000000,
+	{"colon", "space"}:                -50.000000,
+	{"comma", "quotedblright"}:        -100.000000,
+	{"comma", "quoteright"}:           -100.000000,
+	{"e", "comma"}:                    -15.000000,
+	{"e", "period"}:                   -15.000000,
+	{"e", "v"}:                        -30.000000,
+	{"e", "w"}:                        -20.000000,
+	{"e", "x"}:                        -30.000000,
+	{"e", "y"}:                        -20.000000,
+	{"e", "yacute"}:                   -20.000000,
+	{"e", "ydieresis"}:                -20.000000,
+	{"eacute", "comma"}:               -15.000000,
+	{"eacute", "period"}:              -15.000000,
+	{"eacute", "v"}:                   -30.000000,
+	{"eacute", "w"}:                   -20.000000,
+	{"eacute", "x"}:                   -30.000000,
+	{"eacute", "y"}:                   -20.000000,
+	{"eacute", "yacute"}:              -20.000000,
+	{"eacute", "ydieresis"}:           -20.000000,
+	{"ecaron", "comma"}:               -15.000000,
+	{"ecaron", "period"}:              -15.000000,
+	{"ecaron", "v"}:                   -30.000000,
+	{"ecaron", "w"}:                   -20.000000,
+	{"ecaron", "x"}:                   -30.000000,
+	{"ecaron", "y"}:                   -20.000000,
+	{"ecaron", "yacute"}:              -20.000000,
+	{"ecaron", "ydieresis"}:           -20.000000,
+	{"ecircumflex", "comma"}:          -15.000000,
+	{"ecircumflex", "period"}:         -15.000000,
+	{"ecircumflex", "v"}:              -30.000000,
+	{"ecircumflex", "w"}:              -20.000000,
+	{"ecircumflex", "x"}:              -30.000000,
+	{"ecircumflex", "y"}:              -20.000000,
+	{"ecircumflex", "yacute"}:         -20.000000,
+	{"ecircumflex", "ydieresis"}:      -20.000000,
+	{"edieresis", "comma"}:            -15.000000,
+	{"edieresis", "period"}:           -15.000000,
+	{"edieresis", "v"}:                -30.000000,
+	{"edieresis", "w"}:                -20.000000,
+	{"edieresis", "x"}:                -30.000000,
+	{"edieresis", "y"}:                -20.000000,
+	{"edieresis", "yacute"}:           -20.000000,
+	{"edieresis", "ydieresis"}:        -20.000000,
+	{"edotaccent", "comma"}:           -15.000000,
+	{"edotaccent", "period"}:          -15.000000,
+	{"edotaccent", "v"}:               -30.000000,
+	{"edotaccent", "w"}:               -20.000000,
+	{"edotaccent", "x"}:               -30.000000,
+	{"edotaccent", "y"}:               -20.000000,
+	{"edotaccent", "yacute"}:          -20.000000,
+	{"edotaccent", "ydieresis"}:       -20.000000,
+	{"egrave", "comma"}:               -15.000000,
+	{"egrave", "period"}:              -15.000000,
+	{"egrave", "v"}:                   -30.000000,
+	{"egrave", "w"}:                   -20.000000,
+	{"egrave", "x"}:                   -30.000000,
+	{"egrave", "y"}:                   -20.000000,
+	{"egrave", "yacute"}:              -20.000000,
+	{"egrave", "ydieresis"}:           -20.000000,
+	{"emacron", "comma"}:              -15.000000,
+	{"emacron", "period"}:             -15.000000,
+	{"emacron", "v"}:                  -30.000000,
+	{"emacron", "w"}:                  -20.000000,
+	{"emacron", "x"}:                  -30.000000,
+	{"emacron", "y"}:                  -20.000000,
+	{"emacron", "yacute"}:             -20.000000,
+	{"emacron", "ydieresis"}:          -20.000000,
+	{"eogonek", "comma"}:              -15.000000,
+	{"eogonek", "period"}:             -15.000000,
+	{"eogonek", "v"}:                  -30.000000,
+	{"eogonek", "w"}:                  -20.000000,
+	{"eogonek", "x"}:                  -30.000000,
+	{"eogonek", "y"}:                  -20.000000,
+	{"eogonek", "yacute"}:             -20.000000,
+	{"eogonek", "ydieresis"}:          -20.000000,
+	{"f", "a"}:                        -30.000000,
+	{"f", "aacute"}:                   -30.000000,
+	{"f", "abreve"}:                   -30.000000,
+	{"f", "acircumflex"}:              -30.000000,
+	{"f", "adieresis"}:                -30.000000,
+	{"f", "agrave"}:                   -30.000000,
+	{"f", "amacron"}:                  -30.000000,
+	{"f", "aogonek"}:                  -30.000000,
+	{"f", "aring"}:                    -30.000000,
+	{"f", "atilde"}:                   -30.000000,
+	{"f", "comma"}:                    -30.000000,
+	{"f", "dotlessi"}:                 -28.000000,
+	{"f", "e"}:                        -30.000000,
+	{"f", "eacute"}:                   -30.000000,
+	{"f", "ecaron"}:                   -30.000000,
+	{"f", "ecircumflex"}:              -30.000000,
+	{"f", "edieresis"}:                -30.000000,
+	{"f", "edotaccent"}:               -30.000000,
+	{"f", "egrave"}:                   -30.000000,
+	{"f", "emacron"}:                  -30.000000,
+	{"f", "eogonek"}:                  -30.000000,
+	{"f", "o"}:                        -30.000000,
+	{"f", "oacute"}:                   -30.000000,
+	{"f", "ocircumflex"}:              -30.000000,
+	{"f", "odieresis"}:                -30.000000,
+	{"f", "ograve"}:                   -30.000000,
+	{"f", "ohungarumlaut"}:            -30.000000,
+	{"f", "omacron"}:                  -30.000000,
+	{"f", "oslash"}:                   -30.000000,
+	{"f", "otilde"}:                   -30.000000,
+	{"f", "period"}:                   -30.000000,
+	{"f", "quotedblright"}:            60.000000,
+	{"f", "quoteright"}:               50.000000,
+	{"g", "r"}:                        -10.000000,
+	{"g", "racute"}:                   -10.000000,
+	{"g", "rcaron"}:                   -10.000000,
+	{"g", "rcommaaccent"}:             -10.000000,
+	{"gbreve", "r"}:                   -10.000000,
+	{"gbreve", "racute"}:              -10.000000,
+	{"gbreve", "rcaron"}:              -10.000000,
+	{"gbreve", "rcommaaccent"}:        -10.000000,
+	{"gcommaaccent", "r"}:             -10.000000,
+	{"gcommaaccent", "racute"}:        -10.000000,
+	{"gcommaaccent", "rcaron"}:        -10.000000,
+	{"gcommaaccent", "rcommaaccent"}:  -10.000000,
+	{"h", "y"}:                        -30.000000,
+	{"h", "yacute"}:                   -30.000000,
+	{"h", "ydieresis"}:                -30.000000,
+	{"k", "e"}:                        -20.000000,
+	{"k", "eacute"}:                   -20.000000,
+	{"k", "ecaron"}:                   -20.000000,
+	{"k", "ecircumflex"}:              -20.000000,
+	{"k", "edieresis"}:                -20.000000,
+	{"k", "edotaccent"}:               -20.000000,
+	{"k", "egrave"}:                   -20.000000,
+	{"k", "emacron"}:                  -20.000000,
+	{"k", "eogonek"}:                  -20.000000,
+	{"k", "o"}:                        -20.000000,
+	{"k", "oacute"}:                   -20.000000,
+	{"k", "ocircumflex"}:              -20.000000,
+	{"k", "odieresis"}:                -20.000000,
+	{"k", "ograve"}:                   -20.000000,
+	{"k", "ohungarumlaut"}:            -20.000000,
+	{"k", "omacron"}:                  -20.000000,
+	{"k", "oslash"}:                   -20.000000,
+	{"k", "otilde"}:                   -20.000000,
+	{"kcommaaccent", "e"}:             -20.000000,
+	{"kcommaaccent", "eacute"}:        -20.000000,
+	{"kcommaaccent", "ecaron"}:        -20.000000,
+	{"kcommaaccent", "ecircumflex"}:   -20.000000,
+	{"kcommaaccent", "edieresis"}:     -20.000000,
+	{"kcommaaccent", "edotaccent"}:    -20.000000,
+	{"kcommaaccent", "egrave"}:        -20.000000,
+	{"kcommaaccent", "emacron"}:       -20.000000,
+	{"kcommaaccent", "eogonek"}:       -20.000000,
+	{"kcommaaccent", "o"}:             -20.000000,
+	{"kcommaaccent", "oacute"}:        -20.000000,
+	{"kcommaaccent", "ocircumflex"}:   -20.000000,
+	{"kcommaaccent", "odieresis"}:     -20.000000,
+	{"kcommaaccent", "ograve"}:        -20.000000,
+	{"kcommaaccent", "ohungarumlaut"}: -20.000000,
+	{"kcommaaccent", "omacron"}:       -20.000000,
+	{"kcommaaccent", "oslash"}:        -20.000000,
+	{"kcommaaccent", "otilde"}:        -20.000000,
+	{"m", "u"}:                        -10.000000,
+	{"m", "uacute"}:                   -10.000000,
+	{"m", "ucircumflex"}:              -10.000000,
+	{"m", "udieresis"}:                -10.000000,
+	{"m", "ugrave"}:                   -10.000000,
+	{"m", "uhungarumlaut"}:            -10.000000,
+	{"m", "umacron"}:                  -10.000000,
+	{"m", "uogonek"}:                  -10.000000,
+	{"m", "uring"}:                    -10.000000,
+	{"m", "y"}:                        -15.000000,
+	{"m", "yacute"}:                   -15.000000,
+	{"m", "ydieresis"}:                -15.000000,
+	{"n", "u"}:                        -10.000000,
+	{"n", "uacute"}:                   -10.000000,
+	{"n", "ucircumflex"}:              -10.000000,
+	{"n", "udieresis"}:                -10.000000,
+	{"n", "ugrave"}:                   -10.000000,
+	{"n", "uhungarumlaut"}:            -10.000000,
+	{"n", "umacron"}:                  -10.000000,
+	{"n", "uogonek"}:                  -10.000000,
+	{"n", "uring"}:                    -10.000000,
+	{"n", "v"}:                        -20.000000,
+	{"n", "y"}:                        -15.000000,
+	{"n", "yacute"}:                   -15.000000,
+	{"n", "ydieresis"}:                -15.000000,
+	{"nacute", "u"}:                   -10.000000,
+	{"nacute", "uacute"}:              -10.000000,
+	{"nacute", "ucircumflex"}:         -10.000000,
+	{"nacute", "udieresis"}:           -10.000000,
+	{"nacute", "ugrave"}:              -10.000000,
+	{"nacute", "uhungarumlaut"}:       -10.000000,
+	{"nacute", "umacron"}:             -10.000000,
+	{"nacute", "uogonek"}:             -10.000000,
+	{"nacute", "uring"}:               -10.000000,
+	{"nacute", "v"}:                   -20.000000,
+	{"nacute", "y"}:                   -15.000000,
+	{"nacute", "yacute"}:              -15.000000,
+	{"nacute", "ydieresis"}:           -15.000000,
+	{"ncaron", "u"}:                   -10.000000,
+	{"ncaron", "uacute"}:              -10.000000,
+	{"ncaron", "ucircumflex"}:         -10.000000,
+	{"ncaron", "udieresis"}:           -10.000000,
+	{"ncaron", "ugrave"}:              -10.000000,
+	{"ncaron", "uhungarumlaut"}:       -10.000000,
+	{"ncaron", "umacron"}:             -10.000000,
+	{"ncaron", "uogonek"}:             -10.000000,
+	{"ncaron", "uring"}:               -10.000000,
+	{"ncaron", "v"}:                   -20.000000,
+	{"ncaron", "y"}:                   -15.000000,
+	{"ncaron", "yacute"}:              -15.000000,
+	{"ncaron", "ydieresis"}:           -15.000000,
+	{"ncommaaccent", "u"}:             -10.000000,
+	{"ncommaaccent", "uacute"}:        -10.000000,
+	{"ncommaaccent", "ucircumflex"}:   -10.000000,
+	{"ncommaaccent", "udieresis"}:     -10.000000,
+	{"ncommaaccent", "ugrave"}:        -10.000000,
+	{"ncommaaccent", "uhungarumlaut"}: -10.000000,
+	{"ncommaaccent", "umacron"}:       -10.000000,
+	{"ncommaaccent", "uogonek"}:       -10.000000,
+	{"ncommaaccent", "uring"}:         -10.000000,
+	{"ncommaaccent", "v"}:             -20.000000,
+	{"ncommaaccent", "y"}:             -15.000000,
+	{"ncommaaccent", "yacute"}:        -15.000000,
+	{"ncommaaccent", "ydieresis"}:     -15.000000,
+	{"ntilde", "u"}:                   -10.000000,
+	{"ntilde", "uacute"}:              -10.000000,
+	{"ntilde", "ucircumflex"}:         -10.000000,
+	{"ntilde", "udieresis"}:           -10.000000,
+	{"ntilde", "ugrave"}:              -10.000000,
+	{"ntilde", "uhungarumlaut"}:       -10.000000,
+	{"ntilde", "umacron"}:             -10.000000,
+	{"ntilde", "uogonek"}:             -10.000000,
+	{"ntilde", "uring"}:               -10.000000,
+	{"ntilde", "v"}:                   -20.000000,
+	{"ntilde", "y"}:                   -15.000000,
+	{"ntilde", "yacute"}:              -15.000000,
+	{"ntilde", "ydieresis"}:           -15.000000,
+	{"o", "comma"}:                    -40.000000,
+	{"o", "period"}:                   -40.000000,
+	{"o", "v"}:                        -15.000000,
+	{"o", "w"}:                        -15.000000,
+	{"o", "x"}:                        -30.000000,
+	{"o", "y"}:                        -30.000000,
+	{"o", "yacute"}:                   -30.000000,
+	{"o", "ydieresis"}:                -30.000000,
+	{"oacute", "comma"}:               -40.000000,
+	{"oacute", "period"}:              -40.000000,
+	{"oacute", "v"}:                   -15.000000,
+	{"oacute", "w"}:                   -15.000000,
+	{"oacute", "x"}:                   -30.000000,
+	{"oacute", "y"}:                   -30.000000,
+	{"oacute", "yacute"}:              -30.000000,
+	{"oacute", "ydieresis"}:           -30.000000,
+	{"ocircumflex", "comma"}:          -40.000000,
+	{"ocircumflex", "period"}:         -40.000000,
+	{"ocircumflex", "v"}:              -15.000000,
+	{"ocircumflex", "w"}:              -15.000000,
+	{"ocircumflex", "x"}:              -30.000000,
+	{"ocircumflex", "y"}:              -30.000000,
+	{"ocircumflex", "yacute"}:         -30.000000,
+	{"ocircumflex", "ydieresis"}:      -30.000000,
+	{"odieresis", "comma"}:            -40.000000,
+	{"odieresis", "period"}:           -40.000000,
+	{"odieresis", "v"}:                -15.000000,
+	{"odieresis", "w"}:                -15.000000,
+	{"odieresis", "x"}:                -30.000000,
+	{"odieresis", "y"}:                -30.000000,
+	{"odieresis", "yacute"}:           -30.000000,
+	{"odieresis", "ydieresis"}:        -30.000000,
+	{"ograve", "comma"}:               -40.000000,
+	{"ograve", "period"}:              -40.000000,
+	{"ograve", "v"}:                   -15.000000,
+	{"ograve", "w"}:                   -15.000000,
+	{"ograve", "x"}:                   -30.000000,
+	{"ograve", "y"}:                   -30.000000,
+	{"ograve", "yacute"}:              -30.000000,
+	{"ograve", "ydieresis"}:           -30.000000,
+	{"ohungarumlaut", "comma"}:        -40.000000,
+	{"ohungarumlaut", "period"}:       -40.000000,
+	{"ohungarumlaut", "v"}:            -15.000000,
+	{"ohungarumlaut", "w"}:            -15.000000,
+	{"ohungarumlaut", "x"}:            -30.000000,
+	{"ohungarumlaut", "y"}:            -30.000000,
+	{"ohungarumlaut", "yacute"}:       -30.000000,
+	{"ohungarumlaut", "ydieresis"}:    -30.000000,
+	{"omacron", "comma"}:              -40.000000,
+	{"omacron", "period"}:             -40.000000,
+	{"omacron", "v"}:                  -15.000000,
+	{"omacron", "w"}:                  -15.000000,
+	{"omacron", "x"}:                  -30.000000,
+	{"omacron", "y"}:                  -30.000000,
+	{"omacron", "yacute"}:             -30.000000,
+	{"omacron", "ydieresis"}:          -30.000000,
+	{"oslash", "a"}:                   -55.000000,
+	{"oslash", "aacute"}:              -55.000000,
+	{"oslash", "abreve"}:              -55.000000,
+	{"oslash", "acircumflex"}:         -55.000000,
+	{"oslash", "adieresis"}:           -55.000000,
+	{"oslash", "agrave"}:              -55.000000,
+	{"oslash", "amacron"}:             -55.000000,
+	{"oslash", "aogonek"}:             -55.000000,
+	{"oslash", "aring"}:               -55.000000,
+	{"oslash", "atilde"}:              -55.000000,
+	{"oslash", "b"}:                   -55.000000,
+	{"oslash", "c"}:                   -55.000000,
+	{"oslash", "cacute"}:              -55.000000,
+	{"oslash", "ccaron"}:              -55.000000,
+	{"oslash", "ccedilla"}:            -55.000000,
+	{"oslash", "comma"}:               -95.000000,
+	{"oslash", "d"}:                   -55.000000,
+	{"oslash", "dcroat"}:              -55.000000,
+	{"oslash", "e"}:                   -55.000000,
+	{"oslash", "eacute"}:              -55.000000,
+	{"oslash", "ecaron"}:              -55.000000,
+	{"oslash", "ecircumflex"}:         -55.000000,
+	{"oslash", "edieresis"}:           -55.000000,
+	{"oslash", "edotaccent"}:          -55.000000,
+	{"oslash", "egrave"}:              -55.000000,
+	{"oslash", "emacron"}:             -55.000000,
+	{"oslash", "eogonek"}:             -55.000000,
+	{"oslash", "f"}:                   -55.000000,
+	{"oslash", "g"}:                   -55.000000,
+	{"oslash", "gbreve"}:              -55.000000,
+	{"oslash", "gcommaaccent"}:        -55.000000,
+	{"oslash", "h"}:                   -55.000000,
+	{"oslash", "i"}:                   -55.000000,
+	{"oslash", "iacute"}:              -55.000000,
+	{"oslash", "icircumflex"}:         -55.000000,
+	{"oslash", "idieresis"}:           -55.000000,
+	{"oslash", "igrave"}:              -55.000000,
+	{"oslash", "imacron"}:             -55.000000,
+	{"oslash", "iogonek"}:             -55.000000,
+	{"oslash", "j"}:                   -55.000000,
+	{"oslash", "k"}:                   -55.000000,
+	{"oslash", "kcommaaccent"}:        -55.000000,
+	{"oslash", "l"}:                   -55.000000,
+	{"oslash", "lacute"}:              -55.000000,
+	{"oslash", "lcommaaccent"}:        -55.000000,
+	{"oslash", "lslash"}:              -55.000000,
+	{"oslash", "m"}:                   -55.000000,
+	{"oslash", "n"}:                   -55.000000,
+	{"oslash", "nacute"}:              -55.000000,
+	{"oslash", "ncaron"}:              -55.000000,
+	{"oslash", "ncommaaccent"}:        -55.000000,
+	{"oslash", "ntilde"}:              -55.000000,
+	{"oslash", "o"}:                   -55.000000,
+	{"oslash", "oacute"}:              -55.000000,
+	{"oslash", "ocircumflex"}:         -55.000000,
+	{"oslash", "odieresis"}:           -55.000000,
+	{"oslash", "ograve"}:              -55.000000,
+	{"oslash", "ohungarumlaut"}:       -55.000000,
+	{"oslash", "omacron"}:             -55.000000,
+	{"oslash", "oslash"}:              -55.000000,
+	{"oslash", "otilde"}:              -55.000000,
+	{"oslash", "p"}:                   -55.000000,
+	{"oslash", "period"}:              -95.000000,
+	{"oslash", "q"}:                   -55.000000,
+	{"oslash", "r"}:                   -55.000000,
+	{"oslash", "racute"}:              -55.000000,
+	{"oslash", "rcaron"}:              -55.000000,
+	{"oslash", "rcommaaccent"}:        -55.000000,
+	{"oslash", "s"}:                   -55.000000,
+	{"oslash", "sacute"}:              -55.000000,
+	{"oslash", "scaron"}:              -55.000000,
+	{"oslash", "scedilla"}:            -55.000000,
+	{"oslash", "scommaaccent"}:        -55.000000,
+	{"oslash", "t"}:                   -55.000000,
+	{"oslash", "tcommaaccent"}:        -55.000000,
+	{"oslash", "u"}:                   -55.000000,
+	{"oslash", "uacute"}:              -55.000000,
+	{"oslash", "ucircumflex"}:         -55.000000,
+	{"oslash", "udieresis"}:           -55.000000,
+	{"oslash", "ugrave"}:              -55.000000,
+	{"oslash", "uhungarumlaut"}:       -55.000000,
+	{"oslash", "umacron"}:             -55.000000,
+	{"oslash", "uogonek"}:             -55.000000,
+	{"oslash", "uring"}:               -55.000000,
+	{"oslash", "v"}:                   -70.000000,
+	{"oslash", "w"}:                   -70.000000,
+	{"oslash", "x"}:                   -85.000000,
+	{"oslash", "y"}:                   -70.000000,
+	{"oslash", "yacute"}:              -70.000000,
+	{"oslash", "ydieresis"}:           -70.000000,
+	{"oslash", "z"}:                   -55.000000,
+	{"oslash", "zacute"}:              -55.000000,
+	{"oslash", "zcaron"}:              -55.000000,
+	{"oslash", "zdotaccent"}:          -55.000000,
+	{"otilde", "comma"}:               -40.000000,
+	{"otilde", "period"}:              -40.000000,
+	{"otilde", "v"}:                   -15.000000,
+	{"otilde", "w"}:                   -15.000000,
+	{"otilde", "x"}:                   -30.000000,
+	{"otilde", "y"}:                   -30.000000,
+	{"otilde", "yacute"}:              -30.000000,
+	{"otilde", "ydieresis"}:           -30.000000,
+	{"p", "comma"}:                    -35.000000,
+	{"p", "period"}:                   -35.000000,
+	{"p", "y"}:                        -30.000000,
+	{"p", "yacute"}:                   -30.000000,
+	{"p", "ydieresis"}:                -30.000000,
+	{"period", "quotedblright"}:       -100.000000,
+	{"period", "quoteright"}:          -100.000000,
+	{"period", "space"}:               -60.000000,
+	{"quotedblright", "space"}:        -40.000000,
+	{"quoteleft", "quoteleft"}:        -57.000000,
+	{"quoteright", "d"}:               -50.000000,
+	{"quoteright", "dcroat"}:          -50.000000,
+	{"quoteright", "quoteright"}:      -57.000000,
+	{"quoteright", "r"}:               -50.000000,
+	{"quoteright", "racute"}:          -50.000000,
+	{"quoteright", "rcaron"}:          -50.000000,
+	{"quoteright", "rcommaaccent"}:    -50.000000,
+	{"quoteright", "s"}:               -50.000000,
+	{"quoteright", "sacute"}:          -50.000000,
+	{"quoteright", "scaron"}:          -50.000000,
+	{"quoteright", "scedilla"}:        -50.000000,
+	{"quoteright", "scommaaccent"}:    -50.000000,
+	{"quoteright", "space"}:           -70.000000,
+	{"r", "a"}:                        -10.000000,
+	{"r", "aacute"}:                   -10.000000,
+	{"r", "abreve"}:                   -10.000000,
+	{"r", "acircumflex"}:              -10.000000,
+	{"r", "adieresis"}:                -10.000000,
+	{"r", "agrave"}:                   -10.000000,
+	{"r", "amacron"}:                  -10.000000,
+	{"r", "aogonek"}:                  -10.000000,
+	{"r", "aring"}:                    -10.000000,
+	{"r", "atilde"}:                   -10.000000,
+	{"r", "colon"}:                    30.000000,
+	{"r", "comma"}:                    -50.000000,
+	{"r", "i"}:                        15.000000,
+	{"r", "iacute"}:                   15.000000,
+	{"r", "icircumflex"}:              15.000000,
+	{"r", "idieresis"}:                15.000000,
+	{"r", "igrave"}:                   15.000000,
+	{"r", "imacron"}:                  15.000000,
+	{"r", "iogonek"}:                  15.000000,
+	{"r", "k"}:                        15.000000,
+	{"r", "kcommaaccent"}:             15.000000,
+	{"r", "l"}:                        15.000000,
+	{"r", "lacute"}:                   15.000000,
+	{"r", "lcommaaccent"}:             15.000000,
+	{"r", "lslash"}:                   15.000000,
+	{"r", "m"}:                        25.000000,
+	{"r", "n"}:                        25.000000,
+	{"r", "nacute"}:                   25.000000,
+	{"r", "ncaron"}:                   25.000000,
+	{"r", "ncommaaccent"}:             25.000000,
+	{"r", "ntilde"}:                   25.000000,
+	{"r", "p"}:                        30.000000,
+	{"r", "period"}:                   -50.000000,
+	{"r", "semicolon"}:                30.000000,
+	{"r", "t"}:                        40.000000,
+	{"r", "tcommaaccent"}:             40.000000,
+	{"r", "u"}:                        15.000000,
+	{"r", "uacute"}:                   15.000000,
+	{"r", "ucircumflex"}:              15.000000,
+	{"r", "udieresis"}:                15.000000,
+	{"r", "ugrave"}:                   15.000000,
+	{"r", "uhungarumlaut"}:            15.000000,
+	{"r", "umacron"}:                  15.000000,
+	{"r", "uogonek"}:                  15.000000,
+	{"r", "uring"}:                    15.000000,
+	{"r", "v"}:                        30.000000,
+	{"r", "y"}:                        30.000000,
+	{"r", "yacute"}:                   30.000000,
+	{"r", "ydieresis"}:                30.000000,
+	{"racute", "a"}:                   -10.000000,
+	{"racute", "aacute"}:              -10.000000,
+	{"racute", "abreve"}:              -10.000000,
+	{"racute", "acircumflex"}:         -10.000000,
+	{"racute", "adieresis"}:           -10.000000,
+	{"racute", "agrave"}:              -10.000000,
+	{"racute", "amacron"}:             -10.000000,
+	{"racute", "aogonek"}:             -10.000000,
+	{"racute", "aring"}:               -10.000000,
+	{"racute", "atilde"}:              -10.000000,
+	{"racute", "colon"}:               30.000000,
+	{"racute", "comma"}:               -50.000000,
+	{"racute", "i"}:                   15.000000,
+	{"racute", "iacute"}:              15.000000,
+	{"racute", "icircumflex"}:         15.000000,
+	{"racute", "idieresis"}:           15.000000,
+	{"racute", "igrave"}:              15.000000,
+	{"racute", "imacron"}:             15.000000,
+	{"racute", "iogonek"}:             15.000000,
+	{"racute", "k"}:                   15.000000,
+	{"racute", "kcommaaccent"}:        15.000000,
+	{"racute", "l"}:                   15.000000,
+	{"racute", "lacute"}:              15.000000,
+	{"racute", "lcommaaccent"}:        15.000000,
+	{"racute", "lslash"}:              15.000000,
+	{"racute", "m"}:                   25.000000,
+	{"racute", "n"}:                   25.000000,
+	{"racute", "nacute"}:              25.000000,
+	{"racute", "ncaron"}:              25.000000,
+	{"racute", "ncommaaccent"}:        25.000000,
+	{"racute", "ntilde"}:              25.000000,
+	{"racute", "p"}:                   30.000000,
+	{"racute", "period"}:              -50.000000,
+	{"racute", "semicolon"}:           30.000000,
+	{"racute", "t"}:                   40.000000,
+	{"racute", "tcommaaccent"}:        40.000000,
+	{"racute", "u"}:                   15.000000,
+	{"racute", "uacute"}:              15.000000,
+	{"racute", "ucircumflex"}:         15.000000,
+	{"racute", "udieresis"}:           15.000000,
+	{"racute", "ugrave"}:              15.000000,
+	{"racute", "uhungarumlaut"}:       15.000000,
+	{"racute", "umacron"}:             15.000000,
+	{"racute", "uogonek"}:             15.000000,
+	{"racute", "uring"}:               15.000000,
+	{"racute", "v"}:                   30.000000,
+	{"racute", "y"}:                   30.000000,
+	{"racute", "yacute"}:              30.000000,
+	{"racute", "ydieresis"}:           30.000000,
+	{"rcaron", "a"}:                   -10.000000,
+	{"rcaron", "aacute"}:              -10.000000,
+	{"rcaron", "abreve"}:              -10.000000,
+	{"rcaron", "acircumflex"}:         -10.000000,
+	{"rcaron", "adieresis"}:           -10.000000,
+	{"rcaron", "agrave"}:              -10.000000,
+	{"rcaron", "amacron"}:             -10.000000,
+	{"rcaron", "aogonek"}:             -10.000000,
+	{"rcaron", "aring"}:               -10.000000,
+	{"rcaron", "atilde"}:              -10.000000,
+	{"rcaron", "colon"}:               30.000000,
+	{"rcaron", "comma"}:               -50.000000,
+	{"rcaron", "i"}:                   15.000000,
+	{"rcaron", "iacute"}:              15.000000,
+	{"rcaron", "icircumflex"}:         15.000000,
+	{"rcaron", "idieresis"}:           15.000000,
+	{"rcaron", "igrave"}:              15.000000,
+	{"rcaron", "imacron"}:             15.000000,
+	{"rcaron", "iogonek"}:             15.000000,
+	{"rcaron", "k"}:                   15.000000,
+	{"rcaron", "kcommaaccent"}:        15.000000,
+	{"rcaron", "l"}:                   15.000000,
+	{"rcaron", "lacute"}:              15.000000,
+	{"rcaron", "lcommaaccent"}:        15.000000,
+	{"rcaron", "lslash"}:              15.000000,
+	{"rcaron", "m"}:                   25.000000,
+	{"rcaron", "n"}:                   25.000000,
+	{"rcaron", "nacute"}:              25.000000,
+	{"rcaron", "ncaron"}:              25.000000,
+	{"rcaron", "ncommaaccent"}:        25.000000,
+	{"rcaron", "ntilde"}:              25.000000,
+	{"rcaron", "p"}:                   30.000000,
+	{"rcaron", "period"}:              -50.000000,
+	{"rcaron", "semicolon"}:           30.000000,
+	{"rcaron", "t"}:                   40.000000,
+	{"rcaron", "tcommaaccent"}:        40.000000,
+	{"rcaron", "u"}:                   15.000000,
+	{"rcaron", "uacute"}:              15.000000,
+	{"rcaron", "ucircumflex"}:         15.000000,
+	{"rcaron", "udieresis"}:           15.000000,
+	{"rcaron", "ugrave"}:              15.000000,
+	{"rcaron", "uhungarumlaut"}:       15.000000,
+	{"rcaron", "umacron"}:             15.000000,
+	{"rcaron", "uogonek"}:             15.000000,
+	{"rcaron", "uring"}:               15.000000,
+	{"rcaron", "v"}:                   30.000000,
+	{"rcaron", "y"}:                   30.000000,
+	{"rcaron", "yacute"}:              30.000000,
+	{"rcaron", "ydieresis"}:           30.000000,
+	{"rcommaaccent", "a"}:             -10.000000,
+	{"rcommaaccent", "aacute"}:        -10.000000,
+	{"rcommaaccent", "abreve"}:        -10.000000,
+	{"rcommaaccent", "acircumflex"}:   -10.000000,
+	{"rcommaaccent", "adieresis"}:     -10.000000,
+	{"rcommaaccent", "agrave"}:        -10.000000,
+	{"rcommaaccent", "amacron"}:       -10.000000,
+	{"rcommaaccent", "aogonek"}:       -10.000000,
+	{"rcommaaccent", "aring"}:         -10.000000,
+	{"rcommaaccent", "atilde"}:        -10.000000,
+	{"rcommaaccent", "colon"}:         30.000000,
+	{"rcommaaccent", "comma"}:         -50.000000,
+	{"rcommaaccent", "i"}:             15.000000,
+	{"rcommaaccent", "iacute"}:        15.000000,
+	{"rcommaaccent", "icircumflex"}:   15.000000,
+	{"rcommaaccent", "idieresis"}:     15.000000,
+	{"rcommaaccent", "igrave"}:        15.000000,
+	{"rcommaaccent", "imacron"}:       15.000000,
+	{"rcommaaccent", "iogonek"}:       15.000000,
+	{"rcommaaccent", "k"}:             15.000000,
+	{"rcommaaccent", "kcommaaccent"}:  15.000000,
+	{"rcommaaccent", "l"}:             15.000000,
+	{"rcommaaccent", "lacute"}:        15.000000,
+	{"rcommaaccent", "lcommaaccent"}:  15.000000,
+	{"rcommaaccent", "lslash"}:        15.000000,
+	{"rcommaaccent", "m"}:             25.000000,
+	{"rcommaaccent", "n"}:             25.000000,
+	{"rcommaaccent", "nacute"}:        25.000000,
+	{"rcommaaccent", "ncaron"}:        25.000000,
+	{"rcommaaccent", "ncommaaccent"}:  25.000000,
+	{"rcommaaccent", "ntilde"}:        25.000000,
+	{"rcommaaccent", "p"}:             30.000000,
+	{"rcommaaccent", "period"}:        -50.000000,
+	{"rcommaaccent", "semicolon"}:     30.000000,
+	{"rcommaaccent", "t"}:             40.000000,
+	{"rcommaaccent", "tcommaaccent"}:  40.000000,
+	{"rcommaaccent", "u"}:             15.000000,
+	{"rcommaaccent", "uacute"}:        15.000000,
+	{"rcommaaccent", "ucircumflex"}:   15.000000,
+	{"rcommaaccent", "udieresis"}:     15.000000,
+	{"rcommaaccent", "ugrave"}:        15.000000,
+	{"rcommaaccent", "uhungarumlaut"}: 15.000000,
+	{"rcommaaccent", "umacron"}:       15.000000,
+	{"rcommaaccent", "uogonek"}:       15.000000,
+	{"rcommaaccent", "uring"}:         15.000000,
+	{"rcommaaccent", "v"}:             30.000000,
+	{"rcommaaccent", "y"}:             30.000000,
+	{"rcommaaccent", "yacute"}:        30.000000,
+	{"rcommaaccent", "ydieresis"}:     30.000000,
+	{"s", "comma"}:                    -15.000000,
+	{"s", "period"}:                   -15.000000,
+	{"s", "w"}:                        -30.000000,
+	{"sacute", "comma"}:               -15.000000,
+	{"sacute", "period"}:              -15.000000,
+	{"sacute", "w"}:                   -30.000000,
+	{"scaron", "comma"}:               -15.000000,
+	{"scaron", "period"}:              -15.000000,
+	{"scaron", "w"}:                   -30.000000,
+	{"scedilla", "comma"}:             -15.000000,
+	{"scedilla", "period"}:            -15.000000,
+	{"scedilla", "w"}:                 -30.000000,
+	{"scommaaccent", "comma"}:         -15.000000,
+	{"scommaaccent", "period"}:        -15.000000,
+	{"scommaaccent", "w"}:             -30.000000,
+	{"semicolon", "space"}:            -50.000000,
+	{"space", "T"}:                    -50.000000,
+	{"space", "Tcaron"}:               -50.000000,
+	{"space", "Tcommaaccent"}:         -50.000000,
+	{"space", "V"}:                    -50.000000,
+	{"space", "W"}:                    -40.000000,
+	{"space", "Y"}:                    -90.000000,
+	{"space", "Yacute"}:               -90.000000,
+	{"space", "Ydieresis"}:            -90.000000,
+	{"space", "quotedblleft"}:         -30.000000,
+	{"space", "quoteleft"}:            -60.000000,
+	{"v", "a"}:                        -25.000000,
+	{"v", "aacute"}:                   -25.000000,
+	{"v", "abreve"}:                   -25.000000,
+	{"v", "acircumflex"}:              -25.000000,
+	{"v", "adieresis"}:                -25.000000,
+	{"v", "agrave"}:                   -25.000000,
+	{"v", "amacron"}:                  -25.000000,
+	{"v", "aogonek"}:                  -25.000000,
+	{"v", "aring"}:                    -25.000000,
+	{"v", "atilde"}:                   -25.000000,
+	{"v", "comma"}:                    -80.000000,
+	{"v", "e"}:                        -25.000000,
+	{"v", "eacute"}:                   -25.000000,
+	{"v", "ecaron"}:                   -25.000000,
+	{"v", "ecircumflex"}:              -25.000000,
+	{"v", "edieresis"}:                -25.000000,
+	{"v", "edotaccent"}:               -25.000000,
+	{"v", "egrave"}:                   -25.000000,
+	{"v", "emacron"}:                  -25.000000,
+	{"v", "eogonek"}:                  -25.000000,
+	{"v", "o"}:                        -25.000000,
+	{"v", "oacute"}:                   -25.000000,
+	{"v", "ocircumflex"}:              -25.000000,
+	{"v", "odieresis"}:                -25.000000,
+	{"v", "ograve"}:                   -25.000000,
+	{"v", "ohungarumlaut"}:            -25.000000,
+	{"v", "omacron"}:                  -25.000000,
+	{"v", "oslash"}:                   -25.000000,
+	{"v", "otilde"}:                   -25.000000,
+	{"v", "period"}:                   -80.000000,
+	{"w", "a"}:                        -15.000000,
+	{"w", "aacute"}:                   -15.000000,
+	{"w", "abreve"}:                   -15.000000,
+	{"w", "acircumflex"}:              -15.000000,
+	{"w", "adieresis"}:                -15.000000,
+	{"w", "agrave"}:                   -15.000000,
+	{"w", "amacron"}:                  -15.000000,
+	{"w", "aogonek"}:                  -15.000000,
+	{"w", "aring"}:                    -15.000000,
+	{"w", "atilde"}:                   -15.000000,
+	{"w", "comma"}:                    -60.000000,
+	{"w", "e"}:                        -10.000000,
+	{"w", "eacute"}:                   -10.000000,
+	{"w", "ecaron"}:                   -10.000000,
+	{"w", "ecircumflex"}:              -10.000000,
+	{"w", "edieresis"}:                -10.000000,
+	{"w", "edotaccent"}:               -10.000000,
+	{"w", "egrave"}:                   -10.000000,
+	{"w", "emacron"}:                  -10.000000,
+	{"w", "eogonek"}:                  -10.000000,
+	{"w", "o"}:                        -10.000000,
+	{"w", "oacute"}:                   -10.000000,
+	{"w", "ocircumflex"}:              -10.000000,
+	{"w", "odieresis"}:                -10.000000,
+	{"w", "ograve"}:                   -10.000000,
+	{"w", "ohungarumlaut"}:            -10.000000,
+	{"w", "omacron"}:                  -10.000000,
+	{"w", "oslash"}:                   -10.000000,
+	{"w", "otilde"}:                   -10.000000,
+	{"w", "period"}:                   -60.000000,
+	{"x", "e"}:                        -30.000000,
+	{"x", "eacute"}:                   -30.000000,
+	{"x", "ecaron"}:                   -30.000000,
+	{"x", "ecircumflex"}:              -30.000000,
+	{"x", "edieresis"}:                -30.000000,
+	{"x", "edotaccent"}:               -30.000000,
+	{"x", "egrave"}:                   -30.000000,
+	{"x", "emacron"}:                  -30.000000,
+	{"x", "eogonek"}:                  -30.000000,
+	{"y", "a"}:                        -20.000000,
+	{"y", "aacute"}:                   -20.000000,
+	{"y", "abreve"}:                   -20.000000,
+	{"y", "acircumflex"}:              -20.000000,
+	{"y", "adieresis"}:                -20.000000,
+	{"y", "agrave"}:                   -20.000000,
+	{"y", "amacron"}:                  -20.000000,
+	{"y", "aogonek"}:                  -20.000000,
+	{"y", "aring"}:                    -20.000000,
+	{"y", "atilde"}:                   -20.000000,
+	{"y", "comma"}:                    -100.000000,
+	{"y", "e"}:                        -20.000000,
+	{"y", "eacute"}:                   -20.000000,
+	{"y", "ecaron"}:                   -20.000000,
+	{"y", "ecircumflex"}:              -20.000000,
+	{"y", "edieresis"}:                -20.000000,
+	{"y", "edotaccent"}:               -20.000000,
+	{"y", "egrave"}:                   -20.000000,
+	{"y", "emacron"}:                  -20.000000,
+	{"y", "eogonek"}:                  -20.000000,
+	{"y", "o"}:                        -20.000000,
+	{"y", "oacute"}:                   -20.000000,
+	{"y", "ocircumflex"}:              -20.000000,
+	{"y", "odieresis"}:                -20.000000,
+	{"y", "ograve"}:                   -20.000000,
+	{"y", "ohungarumlaut"}:            -20.000000,
+	{"y", "omacron"}:                  -20.000000,
+	{"y", "oslash"}:                   -20.000000,
+	{"y", "otilde"}:                   -20.000000,
+	{"y", "period"}:                   -100.000000,
+	{"yacute", "a"}:                   -20.000000,
+	{"yacute", "aacute"}:              -20.000000,
+	{"yacute", "abreve"}:              -20.000000,
+	{"yacute", "acircumflex"}:         -20.000000,
+	{"yacute", "adieresis"}:           -20.000000,
+	{"yacute", "agrave"}:              -20.000000,
+	{"yacute", "amacron"}:             -20.000000,
+	{"yacute", "aogonek"}:             -20.000000,
+	{"yacute", "aring"}:               -20.000000,
+	{"yacute", "atilde"}:              -20.000000,
+	{"yacute", "comma"}:               -100.000000,
+	{"yacute", "e"}:                   -20.000000,
+	{"yacute", "eacute"}:              -20.000000,
+	{"yacute", "ecaron"}:              -20.000000,
+	{"yacute", "ecircumflex"}:         -20.000000,
+	{"yacute", "edieresis"}:           -20.000000,
+	{"yacute", "edotaccent"}:          -20.000000,
+	{"yacute", "egrave"}:              -20.000000,
+	{"yacute", "emacron"}:             -20.000000,
+	{"yacute", "eogonek"}:             -20.000000,
+	{"yacute", "o"}:                   -20.000000,
+	{"yacute", "oacute"}:              -20.000000,
+	{"yacute", "ocircumflex"}:         -20.000000,
+	{"yacute", "odieresis"}:           -20.000000,
+	{"yacute", "ograve"}:              -20.000000,
+	{"yacute", "ohungarumlaut"}:       -20.000000,
+	{"yacute", "omacron"}:             -20.000000,
+	{"yacute", "oslash"}:              -20.000000,
+	{"yacute", "otilde"}:              -20.000000,
+	{"yacute", "period"}:              -100.000000,
+	{"ydieresis", "a"}:                -20.000000,
+	{"ydieresis", "aacute"}:           -20.000000,
+	{"ydieresis", "abreve"}:           -20.000000,
+	{"ydieresis", "acircumflex"}:      -20.000000,
+	{"ydieresis", "adieresis"}:        -20.000000,
+	{"ydieresis", "agrave"}:           -20.000000,
+	{"ydieresis", "amacron"}:          -20.000000,
+	{"ydieresis", "aogonek"}:          -20.000000,
+	{"ydieresis", "aring"}:            -20.000000,
+	{"ydieresis", "atilde"}:           -20.000000,
+	{"ydieresis", "comma"}:            -100.000000,
+	{"ydieresis", "e"}:                -20.000000,
+	{"ydieresis", "eacute"}:           -20.000000,
+	{"ydieresis", "ecaron"}:           -20.000000,
+	{"ydieresis", "ecircumflex"}:      -20.000000,
+	{"ydieresis", "edieresis"}:        -20.000000,
+	{"ydieresis", "edotaccent"}:       -20.000000,
+	{"ydieresis", "egrave"}:           -20.000000,
+	{"ydieresis", "emacron"}:          -20.000000,
+	{"ydieresis", "eogonek"}:          -20.000000,
+	{"ydieresis", "o"}:                -20.000000,
+	{"ydieresis", "oacute"}:           -20.000000,
+	{"ydieresis", "ocircumflex"}:      -20.000000,
+	{"ydieresis", "odieresis"}:        -20.000000,
+	{"ydieresis", "ograve"}:           -20.000000,
+	{"ydieresis", "ohungarumlaut"}:    -20.000000,
+	{"ydieresis", "omacron"}:          -20.000000,
+	{"ydieresis", "oslash"}:           -20.000000,
+	{"ydieresis", "otilde"}:           -20.000000,
+	{"ydieresis", "period"}:           -100.000000,
+	{"z", "e"}:                        -15.000000,
+	{"z", "eacute"}:                   -15.000000,
+	{"z", "ecaron"}:                   -15.000000,
+	{"z", "ecircumflex"}:              -15.000000,
+	{"z", "edieresis"}:                -15.000000,
+	{"z", "edotaccent"}:               -15.000000,
+	{"z", "egrave"}:                   -15.000000,
+	{"z", "emacron"}:                  -15.000000,
+	{"z", "eogonek"}:                  -15.000000,
+	{"z", "o"}:                        -15.000000,
+	{"z", "oacute"}:                   -15.000000,
+	{"z", "ocircumflex"}:              -15.000000,
+	{"z", "odieresis"}:                -15.000000,
+	{"z", "ograve"}:                   -15.000000,
+	{"z", "ohungarumlaut"}:            -15.000000,
+	{"z", "omacron"}:                  -15.000000,
+	{"z", "oslash"}:                   -15.000000,
+	{"z", "otilde"}:                   -15.000000,
+	{"zacute", "e"}:                   -15.000000,
+	{"zacute", "eacute"}:              -15.000000,
+	{"zacute", "ecaron"}:              -15.000000,
+	{"zacute", "ecircumflex"}:         -15.000000,
+	{"zacute", "edieresis"}:           -15.000000,
+	{"zacute", "edotaccent"}:          -15.000000,
+	{"zacute", "egrave"}:              -15.000000,
+	{"zacute", "emacron"}:             -15.000000,
+	{"zacute", "eogonek"}:             -15.000000,
+	{"zacute", "o"}:                   -15.000000,
+	{"zacute", "oacute"}:              -15.000000,
+	{"zacute", "ocircumflex"}:         -15.000000,
+	{"zacute", "odieresis"}:           -15.000000,
+	{"zacute", "ograve"}:              -15.000000,
+	{"zacute", "ohungarumlaut"}:       -15.000000,
+	{"zacute", "omacron"}:             -15.000000,
+	{"zacute", "oslash"}:              -15.000000,
+	{"zacute", "otilde"}:              -15.000000,
+	{"zcaron", "e"}:                   -15.000000,
+	{"zcaron", "eacute"}:              -15.000000,
+	{"zcaron", "ecaron"}:              -15.000000,
+	{"zcaron", "ecircumflex"}:         -15.000000,
+	{"zcaron", "edieresis"}:           -15.000000,
+	{"zcaron", "edotaccent"}:          -15.000000,
+	{"zcaron", "egrave"}:              -15.000000,
+	{"zcaron", "emacron"}:             -15.000000,
+	{"zcaron", "eogonek"}:             -15.000000,
+	{"zcaron", "o"}:                   -15.000000,
+	{"zcaron", "oacute"}:              -15.000000,
+	{"zcaron", "ocircumflex"}:         -15.000000,
+	{"zcaron", "odieresis"}:           -15.000000,
+	{"zcaron", "ograve"}:              -15.000000,
+	{"zcaron", "ohungarumlaut"}:       -15.000000,
+	{"zcaron", "omacron"}:             -15.000000,
+	{"zcaron", "oslash"}:              -15.000000,
+	{"zcaron", "otilde"}:              -15.000000,
+	{"zdotaccent", "e"}:               -15.000000,
+	{"zdotaccent", "eacute"}:          -15.000000,
+	{"zdotaccent", "ecaron"}:          -15.000000,
+	{"zdotaccent", "ecircumflex"}:     -15.000000,
+	{"zdotaccent", "edieresis"}:       -15.000000,
+	{"zdotaccent", "edotaccent"}:      -15.000000,
+	{"zdotaccent", "egrave"}:          -15.000000,
+	{"zdotaccent", "emacron"}:         -15.000000,
+	{"zdotaccent", "eogonek"}:         -15.000000,
+	{"zdotaccent", "o"}:               -15.000000,
+	{"zdotaccent", "oacute"}:          -15.000000,
+	{"zdotaccent", "ocircumflex"}:     -15.000000,
+	{"zdotaccent", "odieresis"}:       -15.000000,
+	{"zdotaccent", "ograve"}:          -15.000000,
+	{"zdotaccent", "ohungarumlaut"}:   -15.000000,
+	{"zdotaccent", "omacron"}:         -15.000000,
+	{"zdotaccent", "oslash"}:          -15.000000,
+	{"zdotaccent", "otilde"}:          -15.000000,
+}
@@ -39,6 +39,22 @@ func (font fontHelveticaBold) GetGlyphCharMetrics(glyph string) (CharMetrics, bo
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font fontHelveticaBold) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := helveticaBoldKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontHelveticaBold) GetAscentDescent() (float64, float64) {
+	return 718, -207
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontHelveticaBold) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontHelveticaBold) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -370,3 +386,2488 @@ var helveticaBoldCharMetrics map[string]CharMetrics = map[string]CharMetrics{
 	"zdotaccent":     {GlyphName: "zdotaccent", Wx: 500.000000, Wy: 0.000000},
 	"zero":           {GlyphName: "zero", Wx: 556.000000, Wy: 0.000000},
 }
+
+// Helvetica-Bold kerning pairs loaded from afms/Helvetica-Bold.afm.  See afms/MustRead.html for license information.
+var helveticaBoldKerning map[GlyphPair]float64 = map[GlyphPair]float64{
+	{"A", "C"}:                        -40.000000,
+	{"A", "Cacute"}:                   -40.000000,
+	{"A", "Ccaron"}:                   -40.000000,
+	{"A", "Ccedilla"}:                 -40.000000,
+	{"A", "G"}:                        -50.000000,
+	{"A", "Gbreve"}:                   -50.000000,
+	{"A", "Gcommaaccent"}:             -50.000000,
+	{"A", "O"}:                        -40.000000,
+	{"A", "Oacute"}:                   -40.000000,
+	{"A", "Ocircumflex"}:              -40.000000,
+	{"A", "Odieresis"}:                -40.000000,
+	{"A", "Ograve"}:                   -40.000000,
+	{"A", "Ohungarumlaut"}:            -40.000000,
+	{"A", "Omacron"}:                  -40.000000,
+	{"A", "Oslash"}:                   -40.000000,
+	{"A", "Otilde"}:                   -40.000000,
+	{"A", "Q"}:                        -40.000000,
+	{"A", "T"}:                        -90.000000,
+	{"A", "Tcaron"}:                   -90.000000,
+	{"A", "Tcommaaccent"}:             -90.000000,
+	{"A", "U"}:                        -50.000000,
+	{"A", "Uacute"}:                   -50.000000,
+	{"A", "Ucircumflex"}:              -50.000000,
+	{"A", "Udieresis"}:                -50.000000,
+	{"A", "Ugrave"}:                   -50.000000,
+	{"A", "Uhungarumlaut"}:            -50.000000,
+	{"A", "Umacron"}:                  -50.000000,
+	{"A", "Uogonek"}:                  -50.000000,
+	{"A", "Uring"}:                    -50.000000,
+	{"A", "V"}:                        -80.000000,
+	{"A", "W"}:                        -60.000000,
+	{"A", "Y"}:                        -110.000000,
+	{"A", "Yacute"}:                   -110.000000,
+	{"A", "Ydieresis"}:                -110.000000,
+	{"A", "u"}:                        -30.000000,
+	{"A", "uacute"}:                   -30.000000,
+	{"A", "ucircumflex"}:              -30.000000,
+	{"A", "udieresis"}:                -30.000000,
+	{"A", "ugrave"}:                   -30.000000,
+	{"A", "uhungarumlaut"}:            -30.000000,
+	{"A", "umacron"}:                  -30.000000,
+	{"A", "uogonek"}:                  -30.000000,
+	{"A", "uring"}:                    -30.000000,
+	{"A", "v"}:                        -40.000000,
+	{"A", "w"}:                        -30.000000,
+	{"A", "y"}:                        -30.000000,
+	{"A", "yacute"}:                   -30.000000,
+	{"A", "ydieresis"}:                -30.000000,
+	{"Aacute", "C"}:                   -40.000000,
+	{"Aacute", "Cacute"}:              -40.000000,
+	{"Aacute", "Ccaron"}:              -40.000000,
+	{"Aacute", "Ccedilla"}:            -40.000000,
+	{"Aacute", "G"}:                   -50.000000,
+	{"Aacute", "Gbreve"}:              -50.000000,
+	{"Aacute", "Gcommaaccent"}:        -50.000000,
+	{"Aacute", "O"}:                   -40.000000,
+	{"Aacute", "Oacute"}:              -40.000000,
+	{"Aacute", "Ocircumflex"}:         -40.000000,
+	{"Aacute", "Odieresis"}:           -40.000000,
+	{"Aacute", "Ograve"}:              -40.000000,
+	{"Aacute", "Ohungarumlaut"}:       -40.000000,
+	{"Aacute", "Omacron"}:             -40.000000,
+	{"Aacute", "Oslash"}:              -40.000000,
+	{"Aacute", "Otilde"}:              -40.000000,
+	{"Aacute", "Q"}:                   -40.000000,
+	{"Aacute", "T"}:                   -90.000000,
+	{"Aacute", "Tcaron"}:              -90.000000,
+	{"Aacute", "Tcommaaccent"}:        -90.000000,
+	{"Aacute", "U"}:                   -50.000000,
+	{"Aacute", "Uacute"}:              -50.000000,
+	{"Aacute", "Ucircumflex"}:         -50.000000,
+	{"Aacute", "Udieresis"}:           -50.000000,
+	{"Aacute", "Ugrave"}:              -50.000000,
+	{"Aacute", "Uhungarumlaut"}:       -50.000000,
+	{"Aacute", "Umacron"}:             -50.000000,
+	{"Aacute", "Uogonek"}:             -50.000000,
+	{"Aacute", "Uring"}:               -50.000000,
+	{"Aacute", "V"}:                   -80.000000,
+	{"Aacute", "W"}:                   -60.000000,
+	{"Aacute", "Y"}:                   -110.000000,
+	{"Aacute", "Yacute"}:              -110.000000,
+	{"Aacute", "Ydieresis"}:           -110.000000,
+	{"Aacute", "u"}:                   -30.000000,
+	{"Aacute", "uacute"}:              -30.000000,
+	{"Aacute", "ucircumflex"}:         -30.000000,
+	{"Aacute", "udieresis"}:           -30.000000,
+	{"Aacute", "ugrave"}:              -30.000000,
+	{"Aacute", "uhungarumlaut"}:       -30.000000,
+	{"Aacute", "umacron"}:             -30.000000,
+	{"Aacute", "uogonek"}:             -30.000000,
+	{"Aacute", "uring"}:               -30.000000,
+	{"Aacute", "v"}:                   -40.000000,
+	{"Aacute", "w"}:                   -30.000000,
+	{"Aacute", "y"}:                   -30.000000,
+	{"Aacute", "yacute"}:              -30.000000,
+	{"Aacute", "ydieresis"}:           -30.000000,
+	{"Abreve", "C"}:                   -40.000000,
+	{"Abreve", "Cacute"}:              -40.000000,
+	{"Abreve", "Ccaron"}:              -40.000000,
+	{"Abreve", "Ccedilla"}:            -40.000000,
+	{"Abreve", "G"}:                   -50.000000,
+	{"Abreve", "Gbreve"}:              -50.000000,
+	{"Abreve", "Gcommaaccent"}:        -50.000000,
+	{"Abreve", "O"}:                   -40.000000,
+	{"Abreve", "Oacute"}:              -40.000000,
+	{"Abreve", "Ocircumflex"}:         -40.000000,
+	{"Abreve", "Odieresis"}:           -40.000000,
+	{"Abreve", "Ograve"}:              -40.000000,
+	{"Abreve", "Ohungarumlaut"}:       -40.000000,
+	{"Abreve", "Omacron"}:             -40.000000,
+	{"Abreve", "Oslash"}:              -40.000000,
+	{"Abreve", "Otilde"}:              -40.000000,
+	{"Abreve", "Q"}:                   -40.000000,
+	{"Abreve", "T"}:                   -90.000000,
+	{"Abreve", "Tcaron"}:              -90.000000,
+	{"Abreve", "Tcommaaccent"}:        -90.000000,
+	{"Abreve", "U"}:                   -50.000000,
+	{"Abreve", "Uacute"}:              -50.000000,
+	{"Abreve", "Ucircumflex"}:         -50.000000,
+	{"Abreve", "Udieresis"}:           -50.000000,
+	{"Abreve", "Ugrave"}:              -50.000000,
+	{"Abreve", "Uhungarumlaut"}:       -50.000000,
+	{"Abreve", "Umacron"}:             -50.000000,
+	{"Abreve", "Uogonek"}:             -50.000000,
+	{"Abreve", "Uring"}:               -50.000000,
+	{"Abreve", "V"}:                   -80.000000,
+	{"Abreve", "W"}:                   -60.000000,
+	{"Abreve", "Y"}:                   -110.000000,
+	{"Abreve", "Yacute"}:              -110.000000,
+	{"Abreve", "Ydieresis"}:           -110.000000,
+	{"Abreve", "u"}:                   -30.000000,
+	{"Abreve", "uacute"}:              -30.000000,
+	{"Abreve", "ucircumflex"}:         -30.000000,
+	{"Abreve", "udieresis"}:           -30.000000,
+	{"Abreve", "ugrave"}:              -30.000000,
+	{"Abreve", "uhungarumlaut"}:       -30.000000,
+	{"Abreve", "umacron"}:             -30.000000,
+	{"Abreve", "uogonek"}:             -30.000000,
+	{"Abreve", "uring"}:               -30.000000,
+	{"Abreve", "v"}:                   -40.000000,
+	{"Abreve", "w"}:                   -30.000000,
+	{"Abreve", "y"}:                   -30.000000,
+	{"Abreve", "yacute"}:              -30.000000,
+	{"Abreve", "ydieresis"}:           -30.000000,
+	{"Acircumflex", "C"}:              -40.000000,
+	{"Acircumflex", "Cacute"}:         -40.000000,
+	{"Acircumflex", "Ccaron"}:         -40.000000,
+	{"Acircumflex", "Ccedilla"}:       -40.000000,
+	{"Acircumflex", "G"}:              -50.000000,
+	{"Acircumflex", "Gbreve"}:         -50.000000,
+	{"Acircumflex", "Gcommaaccent"}:   -50.000000,
+	{"Acircumflex", "O"}:              -40.000000,
+	{"Acircumflex", "Oacute"}:         -40.000000,
+	{"Acircumflex", "Ocircumflex"}:    -40.000000,
+	{"Acircumflex", "Odieresis"}:      -40.000000,
+	{"Acircumflex", "Ograve"}:         -40.000000,
+	{"Acircumflex", "Ohungarumlaut"}:  -40.000000,
+	{"Acircumflex", "Omacron"}:        -40.000000,
+	{"Acircumflex", "Oslash"}:         -40.000000,
+	{"Acircumflex", "Otilde"}:         -40.000000,
+	{"Acircumflex", "Q"}:              -40.000000,
+	{"Acircumflex", "T"}:              -90.000000,
+	{"Acircumflex", "Tcaron"}:         -90.000000,
+	{"Acircumflex", "Tcommaaccent"}:   -90.000000,
+	{"Acircumflex", "U"}:              -50.000000,
+	{"Acircumflex", "Uacute"}:         -50.000000,
+	{"Acircumflex", "Ucircumflex"}:    -50.000000,
+	{"Acircumflex", "Udieresis"}:      -50.000000,
+	{"Acircumflex", "Ugrave"}:         -50.000000,
+	{"Acircumflex", "Uhungarumlaut"}:  -50.000000,
+	{"Acircumflex", "Umacron"}:        -50.000000,
+	{"Acircumflex", "Uogonek"}:        -50.000000,
+	{"Acircumflex", "Uring"}:          -50.000000,
+	{"Acircumflex", "V"}:              -80.000000,
+	{"Acircumflex", "W"}:              -60.000000,
+	{"Acircumflex", "Y"}:              -110.000000,
+	{"Acircumflex", "Yacute"}:         -110.000000,
+	{"Acircumflex", "Ydieresis"}:      -110.000000,
+	{"Acircumflex", "u"}:              -30.000000,
+	{"Acircumflex", "uacute"}:         -30.000000,
+	{"Acircumflex", "ucircumflex"}:    -30.000000,
+	{"Acircumflex", "udieresis"}:      -30.000000,
+	{"Acircumflex", "ugrave"}:         -30.000000,
+	{"Acircumflex", "uhungarumlaut"}:  -30.000000,
+	{"Acircumflex", "umacron"}:        -30.000000,
+	{"Acircumflex", "uogonek"}:        -30.000000,
+	{"Acircumflex", "uring"}:          -30.000000,
+	{"Acircumflex", "v"}:              -40.000000,
+	{"Acircumflex", "w"}:              -30.000000,
+	{"Acircumflex", "y"}:              -30.000000,
+	{"Acircumflex", "yacute"}:         -30.000000,
+	{"Acircumflex", "ydieresis"}:      -30.000000,
+	{"Adieresis", "C"}:                -40.000000,
+	{"Adieresis", "Cacute"}:           -40.000000,
+	{"Adieresis", "Ccaron"}:           -40.000000,
+	{"Adieresis", "Ccedilla"}:         -40.000000,
+	{"Adieresis", "G"}:                -50.000000,
+	{"Adieresis", "Gbreve"}:           -50.000000,
+	{"Adieresis", "Gcommaaccent"}:     -50.000000,
+	{"Adieresis", "O"}:                -40.000000,
+	{"Adieresis", "Oacute"}:           -40.000000,
+	{"Adieresis", "Ocircumflex"}:      -40.000000,
+	{"Adieresis", "Odieresis"}:        -40.000000,
+	{"Adieresis", "Ograve"}:           -40.000000,
+	{"Adieresis", "Ohungarumlaut"}:    -40.000000,
+	{"Adieresis", "Omacron"}:          -40.000000,
+	{"Adieresis", "Oslash"}:           -40.000000,
+	{"Adieresis", "Otilde"}:           -40.000000,
+	{"Adieresis", "Q"}:                -40.000000,
+	{"Adieresis", "T"}:                -90.000000,
+	{"Adieresis", "Tcaron"}:           -90.000000,
+	{"Adieresis", "Tcommaaccent"}:     -90.000000,
+	{"Adieresis", "U"}:                -50.000000,
+	{"Adieresis", "Uacute"}:           -50.000000,
+	{"Adieresis", "Ucircumflex"}:      -50.000000,
+	{"Adieresis", "Udieresis"}:        -50.000000,
+	{"Adieresis", "Ugrave"}:           -50.000000,
+	{"Adieresis", "Uhungarumlaut"}:    -50.000000,
+	{"Adieresis", "Umacron"}:          -50.000000,
+	{"Adieresis", "Uogonek"}:          -50.000000,
+	{"Adieresis", "Uring"}:            -50.000000,
+	{"Adieresis", "V"}:                -80.000000,
+	{"Adieresis", "W"}:                -60.000000,
+	{"Adieresis", "Y"}:                -110.000000,
+	{"Adieresis", "Yacute"}:           -110.000000,
+	{"Adieresis", "Ydieresis"}:        -110.000000,
+	{"Adieresis", "u"}:                -30.000000,
+	{"Adieresis", "uacute"}:           -30.000000,
+	{"Adieresis", "ucircumflex"}:      -30.000000,
+	{"Adieresis", "udieresis"}:        -30.000000,
+	{"Adieresis", "ugrave"}:           -30.000000,
+	{"Adieresis", "uhungarumlaut"}:    -30.000000,
+	{"Adieresis", "umacron"}:          -30.000000,
+	{"Adieresis", "uogonek"}:          -30.000000,
+	{"Adieresis", "uring"}:            -30.000000,
+	{"Adieresis", "v"}:                -40.000000,
+	{"Adieresis", "w"}:                -30.000000,
+	{"Adieresis", "y"}:                -30.000000,
+	{"Adieresis", "yacute"}:           -30.000000,
+	{"Adieresis", "ydieresis"}:        -30.000000,
+	{"Agrave", "C"}:                   -40.000000,
+	{"Agrave", "Cacute"}:              -40.000000,
+	{"Agrave", "Ccaron"}:              -40.000000,
+	{"Agrave", "Ccedilla"}:            -40.000000,
+	{"Agrave", "G"}:                   -50.000000,
+	{"Agrave", "Gbreve"}:              -50.000000,
+	{"Agrave", "Gcommaaccent"}:        -50.000000,
+	{"Agrave", "O"}:                   -40.000000,
+	{"Agrave", "Oacute"}:              -40.000000,
+	{"Agrave", "Ocircumflex"}:         -40.000000,
+	{"Agrave", "Odieresis"}:           -40.000000,
+	{"Agrave", "Ograve"}:              -40.000000,
+	{"Agrave", "Ohungarumlaut"}:       -40.000000,
+	{"Agrave", "Omacron"}:             -40.000000,
+	{"Agrave", "Oslash"}:              -40.000000,
+	{"Agrave", "Otilde"}:              -40.000000,
+	{"Agrave", "Q"}:                   -40.000000,
+	{"Agrave", "T"}:                   -90.000000,
+	{"Agrave", "Tcaron"}:              -90.000000,
+	{"Agrave", "Tcommaaccent"}:        -90.000000,
+	{"Agrave", "U"}:                   -50.000000,
+	{"Agrave", "Uacute"}:              -50.000000,
+	{"Agrave", "Ucircumflex"}:         -50.000000,
+	{"Agrave", "Udieresis"}:           -50.000000,
+	{"Agrave", "Ugrave"}:              -50.000000,
+	{"Agrave", "Uhungarumlaut"}:       -50.000000,
+	{"Agrave", "Umacron"}:             -50.000000,
+	{"Agrave", "Uogonek"}:             -50.000000,
+	{"Agrave", "Uring"}:               -50.000000,
+	{"Agrave", "V"}:                   -80.000000,
+	{"Agrave", "W"}:                   -60.000000,
+	{"Agrave", "Y"}:                   -110.000000,
+	{"Agrave", "Yacute"}:              -110.000000,
+	{"Agrave", "Ydieresis"}:           -110.000000,
+	{"Agrave", "u"}:                   -30.000000,
+	{"Agrave", "uacute"}:              -30.000000,
+	{"Agrave", "ucircumflex"}:         -30.000000,
+	{"Agrave", "udieresis"}:           -30.000000,
+	{"Agrave", "ugrave"}:              -30.000000,
+	{"Agrave", "uhungarumlaut"}:       -30.000000,
+	{"Agrave", "umacron"}:             -30.000000,
+	{"Agrave", "uogonek"}:             -30.000000,
+	{"Agrave", "uring"}:               -30.000000,
+	{"Agrave", "v"}:                   -40.000000,
+	{"Agrave", "w"}:                   -30.000000,
+	{"Agrave", "y"}:                   -30.000000,
+	{"Agrave", "yacute"}:              -30.000000,
+	{"Agrave", "ydieresis"}:           -30.000000,
+	{"Amacron", "C"}:                  -40.000000,
+	{"Amacron", "Cacute"}:             -40.000000,
+	{"Amacron", "Ccaron"}:             -40.000000,
+	{"Amacron", "Ccedilla"}:           -40.000000,
+	{"Amacron", "G"}:                  -50.000000,
+	{"Amacron", "Gbreve"}:             -50.000000,
+	{"Amacron", "Gcommaaccent"}:       -50.000000,
+	{"Amacron", "O"}:                  -40.000000,
+	{"Amacron", "Oacute"}:             -40.000000,
+	{"Amacron", "Ocircumflex"}:        -40.000000,
+	{"Amacron", "Odieresis"}:          -40.000000,
+	{"Amacron", "Ograve"}:             -40.000000,
+	{"Amacron", "Ohungarumlaut"}:      -40.000000,
+	{"Amacron", "Omacron"}:            -40.000000,
+	{"Amacron", "Oslash"}:             -40.000000,
+	{"Amacron", "Otilde"}:             -40.000000,
+	{"Amacron", "Q"}:                  -40.000000,
+	{"Amacron", "T"}:                  -90.000000,
+	{"Amacron", "Tcaron"}:             -90.000000,
+	{"Amacron", "Tcommaaccent"}:       -90.000000,
+	{"Amacron", "U"}:                  -50.000000,
+	{"Amacron", "Uacute"}:             -50.000000,
+	{"Amacron", "Ucircumflex"}:        -50.000000,
+	{"Amacron", "Udieresis"}:          -50.000000,
+	{"Amacron", "Ugrave"}:             -50.000000,
+	{"Amacron", "Uhungarumlaut"}:      -50.000000,
+	{"Amacron", "Umacron"}:            -50.000000,
+	{"Amacron", "Uogonek"}:            -50.000000,
+	{"Amacron", "Uring"}:              -50.000000,
+	{"Amacron", "V"}:                  -80.000000,
+	{"Amacron", "W"}:                  -60.000000,
+	{"Amacron", "Y"}:                  -110.000000,
+	{"Amacron", "Yacute"}:             -110.000000,
+	{"Amacron", "Ydieresis"}:          -110.000000,
+	{"Amacron", "u"}:                  -30.000000,
+	{"Amacron", "uacute"}:             -30.000000,
+	{"Amacron", "ucircumflex"}:        -30.000000,
+	{"Amacron", "udieresis"}:          -30.000000,
+	{"Amacron", "ugrave"}:             -30.000000,
+	{"Amacron", "uhungarumlaut"}:      -30.000000,
+	{"Amacron", "umacron"}:            -30.000000,
+	{"Amacron", "uogonek"}:            -30.000000,
+	{"Amacron", "uring"}:              -30.000000,
+	{"Amacron", "v"}:                  -40.000000,
+	{"Amacron", "w"}:                  -30.000000,
+	{"Amacron", "y"}:                  -30.000000,
+	{"Amacron", "yacute"}:             -30.000000,
+	{"Amacron", "ydieresis"}:          -30.000000,
+	{"Aogonek", "C"}:                  -40.000000,
+	{"Aogonek", "Cacute"}:             -40.000000,
+	{"Aogonek", "Ccaron"}:             -40.000000,
+	{"Aogonek", "Ccedilla"}:           -40.000000,
+	{"Aogonek", "G"}:                  -50.000000,
+	{"Aogonek", "Gbreve"}:             -50.000000,
+	{"Aogonek", "Gcommaaccent"}:       -50.000000,
+	{"Aogonek", "O"}:                  -40.000000,
+	{"Aogonek", "Oacute"}:             -40.000000,
+	{"Aogonek", "Ocircumflex"}:        -40.000000,
+	{"Aogonek", "Odieresis"}:          -40.000000,
+	{"Aogonek", "Ograve"}:             -40.000000,
+	{"Aogonek", "Ohungarumlaut"}:      -40.000000,
+	{"Aogonek", "Omacron"}:            -40.000000,
+	{"Aogonek", "Oslash"}:             -40.000000,
+	{"Aogonek", "Otilde"}:             -40.000000,
+	{"Aogonek", "Q"}:                  -40.000000,
+	{"Aogonek", "T"}:                  -90.000000,
+	{"Aogonek", "Tcaron"}:             -90.000000,
+	{"Aogonek", "Tcommaaccent"}:       -90.000000,
+	{"Aogonek", "U"}:                  -50.000000,
+	{"Aogonek", "Uacute"}:             -50.000000,
+	{"Aogonek", "Ucircumflex"}:        -50.000000,
+	{"Aogonek", "Udieresis"}:          -50.000000,
+	{"Aogonek", "Ugrave"}:             -50.000000,
+	{"Aogonek", "Uhungarumlaut"}:      -50.000000,
+	{"Aogonek", "Umacron"}:            -50.000000,
+	{"Aogonek", "Uogonek"}:            -50.000000,
+	{"Aogonek", "Uring"}:              -50.000000,
+	{"Aogonek", "V"}:                  -80.000000,
+	{"Aogonek", "W"}:                  -60.000000,
+	{"Aogonek", "Y"}:                  -110.000000,
+	{"Aogonek", "Yacute"}:             -110.000000,
+	{"Aogonek", "Ydieresis"}:          -110.000000,
+	{"Aogonek", "u"}:                  -30.000000,
+	{"Aogonek", "uacute"}:             -30.000000,
+	{"Aogonek", "ucircumflex"}:        -30.000000,
+	{"Aogonek", "udieresis"}:          -30.000000,
+	{"Aogonek", "ugrave"}:             -30.000000,
+	{"Aogonek", "uhungarumlaut"}:      -30.000000,
+	{"Aogonek", "umacron"}:            -30.000000,
+	{"Aogonek", "uogonek"}:            -30.000000,
+	{"Aogonek", "uring"}:              -30.000000,
+	{"Aogonek", "v"}:                  -40.000000,
+	{"Aogonek", "w"}:                  -30.000000,
+	{"Aogonek", "y"}:                  -30.000000,
+	{"Aogonek", "yacute"}:             -30.000000,
+	{"Aogonek", "ydieresis"}:          -30.000000,
+	{"Aring", "C"}:                    -40.000000,
+	{"Aring", "Cacute"}:               -40.000000,
+	{"Aring", "Ccaron"}:               -40.000000,
+	{"Aring", "Ccedilla"}:             -40.000000,
+	{"Aring", "G"}:                    -50.000000,
+	{"Aring", "Gbreve"}:               -50.000000,
+	{"Aring", "Gcommaaccent"}:         -50.000000,
+	{"Aring", "O"}:                    -40.000000,
+	{"Aring", "Oacute"}:               -40.000000,
+	{"Aring", "Ocircumflex"}:          -40.000000,
+	{"Aring", "Odieresis"}:            -40.000000,
+	{"Aring", "Ograve"}:               -40.000000,
+	{"Aring", "Ohungarumlaut"}:        -40.000000,
+	{"Aring", "Omacron"}:              -40.000000,
+	{"Aring", "Oslash"}:               -40.000000,
+	{"Aring", "Otilde"}:               -40.000000,
+	{"Aring", "Q"}:                    -40.000000,
+	{"Aring", "T"}:                    -90.000000,
+	{"Aring", "Tcaron"}:               -90.000000,
+	{"Aring", "Tcommaaccent"}:         -90.000000,
+	{"Aring", "U"}:                    -50.000000,
+	{"Aring", "Uacute"}:               -50.000000,
+	{"Aring", "Ucircumflex"}:          -50.000000,
+	{"Aring", "Udieresis"}:            -50.000000,
+	{"Aring", "Ugrave"}:               -50.000000,
+	{"Aring", "Uhungarumlaut"}:        -50.000000,
+	{"Aring", "Umacron"}:              -50.000000,
+	{"Aring", "Uogonek"}:              -50.000000,
+	{"Aring", "Uring"}:                -50.000000,
+	{"Aring", "V"}:                    -80.000000,
+	{"Aring", "W"}:                    -60.000000,
+	{"Aring", "Y"}:                    -110.000000,
+	{"Aring", "Yacute"}:               -110.000000,
+	{"Aring", "Ydieresis"}:            -110.000000,
+	{"Aring", "u"}:                    -30.000000,
+	{"Aring", "uacute"}:               -30.000000,
+	{"Aring", "ucircumflex"}:          -30.000000,
+	{"Aring", "udieresis"}:            -30.000000,
+	{"Aring", "ugrave"}:               -30.000000,
+	{"Aring", "uhungarumlaut"}:        -30.000000,
+	{"Aring", "umacron"}:              -30.000000,
+	{"Aring", "uogonek"}:              -30.000000,
+	{"Aring", "uring"}:                -30.000000,
+	{"Aring", "v"}:                    -40.000000,
+	{"Aring", "w"}:                    -30.000000,
+	{"Aring", "y"}:                    -30.000000,
+	{"Aring", "yacute"}:               -30.000000,
+	{"Aring", "ydieresis"}:            -30.000000,
+	{"Atilde", "C"}:                   -40.000000,
+	{"Atilde", "Cacute"}:              -40.000000,
+	{"Atilde", "Ccaron"}:              -40.000000,
+	{"Atilde", "Ccedilla"}:            -40.000000,
+	{"Atilde", "G"}:                   -50.000000,
+	{"Atilde", "Gbreve"}:              -50.000000,
+	{"Atilde", "Gcommaaccent"}:        -50.000000,
+	{"Atilde", "O"}:                   -40.000000,
+	{"Atilde", "Oacute"}:              -40.000000,
+	{"Atilde", "Ocircumflex"}:         -40.000000,
+	{"Atilde", "Odieresis"}:           -40.000000,
+	{"Atilde", "Ograve"}:              -40.000000,
+	{"Atilde", "Ohungarumlaut"}:       -40.000000,
+	{"Atilde", "Omacron"}:             -40.000000,
+	{"Atilde", "Oslash"}:              -40.000000,
+	{"Atilde", "Otilde"}:              -40.000000,
+	{"Atilde", "Q"}:                   -40.000000,
+	{"Atilde", "T"}:                   -90.000000,
+	{"Atilde", "Tcaron"}:              -90.000000,
+	{"Atilde", "Tcommaaccent"}:        -90.000000,
+	{"Atilde", "U"}:                   -50.000000,
+	{"Atilde", "Uacute"}:              -50.000000,
+	{"Atilde", "Ucircumflex"}:         -50.000000,
+	{"Atilde", "Udieresis"}:           -50.000000,
+	{"Atilde", "Ugrave"}:              -50.000000,
+	{"Atilde", "Uhungarumlaut"}:       -50.000000,
+	{"Atilde", "Umacron"}:             -50.000000,
+	{"Atilde", "Uogonek"}:             -50.000000,
+	{"Atilde", "Uring"}:               -50.000000,
+	{"Atilde", "V"}:                   -80.000000,
+	{"Atilde", "W"}:                   -60.000000,
+	{"Atilde", "Y"}:                   -110.000000,
+	{"Atilde", "Yacute"}:              -110.000000,
+	{"Atilde", "Ydieresis"}:           -110.000000,
+	{"Atilde", "u"}:                   -30.000000,
+	{"Atilde", "uacute"}:              -30.000000,
+	{"Atilde", "ucircumflex"}:         -30.000000,
+	{"Atilde", "udieresis"}:           -30.000000,
+	{"Atilde", "ugrave"}:              -30.000000,
+	{"Atilde", "uhungarumlaut"}:       -30.000000,
+	{"Atilde", "umacron"}:             -30.000000,
+	{"Atilde", "uogonek"}:             -30.000000,
+	{"Atilde", "uring"}:               -30.000000,
+	{"Atilde", "v"}:                   -40.000000,
+	{"Atilde", "w"}:                   -30.000000,
+	{"Atilde", "y"}:                   -30.000000,
+	{"Atilde", "yacute"}:              -30.000000,
+	{"Atilde", "ydieresis"}:           -30.000000,
+	{"B", "A"}:                        -30.000000,
+	{"B", "Aacute"}:                   -30.000000,
+	{"B", "Abreve"}:                   -30.000000,
+	{"B", "Acircumflex"}:              -30.000000,
+	{"B", "Adieresis"}:                -30.000000,
+	{"B", "Agrave"}:                   -30.000000,
+	{"B", "Amacron"}:                  -30.000000,
+	{"B", "Aogonek"}:                  -30.000000,
+	{"B", "Aring"}:                    -30.000000,
+	{"B", "Atilde"}:                   -30.000000,
+	{"B", "U"}:                        -10.000000,
+	{"B", "Uacute"}:                   -10.000000,
+	{"B", "Ucircumflex"}:              -10.000000,
+	{"B", "Udieresis"}:                -10.000000,
+	{"B", "Ugrave"}:                   -10.000000,
+	{"B", "Uhungarumlaut"}:            -10.000000,
+	{"B", "Umacron"}:                  -10.000000,
+	{"B", "Uogonek"}:                  -10.000000,
+	{"B", "Uring"}:                    -10.000000,
+	{"D", "A"}:                        -40.000000,
+	{"D", "Aacute"}:                   -40.000000,
+	{"D", "Abreve"}:                   -40.000000,
+	{"D", "Acircumflex"}:              -40.000000,
+	{"D", "Adieresis"}:                -40.000000,
+	{"D", "Agrave"}:                   -40.000000,
+	{"D", "Amacron"}:                  -40.000000,
+	{"D", "Aogonek"}:                  -40.000000,
+	{"D", "Aring"}:                    -40.000000,
+	{"D", "Atilde"}:                   -40.000000,
+	{"D", "V"}:                        -40.000000,
+	{"D", "W"}:                        -40.000000,
+	{"D", "Y"}:                        -70.000000,
+	{"D", "Yacute"}:                   -70.000000,
+	{"D", "Ydieresis"}:                -70.000000,
+	{"D", "comma"}:                    -30.000000,
+	{"D", "period"}:                   -30.000000,
+	{"Dcaron", "A"}:                   -40.000000,
+	{"Dcaron", "Aacute"}:              -40.000000,
+	{"Dcaron", "Abreve"}:              -40.000000,
+	{"Dcaron", "Acircumflex"}:         -40.000000,
+	{"Dcaron", "Adieresis"}:           -40.000000,
+	{"Dcaron", "Agrave"}:              -40.000000,
+	{"Dcaron", "Amacron"}:             -40.000000,
+	{"Dcaron", "Aogonek"}:             -40.000000,
+	{"Dcaron", "Aring"}:               -40.000000,
+	{"Dcaron", "Atilde"}:              -40.000000,
+	{"Dcaron", "V"}:                   -40.000000,
+	{"Dcaron", "W"}:                   -40.000000,
+	{"Dcaron", "Y"}:                   -70.000000,
+	{"Dcaron", "Yacute"}:              -70.000000,
+	{"Dcaron", "Ydieresis"}:           -70.000000,
+	{"Dcaron", "comma"}:               -30.000000,
+	{"Dcaron", "period"}:              -30.000000,
+	{"Dcroat", "A"}:                   -40.000000,
+	{"Dcroat", "Aacute"}:              -40.000000,
+	{"Dcroat", "Abreve"}:              -40.000000,
+	{"Dcroat", "Acircumflex"}:         -40.000000,
+	{"Dcroat", "Adieresis"}:           -40.000000,
+	{"Dcroat", "Agrave"}:              -40.000000,
+	{"Dcroat", "Amacron"}:             -40.000000,
+	{"Dcroat", "Aogonek"}:             -40.000000,
+	{"Dcroat", "Aring"}:               -40.000000,
+	{"Dcroat", "Atilde"}:              -40.000000,
+	{"Dcroat", "V"}:                   -40.000000,
+	{"Dcroat", "W"}:                   -40.000000,
+	{"Dcroat", "Y"}:                   -70.000000,
+	{"Dcroat", "Yacute"}:              -70.000000,
+	{"Dcroat", "Ydieresis"}:           -70.000000,
+	{"Dcroat", "comma"}:               -30.000000,
+	{"Dcroat", "period"}:              -30.000000,
+	{"F", "A"}:                        -80.000000,
+	{"F", "Aacute"}:                   -80.000000,
+	{"F", "Abreve"}:                   -80.000000,
+	{"F", "Acircumflex"}:              -80.000000,
+	{"F", "Adieresis"}:                -80.000000,
+	{"F", "Agrave"}:                   -80.000000,
+	{"F", "Amacron"}:                  -80.000000,
+	{"F", "Aogonek"}:                  -80.000000,
+	{"F", "Aring"}:                    -80.000000,
+	{"F", "Atilde"}:                   -80.000000,
+	{"F", "a"}:                        -20.000000,
+	{"F", "aacute"}:                   -20.000000,
+	{"F", "abreve"}:                   -20.000000,
+	{"F", "acircumflex"}:              -20.000000,
+	{"F", "adieresis"}:                -20.000000,
+	{"F", "agrave"}:                   -20.000000,
+	{"F", "amacron"}:                  -20.000000,
+	{"F", "aogonek"}:                  -20.000000,
+	{"F", "aring"}:                    -20.000000,
+	{"F", "atilde"}:                   -20.000000,
+	{"F", "comma"}:                    -100.000000,
+	{"F", "period"}:                   -100.000000,
+	{"J", "A"}:                        -20.000000,
+	{"J", "Aacute"}:                   -20.000000,
+	{"J", "Abreve"}:                   -20.000000,
+	{"J", "Acircumflex"}:              -20.000000,
+	{"J", "Adieresis"}:                -20.000000,
+	{"J", "Agrave"}:                   -20.000000,
+	{"J", "Amacron"}:                  -20.000000,
+	{"J", "Aogonek"}:                  -20.000000,
+	{"J", "Aring"}:                    -20.000000,
+	{"J", "Atilde"}:                   -20.000000,
+	{"J", "comma"}:                    -20.000000,
+	{"J", "period"}:                   -20.000000,
+	{"J", "u"}:                        -20.000000,
+	{"J", "uacute"}:                   -20.000000,
+	{"J", "ucircumflex"}:              -20.000000,
+	{"J", "udieresis"}:                -20.000000,
+	{"J", "ugrave"}:                   -20.000000,
+	{"J", "uhungarumlaut"}:            -20.000000,
+	{"J", "umacron"}:                  -20.000000,
+	{"J", "uogonek"}:                  -20.000000,
+	{"J", "uring"}:                    -20.000000,
+	{"K", "O"}:                        -30.000000,
+	{"K", "Oacute"}:                   -30.000000,
+	{"K", "Ocircumflex"}:              -30.000000,
+	{"K", "Odieresis"}:                -30.000000,
+	{"K", "Ograve"}:                   -30.000000,
+	{"K", "Ohungarumlaut"}:            -30.000000,
+	{"K", "Omacron"}:                  -30.000000,
+	{"K", "Oslash"}:                   -30.000000,
+	{"K", "Otilde"}:                   -30.000000,
+	{"K", "e"}:                        -15.000000,
+	{"K", "eacute"}:                   -15.000000,
+	{"K", "ecaron"}:                   -15.000000,
+	{"K", "ecircumflex"}:              -15.000000,
+	{"K", "edieresis"}:                -15.000000,
+	{"K", "edotaccent"}:               -15.000000,
+	{"K", "egrave"}:                   -15.000000,
+	{"K", "emacron"}:                  -15.000000,
+	{"K", "eogonek"}:                  -15.000000,
+	{"K", "o"}:                        -35.000000,
+	{"K", "oacute"}:                   -35.000000,
+	{"K", "ocircumflex"}:              -35.000000,
+	{"K", "odieresis"}:                -35.000000,
+	{"K", "ograve"}:                   -35.000000,
+	{"K", "ohungarumlaut"}:            -35.000000,
+	{"K", "omacron"}:                  -35.000000,
+	{"K", "oslash"}:                   -35.000000,
+	{"K", "otilde"}:                   -35.000000,
+	{"K", "u"}:                        -30.000000,
+	{"K", "uacute"}:                   -30.000000,
+	{"K", "ucircumflex"}:              -30.000000,
+	{"K", "udieresis"}:                -30.000000,
+	{"K", "ugrave"}:                   -30.000000,
+	{"K", "uhungarumlaut"}:            -30.000000,
+	{"K", "umacron"}:                  -30.000000,
+	{"K", "uogonek"}:                  -30.000000,
+	{"K", "uring"}:                    -30.000000,
+	{"K", "y"}:                        -40.000000,
+	{"K", "yacute"}:                   -40.000000,
+	{"K", "ydieresis"}:                -40.000000,
+	{"Kcommaaccent", "O"}:             -30.000000,
+	{"Kcommaaccent", "Oacute"}:        -30.000000,
+	{"Kcommaaccent", "Ocircumflex"}:   -30.000000,
+	{"Kcommaaccent", "Odieresis"}:     -30.000000,
+	{"Kcommaaccent", "Ograve"}:        -30.000000,
+	{"Kcommaaccent", "Ohungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "Omacron"}:       -30.000000,
+	{"Kcommaaccent", "Oslash"}:        -30.000000,
+	{"Kcommaaccent", "Otilde"}:        -30.000000,
+	{"Kcommaaccent", "e"}:             -15.000000,
+	{"Kcommaaccent", "eacute"}:        -15.000000,
+	{"Kcommaaccent", "ecaron"}:        -15.000000,
+	{"Kcommaaccent", "ecircumflex"}:   -15.000000,
+	{"Kcommaaccent", "edieresis"}:     -15.000000,
+	{"Kcommaaccent", "edotaccent"}:    -15.000000,
+	{"Kcommaaccent", "egrave"}:        -15.000000,
+	{"Kcommaaccent", "emacron"}:       -15.000000,
+	{"Kcommaaccent", "eogonek"}:       -15.000000,
+	{"Kcommaaccent", "o"}:             -35.000000,
+	{"Kcommaaccent", "oacute"}:        -35.000000,
+	{"Kcommaaccent", "ocircumflex"}:   -35.000000,
+	{"Kcommaaccent", "odieresis"}:     -35.000000,
+	{"Kcommaaccent", "ograve"}:        -35.000000,
+	{"Kcommaaccent", "ohungarumlaut"}: -35.000000,
+	{"Kcommaaccent", "omacron"}:       -35.000000,
+	{"Kcommaaccent", "oslash"}:        -35.000000,
+	{"Kcommaaccent", "otilde"}:        -35.000000,
+	{"Kcommaaccent", "u"}:             -30.000000,
+	{"Kcommaaccent", "uacute"}:        -30.000000,
+	{"Kcommaaccent", "ucircumflex"}:   -30.000000,
+	{"Kcommaaccent", "udieresis"}:     -30.000000,
+	{"Kcommaaccent", "ugrave"}:        -30.000000,
+	{"Kcommaaccent", "uhungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "umacron"}:       -30.000000,
+	{"Kcommaaccent", "uogonek"}:       -30.000000,
+	{"Kcommaaccent", "uring"}:         -30.000000,
+	{"Kcommaaccent", "y"}:             -40.000000,
+	{"Kcommaaccent", "yacute"}:        -40.000000,
+	{"Kcommaaccent", "ydieresis"}:     -40.000000,
+	{"L", "T"}:                        -90.000000,
+	{"L", "Tcaron"}:                   -90.000000,
+	{"L", "Tcommaaccent"}:             -90.000000,
+	{"L", "V"}:                        -110.000000,
+	{"L", "W"}:                        -80.000000,
+	{"L", "Y"}:                        -120.000000,
+	{"L", "Yacute"}:                   -120.000000,
+	{"L", "Ydieresis"}:                -120.000000,
+	{"L", "quotedblright"}:            -140.000000,
+	{"L", "quoteright"}:               -140.000000,
+	{"L", "y"}:                        -30.000000,
+	{"L", "yacute"}:                   -30.000000,
+	{"L", "ydieresis"}:                -30.000000,
+	{"Lacute", "T"}:                   -90.000000,
+	{"Lacute", "Tcaron"}:              -90.000000,
+	{"Lacute", "Tcommaaccent"}:        -90.000000,
+	{"Lacute", "V"}:                   -110.000000,
+	{"Lacute", "W"}:                   -80.000000,
+	{"Lacute", "Y"}:                   -120.000000,
+	{"Lacute", "Yacute"}:              -120.000000,
+	{"Lacute", "Ydieresis"}:           -120.000000,
+	{"Lacute", "quotedblright"}:       -140.000000,
+	{"Lacute", "quoteright"}:          -140.000000,
+	{"Lacute", "y"}:                   -30.000000,
+	{"Lacute", "yacute"}:              -30.000000,
+	{"Lacute", "ydieresis"}:           -30.000000,
+	{"Lcommaaccent", "T"}:             -90.000000,
+	{"Lcommaaccent", "Tcaron"}:        -90.000000,
+	{"Lcommaaccent", "Tcommaaccent"}:  -90.000000,
+	{"Lcommaaccent", "V"}:             -110.000000,
+	{"Lcommaaccent", "W"}:             -80.000000,
+	{"Lcommaaccent", "Y"}:             -120.000000,
+	{"Lcommaaccent", "Yacute"}:        -120.000000,
+	{"Lcommaaccent", "Ydieresis"}:     -120.000000,
+	{"Lcommaaccent", "quotedblright"}: -140.000000,
+	{"Lcommaaccent", "quoteright"}:    -140.000000,
+	{"Lcommaaccent", "y"}:             -30.000000,
+	{"Lcommaaccent", "yacute"}:        -30.000000,
+	{"Lcommaaccent", "ydieresis"}:     -30.000000,
+	{"Lslash", "T"}:                   -90.000000,
+	{"Lslash", "Tcaron"}:              -90.000000,
+	{"Lslash", "Tcommaaccent"}:        -90.000000,
+	{"Lslash", "V"}:                   -110.000000,
+	{"Lslash", "W"}:                   -80.000000,
+	{"Lslash", "Y"}:                   -120.000000,
+	{"Lslash", "Yacute"}:              -120.000000,
+	{"Lslash", "Ydieresis"}:           -120.000000,
+	{"Lslash", "quotedblright"}:       -140.000000,
+	{"Lslash", "quoteright"}:          -140.000000,
+	{"Lslash", "y"}:                   -30.000000,
+	{"Lslash", "yacute"}:              -30.000000,
+	{"Lslash", "ydieresis"}:           -30.000000,
+	{"O", "A"}:                        -50.000000,
+	{"O", "Aacute"}:                   -50.000000,
+	{"O", "Abreve"}:                   -50.000000,
+	{"O", "Acircumflex"}:              -50.000000,
+	{"O", "Adieresis"}:                -50.000000,
+	{"O", "Agrave"}:                   -50.000000,
+	{"O", "Amacron"}:                  -50.000000,
+	{"O", "Aogonek"}:                  -50.000000,
+	{"O", "Aring"}:                    -50.000000,
+	{"O", "Atilde"}:                   -50.000000,
+	{"O", "T"}:                        -40.000000,
+	{"O", "Tcaron"}:                   -40.000000,
+	{"O", "Tcommaaccent"}:             -40.000000,
+	{"O", "V"}:                        -50.000000,
+	{"O", "W"}:                        -50.000000,
+	{"O", "X"}:                        -50.000000,
+	{"O", "Y"}:                        -70.000000,
+	{"O", "Yacute"}:                   -70.000000,
+	{"O", "Ydieresis"}:                -70.000000,
+	{"O", "comma"}:                    -40.000000,
+	{"O", "period"}:                   -40.000000,
+	{"Oacute", "A"}:                   -50.000000,
+	{"Oacute", "Aacute"}:              -50.000000,
+	{"Oacute", "Abreve"}:              -50.000000,
+	{"Oacute", "Acircumflex"}:         -50.000000,
+	{"Oacute", "Adieresis"}:           -50.000000,
+	{"Oacute", "Agrave"}:              -50.000000,
+	{"Oacute", "Amacron"}:             -50.000000,
+	{"Oacute", "Aogonek"}:             -50.000000,
+	{"Oacute", "Aring"}:               -50.000000,
+	{"Oacute", "Atilde"}:              -50.000000,
+	{"Oacute", "T"}:                   -40.000000,
+	{"Oacute", "Tcaron"}:              -40.000000,
+	{"Oacute", "Tcommaaccent"}:        -40.000000,
+	{"Oacute", "V"}:                   -50.000000,
+	{"Oacute", "W"}:                   -50.000000,
+	{"Oacute", "X"}:                   -50.000000,
+	{"Oacute", "Y"}:                   -70.000000,
+	{"Oacute", "Yacute"}:              -70.000000,
+	{"Oacute", "Ydieresis"}:           -70.000000,
+	{"Oacute", "comma"}:               -40.000000,
+	{"Oacute", "period"}:              -40.000000,
+	{"Ocircumflex", "A"}:              -50.000000,
+	{"Ocircumflex", "Aacute"}:         -50.000000,
+	{"Ocircumflex", "Abreve"}:         -50.000000,
+	{"Ocircumflex", "Acircumflex"}:    -50.000000,
+	{"Ocircumflex", "Adieresis"}:      -50.000000,
+	{"Ocircumflex", "Agrave"}:         -50.000000,
+	{"Ocircumflex", "Amacron"}:        -50.000000,
+	{"Ocircumflex", "Aogonek"}:        -50.000000,
+	{"Ocircumflex", "Aring"}:          -50.000000,
+	{"Ocircumflex", "Atilde"}:         -50.000000,
+	{"Ocircumflex", "T"}:              -40.000000,
+	{"Ocircumflex", "Tcaron"}:         -40.000000,
+	{"Ocircumflex", "Tcommaaccent"}:   -40.000000,
+	{"Ocircumflex", "V"}:              -50.000000,
+	{"Ocircumflex", "W"}:              -50.000000,
+	{"Ocircumflex", "X"}:              -50.000000,
+	{"Ocircumflex", "Y"}:              -70.000000,
+	{"Ocircumflex", "Yacute"}:         -70.000000,
+	{"Ocircumflex", "Ydieresis"}:      -70.000000,
+	{"Ocircumflex", "comma"}:          -40.000000,
+	{"Ocircumflex", "period"}:         -40.000000,
+	{"Odieresis", "A"}:                -50.000000,
+	{"Odieresis", "Aacute"}:           -50.000000,
+	{"Odieresis", "Abreve"}:           -50.000000,
+	{"Odieresis", "Acircumflex"}:      -50.000000,
+	{"Odieresis", "Adieresis"}:        -50.000000,
+	{"Odieresis", "Agrave"}:           -50.000000,
+	{"Odieresis", "Amacron"}:          -50.000000,
+	{"Odieresis", "Aogonek"}:          -50.000000,
+	{"Odieresis", "Aring"}:            -50.000000,
+	{"Odieresis", "Atilde"}:           -50.000000,
+	{"Odieresis", "T"}:                -40.000000,
+	{"Odieresis", "Tcaron"}:           -40.000000,
+	{"Odieresis", "Tcommaaccent"}:     -40.000000,
+	{"Odieresis", "V"}:                -50.000000,
+	{"Odieresis", "W"}:                -50.000000,
+	{"Odieresis", "X"}:                -50.000000,
+	{"Odieresis", "Y"}:                -70.000000,
+	{"Odieresis", "Yacute"}:           -70.000000,
+	{"Odieresis", "Ydieresis"}:        -70.000000,
+	{"Odieresis", "comma"}:            -40.000000,
+	{"Odieresis", "period"}:           -40.000000,
+	{"Ograve", "A"}:                   -50.000000,
+	{"Ograve", "Aacute"}:              -50.000000,
+	{"Ograve", "Abreve"}:              -50.000000,
+	{"Ograve", "Acircumflex"}:         -50.000000,
+	{"Ograve", "Adieresis"}:           -50.000000,
+	{"Ograve", "Agrave"}:              -50.000000,
+	{"Ograve", "Amacron"}:             -50.000000,
+	{"Ograve", "Aogonek"}:             -50.000000,
+	{"Ograve", "Aring"}:               -50.000000,
+	{"Ograve", "Atilde"}:              -50.000000,
+	{"Ograve", "T"}:                   -40.000000,
+	{"Ograve", "Tcaron"}:              -40.000000,
+	{"Ograve", "Tcommaaccent"}:        -40.000000,
+	{"Ograve", "V"}:                   -50.000000,
+	{"Ograve", "W"}:                   -50.000000,
+	{"Ograve", "X"}:                   -50.000000,
+	{"Ograve", "Y"}:                   -70.000000,
+	{"Ograve", "Yacute"}:              -70.000000,
+	{"Ograve", "Ydieresis"}:           -70.000000,
+	{"Ograve", "comma"}:               -40.000000,
+	{"Ograve", "period"}:              -40.000000,
+	{"Ohungarumlaut", "A"}:            -50.000000,
+	{"Ohungarumlaut", "Aacute"}:       -50.000000,
+	{"Ohungarumlaut", "Abreve"}:       -50.000000,
+	{"Ohungarumlaut", "Acircumflex"}:  -50.000000,
+	{"Ohungarumlaut", "Adieresis"}:    -50.000000,
+	{"Ohungarumlaut", "Agrave"}:       -50.000000,
+	{"Ohungarumlaut", "Amacron"}:      -50.000000,
+	{"Ohungarumlaut", "Aogonek"}:      -50.000000,
+	{"Ohungarumlaut", "Aring"}:        -50.000000,
+	{"Ohungarumlaut", "Atilde"}:       -50.000000,
+	{"Ohungarumlaut", "T"}:            -40.000000,
+	{"Ohungarumlaut", "Tcaron"}:       -40.000000,
+	{"Ohungarumlaut", "Tcommaaccent"}: -40.000000,
+	{"Ohungarumlaut", "V"}:            -50.000000,
+	{"Ohungarumlaut", "W"}:            -50.000000,
+	{"Ohungarumlaut", "X"}:            -50.000000,
+	{"Ohungarumlaut", "Y"}:            -70.000000,
+	{"Ohungarumlaut", "Yacute"}:       -70.000000,
+	{"Ohungarumlaut", "Ydieresis"}:    -70.000000,
+	{"Ohungarumlaut", "comma"}:        -40.000000,
+	{"Ohungarumlaut", "period"}:       -40.000000,
+	{"Omacron", "A"}:                  -50.000000,
+	{"Omacron", "Aacute"}:             -50.000000,
+	{"Omacron", "Abreve"}:             -50.000000,
+	{"Omacron", "Acircumflex"}:        -50.000000,
+	{"Omacron", "Adieresis"}:          -50.000000,
+	{"Omacron", "Agrave"}:             -50.000000,
+	{"Omacron", "Amacron"}:            -50.000000,
+	{"Omacron", "Aogonek"}:            -50.000000,
+	{"Omacron", "Aring"}:              -50.000000,
+	{"Omacron", "Atilde"}:             -50.000000,
+	{"Omacron", "T"}:                  -40.000000,
+	{"Omacron", "Tcaron"}:             -40.000000,
+	{"Omacron", "Tcommaaccent"}:       -40.000000,
+	{"Omacron", "V"}:                  -50.000000,
+	{"Omacron", "W"}:                  -50.000000,
+	{"Omacron", "X"}:                  -50.000000,
+	{"Omacron", "Y"}:                  -70.000000,
+	{"Omacron", "Yacute"}:             -70.000000,
+	{"Omacron", "Ydieresis"}:          -70.000000,
+	{"Omacron", "comma"}:              -40.000000,
+	{"Omacron", "period"}:             -40.000000,
+	{"Oslash", "A"}:                   -50.000000,
+	{"Oslash", "Aacute"}:              -50.000000,
+	{"Oslash", "Abreve"}:              -50.000000,
+	{"Oslash", "Acircumflex"}:         -50.000000,
+	{"Oslash", "Adieresis"}:           -50.000000,
+	{"Oslash", "Agrave"}:              -50.000000,
+	{"Oslash", "Amacron"}:             -50.000000,
+	{"Oslash", "Aogonek"}:             -50.000000,
+	{"Oslash", "Aring"}:               -50.000000,
+	{"Oslash", "Atilde"}:              -50.000000,
+	{"Oslash", "T"}:                   -40.000000,
+	{"Oslash", "Tcaron"}:              -40.000000,
+	{"Oslash", "Tcommaaccent"}:        -40.000000,
+	{"Oslash", "V"}:                   -50.000000,
+	{"Oslash", "W"}:                   -50.000000,
+	{"Oslash", "X"}:                   -50.000000,
+	{"Oslash", "Y"}:                   -70.000000,
+	{"Oslash", "Yacute"}:              -70.000000,
+	{"Oslash", "Ydieresis"}:           -70.000000,
+	{"Oslash", "comma"}:               -40.000000,
+	{"Oslash", "period"}:              -40.000000,
+	{"Otilde", "A"}:                   -50.000000,
+	{"Otilde", "Aacute"}:              -50.000000,
+	{"Otilde", "Abreve"}:              -50.000000,
+	{"Otilde", "Acircumflex"}:         -50.000000,
+	{"Otilde", "Adieresis"}:           -50.000000,
+	{"Otilde", "Agrave"}:              -50.000000,
+	{"Otilde", "Amacron"}:             -50.000000,
+	{"Otilde", "Aogonek"}:             -50.000000,
+	{"Otilde", "Aring"}:               -50.000000,
+	{"Otilde", "Atilde"}:              -50.000000,
+	{"Otilde", "T"}:                   -40.000000,
+	{"Otilde", "Tcaron"}:              -40.000000,
+	{"Otilde", "Tcommaaccent"}:        -40.000000,
+	{"Otilde", "V"}:                   -50.000000,
+	{"Otilde", "W"}:                   -50.000000,
+	{"Otilde", "X"}:                   -50.000000,
+	{"Otilde", "Y"}:                   -70.000000,
+	{"Otilde", "Yacute"}:              -70.000000,
+	{"Otilde", "Ydieresis"}:           -70.000000,
+	{"Otilde", "comma"}:               -40.000000,
+	{"Otilde", "period"}:              -40.000000,
+	{"P", "A"}:                        -100.000000,
+	{"P", "Aacute"}:                   -100.000000,
+	{"P", "Abreve"}:                   -100.000000,
+	{"P", "Acircumflex"}:              -100.000000,
+	{"P", "Adieresis"}:                -100.000000,
+	{"P", "Agrave"}:                   -100.000000,
+	{"P", "Amacron"}:                  -100.000000,
+	{"P", "Aogonek"}:                  -100.000000,
+	{"P", "Aring"}:                    -100.000000,
+	{"P", "Atilde"}:                   -100.000000,
+	{"P", "a"}:                        -30.000000,
+	{"P", "aacute"}:                   -30.000000,
+	{"P", "abreve"}:                   -30.000000,
+	{"P", "acircumflex"}:              -30.000000,
+	{"P", "adieresis"}:                -30.000000,
+	{"P", "agrave"}:                   -30.000000,
+	{"P", "amacron"}:                  -30.000000,
+	{"P", "aogonek"}:                  -30.000000,
+	{"P", "aring"}:                    -30.000000,
+	{"P", "atilde"}:                   -30.000000,
+	{"P", "comma"}:                    -120.000000,
+	{"P", "e"}:                        -30.000000,
+	{"P", "eacute"}:                   -30.000000,
+	{"P", "ecaron"}:                   -30.000000,
+	{"P", "ecircumflex"}:              -30.000000,
+	{"P", "edieresis"}:                -30.000000,
+	{"P", "edotaccent"}:               -30.000000,
+	{"P", "egrave"}:                   -30.000000,
+	{"P", "emacron"}:                  -30.000000,
+	{"P", "eogonek"}:                  -30.000000,
+	{"P", "o"}:                        -40.000000,
+	{"P", "oacute"}:                   -40.000000,
+	{"P", "ocircumflex"}:              -40.000000,
+	{"P", "odieresis"}:                -40.000000,
+	{"P", "ograve"}:                   -40.000000,
+	{"P", "ohungarumlaut"}:            -40.000000,
+	{"P", "omacron"}:                  -40.000000,
+	{"P", "oslash"}:                   -40.000000,
+	{"P", "otilde"}:                   -40.000000,
+	{"P", "period"}:                   -120.000000,
+	{"Q", "U"}:                        -10.000000,
+	{"Q", "Uacute"}:                   -10.000000,
+	{"Q", "Ucircumflex"}:              -10.000000,
+	{"Q", "Udieresis"}:                -10.000000,
+	{"Q", "Ugrave"}:                   -10.000000,
+	{"Q", "Uhungarumlaut"}:            -10.000000,
+	{"Q", "Umacron"}:                  -10.000000,
+	{"Q", "Uogonek"}:                  -10.000000,
+	{"Q", "Uring"}:                    -10.000000,
+	{"Q", "comma"}:                    20.000000,
+	{"Q", "period"}:                   20.000000,
+	{"R", "O"}:                        -20.000000,
+	{"R", "Oacute"}:                   -20.000000,
+	{"R", "Ocircumflex"}:              -20.000000,
+	{"R", "Odieresis"}:                -20.000000,
+	{"R", "Ograve"}:                   -20.000000,
+	{"R", "Ohungarumlaut"}:            -20.000000,
+	{"R", "Omacron"}:                  -20.000000,
+	{"R", "Oslash"}:                   -20.000000,
+	{"R", "Otilde"}:                   -20.000000,
+	{"R", "T"}:                        -20.000000,
+	{"R", "Tcaron"}:                   -20.000000,
+	{"R", "Tcommaaccent"}:             -20.000000,
+	{"R", "U"}:                        -20.000000,
+	{"R", "Uacute"}:                   -20.000000,
+	{"R", "Ucircumflex"}:              -20.000000,
+	{"R", "Udieresis"}:                -20.000000,
+	{"R", "Ugrave"}:                   -20.000000,
+	{"R", "Uhungarumlaut"}:            -20.000000,
+	{"R", "Umacron"}:                  -20.000000,
+	{"R", "Uogonek"}:                  -20.000000,
+	{"R", "Uring"}:                    -20.000000,
+	{"R", "V"}:                        -50.000000,
+	{"R", "W"}:                        -40.000000,
+	{"R", "Y"}:                        -50.000000,
+	{"R", "Yacute"}:                   -50.000000,
+	{"R", "Ydieresis"}:                -50.000000,
+	{"Racute", "O"}:                   -20.000000,
+	{"Racute", "Oacute"}:              -20.000000,
+	{"Racute", "Ocircumflex"}:         -20.000000,
+	{"Racute", "Odieresis"}:           -20.000000,
+	{"Racute", "Ograve"}:              -20.000000,
+	{"Racute", "Ohungarumlaut"}:       -20.000000,
+	{"Racute", "Omacron"}:             -20.000000,
+	{"Racute", "Oslash"}:              -20.000000,
+	{"Racute", "Otilde"}:              -20.000000,
+	{"Racute", "T"}:                   -20.000000,
+	{"Racute", "Tcaron"}:              -20.000000,
+	{"Racute", "Tcommaaccent"}:        -20.000000,
+	{"Racute", "U"}:                   -20.000000,
+	{"Racute", "Uacute"}:              -20.000000,
+	{"Racute", "Ucircumflex"}:         -20.000000,
+	{"Racute", "Udieresis"}:           -20.000000,
+	{"Racute", "Ugrave"}:              -20.000000,
+	{"Racute", "Uhungarumlaut"}:       -20.000000,
+	{"Racute", "Umacron"}:             -20.000000,
+	{"Racute", "Uogonek"}:             -20.000000,
+	{"Racute", "Uring"}:               -20.000000,
+	{"Racute", "V"}:                   -50.000000,
+	{"Racute", "W"}:                   -40.000000,
+	{"Racute", "Y"}:                   -50.000000,
+	{"Racute", "Yacute"}:              -50.000000,
+	{"Racute", "Ydieresis"}:           -50.000000,
+	{"Rcaron", "O"}:                   -20.000000,
+	{"Rcaron", "Oacute"}:              -20.000000,
+	{"Rcaron", "Ocircumflex"}:         -20.000000,
+	{"Rcaron", "Odieresis"}:           -20.000000,
+	{"Rcaron", "Ograve"}:              -20.000000,
+	{"Rcaron", "Ohungarumlaut"}:       -20.000000,
+	{"Rcaron", "Omacron"}:             -20.000000,
+	{"Rcaron", "Oslash"}:              -20.000000,
+	{"Rcaron", "Otilde"}:              -20.000000,
+	{"Rcaron", "T"}:                   -20.000000,
+	{"Rcaron", "Tcaron"}:              -20.000000,
+	{"Rcaron", "Tcommaaccent"}:        -20.000000,
+	{"Rcaron", "U"}:                   -20.000000,
+	{"Rcaron", "Uacute"}:              -20.000000,
+	{"Rcaron", "Ucircumflex"}:         -20.000000,
+	{"Rcaron", "Udieresis"}:           -20.000000,
+	{"Rcaron", "Ugrave"}:              -20.000000,
+	{"Rcaron", "Uhungarumlaut"}:       -20.000000,
+	{"Rcaron", "Umacron"}:             -20.000000,
+	{"Rcaron", "Uogonek"}:             -20.000000,
+	{"Rcaron", "Uring"}:               -20.000000,
+	{"Rcaron", "V"}:                   -50.000000,
+	{"Rcaron", "W"}:                   -40.000000,
+	{"Rcaron", "Y"}:                   -50.000000,
+	{"Rcaron", "Yacute"}:              -50.000000,
+	{"Rcaron", "Ydieresis"}:           -50.000000,
+	{"Rcommaaccent", "O"}:             -20.000000,
+	{"Rcommaaccent", "Oacute"}:        -20.000000,
+	{"Rcommaaccent", "Ocircumflex"}:   -20.000000,
+	{"Rcommaaccent", "Odieresis"}:     -20.000000,
+	{"Rcommaaccent", "Ograve"}:        -20.000000,
+	{"Rcommaaccent", "Ohungarumlaut"}: -20.000000,
+	{"Rcommaaccent", "Omacron"}:       -20.000000,
+	{"Rcommaaccent", "Oslash"}:        -20.000000,
+	{"Rcommaaccent", "Otilde"}:        -20.000000,
+	{"Rcommaaccent", "T"}:             -20.000000,
+	{"Rcommaaccent", "Tcaron"}:        -20.000000,
+	{"Rcommaaccent", "Tcommaaccent"}:  -20.000000,
+	{"Rcommaaccent", "U"}:             -20.000000,
+	{"Rcommaaccent", "Uacute"}:        -20.000000,
+	{"Rcommaaccent", "Ucircumflex"}:   -20.000000,
+	{"Rcommaaccent", "Udieresis"}:     -20.000000,
+	{"Rcommaaccent", "Ugrave"}:        -20.000000,
+	{"Rcommaaccent", "Uhungarumlaut"}: -20.000000,
+	{"Rcommaaccent", "Umacron"}:       -20.000000,
+	{"Rcommaaccent", "Uogonek"}:       -20.000000,
+	{"Rcommaaccent", "Uring"}:         -20.000000,
+	{"Rcommaaccent", "V"}:             -50.000000,
+	{"Rcommaaccent", "W"}:             -40.000000,
+	{"Rcommaaccent", "Y"}:             -50.000000,
+	{"Rcommaaccent", "Yacute"}:        -50.000000,
+	{"Rcommaaccent", "Ydieresis"}:     -50.000000,
+	{"T", "A"}:                        -90.000000,
+	{"T", "Aacute"}:                   -90.000000,
+	{"T", "Abreve"}:                   -90.000000,
+	{"T", "Acircumflex"}:              -90.000000,
+	{"T", "Adieresis"}:                -90.000000,
+	{"T", "Agrave"}:                   -90.000000,
+	{"T", "Amacron"}:                  -90.000000,
+	{"T", "Aogonek"}:                  -90.000000,
+	{"T", "Aring"}:                    -90.000000,
+	{"T", "Atilde"}:                   -90.000000,
+	{"T", "O"}:                        -40.000000,
+	{"T", "Oacute"}:                   -40.000000,
+	{"T", "Ocircumflex"}:              -40.000000,
+	{"T", "Odieresis"}:                -40.000000,
+	{"T", "Ograve"}:                   -40.000000,
+	{"T", "Ohungarumlaut"}:            -40.000000,
+	{"T", "Omacron"}:                  -40.000000,
+	{"T", "Oslash"}:                   -40.000000,
+	{"T", "Otilde"}:                   -40.000000,
+	{"T", "a"}:                        -80.000000,
+	{"T", "aacute"}:                   -80.000000,
+	{"T", "abreve"}:                   -80.000000,
+	{"T", "acircumflex"}:              -80.000000,
+	{"T", "adieresis"}:                -80.000000,
+	{"T", "agrave"}:                   -80.000000,
+	{"T", "amacron"}:                  -80.000000,
+	{"T", "aogonek"}:                  -80.000000,
+	{"T", "aring"}:                    -80.000000,
+	{"T", "atilde"}:                   -80.000000,
+	{"T", "colon"}:                    -40.000000,
+	{"T", "comma"}:                    -80.000000,
+	{"T", "e"}:                        -60.000000,
+	{"T", "eacute"}:                   -60.000000,
+	{"T", "ecaron"}:                   -60.000000,
+	{"T", "ecircumflex"}:              -60.000000,
+	{"T", "edieresis"}:                -60.000000,
+	{"T", "edotaccent"}:               -60.000000,
+	{"T", "egrave"}:                   -60.000000,
+	{"T", "emacron"}:                  -60.000000,
+	{"T", "eogonek"}:                  -60.000000,
+	{"T", "hyphen"}:                   -120.000000,
+	{"T", "o"}:                        -80.000000,
+	{"T", "oacute"}:                   -80.000000,
+	{"T", "ocircumflex"}:              -80.000000,
+	{"T", "odieresis"}:                -80.000000,
+	{"T", "ograve"}:                   -80.000000,
+	{"T", "ohungarumlaut"}:            -80.000000,
+	{"T", "omacron"}:                  -80.000000,
+	{"T", "oslash"}:                   -80.000000,
+	{"T", "otilde"}:                   -80.000000,
+	{"T", "period"}:                   -80.000000,
+	{"T", "r"}:                        -80.000000,
+	{"T", "racute"}:                   -80.000000,
+	{"T", "rcommaaccent"}:             -80.000000,
+	{"T", "semicolon"}:                -40.000000,
+	{"T", "u"}:                        -90.000000,
+	{"T", "uacute"}:                   -90.000000,
+	{"T", "ucircumflex"}:              -90.000000,
+	{"T", "udieresis"}:                -90.000000,
+	{"T", "ugrave"}:                   -90.000000,
+	{"T", "uhungarumlaut"}:            -90.000000,
+	{"T", "umacron"}:                  -90.000000,
+	{"T", "uogonek"}:                  -90.000000,
+	{"T", "uring"}:                    -90.000000,
+	{"T", "w"}:                        -60.000000,
+	{"T", "y"}:                        -60.000000,
+	{"T", "yacute"}:                   -60.000000,
+	{"T", "ydieresis"}:                -60.000000,
+	{"Tcaron", "A"}:                   -90.000000,
+	{"Tcaron", "Aacute"}:              -90.000000,
+	{"Tcaron", "Abreve"}:              -90.000000,
+	{"Tcaron", "Acircumflex"}:         -90.000000,
+	{"Tcaron", "Adieresis"}:           -90.000000,
+	{"Tcaron", "Agrave"}:              -90.000000,
+	{"Tcaron", "Amacron"}:             -90.000000,
+	{"Tcaron", "Aogonek"}:             -90.000000,
+	{"Tcaron", "Aring"}:               -90.000000,
+	{"Tcaron", "Atilde"}:              -90.000000,
+	{"Tcaron", "O"}:                   -40.000000,
+	{"Tcaron", "Oacute"}:              -40.000000,
+	{"Tcaron", "Ocircumflex"}:         -40.000000,
+	{"Tcaron", "Odieresis"}:           -40.000000,
+	{"Tcaron", "Ograve"}:              -40.000000,
+	{"Tcaron", "Ohungarumlaut"}:       -40.000000,
+	{"Tcaron", "Omacron"}:             -40.000000,
+	{"Tcaron", "Oslash"}:              -40.000000,
+	{"Tcaron", "Otilde"}:              -40.000000,
+	{"Tcaron", "a"}:                   -80.000000,
+	{"Tcaron", "aacute"}:              -80.000000,
+	{"Tcaron", "abreve"}:              -80.000000,
+	{"Tcaron", "acircumflex"}:         -80.000000,
+	{"Tcaron", "adieresis"}:           -80.000000,
+	{"Tcaron", "agrave"}:              -80.000000,
+	{"Tcaron", "amacron"}:             -80.000000,
+	{"Tcaron", "aogonek"}:             -80.000000,
+	{"Tcaron", "aring"}:               -80.000000,
+	{"Tcaron", "atilde"}:              -80.000000,
+	{"Tcaron", "colon"}:               -40.000000,
+	{"Tcaron", "comma"}:               -80.000000,
+	{"Tcaron", "e"}:                   -60.000000,
+	{"Tcaron", "eacute"}:              -60.000000,
+	{"Tcaron", "ecaron"}:              -60.000000,
+	{"Tcaron", "ecircumflex"}:         -60.000000,
+	{"Tcaron", "edieresis"}:           -60.000000,
+	{"Tcaron", "edotaccent"}:          -60.000000,
+	{"Tcaron", "egrave"}:              -60.000000,
+	{"Tcaron", "emacron"}:             -60.000000,
+	{"Tcaron", "eogonek"}:             -60.000000,
+	{"Tcaron", "hyphen"}:              -120.000000,
+	{"Tcaron", "o"}:                   -80.000000,
+	{"Tcaron", "oacute"}:              -80.000000,
+	{"Tcaron", "ocircumflex"}:         -80.000000,
+	{"Tcaron", "odieresis"}:           -80.000000,
+	{"Tcaron", "ograve"}:              -80.000000,
+	{"Tcaron", "ohungarumlaut"}:       -80.000000,
+	{"Tcaron", "omacron"}:             -80.000000,
+	{"Tcaron", "oslash"}:              -80.000000,
+	{"Tcaron", "otilde"}:              -80.000000,
+	{"Tcaron", "period"}:              -80.000000,
+	{"Tcaron", "r"}:                   -80.000000,
+	{"Tcaron", "racute"}:              -80.000000,
+	{"Tcaron", "rcommaaccent"}:        -80.000000,
+	{"Tcaron", "semicolon"}:           -40.000000,
+	{"Tcaron", "u"}:                   -90.000000,
+	{"Tcaron", "uacute"}:              -90.000000,
+	{"Tcaron", "ucircumflex"}:         -90.000000,
+	{"Tcaron", "udieresis"}:           -90.000000,
+	{"Tcaron", "ugrave"}:              -90.000000,
+	{"Tcaron", "uhungarumlaut"}:       -90.000000,
+	{"Tcaron", "umacron"}:             -90.000000,
+	{"Tcaron", "uogonek"}:             -90.000000,
+	{"Tcaron", "uring"}:               -90.000000,
+	{"Tcaron", "w"}:                   -60.000000,
+	{"Tcaron", "y"}:                   -60.000000,
+	{"Tcaron", "yacute"}:              -60.000000,
+	{"Tcaron", "ydieresis"}:           -60.000000,
+	{"Tcommaaccent", "A"}:             -90.000000,
+	{"Tcommaaccent", "Aacute"}:        -90.000000,
+	{"Tcommaaccent", "Abreve"}:        -90.000000,
+	{"Tcommaaccent", "Acircumflex"}:   -90.000000,
+	{"Tcommaaccent", "Adieresis"}:     -90.000000,
+	{"Tcommaaccent", "Agrave"}:        -90.000000,
+	{"Tcommaaccent", "Amacron"}:       -90.000000,
+	{"Tcommaaccent", "Aogonek"}:       -90.000000,
+	{"Tcommaaccent", "Aring"}:         -90.000000,
+	{"Tcommaaccent", "Atilde"}:        -90.000000,
+	{"Tcommaaccent", "O"}:             -40.000000,
+	{"Tcommaaccent", "Oacute"}:        -40.000000,
+	{"Tcommaaccent", "Ocircumflex"}:   -40.000000,
+	{"Tcommaaccent", "Odieresis"}:     -40.000000,
+	{"Tcommaaccent", "Ograve"}:        -40.000000,
+	{"Tcommaaccent", "Ohungarumlaut"}: -40.000000,
+	{"Tcommaaccent", "Omacron"}:       -40.000000,
+	{"Tcommaaccent", "Oslash"}:        -40.000000,
+	{"Tcommaaccent", "Otilde"}:        -40.000000,
+	{"Tcommaaccent", "a"}:             -80.000000,
+	{"Tcommaaccent", "aacute"}:        -80.000000,
+	{"Tcommaaccent", "abreve"}:        -80.000000,
+	{"Tcommaaccent", "acircumflex"}:   -80.000000,
+	{"Tcommaaccent", "adieresis"}:     -80.000000,
+	{"Tcommaaccent", "agrave"}:        -80.000000,
+	{"Tcommaaccent", "amacron"}:       -80.000000,
+	{"Tcommaaccent", "aogonek"}:       -80.000000,
+	{"Tcommaaccent", "aring"}:         -80.000000,
+	{"Tcommaaccent", "atilde"}:        -80.000000,
+	{"Tcommaaccent", "colon"}:         -40.000000,
+	{"Tcommaaccent", "comma"}:         -80.000000,
+	{"Tcommaaccent", "e"}:             -60.000000,
+	{"Tcommaaccent", "eacute"}:        -60.000000,
+	{"Tcommaaccent", "ecaron"}:        -60.000000,
+	{"Tcommaaccent", "ecircumflex"}:   -60.000000,
+	{"Tcommaaccent", "edieresis"}:     -60.000000,
+	{"Tcommaaccent", "edotaccent"}:    -60.000000,
+	{"Tcommaaccent", "egrave"}:        -60.000000,
+	{"Tcommaaccent", "emacron"}:       -60.000000,
+	{"Tcommaaccent", "eogonek"}:       -60.000000,
+	{"Tcommaaccent", "hyphen"}:        -120.000000,
+	{"Tcommaaccent", "o"}:             -80.000000,
+	{"Tcommaaccent", "oacute"}:        -80.000000,
+	{"Tcommaaccent", "ocircumflex"}:   -80.000000,
+	{"Tcommaaccent", "odieresis"}:     -80.000000,
+	{"Tcommaaccent", "ograve"}:        -80.000000,
+	{"Tcommaaccent", "ohungarumlaut"}: -80.000000,
+	{"Tcommaaccent", "omacron"}:       -80.000000,
+	{"Tcommaaccent", "oslash"}:        -80.000000,
+	{"Tcommaaccent", "otilde"}:        -80.000000,
+	{"Tcommaaccent", "period"}:        -80.000000,
+	{"Tcommaaccent", "r"}:             -80.000000,
+	{"Tcommaaccent", "racute"}:        -80.000000,
+	{"Tcommaaccent", "rcommaaccent"}:  -80.000000,
+	{"Tcommaaccent", "semicolon"}:     -40.000000,
+	{"Tcommaaccent", "u"}:             -90.000000,
+	{"Tcommaaccent", "uacute"}:        -90.000000,
+	{"Tcommaaccent", "ucircumflex"}:   -90.000000,
+	{"Tcommaaccent", "udieresis"}:     -90.000000,
+	{"Tcommaaccent", "ugrave"}:        -90.000000,
+	{"Tcommaaccent", "uhungarumlaut"}: -90.000000,
+	{"Tcommaaccent", "umacron"}:       -90.000000,
+	{"Tcommaaccent", "uogonek"}:       -90.000000,
+	{"Tcommaaccent", "uring"}:         -90.000000,
+	{"Tcommaaccent", "w"}:             -60.000000,
+	{"Tcommaaccent", "y"}:             -60.000000,
+	{"Tcommaaccent", "yacute"}:        -60.000000,
+	{"Tcommaaccent", "ydieresis"}:     -60.000000,
+	{"U", "A"}:                        -50.000000,
+	{"U", "Aacute"}:                   -50.000000,
+	{"U", "Abreve"}:                   -50.000000,
+	{"U", "Acircumflex"}:              -50.000000,
+	{"U", "Adieresis"}:                -50.000000,
+	{"U", "Agrave"}:                   -50.000000,
+	{"U", "Amacron"}:                  -50.000000,
+	{"U", "Aogonek"}:                  -50.000000,
+	{"U", "Aring"}:                    -50.000000,
+	{"U", "Atilde"}:                   -50.000000,
+	{"U", "comma"}:                    -30.000000,
+	{"U", "period"}:                   -30.000000,
+	{"Uacute", "A"}:                   -50.000000,
+	{"Uacute", "Aacute"}:              -50.000000,
+	{"Uacute", "Abreve"}:              -50.000000,
+	{"Uacute", "Acircumflex"}:         -50.000000,
+	{"Uacute", "Adieresis"}:           -50.000000,
+	{"Uacute", "Agrave"}:              -50.000000,
+	{"Uacute", "Amacron"}:             -50.000000,
+	{"Uacute", "Aogonek"}:             -50.000000,
+	{"Uacute", "Aring"}:               -50.000000,
+	{"Uacute", "Atilde"}:              -50.000000,
+	{"Uacute", "comma"}:               -30.000000,
+	{"Uacute", "period"}:              -30.000000,
+	{"Ucircumflex", "A"}:              -50.000000,
+	{"Ucircumflex", "Aacute"}:         -50.000000,
+	{"Ucircumflex", "Abreve"}:         -50.000000,
+	{"Ucircumflex", "Acircumflex"}:    -50.000000,
+	{"Ucircumflex", "Adieresis"}:      -50.000000,
+	{"Ucircumflex", "Agrave"}:         -50.000000,
+	{"Ucircumflex", "Amacron"}:        -50.000000,
+	{"Ucircumflex", "Aogonek"}:        -50.000000,
+	{"Ucircumflex", "Aring"}:          -50.000000,
+	{"Ucircumflex", "Atilde"}:         -50.000000,
+	{"Ucircumflex", "comma"}:          -30.000000,
+	{"Ucircumflex", "period"}:         -30.000000,
+	{"Udieresis", "A"}:                -50.000000,
+	{"Udieresis", "Aacute"}:           -50.000000,
+	{"Udieresis", "Abreve"}:           -50.000000,
+	{"Udieresis", "Acircumflex"}:      -50.000000,
+	{"Udieresis", "Adieresis"}:        -50.000000,
+	{"Udieresis", "Agrave"}:           -50.000000,
+	{"Udieresis", "Amacron"}:          -50.000000,
+	{"Udieresis", "Aogonek"}:          -50.000000,
+	{"Udieresis", "Aring"}:            -50.000000,
+	{"Udieresis", "Atilde"}:           -50.000000,
+	{"Udieresis", "comma"}:            -30.000000,
+	{"Udieresis", "period"}:           -30.000000,
+	{"Ugrave", "A"}:                   -50.000000,
+	{"Ugrave", "Aacute"}:              -50.000000,
+	{"Ugrave", "Abreve"}:              -50.000000,
+	{"Ugrave", "Acircumflex"}:         -50.000000,
+	{"Ugrave", "Adieresis"}:           -50.000000,
+	{"Ugrave", "Agrave"}:              -50.000000,
+	{"Ugrave", "Amacron"}:             -50.000000,
+	{"Ugrave", "Aogonek"}:             -50.000000,
+	{"Ugrave", "Aring"}:               -50.000000,
+	{"Ugrave", "Atilde"}:              -50.000000,
+	{"Ugrave", "comma"}:               -30.000000,
+	{"Ugrave", "period"}:              -30.000000,
+	{"Uhungarumlaut", "A"}:            -50.000000,
+	{"Uhungarumlaut", "Aacute"}:       -50.000000,
+	{"Uhungarumlaut", "Abreve"}:       -50.000000,
+	{"Uhungarumlaut", "Acircumflex"}:  -50.000000,
+	{"Uhungarumlaut", "Adieresis"}:    -50.000000,
+	{"Uhungarumlaut", "Agrave"}:       -50.000000,
+	{"Uhungarumlaut", "Amacron"}:      -50.000000,
+	{"Uhungarumlaut", "Aogonek"}:      -50.000000,
+	{"Uhungarumlaut", "Aring"}:        -50.000000,
+	{"Uhungarumlaut", "Atilde"}:       -50.000000,
+	{"Uhungarumlaut", "comma"}:        -30.000000,
+	{"Uhungarumlaut", "period"}:       -30.000000,
+	{"Umacron", "A"}:                  -50.000000,
+	{"Umacron", "Aacute"}:             -50.000000,
+	{"Umacron", "Abreve"}:             -50.000000,
+	{"Umacron", "Acircumflex"}:        -50.000000,
+	{"Umacron", "Adieresis"}:          -50.000000,
+	{"Umacron", "Agrave"}:             -50.000000,
+	{"Umacron", "Amacron"}:            -50.000000,
+	{"Umacron", "Aogonek"}:            -50.000000,
+	{"Umacron", "Aring"}:              -50.000000,
+	{"Umacron", "Atilde"}:             -50.000000,
+	{"Umacron", "comma"}:              -30.000000,
+	{"Umacron", "period"}:             -30.000000,
+	{"Uogonek", "A"}:                  -50.000000,
+	{"Uogonek", "Aacute"}:             -50.000000,
+	{"Uogonek", "Abreve"}:             -50.000000,
+	{"Uogonek", "Acircumflex"}:        -50.000000,
+	{"Uogonek", "Adieresis"}:          -50.000000,
+	{"Uogonek", "Agrave"}:             -50.000000,
+	{"Uogonek", "Amacron"}:            -50.000000,
+	{"Uogonek", "Aogonek"}:            -50.000000,
+	{"Uogonek", "Aring"}:              -50.000000,
+	{"Uogonek", "Atilde"}:             -50.000000,
+	{"Uogonek", "comma"}:              -30.000000,
+	{"Uogonek", "period"}:             -30.000000,
+	{"Uring", "A"}:                    -50.000000,
+	{"Uring", "Aacute"}:               -50.000000,
+	{"Uring", "Abreve"}:               -50.000000,
+	{"Uring", "Acircumflex"}:          -50.000000,
+	{"Uring", "Adieresis"}:            -50.000000,
+	{"Uring", "Agrave"}:               -50.000000,
+	{"Uring", "Amacron"}:              -50.000000,
+	{"Uring", "Aogonek"}:              -50.000000,
+	{"Uring", "Aring"}:                -50.000000,
+	{"Uring", "Atilde"}:               -50.000000,
+	{"Uring", "comma"}:                -30.000000,
+	{"Uring", "period"}:               -30.000000,
+	{"V", "A"}:                        -80.000000,
+	{"V", "Aacute"}:                   -80.000000,
+	{"V", "Abreve"}:                   -80.000000,
+	{"V", "Acircumflex"}:              -80.000000,
+	{"V", "Adieresis"}:                -80.000000,
+	{"V", "Agrave"}:                   -80.000000,
+	{"V", "Amacron"}:                  -80.000000,
+	{"V", "Aogonek"}:                  -80.000000,
+	{"V", "Aring"}:                    -80.000000,
+	{"V", "Atilde"}:                   -80.000000,
+	{"V", "G"}:                        -50.000000,
+	{"V", "Gbreve"}:                   -50.000000,
+	{"V", "Gcommaaccent"}:             -50.000000,
+	{"V", "O"}:                        -50.000000,
+	{"V", "Oacute"}:                   -50.000000,
+	{"V", "Ocircumflex"}:              -50.000000,
+	{"V", "Odieresis"}:                -50.000000,
+	{"V", "Ograve"}:                   -50.000000,
+	{"V", "Ohungarumlaut"}:            -50.000000,
+	{"V", "Omacron"}:                  -50.000000,
+	{"V", "Oslash"}:                   -50.000000,
+	{"V", "Otilde"}:                   -50.000000,
+	{"V", "a"}:                        -60.000000,
+	{"V", "aacute"}:                   -60.000000,
+	{"V", "abreve"}:                   -60.000000,
+	{"V", "acircumflex"}:              -60.000000,
+	{"V", "adieresis"}:                -60.000000,
+	{"V", "agrave"}:                   -60.000000,
+	{"V", "amacron"}:                  -60.000000,
+	{"V", "aogonek"}:                  -60.000000,
+	{"V", "aring"}:                    -60.000000,
+	{"V", "atilde"}:                   -60.000000,
+	{"V", "colon"}:                    -40.000000,
+	{"V", "comma"}:                    -120.000000,
+	{"V", "e"}:                        -50.000000,
+	{"V", "eacute"}:                   -50.000000,
+	{"V", "ecaron"}:                   -50.000000,
+	{"V", "ecircumflex"}:              -50.000000,
+	{"V", "edieresis"}:                -50.000000,
+	{"V", "edotaccent"}:               -50.000000,
+	{"V", "egrave"}:                   -50.000000,
+	{"V", "emacron"}:                  -50.000000,
+	{"V", "eogonek"}:                  -50.000000,
+	{"V", "hyphen"}:                   -80.000000,
+	{"V", "o"}:                        -90.000000,
+	{"V", "oacute"}:                   -90.000000,
+	{"V", "ocircumflex"}:              -90.000000,
+	{"V", "odieresis"}:                -90.000000,
+	{"V", "ograve"}:                   -90.000000,
+	{"V", "ohungarumlaut"}:            -90.000000,
+	{"V", "omacron"}:                  -90.000000,
+	{"V", "oslash"}:                   -90.000000,
+	{"V", "otilde"}:                   -90.000000,
+	{"V", "period"}:                   -120.000000,
+	{"V", "semicolon"}:                -40.000000,
+	{"V", "u"}:                        -60.000000,
+	{"V", "uacute"}:                   -60.000000,
+	{"V", "ucircumflex"}:              -60.000000,
+	{"V", "udieresis"}:                -60.000000,
+	{"V", "ugrave"}:                   -60.000000,
+	{"V", "uhungarumlaut"}:            -60.000000,
+	{"V", "umacron"}:                  -60.000000,
+	{"V", "uogonek"}:                  -60.000000,
+	{"V", "uring"}:                    -60.000000,
+	{"W", "A"}:                        -60.000000,
+	{"W", "Aacute"}:                   -60.000000,
+	{"W", "Abreve"}:                   -60.000000,
+	{"W", "Acircumflex"}:              -60.000000,
+	{"W", "Adieresis"}:                -60.000000,
+	{"W", "Agrave"}:                   -60.000000,
+	{"W", "Amacron"}:                  -60.000000,
+	{"W", "Aogonek"}:                  -60.000000,
+	{"W", "Aring"}:                    -60.000000,
+	{"W", "Atilde"}:                   -60.000000,
+	{"W", "O"}:                        -20.000000,
+	{"W", "Oacute"}:                   -20.000000,
+	{"W", "Ocircumflex"}:              -20.000000,
+	{"W", "Odieresis"}:                -20.000000,
+	{"W", "Ograve"}:                   -20.000000,
+	{"W", "Ohungarumlaut"}:            -20.000000,
+	{"W", "Omacron"}:                  -20.000000,
+	{"W", "Oslash"}:                   -20.000000,
+	{"W", "Otilde"}:                   -20.000000,
+	{"W", "a"}:                        -40.000000,
+	{"W", "aacute"}:                   -40.000000,
+	{"W", "abreve"}:                   -40.000000,
+	{"W", "acircumflex"}:              -40.000000,
+	{"W", "adieresis"}:                -40.000000,
+	{"W", "agrave"}:                   -40.000000,
+	{"W", "amacron"}:                  -40.000000,
+	{"W", "aogonek"}:                  -40.000000,
+	{"W", "aring"}:                    -40.000000,
+	{"W", "atilde"}:                   -40.000000,
+	{"W", "colon"}:                    -10.000000,
+	{"W", "comma"}:                    -80.000000,
+	{"W", "e"}:                        -35.000000,
+	{"W", "eacute"}:                   -35.000000,
+	{"W", "ecaron"}:                   -35.000000,
+	{"W", "ecircumflex"}:              -35.000000,
+	{"W", "edieresis"}:                -35.000000,
+	{"W", "edotaccent"}:               -35.000000,
+	{"W", "egrave"}:                   -35.000000,
+	{"W", "emacron"}:                  -35.000000,
+	{"W", "eogonek"}:                  -35.000000,
+	{"W", "hyphen"}:                   -40.000000,
+	{"W", "o"}:                        -60.000000,
+	{"W", "oacute"}:                   -60.000000,
+	{"W", "ocircumflex"}:              -60.000000,
+	{"W", "odieresis"}:                -60.000000,
+	{"W", "ograve"}:                   -60.000000,
+	{"W", "ohungarumlaut"}:            -60.000000,
+	{"W", "omacron"}:                  -60.000000,
+	{"W", "oslash"}:                   -60.000000,
+	{"W", "otilde"}:                   -60.000000,
+	{"W", "period"}:                   -80.000000,
+	{"W", "semicolon"}:                -10.000000,
+	{"W", "u"}:                        -45.000000,
+	{"W", "uacute"}:                   -45.000000,
+	{"W", "ucircumflex"}:              -45.000000,
+	{"W", "udieresis"}:                -45.000000,
+	{"W", "ugrave"}:                   -45.000000,
+	{"W", "uhungarumlaut"}:            -45.000000,
+	{"W", "umacron"}:                  -45.000000,
+	{"W", "uogonek"}:                  -45.000000,
+	{"W", "uring"}:                    -45.000000,
+	{"W", "y"}:                        -20.000000,
+	{"W", "yacute"}:                   -20.000000,
+	{"W", "ydieresis"}:                -20.000000,
+	{"Y", "A"}:                        -110.000000,
+	{"Y", "Aacute"}:                   -110.000000,
+	{"Y", "Abreve"}:                   -110.000000,
+	{"Y", "Acircumflex"}:              -110.000000,
+	{"Y", "Adieresis"}:                -110.000000,
+	{"Y", "Agrave"}:                   -110.000000,
+	{"Y", "Amacron"}:                  -110.000000,
+	{"Y", "Aogonek"}:                  -110.000000,
+	{"Y", "Aring"}:                    -110.000000,
+	{"Y", "Atilde"}:                   -110.000000,
+	{"Y", "O"}:                        -70.000000,
+	{"Y", "Oacute"}:                   -70.000000,
+	{"Y", "Ocircumflex"}:              -70.000000,
+	{"Y", "Odieresis"}:                -70.000000,
+	{"Y", "Ograve"}:                   -70.000000,
+	{"Y", "Ohungarumlaut"}:            -70.000000,
+	{"Y", "Omacron"}:                  -70.000000,
+	{"Y", "Oslash"}:                   -70.000000,
+	{"Y", "Otilde"}:                   -70.000000,
+	{"Y", "a"}:                        -90.000000,
+	{"Y", "aacute"}:                   -90.000000,
+	{"Y", "abreve"}:                   -90.000000,
+	{"Y", "acircumflex"}:              -90.000000,
+	{"Y", "adieresis"}:                -90.000000,
+	{"Y", "agrave"}:                   -90.000000,
+	{"Y", "amacron"}:                  -90.000000,
+	{"Y", "aogonek"}:                  -90.000000,
+	{"Y", "aring"}:                    -90.000000,
+	{"Y", "atilde"}:                   -90.000000,
+	{"Y", "colon"}:                    -50.000000,
+	{"Y", "comma"}:                    -100.000000,
+	{"Y", "e"}:                        -80.000000,
+	{"Y", "eacute"}:                   -80.000000,
+	{"Y", "ecaron"}:                   -80.000000,
+	{"Y", "ecircumflex"}:              -80.000000,
+	{"Y", "edieresis"}:                -80.000000,
+	{"Y", "edotaccent"}:               -80.000000,
+	{"Y", "egrave"}:                   -80.000000,
+	{"Y", "emacron"}:                  -80.000000,
+	{"Y", "eogonek"}:                  -80.000000,
+	{"Y", "o"}:                        -100.000000,
+	{"Y", "oacute"}:                   -100.000000,
+	{"Y", "ocircumflex"}:              -100.000000,
+	{"Y", "odieresis"}:                -100.000000,
+	{"Y", "ograve"}:                   -100.000000,
+	{"Y", "ohungarumlaut"}:            -100.000000,
+	{"Y", "omacron"}:                  -100.000000,
+	{"Y", "oslash"}:                   -100.000000,
+	{"Y", "otilde"}:                   -100.000000,
+	{"Y", "period"}:                   -100.000000,
+	{"Y", "semicolon"}:                -50.000000,
+	{"Y", "u"}:                        -100.000000,
+	{"Y", "uacute"}:                   -100.000000,
+	{"Y", "ucircumflex"}:              -100.000000,
+	{"Y", "udieresis"}:                -100.000000,
+	{"Y", "ugrave"}:                   -100.000000,
+	{"Y", "uhungarumlaut"}:            -100.000000,
+	{"Y", "umacron"}:                  -100.000000,
+	{"Y", "uogonek"}:                  -100.000000,
+	{"Y", "uring"}:                    -100.000000,
+	{"Yacute", "A"}:                   -110.000000,
+	{"Yacute", "Aacute"}:              -110.000000,
+	{"Yacute", "Abreve"}:              -110.000000,
+	{"Yacute", "Acircumflex"}:         -110.000000,
+	{"Yacute", "Adieresis"}:           -110.000000,
+	{"Yacute", "Agrave"}:              -110.000000,
+	{"Yacute", "Amacron"}:             -110.000000,
+	{"Yacute", "Aogonek"}:             -110.000000,
+	{"Yacute", "Aring"}:               -110.000000,
+	{"Yacute", "Atilde"}:              -110.000000,
+	{"Yacute", "O"}:                   -70.000000,
+	{"Yacute", "Oacute"}:              -70.000000,
+	{"Yacute", "Ocircumflex"}:         -70.000000,
+	{"Yacute", "Odieresis"}:           -70.000000,
+	{"Yacute", "Ograve"}:              -70.000000,
+	{"Yacute", "Ohungarumlaut"}:       -70.000000,
+	{"Yacute", "Omacron"}:             -70.000000,
+	{"Yacute", "Oslash"}:              -70.000000,
+	{"Yacute", "Otilde"}:              -70.000000,
+	{"Yacute", "a"}:                   -90.000000,
+	{"Yacute", "aacute"}:              -90.000000,
+	{"Yacute", "abreve"}:              -90.000000,
+	{"Yacute", "acircumflex"}:         -90.000000,
+	{"Yacute", "adieresis"}:           -90.000000,
+	{"Yacute", "agrave"}:              -90.000000,
+	{"Yacute", "amacron"}:             -90.000000,
+	{"Yacute", "aogonek"}:             -90.000000,
+	{"Yacute", "aring"}:               -90.000000,
+	{"Yacute", "atilde"}:              -90.000000,
+	{"Yacute", "colon"}:               -50.000000,
+	{"Yacute", "comma"}:               -100.000000,
+	{"Yacute", "e"}:                   -80.000000,
+	{"Yacute", "eacute"}:              -80.000000,
+	{"Yacute", "ecaron"}:              -80.000000,
+	{"Yacute", "ecircumflex"}:         -80.000000,
+	{"Yacute", "edieresis"}:           -80.000000,
+	{"Yacute", "edotaccent"}:          -80.000000,
+	{"Yacute", "egrave"}:              -80.000000,
+	{"Yacute", "emacron"}:             -80.000000,
+	{"Yacute", "eogonek"}:             -80.000000,
+	{"Yacute", "o"}:                   -100.000000,
+	{"Yacute", "oacute"}:              -100.000000,
+	{"Yacute", "ocircumflex"}:         -100.000000,
+	{"Yacute", "odieresis"}:           -100.000000,
+	{"Yacute", "ograve"}:              -100.000000,
+	{"Yacute", "ohungarumlaut"}:       -100.000000,
+	{"Yacute", "omacron"}:             -100.000000,
+	{"Yacute", "oslash"}:              -100.000000,
+	{"Yacute", "otilde"}:              -100.000000,
+	{"Yacute", "period"}:              -100.000000,
+	{"Yacute", "semicolon"}:           -50.000000,
+	{"Yacute", "u"}:                   -100.000000,
+	{"Yacute", "uacute"}:              -100.000000,
+	{"Yacute", "ucircumflex"}:         -100.000000,
+	{"Yacute", "udieresis"}:           -100.000000,
+	{"Yacute", "ugrave"}:              -100.000000,
+	{"Yacute", "uhungarumlaut"}:       -100.000000,
+	{"Yacute", "umacron"}:             -100.000000,
+	{"Yacute", "uogonek"}:             -100.000000,
+	{"Yacute", "uring"}:               -100.000000,
+	{"Ydieresis", "A"}:                -110.000000,
+	{"Ydieresis", "Aacute"}:           -110.000000,
+	{"Ydieresis", "Abreve"}:           -110.000000,
+	{"Ydieresis", "Acircumflex"}:      -110.000000,
+	{"Ydieresis", "Adieresis"}:        -110.000000,
+	{"Ydieresis", "Agrave"}:           -110.000000,
+	{"Ydieresis", "Amacron"}:          -110.000000,
+	{"Ydieresis", "Aogonek"}:          -110.000000,
+	{"Ydieresis", "Aring"}:            -110.000000,
+	{"Ydieresis", "Atilde"}:           -110.000000,
+	{"Ydieresis", "O"}:                -70.000000,
+	{"Ydieresis", "Oacute"}:           -70.000000,
+	{"Ydieresis", "Ocircumflex"}:      -70.000000,
+	{"Ydieresis", "Odieresis"}:        -70.000000,
+	{"Ydieresis", "Ograve"}:           -70.000000,
+	{"Ydieresis", "Ohungarumlaut"}:    -70.000000,
+	{"Ydieresis", "Omacron"}:          -70.000000,
+	{"Ydieresis", "Oslash"}:           -70.000000,
+	{"Ydieresis", "Otilde"}:           -70.000000,
+	{"Ydieresis", "a"}:                -90.000000,
+	{"Ydieresis", "aacute"}:           -90.000000,
+	{"Ydieresis", "abreve"}:           -90.000000,
+	{"Ydieresis", "acircumflex"}:      -90.000000,
+	{"Ydieresis", "adieresis"}:        -90.000000,
+	{"Ydieresis", "agrave"}:           -90.000000,
+	{"Ydieresis", "amacron"}:          -90.000000,
+	{"Ydieresis", "aogonek"}:          -90.000000,
+	{"Ydieresis", "aring"}:            -90.000000,
+	{"Ydieresis", "atilde"}:           -90.000000,
+	{"Ydieresis", "colon"}:            -50.000000,
+	{"Ydieresis", "comma"}:            -100.000000,
+	{"Ydieresis", "e"}:                -80.000000,
+	{"Ydieresis", "eacute"}:           -80.000000,
+	{"Ydieresis", "ecaron"}:           -80.000000,
+	{"Ydieresis", "ecircumflex"}:      -80.000000,
+	{"Ydieresis", "edieresis"}:        -80.000000,
+	{"Ydieresis", "edotaccent"}:       -80.000000,
+	{"Ydieresis", "egrave"}:           -80.000000,
+	{"Ydieresis", "emacron"}:          -80.000000,
+	{"Ydieresis", "eogonek"}:          -80.000000,
+	{"Ydieresis", "o"}:                -100.000000,
+	{"Ydieresis", "oacute"}:           -100.000000,
+	{"Ydieresis", "ocircumflex"}:      -100.000000,
+	{"Ydieresis", "odieresis"}:        -100.000000,
+	{"Ydieresis", "ograve"}:           -100.000000,
+	{"Ydieresis", "ohungarumlaut"}:    -100.000000,
+	{"Ydieresis", "omacron"}:          -100.000000,
+	{"Ydieresis", "oslash"}:           -100.000000,
+	{"Ydieresis", "otilde"}:           -100.000000,
+	{"Ydieresis", "period"}:           -100.000000,
+	{"Ydieresis", "semicolon"}:        -50.000000,
+	{"Ydieresis", "u"}:                -100.000000,
+	{"Ydieresis", "uacute"}:           -100.000000,
+	{"Ydieresis", "ucircumflex"}:      -100.000000,
+	{"Ydieresis", "udieresis"}:        -100.000000,
+	{"Ydieresis", "ugrave"}:           -100.000000,
+	{"Ydieresis", "uhungarumlaut"}:    -100.000000,
+	{"Ydieresis", "umacron"}:          -100.000000,
+	{"Ydieresis", "uogonek"}:          -100.000000,
+	{"Ydieresis", "uring"}:            -100.000000,
+	{"a", "g"}:                        -10.000000,
+	{"a", "gbreve"}:                   -10.000000,
+	{"a", "gcommaaccent"}:             -10.000000,
+	{"a", "v"}:                        -15.000000,
+	{"a", "w"}:                        -15.000000,
+	{"a", "y"}:                        -20.000000,
+	{"a", "yacute"}:                   -20.000000,
+	{"a", "ydieresis"}:                -20.000000,
+	{"aacute", "g"}:                   -10.000000,
+	{"aacute", "gbreve"}:              -10.000000,
+	{"aacute", "gcommaaccent"}:        -10.000000,
+	{"aacute", "v"}:                   -15.000000,
+	{"aacute", "w"}:                   -15.000000,
+	{"aacute", "y"}:                   -20.000000,
+	{"aacute", "yacute"}:              -20.000000,
+	{"aacute", "ydieresis"}:           -20.000000,
+	{"abreve", "g"}:                   -10.000000,
+	{"abreve", "gbreve"}:              -10.000000,
+	{"abreve", "gcommaaccent"}:        -10.000000,
+	{"abreve", "v"}:                   -15.000000,
+	{"abreve", "w"}:                   -15.000000,
+	{"abreve", "y"}:                   -20.000000,
+	{"abreve", "yacute"}:              -20.000000,
+	{"abreve", "ydieresis"}:           -20.000000,
+	{"acircumflex", "g"}:              -10.000000,
+	{"acircumflex", "gbreve"}:         -10.000000,
+	{"acircumflex", "gcommaaccent"}:   -10.000000,
+	{"acircumflex", "v"}:              -15.000000,
+	{"acircumflex", "w"}:              -15.000000,
+	{"acircumflex", "y"}:              -20.000000,
+	{"acircumflex", "yacute"}:         -20.000000,
+	{"acircumflex", "ydieresis"}:      -20.000000,
+	{"adieresis", "g"}:                -10.000000,
+	{"adieresis", "gbreve"}:           -10.000000,
+	{"adieresis", "gcommaaccent"}:     -10.000000,
+	{"adieresis", "v"}:                -15.000000,
+	{"adieresis", "w"}:                -15.000000,
+	{"adieresis", "y"}:                -20.000000,
+	{"adieresis", "yacute"}:           -20.000000,
+	{"adieresis", "ydieresis"}:        -20.000000,
+	{"agrave", "g"}:                   -10.000000,
+	{"agrave", "gbreve"}:              -10.000000,
+	{"agrave", "gcommaaccent"}:        -10.000000,
+	{"agrave", "v"}:                   -15.000000,
+	{"agrave", "w"}:                   -15.000000,
+	{"agrave", "y"}:                   -20.000000,
+	{"agrave", "yacute"}:              -20.000000,
+	{"agrave", "ydieresis"}:           -20.000000,
+	{"amacron", "g"}:                  -10.000000,
+	{"amacron", "gbreve"}:             -10.000000,
+	{"amacron", "gcommaaccent"}:       -10.000000,
+	{"amacron", "v"}:                  -15.000000,
+	{"amacron", "w"}:                  -15.000000,
+	{"amacron", "y"}:                  -20.000000,
+	{"amacron", "yacute"}:             -20.000000,
+	{"amacron", "ydieresis"}:          -20.000000,
+	{"aogonek", "g"}:                  -10.000000,
+	{"aogonek", "gbreve"}:             -10.000000,
+	{"aogonek", "gcommaaccent"}:       -10.000000,
+	{"aogonek", "v"}:                  -15.000000,
+	{"aogonek", "w"}:                  -15.000000,
+	{"aogonek", "y"}:                  -20.000000,
+	{"aogonek", "yacute"}:             -20.000000,
+	{"aogonek", "ydieresis"}:          -20.000000,
+	{"aring", "g"}:                    -10.000000,
+	{"aring", "gbreve"}:               -10.000000,
+	{"aring", "gcommaaccent"}:         -10.000000,
+	{"aring", "v"}:                    -15.000000,
+	{"aring", "w"}:                    -15.000000,
+	{"aring", "y"}:                    -20.000000,
+	{"aring", "yacute"}:               -20.000000,
+	{"aring", "ydieresis"}:            -20.000000,
+	{"atilde", "g"}:                   -10.000000,
+	{"atilde", "gbreve"}:              -10.000000,
+	{"atilde", "gcommaaccent"}:        -10.000000,
+	{"atilde", "v"}:                   -15.000000,
+	{"atilde", "w"}:                   -15.000000,
+	{"atilde", "y"}:                   -20.000000,
+	{"atilde", "yacute"}:              -20.000000,
+	{"atilde", "ydieresis"}:           -20.000000,
+	{"b", "l"}:                        -10.000000,
+	{"b", "lacute"}:                   -10.000000,
+	{"b", "lcommaaccent"}:             -10.000000,
+	{"b", "lslash"}:                   -10.000000,
+	{"b", "u"}:                        -20.000000,
+	{"b", "uacute"}:                   -20.000000,
+	{"b", "ucircumflex"}:              -20.000000,
+	{"b", "udieresis"}:                -20.000000,
+	{"b", "ugrave"}:                   -20.000000,
+	{"b", "uhungarumlaut"}:            -20.000000,
+	{"b", "umacron"}:                  -20.000000,
+	{"b", "uogonek"}:                  -20.000000,
+	{"b", "uring"}:                    -20.000000,
+	{"b", "v"}:                        -20.000000,
+	{"b", "y"}:                        -20.000000,
+	{"b", "yacute"}:                   -20.000000,
+	{"b", "ydieresis"}:                -20.000000,
+	{"c", "h"}:                        -10.000000,
+	{"c", "k"}:                        -20.000000,
+	{"c", "kcommaaccent"}:             -20.000000,
+	{"c", "l"}:                        -20.000000,
+	{"c", "lacute"}:                   -20.000000,
+	{"c", "lcommaaccent"}:             -20.000000,
+	{"c", "lslash"}:                   -20.000000,
+	{"c", "y"}:                        -10.000000,
+	{"c", "yacute"}:                   -10.000000,
+	{"c", "ydieresis"}:                -10.000000,
+	{"cacute", "h"}:                   -10.000000,
+	{"cacute", "k"}:                   -20.000000,
+	{"cacute", "kcommaaccent"}:        -20.000000,
+	{"cacute", "l"}:                   -20.000000,
+	{"cacute", "lacute"}:              -20.000000,
+	{"cacute", "lcommaaccent"}:        -20.000000,
+	{"cacute", "lslash"}:              -20.000000,
+	{"cacute", "y"}:                   -10.000000,
+	{"cacute", "yacute"}:              -10.000000,
+	{"cacute", "ydieresis"}:           -10.000000,
+	{"ccaron", "h"}:                   -10.000000,
+	{"ccaron", "k"}:                   -20.000000,
+	{"ccaron", "kcommaaccent"}:        -20.000000,
+	{"ccaron", "l"}:                   -20.000000,
+	{"ccaron", "lacute"}:              -20.000000,
+	{"ccaron", "lcommaaccent"}:        -20.000000,
+	{"ccaron", "lslash"}:              -20.000000,
+	{"ccaron", "y"}:                   -10.000000,
+	{"ccaron", "yacute"}:              -10.000000,
+	{"ccaron", "ydieresis"}:           -10.000000,
+	{"ccedilla", "h"}:                 -10.000000,
+	{"ccedilla", "k"}:                 -20.000000,
+	{"ccedilla", "kcommaaccent"}:      -20.000000,
+	{"ccedilla", "l"}:                 -20.000000,
+	{"ccedilla", "lacute"}:            -20.000000,
+	{"ccedilla", "lcommaaccent"}:      -20.000000,
+	{"ccedilla", "lslash"}:            -20.000000,
+	{"ccedilla", "y"}:                 -10.000000,
+	{"ccedilla", "yacute"}:            -10.000000,
+	{"ccedilla", "ydieresis"}:         -10.000000,
+	{"colon", "space"}:                -40.000000,
+	{"comma", "quotedblright"}:        -120.000000,
+	{"comma", "quoteright"}:           -120.000000,
+	{"comma", "space"}:                -40.000000,
+	{"d", "d"}:                        -10.000000,
+	{"d", "dcroat"}:                   -10.000000,
+	{"d", "v"}:                        -15.000000,
+	{"d", "w"}:                        -15.000000,
+	{"d", "y"}:                        -15.000000,
+	{"d", "yacute"}:                   -15.000000,
+	{"d", "ydieresis"}:                -15.000000,
+	{"dcroat", "d"}:                   -10.000000,
+	{"dcroat", "dcroat"}:              -10.000000,
+	{"dcroat", "v"}:                   -15.000000,
+	{"dcroat", "w"}:                   -15.000000,
+	{"dcroat", "y"}:                   -15.000000,
+	{"dcroat", "yacute"}:              -15.000000,
+	{"dcroat", "ydieresis"}:           -15.000000,
+	{"e", "comma"}:                    10.000000,
+	{"e", "period"}:                   20.000000,
+	{"e", "v"}:                        -15.000000,
+	{"e", "w"}:                        -15.000000,
+	{"e", "x"}:                        -15.000000,
+	{"e", "y"}:                        -15.000000,
+	{"e", "yacute"}:                   -15.000000,
+	{"e", "ydieresis"}:                -15.000000,
+	{"eacute", "comma"}:               10.000000,
+	{"eacute", "period"}:              20.000000,
+	{"eacute", "v"}:                   -15.000000,
+	{"eacute", "w"}:                   -15.000000,
+	{"eacute", "x"}:                   -15.000000,
+	{"eacute", "y"}:                   -15.000000,
+	{"eacute", "yacute"}:              -15.000000,
+	{"eacute", "ydieresis"}:           -15.000000,
+	{"ecaron", "comma"}:               10.000000,
+	{"ecaron", "period"}:              20.000000,
+	{"ecaron", "v"}:                   -15.000000,
+	{"ecaron", "w"}:                   -15.000000,
+	{"ecaron", "x"}:                   -15.000000,
+	{"ecaron", "y"}:                   -15.000000,
+	{"ecaron", "yacute"}:              -15.000000,
+	{"ecaron", "ydieresis"}:           -15.000000,
+	{"ecircumflex", "comma"}:          10.000000,
+	{"ecircumflex", "period"}:         20.000000,
+	{"ecircumflex", "v"}:              -15.000000,
+	{"ecircumflex", "w"}:              -15.000000,
+	{"ecircumflex", "x"}:              -15.000000,
+	{"ecircumflex", "y"}:              -15.000000,
+	{"ecircumflex", "yacute"}:         -15.000000,
+	{"ecircumflex", "ydieresis"}:      -15.000000,
+	{"edieresis", "comma"}:            10.000000,
+	{"edieresis", "period"}:           20.000000,
+	{"edieresis", "v"}:                -15.000000,
+	{"edieresis", "w"}:                -15.000000,
+	{"edieresis", "x"}:                -15.000000,
+	{"edieresis", "y"}:                -15.000000,
+	{"edieresis", "yacute"}:           -15.000000,
+	{"edieresis", "ydieresis"}:        -15.000000,
+	{"edotaccent", "comma"}:           10.000000,
+	{"edotaccent", "period"}:          20.000000,
+	{"edotaccent", "v"}:               -15.000000,
+	{"edotaccent", "w"}:               -15.000000,
+	{"edotaccent", "x"}:               -15.000000,
+	{"edotaccent", "y"}:               -15.000000,
+	{"edotaccent", "yacute"}:          -15.000000,
+	{"edotaccent", "ydieresis"}:       -15.000000,
+	{"egrave", "comma"}:               10.000000,
+	{"egrave", "period"}:              20.000000,
+	{"egrave", "v"}:                   -15.000000,
+	{"egrave", "w"}:                   -15.000000,
+	{"egrave", "x"}:                   -15.000000,
+	{"egrave", "y"}:                   -15.000000,
+	{"egrave", "yacute"}:              -15.000000,
+	{"egrave", "ydieresis"}:           -15.000000,
+	{"emacron", "comma"}:              10.000000,
+	{"emacron", "period"}:             20.000000,
+	{"emacron", "v"}:                  -15.000000,
+	{"emacron", "w"}:                  -15.000000,
+	{"emacron", "x"}:                  -15.000000,
+	{"emacron", "y"}:                  -15.000000,
+	{"emacron", "yacute"}:             -15.000000,
+	{"emacron", "ydieresis"}:          -15.000000,
+	{"eogonek", "comma"}:              10.000000,
+	{"eogonek", "period"}:             20.000000,
+	{"eogonek", "v"}:                  -15.000000,
+	{"eogonek", "w"}:                  -15.000000,
+	{"eogonek", "x"}:                  -15.000000,
+	{"eogonek", "y"}:                  -15.000000,
+	{"eogonek", "yacute"}:             -15.000000,
+	{"eogonek", "ydieresis"}:          -15.000000,
+	{"f", "comma"}:                    -10.000000,
+	{"f", "e"}:                        -10.000000,
+	{"f", "eacute"}:                   -10.000000,
+	{"f", "ecaron"}:                   -10.000000,
+	{"f", "ecircumflex"}:              -10.000000,
+	{"f", "edieresis"}:                -10.000000,
+	{"f", "edotaccent"}:               -10.000000,
+	{"f", "egrave"}:                   -10.000000,
+	{"f", "emacron"}:                  -10.000000,
+	{"f", "eogonek"}:                  -10.000000,
+	{"f", "o"}:                        -20.000000,
+	{"f", "oacute"}:                   -20.000000,
+	{"f", "ocircumflex"}:              -20.000000,
+	{"f", "odieresis"}:                -20.000000,
+	{"f", "ograve"}:                   -20.000000,
+	{"f", "ohungarumlaut"}:            -20.000000,
+	{"f", "omacron"}:                  -20.000000,
+	{"f", "oslash"}:                   -20.000000,
+	{"f", "otilde"}:                   -20.000000,
+	{"f", "period"}:                   -10.000000,
+	{"f", "quotedblright"}:            30.000000,
+	{"f", "quoteright"}:               30.000000,
+	{"g", "e"}:                        10.000000,
+	{"g", "eacute"}:                   10.000000,
+	{"g", "ecaron"}:                   10.000000,
+	{"g", "ecircumflex"}:              10.000000,
+	{"g", "edieresis"}:                10.000000,
+	{"g", "edotaccent"}:               10.000000,
+	{"g", "egrave"}:                   10.000000,
+	{"g", "emacron"}:                  10.000000,
+	{"g", "eogonek"}:                  10.000000,
+	{"g", "g"}:                        -10.000000,
+	{"g", "gbreve"}:                   -10.000000,
+	{"g", "gcommaaccent"}:             -10.000000,
+	{"gbreve", "e"}:                   10.000000,
+	{"gbreve", "eacute"}:              10.000000,
+	{"gbreve", "ecaron"}:              10.000000,
+	{"gbreve", "ecircumflex"}:         10.000000,
+	{"gbreve", "edieresis"}:           10.000000,
+	{"gbreve", "edotaccent"}:          10.000000,
+	{"gbreve", "egrave"}:              10.000000,
+	{"gbreve", "emacron"}:             10.000000,
+	{"gbreve", "eogonek"}:             10.000000,
+	{"gbreve", "g"}:                   -10.000000,
+	{"gbreve", "gbreve"}:              -10.000000,
+	{"gbreve", "gcommaaccent"}:        -10.000000,
+	{"gcommaaccent", "e"}:             10.000000,
+	{"gcommaaccent", "eacute"}:        10.000000,
+	{"gcommaaccent", "ecaron"}:        10.000000,
+	{"gcommaaccent", "ecircumflex"}:   10.000000,
+	{"gcommaaccent", "edieresis"}:     10.000000,
+	{"gcommaaccent", "edotaccent"}:    10.000000,
+	{"gcommaaccent", "egrave"}:        10.000000,
+	{"gcommaaccent", "emacron"}:       10.000000,
+	{"gcommaaccent", "eogonek"}:       10.000000,
+	{"gcommaaccent", "g"}:             -10.000000,
+	{"gcommaaccent", "gbreve"}:        -10.000000,
+	{"gcommaaccent", "gcommaaccent"}:  -10.000000,
+	{"h", "y"}:                        -20.000000,
+	{"h", "yacute"}:                   -20.000000,
+	{"h", "ydieresis"}:                -20.000000,
+	{"k", "o"}:                        -15.000000,
+	{"k", "oacute"}:                   -15.000000,
+	{"k", "ocircumflex"}:              -15.000000,
+	{"k", "odieresis"}:                -15.000000,
+	{"k", "ograve"}:                   -15.000000,
+	{"k", "ohungarumlaut"}:            -15.000000,
+	{"k", "omacron"}:                  -15.000000,
+	{"k", "oslash"}:                   -15.000000,
+	{"k", "otilde"}:                   -15.000000,
+	{"kcommaaccent", "o"}:             -15.000000,
+	{"kcommaaccent", "oacute"}:        -15.000000,
+	{"kcommaaccent", "ocircumflex"}:   -15.000000,
+	{"kcommaaccent", "odieresis"}:     -15.000000,
+	{"kcommaaccent", "ograve"}:        -15.000000,
+	{"kcommaaccent", "ohungarumlaut"}: -15.000000,
+	{"kcommaaccent", "omacron"}:       -15.000000,
+	{"kcommaaccent", "oslash"}:        -15.000000,
+	{"kcommaaccent", "otilde"}:        -15.000000,
+	{"l", "w"}:                        -15.000000,
+	{"l", "y"}:                        -15.000000,
+	{"l", "yacute"}:                   -15.000000,
+	{"l", "ydieresis"}:                -15.000000,
+	{"lacute", "w"}:                   -15.000000,
+	{"lacute", "y"}:                   -15.000000,
+	{"lacute", "yacute"}:              -15.000000,
+	{"lacute", "ydieresis"}:           -15.000000,
+	{"lcommaaccent", "w"}:             -15.000000,
+	{"lcommaaccent", "y"}:             -15.000000,
+	{"lcommaaccent", "yacute"}:        -15.000000,
+	{"lcommaaccent", "ydieresis"}:     -15.000000,
+	{"lslash", "w"}:                   -15.000000,
+	{"lslash", "y"}:                   -15.000000,
+	{"lslash", "yacute"}:              -15.000000,
+	{"lslash", "ydieresis"}:           -15.000000,
+	{"m", "u"}:                        -20.000000,
+	{"m", "uacute"}:                   -20.000000,
+	{"m", "ucircumflex"}:              -20.000000,
+	{"m", "udieresis"}:                -20.000000,
+	{"m", "ugrave"}:                   -20.000000,
+	{"m", "uhungarumlaut"}:            -20.000000,
+	{"m", "umacron"}:                  -20.000000,
+	{"m", "uogonek"}:                  -20.000000,
+	{"m", "uring"}:                    -20.000000,
+	{"m", "y"}:                        -30.000000,
+	{"m", "yacute"}:                   -30.000000,
+	{"m", "ydieresis"}:                -30.000000,
+	{"n", "u"}:                        -10.000000,
+	{"n", "uacute"}:                   -10.000000,
+	{"n", "ucircumflex"}:              -10.000000,
+	{"n", "udieresis"}:                -10.000000,
+	{"n", "ugrave"}:                   -10.000000,
+	{"n", "uhungarumlaut"}:            -10.000000,
+	{"n", "umacron"}:                  -10.000000,
+	{"n", "uogonek"}:                  -10.000000,
+	{"n", "uring"}:                    -10.000000,
+	{"n", "v"}:                        -40.000000,
+	{"n", "y"}:                        -20.000000,
+	{"n", "yacute"}:                   -20.000000,
+	{"n", "ydieresis"}:                -20.000000,
+	{"nacute", "u"}:                   -10.000000,
+	{"nacute", "uacute"}:              -10.000000,
+	{"nacute", "ucircumflex"}:         -10.000000,
+	{"nacute", "udieresis"}:           -10.000000,
+	{"nacute", "ugrave"}:              -10.000000,
+	{"nacute", "uhungarumlaut"}:       -10.000000,
+	{"nacute", "umacron"}:             -10.000000,
+	{"nacute", "uogonek"}:             -10.000000,
+	{"nacute", "uring"}:               -10.000000,
+	{"nacute", "v"}:                   -40.000000,
+	{"nacute", "y"}:                   -20.000000,
+	{"nacute", "yacute"}:              -20.000000,
+	{"nacute", "ydieresis"}:           -20.000000,
+	{"ncaron", "u"}:                   -10.000000,
+	{"ncaron", "uacute"}:              -10.000000,
+	{"ncaron", "ucircumflex"}:         -10.000000,
+	{"ncaron", "udieresis"}:           -10.000000,
+	{"ncaron", "ugrave"}:              -10.000000,
+	{"ncaron", "uhungarumlaut"}:       -10.000000,
+	{"ncaron", "umacron"}:             -10.000000,
+	{"ncaron", "uogonek"}:             -10.000000,
+	{"ncaron", "uring"}:               -10.000000,
+	{"ncaron", "v"}:                   -40.000000,
+	{"ncaron", "y"}:                   -20.000000,
+	{"ncaron", "yacute"}:              -20.000000,
+	{"ncaron", "ydieresis"}:           -20.000000,
+	{"ncommaaccent", "u"}:             -10.000000,
+	{"ncommaaccent", "uacute"}:        -10.000000,
+	{"ncommaaccent", "ucircumflex"}:   -10.000000,
+	{"ncommaaccent", "udieresis"}:     -10.000000,
+	{"ncommaaccent", "ugrave"}:        -10.000000,
+	{"ncommaaccent", "uhungarumlaut"}: -10.000000,
+	{"ncommaaccent", "umacron"}:       -10.000000,
+	{"ncommaaccent", "uogonek"}:       -10.000000,
+	{"ncommaaccent", "uring"}:         -10.000000,
+	{"ncommaaccent", "v"}:             -40.000000,
+	{"ncommaaccent", "y"}:             -20.000000,
+	{"ncommaaccent", "yacute"}:        -20.000000,
+	{"ncommaaccent", "ydieresis"}:     -20.000000,
+	{"ntilde", "u"}:                   -10.000000,
+	{"ntilde", "uacute"}:              -10.000000,
+	{"ntilde", "ucircumflex"}:         -10.000000,
+	{"ntilde", "udieresis"}:           -10.000000,
+	{"ntilde", "ugrave"}:              -10.000000,
+	{"ntilde", "uhungarumlaut"}:       -10.000000,
+	{"ntilde", "umacron"}:             -10.000000,
+	{"ntilde", "uogonek"}:             -10.000000,
+	{"ntilde", "uring"}:               -10.000000,
+	{"ntilde", "v"}:                   -40.000000,
+	{"ntilde", "y"}:                   -20.000000,
+	{"ntilde", "yacute"}:              -20.000000,
+	{"ntilde", "ydieresis"}:           -20.000000,
+	{"o", "v"}:                        -20.000000,
+	{"o", "w"}:                        -15.000000,
+	{"o", "x"}:                        -30.000000,
+	{"o", "y"}:                        -20.000000,
+	{"o", "yacute"}:                   -20.000000,
+	{"o", "ydieresis"}:                -20.000000,
+	{"oacute", "v"}:                   -20.000000,
+	{"oacute", "w"}:                   -15.000000,
+	{"oacute", "x"}:                   -30.000000,
+	{"oacute", "y"}:                   -20.000000,
+	{"oacute", "yacute"}:              -20.000000,
+	{"oacute", "ydieresis"}:           -20.000000,
+	{"ocircumflex", "v"}:              -20.000000,
+	{"ocircumflex", "w"}:              -15.000000,
+	{"ocircumflex", "x"}:              -30.000000,
+	{"ocircumflex", "y"}:              -20.000000,
+	{"ocircumflex", "yacute"}:         -20.000000,
+	{"ocircumflex", "ydieresis"}:      -20.000000,
+	{"odieresis", "v"}:                -20.000000,
+	{"odieresis", "w"}:                -15.000000,
+	{"odieresis", "x"}:                -30.000000,
+	{"odieresis", "y"}:                -20.000000,
+	{"odieresis", "yacute"}:           -20.000000,
+	{"odieresis", "ydieresis"}:        -20.000000,
+	{"ograve", "v"}:                   -20.000000,
+	{"ograve", "w"}:                   -15.000000,
+	{"ograve", "x"}:                   -30.000000,
+	{"ograve", "y"}:                   -20.000000,
+	{"ograve", "yacute"}:              -20.000000,
+	{"ograve", "ydieresis"}:           -20.000000,
+	{"ohungarumlaut", "v"}:            -20.000000,
+	{"ohungarumlaut", "w"}:            -15.000000,
+	{"ohungarumlaut", "x"}:            -30.000000,
+	{"ohungarumlaut", "y"}:            -20.000000,
+	{"ohungarumlaut", "yacute"}:       -20.000000,
+	{"ohungarumlaut", "ydieresis"}:    -20.000000,
+	{"omacron", "v"}:                  -20.000000,
+	{"omacron", "w"}:                  -15.000000,
+	{"omacron", "x"}:                  -30.000000,
+	{"omacron", "y"}:                  -20.000000,
+	{"omacron", "yacute"}:             -20.000000,
+	{"omacron", "ydieresis"}:          -20.000000,
+	{"oslash", "v"}:                   -20.000000,
+	{"oslash", "w"}:                   -15.000000,
+	{"oslash", "x"}:                   -30.000000,
+	{"oslash", "y"}:                   -20.000000,
+	{"oslash", "yacute"}:              -20.000000,
+	{"oslash", "ydieresis"}:           -20.000000,
+	{"otilde", "v"}:                   -20.000000,
+	{"otilde", "w"}:                   -15.000000,
+	{"otilde", "x"}:                   -30.000000,
+	{"otilde", "y"}:                   -20.000000,
+	{"otilde", "yacute"}:              -20.000000,
+	{"otilde", "ydieresis"}:           -20.000000,
+	{"p", "y"}:                        -15.000000,
+	{"p", "yacute"}:                   -15.000000,
+	{"p", "ydieresis"}:                -15.000000,
+	{"period", "quotedblright"}:       -120.000000,
+	{"period", "quoteright"}:          -120.000000,
+	{"period", "space"}:               -40.000000,
+	{"quotedblright", "space"}:        -80.000000,
+	{"quoteleft", "quoteleft"}:        -46.000000,
+	{"quoteright", "d"}:               -80.000000,
+	{"quoteright", "dcroat"}:          -80.000000,
+	{"quoteright", "l"}:               -20.000000,
+	{"quoteright", "lacute"}:          -20.000000,
+	{"quoteright", "lcommaaccent"}:    -20.000000,
+	{"quoteright", "lslash"}:          -20.000000,
+	{"quoteright", "quoteright"}:      -46.000000,
+	{"quoteright", "r"}:               -40.000000,
+	{"quoteright", "racute"}:          -40.000000,
+	{"quoteright", "rcaron"}:          -40.000000,
+	{"quoteright", "rcommaaccent"}:    -40.000000,
+	{"quoteright", "s"}:               -60.000000,
+	{"quoteright", "sacute"}:          -60.000000,
+	{"quoteright", "scaron"}:          -60.000000,
+	{"quoteright", "scedilla"}:        -60.000000,
+	{"quoteright", "scommaaccent"}:    -60.000000,
+	{"quoteright", "space"}:           -80.000000,
+	{"quoteright", "v"}:               -20.000000,
+	{"r", "c"}:                        -20.000000,
+	{"r", "cacute"}:                   -20.000000,
+	{"r", "ccaron"}:                   -20.000000,
+	{"r", "ccedilla"}:                 -20.000000,
+	{"r", "comma"}:                    -60.000000,
+	{"r", "d"}:                        -20.000000,
+	{"r", "dcroat"}:                   -20.000000,
+	{"r", "g"}:                        -15.000000,
+	{"r", "gbreve"}:                   -15.000000,
+	{"r", "gcommaaccent"}:             -15.000000,
+	{"r", "hyphen"}:                   -20.000000,
+	{"r", "o"}:                        -20.000000,
+	{"r", "oacute"}:                   -20.000000,
+	{"r", "ocircumflex"}:              -20.000000,
+	{"r", "odieresis"}:                -20.000000,
+	{"r", "ograve"}:                   -20.000000,
+	{"r", "ohungarumlaut"}:            -20.000000,
+	{"r", "omacron"}:                  -20.000000,
+	{"r", "oslash"}:                   -20.000000,
+	{"r", "otilde"}:                   -20.000000,
+	{"r", "period"}:                   -60.000000,
+	{"r", "q"}:                        -20.000000,
+	{"r", "s"}:                        -15.000000,
+	{"r", "sacute"}:                   -15.000000,
+	{"r", "scaron"}:                   -15.000000,
+	{"r", "scedilla"}:                 -15.000000,
+	{"r", "scommaaccent"}:             -15.000000,
+	{"r", "t"}:                        20.000000,
+	{"r", "tcommaaccent"}:             20.000000,
+	{"r", "v"}:                        10.000000,
+	{"r", "y"}:                        10.000000,
+	{"r", "yacute"}:                   10.000000,
+	{"r", "ydieresis"}:                10.000000,
+	{"racute", "c"}:                   -20.000000,
+	{"racute", "cacute"}:              -20.000000,
+	{"racute", "ccaron"}:              -20.000000,
+	{"racute", "ccedilla"}:            -20.000000,
+	{"racute", "comma"}:               -60.000000,
+	{"racute", "d"}:                   -20.000000,
+	{"racute", "dcroat"}:              -20.000000,
+	{"racute", "g"}:                   -15.000000,
+	{"racute", "gbreve"}:              -15.000000,
+	{"racute", "gcommaaccent"}:        -15.000000,
+	{"racute", "hyphen"}:              -20.000000,
+	{"racute", "o"}:                   -20.000000,
+	{"racute", "oacute"}:              -20.000000,
+	{"racute", "ocircumflex"}:         -20.000000,
+	{"racute", "odieresis"}:           -20.000000,
+	{"racute", "ograve"}:              -20.000000,
+	{"racute", "ohungarumlaut"}:       -20.000000,
+	{"racute", "omacron"}:             -20.000000,
+	{"racute", "oslash"}:              -20.000000,
+	{"racute", "otilde"}:              -20.000000,
+	{"racute", "period"}:              -60.000000,
+	{"racute", "q"}:                   -20.000000,
+	{"racute", "s"}:                   -15.000000,
+	{"racute", "sacute"}:              -15.000000,
+	{"racute", "scaron"}:              -15.000000,
+	{"racute", "scedilla"}:            -15.000000,
+	{"racute", "scommaaccent"}:        -15.000000,
+	{"racute", "t"}:                   20.000000,
+	{"racute", "tcommaaccent"}:        20.000000,
+	{"racute", "v"}:                   10.000000,
+	{"racute", "y"}:                   10.000000,
+	{"racute", "yacute"}:              10.000000,
+	{"racute", "ydieresis"}:           10.000000,
+	{"rcaron", "c"}:                   -20.000000,
+	{"rcaron", "cacute"}:              -20.000000,
+	{"rcaron", "ccaron"}:              -20.000000,
+	{"rcaron", "ccedilla"}:            -20.000000,
+	{"rcaron", "comma"}:               -60.000000,
+	{"rcaron", "d"}:                   -20.000000,
+	{"rcaron", "dcroat"}:              -20.000000,
+	{"rcaron", "g"}:                   -15.000000,
+	{"rcaron", "gbreve"}:              -15.000000,
+	{"rcaron", "gcommaaccent"}:        -15.000000,
+	{"rcaron", "hyphen"}:              -20.000000,
+	{"rcaron", "o"}:                   -20.000000,
+	{"rcaron", "oacute"}:              -20.000000,
+	{"rcaron", "ocircumflex"}:         -20.000000,
+	{"rcaron", "odieresis"}:           -20.000000,
+	{"rcaron", "ograve"}:              -20.000000,
+	{"rcaron", "ohungarumlaut"}:       -20.000000,
+	{"rcaron", "omacron"}:             -20.000000,
+	{"rcaron", "oslash"}:              -20.000000,
+	{"rcaron", "otilde"}:              -20.000000,
+	{"rcaron", "period"}:              -60.000000,
+	{"rcaron", "q"}:                   -20.000000,
+	{"rcaron", "s"}:                   -15.000000,
+	{"rcaron", "sacute"}:              -15.000000,
+	{"rcaron", "scaron"}:              -15.000000,
+	{"rcaron", "scedilla"}:            -15.000000,
+	{"rcaron", "scommaaccent"}:        -15.000000,
+	{"rcaron", "t"}:                   20.000000,
+	{"rcaron", "tcommaaccent"}:        20.000000,
+	{"rcaron", "v"}:                   10.000000,
+	{"rcaron", "y"}:                   10.000000,
+	{"rcaron", "yacute"}:              10.000000,
+	{"rcaron", "ydieresis"}:           10.000000,
+	{"rcommaaccent", "c"}:             -20.000000,
+	{"rcommaaccent", "cacute"}:        -20.000000,
+	{"rcommaaccent", "ccaron"}:        -20.000000,
+	{"rcommaaccent", "ccedilla"}:      -20.000000,
+	{"rcommaaccent", "comma"}:         -60.000000,
+	{"rcommaaccent", "d"}:             -20.000000,
+	{"rcommaaccent", "dcroat"}:        -20.000000,
+	{"rcommaaccent", "g"}:             -15.000000,
+	{"rcommaaccent", "gbreve"}:        -15.000000,
+	{"rcommaaccent", "gcommaaccent"}:  -15.000000,
+	{"rcommaaccent", "hyphen"}:        -20.000000,
+	{"rcommaaccent", "o"}:             -20.000000,
+	{"rcommaaccent", "oacute"}:        -20.000000,
+	{"rcommaaccent", "ocircumflex"}:   -20.000000,
+	{"rcommaaccent", "odieresis"}:     -20.000000,
+	{"rcommaaccent", "ograve"}:        -20.000000,
+	{"rcommaaccent", "ohungarumlaut"}: -20.000000,
+	{"rcommaaccent", "omacron"}:       -20.000000,
+	{"rcommaaccent", "oslash"}:        -20.000000,
+	{"rcommaaccent", "otilde"}:        -20.000000,
+	{"rcommaaccent", "period"}:        -60.000000,
+	{"rcommaaccent", "q"}:             -20.000000,
+	{"rcommaaccent", "s"}:             -15.000000,
+	{"rcommaaccent", "sacute"}:        -15.000000,
+	{"rcommaaccent", "scaron"}:        -15.000000,
+	{"rcommaaccent", "scedilla"}:      -15.000000,
+	{"rcommaaccent", "scommaaccent"}:  -15.000000,
+	{"rcommaaccent", "t"}:             20.000000,
+	{"rcommaaccent", "tcommaaccent"}:  20.000000,
+	{"rcommaaccent", "v"}:             10.000000,
+	{"rcommaaccent", "y"}:             10.000000,
+	{"rcommaaccent", "yacute"}:        10.000000,
+	{"rcommaaccent", "ydieresis"}:     10.000000,
+	{"s", "w"}:                        -15.000000,
+	{"sacute", "w"}:                   -15.000000,
+	{"scaron", "w"}:                   -15.000000,
+	{"scedilla", "w"}:                 -15.000000,
+	{"scommaaccent", "w"}:             -15.000000,
+	{"semicolon", "space"}:            -40.000000,
+	{"space", "T"}:                    -100.000000,
+	{"space", "Tcaron"}:               -100.000000,
+	{"space", "Tcommaaccent"}:         -100.000000,
+	{"space", "V"}:                    -80.000000,
+	{"space", "W"}:                    -80.000000,
+	{"space", "Y"}:                    -120.000000,
+	{"space", "Yacute"}:               -120.000000,
+	{"space", "Ydieresis"}:            -120.000000,
+	{"space", "quotedblleft"}:         -80.000000,
+	{"space", "quoteleft"}:            -60.000000,
+	{"v", "a"}:                        -20.000000,
+	{"v", "aacute"}:                   -20.000000,
+	{"v", "abreve"}:                   -20.000000,
+	{"v", "acircumflex"}:              -20.000000,
+	{"v", "adieresis"}:                -20.000000,
+	{"v", "agrave"}:                   -20.000000,
+	{"v", "amacron"}:                  -20.000000,
+	{"v", "aogonek"}:                  -20.000000,
+	{"v", "aring"}:                    -20.000000,
+	{"v", "atilde"}:                   -20.000000,
+	{"v", "comma"}:                    -80.000000,
+	{"v", "o"}:                        -30.000000,
+	{"v", "oacute"}:                   -30.000000,
+	{"v", "ocircumflex"}:              -30.000000,
+	{"v", "odieresis"}:                -30.000000,
+	{"v", "ograve"}:                   -30.000000,
+	{"v", "ohungarumlaut"}:            -30.000000,
+	{"v", "omacron"}:                  -30.000000,
+	{"v", "oslash"}:                   -30.000000,
+	{"v", "otilde"}:                   -30.000000,
+	{"v", "period"}:                   -80.000000,
+	{"w", "comma"}:                    -40.000000,
+	{"w", "o"}:                        -20.000000,
+	{"w", "oacute"}:                   -20.000000,
+	{"w", "ocircumflex"}:              -20.000000,
+	{"w", "odieresis"}:                -20.000000,
+	{"w", "ograve"}:                   -20.000000,
+	{"w", "ohungarumlaut"}:            -20.000000,
+	{"w", "omacron"}:                  -20.000000,
+	{"w", "oslash"}:                   -20.000000,
+	{"w", "otilde"}:                   -20.000000,
+	{"w", "period"}:                   -40.000000,
+	{"x", "e"}:                        -10.000000,
+	{"x", "eacute"}:                   -10.000000,
+	{"x", "ecaron"}:                   -10.000000,
+	{"x", "ecircumflex"}:              -10.000000,
+	{"x", "edieresis"}:                -10.000000,
+	{"x", "edotaccent"}:               -10.000000,
+	{"x", "egrave"}:                   -10.000000,
+	{"x", "emacron"}:                  -10.000000,
+	{"x", "eogonek"}:                  -10.000000,
+	{"y", "a"}:                        -30.000000,
+	{"y", "aacute"}:                   -30.000000,
+	{"y", "abreve"}:                   -30.000000,
+	{"y", "acircumflex"}:              -30.000000,
+	{"y", "adieresis"}:                -30.000000,
+	{"y", "agrave"}:                   -30.000000,
+	{"y", "amacron"}:                  -30.000000,
+	{"y", "aogonek"}:                  -30.000000,
+	{"y", "aring"}:                    -30.000000,
+	{"y", "atilde"}:                   -30.000000,
+	{"y", "comma"}:                    -80.000000,
+	{"y", "e"}:                        -10.000000,
+	{"y", "eacute"}:                   -10.000000,
+	{"y", "ecaron"}:                   -10.000000,
+	{"y", "ecircumflex"}:              -10.000000,
+	{"y", "edieresis"}:                -10.000000,
+	{"y", "edotaccent"}:               -10.000000,
+	{"y", "egrave"}:                   -10.000000,
+	{"y", "emacron"}:                  -10.000000,
+	{"y", "eogonek"}:                  -10.000000,
+	{"y", "o"}:                        -25.000000,
+	{"y", "oacute"}:                   -25.000000,
+	{"y", "ocircumflex"}:              -25.000000,
+	{"y", "odieresis"}:                -25.000000,
+	{"y", "ograve"}:                   -25.000000,
+	{"y", "ohungarumlaut"}:            -25.000000,
+	{"y", "omacron"}:                  -25.000000,
+	{"y", "oslash"}:                   -25.000000,
+	{"y", "otilde"}:                   -25.000000,
+	{"y", "period"}:                   -80.000000,
+	{"yacute", "a"}:                   -30.000000,
+	{"yacute", "aacute"}:              -30.000000,
+	{"yacute", "abreve"}:              -30.000000,
+	{"yacute", "acircumflex"}:         -30.000000,
+	{"yacute", "adieresis"}:           -30.000000,
+	{"yacute", "agrave"}:              -30.000000,
+	{"yacute", "amacron"}:             -30.000000,
+	{"yacute", "aogonek"}:             -30.000000,
+	{"yacute", "aring"}:               -30.000000,
+	{"yacute", "atilde"}:              -30.000000,
+	{"yacute", "comma"}:               -80.000000,
+	{"yacute", "e"}:                   -10.000000,
+	{"yacute", "eacute"}:              -10.000000,
+	{"yacute", "ecaron"}:              -10.000000,
+	{"yacute", "ecircumflex"}:         -10.000000,
+	{"yacute", "edieresis"}:           -10.000000,
+	{"yacute", "edotaccent"}:          -10.000000,
+	{"yacute", "egrave"}:              -10.000000,
+	{"yacute", "emacron"}:             -10.000000,
+	{"yacute", "eogonek"}:             -10.000000,
+	{"yacute", "o"}:                   -25.000000,
+	{"yacute", "oacute"}:              -25.000000,
+	{"yacute", "ocircumflex"}:         -25.000000,
+	{"yacute", "odieresis"}:           -25.000000,
+	{"yacute", "ograve"}:              -25.000000,
+	{"yacute", "ohungarumlaut"}:       -25.000000,
+	{"yacute", "omacron"}:             -25.000000,
+	{"yacute", "oslash"}:              -25.000000,
+	{"yacute", "otilde"}:              -25.000000,
+	{"yacute", "period"}:              -80.000000,
+	{"ydieresis", "a"}:                -30.000000,
+	{"ydieresis", "aacute"}:           -30.000000,
+	{"ydieresis", "abreve"}:           -30.000000,
+	{"ydieresis", "acircumflex"}:      -30.000000,
+	{"ydieresis", "adieresis"}:        -30.000000,
+	{"ydieresis", "agrave"}:           -30.000000,
+	{"ydieresis", "amacron"}:          -30.000000,
+	{"ydieresis", "aogonek"}:          -30.000000,
+	{"ydieresis", "aring"}:            -30.000000,
+	{"ydieresis", "atilde"}:           -30.000000,
+	{"ydieresis", "comma"}:            -80.000000,
+	{"ydieresis", "e"}:                -10.000000,
+	{"ydieresis", "eacute"}:           -10.000000,
+	{"ydieresis", "ecaron"}:           -10.000000,
+	{"ydieresis", "ecircumflex"}:      -10.000000,
+	{"ydieresis", "edieresis"}:        -10.000000,
+	{"ydieresis", "edotaccent"}:       -10.000000,
+	{"ydieresis", "egrave"}:           -10.000000,
+	{"ydieresis", "emacron"}:          -10.000000,
+	{"ydieresis", "eogonek"}:          -10.000000,
+	{"ydieresis", "o"}:                -25.000000,
+	{"ydieresis", "oacute"}:           -25.000000,
+	{"ydieresis", "ocircumflex"}:      -25.000000,
+	{"ydieresis", "odieresis"}:        -25.000000,
+	{"ydieresis", "ograve"}:           -25.000000,
+	{"ydieresis", "ohungarumlaut"}:    -25.000000,
+	{"ydieresis", "omacron"}:          -25.000000,
+	{"ydieresis", "oslash"}:           -25.000000,
+	{"ydieresis", "otilde"}:           -25.000000,
+	{"ydieresis", "period"}:           -80.000000,
+	{"z", "e"}:                        10.000000,
+	{"z", "eacute"}:                   10.000000,
+	{"z", "ecaron"}:                   10.000000,
+	{"z", "ecircumflex"}:              10.000000,
+	{"z", "edieresis"}:                10.000000,
+	{"z", "edotaccent"}:               10.000000,
+	{"z", "egrave"}:                   10.000000,
+	{"z", "emacron"}:                  10.000000,
+	{"z", "eogonek"}:                  10.000000,
+	{"zacute", "e"}:                   10.000000,
+	{"zacute", "eacute"}:              10.000000,
+	{"zacute", "ecaron"}:              10.000000,
+	{"zacute", "ecircumflex"}:         10.000000,
+	{"zacute", "edieresis"}:           10.000000,
+	{"zacute", "edotaccent"}:          10.000000,
+	{"zacute", "egrave"}:              10.000000,
+	{"zacute", "emacron"}:             10.000000,
+	{"zacute", "eogonek"}:             10.000000,
+	{"zcaron", "e"}:                   10.000000,
+	{"zcaron", "eacute"}:              10.000000,
+	{"zcaron", "ecaron"}:              10.000000,
+	{"zcaron", "ecircumflex"}:         10.000000,
+	{"zcaron", "edieresis"}:           10.000000,
+	{"zcaron", "edotaccent"}:          10.000000,
+	{"zcaron", "egrave"}:              10.000000,
+	{"zcaron", "emacron"}:             10.000000,
+	{"zcaron", "eogonek"}:             10.000000,
+	{"zdotaccent", "e"}:               10.000000,
+	{"zdotaccent", "eacute"}:          10.000000,
+	{"zdotaccent", "ecaron"}:          10.000000,
+	{"zdotaccent", "ecircumflex"}:     10.000000,
+	{"zdotaccent", "edieresis"}:       10.000000,
+	{"zdotaccent", "edotaccent"}:      10.000000,
+	{"zdotaccent", "egrave"}:          10.000000,
+	{"zdotaccent", "emacron"}:         10.000000,
+	{"zdotaccent", "eogonek"}:         10.000000,
+}
@@ -39,6 +39,23 @@ func (font fontHelveticaBoldOblique) GetGlyphCharMetrics(glyph string) (CharMetr
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+// The kerning pairs are the same as those of Helvetica-Bold.
+func (font fontHelveticaBoldOblique) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := helveticaBoldKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontHelveticaBoldOblique) GetAscentDescent() (float64, float64) {
+	return 718, -207
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontHelveticaBoldOblique) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontHelveticaBoldOblique) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -39,6 +39,23 @@ func (font fontHelveticaOblique) GetGlyphCharMetrics(glyph string) (CharMetrics,
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+// The kerning pairs are the same as those of Helvetica.
+func (font fontHelveticaOblique) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := helveticaKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontHelveticaOblique) GetAscentDescent() (float64, float64) {
+	return 718, -207
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontHelveticaOblique) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontHelveticaOblique) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
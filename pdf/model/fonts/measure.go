@@ -0,0 +1,128 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// GlyphPair is a pair of consecutive glyphs, e.g. for kerning.
+type GlyphPair struct {
+	Left  string
+	Right string
+}
+
+// KerningFont is implemented by fonts with kerning information.
+type KerningFont interface {
+	// GetGlyphKerning returns the adjustment of the advance of glyph `left` followed by glyph `right` in glyph
+	// space units (1/1000 em), where negative values move the glyphs closer together.
+	// The bool flag is true if the font has kerning for the pair.
+	GetGlyphKerning(left, right string) (float64, bool)
+}
+
+// AscentDescentFont is implemented by fonts that know their ascent and descent.
+type AscentDescentFont interface {
+	// GetAscentDescent returns the ascent (above the baseline) and the descent (below the baseline, negative)
+	// of the font in glyph space units (1/1000 em).
+	GetAscentDescent() (ascent float64, descent float64)
+}
+
+// Ascent and descent of fonts that do not implement AscentDescentFont, in glyph space units.
+const (
+	defaultAscent  = 750.0
+	defaultDescent = -250.0
+)
+
+// TextSpacing contains the text state parameters that affect the advance of text (9.3).
+type TextSpacing struct {
+	// Character spacing (Tc), added to the advance of every glyph, in unscaled text space units.
+	CharSpacing float64
+
+	// Word spacing (Tw), added to the advance of the space character (code 32) of single byte encodings, in
+	// unscaled text space units.
+	WordSpacing float64
+}
+
+// StringMetrics contains the metrics of a string of text shown in a font, in unscaled text space units (points
+// for the identity text matrix), relative to the start point of the text on the baseline.
+type StringMetrics struct {
+	// Advance is the horizontal displacement of the text, i.e. the start point of text that follows it, including
+	// the kerning and spacing.
+	Advance float64
+
+	// Kerning is the total kerning adjustment included in Advance.
+	Kerning float64
+
+	// Bounding box of the text: from the start point to the advance horizontally, and from the descent to the
+	// ascent of the font vertically.
+	Llx, Lly, Urx, Ury float64
+
+	// Number of glyphs measured and number of runes skipped as they are not in the font.
+	NumGlyphs  int
+	NumMissing int
+}
+
+// Width returns the width of the bounding box.
+func (m StringMetrics) Width() float64 {
+	return m.Urx - m.Llx
+}
+
+// Height returns the height of the bounding box.
+func (m StringMetrics) Height() float64 {
+	return m.Ury - m.Lly
+}
+
+// MeasureString returns the metrics of string `s` shown in `font` at font size `size` with the text spacing
+// `spacing`. The runes of `s` are mapped to glyphs by `encoder`. The kerning of consecutive glyphs is applied if
+// `font` implements KerningFont. Runes that have no glyph or no metrics in the font are skipped and counted in
+// NumMissing, as they cannot be shown.
+func MeasureString(font Font, encoder textencoding.TextEncoder, s string, size float64, spacing TextSpacing) StringMetrics {
+	m := StringMetrics{}
+
+	_, isIdentity := encoder.(textencoding.IdentityEncoder)
+	kerner, hasKerning := font.(KerningFont)
+
+	prev := ""
+	for _, r := range s {
+		glyph, found := encoder.RuneToGlyph(r)
+		if !found {
+			m.NumMissing++
+			continue
+		}
+		metrics, found := font.GetGlyphCharMetrics(glyph)
+		if !found {
+			m.NumMissing++
+			continue
+		}
+
+		if hasKerning && prev != "" {
+			if kx, has := kerner.GetGlyphKerning(prev, glyph); has {
+				m.Kerning += kx * size / 1000.0
+			}
+		}
+
+		m.Advance += metrics.Wx*size/1000.0 + spacing.CharSpacing
+		if r == ' ' && !isIdentity {
+			m.Advance += spacing.WordSpacing
+		}
+		m.NumGlyphs++
+		prev = glyph
+	}
+	m.Advance += m.Kerning
+
+	ascent, descent := defaultAscent, defaultDescent
+	if f, ok := font.(AscentDescentFont); ok {
+		ascent, descent = f.GetAscentDescent()
+	}
+
+	m.Llx, m.Urx = 0, m.Advance
+	if m.Advance < 0 {
+		m.Llx, m.Urx = m.Advance, 0
+	}
+	m.Lly = descent * size / 1000.0
+	m.Ury = ascent * size / 1000.0
+	return m
+}
@@ -0,0 +1,54 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMeasureStringKerning(t *testing.T) {
+	font := NewFontHelvetica()
+
+	// A: 667, V: 667, kerning A V: -70.
+	m := font.MeasureString("AV", 10)
+	if m.NumGlyphs != 2 || m.NumMissing != 0 {
+		t.Fatalf("Unexpected glyph counts: %+v", m)
+	}
+	if math.Abs(m.Kerning-(-0.7)) > 1e-9 {
+		t.Errorf("Kerning %f != -0.7", m.Kerning)
+	}
+	if math.Abs(m.Advance-(13.34-0.7)) > 1e-9 {
+		t.Errorf("Advance %f != 12.64", m.Advance)
+	}
+	if m.Llx != 0 || m.Urx != m.Advance || math.Abs(m.Lly-(-2.07)) > 1e-9 || math.Abs(m.Ury-7.18) > 1e-9 {
+		t.Errorf("Unexpected bbox: %+v", m)
+	}
+
+	// Courier has no kerning.
+	m = NewFontCourier().MeasureString("AV", 10)
+	if m.Kerning != 0 || math.Abs(m.Advance-12) > 1e-9 {
+		t.Errorf("Unexpected Courier metrics: %+v", m)
+	}
+}
+
+func TestMeasureStringSpacing(t *testing.T) {
+	font := NewFontTimesRoman()
+	encoder := font.encoder
+
+	plain := MeasureString(font, encoder, "a b", 12, TextSpacing{})
+	spaced := MeasureString(font, encoder, "a b", 12, TextSpacing{CharSpacing: 1, WordSpacing: 2})
+	// Character spacing applies to the 3 glyphs and word spacing to the space.
+	if math.Abs(spaced.Advance-plain.Advance-5) > 1e-9 {
+		t.Errorf("Spacing not applied: %f vs %f", spaced.Advance, plain.Advance)
+	}
+
+	// Runes not in the encoding are skipped.
+	m := MeasureString(font, encoder, "a中", 12, TextSpacing{})
+	if m.NumGlyphs != 1 || m.NumMissing != 1 {
+		t.Errorf("Unexpected glyph counts: %+v", m)
+	}
+}
@@ -39,6 +39,16 @@ func (font fontSymbol) GetGlyphCharMetrics(glyph string) (CharMetrics, bool) {
 	return metrics, true
 }
 
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the font bounding box).
+func (font fontSymbol) GetAscentDescent() (float64, float64) {
+	return 1010, -293
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`.
+func (font fontSymbol) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontSymbol) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
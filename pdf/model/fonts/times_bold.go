@@ -39,6 +39,22 @@ func (font fontTimesBold) GetGlyphCharMetrics(glyph string) (CharMetrics, bool)
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font fontTimesBold) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := timesBoldKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontTimesBold) GetAscentDescent() (float64, float64) {
+	return 683, -217
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontTimesBold) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontTimesBold) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -370,3 +386,2249 @@ var timesBoldCharMetrics map[string]CharMetrics = map[string]CharMetrics{
 	"zdotaccent":     {GlyphName: "zdotaccent", Wx: 444.000000, Wy: 0.000000},
 	"zero":           {GlyphName: "zero", Wx: 500.000000, Wy: 0.000000},
 }
+
+// Times-Bold kerning pairs loaded from afms/Times-Bold.afm.  See afms/MustRead.html for license information.
+var timesBoldKerning map[GlyphPair]float64 = map[GlyphPair]float64{
+	{"A", "C"}:                        -55.000000,
+	{"A", "Cacute"}:                   -55.000000,
+	{"A", "Ccaron"}:                   -55.000000,
+	{"A", "Ccedilla"}:                 -55.000000,
+	{"A", "G"}:                        -55.000000,
+	{"A", "Gbreve"}:                   -55.000000,
+	{"A", "Gcommaaccent"}:             -55.000000,
+	{"A", "O"}:                        -45.000000,
+	{"A", "Oacute"}:                   -45.000000,
+	{"A", "Ocircumflex"}:              -45.000000,
+	{"A", "Odieresis"}:                -45.000000,
+	{"A", "Ograve"}:                   -45.000000,
+	{"A", "Ohungarumlaut"}:            -45.000000,
+	{"A", "Omacron"}:                  -45.000000,
+	{"A", "Oslash"}:                   -45.000000,
+	{"A", "Otilde"}:                   -45.000000,
+	{"A", "Q"}:                        -45.000000,
+	{"A", "T"}:                        -95.000000,
+	{"A", "Tcaron"}:                   -95.000000,
+	{"A", "Tcommaaccent"}:             -95.000000,
+	{"A", "U"}:                        -50.000000,
+	{"A", "Uacute"}:                   -50.000000,
+	{"A", "Ucircumflex"}:              -50.000000,
+	{"A", "Udieresis"}:                -50.000000,
+	{"A", "Ugrave"}:                   -50.000000,
+	{"A", "Uhungarumlaut"}:            -50.000000,
+	{"A", "Umacron"}:                  -50.000000,
+	{"A", "Uogonek"}:                  -50.000000,
+	{"A", "Uring"}:                    -50.000000,
+	{"A", "V"}:                        -145.000000,
+	{"A", "W"}:                        -130.000000,
+	{"A", "Y"}:                        -100.000000,
+	{"A", "Yacute"}:                   -100.000000,
+	{"A", "Ydieresis"}:                -100.000000,
+	{"A", "p"}:                        -25.000000,
+	{"A", "quoteright"}:               -74.000000,
+	{"A", "u"}:                        -50.000000,
+	{"A", "uacute"}:                   -50.000000,
+	{"A", "ucircumflex"}:              -50.000000,
+	{"A", "udieresis"}:                -50.000000,
+	{"A", "ugrave"}:                   -50.000000,
+	{"A", "uhungarumlaut"}:            -50.000000,
+	{"A", "umacron"}:                  -50.000000,
+	{"A", "uogonek"}:                  -50.000000,
+	{"A", "uring"}:                    -50.000000,
+	{"A", "v"}:                        -100.000000,
+	{"A", "w"}:                        -90.000000,
+	{"A", "y"}:                        -74.000000,
+	{"A", "yacute"}:                   -74.000000,
+	{"A", "ydieresis"}:                -74.000000,
+	{"Aacute", "C"}:                   -55.000000,
+	{"Aacute", "Cacute"}:              -55.000000,
+	{"Aacute", "Ccaron"}:              -55.000000,
+	{"Aacute", "Ccedilla"}:            -55.000000,
+	{"Aacute", "G"}:                   -55.000000,
+	{"Aacute", "Gbreve"}:              -55.000000,
+	{"Aacute", "Gcommaaccent"}:        -55.000000,
+	{"Aacute", "O"}:                   -45.000000,
+	{"Aacute", "Oacute"}:              -45.000000,
+	{"Aacute", "Ocircumflex"}:         -45.000000,
+	{"Aacute", "Odieresis"}:           -45.000000,
+	{"Aacute", "Ograve"}:              -45.000000,
+	{"Aacute", "Ohungarumlaut"}:       -45.000000,
+	{"Aacute", "Omacron"}:             -45.000000,
+	{"Aacute", "Oslash"}:              -45.000000,
+	{"Aacute", "Otilde"}:              -45.000000,
+	{"Aacute", "Q"}:                   -45.000000,
+	{"Aacute", "T"}:                   -95.000000,
+	{"Aacute", "Tcaron"}:              -95.000000,
+	{"Aacute", "Tcommaaccent"}:        -95.000000,
+	{"Aacute", "U"}:                   -50.000000,
+	{"Aacute", "Uacute"}:              -50.000000,
+	{"Aacute", "Ucircumflex"}:         -50.000000,
+	{"Aacute", "Udieresis"}:           -50.000000,
+	{"Aacute", "Ugrave"}:              -50.000000,
+	{"Aacute", "Uhungarumlaut"}:       -50.000000,
+	{"Aacute", "Umacron"}:             -50.000000,
+	{"Aacute", "Uogonek"}:             -50.000000,
+	{"Aacute", "Uring"}:               -50.000000,
+	{"Aacute", "V"}:                   -145.000000,
+	{"Aacute", "W"}:                   -130.000000,
+	{"Aacute", "Y"}:                   -100.000000,
+	{"Aacute", "Yacute"}:              -100.000000,
+	{"Aacute", "Ydieresis"}:           -100.000000,
+	{"Aacute", "p"}:                   -25.000000,
+	{"Aacute", "quoteright"}:          -74.000000,
+	{"Aacute", "u"}:                   -50.000000,
+	{"Aacute", "uacute"}:              -50.000000,
+	{"Aacute", "ucircumflex"}:         -50.000000,
+	{"Aacute", "udieresis"}:           -50.000000,
+	{"Aacute", "ugrave"}:              -50.000000,
+	{"Aacute", "uhungarumlaut"}:       -50.000000,
+	{"Aacute", "umacron"}:             -50.000000,
+	{"Aacute", "uogonek"}:             -50.000000,
+	{"Aacute", "uring"}:               -50.000000,
+	{"Aacute", "v"}:                   -100.000000,
+	{"Aacute", "w"}:                   -90.000000,
+	{"Aacute", "y"}:                   -74.000000,
+	{"Aacute", "yacute"}:              -74.000000,
+	{"Aacute", "ydieresis"}:           -74.000000,
+	{"Abreve", "C"}:                   -55.000000,
+	{"Abreve", "Cacute"}:              -55.000000,
+	{"Abreve", "Ccaron"}:              -55.000000,
+	{"Abreve", "Ccedilla"}:            -55.000000,
+	{"Abreve", "G"}:                   -55.000000,
+	{"Abreve", "Gbreve"}:              -55.000000,
+	{"Abreve", "Gcommaaccent"}:        -55.000000,
+	{"Abreve", "O"}:                   -45.000000,
+	{"Abreve", "Oacute"}:              -45.000000,
+	{"Abreve", "Ocircumflex"}:         -45.000000,
+	{"Abreve", "Odieresis"}:           -45.000000,
+	{"Abreve", "Ograve"}:              -45.000000,
+	{"Abreve", "Ohungarumlaut"}:       -45.000000,
+	{"Abreve", "Omacron"}:             -45.000000,
+	{"Abreve", "Oslash"}:              -45.000000,
+	{"Abreve", "Otilde"}:              -45.000000,
+	{"Abreve", "Q"}:                   -45.000000,
+	{"Abreve", "T"}:                   -95.000000,
+	{"Abreve", "Tcaron"}:              -95.000000,
+	{"Abreve", "Tcommaaccent"}:        -95.000000,
+	{"Abreve", "U"}:                   -50.000000,
+	{"Abreve", "Uacute"}:              -50.000000,
+	{"Abreve", "Ucircumflex"}:         -50.000000,
+	{"Abreve", "Udieresis"}:           -50.000000,
+	{"Abreve", "Ugrave"}:              -50.000000,
+	{"Abreve", "Uhungarumlaut"}:       -50.000000,
+	{"Abreve", "Umacron"}:             -50.000000,
+	{"Abreve", "Uogonek"}:             -50.000000,
+	{"Abreve", "Uring"}:               -50.000000,
+	{"Abreve", "V"}:                   -145.000000,
+	{"Abreve", "W"}:                   -130.000000,
+	{"Abreve", "Y"}:                   -100.000000,
+	{"Abreve", "Yacute"}:              -100.000000,
+	{"Abreve", "Ydieresis"}:           -100.000000,
+	{"Abreve", "p"}:                   -25.000000,
+	{"Abreve", "quoteright"}:          -74.000000,
+	{"Abreve", "u"}:                   -50.000000,
+	{"Abreve", "uacute"}:              -50.000000,
+	{"Abreve", "ucircumflex"}:         -50.000000,
+	{"Abreve", "udieresis"}:           -50.000000,
+	{"Abreve", "ugrave"}:              -50.000000,
+	{"Abreve", "uhungarumlaut"}:       -50.000000,
+	{"Abreve", "umacron"}:             -50.000000,
+	{"Abreve", "uogonek"}:             -50.000000,
+	{"Abreve", "uring"}:               -50.000000,
+	{"Abreve", "v"}:                   -100.000000,
+	{"Abreve", "w"}:                   -90.000000,
+	{"Abreve", "y"}:                   -74.000000,
+	{"Abreve", "yacute"}:              -74.000000,
+	{"Abreve", "ydieresis"}:           -74.000000,
+	{"Acircumflex", "C"}:              -55.000000,
+	{"Acircumflex", "Cacute"}:         -55.000000,
+	{"Acircumflex", "Ccaron"}:         -55.000000,
+	{"Acircumflex", "Ccedilla"}:       -55.000000,
+	{"Acircumflex", "G"}:              -55.000000,
+	{"Acircumflex", "Gbreve"}:         -55.000000,
+	{"Acircumflex", "Gcommaaccent"}:   -55.000000,
+	{"Acircumflex", "O"}:              -45.000000,
+	{"Acircumflex", "Oacute"}:         -45.000000,
+	{"Acircumflex", "Ocircumflex"}:    -45.000000,
+	{"Acircumflex", "Odieresis"}:      -45.000000,
+	{"Acircumflex", "Ograve"}:         -45.000000,
+	{"Acircumflex", "Ohungarumlaut"}:  -45.000000,
+	{"Acircumflex", "Omacron"}:        -45.000000,
+	{"Acircumflex", "Oslash"}:         -45.000000,
+	{"Acircumflex", "Otilde"}:         -45.000000,
+	{"Acircumflex", "Q"}:              -45.000000,
+	{"Acircumflex", "T"}:              -95.000000,
+	{"Acircumflex", "Tcaron"}:         -95.000000,
+	{"Acircumflex", "Tcommaaccent"}:   -95.000000,
+	{"Acircumflex", "U"}:              -50.000000,
+	{"Acircumflex", "Uacute"}:         -50.000000,
+	{"Acircumflex", "Ucircumflex"}:    -50.000000,
+	{"Acircumflex", "Udieresis"}:      -50.000000,
+	{"Acircumflex", "Ugrave"}:         -50.000000,
+	{"Acircumflex", "Uhungarumlaut"}:  -50.000000,
+	{"Acircumflex", "Umacron"}:        -50.000000,
+	{"Acircumflex", "Uogonek"}:        -50.000000,
+	{"Acircumflex", "Uring"}:          -50.000000,
+	{"Acircumflex", "V"}:              -145.000000,
+	{"Acircumflex", "W"}:              -130.000000,
+	{"Acircumflex", "Y"}:              -100.000000,
+	{"Acircumflex", "Yacute"}:         -100.000000,
+	{"Acircumflex", "Ydieresis"}:      -100.000000,
+	{"Acircumflex", "p"}:              -25.000000,
+	{"Acircumflex", "quoteright"}:     -74.000000,
+	{"Acircumflex", "u"}:              -50.000000,
+	{"Acircumflex", "uacute"}:         -50.000000,
+	{"Acircumflex", "ucircumflex"}:    -50.000000,
+	{"Acircumflex", "udieresis"}:      -50.000000,
+	{"Acircumflex", "ugrave"}:         -50.000000,
+	{"Acircumflex", "uhungarumlaut"}:  -50.000000,
+	{"Acircumflex", "umacron"}:        -50.000000,
+	{"Acircumflex", "uogonek"}:        -50.000000,
+	{"Acircumflex", "uring"}:          -50.000000,
+	{"Acircumflex", "v"}:              -100.000000,
+	{"Acircumflex", "w"}:              -90.000000,
+	{"Acircumflex", "y"}:              -74.000000,
+	{"Acircumflex", "yacute"}:         -74.000000,
+	{"Acircumflex", "ydieresis"}:      -74.000000,
+	{"Adieresis", "C"}:                -55.000000,
+	{"Adieresis", "Cacute"}:           -55.000000,
+	{"Adieresis", "Ccaron"}:           -55.000000,
+	{"Adieresis", "Ccedilla"}:         -55.000000,
+	{"Adieresis", "G"}:                -55.000000,
+	{"Adieresis", "Gbreve"}:           -55.000000,
+	{"Adieresis", "Gcommaaccent"}:     -55.000000,
+	{"Adieresis", "O"}:                -45.000000,
+	{"Adieresis", "Oacute"}:           -45.000000,
+	{"Adieresis", "Ocircumflex"}:      -45.000000,
+	{"Adieresis", "Odieresis"}:        -45.000000,
+	{"Adieresis", "Ograve"}:           -45.000000,
+	{"Adieresis", "Ohungarumlaut"}:    -45.000000,
+	{"Adieresis", "Omacron"}:          -45.000000,
+	{"Adieresis", "Oslash"}:           -45.000000,
+	{"Adieresis", "Otilde"}:           -45.000000,
+	{"Adieresis", "Q"}:                -45.000000,
+	{"Adieresis", "T"}:                -95.000000,
+	{"Adieresis", "Tcaron"}:           -95.000000,
+	{"Adieresis", "Tcommaaccent"}:     -95.000000,
+	{"Adieresis", "U"}:                -50.000000,
+	{"Adieresis", "Uacute"}:           -50.000000,
+	{"Adieresis", "Ucircumflex"}:      -50.000000,
+	{"Adieresis", "Udieresis"}:        -50.000000,
+	{"Adieresis", "Ugrave"}:           -50.000000,
+	{"Adieresis", "Uhungarumlaut"}:    -50.000000,
+	{"Adieresis", "Umacron"}:          -50.000000,
+	{"Adieresis", "Uogonek"}:          -50.000000,
+	{"Adieresis", "Uring"}:            -50.000000,
+	{"Adieresis", "V"}:                -145.000000,
+	{"Adieresis", "W"}:                -130.000000,
+	{"Adieresis", "Y"}:                -100.000000,
+	{"Adieresis", "Yacute"}:           -100.000000,
+	{"Adieresis", "Ydieresis"}:        -100.000000,
+	{"Adieresis", "p"}:                -25.000000,
+	{"Adieresis", "quoteright"}:       -74.000000,
+	{"Adieresis", "u"}:                -50.000000,
+	{"Adieresis", "uacute"}:           -50.000000,
+	{"Adieresis", "ucircumflex"}:      -50.000000,
+	{"Adieresis", "udieresis"}:        -50.000000,
+	{"Adieresis", "ugrave"}:           -50.000000,
+	{"Adieresis", "uhungarumlaut"}:    -50.000000,
+	{"Adieresis", "umacron"}:          -50.000000,
+	{"Adieresis", "uogonek"}:          -50.000000,
+	{"Adieresis", "uring"}:            -50.000000,
+	{"Adieresis", "v"}:                -100.000000,
+	{"Adieresis", "w"}:                -90.000000,
+	{"Adieresis", "y"}:                -74.000000,
+	{"Adieresis", "yacute"}:           -74.000000,
+	{"Adieresis", "ydieresis"}:        -74.000000,
+	{"Agrave", "C"}:                   -55.000000,
+	{"Agrave", "Cacute"}:              -55.000000,
+	{"Agrave", "Ccaron"}:              -55.000000,
+	{"Agrave", "Ccedilla"}:            -55.000000,
+	{"Agrave", "G"}:                   -55.000000,
+	{"Agrave", "Gbreve"}:              -55.000000,
+	{"Agrave", "Gcommaaccent"}:        -55.000000,
+	{"Agrave", "O"}:                   -45.000000,
+	{"Agrave", "Oacute"}:              -45.000000,
+	{"Agrave", "Ocircumflex"}:         -45.000000,
+	{"Agrave", "Odieresis"}:           -45.000000,
+	{"Agrave", "Ograve"}:              -45.000000,
+	{"Agrave", "Ohungarumlaut"}:       -45.000000,
+	{"Agrave", "Omacron"}:             -45.000000,
+	{"Agrave", "Oslash"}:              -45.000000,
+	{"Agrave", "Otilde"}:              -45.000000,
+	{"Agrave", "Q"}:                   -45.000000,
+	{"Agrave", "T"}:                   -95.000000,
+	{"Agrave", "Tcaron"}:              -95.000000,
+	{"Agrave", "Tcommaaccent"}:        -95.000000,
+	{"Agrave", "U"}:                   -50.000000,
+	{"Agrave", "Uacute"}:              -50.000000,
+	{"Agrave", "Ucircumflex"}:         -50.000000,
+	{"Agrave", "Udieresis"}:           -50.000000,
+	{"Agrave", "Ugrave"}:              -50.000000,
+	{"Agrave", "Uhungarumlaut"}:       -50.000000,
+	{"Agrave", "Umacron"}:             -50.000000,
+	{"Agrave", "Uogonek"}:             -50.000000,
+	{"Agrave", "Uring"}:               -50.000000,
+	{"Agrave", "V"}:                   -145.000000,
+	{"Agrave", "W"}:                   -130.000000,
+	{"Agrave", "Y"}:                   -100.000000,
+	{"Agrave", "Yacute"}:              -100.000000,
+	{"Agrave", "Ydieresis"}:           -100.000000,
+	{"Agrave", "p"}:                   -25.000000,
+	{"Agrave", "quoteright"}:          -74.000000,
+	{"Agrave", "u"}:                   -50.000000,
+	{"Agrave", "uacute"}:              -50.000000,
+	{"Agrave", "ucircumflex"}:         -50.000000,
+	{"Agrave", "udieresis"}:           -50.000000,
+	{"Agrave", "ugrave"}:              -50.000000,
+	{"Agrave", "uhungarumlaut"}:       -50.000000,
+	{"Agrave", "umacron"}:             -50.000000,
+	{"Agrave", "uogonek"}:             -50.000000,
+	{"Agrave", "uring"}:               -50.000000,
+	{"Agrave", "v"}:                   -100.000000,
+	{"Agrave", "w"}:                   -90.000000,
+	{"Agrave", "y"}:                   -74.000000,
+	{"Agrave", "yacute"}:              -74.000000,
+	{"Agrave", "ydieresis"}:           -74.000000,
+	{"Amacron", "C"}:                  -55.000000,
+	{"Amacron", "Cacute"}:             -55.000000,
+	{"Amacron", "Ccaron"}:             -55.000000,
+	{"Amacron", "Ccedilla"}:           -55.000000,
+	{"Amacron", "G"}:                  -55.000000,
+	{"Amacron", "Gbreve"}:             -55.000000,
+	{"Amacron", "Gcommaaccent"}:       -55.000000,
+	{"Amacron", "O"}:                  -45.000000,
+	{"Amacron", "Oacute"}:             -45.000000,
+	{"Amacron", "Ocircumflex"}:        -45.000000,
+	{"Amacron", "Odieresis"}:          -45.000000,
+	{"Amacron", "Ograve"}:             -45.000000,
+	{"Amacron", "Ohungarumlaut"}:      -45.000000,
+	{"Amacron", "Omacron"}:            -45.000000,
+	{"Amacron", "Oslash"}:             -45.000000,
+	{"Amacron", "Otilde"}:             -45.000000,
+	{"Amacron", "Q"}:                  -45.000000,
+	{"Amacron", "T"}:                  -95.000000,
+	{"Amacron", "Tcaron"}:             -95.000000,
+	{"Amacron", "Tcommaaccent"}:       -95.000000,
+	{"Amacron", "U"}:                  -50.000000,
+	{"Amacron", "Uacute"}:             -50.000000,
+	{"Amacron", "Ucircumflex"}:        -50.000000,
+	{"Amacron", "Udieresis"}:          -50.000000,
+	{"Amacron", "Ugrave"}:             -50.000000,
+	{"Amacron", "Uhungarumlaut"}:      -50.000000,
+	{"Amacron", "Umacron"}:            -50.000000,
+	{"Amacron", "Uogonek"}:            -50.000000,
+	{"Amacron", "Uring"}:              -50.000000,
+	{"Amacron", "V"}:                  -145.000000,
+	{"Amacron", "W"}:                  -130.000000,
+	{"Amacron", "Y"}:                  -100.000000,
+	{"Amacron", "Yacute"}:             -100.000000,
+	{"Amacron", "Ydieresis"}:          -100.000000,
+	{"Amacron", "p"}:                  -25.000000,
+	{"Amacron", "quoteright"}:         -74.000000,
+	{"Amacron", "u"}:                  -50.000000,
+	{"Amacron", "uacute"}:             -50.000000,
+	{"Amacron", "ucircumflex"}:        -50.000000,
+	{"Amacron", "udieresis"}:          -50.000000,
+	{"Amacron", "ugrave"}:             -50.000000,
+	{"Amacron", "uhungarumlaut"}:      -50.000000,
+	{"Amacron", "umacron"}:            -50.000000,
+	{"Amacron", "uogonek"}:            -50.000000,
+	{"Amacron", "uring"}:              -50.000000,
+	{"Amacron", "v"}:                  -100.000000,
+	{"Amacron", "w"}:                  -90.000000,
+	{"Amacron", "y"}:                  -74.000000,
+	{"Amacron", "yacute"}:             -74.000000,
+	{"Amacron", "ydieresis"}:          -74.000000,
+	{"Aogonek", "C"}:                  -55.000000,
+	{"Aogonek", "Cacute"}:             -55.000000,
+	{"Aogonek", "Ccaron"}:             -55.000000,
+	{"Aogonek", "Ccedilla"}:           -55.000000,
+	{"Aogonek", "G"}:                  -55.000000,
+	{"Aogonek", "Gbreve"}:             -55.000000,
+	{"Aogonek", "Gcommaaccent"}:       -55.000000,
+	{"Aogonek", "O"}:                  -45.000000,
+	{"Aogonek", "Oacute"}:             -45.000000,
+	{"Aogonek", "Ocircumflex"}:        -45.000000,
+	{"Aogonek", "Odieresis"}:          -45.000000,
+	{"Aogonek", "Ograve"}:             -45.000000,
+	{"Aogonek", "Ohungarumlaut"}:      -45.000000,
+	{"Aogonek", "Omacron"}:            -45.000000,
+	{"Aogonek", "Oslash"}:             -45.000000,
+	{"Aogonek", "Otilde"}:             -45.000000,
+	{"Aogonek", "Q"}:                  -45.000000,
+	{"Aogonek", "T"}:                  -95.000000,
+	{"Aogonek", "Tcaron"}:             -95.000000,
+	{"Aogonek", "Tcommaaccent"}:       -95.000000,
+	{"Aogonek", "U"}:                  -50.000000,
+	{"Aogonek", "Uacute"}:             -50.000000,
+	{"Aogonek", "Ucircumflex"}:        -50.000000,
+	{"Aogonek", "Udieresis"}:          -50.000000,
+	{"Aogonek", "Ugrave"}:             -50.000000,
+	{"Aogonek", "Uhungarumlaut"}:      -50.000000,
+	{"Aogonek", "Umacron"}:            -50.000000,
+	{"Aogonek", "Uogonek"}:            -50.000000,
+	{"Aogonek", "Uring"}:              -50.000000,
+	{"Aogonek", "V"}:                  -145.000000,
+	{"Aogonek", "W"}:                  -130.000000,
+	{"Aogonek", "Y"}:                  -100.000000,
+	{"Aogonek", "Yacute"}:             -100.000000,
+	{"Aogonek", "Ydieresis"}:          -100.000000,
+	{"Aogonek", "p"}:                  -25.000000,
+	{"Aogonek", "quoteright"}:         -74.000000,
+	{"Aogonek", "u"}:                  -50.000000,
+	{"Aogonek", "uacute"}:             -50.000000,
+	{"Aogonek", "ucircumflex"}:        -50.000000,
+	{"Aogonek", "udieresis"}:          -50.000000,
+	{"Aogonek", "ugrave"}:             -50.000000,
+	{"Aogonek", "uhungarumlaut"}:      -50.000000,
+	{"Aogonek", "umacron"}:            -50.000000,
+	{"Aogonek", "uogonek"}:            -50.000000,
+	{"Aogonek", "uring"}:              -50.000000,
+	{"Aogonek", "v"}:                  -100.000000,
+	{"Aogonek", "w"}:                  -90.000000,
+	{"Aogonek", "y"}:                  -34.000000,
+	{"Aogonek", "yacute"}:             -34.000000,
+	{"Aogonek", "ydieresis"}:          -34.000000,
+	{"Aring", "C"}:                    -55.000000,
+	{"Aring", "Cacute"}:               -55.000000,
+	{"Aring", "Ccaron"}:               -55.000000,
+	{"Aring", "Ccedilla"}:             -55.000000,
+	{"Aring", "G"}:                    -55.000000,
+	{"Aring", "Gbreve"}:               -55.000000,
+	{"Aring", "Gcommaaccent"}:         -55.000000,
+	{"Aring", "O"}:                    -45.000000,
+	{"Aring", "Oacute"}:               -45.000000,
+	{"Aring", "Ocircumflex"}:          -45.000000,
+	{"Aring", "Odieresis"}:            -45.000000,
+	{"Aring", "Ograve"}:               -45.000000,
+	{"Aring", "Ohungarumlaut"}:        -45.000000,
+	{"Aring", "Omacron"}:              -45.000000,
+	{"Aring", "Oslash"}:               -45.000000,
+	{"Aring", "Otilde"}:               -45.000000,
+	{"Aring", "Q"}:                    -45.000000,
+	{"Aring", "T"}:                    -95.000000,
+	{"Aring", "Tcaron"}:               -95.000000,
+	{"Aring", "Tcommaaccent"}:         -95.000000,
+	{"Aring", "U"}:                    -50.000000,
+	{"Aring", "Uacute"}:               -50.000000,
+	{"Aring", "Ucircumflex"}:          -50.000000,
+	{"Aring", "Udieresis"}:            -50.000000,
+	{"Aring", "Ugrave"}:               -50.000000,
+	{"Aring", "Uhungarumlaut"}:        -50.000000,
+	{"Aring", "Umacron"}:              -50.000000,
+	{"Aring", "Uogonek"}:              -50.000000,
+	{"Aring", "Uring"}:                -50.000000,
+	{"Aring", "V"}:                    -145.000000,
+	{"Aring", "W"}:                    -130.000000,
+	{"Aring", "Y"}:                    -100.000000,
+	{"Aring", "Yacute"}:               -100.000000,
+	{"Aring", "Ydieresis"}:            -100.000000,
+	{"Aring", "p"}:                    -25.000000,
+	{"Aring", "quoteright"}:           -74.000000,
+	{"Aring", "u"}:                    -50.000000,
+	{"Aring", "uacute"}:               -50.000000,
+	{"Aring", "ucircumflex"}:          -50.000000,
+	{"Aring", "udieresis"}:            -50.000000,
+	{"Aring", "ugrave"}:               -50.000000,
+	{"Aring", "uhungarumlaut"}:        -50.000000,
+	{"Aring", "umacron"}:              -50.000000,
+	{"Aring", "uogonek"}:              -50.000000,
+	{"Aring", "uring"}:                -50.000000,
+	{"Aring", "v"}:                    -100.000000,
+	{"Aring", "w"}:                    -90.000000,
+	{"Aring", "y"}:                    -74.000000,
+	{"Aring", "yacute"}:               -74.000000,
+	{"Aring", "ydieresis"}:            -74.000000,
+	{"Atilde", "C"}:                   -55.000000,
+	{"Atilde", "Cacute"}:              -55.000000,
+	{"Atilde", "Ccaron"}:              -55.000000,
+	{"Atilde", "Ccedilla"}:            -55.000000,
+	{"Atilde", "G"}:                   -55.000000,
+	{"Atilde", "Gbreve"}:              -55.000000,
+	{"Atilde", "Gcommaaccent"}:        -55.000000,
+	{"Atilde", "O"}:                   -45.000000,
+	{"Atilde", "Oacute"}:              -45.000000,
+	{"Atilde", "Ocircumflex"}:         -45.000000,
+	{"Atilde", "Odieresis"}:           -45.000000,
+	{"Atilde", "Ograve"}:              -45.000000,
+	{"Atilde", "Ohungarumlaut"}:       -45.000000,
+	{"Atilde", "Omacron"}:             -45.000000,
+	{"Atilde", "Oslash"}:              -45.000000,
+	{"Atilde", "Otilde"}:              -45.000000,
+	{"Atilde", "Q"}:                   -45.000000,
+	{"Atilde", "T"}:                   -95.000000,
+	{"Atilde", "Tcaron"}:              -95.000000,
+	{"Atilde", "Tcommaaccent"}:        -95.000000,
+	{"Atilde", "U"}:                   -50.000000,
+	{"Atilde", "Uacute"}:              -50.000000,
+	{"Atilde", "Ucircumflex"}:         -50.000000,
+	{"Atilde", "Udieresis"}:           -50.000000,
+	{"Atilde", "Ugrave"}:              -50.000000,
+	{"Atilde", "Uhungarumlaut"}:       -50.000000,
+	{"Atilde", "Umacron"}:             -50.000000,
+	{"Atilde", "Uogonek"}:             -50.000000,
+	{"Atilde", "Uring"}:               -50.000000,
+	{"Atilde", "V"}:                   -145.000000,
+	{"Atilde", "W"}:                   -130.000000,
+	{"Atilde", "Y"}:                   -100.000000,
+	{"Atilde", "Yacute"}:              -100.000000,
+	{"Atilde", "Ydieresis"}:           -100.000000,
+	{"Atilde", "p"}:                   -25.000000,
+	{"Atilde", "quoteright"}:          -74.000000,
+	{"Atilde", "u"}:                   -50.000000,
+	{"Atilde", "uacute"}:              -50.000000,
+	{"Atilde", "ucircumflex"}:         -50.000000,
+	{"Atilde", "udieresis"}:           -50.000000,
+	{"Atilde", "ugrave"}:              -50.000000,
+	{"Atilde", "uhungarumlaut"}:       -50.000000,
+	{"Atilde", "umacron"}:             -50.000000,
+	{"Atilde", "uogonek"}:             -50.000000,
+	{"Atilde", "uring"}:               -50.000000,
+	{"Atilde", "v"}:                   -100.000000,
+	{"Atilde", "w"}:                   -90.000000,
+	{"Atilde", "y"}:                   -74.000000,
+	{"Atilde", "yacute"}:              -74.000000,
+	{"Atilde", "ydieresis"}:           -74.000000,
+	{"B", "A"}:                        -30.000000,
+	{"B", "Aacute"}:                   -30.000000,
+	{"B", "Abreve"}:                   -30.000000,
+	{"B", "Acircumflex"}:              -30.000000,
+	{"B", "Adieresis"}:                -30.000000,
+	{"B", "Agrave"}:                   -30.000000,
+	{"B", "Amacron"}:                  -30.000000,
+	{"B", "Aogonek"}:                  -30.000000,
+	{"B", "Aring"}:                    -30.000000,
+	{"B", "Atilde"}:                   -30.000000,
+	{"B", "U"}:                        -10.000000,
+	{"B", "Uacute"}:                   -10.000000,
+	{"B", "Ucircumflex"}:              -10.000000,
+	{"B", "Udieresis"}:                -10.000000,
+	{"B", "Ugrave"}:                   -10.000000,
+	{"B", "Uhungarumlaut"}:            -10.000000,
+	{"B", "Umacron"}:                  -10.000000,
+	{"B", "Uogonek"}:                  -10.000000,
+	{"B", "Uring"}:                    -10.000000,
+	{"D", "A"}:                        -35.000000,
+	{"D", "Aacute"}:                   -35.000000,
+	{"D", "Abreve"}:                   -35.000000,
+	{"D", "Acircumflex"}:              -35.000000,
+	{"D", "Adieresis"}:                -35.000000,
+	{"D", "Agrave"}:                   -35.000000,
+	{"D", "Amacron"}:                  -35.000000,
+	{"D", "Aogonek"}:                  -35.000000,
+	{"D", "Aring"}:                    -35.000000,
+	{"D", "Atilde"}:                   -35.000000,
+	{"D", "V"}:                        -40.000000,
+	{"D", "W"}:                        -40.000000,
+	{"D", "Y"}:                        -40.000000,
+	{"D", "Yacute"}:                   -40.000000,
+	{"D", "Ydieresis"}:                -40.000000,
+	{"D", "period"}:                   -20.000000,
+	{"Dcaron", "A"}:                   -35.000000,
+	{"Dcaron", "Aacute"}:              -35.000000,
+	{"Dcaron", "Abreve"}:              -35.000000,
+	{"Dcaron", "Acircumflex"}:         -35.000000,
+	{"Dcaron", "Adieresis"}:           -35.000000,
+	{"Dcaron", "Agrave"}:              -35.000000,
+	{"Dcaron", "Amacron"}:             -35.000000,
+	{"Dcaron", "Aogonek"}:             -35.000000,
+	{"Dcaron", "Aring"}:               -35.000000,
+	{"Dcaron", "Atilde"}:              -35.000000,
+	{"Dcaron", "V"}:                   -40.000000,
+	{"Dcaron", "W"}:                   -40.000000,
+	{"Dcaron", "Y"}:                   -40.000000,
+	{"Dcaron", "Yacute"}:              -40.000000,
+	{"Dcaron", "Ydieresis"}:           -40.000000,
+	{"Dcaron", "period"}:              -20.000000,
+	{"Dcroat", "A"}:                   -35.000000,
+	{"Dcroat", "Aacute"}:              -35.000000,
+	{"Dcroat", "Abreve"}:              -35.000000,
+	{"Dcroat", "Acircumflex"}:         -35.000000,
+	{"Dcroat", "Adieresis"}:           -35.000000,
+	{"Dcroat", "Agrave"}:              -35.000000,
+	{"Dcroat", "Amacron"}:             -35.000000,
+	{"Dcroat", "Aogonek"}:             -35.000000,
+	{"Dcroat", "Aring"}:               -35.000000,
+	{"Dcroat", "Atilde"}:              -35.000000,
+	{"Dcroat", "V"}:                   -40.000000,
+	{"Dcroat", "W"}:                   -40.000000,
+	{"Dcroat", "Y"}:                   -40.000000,
+	{"Dcroat", "Yacute"}:              -40.000000,
+	{"Dcroat", "Ydieresis"}:           -40.000000,
+	{"Dcroat", "period"}:              -20.000000,
+	{"F", "A"}:                        -90.000000,
+	{"F", "Aacute"}:                   -90.000000,
+	{"F", "Abreve"}:                   -90.000000,
+	{"F", "Acircumflex"}:              -90.000000,
+	{"F", "Adieresis"}:                -90.000000,
+	{"F", "Agrave"}:                   -90.000000,
+	{"F", "Amacron"}:                  -90.000000,
+	{"F", "Aogonek"}:                  -90.000000,
+	{"F", "Aring"}:                    -90.000000,
+	{"F", "Atilde"}:                   -90.000000,
+	{"F", "a"}:                        -25.000000,
+	{"F", "aacute"}:                   -25.000000,
+	{"F", "abreve"}:                   -25.000000,
+	{"F", "acircumflex"}:              -25.000000,
+	{"F", "adieresis"}:                -25.000000,
+	{"F", "agrave"}:                   -25.000000,
+	{"F", "amacron"}:                  -25.000000,
+	{"F", "aogonek"}:                  -25.000000,
+	{"F", "aring"}:                    -25.000000,
+	{"F", "atilde"}:                   -25.000000,
+	{"F", "comma"}:                    -92.000000,
+	{"F", "e"}:                        -25.000000,
+	{"F", "eacute"}:                   -25.000000,
+	{"F", "ecaron"}:                   -25.000000,
+	{"F", "ecircumflex"}:              -25.000000,
+	{"F", "edieresis"}:                -25.000000,
+	{"F", "edotaccent"}:               -25.000000,
+	{"F", "egrave"}:                   -25.000000,
+	{"F", "emacron"}:                  -25.000000,
+	{"F", "eogonek"}:                  -25.000000,
+	{"F", "o"}:                        -25.000000,
+	{"F", "oacute"}:                   -25.000000,
+	{"F", "ocircumflex"}:              -25.000000,
+	{"F", "odieresis"}:                -25.000000,
+	{"F", "ograve"}:                   -25.000000,
+	{"F", "ohungarumlaut"}:            -25.000000,
+	{"F", "omacron"}:                  -25.000000,
+	{"F", "oslash"}:                   -25.000000,
+	{"F", "otilde"}:                   -25.000000,
+	{"F", "period"}:                   -110.000000,
+	{"J", "A"}:                        -30.000000,
+	{"J", "Aacute"}:                   -30.000000,
+	{"J", "Abreve"}:                   -30.000000,
+	{"J", "Acircumflex"}:              -30.000000,
+	{"J", "Adieresis"}:                -30.000000,
+	{"J", "Agrave"}:                   -30.000000,
+	{"J", "Amacron"}:                  -30.000000,
+	{"J", "Aogonek"}:                  -30.000000,
+	{"J", "Aring"}:                    -30.000000,
+	{"J", "Atilde"}:                   -30.000000,
+	{"J", "a"}:                        -15.000000,
+	{"J", "aacute"}:                   -15.000000,
+	{"J", "abreve"}:                   -15.000000,
+	{"J", "acircumflex"}:              -15.000000,
+	{"J", "adieresis"}:                -15.000000,
+	{"J", "agrave"}:                   -15.000000,
+	{"J", "amacron"}:                  -15.000000,
+	{"J", "aogonek"}:                  -15.000000,
+	{"J", "aring"}:                    -15.000000,
+	{"J", "atilde"}:                   -15.000000,
+	{"J", "e"}:                        -15.000000,
+	{"J", "eacute"}:                   -15.000000,
+	{"J", "ecaron"}:                   -15.000000,
+	{"J", "ecircumflex"}:              -15.000000,
+	{"J", "edieresis"}:                -15.000000,
+	{"J", "edotaccent"}:               -15.000000,
+	{"J", "egrave"}:                   -15.000000,
+	{"J", "emacron"}:                  -15.000000,
+	{"J", "eogonek"}:                  -15.000000,
+	{"J", "o"}:                        -15.000000,
+	{"J", "oacute"}:                   -15.000000,
+	{"J", "ocircumflex"}:              -15.000000,
+	{"J", "odieresis"}:                -15.000000,
+	{"J", "ograve"}:                   -15.000000,
+	{"J", "ohungarumlaut"}:            -15.000000,
+	{"J", "omacron"}:                  -15.000000,
+	{"J", "oslash"}:                   -15.000000,
+	{"J", "otilde"}:                   -15.000000,
+	{"J", "period"}:                   -20.000000,
+	{"J", "u"}:                        -15.000000,
+	{"J", "uacute"}:                   -15.000000,
+	{"J", "ucircumflex"}:              -15.000000,
+	{"J", "udieresis"}:                -15.000000,
+	{"J", "ugrave"}:                   -15.000000,
+	{"J", "uhungarumlaut"}:            -15.000000,
+	{"J", "umacron"}:                  -15.000000,
+	{"J", "uogonek"}:                  -15.000000,
+	{"J", "uring"}:                    -15.000000,
+	{"K", "O"}:                        -30.000000,
+	{"K", "Oacute"}:                   -30.000000,
+	{"K", "Ocircumflex"}:              -30.000000,
+	{"K", "Odieresis"}:                -30.000000,
+	{"K", "Ograve"}:                   -30.000000,
+	{"K", "Ohungarumlaut"}:            -30.000000,
+	{"K", "Omacron"}:                  -30.000000,
+	{"K", "Oslash"}:                   -30.000000,
+	{"K", "Otilde"}:                   -30.000000,
+	{"K", "e"}:                        -25.000000,
+	{"K", "eacute"}:                   -25.000000,
+	{"K", "ecaron"}:                   -25.000000,
+	{"K", "ecircumflex"}:              -25.000000,
+	{"K", "edieresis"}:                -25.000000,
+	{"K", "edotaccent"}:               -25.000000,
+	{"K", "egrave"}:                   -25.000000,
+	{"K", "emacron"}:                  -25.000000,
+	{"K", "eogonek"}:                  -25.000000,
+	{"K", "o"}:                        -25.000000,
+	{"K", "oacute"}:                   -25.000000,
+	{"K", "ocircumflex"}:              -25.000000,
+	{"K", "odieresis"}:                -25.000000,
+	{"K", "ograve"}:                   -25.000000,
+	{"K", "ohungarumlaut"}:            -25.000000,
+	{"K", "omacron"}:                  -25.000000,
+	{"K", "oslash"}:                   -25.000000,
+	{"K", "otilde"}:                   -25.000000,
+	{"K", "u"}:                        -15.000000,
+	{"K", "uacute"}:                   -15.000000,
+	{"K", "ucircumflex"}:              -15.000000,
+	{"K", "udieresis"}:                -15.000000,
+	{"K", "ugrave"}:                   -15.000000,
+	{"K", "uhungarumlaut"}:            -15.000000,
+	{"K", "umacron"}:                  -15.000000,
+	{"K", "uogonek"}:                  -15.000000,
+	{"K", "uring"}:                    -15.000000,
+	{"K", "y"}:                        -45.000000,
+	{"K", "yacute"}:                   -45.000000,
+	{"K", "ydieresis"}:                -45.000000,
+	{"Kcommaaccent", "O"}:             -30.000000,
+	{"Kcommaaccent", "Oacute"}:        -30.000000,
+	{"Kcommaaccent", "Ocircumflex"}:   -30.000000,
+	{"Kcommaaccent", "Odieresis"}:     -30.000000,
+	{"Kcommaaccent", "Ograve"}:        -30.000000,
+	{"Kcommaaccent", "Ohungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "Omacron"}:       -30.000000,
+	{"Kcommaaccent", "Oslash"}:        -30.000000,
+	{"Kcommaaccent", "Otilde"}:        -30.000000,
+	{"Kcommaaccent", "e"}:             -25.000000,
+	{"Kcommaaccent", "eacute"}:        -25.000000,
+	{"Kcommaaccent", "ecaron"}:        -25.000000,
+	{"Kcommaaccent", "ecircumflex"}:   -25.000000,
+	{"Kcommaaccent", "edieresis"}:     -25.000000,
+	{"Kcommaaccent", "edotaccent"}:    -25.000000,
+	{"Kcommaaccent", "egrave"}:        -25.000000,
+	{"Kcommaaccent", "emacron"}:       -25.000000,
+	{"Kcommaaccent", "eogonek"}:       -25.000000,
+	{"Kcommaaccent", "o"}:             -25.000000,
+	{"Kcommaaccent", "oacute"}:        -25.000000,
+	{"Kcommaaccent", "ocircumflex"}:   -25.000000,
+	{"Kcommaaccent", "odieresis"}:     -25.000000,
+	{"Kcommaaccent", "ograve"}:        -25.000000,
+	{"Kcommaaccent", "ohungarumlaut"}: -25.000000,
+	{"Kcommaaccent", "omacron"}:       -25.000000,
+	{"Kcommaaccent", "oslash"}:        -25.000000,
+	{"Kcommaaccent", "otilde"}:        -25.000000,
+	{"Kcommaaccent", "u"}:             -15.000000,
+	{"Kcommaaccent", "uacute"}:        -15.000000,
+	{"Kcommaaccent", "ucircumflex"}:   -15.000000,
+	{"Kcommaaccent", "udieresis"}:     -15.000000,
+	{"Kcommaaccent", "ugrave"}:        -15.000000,
+	{"Kcommaaccent", "uhungarumlaut"}: -15.000000,
+	{"Kcommaaccent", "umacron"}:       -15.000000,
+	{"Kcommaaccent", "uogonek"}:       -15.000000,
+	{"Kcommaaccent", "uring"}:         -15.000000,
+	{"Kcommaaccent", "y"}:             -45.000000,
+	{"Kcommaaccent", "yacute"}:        -45.000000,
+	{"Kcommaaccent", "ydieresis"}:     -45.000000,
+	{"L", "T"}:                        -92.000000,
+	{"L", "Tcaron"}:                   -92.000000,
+	{"L", "Tcommaaccent"}:             -92.000000,
+	{"L", "V"}:                        -92.000000,
+	{"L", "W"}:                        -92.000000,
+	{"L", "Y"}:                        -92.000000,
+	{"L", "Yacute"}:                   -92.000000,
+	{"L", "Ydieresis"}:                -92.000000,
+	{"L", "quotedblright"}:            -20.000000,
+	{"L", "quoteright"}:               -110.000000,
+	{"L", "y"}:                        -55.000000,
+	{"L", "yacute"}:                   -55.000000,
+	{"L", "ydieresis"}:                -55.000000,
+	{"Lacute", "T"}:                   -92.000000,
+	{"Lacute", "Tcaron"}:              -92.000000,
+	{"Lacute", "Tcommaaccent"}:        -92.000000,
+	{"Lacute", "V"}:                   -92.000000,
+	{"Lacute", "W"}:                   -92.000000,
+	{"Lacute", "Y"}:                   -92.000000,
+	{"Lacute", "Yacute"}:              -92.000000,
+	{"Lacute", "Ydieresis"}:           -92.000000,
+	{"Lacute", "quotedblright"}:       -20.000000,
+	{"Lacute", "quoteright"}:          -110.000000,
+	{"Lacute", "y"}:                   -55.000000,
+	{"Lacute", "yacute"}:              -55.000000,
+	{"Lacute", "ydieresis"}:           -55.000000,
+	{"Lcommaaccent", "T"}:             -92.000000,
+	{"Lcommaaccent", "Tcaron"}:        -92.000000,
+	{"Lcommaaccent", "Tcommaaccent"}:  -92.000000,
+	{"Lcommaaccent", "V"}:             -92.000000,
+	{"Lcommaaccent", "W"}:             -92.000000,
+	{"Lcommaaccent", "Y"}:             -92.000000,
+	{"Lcommaaccent", "Yacute"}:        -92.000000,
+	{"Lcommaaccent", "Ydieresis"}:     -92.000000,
+	{"Lcommaaccent", "quotedblright"}: -20.000000,
+	{"Lcommaaccent", "quoteright"}:    -110.000000,
+	{"Lcommaaccent", "y"}:             -55.000000,
+	{"Lcommaaccent", "yacute"}:        -55.000000,
+	{"Lcommaaccent", "ydieresis"}:     -55.000000,
+	{"Lslash", "T"}:                   -92.000000,
+	{"Lslash", "Tcaron"}:              -92.000000,
+	{"Lslash", "Tcommaaccent"}:        -92.000000,
+	{"Lslash", "V"}:                   -92.000000,
+	{"Lslash", "W"}:                   -92.000000,
+	{"Lslash", "Y"}:                   -92.000000,
+	{"Lslash", "Yacute"}:              -92.000000,
+	{"Lslash", "Ydieresis"}:           -92.000000,
+	{"Lslash", "quotedblright"}:       -20.000000,
+	{"Lslash", "quoteright"}:          -110.000000,
+	{"Lslash", "y"}:                   -55.000000,
+	{"Lslash", "yacute"}:              -55.000000,
+	{"Lslash", "ydieresis"}:           -55.000000,
+	{"N", "A"}:                        -20.000000,
+	{"N", "Aacute"}:                   -20.000000,
+	{"N", "Abreve"}:                   -20.000000,
+	{"N", "Acircumflex"}:              -20.000000,
+	{"N", "Adieresis"}:                -20.000000,
+	{"N", "Agrave"}:                   -20.000000,
+	{"N", "Amacron"}:                  -20.000000,
+	{"N", "Aogonek"}:                  -20.000000,
+	{"N", "Aring"}:                    -20.000000,
+	{"N", "Atilde"}:                   -20.000000,
+	{"Nacute", "A"}:                   -20.000000,
+	{"Nacute", "Aacute"}:              -20.000000,
+	{"Nacute", "Abreve"}:              -20.000000,
+	{"Nacute", "Acircumflex"}:         -20.000000,
+	{"Nacute", "Adieresis"}:           -20.000000,
+	{"Nacute", "Agrave"}:              -20.000000,
+	{"Nacute", "Amacron"}:             -20.000000,
+	{"Nacute", "Aogonek"}:             -20.000000,
+	{"Nacute", "Aring"}:               -20.000000,
+	{"Nacute", "Atilde"}:              -20.000000,
+	{"Ncaron", "A"}:                   -20.000000,
+	{"Ncaron", "Aacute"}:              -20.000000,
+	{"Ncaron", "Abreve"}:              -20.000000,
+	{"Ncaron", "Acircumflex"}:         -20.000000,
+	{"Ncaron", "Adieresis"}:           -20.000000,
+	{"Ncaron", "Agrave"}:              -20.000000,
+	{"Ncaron", "Amacron"}:             -20.000000,
+	{"Ncaron", "Aogonek"}:             -20.000000,
+	{"Ncaron", "Aring"}:               -20.000000,
+	{"Ncaron", "Atilde"}:              -20.000000,
+	{"Ncommaaccent", "A"}:             -20.000000,
+	{"Ncommaaccent", "Aacute"}:        -20.000000,
+	{"Ncommaaccent", "Abreve"}:        -20.000000,
+	{"Ncommaaccent", "Acircumflex"}:   -20.000000,
+	{"Ncommaaccent", "Adieresis"}:     -20.000000,
+	{"Ncommaaccent", "Agrave"}:        -20.000000,
+	{"Ncommaaccent", "Amacron"}:       -20.000000,
+	{"Ncommaaccent", "Aogonek"}:       -20.000000,
+	{"Ncommaaccent", "Aring"}:         -20.000000,
+	{"Ncommaaccent", "Atilde"}:        -20.000000,
+	{"Ntilde", "A"}:                   -20.000000,
+	{"Ntilde", "Aacute"}:              -20.000000,
+	{"Ntilde", "Abreve"}:              -20.000000,
+	{"Ntilde", "Acircumflex"}:         -20.000000,
+	{"Ntilde", "Adieresis"}:           -20.000000,
+	{"Ntilde", "Agrave"}:              -20.000000,
+	{"Ntilde", "Amacron"}:             -20.000000,
+	{"Ntilde", "Aogonek"}:             -20.000000,
+	{"Ntilde", "Aring"}:               -20.000000,
+	{"Ntilde", "Atilde"}:              -20.000000,
+	{"O", "A"}:                        -40.000000,
+	{"O", "Aacute"}:                   -40.000000,
+	{"O", "Abreve"}:                   -40.000000,
+	{"O", "Acircumflex"}:              -40.000000,
+	{"O", "Adieresis"}:                -40.000000,
+	{"O", "Agrave"}:                   -40.000000,
+	{"O", "Amacron"}:                  -40.000000,
+	{"O", "Aogonek"}:                  -40.000000,
+	{"O", "Aring"}:                    -40.000000,
+	{"O", "Atilde"}:                   -40.000000,
+	{"O", "T"}:                        -40.000000,
+	{"O", "Tcaron"}:                   -40.000000,
+	{"O", "Tcommaaccent"}:             -40.000000,
+	{"O", "V"}:                        -50.000000,
+	{"O", "W"}:                        -50.000000,
+	{"O", "X"}:                        -40.000000,
+	{"O", "Y"}:                        -50.000000,
+	{"O", "Yacute"}:                   -50.000000,
+	{"O", "Ydieresis"}:                -50.000000,
+	{"Oacute", "A"}:                   -40.000000,
+	{"Oacute", "Aacute"}:              -40.000000,
+	{"Oacute", "Abreve"}:              -40.000000,
+	{"Oacute", "Acircumflex"}:         -40.000000,
+	{"Oacute", "Adieresis"}:           -40.000000,
+	{"Oacute", "Agrave"}:              -40.000000,
+	{"Oacute", "Amacron"}:             -40.000000,
+	{"Oacute", "Aogonek"}:             -40.000000,
+	{"Oacute", "Aring"}:               -40.000000,
+	{"Oacute", "Atilde"}:              -40.000000,
+	{"Oacute", "T"}:                   -40.000000,
+	{"Oacute", "Tcaron"}:              -40.000000,
+	{"Oacute", "Tcommaaccent"}:        -40.000000,
+	{"Oacute", "V"}:                   -50.000000,
+	{"Oacute", "W"}:                   -50.000000,
+	{"Oacute", "X"}:                   -40.000000,
+	{"Oacute", "Y"}:                   -50.000000,
+	{"Oacute", "Yacute"}:              -50.000000,
+	{"Oacute", "Ydieresis"}:           -50.000000,
+	{"Ocircumflex", "A"}:              -40.000000,
+	{"Ocircumflex", "Aacute"}:         -40.000000,
+	{"Ocircumflex", "Abreve"}:         -40.000000,
+	{"Ocircumflex", "Acircumflex"}:    -40.000000,
+	{"Ocircumflex", "Adieresis"}:      -40.000000,
+	{"Ocircumflex", "Agrave"}:         -40.000000,
+	{"Ocircumflex", "Amacron"}:        -40.000000,
+	{"Ocircumflex", "Aogonek"}:        -40.000000,
+	{"Ocircumflex", "Aring"}:          -40.000000,
+	{"Ocircumflex", "Atilde"}:         -40.000000,
+	{"Ocircumflex", "T"}:              -40.000000,
+	{"Ocircumflex", "Tcaron"}:         -40.000000,
+	{"Ocircumflex", "Tcommaaccent"}:   -40.000000,
+	{"Ocircumflex", "V"}:              -50.000000,
+	{"Ocircumflex", "W"}:              -50.000000,
+	{"Ocircumflex", "X"}:              -40.000000,
+	{"Ocircumflex", "Y"}:              -50.000000,
+	{"Ocircumflex", "Yacute"}:         -50.000000,
+	{"Ocircumflex", "Ydieresis"}:      -50.000000,
+	{"Odieresis", "A"}:                -40.000000,
+	{"Odieresis", "Aacute"}:           -40.000000,
+	{"Odieresis", "Abreve"}:           -40.000000,
+	{"Odieresis", "Acircumflex"}:      -40.000000,
+	{"Odieresis", "Adieresis"}:        -40.000000,
+	{"Odieresis", "Agrave"}:           -40.000000,
+	{"Odieresis", "Amacron"}:          -40.000000,
+	{"Odieresis", "Aogonek"}:          -40.000000,
+	{"Odieresis", "Aring"}:            -40.000000,
+	{"Odieresis", "Atilde"}:           -40.000000,
+	{"Odieresis", "T"}:                -40.000000,
+	{"Odieresis", "Tcaron"}:           -40.000000,
+	{"Odieresis", "Tcommaaccent"}:     -40.000000,
+	{"Odieresis", "V"}:                -50.000000,
+	{"Odieresis", "W"}:                -50.000000,
+	{"Odieresis", "X"}:                -40.000000,
+	{"Odieresis", "Y"}:                -50.000000,
+	{"Odieresis", "Yacute"}:           -50.000000,
+	{"Odieresis", "Ydieresis"}:        -50.000000,
+	{"Ograve", "A"}:                   -40.000000,
+	{"Ograve", "Aacute"}:              -40.000000,
+	{"Ograve", "Abreve"}:              -40.000000,
+	{"Ograve", "Acircumflex"}:         -40.000000,
+	{"Ograve", "Adieresis"}:           -40.000000,
+	{"Ograve", "Agrave"}:              -40.000000,
+	{"Ograve", "Amacron"}:             -40.000000,
+	{"Ograve", "Aogonek"}:             -40.000000,
+	{"Ograve", "Aring"}:               -40.000000,
+	{"Ograve", "Atilde"}:              -40.000000,
+	{"Ograve", "T"}:                   -40.000000,
+	{"Ograve", "Tcaron"}:              -40.000000,
+	{"Ograve", "Tcommaaccent"}:        -40.000000,
+	{"Ograve", "V"}:                   -50.000000,
+	{"Ograve", "W"}:                   -50.000000,
+	{"Ograve", "X"}:                   -40.000000,
+	{"Ograve", "Y"}:                   -50.000000,
+	{"Ograve", "Yacute"}:              -50.000000,
+	{"Ograve", "Ydieresis"}:           -50.000000,
+	{"Ohungarumlaut", "A"}:            -40.000000,
+	{"Ohungarumlaut", "Aacute"}:       -40.000000,
+	{"Ohungarumlaut", "Abreve"}:       -40.000000,
+	{"Ohungarumlaut", "Acircumflex"}:  -40.000000,
+	{"Ohungarumlaut", "Adieresis"}:    -40.000000,
+	{"Ohungarumlaut", "Agrave"}:       -40.000000,
+	{"Ohungarumlaut", "Amacron"}:      -40.000000,
+	{"Ohungarumlaut", "Aogonek"}:      -40.000000,
+	{"Ohungarumlaut", "Aring"}:        -40.000000,
+	{"Ohungarumlaut", "Atilde"}:       -40.000000,
+	{"Ohungarumlaut", "T"}:            -40.000000,
+	{"Ohungarumlaut", "Tcaron"}:       -40.000000,
+	{"Ohungarumlaut", "Tcommaaccent"}: -40.000000,
+	{"Ohungarumlaut", "V"}:            -50.000000,
+	{"Ohungarumlaut", "W"}:            -50.000000,
+	{"Ohungarumlaut", "X"}:            -40.000000,
+	{"Ohungarumlaut", "Y"}:            -50.000000,
+	{"Ohungarumlaut", "Yacute"}:       -50.000000,
+	{"Ohungarumlaut", "Ydieresis"}:    -50.000000,
+	{"Omacron", "A"}:                  -40.000000,
+	{"Omacron", "Aacute"}:             -40.000000,
+	{"Omacron", "Abreve"}:             -40.000000,
+	{"Omacron", "Acircumflex"}:        -40.000000,
+	{"Omacron", "Adieresis"}:          -40.000000,
+	{"Omacron", "Agrave"}:             -40.000000,
+	{"Omacron", "Amacron"}:            -40.000000,
+	{"Omacron", "Aogonek"}:            -40.000000,
+	{"Omacron", "Aring"}:              -40.000000,
+	{"Omacron", "Atilde"}:             -40.000000,
+	{"Omacron", "T"}:                  -40.000000,
+	{"Omacron", "Tcaron"}:             -40.000000,
+	{"Omacron", "Tcommaaccent"}:       -40.000000,
+	{"Omacron", "V"}:                  -50.000000,
+	{"Omacron", "W"}:                  -50.000000,
+	{"Omacron", "X"}:                  -40.000000,
+	{"Omacron", "Y"}:                  -50.000000,
+	{"Omacron", "Yacute"}:             -50.000000,
+	{"Omacron", "Ydieresis"}:          -50.000000,
+	{"Oslash", "A"}:                   -40.000000,
+	{"Oslash", "Aacute"}:              -40.000000,
+	{"Oslash", "Abreve"}:              -40.000000,
+	{"Oslash", "Acircumflex"}:         -40.000000,
+	{"Oslash", "Adieresis"}:           -40.000000,
+	{"Oslash", "Agrave"}:              -40.000000,
+	{"Oslash", "Amacron"}:             -40.000000,
+	{"Oslash", "Aogonek"}:             -40.000000,
+	{"Oslash", "Aring"}:               -40.000000,
+	{"Oslash", "Atilde"}:              -40.000000,
+	{"Oslash", "T"}:                   -40.000000,
+	{"Oslash", "Tcaron"}:              -40.000000,
+	{"Oslash", "Tcommaaccent"}:        -40.000000,
+	{"Oslash", "V"}:                   -50.000000,
+	{"Oslash", "W"}:                   -50.000000,
+	{"Oslash", "X"}:                   -40.000000,
+	{"Oslash", "Y"}:                   -50.000000,
+	{"Oslash", "Yacute"}:              -50.000000,
+	{"Oslash", "Ydieresis"}:           -50.000000,
+	{"Otilde", "A"}:                   -40.000000,
+	{"Otilde", "Aacute"}:              -40.000000,
+	{"Otilde", "Abreve"}:              -40.000000,
+	{"Otilde", "Acircumflex"}:         -40.000000,
+	{"Otilde", "Adieresis"}:           -40.000000,
+	{"Otilde", "Agrave"}:              -40.000000,
+	{"Otilde", "Amacron"}:             -40.000000,
+	{"Otilde", "Aogonek"}:             -40.000000,
+	{"Otilde", "Aring"}:               -40.000000,
+	{"Otilde", "Atilde"}:              -40.000000,
+	{"Otilde", "T"}:                   -40.000000,
+	{"Otilde", "Tcaron"}:              -40.000000,
+	{"Otilde", "Tcommaaccent"}:        -40.000000,
+	{"Otilde", "V"}:                   -50.000000,
+	{"Otilde", "W"}:                   -50.000000,
+	{"Otilde", "X"}:                   -40.000000,
+	{"Otilde", "Y"}:                   -50.000000,
+	{"Otilde", "Yacute"}:              -50.000000,
+	{"Otilde", "Ydieresis"}:           -50.000000,
+	{"P", "A"}:                        -74.000000,
+	{"P", "Aacute"}:                   -74.000000,
+	{"P", "Abreve"}:                   -74.000000,
+	{"P", "Acircumflex"}:              -74.000000,
+	{"P", "Adieresis"}:                -74.000000,
+	{"P", "Agrave"}:                   -74.000000,
+	{"P", "Amacron"}:                  -74.000000,
+	{"P", "Aogonek"}:                  -74.000000,
+	{"P", "Aring"}:                    -74.000000,
+	{"P", "Atilde"}:                   -74.000000,
+	{"P", "a"}:                        -10.000000,
+	{"P", "aacute"}:                   -10.000000,
+	{"P", "abreve"}:                   -10.000000,
+	{"P", "acircumflex"}:              -10.000000,
+	{"P", "adieresis"}:                -10.000000,
+	{"P", "agrave"}:                   -10.000000,
+	{"P", "amacron"}:                  -10.000000,
+	{"P", "aogonek"}:                  -10.000000,
+	{"P", "aring"}:                    -10.000000,
+	{"P", "atilde"}:                   -10.000000,
+	{"P", "comma"}:                    -92.000000,
+	{"P", "e"}:                        -20.000000,
+	{"P", "eacute"}:                   -20.000000,
+	{"P", "ecaron"}:                   -20.000000,
+	{"P", "ecircumflex"}:              -20.000000,
+	{"P", "edieresis"}:                -20.000000,
+	{"P", "edotaccent"}:               -20.000000,
+	{"P", "egrave"}:                   -20.000000,
+	{"P", "emacron"}:                  -20.000000,
+	{"P", "eogonek"}:                  -20.000000,
+	{"P", "o"}:                        -20.000000,
+	{"P", "oacute"}:                   -20.000000,
+	{"P", "ocircumflex"}:              -20.000000,
+	{"P", "odieresis"}:                -20.000000,
+	{"P", "ograve"}:                   -20.000000,
+	{"P", "ohungarumlaut"}:            -20.000000,
+	{"P", "omacron"}:                  -20.000000,
+	{"P", "oslash"}:                   -20.000000,
+	{"P", "otilde"}:                   -20.000000,
+	{"P", "period"}:                   -110.000000,
+	{"Q", "U"}:                        -10.000000,
+	{"Q", "Uacute"}:                   -10.000000,
+	{"Q", "Ucircumflex"}:              -10.000000,
+	{"Q", "Udieresis"}:                -10.000000,
+	{"Q", "Ugrave"}:                   -10.000000,
+	{"Q", "Uhungarumlaut"}:            -10.000000,
+	{"Q", "Umacron"}:                  -10.000000,
+	{"Q", "Uogonek"}:                  -10.000000,
+	{"Q", "Uring"}:                    -10.000000,
+	{"Q", "period"}:                   -20.000000,
+	{"R", "O"}:                        -30.000000,
+	{"R", "Oacute"}:                   -30.000000,
+	{"R", "Ocircumflex"}:              -30.000000,
+	{"R", "Odieresis"}:                -30.000000,
+	{"R", "Ograve"}:                   -30.000000,
+	{"R", "Ohungarumlaut"}:            -30.000000,
+	{"R", "Omacron"}:                  -30.000000,
+	{"R", "Oslash"}:                   -30.000000,
+	{"R", "Otilde"}:                   -30.000000,
+	{"R", "T"}:                        -40.000000,
+	{"R", "Tcaron"}:                   -40.000000,
+	{"R", "Tcommaaccent"}:             -40.000000,
+	{"R", "U"}:                        -30.000000,
+	{"R", "Uacute"}:                   -30.000000,
+	{"R", "Ucircumflex"}:              -30.000000,
+	{"R", "Udieresis"}:                -30.000000,
+	{"R", "Ugrave"}:                   -30.000000,
+	{"R", "Uhungarumlaut"}:            -30.000000,
+	{"R", "Umacron"}:                  -30.000000,
+	{"R", "Uogonek"}:                  -30.000000,
+	{"R", "Uring"}:                    -30.000000,
+	{"R", "V"}:                        -55.000000,
+	{"R", "W"}:                        -35.000000,
+	{"R", "Y"}:                        -35.000000,
+	{"R", "Yacute"}:                   -35.000000,
+	{"R", "Ydieresis"}:                -35.000000,
+	{"Racute", "O"}:                   -30.000000,
+	{"Racute", "Oacute"}:              -30.000000,
+	{"Racute", "Ocircumflex"}:         -30.000000,
+	{"Racute", "Odieresis"}:           -30.000000,
+	{"Racute", "Ograve"}:              -30.000000,
+	{"Racute", "Ohungarumlaut"}:       -30.000000,
+	{"Racute", "Omacron"}:             -30.000000,
+	{"Racute", "Oslash"}:              -30.000000,
+	{"Racute", "Otilde"}:              -30.000000,
+	{"Racute", "T"}:                   -40.000000,
+	{"Racute", "Tcaron"}:              -40.000000,
+	{"Racute", "Tcommaaccent"}:        -40.000000,
+	{"Racute", "U"}:                   -30.000000,
+	{"Racute", "Uacute"}:              -30.000000,
+	{"Racute", "Ucircumflex"}:         -30.000000,
+	{"Racute", "Udieresis"}:           -30.000000,
+	{"Racute", "Ugrave"}:              -30.000000,
+	{"Racute", "Uhungarumlaut"}:       -30.000000,
+	{"Racute", "Umacron"}:             -30.000000,
+	{"Racute", "Uogonek"}:             -30.000000,
+	{"Racute", "Uring"}:               -30.000000,
+	{"Racute", "V"}:                   -55.000000,
+	{"Racute", "W"}:                   -35.000000,
+	{"Racute", "Y"}:                   -35.000000,
+	{"Racute", "Yacute"}:              -35.000000,
+	{"Racute", "Ydieresis"}:           -35.000000,
+	{"Rcaron", "O"}:                   -30.000000,
+	{"Rcaron", "Oacute"}:              -30.000000,
+	{"Rcaron", "Ocircumflex"}:         -30.000000,
+	{"Rcaron", "Odieresis"}:           -30.000000,
+	{"Rcaron", "Ograve"}:              -30.000000,
+	{"Rcaron", "Ohungarumlaut"}:       -30.000000,
+	{"Rcaron", "Omacron"}:             -30.000000,
+	{"Rcaron", "Oslash"}:              -30.000000,
+	{"Rcaron", "Otilde"}:              -30.000000,
+	{"Rcaron", "T"}:                   -40.000000,
+	{"Rcaron", "Tcaron"}:              -40.000000,
+	{"Rcaron", "Tcommaaccent"}:        -40.000000,
+	{"Rcaron", "U"}:                   -30.000000,
+	{"Rcaron", "Uacute"}:              -30.000000,
+	{"Rcaron", "Ucircumflex"}:         -30.000000,
+	{"Rcaron", "Udieresis"}:           -30.000000,
+	{"Rcaron", "Ugrave"}:              -30.000000,
+	{"Rcaron", "Uhungarumlaut"}:       -30.000000,
+	{"Rcaron", "Umacron"}:             -30.000000,
+	{"Rcaron", "Uogonek"}:             -30.000000,
+	{"Rcaron", "Uring"}:               -30.000000,
+	{"Rcaron", "V"}:                   -55.000000,
+	{"Rcaron", "W"}:                   -35.000000,
+	{"Rcaron", "Y"}:                   -35.000000,
+	{"Rcaron", "Yacute"}:              -35.000000,
+	{"Rcaron", "Ydieresis"}:           -35.000000,
+	{"Rcommaaccent", "O"}:             -30.000000,
+	{"Rcommaaccent", "Oacute"}:        -30.000000,
+	{"Rcommaaccent", "Ocircumflex"}:   -30.000000,
+	{"Rcommaaccent", "Odieresis"}:     -30.000000,
+	{"Rcommaaccent", "Ograve"}:        -30.000000,
+	{"Rcommaaccent", "Ohungarumlaut"}: -30.000000,
+	{"Rcommaaccent", "Omacron"}:       -30.000000,
+	{"Rcommaaccent", "Oslash"}:        -30.000000,
+	{"Rcommaaccent", "Otilde"}:        -30.000000,
+	{"Rcommaaccent", "T"}:             -40.000000,
+	{"Rcommaaccent", "Tcaron"}:        -40.000000,
+	{"Rcommaaccent", "Tcommaaccent"}:  -40.000000,
+	{"Rcommaaccent", "U"}:             -30.000000,
+	{"Rcommaaccent", "Uacute"}:        -30.000000,
+	{"Rcommaaccent", "Ucircumflex"}:   -30.000000,
+	{"Rcommaaccent", "Udieresis"}:     -30.000000,
+	{"Rcommaaccent", "Ugrave"}:        -30.000000,
+	{"Rcommaaccent", "Uhungarumlaut"}: -30.000000,
+	{"Rcommaaccent", "Umacron"}:       -30.000000,
+	{"Rcommaaccent", "Uogonek"}:       -30.000000,
+	{"Rcommaaccent", "Uring"}:         -30.000000,
+	{"Rcommaaccent", "V"}:             -55.000000,
+	{"Rcommaaccent", "W"}:             -35.000000,
+	{"Rcommaaccent", "Y"}:             -35.000000,
+	{"Rcommaaccent", "Yacute"}:        -35.000000,
+	{"Rcommaaccent", "Ydieresis"}:     -35.000000,
+	{"T", "A"}:                        -90.000000,
+	{"T", "Aacute"}:                   -90.000000,
+	{"T", "Abreve"}:                   -90.000000,
+	{"T", "Acircumflex"}:              -90.000000,
+	{"T", "Adieresis"}:                -90.000000,
+	{"T", "Agrave"}:                   -90.000000,
+	{"T", "Amacron"}:                  -90.000000,
+	{"T", "Aogonek"}:                  -90.000000,
+	{"T", "Aring"}:                    -90.000000,
+	{"T", "Atilde"}:                   -90.000000,
+	{"T", "O"}:                        -18.000000,
+	{"T", "Oacute"}:                   -18.000000,
+	{"T", "Ocircumflex"}:              -18.000000,
+	{"T", "Odieresis"}:                -18.000000,
+	{"T", "Ograve"}:                   -18.000000,
+	{"T", "Ohungarumlaut"}:            -18.000000,
+	{"T", "Omacron"}:                  -18.000000,
+	{"T", "Oslash"}:                   -18.000000,
+	{"T", "Otilde"}:                   -18.000000,
+	{"T", "a"}:                        -92.000000,
+	{"T", "aacute"}:                   -92.000000,
+	{"T", "abreve"}:                   -52.000000,
+	{"T", "acircumflex"}:              -52.000000,
+	{"T", "adieresis"}:                -52.000000,
+	{"T", "agrave"}:                   -52.000000,
+	{"T", "amacron"}:                  -52.000000,
+	{"T", "aogonek"}:                  -92.000000,
+	{"T", "aring"}:                    -92.000000,
+	{"T", "atilde"}:                   -52.000000,
+	{"T", "colon"}:                    -74.000000,
+	{"T", "comma"}:                    -74.000000,
+	{"T", "e"}:                        -92.000000,
+	{"T", "eacute"}:                   -92.000000,
+	{"T", "ecaron"}:                   -92.000000,
+	{"T", "ecircumflex"}:              -92.000000,
+	{"T", "edieresis"}:                -52.000000,
+	{"T", "edotaccent"}:               -92.000000,
+	{"T", "egrave"}:                   -52.000000,
+	{"T", "emacron"}:                  -52.000000,
+	{"T", "eogonek"}:                  -92.000000,
+	{"T", "hyphen"}:                   -92.000000,
+	{"T", "i"}:                        -18.000000,
+	{"T", "iacute"}:                   -18.000000,
+	{"T", "iogonek"}:                  -18.000000,
+	{"T", "o"}:                        -92.000000,
+	{"T", "oacute"}:                   -92.000000,
+	{"T", "ocircumflex"}:              -92.000000,
+	{"T", "odieresis"}:                -92.000000,
+	{"T", "ograve"}:                   -92.000000,
+	{"T", "ohungarumlaut"}:            -92.000000,
+	{"T", "omacron"}:                  -92.000000,
+	{"T", "oslash"}:                   -92.000000,
+	{"T", "otilde"}:                   -92.000000,
+	{"T", "period"}:                   -90.000000,
+	{"T", "r"}:                        -74.000000,
+	{"T", "racute"}:                   -74.000000,
+	{"T", "rcaron"}:                   -74.000000,
+	{"T", "rcommaaccent"}:             -74.000000,
+	{"T", "semicolon"}:                -74.000000,
+	{"T", "u"}:                        -92.000000,
+	{"T", "uacute"}:                   -92.000000,
+	{"T", "ucircumflex"}:              -92.000000,
+	{"T", "udieresis"}:                -92.000000,
+	{"T", "ugrave"}:                   -92.000000,
+	{"T", "uhungarumlaut"}:            -92.000000,
+	{"T", "umacron"}:                  -92.000000,
+	{"T", "uogonek"}:                  -92.000000,
+	{"T", "uring"}:                    -92.000000,
+	{"T", "w"}:                        -74.000000,
+	{"T", "y"}:                        -34.000000,
+	{"T", "yacute"}:                   -34.000000,
+	{"T", "ydieresis"}:                -34.000000,
+	{"Tcaron", "A"}:                   -90.000000,
+	{"Tcaron", "Aacute"}:              -90.000000,
+	{"Tcaron", "Abreve"}:              -90.000000,
+	{"Tcaron", "Acircumflex"}:         -90.000000,
+	{"Tcaron", "Adieresis"}:           -90.000000,
+	{"Tcaron", "Agrave"}:              -90.000000,
+	{"Tcaron", "Amacron"}:             -90.000000,
+	{"Tcaron", "Aogonek"}:             -90.000000,
+	{"Tcaron", "Aring"}:               -90.000000,
+	{"Tcaron", "Atilde"}:              -90.000000,
+	{"Tcaron", "O"}:                   -18.000000,
+	{"Tcaron", "Oacute"}:              -18.000000,
+	{"Tcaron", "Ocircumflex"}:         -18.000000,
+	{"Tcaron", "Odieresis"}:           -18.000000,
+	{"Tcaron", "Ograve"}:              -18.000000,
+	{"Tcaron", "Ohungarumlaut"}:       -18.000000,
+	{"Tcaron", "Omacron"}:             -18.000000,
+	{"Tcaron", "Oslash"}:              -18.000000,
+	{"Tcaron", "Otilde"}:              -18.000000,
+	{"Tcaron", "a"}:                   -92.000000,
+	{"Tcaron", "aacute"}:              -92.000000,
+	{"Tcaron", "abreve"}:              -52.000000,
+	{"Tcaron", "acircumflex"}:         -52.000000,
+	{"Tcaron", "adieresis"}:           -52.000000,
+	{"Tcaron", "agrave"}:              -52.000000,
+	{"Tcaron", "amacron"}:             -52.000000,
+	{"Tcaron", "aogonek"}:             -92.000000,
+	{"Tcaron", "aring"}:               -92.000000,
+	{"Tcaron", "atilde"}:              -52.000000,
+	{"Tcaron", "colon"}:               -74.000000,
+	{"Tcaron", "comma"}:               -74.000000,
+	{"Tcaron", "e"}:                   -92.000000,
+	{"Tcaron", "eacute"}:              -92.000000,
+	{"Tcaron", "ecaron"}:              -92.000000,
+	{"Tcaron", "ecircumflex"}:         -92.000000,
+	{"Tcaron", "edieresis"}:           -52.000000,
+	{"Tcaron", "edotaccent"}:          -92.000000,
+	{"Tcaron", "egrave"}:              -52.000000,
+	{"Tcaron", "emacron"}:             -52.000000,
+	{"Tcaron", "eogonek"}:             -92.000000,
+	{"Tcaron", "hyphen"}:              -92.000000,
+	{"Tcaron", "i"}:                   -18.000000,
+	{"Tcaron", "iacute"}:              -18.000000,
+	{"Tcaron", "iogonek"}:             -18.000000,
+	{"Tcaron", "o"}:                   -92.000000,
+	{"Tcaron", "oacute"}:              -92.000000,
+	{"Tcaron", "ocircumflex"}:         -92.000000,
+	{"Tcaron", "odieresis"}:           -92.000000,
+	{"Tcaron", "ograve"}:              -92.000000,
+	{"Tcaron", "ohungarumlaut"}:       -92.000000,
+	{"Tcaron", "omacron"}:             -92.000000,
+	{"Tcaron", "oslash"}:              -92.000000,
+	{"Tcaron", "otilde"}:              -92.000000,
+	{"Tcaron", "period"}:              -90.000000,
+	{"Tcaron", "r"}:                   -74.000000,
+	{"Tcaron", "racute"}:              -74.000000,
+	{"Tcaron", "rcaron"}:              -74.000000,
+	{"Tcaron", "rcommaaccent"}:        -74.000000,
+	{"Tcaron", "semicolon"}:           -74.000000,
+	{"Tcaron", "u"}:                   -92.000000,
+	{"Tcaron", "uacute"}:              -92.000000,
+	{"Tcaron", "ucircumflex"}:         -92.000000,
+	{"Tcaron", "udieresis"}:           -92.000000,
+	{"Tcaron", "ugrave"}:              -92.000000,
+	{"Tcaron", "uhungarumlaut"}:       -92.000000,
+	{"Tcaron", "umacron"}:             -92.000000,
+	{"Tcaron", "uogonek"}:             -92.000000,
+	{"Tcaron", "uring"}:               -92.000000,
+	{"Tcaron", "w"}:                   -74.000000,
+	{"Tcaron", "y"}:                   -34.000000,
+	{"Tcaron", "yacute"}:              -34.000000,
+	{"Tcaron", "ydieresis"}:           -34.000000,
+	{"Tcommaaccent", "A"}:             -90.000000,
+	{"Tcommaaccent", "Aacute"}:        -90.000000,
+	{"Tcommaaccent", "Abreve"}:        -90.000000,
+	{"Tcommaaccent", "Acircumflex"}:   -90.000000,
+	{"Tcommaaccent", "Adieresis"}:     -90.000000,
+	{"Tcommaaccent", "Agrave"}:        -90.000000,
+	{"Tcommaaccent", "Amacron"}:       -90.000000,
+	{"Tcommaaccent", "Aogonek"}:       -90.000000,
+	{"Tcommaaccent", "Aring"}:         -90.000000,
+	{"Tcommaaccent", "Atilde"}:        -90.000000,
+	{"Tcommaaccent", "O"}:             -18.000000,
+	{"Tcommaaccent", "Oacute"}:        -18.000000,
+	{"Tcommaaccent", "Ocircumflex"}:   -18.000000,
+	{"Tcommaaccent", "Odieresis"}:     -18.000000,
+	{"Tcommaaccent", "Ograve"}:        -18.000000,
+	{"Tcommaaccent", "Ohungarumlaut"}: -18.000000,
+	{"Tcommaaccent", "Omacron"}:       -18.000000,
+	{"Tcommaaccent", "Oslash"}:        -18.000000,
+	{"Tcommaaccent", "Otilde"}:        -18.000000,
+	{"Tcommaaccent", "a"}:             -92.000000,
+	{"Tcommaaccent", "aacute"}:        -92.000000,
+	{"Tcommaaccent", "abreve"}:        -52.000000,
+	{"Tcommaaccent", "acircumflex"}:   -52.000000,
+	{"Tcommaaccent", "adieresis"}:     -52.000000,
+	{"Tcommaaccent", "agrave"}:        -52.000000,
+	{"Tcommaaccent", "amacron"}:       -52.000000,
+	{"Tcommaaccent", "aogonek"}:       -92.000000,
+	{"Tcommaaccent", "aring"}:         -92.000000,
+	{"Tcommaaccent", "atilde"}:        -52.000000,
+	{"Tcommaaccent", "colon"}:         -74.000000,
+	{"Tcommaaccent", "comma"}:         -74.000000,
+	{"Tcommaaccent", "e"}:             -92.000000,
+	{"Tcommaaccent", "eacute"}:        -92.000000,
+	{"Tcommaaccent", "ecaron"}:        -92.000000,
+	{"Tcommaaccent", "ecircumflex"}:   -92.000000,
+	{"Tcommaaccent", "edieresis"}:     -52.000000,
+	{"Tcommaaccent", "edotaccent"}:    -92.000000,
+	{"Tcommaaccent", "egrave"}:        -52.000000,
+	{"Tcommaaccent", "emacron"}:       -52.000000,
+	{"Tcommaaccent", "eogonek"}:       -92.000000,
+	{"Tcommaaccent", "hyphen"}:        -92.000000,
+	{"Tcommaaccent", "i"}:             -18.000000,
+	{"Tcommaaccent", "iacute"}:        -18.000000,
+	{"Tcommaaccent", "iogonek"}:       -18.000000,
+	{"Tcommaaccent", "o"}:             -92.000000,
+	{"Tcommaaccent", "oacute"}:        -92.000000,
+	{"Tcommaaccent", "ocircumflex"}:   -92.000000,
+	{"Tcommaaccent", "odieresis"}:     -92.000000,
+	{"Tcommaaccent", "ograve"}:        -92.000000,
+	{"Tcommaaccent", "ohungarumlaut"}: -92.000000,
+	{"Tcommaaccent", "omacron"}:       -92.000000,
+	{"Tcommaaccent", "oslash"}:        -92.000000,
+	{"Tcommaaccent", "otilde"}:        -92.000000,
+	{"Tcommaaccent", "period"}:        -90.000000,
+	{"Tcommaaccent", "r"}:             -74.000000,
+	{"Tcommaaccent", "racute"}:        -74.000000,
+	{"Tcommaaccent", "rcaron"}:        -74.000000,
+	{"Tcommaaccent", "rcommaaccent"}:  -74.000000,
+	{"Tcommaaccent", "semicolon"}:     -74.000000,
+	{"Tcommaaccent", "u"}:             -92.000000,
+	{"Tcommaaccent", "uacute"}:        -92.000000,
+	{"Tcommaaccent", "ucircumflex"}:   -92.000000,
+	{"Tcommaaccent", "udieresis"}:     -92.000000,
+	{"Tcommaaccent", "ugrave"}:        -92.000000,
+	{"Tcommaaccent", "uhungarumlaut"}: -92.000000,
+	{"Tcommaaccent", "umacron"}:       -92.000000,
+	{"Tcommaaccent", "uogonek"}:       -92.000000,
+	{"Tcommaaccent", "uring"}:         -92.000000,
+	{"Tcommaaccent", "w"}:             -74.000000,
+	{"Tcommaaccent", "y"}:             -34.000000,
+	{"Tcommaaccent", "yacute"}:        -34.000000,
+	{"Tcommaaccent", "ydieresis"}:     -34.000000,
+	{"U", "A"}:                        -60.000000,
+	{"U", "Aacute"}:                   -60.000000,
+	{"U", "Abreve"}:                   -60.000000,
+	{"U", "Acircumflex"}:              -60.000000,
+	{"U", "Adieresis"}:                -60.000000,
+	{"U", "Agrave"}:                   -60.000000,
+	{"U", "Amacron"}:                  -60.000000,
+	{"U", "Aogonek"}:                  -60.000000,
+	{"U", "Aring"}:                    -60.000000,
+	{"U", "Atilde"}:                   -60.000000,
+	{"U", "comma"}:                    -50.000000,
+	{"U", "period"}:                   -50.000000,
+	{"Uacute", "A"}:                   -60.000000,
+	{"Uacute", "Aacute"}:              -60.000000,
+	{"Uacute", "Abreve"}:              -60.000000,
+	{"Uacute", "Acircumflex"}:         -60.000000,
+	{"Uacute", "Adieresis"}:           -60.000000,
+	{"Uacute", "Agrave"}:              -60.000000,
+	{"Uacute", "Amacron"}:             -60.000000,
+	{"Uacute", "Aogonek"}:             -60.000000,
+	{"Uacute", "Aring"}:               -60.000000,
+	{"Uacute", "Atilde"}:              -60.000000,
+	{"Uacute", "comma"}:               -50.000000,
+	{"Uacute", "period"}:              -50.000000,
+	{"Ucircumflex", "A"}:              -60.000000,
+	{"Ucircumflex", "Aacute"}:         -60.000000,
+	{"Ucircumflex", "Abreve"}:         -60.000000,
+	{"Ucircumflex", "Acircumflex"}:    -60.000000,
+	{"Ucircumflex", "Adieresis"}:      -60.000000,
+	{"Ucircumflex", "Agrave"}:         -60.000000,
+	{"Ucircumflex", "Amacron"}:        -60.000000,
+	{"Ucircumflex", "Aogonek"}:        -60.000000,
+	{"Ucircumflex", "Aring"}:          -60.000000,
+	{"Ucircumflex", "Atilde"}:         -60.000000,
+	{"Ucircumflex", "comma"}:          -50.000000,
+	{"Ucircumflex", "period"}:         -50.000000,
+	{"Udieresis", "A"}:                -60.000000,
+	{"Udieresis", "Aacute"}:           -60.000000,
+	{"Udieresis", "Abreve"}:           -60.000000,
+	{"Udieresis", "Acircumflex"}:      -60.000000,
+	{"Udieresis", "Adieresis"}:        -60.000000,
+	{"Udieresis", "Agrave"}:           -60.000000,
+	{"Udieresis", "Amacron"}:          -60.000000,
+	{"Udieresis", "Aogonek"}:          -60.000000,
+	{"Udieresis", "Aring"}:            -60.000000,
+	{"Udieresis", "Atilde"}:           -60.000000,
+	{"Udieresis", "comma"}:            -50.000000,
+	{"Udieresis", "period"}:           -50.000000,
+	{"Ugrave", "A"}:                   -60.000000,
+	{"Ugrave", "Aacute"}:              -60.000000,
+	{"Ugrave", "Abreve"}:              -60.000000,
+	{"Ugrave", "Acircumflex"}:         -60.000000,
+	{"Ugrave", "Adieresis"}:           -60.000000,
+	{"Ugrave", "Agrave"}:              -60.000000,
+	{"Ugrave", "Amacron"}:             -60.000000,
+	{"Ugrave", "Aogonek"}:             -60.000000,
+	{"Ugrave", "Aring"}:               -60.000000,
+	{"Ugrave", "Atilde"}:              -60.000000,
+	{"Ugrave", "comma"}:               -50.000000,
+	{"Ugrave", "period"}:              -50.000000,
+	{"Uhungarumlaut", "A"}:            -60.000000,
+	{"Uhungarumlaut", "Aacute"}:       -60.000000,
+	{"Uhungarumlaut", "Abreve"}:       -60.000000,
+	{"Uhungarumlaut", "Acircumflex"}:  -60.000000,
+	{"Uhungarumlaut", "Adieresis"}:    -60.000000,
+	{"Uhungarumlaut", "Agrave"}:       -60.000000,
+	{"Uhungarumlaut", "Amacron"}:      -60.000000,
+	{"Uhungarumlaut", "Aogonek"}:      -60.000000,
+	{"Uhungarumlaut", "Aring"}:        -60.000000,
+	{"Uhungarumlaut", "Atilde"}:       -60.000000,
+	{"Uhungarumlaut", "comma"}:        -50.000000,
+	{"Uhungarumlaut", "period"}:       -50.000000,
+	{"Umacron", "A"}:                  -60.000000,
+	{"Umacron", "Aacute"}:             -60.000000,
+	{"Umacron", "Abreve"}:             -60.000000,
+	{"Umacron", "Acircumflex"}:        -60.000000,
+	{"Umacron", "Adieresis"}:          -60.000000,
+	{"Umacron", "Agrave"}:             -60.000000,
+	{"Umacron", "Amacron"}:            -60.000000,
+	{"Umacron", "Aogonek"}:            -60.000000,
+	{"Umacron", "Aring"}:              -60.000000,
+	{"Umacron", "Atilde"}:             -60.000000,
+	{"Umacron", "comma"}:              -50.000000,
+	{"Umacron", "period"}:             -50.000000,
+	{"Uogonek", "A"}:                  -60.000000,
+	{"Uogonek", "Aacute"}:             -60.000000,
+	{"Uogonek", "Abreve"}:             -60.000000,
+	{"Uogonek", "Acircumflex"}:        -60.000000,
+	{"Uogonek", "Adieresis"}:          -60.000000,
+	{"Uogonek", "Agrave"}:             -60.000000,
+	{"Uogonek", "Amacron"}:            -60.000000,
+	{"Uogonek", "Aogonek"}:            -60.000000,
+	{"Uogonek", "Aring"}:              -60.000000,
+	{"Uogonek", "Atilde"}:             -60.000000,
+	{"Uogonek", "comma"}:              -50.000000,
+	{"Uogonek", "period"}:             -50.000000,
+	{"Uring", "A"}:                    -60.000000,
+	{"Uring", "Aacute"}:               -60.000000,
+	{"Uring", "Abreve"}:               -60.000000,
+	{"Uring", "Acircumflex"}:          -60.000000,
+	{"Uring", "Adieresis"}:            -60.000000,
+	{"Uring", "Agrave"}:               -60.000000,
+	{"Uring", "Amacron"}:              -60.000000,
+	{"Uring", "Aogonek"}:              -60.000000,
+	{"Uring", "Aring"}:                -60.000000,
+	{"Uring", "Atilde"}:               -60.000000,
+	{"Uring", "comma"}:                -50.000000,
+	{"Uring", "period"}:               -50.000000,
+	{"V", "A"}:                        -135.000000,
+	{"V", "Aacute"}:                   -135.000000,
+	{"V", "Abreve"}:                   -135.000000,
+	{"V", "Acircumflex"}:              -135.000000,
+	{"V", "Adieresis"}:                -135.000000,
+	{"V", "Agrave"}:                   -135.000000,
+	{"V", "Amacron"}:                  -135.000000,
+	{"V", "Aogonek"}:                  -135.000000,
+	{"V", "Aring"}:                    -135.000000,
+	{"V", "Atilde"}:                   -135.000000,
+	{"V", "G"}:                        -30.000000,
+	{"V", "Gbreve"}:                   -30.000000,
+	{"V", "Gcommaaccent"}:             -30.000000,
+	{"V", "O"}:                        -45.000000,
+	{"V", "Oacute"}:                   -45.000000,
+	{"V", "Ocircumflex"}:              -45.000000,
+	{"V", "Odieresis"}:                -45.000000,
+	{"V", "Ograve"}:                   -45.000000,
+	{"V", "Ohungarumlaut"}:            -45.000000,
+	{"V", "Omacron"}:                  -45.000000,
+	{"V", "Oslash"}:                   -45.000000,
+	{"V", "Otilde"}:                   -45.000000,
+	{"V", "a"}:                        -92.000000,
+	{"V", "aacute"}:                   -92.000000,
+	{"V", "abreve"}:                   -92.000000,
+	{"V", "acircumflex"}:              -92.000000,
+	{"V", "adieresis"}:                -92.000000,
+	{"V", "agrave"}:                   -92.000000,
+	{"V", "amacron"}:                  -92.000000,
+	{"V", "aogonek"}:                  -92.000000,
+	{"V", "aring"}:                    -92.000000,
+	{"V", "atilde"}:                   -92.000000,
+	{"V", "colon"}:                    -92.000000,
+	{"V", "comma"}:                    -129.000000,
+	{"V", "e"}:                        -100.000000,
+	{"V", "eacute"}:                   -100.000000,
+	{"V", "ecaron"}:                   -100.000000,
+	{"V", "ecircumflex"}:              -100.000000,
+	{"V", "edieresis"}:                -100.000000,
+	{"V", "edotaccent"}:               -100.000000,
+	{"V", "egrave"}:                   -100.000000,
+	{"V", "emacron"}:                  -100.000000,
+	{"V", "eogonek"}:                  -100.000000,
+	{"V", "hyphen"}:                   -74.000000,
+	{"V", "i"}:                        -37.000000,
+	{"V", "iacute"}:                   -37.000000,
+	{"V", "icircumflex"}:              -37.000000,
+	{"V", "idieresis"}:                -37.000000,
+	{"V", "igrave"}:                   -37.000000,
+	{"V", "imacron"}:                  -37.000000,
+	{"V", "iogonek"}:                  -37.000000,
+	{"V", "o"}:                        -100.000000,
+	{"V", "oacute"}:                   -100.000000,
+	{"V", "ocircumflex"}:              -100.000000,
+	{"V", "odieresis"}:                -100.000000,
+	{"V", "ograve"}:                   -100.000000,
+	{"V", "ohungarumlaut"}:            -100.000000,
+	{"V", "omacron"}:                  -100.000000,
+	{"V", "oslash"}:                   -100.000000,
+	{"V", "otilde"}:                   -100.000000,
+	{"V", "period"}:                   -145.000000,
+	{"V", "semicolon"}:                -92.000000,
+	{"V", "u"}:                        -92.000000,
+	{"V", "uacute"}:                   -92.000000,
+	{"V", "ucircumflex"}:              -92.000000,
+	{"V", "udieresis"}:                -92.000000,
+	{"V", "ugrave"}:                   -92.000000,
+	{"V", "uhungarumlaut"}:            -92.000000,
+	{"V", "umacron"}:                  -92.000000,
+	{"V", "uogonek"}:                  -92.000000,
+	{"V", "uring"}:                    -92.000000,
+	{"W", "A"}:                        -120.000000,
+	{"W", "Aacute"}:                   -120.000000,
+	{"W", "Abreve"}:                   -120.000000,
+	{"W", "Acircumflex"}:              -120.000000,
+	{"W", "Adieresis"}:                -120.000000,
+	{"W", "Agrave"}:                   -120.000000,
+	{"W", "Amacron"}:                  -120.000000,
+	{"W", "Aogonek"}:                  -120.000000,
+	{"W", "Aring"}:                    -120.000000,
+	{"W", "Atilde"}:                   -120.000000,
+	{"W", "O"}:                        -10.000000,
+	{"W", "Oacute"}:                   -10.000000,
+	{"W", "Ocircumflex"}:              -10.000000,
+	{"W", "Odieresis"}:                -10.000000,
+	{"W", "Ograve"}:                   -10.000000,
+	{"W", "Ohungarumlaut"}:            -10.000000,
+	{"W", "Omacron"}:                  -10.000000,
+	{"W", "Oslash"}:                   -10.000000,
+	{"W", "Otilde"}:                   -10.000000,
+	{"W", "a"}:                        -65.000000,
+	{"W", "aacute"}:                   -65.000000,
+	{"W", "abreve"}:                   -65.000000,
+	{"W", "acircumflex"}:              -65.000000,
+	{"W", "adieresis"}:                -65.000000,
+	{"W", "agrave"}:                   -65.000000,
+	{"W", "amacron"}:                  -65.000000,
+	{"W", "aogonek"}:                  -65.000000,
+	{"W", "aring"}:                    -65.000000,
+	{"W", "atilde"}:                   -65.000000,
+	{"W", "colon"}:                    -55.000000,
+	{"W", "comma"}:                    -92.000000,
+	{"W", "e"}:                        -65.000000,
+	{"W", "eacute"}:                   -65.000000,
+	{"W", "ecaron"}:                   -65.000000,
+	{"W", "ecircumflex"}:              -65.000000,
+	{"W", "edieresis"}:                -65.000000,
+	{"W", "edotaccent"}:               -65.000000,
+	{"W", "egrave"}:                   -65.000000,
+	{"W", "emacron"}:                  -65.000000,
+	{"W", "eogonek"}:                  -65.000000,
+	{"W", "hyphen"}:                   -37.000000,
+	{"W", "i"}:                        -18.000000,
+	{"W", "iacute"}:                   -18.000000,
+	{"W", "iogonek"}:                  -18.000000,
+	{"W", "o"}:                        -75.000000,
+	{"W", "oacute"}:                   -75.000000,
+	{"W", "ocircumflex"}:              -75.000000,
+	{"W", "odieresis"}:                -75.000000,
+	{"W", "ograve"}:                   -75.000000,
+	{"W", "ohungarumlaut"}:            -75.000000,
+	{"W", "omacron"}:                  -75.000000,
+	{"W", "oslash"}:                   -75.000000,
+	{"W", "otilde"}:                   -75.000000,
+	{"W", "period"}:                   -92.000000,
+	{"W", "semicolon"}:                -55.000000,
+	{"W", "u"}:                        -50.000000,
+	{"W", "uacute"}:                   -50.000000,
+	{"W", "ucircumflex"}:              -50.000000,
+	{"W", "udieresis"}:                -50.000000,
+	{"W", "ugrave"}:                   -50.000000,
+	{"W", "uhungarumlaut"}:            -50.000000,
+	{"W", "umacron"}:                  -50.000000,
+	{"W", "uogonek"}:                  -50.000000,
+	{"W", "uring"}:                    -50.000000,
+	{"W", "y"}:                        -60.000000,
+	{"W", "yacute"}:                   -60.000000,
+	{"W", "ydieresis"}:                -60.000000,
+	{"Y", "A"}:                        -110.000000,
+	{"Y", "Aacute"}:                   -110.000000,
+	{"Y", "Abreve"}:                   -110.000000,
+	{"Y", "Acircumflex"}:              -110.000000,
+	{"Y", "Adieresis"}:                -110.000000,
+	{"Y", "Agrave"}:                   -110.000000,
+	{"Y", "Amacron"}:                  -110.000000,
+	{"Y", "Aogonek"}:                  -110.000000,
+	{"Y", "Aring"}:                    -110.000000,
+	{"Y", "Atilde"}:                   -110.000000,
+	{"Y", "O"}:                        -35.000000,
+	{"Y", "Oacute"}:                   -35.000000,
+	{"Y", "Ocircumflex"}:              -35.000000,
+	{"Y", "Odieresis"}:                -35.000000,
+	{"Y", "Ograve"}:                   -35.000000,
+	{"Y", "Ohungarumlaut"}:            -35.000000,
+	{"Y", "Omacron"}:                  -35.000000,
+	{"Y", "Oslash"}:                   -35.000000,
+	{"Y", "Otilde"}:                   -35.000000,
+	{"Y", "a"}:                        -85.000000,
+	{"Y", "aacute"}:                   -85.000000,
+	{"Y", "abreve"}:                   -85.000000,
+	{"Y", "acircumflex"}:              -85.000000,
+	{"Y", "adieresis"}:                -85.000000,
+	{"Y", "agrave"}:                   -85.000000,
+	{"Y", "amacron"}:                  -85.000000,
+	{"Y", "aogonek"}:                  -85.000000,
+	{"Y", "aring"}:                    -85.000000,
+	{"Y", "atilde"}:                   -85.000000,
+	{"Y", "colon"}:                    -92.000000,
+	{"Y", "comma"}:                    -92.000000,
+	{"Y", "e"}:                        -111.000000,
+	{"Y", "eacute"}:                   -111.000000,
+	{"Y", "ecaron"}:                   -111.000000,
+	{"Y", "ecircumflex"}:              -111.000000,
+	{"Y", "edieresis"}:                -71.000000,
+	{"Y", "edotaccent"}:               -111.000000,
+	{"Y", "egrave"}:                   -71.000000,
+	{"Y", "emacron"}:                  -71.000000,
+	{"Y", "eogonek"}:                  -111.000000,
+	{"Y", "hyphen"}:                   -92.000000,
+	{"Y", "i"}:                        -37.000000,
+	{"Y", "iacute"}:                   -37.000000,
+	{"Y", "iogonek"}:                  -37.000000,
+	{"Y", "o"}:                        -111.000000,
+	{"Y", "oacute"}:                   -111.000000,
+	{"Y", "ocircumflex"}:              -111.000000,
+	{"Y", "odieresis"}:                -111.000000,
+	{"Y", "ograve"}:                   -111.000000,
+	{"Y", "ohungarumlaut"}:            -111.000000,
+	{"Y", "omacron"}:                  -111.000000,
+	{"Y", "oslash"}:                   -111.000000,
+	{"Y", "otilde"}:                   -111.000000,
+	{"Y", "period"}:                   -92.000000,
+	{"Y", "semicolon"}:                -92.000000,
+	{"Y", "u"}:                        -92.000000,
+	{"Y", "uacute"}:                   -92.000000,
+	{"Y", "ucircumflex"}:              -92.000000,
+	{"Y", "udieresis"}:                -92.000000,
+	{"Y", "ugrave"}:                   -92.000000,
+	{"Y", "uhungarumlaut"}:            -92.000000,
+	{"Y", "umacron"}:                  -92.000000,
+	{"Y", "uogonek"}:                  -92.000000,
+	{"Y", "uring"}:                    -92.000000,
+	{"Yacute", "A"}:                   -110.000000,
+	{"Yacute", "Aacute"}:              -110.000000,
+	{"Yacute", "Abreve"}:              -110.000000,
+	{"Yacute", "Acircumflex"}:         -110.000000,
+	{"Yacute", "Adieresis"}:           -110.000000,
+	{"Yacute", "Agrave"}:              -110.000000,
+	{"Yacute", "Amacron"}:             -110.000000,
+	{"Yacute", "Aogonek"}:             -110.000000,
+	{"Yacute", "Aring"}:               -110.000000,
+	{"Yacute", "Atilde"}:              -110.000000,
+	{"Yacute", "O"}:                   -35.000000,
+	{"Yacute", "Oacute"}:              -35.000000,
+	{"Yacute", "Ocircumflex"}:         -35.000000,
+	{"Yacute", "Odieresis"}:           -35.000000,
+	{"Yacute", "Ograve"}:              -35.000000,
+	{"Yacute", "Ohungarumlaut"}:       -35.000000,
+	{"Yacute", "Omacron"}:             -35.000000,
+	{"Yacute", "Oslash"}:              -35.000000,
+	{"Yacute", "Otilde"}:              -35.000000,
+	{"Yacute", "a"}:                   -85.000000,
+	{"Yacute", "aacute"}:              -85.000000,
+	{"Yacute", "abreve"}:              -85.000000,
+	{"Yacute", "acircumflex"}:         -85.000000,
+	{"Yacute", "adieresis"}:           -85.000000,
+	{"Yacute", "agrave"}:              -85.000000,
+	{"Yacute", "amacron"}:             -85.000000,
+	{"Yacute", "aogonek"}:             -85.000000,
+	{"Yacute", "aring"}:               -85.000000,
+	{"Yacute", "atilde"}:              -85.000000,
+	{"Yacute", "colon"}:               -92.000000,
+	{"Yacute", "comma"}:               -92.000000,
+	{"Yacute", "e"}:                   -111.000000,
+	{"Yacute", "eacute"}:              -111.000000,
+	{"Yacute", "ecaron"}:              -111.000000,
+	{"Yacute", "ecircumflex"}:         -111.000000,
+	{"Yacute", "edieresis"}:           -71.000000,
+	{"Yacute", "edotaccent"}:          -111.000000,
+	{"Yacute", "egrave"}:              -71.000000,
+	{"Yacute", "emacron"}:             -71.000000,
+	{"Yacute", "eogonek"}:             -111.000000,
+	{"Yacute", "hyphen"}:              -92.000000,
+	{"Yacute", "i"}:                   -37.000000,
+	{"Yacute", "iacute"}:              -37.000000,
+	{"Yacute", "iogonek"}:             -37.000000,
+	{"Yacute", "o"}:                   -111.000000,
+	{"Yacute", "oacute"}:              -111.000000,
+	{"Yacute", "ocircumflex"}:         -111.000000,
+	{"Yacute", "odieresis"}:           -111.000000,
+	{"Yacute", "ograve"}:              -111.000000,
+	{"Yacute", "ohungarumlaut"}:       -111.000000,
+	{"Yacute", "omacron"}:             -111.000000,
+	{"Yacute", "oslash"}:              -111.000000,
+	{"Yacute", "otilde"}:              -111.000000,
+	{"Yacute", "period"}:              -92.000000,
+	{"Yacute", "semicolon"}:           -92.000000,
+	{"Yacute", "u"}:                   -92.000000,
+	{"Yacute", "uacute"}:              -92.000000,
+	{"Yacute", "ucircumflex"}:         -92.000000,
+	{"Yacute", "udieresis"}:           -92.000000,
+	{"Yacute", "ugrave"}:              -92.000000,
+	{"Yacute", "uhungarumlaut"}:       -92.000000,
+	{"Yacute", "umacron"}:             -92.000000,
+	{"Yacute", "uogonek"}:             -92.000000,
+	{"Yacute", "uring"}:               -92.000000,
+	{"Ydieresis", "A"}:                -110.000000,
+	{"Ydieresis", "Aacute"}:           -110.000000,
+	{"Ydieresis", "Abreve"}:           -110.000000,
+	{"Ydieresis", "Acircumflex"}:      -110.000000,
+	{"Ydieresis", "Adieresis"}:        -110.000000,
+	{"Ydieresis", "Agrave"}:           -110.000000,
+	{"Ydieresis", "Amacron"}:          -110.000000,
+	{"Ydieresis", "Aogonek"}:          -110.000000,
+	{"Ydieresis", "Aring"}:            -110.000000,
+	{"Ydieresis", "Atilde"}:           -110.000000,
+	{"Ydieresis", "O"}:                -35.000000,
+	{"Ydieresis", "Oacute"}:           -35.000000,
+	{"Ydieresis", "Ocircumflex"}:      -35.000000,
+	{"Ydieresis", "Odieresis"}:        -35.000000,
+	{"Ydieresis", "Ograve"}:           -35.000000,
+	{"Ydieresis", "Ohungarumlaut"}:    -35.000000,
+	{"Ydieresis", "Omacron"}:          -35.000000,
+	{"Ydieresis", "Oslash"}:           -35.000000,
+	{"Ydieresis", "Otilde"}:           -35.000000,
+	{"Ydieresis", "a"}:                -85.000000,
+	{"Ydieresis", "aacute"}:           -85.000000,
+	{"Ydieresis", "abreve"}:           -85.000000,
+	{"Ydieresis", "acircumflex"}:      -85.000000,
+	{"Ydieresis", "adieresis"}:        -85.000000,
+	{"Ydieresis", "agrave"}:           -85.000000,
+	{"Ydieresis", "amacron"}:          -85.000000,
+	{"Ydieresis", "aogonek"}:          -85.000000,
+	{"Ydieresis", "aring"}:            -85.000000,
+	{"Ydieresis", "atilde"}:           -85.000000,
+	{"Ydieresis", "colon"}:            -92.000000,
+	{"Ydieresis", "comma"}:            -92.000000,
+	{"Ydieresis", "e"}:                -111.000000,
+	{"Ydieresis", "eacute"}:           -111.000000,
+	{"Ydieresis", "ecaron"}:           -111.000000,
+	{"Ydieresis", "ecircumflex"}:      -111.000000,
+	{"Ydieresis", "edieresis"}:        -71.000000,
+	{"Ydieresis", "edotaccent"}:       -111.000000,
+	{"Ydieresis", "egrave"}:           -71.000000,
+	{"Ydieresis", "emacron"}:          -71.000000,
+	{"Ydieresis", "eogonek"}:          -111.000000,
+	{"Ydieresis", "hyphen"}:           -92.000000,
+	{"Ydieresis", "i"}:                -37.000000,
+	{"Ydieresis", "iacute"}:           -37.000000,
+	{"Ydieresis", "iogonek"}:          -37.000000,
+	{"Ydieresis", "o"}:                -111.000000,
+	{"Ydieresis", "oacute"}:           -111.000000,
+	{"Ydieresis", "ocircumflex"}:      -111.000000,
+	{"Ydieresis", "odieresis"}:        -111.000000,
+	{"Ydieresis", "ograve"}:           -111.000000,
+	{"Ydieresis", "ohungarumlaut"}:    -111.000000,
+	{"Ydieresis", "omacron"}:          -111.000000,
+	{"Ydieresis", "oslash"}:           -111.000000,
+	{"Ydieresis", "otilde"}:           -111.000000,
+	{"Ydieresis", "period"}:           -92.000000,
+	{"Ydieresis", "semicolon"}:        -92.000000,
+	{"Ydieresis", "u"}:                -92.000000,
+	{"Ydieresis", "uacute"}:           -92.000000,
+	{"Ydieresis", "ucircumflex"}:      -92.000000,
+	{"Ydieresis", "udieresis"}:        -92.000000,
+	{"Ydieresis", "ugrave"}:           -92.000000,
+	{"Ydieresis", "uhungarumlaut"}:    -92.000000,
+	{"Ydieresis", "umacron"}:          -92.000000,
+	{"Ydieresis", "uogonek"}:          -92.000000,
+	{"Ydieresis", "uring"}:            -92.000000,
+	{"a", "v"}:                        -25.000000,
+	{"aacute", "v"}:                   -25.000000,
+	{"abreve", "v"}:                   -25.000000,
+	{"acircumflex", "v"}:              -25.000000,
+	{"adieresis", "v"}:                -25.000000,
+	{"agrave", "v"}:                   -25.000000,
+	{"amacron", "v"}:                  -25.000000,
+	{"aogonek", "v"}:                  -25.000000,
+	{"aring", "v"}:                    -25.000000,
+	{"atilde", "v"}:                   -25.000000,
+	{"b", "b"}:                        -10.000000,
+	{"b", "period"}:                   -40.000000,
+	{"b", "u"}:                        -20.000000,
+	{"b", "uacute"}:                   -20.000000,
+	{"b", "ucircumflex"}:              -20.000000,
+	{"b", "udieresis"}:                -20.000000,
+	{"b", "ugrave"}:                   -20.000000,
+	{"b", "uhungarumlaut"}:            -20.000000,
+	{"b", "umacron"}:                  -20.000000,
+	{"b", "uogonek"}:                  -20.000000,
+	{"b", "uring"}:                    -20.000000,
+	{"b", "v"}:                        -15.000000,
+	{"comma", "quotedblright"}:        -45.000000,
+	{"comma", "quoteright"}:           -55.000000,
+	{"d", "w"}:                        -15.000000,
+	{"dcroat", "w"}:                   -15.000000,
+	{"e", "v"}:                        -15.000000,
+	{"eacute", "v"}:                   -15.000000,
+	{"ecaron", "v"}:                   -15.000000,
+	{"ecircumflex", "v"}:              -15.000000,
+	{"edieresis", "v"}:                -15.000000,
+	{"edotaccent", "v"}:               -15.000000,
+	{"egrave", "v"}:                   -15.000000,
+	{"emacron", "v"}:                  -15.000000,
+	{"eogonek", "v"}:                  -15.000000,
+	{"f", "comma"}:                    -15.000000,
+	{"f", "dotlessi"}:                 -35.000000,
+	{"f", "i"}:                        -25.000000,
+	{"f", "o"}:                        -25.000000,
+	{"f", "oacute"}:                   -25.000000,
+	{"f", "ocircumflex"}:              -25.000000,
+	{"f", "odieresis"}:                -25.000000,
+	{"f", "ograve"}:                   -25.000000,
+	{"f", "ohungarumlaut"}:            -25.000000,
+	{"f", "omacron"}:                  -25.000000,
+	{"f", "oslash"}:                   -25.000000,
+	{"f", "otilde"}:                   -25.000000,
+	{"f", "period"}:                   -15.000000,
+	{"f", "quotedblright"}:            50.000000,
+	{"f", "quoteright"}:               55.000000,
+	{"g", "period"}:                   -15.000000,
+	{"gbreve", "period"}:              -15.000000,
+	{"gcommaaccent", "period"}:        -15.000000,
+	{"h", "y"}:                        -15.000000,
+	{"h", "yacute"}:                   -15.000000,
+	{"h", "ydieresis"}:                -15.000000,
+	{"i", "v"}:                        -10.000000,
+	{"iacute", "v"}:                   -10.000000,
+	{"icircumflex", "v"}:              -10.000000,
+	{"idieresis", "v"}:                -10.000000,
+	{"igrave", "v"}:                   -10.000000,
+	{"imacron", "v"}:                  -10.000000,
+	{"iogonek", "v"}:                  -10.000000,
+	{"k", "e"}:                        -10.000000,
+	{"k", "eacute"}:                   -10.000000,
+	{"k", "ecaron"}:                   -10.000000,
+	{"k", "ecircumflex"}:              -10.000000,
+	{"k", "edieresis"}:                -10.000000,
+	{"k", "edotaccent"}:               -10.000000,
+	{"k", "egrave"}:                   -10.000000,
+	{"k", "emacron"}:                  -10.000000,
+	{"k", "eogonek"}:                  -10.000000,
+	{"k", "o"}:                        -15.000000,
+	{"k", "oacute"}:                   -15.000000,
+	{"k", "ocircumflex"}:              -15.000000,
+	{"k", "odieresis"}:                -15.000000,
+	{"k", "ograve"}:                   -15.000000,
+	{"k", "ohungarumlaut"}:            -15.000000,
+	{"k", "omacron"}:                  -15.000000,
+	{"k", "oslash"}:                   -15.000000,
+	{"k", "otilde"}:                   -15.000000,
+	{"k", "y"}:                        -15.000000,
+	{"k", "yacute"}:                   -15.000000,
+	{"k", "ydieresis"}:                -15.000000,
+	{"kcommaaccent", "e"}:             -10.000000,
+	{"kcommaaccent", "eacute"}:        -10.000000,
+	{"kcommaaccent", "ecaron"}:        -10.000000,
+	{"kcommaaccent", "ecircumflex"}:   -10.000000,
+	{"kcommaaccent", "edieresis"}:     -10.000000,
+	{"kcommaaccent", "edotaccent"}:    -10.000000,
+	{"kcommaaccent", "egrave"}:        -10.000000,
+	{"kcommaaccent", "emacron"}:       -10.000000,
+	{"kcommaaccent", "eogonek"}:       -10.000000,
+	{"kcommaaccent", "o"}:             -15.000000,
+	{"kcommaaccent", "oacute"}:        -15.000000,
+	{"kcommaaccent", "ocircumflex"}:   -15.000000,
+	{"kcommaaccent", "odieresis"}:     -15.000000,
+	{"kcommaaccent", "ograve"}:        -15.000000,
+	{"kcommaaccent", "ohungarumlaut"}: -15.000000,
+	{"kcommaaccent", "omacron"}:       -15.000000,
+	{"kcommaaccent", "oslash"}:        -15.000000,
+	{"kcommaaccent", "otilde"}:        -15.000000,
+	{"kcommaaccent", "y"}:             -15.000000,
+	{"kcommaaccent", "yacute"}:        -15.000000,
+	{"kcommaaccent", "ydieresis"}:     -15.000000,
+	{"n", "v"}:                        -40.000000,
+	{"nacute", "v"}:                   -40.000000,
+	{"ncaron", "v"}:                   -40.000000,
+	{"ncommaaccent", "v"}:             -40.000000,
+	{"ntilde", "v"}:                   -40.000000,
+	{"o", "v"}:                        -10.000000,
+	{"o", "w"}:                        -10.000000,
+	{"oacute", "v"}:                   -10.000000,
+	{"oacute", "w"}:                   -10.000000,
+	{"ocircumflex", "v"}:              -10.000000,
+	{"ocircumflex", "w"}:              -10.000000,
+	{"odieresis", "v"}:                -10.000000,
+	{"odieresis", "w"}:                -10.000000,
+	{"ograve", "v"}:                   -10.000000,
+	{"ograve", "w"}:                   -10.000000,
+	{"ohungarumlaut", "v"}:            -10.000000,
+	{"ohungarumlaut", "w"}:            -10.000000,
+	{"omacron", "v"}:                  -10.000000,
+	{"omacron", "w"}:                  -10.000000,
+	{"oslash", "v"}:                   -10.000000,
+	{"oslash", "w"}:                   -10.000000,
+	{"otilde", "v"}:                   -10.000000,
+	{"otilde", "w"}:                   -10.000000,
+	{"period", "quotedblright"}:       -55.000000,
+	{"period", "quoteright"}:          -55.000000,
+	{"quotedblleft", "A"}:             -10.000000,
+	{"quotedblleft", "Aacute"}:        -10.000000,
+	{"quotedblleft", "Abreve"}:        -10.000000,
+	{"quotedblleft", "Acircumflex"}:   -10.000000,
+	{"quotedblleft", "Adieresis"}:     -10.000000,
+	{"quotedblleft", "Agrave"}:        -10.000000,
+	{"quotedblleft", "Amacron"}:       -10.000000,
+	{"quotedblleft", "Aogonek"}:       -10.000000,
+	{"quotedblleft", "Aring"}:         -10.000000,
+	{"quotedblleft", "Atilde"}:        -10.000000,
+	{"quoteleft", "A"}:                -10.000000,
+	{"quoteleft", "Aacute"}:           -10.000000,
+	{"quoteleft", "Abreve"}:           -10.000000,
+	{"quoteleft", "Acircumflex"}:      -10.000000,
+	{"quoteleft", "Adieresis"}:        -10.000000,
+	{"quoteleft", "Agrave"}:           -10.000000,
+	{"quoteleft", "Amacron"}:          -10.000000,
+	{"quoteleft", "Aogonek"}:          -10.000000,
+	{"quoteleft", "Aring"}:            -10.000000,
+	{"quoteleft", "Atilde"}:           -10.000000,
+	{"quoteleft", "quoteleft"}:        -63.000000,
+	{"quoteright", "d"}:               -20.000000,
+	{"quoteright", "dcroat"}:          -20.000000,
+	{"quoteright", "quoteright"}:      -63.000000,
+	{"quoteright", "r"}:               -20.000000,
+	{"quoteright", "racute"}:          -20.000000,
+	{"quoteright", "rcaron"}:          -20.000000,
+	{"quoteright", "rcommaaccent"}:    -20.000000,
+	{"quoteright", "s"}:               -37.000000,
+	{"quoteright", "sacute"}:          -37.000000,
+	{"quoteright", "scaron"}:          -37.000000,
+	{"quoteright", "scedilla"}:        -37.000000,
+	{"quoteright", "scommaaccent"}:    -37.000000,
+	{"quoteright", "space"}:           -74.000000,
+	{"quoteright", "v"}:               -20.000000,
+	{"r", "c"}:                        -18.000000,
+	{"r", "cacute"}:                   -18.000000,
+	{"r", "ccaron"}:                   -18.000000,
+	{"r", "ccedilla"}:                 -18.000000,
+	{"r", "comma"}:                    -92.000000,
+	{"r", "e"}:                        -18.000000,
+	{"r", "eacute"}:                   -18.000000,
+	{"r", "ecaron"}:                   -18.000000,
+	{"r", "ecircumflex"}:              -18.000000,
+	{"r", "edieresis"}:                -18.000000,
+	{"r", "edotaccent"}:               -18.000000,
+	{"r", "egrave"}:                   -18.000000,
+	{"r", "emacron"}:                  -18.000000,
+	{"r", "eogonek"}:                  -18.000000,
+	{"r", "g"}:                        -10.000000,
+	{"r", "gbreve"}:                   -10.000000,
+	{"r", "gcommaaccent"}:             -10.000000,
+	{"r", "hyphen"}:                   -37.000000,
+	{"r", "n"}:                        -15.000000,
+	{"r", "nacute"}:                   -15.000000,
+	{"r", "ncaron"}:                   -15.000000,
+	{"r", "ncommaaccent"}:             -15.000000,
+	{"r", "ntilde"}:                   -15.000000,
+	{"r", "o"}:                        -18.000000,
+	{"r", "oacute"}:                   -18.000000,
+	{"r", "ocircumflex"}:              -18.000000,
+	{"r", "odieresis"}:                -18.000000,
+	{"r", "ograve"}:                   -18.000000,
+	{"r", "ohungarumlaut"}:            -18.000000,
+	{"r", "omacron"}:                  -18.000000,
+	{"r", "oslash"}:                   -18.000000,
+	{"r", "otilde"}:                   -18.000000,
+	{"r", "p"}:                        -10.000000,
+	{"r", "period"}:                   -100.000000,
+	{"r", "q"}:                        -18.000000,
+	{"r", "v"}:                        -10.000000,
+	{"racute", "c"}:                   -18.000000,
+	{"racute", "cacute"}:              -18.000000,
+	{"racute", "ccaron"}:              -18.000000,
+	{"racute", "ccedilla"}:            -18.000000,
+	{"racute", "comma"}:               -92.000000,
+	{"racute", "e"}:                   -18.000000,
+	{"racute", "eacute"}:              -18.000000,
+	{"racute", "ecaron"}:              -18.000000,
+	{"racute", "ecircumflex"}:         -18.000000,
+	{"racute", "edieresis"}:           -18.000000,
+	{"racute", "edotaccent"}:          -18.000000,
+	{"racute", "egrave"}:              -18.000000,
+	{"racute", "emacron"}:             -18.000000,
+	{"racute", "eogonek"}:             -18.000000,
+	{"racute", "g"}:                   -10.000000,
+	{"racute", "gbreve"}:              -10.000000,
+	{"racute", "gcommaaccent"}:        -10.000000,
+	{"racute", "hyphen"}:              -37.000000,
+	{"racute", "n"}:                   -15.000000,
+	{"racute", "nacute"}:              -15.000000,
+	{"racute", "ncaron"}:              -15.000000,
+	{"racute", "ncommaaccent"}:        -15.000000,
+	{"racute", "ntilde"}:              -15.000000,
+	{"racute", "o"}:                   -18.000000,
+	{"racute", "oacute"}:              -18.000000,
+	{"racute", "ocircumflex"}:         -18.000000,
+	{"racute", "odieresis"}:           -18.000000,
+	{"racute", "ograve"}:              -18.000000,
+	{"racute", "ohungarumlaut"}:       -18.000000,
+	{"racute", "omacron"}:             -18.000000,
+	{"racute", "oslash"}:              -18.000000,
+	{"racute", "otilde"}:              -18.000000,
+	{"racute", "p"}:                   -10.000000,
+	{"racute", "period"}:              -100.000000,
+	{"racute", "q"}:                   -18.000000,
+	{"racute", "v"}:                   -10.000000,
+	{"rcaron", "c"}:                   -18.000000,
+	{"rcaron", "cacute"}:              -18.000000,
+	{"rcaron", "ccaron"}:              -18.000000,
+	{"rcaron", "ccedilla"}:            -18.000000,
+	{"rcaron", "comma"}:               -92.000000,
+	{"rcaron", "e"}:                   -18.000000,
+	{"rcaron", "eacute"}:              -18.000000,
+	{"rcaron", "ecaron"}:              -18.000000,
+	{"rcaron", "ecircumflex"}:         -18.000000,
+	{"rcaron", "edieresis"}:           -18.000000,
+	{"rcaron", "edotaccent"}:          -18.000000,
+	{"rcaron", "egrave"}:              -18.000000,
+	{"rcaron", "emacron"}:             -18.000000,
+	{"rcaron", "eogonek"}:             -18.000000,
+	{"rcaron", "g"}:                   -10.000000,
+	{"rcaron", "gbreve"}:              -10.000000,
+	{"rcaron", "gcommaaccent"}:        -10.000000,
+	{"rcaron", "hyphen"}:              -37.000000,
+	{"rcaron", "n"}:                   -15.000000,
+	{"rcaron", "nacute"}:              -15.000000,
+	{"rcaron", "ncaron"}:              -15.000000,
+	{"rcaron", "ncommaaccent"}:        -15.000000,
+	{"rcaron", "ntilde"}:              -15.000000,
+	{"rcaron", "o"}:                   -18.000000,
+	{"rcaron", "oacute"}:              -18.000000,
+	{"rcaron", "ocircumflex"}:         -18.000000,
+	{"rcaron", "odieresis"}:           -18.000000,
+	{"rcaron", "ograve"}:              -18.000000,
+	{"rcaron", "ohungarumlaut"}:       -18.000000,
+	{"rcaron", "omacron"}:             -18.000000,
+	{"rcaron", "oslash"}:              -18.000000,
+	{"rcaron", "otilde"}:              -18.000000,
+	{"rcaron", "p"}:                   -10.000000,
+	{"rcaron", "period"}:              -100.000000,
+	{"rcaron", "q"}:                   -18.000000,
+	{"rcaron", "v"}:                   -10.000000,
+	{"rcommaaccent", "c"}:             -18.000000,
+	{"rcommaaccent", "cacute"}:        -18.000000,
+	{"rcommaaccent", "ccaron"}:        -18.000000,
+	{"rcommaaccent", "ccedilla"}:      -18.000000,
+	{"rcommaaccent", "comma"}:         -92.000000,
+	{"rcommaaccent", "e"}:             -18.000000,
+	{"rcommaaccent", "eacute"}:        -18.000000,
+	{"rcommaaccent", "ecaron"}:        -18.000000,
+	{"rcommaaccent", "ecircumflex"}:   -18.000000,
+	{"rcommaaccent", "edieresis"}:     -18.000000,
+	{"rcommaaccent", "edotaccent"}:    -18.000000,
+	{"rcommaaccent", "egrave"}:        -18.000000,
+	{"rcommaaccent", "emacron"}:       -18.000000,
+	{"rcommaaccent", "eogonek"}:       -18.000000,
+	{"rcommaaccent", "g"}:             -10.000000,
+	{"rcommaaccent", "gbreve"}:        -10.000000,
+	{"rcommaaccent", "gcommaaccent"}:  -10.000000,
+	{"rcommaaccent", "hyphen"}:        -37.000000,
+	{"rcommaaccent", "n"}:             -15.000000,
+	{"rcommaaccent", "nacute"}:        -15.000000,
+	{"rcommaaccent", "ncaron"}:        -15.000000,
+	{"rcommaaccent", "ncommaaccent"}:  -15.000000,
+	{"rcommaaccent", "ntilde"}:        -15.000000,
+	{"rcommaaccent", "o"}:             -18.000000,
+	{"rcommaaccent", "oacute"}:        -18.000000,
+	{"rcommaaccent", "ocircumflex"}:   -18.000000,
+	{"rcommaaccent", "odieresis"}:     -18.000000,
+	{"rcommaaccent", "ograve"}:        -18.000000,
+	{"rcommaaccent", "ohungarumlaut"}: -18.000000,
+	{"rcommaaccent", "omacron"}:       -18.000000,
+	{"rcommaaccent", "oslash"}:        -18.000000,
+	{"rcommaaccent", "otilde"}:        -18.000000,
+	{"rcommaaccent", "p"}:             -10.000000,
+	{"rcommaaccent", "period"}:        -100.000000,
+	{"rcommaaccent", "q"}:             -18.000000,
+	{"rcommaaccent", "v"}:             -10.000000,
+	{"space", "A"}:                    -55.000000,
+	{"space", "Aacute"}:               -55.000000,
+	{"space", "Abreve"}:               -55.000000,
+	{"space", "Acircumflex"}:          -55.000000,
+	{"space", "Adieresis"}:            -55.000000,
+	{"space", "Agrave"}:               -55.000000,
+	{"space", "Amacron"}:              -55.000000,
+	{"space", "Aogonek"}:              -55.000000,
+	{"space", "Aring"}:                -55.000000,
+	{"space", "Atilde"}:               -55.000000,
+	{"space", "T"}:                    -30.000000,
+	{"space", "Tcaron"}:               -30.000000,
+	{"space", "Tcommaaccent"}:         -30.000000,
+	{"space", "V"}:                    -45.000000,
+	{"space", "W"}:                    -30.000000,
+	{"space", "Y"}:                    -55.000000,
+	{"space", "Yacute"}:               -55.000000,
+	{"space", "Ydieresis"}:            -55.000000,
+	{"v", "a"}:                        -10.000000,
+	{"v", "aacute"}:                   -10.000000,
+	{"v", "abreve"}:                   -10.000000,
+	{"v", "acircumflex"}:              -10.000000,
+	{"v", "adieresis"}:                -10.000000,
+	{"v", "agrave"}:                   -10.000000,
+	{"v", "amacron"}:                  -10.000000,
+	{"v", "aogonek"}:                  -10.000000,
+	{"v", "aring"}:                    -10.000000,
+	{"v", "atilde"}:                   -10.000000,
+	{"v", "comma"}:                    -55.000000,
+	{"v", "e"}:                        -10.000000,
+	{"v", "eacute"}:                   -10.000000,
+	{"v", "ecaron"}:                   -10.000000,
+	{"v", "ecircumflex"}:              -10.000000,
+	{"v", "edieresis"}:                -10.000000,
+	{"v", "edotaccent"}:               -10.000000,
+	{"v", "egrave"}:                   -10.000000,
+	{"v", "emacron"}:                  -10.000000,
+	{"v", "eogonek"}:                  -10.000000,
+	{"v", "o"}:                        -10.000000,
+	{"v", "oacute"}:                   -10.000000,
+	{"v", "ocircumflex"}:              -10.000000,
+	{"v", "odieresis"}:                -10.000000,
+	{"v", "ograve"}:                   -10.000000,
+	{"v", "ohungarumlaut"}:            -10.000000,
+	{"v", "omacron"}:                  -10.000000,
+	{"v", "oslash"}:                   -10.000000,
+	{"v", "otilde"}:                   -10.000000,
+	{"v", "period"}:                   -70.000000,
+	{"w", "comma"}:                    -55.000000,
+	{"w", "o"}:                        -10.000000,
+	{"w", "oacute"}:                   -10.000000,
+	{"w", "ocircumflex"}:              -10.000000,
+	{"w", "odieresis"}:                -10.000000,
+	{"w", "ograve"}:                   -10.000000,
+	{"w", "ohungarumlaut"}:            -10.000000,
+	{"w", "omacron"}:                  -10.000000,
+	{"w", "oslash"}:                   -10.000000,
+	{"w", "otilde"}:                   -10.000000,
+	{"w", "period"}:                   -70.000000,
+	{"y", "comma"}:                    -55.000000,
+	{"y", "e"}:                        -10.000000,
+	{"y", "eacute"}:                   -10.000000,
+	{"y", "ecaron"}:                   -10.000000,
+	{"y", "ecircumflex"}:              -10.000000,
+	{"y", "edieresis"}:                -10.000000,
+	{"y", "edotaccent"}:               -10.000000,
+	{"y", "egrave"}:                   -10.000000,
+	{"y", "emacron"}:                  -10.000000,
+	{"y", "eogonek"}:                  -10.000000,
+	{"y", "o"}:                        -25.000000,
+	{"y", "oacute"}:                   -25.000000,
+	{"y", "ocircumflex"}:              -25.000000,
+	{"y", "odieresis"}:                -25.000000,
+	{"y", "ograve"}:                   -25.000000,
+	{"y", "ohungarumlaut"}:            -25.000000,
+	{"y", "omacron"}:                  -25.000000,
+	{"y", "oslash"}:                   -25.000000,
+	{"y", "otilde"}:                   -25.000000,
+	{"y", "period"}:                   -70.000000,
+	{"yacute", "comma"}:               -55.000000,
+	{"yacute", "e"}:                   -10.000000,
+	{"yacute", "eacute"}:              -10.000000,
+	{"yacute", "ecaron"}:              -10.000000,
+	{"yacute", "ecircumflex"}:         -10.000000,
+	{"yacute", "edieresis"}:           -10.000000,
+	{"yacute", "edotaccent"}:          -10.000000,
+	{"yacute", "egrave"}:              -10.000000,
+	{"yacute", "emacron"}:             -10.000000,
+	{"yacute", "eogonek"}:             -10.000000,
+	{"yacute", "o"}:                   -25.000000,
+	{"yacute", "oacute"}:              -25.000000,
+	{"yacute", "ocircumflex"}:         -25.000000,
+	{"yacute", "odieresis"}:           -25.000000,
+	{"yacute", "ograve"}:              -25.000000,
+	{"yacute", "ohungarumlaut"}:       -25.000000,
+	{"yacute", "omacron"}:             -25.000000,
+	{"yacute", "oslash"}:              -25.000000,
+	{"yacute", "otilde"}:              -25.000000,
+	{"yacute", "period"}:              -70.000000,
+	{"ydieresis", "comma"}:            -55.000000,
+	{"ydieresis", "e"}:                -10.000000,
+	{"ydieresis", "eacute"}:           -10.000000,
+	{"ydieresis", "ecaron"}:           -10.000000,
+	{"ydieresis", "ecircumflex"}:      -10.000000,
+	{"ydieresis", "edieresis"}:        -10.000000,
+	{"ydieresis", "edotaccent"}:       -10.000000,
+	{"ydieresis", "egrave"}:           -10.000000,
+	{"ydieresis", "emacron"}:          -10.000000,
+	{"ydieresis", "eogonek"}:          -10.000000,
+	{"ydieresis", "o"}:                -25.000000,
+	{"ydieresis", "oacute"}:           -25.000000,
+	{"ydieresis", "ocircumflex"}:      -25.000000,
+	{"ydieresis", "odieresis"}:        -25.000000,
+	{"ydieresis", "ograve"}:           -25.000000,
+	{"ydieresis", "ohungarumlaut"}:    -25.000000,
+	{"ydieresis", "omacron"}:          -25.000000,
+	{"ydieresis", "oslash"}:           -25.000000,
+	{"ydieresis", "otilde"}:           -25.000000,
+	{"ydieresis", "period"}:           -70.000000,
+}
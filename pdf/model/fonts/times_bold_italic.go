@@ -39,6 +39,22 @@ func (font fontTimesBoldItalic) GetGlyphCharMetrics(glyph string) (CharMetrics,
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font fontTimesBoldItalic) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := timesBoldItalicKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontTimesBoldItalic) GetAscentDescent() (float64, float64) {
+	return 683, -217
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontTimesBoldItalic) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontTimesBoldItalic) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -370,3 +386,2045 @@ var timesBoldItalicCharMetrics map[string]CharMetrics = map[string]CharMetrics{
 	"zdotaccent":     {GlyphName: "zdotaccent", Wx: 389.000000, Wy: 0.000000},
 	"zero":           {GlyphName: "zero", Wx: 500.000000, Wy: 0.000000},
 }
+
+// Times-BoldItalic kerning pairs loaded from afms/Times-BoldItalic.afm.  See afms/MustRead.html for license information.
+var timesBoldItalicKerning map[GlyphPair]float64 = map[GlyphPair]float64{
+	{"A", "C"}:                        -65.000000,
+	{"A", "Cacute"}:                   -65.000000,
+	{"A", "Ccaron"}:                   -65.000000,
+	{"A", "Ccedilla"}:                 -65.000000,
+	{"A", "G"}:                        -60.000000,
+	{"A", "Gbreve"}:                   -60.000000,
+	{"A", "Gcommaaccent"}:             -60.000000,
+	{"A", "O"}:                        -50.000000,
+	{"A", "Oacute"}:                   -50.000000,
+	{"A", "Ocircumflex"}:              -50.000000,
+	{"A", "Odieresis"}:                -50.000000,
+	{"A", "Ograve"}:                   -50.000000,
+	{"A", "Ohungarumlaut"}:            -50.000000,
+	{"A", "Omacron"}:                  -50.000000,
+	{"A", "Oslash"}:                   -50.000000,
+	{"A", "Otilde"}:                   -50.000000,
+	{"A", "Q"}:                        -55.000000,
+	{"A", "T"}:                        -55.000000,
+	{"A", "Tcaron"}:                   -55.000000,
+	{"A", "Tcommaaccent"}:             -55.000000,
+	{"A", "U"}:                        -50.000000,
+	{"A", "Uacute"}:                   -50.000000,
+	{"A", "Ucircumflex"}:              -50.000000,
+	{"A", "Udieresis"}:                -50.000000,
+	{"A", "Ugrave"}:                   -50.000000,
+	{"A", "Uhungarumlaut"}:            -50.000000,
+	{"A", "Umacron"}:                  -50.000000,
+	{"A", "Uogonek"}:                  -50.000000,
+	{"A", "Uring"}:                    -50.000000,
+	{"A", "V"}:                        -95.000000,
+	{"A", "W"}:                        -100.000000,
+	{"A", "Y"}:                        -70.000000,
+	{"A", "Yacute"}:                   -70.000000,
+	{"A", "Ydieresis"}:                -70.000000,
+	{"A", "quoteright"}:               -74.000000,
+	{"A", "u"}:                        -30.000000,
+	{"A", "uacute"}:                   -30.000000,
+	{"A", "ucircumflex"}:              -30.000000,
+	{"A", "udieresis"}:                -30.000000,
+	{"A", "ugrave"}:                   -30.000000,
+	{"A", "uhungarumlaut"}:            -30.000000,
+	{"A", "umacron"}:                  -30.000000,
+	{"A", "uogonek"}:                  -30.000000,
+	{"A", "uring"}:                    -30.000000,
+	{"A", "v"}:                        -74.000000,
+	{"A", "w"}:                        -74.000000,
+	{"A", "y"}:                        -74.000000,
+	{"A", "yacute"}:                   -74.000000,
+	{"A", "ydieresis"}:                -74.000000,
+	{"Aacute", "C"}:                   -65.000000,
+	{"Aacute", "Cacute"}:              -65.000000,
+	{"Aacute", "Ccaron"}:              -65.000000,
+	{"Aacute", "Ccedilla"}:            -65.000000,
+	{"Aacute", "G"}:                   -60.000000,
+	{"Aacute", "Gbreve"}:              -60.000000,
+	{"Aacute", "Gcommaaccent"}:        -60.000000,
+	{"Aacute", "O"}:                   -50.000000,
+	{"Aacute", "Oacute"}:              -50.000000,
+	{"Aacute", "Ocircumflex"}:         -50.000000,
+	{"Aacute", "Odieresis"}:           -50.000000,
+	{"Aacute", "Ograve"}:              -50.000000,
+	{"Aacute", "Ohungarumlaut"}:       -50.000000,
+	{"Aacute", "Omacron"}:             -50.000000,
+	{"Aacute", "Oslash"}:              -50.000000,
+	{"Aacute", "Otilde"}:              -50.000000,
+	{"Aacute", "Q"}:                   -55.000000,
+	{"Aacute", "T"}:                   -55.000000,
+	{"Aacute", "Tcaron"}:              -55.000000,
+	{"Aacute", "Tcommaaccent"}:        -55.000000,
+	{"Aacute", "U"}:                   -50.000000,
+	{"Aacute", "Uacute"}:              -50.000000,
+	{"Aacute", "Ucircumflex"}:         -50.000000,
+	{"Aacute", "Udieresis"}:           -50.000000,
+	{"Aacute", "Ugrave"}:              -50.000000,
+	{"Aacute", "Uhungarumlaut"}:       -50.000000,
+	{"Aacute", "Umacron"}:             -50.000000,
+	{"Aacute", "Uogonek"}:             -50.000000,
+	{"Aacute", "Uring"}:               -50.000000,
+	{"Aacute", "V"}:                   -95.000000,
+	{"Aacute", "W"}:                   -100.000000,
+	{"Aacute", "Y"}:                   -70.000000,
+	{"Aacute", "Yacute"}:              -70.000000,
+	{"Aacute", "Ydieresis"}:           -70.000000,
+	{"Aacute", "quoteright"}:          -74.000000,
+	{"Aacute", "u"}:                   -30.000000,
+	{"Aacute", "uacute"}:              -30.000000,
+	{"Aacute", "ucircumflex"}:         -30.000000,
+	{"Aacute", "udieresis"}:           -30.000000,
+	{"Aacute", "ugrave"}:              -30.000000,
+	{"Aacute", "uhungarumlaut"}:       -30.000000,
+	{"Aacute", "umacron"}:             -30.000000,
+	{"Aacute", "uogonek"}:             -30.000000,
+	{"Aacute", "uring"}:               -30.000000,
+	{"Aacute", "v"}:                   -74.000000,
+	{"Aacute", "w"}:                   -74.000000,
+	{"Aacute", "y"}:                   -74.000000,
+	{"Aacute", "yacute"}:              -74.000000,
+	{"Aacute", "ydieresis"}:           -74.000000,
+	{"Abreve", "C"}:                   -65.000000,
+	{"Abreve", "Cacute"}:              -65.000000,
+	{"Abreve", "Ccaron"}:              -65.000000,
+	{"Abreve", "Ccedilla"}:            -65.000000,
+	{"Abreve", "G"}:                   -60.000000,
+	{"Abreve", "Gbreve"}:              -60.000000,
+	{"Abreve", "Gcommaaccent"}:        -60.000000,
+	{"Abreve", "O"}:                   -50.000000,
+	{"Abreve", "Oacute"}:              -50.000000,
+	{"Abreve", "Ocircumflex"}:         -50.000000,
+	{"Abreve", "Odieresis"}:           -50.000000,
+	{"Abreve", "Ograve"}:              -50.000000,
+	{"Abreve", "Ohungarumlaut"}:       -50.000000,
+	{"Abreve", "Omacron"}:             -50.000000,
+	{"Abreve", "Oslash"}:              -50.000000,
+	{"Abreve", "Otilde"}:              -50.000000,
+	{"Abreve", "Q"}:                   -55.000000,
+	{"Abreve", "T"}:                   -55.000000,
+	{"Abreve", "Tcaron"}:              -55.000000,
+	{"Abreve", "Tcommaaccent"}:        -55.000000,
+	{"Abreve", "U"}:                   -50.000000,
+	{"Abreve", "Uacute"}:              -50.000000,
+	{"Abreve", "Ucircumflex"}:         -50.000000,
+	{"Abreve", "Udieresis"}:           -50.000000,
+	{"Abreve", "Ugrave"}:              -50.000000,
+	{"Abreve", "Uhungarumlaut"}:       -50.000000,
+	{"Abreve", "Umacron"}:             -50.000000,
+	{"Abreve", "Uogonek"}:             -50.000000,
+	{"Abreve", "Uring"}:               -50.000000,
+	{"Abreve", "V"}:                   -95.000000,
+	{"Abreve", "W"}:                   -100.000000,
+	{"Abreve", "Y"}:                   -70.000000,
+	{"Abreve", "Yacute"}:              -70.000000,
+	{"Abreve", "Ydieresis"}:           -70.000000,
+	{"Abreve", "quoteright"}:          -74.000000,
+	{"Abreve", "u"}:                   -30.000000,
+	{"Abreve", "uacute"}:              -30.000000,
+	{"Abreve", "ucircumflex"}:         -30.000000,
+	{"Abreve", "udieresis"}:           -30.000000,
+	{"Abreve", "ugrave"}:              -30.000000,
+	{"Abreve", "uhungarumlaut"}:       -30.000000,
+	{"Abreve", "umacron"}:             -30.000000,
+	{"Abreve", "uogonek"}:             -30.000000,
+	{"Abreve", "uring"}:               -30.000000,
+	{"Abreve", "v"}:                   -74.000000,
+	{"Abreve", "w"}:                   -74.000000,
+	{"Abreve", "y"}:                   -74.000000,
+	{"Abreve", "yacute"}:              -74.000000,
+	{"Abreve", "ydieresis"}:           -74.000000,
+	{"Acircumflex", "C"}:              -65.000000,
+	{"Acircumflex", "Cacute"}:         -65.000000,
+	{"Acircumflex", "Ccaron"}:         -65.000000,
+	{"Acircumflex", "Ccedilla"}:       -65.000000,
+	{"Acircumflex", "G"}:              -60.000000,
+	{"Acircumflex", "Gbreve"}:         -60.000000,
+	{"Acircumflex", "Gcommaaccent"}:   -60.000000,
+	{"Acircumflex", "O"}:              -50.000000,
+	{"Acircumflex", "Oacute"}:         -50.000000,
+	{"Acircumflex", "Ocircumflex"}:    -50.000000,
+	{"Acircumflex", "Odieresis"}:      -50.000000,
+	{"Acircumflex", "Ograve"}:         -50.000000,
+	{"Acircumflex", "Ohungarumlaut"}:  -50.000000,
+	{"Acircumflex", "Omacron"}:        -50.000000,
+	{"Acircumflex", "Oslash"}:         -50.000000,
+	{"Acircumflex", "Otilde"}:         -50.000000,
+	{"Acircumflex", "Q"}:              -55.000000,
+	{"Acircumflex", "T"}:              -55.000000,
+	{"Acircumflex", "Tcaron"}:         -55.000000,
+	{"Acircumflex", "Tcommaaccent"}:   -55.000000,
+	{"Acircumflex", "U"}:              -50.000000,
+	{"Acircumflex", "Uacute"}:         -50.000000,
+	{"Acircumflex", "Ucircumflex"}:    -50.000000,
+	{"Acircumflex", "Udieresis"}:      -50.000000,
+	{"Acircumflex", "Ugrave"}:         -50.000000,
+	{"Acircumflex", "Uhungarumlaut"}:  -50.000000,
+	{"Acircumflex", "Umacron"}:        -50.000000,
+	{"Acircumflex", "Uogonek"}:        -50.000000,
+	{"Acircumflex", "Uring"}:          -50.000000,
+	{"Acircumflex", "V"}:              -95.000000,
+	{"Acircumflex", "W"}:              -100.000000,
+	{"Acircumflex", "Y"}:              -70.000000,
+	{"Acircumflex", "Yacute"}:         -70.000000,
+	{"Acircumflex", "Ydieresis"}:      -70.000000,
+	{"Acircumflex", "quoteright"}:     -74.000000,
+	{"Acircumflex", "u"}:              -30.000000,
+	{"Acircumflex", "uacute"}:         -30.000000,
+	{"Acircumflex", "ucircumflex"}:    -30.000000,
+	{"Acircumflex", "udieresis"}:      -30.000000,
+	{"Acircumflex", "ugrave"}:         -30.000000,
+	{"Acircumflex", "uhungarumlaut"}:  -30.000000,
+	{"Acircumflex", "umacron"}:        -30.000000,
+	{"Acircumflex", "uogonek"}:        -30.000000,
+	{"Acircumflex", "uring"}:          -30.000000,
+	{"Acircumflex", "v"}:              -74.000000,
+	{"Acircumflex", "w"}:              -74.000000,
+	{"Acircumflex", "y"}:              -74.000000,
+	{"Acircumflex", "yacute"}:         -74.000000,
+	{"Acircumflex", "ydieresis"}:      -74.000000,
+	{"Adieresis", "C"}:                -65.000000,
+	{"Adieresis", "Cacute"}:           -65.000000,
+	{"Adieresis", "Ccaron"}:           -65.000000,
+	{"Adieresis", "Ccedilla"}:         -65.000000,
+	{"Adieresis", "G"}:                -60.000000,
+	{"Adieresis", "Gbreve"}:           -60.000000,
+	{"Adieresis", "Gcommaaccent"}:     -60.000000,
+	{"Adieresis", "O"}:                -50.000000,
+	{"Adieresis", "Oacute"}:           -50.000000,
+	{"Adieresis", "Ocircumflex"}:      -50.000000,
+	{"Adieresis", "Odieresis"}:        -50.000000,
+	{"Adieresis", "Ograve"}:           -50.000000,
+	{"Adieresis", "Ohungarumlaut"}:    -50.000000,
+	{"Adieresis", "Omacron"}:          -50.000000,
+	{"Adieresis", "Oslash"}:           -50.000000,
+	{"Adieresis", "Otilde"}:           -50.000000,
+	{"Adieresis", "Q"}:                -55.000000,
+	{"Adieresis", "T"}:                -55.000000,
+	{"Adieresis", "Tcaron"}:           -55.000000,
+	{"Adieresis", "Tcommaaccent"}:     -55.000000,
+	{"Adieresis", "U"}:                -50.000000,
+	{"Adieresis", "Uacute"}:           -50.000000,
+	{"Adieresis", "Ucircumflex"}:      -50.000000,
+	{"Adieresis", "Udieresis"}:        -50.000000,
+	{"Adieresis", "Ugrave"}:           -50.000000,
+	{"Adieresis", "Uhungarumlaut"}:    -50.000000,
+	{"Adieresis", "Umacron"}:          -50.000000,
+	{"Adieresis", "Uogonek"}:          -50.000000,
+	{"Adieresis", "Uring"}:            -50.000000,
+	{"Adieresis", "V"}:                -95.000000,
+	{"Adieresis", "W"}:                -100.000000,
+	{"Adieresis", "Y"}:                -70.000000,
+	{"Adieresis", "Yacute"}:           -70.000000,
+	{"Adieresis", "Ydieresis"}:        -70.000000,
+	{"Adieresis", "quoteright"}:       -74.000000,
+	{"Adieresis", "u"}:                -30.000000,
+	{"Adieresis", "uacute"}:           -30.000000,
+	{"Adieresis", "ucircumflex"}:      -30.000000,
+	{"Adieresis", "udieresis"}:        -30.000000,
+	{"Adieresis", "ugrave"}:           -30.000000,
+	{"Adieresis", "uhungarumlaut"}:    -30.000000,
+	{"Adieresis", "umacron"}:          -30.000000,
+	{"Adieresis", "uogonek"}:          -30.000000,
+	{"Adieresis", "uring"}:            -30.000000,
+	{"Adieresis", "v"}:                -74.000000,
+	{"Adieresis", "w"}:                -74.000000,
+	{"Adieresis", "y"}:                -74.000000,
+	{"Adieresis", "yacute"}:           -74.000000,
+	{"Adieresis", "ydieresis"}:        -74.000000,
+	{"Agrave", "C"}:                   -65.000000,
+	{"Agrave", "Cacute"}:              -65.000000,
+	{"Agrave", "Ccaron"}:              -65.000000,
+	{"Agrave", "Ccedilla"}:            -65.000000,
+	{"Agrave", "G"}:                   -60.000000,
+	{"Agrave", "Gbreve"}:              -60.000000,
+	{"Agrave", "Gcommaaccent"}:        -60.000000,
+	{"Agrave", "O"}:                   -50.000000,
+	{"Agrave", "Oacute"}:              -50.000000,
+	{"Agrave", "Ocircumflex"}:         -50.000000,
+	{"Agrave", "Odieresis"}:           -50.000000,
+	{"Agrave", "Ograve"}:              -50.000000,
+	{"Agrave", "Ohungarumlaut"}:       -50.000000,
+	{"Agrave", "Omacron"}:             -50.000000,
+	{"Agrave", "Oslash"}:              -50.000000,
+	{"Agrave", "Otilde"}:              -50.000000,
+	{"Agrave", "Q"}:                   -55.000000,
+	{"Agrave", "T"}:                   -55.000000,
+	{"Agrave", "Tcaron"}:              -55.000000,
+	{"Agrave", "Tcommaaccent"}:        -55.000000,
+	{"Agrave", "U"}:                   -50.000000,
+	{"Agrave", "Uacute"}:              -50.000000,
+	{"Agrave", "Ucircumflex"}:         -50.000000,
+	{"Agrave", "Udieresis"}:           -50.000000,
+	{"Agrave", "Ugrave"}:              -50.000000,
+	{"Agrave", "Uhungarumlaut"}:       -50.000000,
+	{"Agrave", "Umacron"}:             -50.000000,
+	{"Agrave", "Uogonek"}:             -50.000000,
+	{"Agrave", "Uring"}:               -50.000000,
+	{"Agrave", "V"}:                   -95.000000,
+	{"Agrave", "W"}:                   -100.000000,
+	{"Agrave", "Y"}:                   -70.000000,
+	{"Agrave", "Yacute"}:              -70.000000,
+	{"Agrave", "Ydieresis"}:           -70.000000,
+	{"Agrave", "quoteright"}:          -74.000000,
+	{"Agrave", "u"}:                   -30.000000,
+	{"Agrave", "uacute"}:              -30.000000,
+	{"Agrave", "ucircumflex"}:         -30.000000,
+	{"Agrave", "udieresis"}:           -30.000000,
+	{"Agrave", "ugrave"}:              -30.000000,
+	{"Agrave", "uhungarumlaut"}:       -30.000000,
+	{"Agrave", "umacron"}:             -30.000000,
+	{"Agrave", "uogonek"}:             -30.000000,
+	{"Agrave", "uring"}:               -30.000000,
+	{"Agrave", "v"}:                   -74.000000,
+	{"Agrave", "w"}:                   -74.000000,
+	{"Agrave", "y"}:                   -74.000000,
+	{"Agrave", "yacute"}:              -74.000000,
+	{"Agrave", "ydieresis"}:           -74.000000,
+	{"Amacron", "C"}:                  -65.000000,
+	{"Amacron", "Cacute"}:             -65.000000,
+	{"Amacron", "Ccaron"}:             -65.000000,
+	{"Amacron", "Ccedilla"}:           -65.000000,
+	{"Amacron", "G"}:                  -60.000000,
+	{"Amacron", "Gbreve"}:             -60.000000,
+	{"Amacron", "Gcommaaccent"}:       -60.000000,
+	{"Amacron", "O"}:                  -50.000000,
+	{"Amacron", "Oacute"}:             -50.000000,
+	{"Amacron", "Ocircumflex"}:        -50.000000,
+	{"Amacron", "Odieresis"}:          -50.000000,
+	{"Amacron", "Ograve"}:             -50.000000,
+	{"Amacron", "Ohungarumlaut"}:      -50.000000,
+	{"Amacron", "Omacron"}:            -50.000000,
+	{"Amacron", "Oslash"}:             -50.000000,
+	{"Amacron", "Otilde"}:             -50.000000,
+	{"Amacron", "Q"}:                  -55.000000,
+	{"Amacron", "T"}:                  -55.000000,
+	{"Amacron", "Tcaron"}:             -55.000000,
+	{"Amacron", "Tcommaaccent"}:       -55.000000,
+	{"Amacron", "U"}:                  -50.000000,
+	{"Amacron", "Uacute"}:             -50.000000,
+	{"Amacron", "Ucircumflex"}:        -50.000000,
+	{"Amacron", "Udieresis"}:          -50.000000,
+	{"Amacron", "Ugrave"}:             -50.000000,
+	{"Amacron", "Uhungarumlaut"}:      -50.000000,
+	{"Amacron", "Umacron"}:            -50.000000,
+	{"Amacron", "Uogonek"}:            -50.000000,
+	{"Amacron", "Uring"}:              -50.000000,
+	{"Amacron", "V"}:                  -95.000000,
+	{"Amacron", "W"}:                  -100.000000,
+	{"Amacron", "Y"}:                  -70.000000,
+	{"Amacron", "Yacute"}:             -70.000000,
+	{"Amacron", "Ydieresis"}:          -70.000000,
+	{"Amacron", "quoteright"}:         -74.000000,
+	{"Amacron", "u"}:                  -30.000000,
+	{"Amacron", "uacute"}:             -30.000000,
+	{"Amacron", "ucircumflex"}:        -30.000000,
+	{"Amacron", "udieresis"}:          -30.000000,
+	{"Amacron", "ugrave"}:             -30.000000,
+	{"Amacron", "uhungarumlaut"}:      -30.000000,
+	{"Amacron", "umacron"}:            -30.000000,
+	{"Amacron", "uogonek"}:            -30.000000,
+	{"Amacron", "uring"}:              -30.000000,
+	{"Amacron", "v"}:                  -74.000000,
+	{"Amacron", "w"}:                  -74.000000,
+	{"Amacron", "y"}:                  -74.000000,
+	{"Amacron", "yacute"}:             -74.000000,
+	{"Amacron", "ydieresis"}:          -74.000000,
+	{"Aogonek", "C"}:                  -65.000000,
+	{"Aogonek", "Cacute"}:             -65.000000,
+	{"Aogonek", "Ccaron"}:             -65.000000,
+	{"Aogonek", "Ccedilla"}:           -65.000000,
+	{"Aogonek", "G"}:                  -60.000000,
+	{"Aogonek", "Gbreve"}:             -60.000000,
+	{"Aogonek", "Gcommaaccent"}:       -60.000000,
+	{"Aogonek", "O"}:                  -50.000000,
+	{"Aogonek", "Oacute"}:             -50.000000,
+	{"Aogonek", "Ocircumflex"}:        -50.000000,
+	{"Aogonek", "Odieresis"}:          -50.000000,
+	{"Aogonek", "Ograve"}:             -50.000000,
+	{"Aogonek", "Ohungarumlaut"}:      -50.000000,
+	{"Aogonek", "Omacron"}:            -50.000000,
+	{"Aogonek", "Oslash"}:             -50.000000,
+	{"Aogonek", "Otilde"}:             -50.000000,
+	{"Aogonek", "Q"}:                  -55.000000,
+	{"Aogonek", "T"}:                  -55.000000,
+	{"Aogonek", "Tcaron"}:             -55.000000,
+	{"Aogonek", "Tcommaaccent"}:       -55.000000,
+	{"Aogonek", "U"}:                  -50.000000,
+	{"Aogonek", "Uacute"}:             -50.000000,
+	{"Aogonek", "Ucircumflex"}:        -50.000000,
+	{"Aogonek", "Udieresis"}:          -50.000000,
+	{"Aogonek", "Ugrave"}:             -50.000000,
+	{"Aogonek", "Uhungarumlaut"}:      -50.000000,
+	{"Aogonek", "Umacron"}:            -50.000000,
+	{"Aogonek", "Uogonek"}:            -50.000000,
+	{"Aogonek", "Uring"}:              -50.000000,
+	{"Aogonek", "V"}:                  -95.000000,
+	{"Aogonek", "W"}:                  -100.000000,
+	{"Aogonek", "Y"}:                  -70.000000,
+	{"Aogonek", "Yacute"}:             -70.000000,
+	{"Aogonek", "Ydieresis"}:          -70.000000,
+	{"Aogonek", "quoteright"}:         -74.000000,
+	{"Aogonek", "u"}:                  -30.000000,
+	{"Aogonek", "uacute"}:             -30.000000,
+	{"Aogonek", "ucircumflex"}:        -30.000000,
+	{"Aogonek", "udieresis"}:          -30.000000,
+	{"Aogonek", "ugrave"}:             -30.000000,
+	{"Aogonek", "uhungarumlaut"}:      -30.000000,
+	{"Aogonek", "umacron"}:            -30.000000,
+	{"Aogonek", "uogonek"}:            -30.000000,
+	{"Aogonek", "uring"}:              -30.000000,
+	{"Aogonek", "v"}:                  -74.000000,
+	{"Aogonek", "w"}:                  -74.000000,
+	{"Aogonek", "y"}:                  -34.000000,
+	{"Aogonek", "yacute"}:             -34.000000,
+	{"Aogonek", "ydieresis"}:          -34.000000,
+	{"Aring", "C"}:                    -65.000000,
+	{"Aring", "Cacute"}:               -65.000000,
+	{"Aring", "Ccaron"}:               -65.000000,
+	{"Aring", "Ccedilla"}:             -65.000000,
+	{"Aring", "G"}:                    -60.000000,
+	{"Aring", "Gbreve"}:               -60.000000,
+	{"Aring", "Gcommaaccent"}:         -60.000000,
+	{"Aring", "O"}:                    -50.000000,
+	{"Aring", "Oacute"}:               -50.000000,
+	{"Aring", "Ocircumflex"}:          -50.000000,
+	{"Aring", "Odieresis"}:            -50.000000,
+	{"Aring", "Ograve"}:               -50.000000,
+	{"Aring", "Ohungarumlaut"}:        -50.000000,
+	{"Aring", "Omacron"}:              -50.000000,
+	{"Aring", "Oslash"}:               -50.000000,
+	{"Aring", "Otilde"}:               -50.000000,
+	{"Aring", "Q"}:                    -55.000000,
+	{"Aring", "T"}:                    -55.000000,
+	{"Aring", "Tcaron"}:               -55.000000,
+	{"Aring", "Tcommaaccent"}:         -55.000000,
+	{"Aring", "U"}:                    -50.000000,
+	{"Aring", "Uacute"}:               -50.000000,
+	{"Aring", "Ucircumflex"}:          -50.000000,
+	{"Aring", "Udieresis"}:            -50.000000,
+	{"Aring", "Ugrave"}:               -50.000000,
+	{"Aring", "Uhungarumlaut"}:        -50.000000,
+	{"Aring", "Umacron"}:              -50.000000,
+	{"Aring", "Uogonek"}:              -50.000000,
+	{"Aring", "Uring"}:                -50.000000,
+	{"Aring", "V"}:                    -95.000000,
+	{"Aring", "W"}:                    -100.000000,
+	{"Aring", "Y"}:                    -70.000000,
+	{"Aring", "Yacute"}:               -70.000000,
+	{"Aring", "Ydieresis"}:            -70.000000,
+	{"Aring", "quoteright"}:           -74.000000,
+	{"Aring", "u"}:                    -30.000000,
+	{"Aring", "uacute"}:               -30.000000,
+	{"Aring", "ucircumflex"}:          -30.000000,
+	{"Aring", "udieresis"}:            -30.000000,
+	{"Aring", "ugrave"}:               -30.000000,
+	{"Aring", "uhungarumlaut"}:        -30.000000,
+	{"Aring", "umacron"}:              -30.000000,
+	{"Aring", "uogonek"}:              -30.000000,
+	{"Aring", "uring"}:                -30.000000,
+	{"Aring", "v"}:                    -74.000000,
+	{"Aring", "w"}:                    -74.000000,
+	{"Aring", "y"}:                    -74.000000,
+	{"Aring", "yacute"}:               -74.000000,
+	{"Aring", "ydieresis"}:            -74.000000,
+	{"Atilde", "C"}:                   -65.000000,
+	{"Atilde", "Cacute"}:              -65.000000,
+	{"Atilde", "Ccaron"}:              -65.000000,
+	{"Atilde", "Ccedilla"}:            -65.000000,
+	{"Atilde", "G"}:                   -60.000000,
+	{"Atilde", "Gbreve"}:              -60.000000,
+	{"Atilde", "Gcommaaccent"}:        -60.000000,
+	{"Atilde", "O"}:                   -50.000000,
+	{"Atilde", "Oacute"}:              -50.000000,
+	{"Atilde", "Ocircumflex"}:         -50.000000,
+	{"Atilde", "Odieresis"}:           -50.000000,
+	{"Atilde", "Ograve"}:              -50.000000,
+	{"Atilde", "Ohungarumlaut"}:       -50.000000,
+	{"Atilde", "Omacron"}:             -50.000000,
+	{"Atilde", "Oslash"}:              -50.000000,
+	{"Atilde", "Otilde"}:              -50.000000,
+	{"Atilde", "Q"}:                   -55.000000,
+	{"Atilde", "T"}:                   -55.000000,
+	{"Atilde", "Tcaron"}:              -55.000000,
+	{"Atilde", "Tcommaaccent"}:        -55.000000,
+	{"Atilde", "U"}:                   -50.000000,
+	{"Atilde", "Uacute"}:              -50.000000,
+	{"Atilde", "Ucircumflex"}:         -50.000000,
+	{"Atilde", "Udieresis"}:           -50.000000,
+	{"Atilde", "Ugrave"}:              -50.000000,
+	{"Atilde", "Uhungarumlaut"}:       -50.000000,
+	{"Atilde", "Umacron"}:             -50.000000,
+	{"Atilde", "Uogonek"}:             -50.000000,
+	{"Atilde", "Uring"}:               -50.000000,
+	{"Atilde", "V"}:                   -95.000000,
+	{"Atilde", "W"}:                   -100.000000,
+	{"Atilde", "Y"}:                   -70.000000,
+	{"Atilde", "Yacute"}:              -70.000000,
+	{"Atilde", "Ydieresis"}:           -70.000000,
+	{"Atilde", "quoteright"}:          -74.000000,
+	{"Atilde", "u"}:                   -30.000000,
+	{"Atilde", "uacute"}:              -30.000000,
+	{"Atilde", "ucircumflex"}:         -30.000000,
+	{"Atilde", "udieresis"}:           -30.000000,
+	{"Atilde", "ugrave"}:              -30.000000,
+	{"Atilde", "uhungarumlaut"}:       -30.000000,
+	{"Atilde", "umacron"}:             -30.000000,
+	{"Atilde", "uogonek"}:             -30.000000,
+	{"Atilde", "uring"}:               -30.000000,
+	{"Atilde", "v"}:                   -74.000000,
+	{"Atilde", "w"}:                   -74.000000,
+	{"Atilde", "y"}:                   -74.000000,
+	{"Atilde", "yacute"}:              -74.000000,
+	{"Atilde", "ydieresis"}:           -74.000000,
+	{"B", "A"}:                        -25.000000,
+	{"B", "Aacute"}:                   -25.000000,
+	{"B", "Abreve"}:                   -25.000000,
+	{"B", "Acircumflex"}:              -25.000000,
+	{"B", "Adieresis"}:                -25.000000,
+	{"B", "Agrave"}:                   -25.000000,
+	{"B", "Amacron"}:                  -25.000000,
+	{"B", "Aogonek"}:                  -25.000000,
+	{"B", "Aring"}:                    -25.000000,
+	{"B", "Atilde"}:                   -25.000000,
+	{"B", "U"}:                        -10.000000,
+	{"B", "Uacute"}:                   -10.000000,
+	{"B", "Ucircumflex"}:              -10.000000,
+	{"B", "Udieresis"}:                -10.000000,
+	{"B", "Ugrave"}:                   -10.000000,
+	{"B", "Uhungarumlaut"}:            -10.000000,
+	{"B", "Umacron"}:                  -10.000000,
+	{"B", "Uogonek"}:                  -10.000000,
+	{"B", "Uring"}:                    -10.000000,
+	{"D", "A"}:                        -25.000000,
+	{"D", "Aacute"}:                   -25.000000,
+	{"D", "Abreve"}:                   -25.000000,
+	{"D", "Acircumflex"}:              -25.000000,
+	{"D", "Adieresis"}:                -25.000000,
+	{"D", "Agrave"}:                   -25.000000,
+	{"D", "Amacron"}:                  -25.000000,
+	{"D", "Aogonek"}:                  -25.000000,
+	{"D", "Aring"}:                    -25.000000,
+	{"D", "Atilde"}:                   -25.000000,
+	{"D", "V"}:                        -50.000000,
+	{"D", "W"}:                        -40.000000,
+	{"D", "Y"}:                        -50.000000,
+	{"D", "Yacute"}:                   -50.000000,
+	{"D", "Ydieresis"}:                -50.000000,
+	{"Dcaron", "A"}:                   -25.000000,
+	{"Dcaron", "Aacute"}:              -25.000000,
+	{"Dcaron", "Abreve"}:              -25.000000,
+	{"Dcaron", "Acircumflex"}:         -25.000000,
+	{"Dcaron", "Adieresis"}:           -25.000000,
+	{"Dcaron", "Agrave"}:              -25.000000,
+	{"Dcaron", "Amacron"}:             -25.000000,
+	{"Dcaron", "Aogonek"}:             -25.000000,
+	{"Dcaron", "Aring"}:               -25.000000,
+	{"Dcaron", "Atilde"}:              -25.000000,
+	{"Dcaron", "V"}:                   -50.000000,
+	{"Dcaron", "W"}:                   -40.000000,
+	{"Dcaron", "Y"}:                   -50.000000,
+	{"Dcaron", "Yacute"}:              -50.000000,
+	{"Dcaron", "Ydieresis"}:           -50.000000,
+	{"Dcroat", "A"}:                   -25.000000,
+	{"Dcroat", "Aacute"}:              -25.000000,
+	{"Dcroat", "Abreve"}:              -25.000000,
+	{"Dcroat", "Acircumflex"}:         -25.000000,
+	{"Dcroat", "Adieresis"}:           -25.000000,
+	{"Dcroat", "Agrave"}:              -25.000000,
+	{"Dcroat", "Amacron"}:             -25.000000,
+	{"Dcroat", "Aogonek"}:             -25.000000,
+	{"Dcroat", "Aring"}:               -25.000000,
+	{"Dcroat", "Atilde"}:              -25.000000,
+	{"Dcroat", "V"}:                   -50.000000,
+	{"Dcroat", "W"}:                   -40.000000,
+	{"Dcroat", "Y"}:                   -50.000000,
+	{"Dcroat", "Yacute"}:              -50.000000,
+	{"Dcroat", "Ydieresis"}:           -50.000000,
+	{"F", "A"}:                        -100.000000,
+	{"F", "Aacute"}:                   -100.000000,
+	{"F", "Abreve"}:                   -100.000000,
+	{"F", "Acircumflex"}:              -100.000000,
+	{"F", "Adieresis"}:                -100.000000,
+	{"F", "Agrave"}:                   -100.000000,
+	{"F", "Amacron"}:                  -100.000000,
+	{"F", "Aogonek"}:                  -100.000000,
+	{"F", "Aring"}:                    -100.000000,
+	{"F", "Atilde"}:                   -100.000000,
+	{"F", "a"}:                        -95.000000,
+	{"F", "aacute"}:                   -95.000000,
+	{"F", "abreve"}:                   -95.000000,
+	{"F", "acircumflex"}:              -95.000000,
+	{"F", "adieresis"}:                -95.000000,
+	{"F", "agrave"}:                   -95.000000,
+	{"F", "amacron"}:                  -95.000000,
+	{"F", "aogonek"}:                  -95.000000,
+	{"F", "aring"}:                    -95.000000,
+	{"F", "atilde"}:                   -95.000000,
+	{"F", "comma"}:                    -129.000000,
+	{"F", "e"}:                        -100.000000,
+	{"F", "eacute"}:                   -100.000000,
+	{"F", "ecaron"}:                   -100.000000,
+	{"F", "ecircumflex"}:              -100.000000,
+	{"F", "edieresis"}:                -100.000000,
+	{"F", "edotaccent"}:               -100.000000,
+	{"F", "egrave"}:                   -100.000000,
+	{"F", "emacron"}:                  -100.000000,
+	{"F", "eogonek"}:                  -100.000000,
+	{"F", "i"}:                        -40.000000,
+	{"F", "iacute"}:                   -40.000000,
+	{"F", "icircumflex"}:              -40.000000,
+	{"F", "idieresis"}:                -40.000000,
+	{"F", "igrave"}:                   -40.000000,
+	{"F", "imacron"}:                  -40.000000,
+	{"F", "iogonek"}:                  -40.000000,
+	{"F", "o"}:                        -70.000000,
+	{"F", "oacute"}:                   -70.000000,
+	{"F", "ocircumflex"}:              -70.000000,
+	{"F", "odieresis"}:                -70.000000,
+	{"F", "ograve"}:                   -70.000000,
+	{"F", "ohungarumlaut"}:            -70.000000,
+	{"F", "omacron"}:                  -70.000000,
+	{"F", "oslash"}:                   -70.000000,
+	{"F", "otilde"}:                   -70.000000,
+	{"F", "period"}:                   -129.000000,
+	{"F", "r"}:                        -50.000000,
+	{"F", "racute"}:                   -50.000000,
+	{"F", "rcaron"}:                   -50.000000,
+	{"F", "rcommaaccent"}:             -50.000000,
+	{"J", "A"}:                        -25.000000,
+	{"J", "Aacute"}:                   -25.000000,
+	{"J", "Abreve"}:                   -25.000000,
+	{"J", "Acircumflex"}:              -25.000000,
+	{"J", "Adieresis"}:                -25.000000,
+	{"J", "Agrave"}:                   -25.000000,
+	{"J", "Amacron"}:                  -25.000000,
+	{"J", "Aogonek"}:                  -25.000000,
+	{"J", "Aring"}:                    -25.000000,
+	{"J", "Atilde"}:                   -25.000000,
+	{"J", "a"}:                        -40.000000,
+	{"J", "aacute"}:                   -40.000000,
+	{"J", "abreve"}:                   -40.000000,
+	{"J", "acircumflex"}:              -40.000000,
+	{"J", "adieresis"}:                -40.000000,
+	{"J", "agrave"}:                   -40.000000,
+	{"J", "amacron"}:                  -40.000000,
+	{"J", "aogonek"}:                  -40.000000,
+	{"J", "aring"}:                    -40.000000,
+	{"J", "atilde"}:                   -40.000000,
+	{"J", "comma"}:                    -10.000000,
+	{"J", "e"}:                        -40.000000,
+	{"J", "eacute"}:                   -40.000000,
+	{"J", "ecaron"}:                   -40.000000,
+	{"J", "ecircumflex"}:              -40.000000,
+	{"J", "edieresis"}:                -40.000000,
+	{"J", "edotaccent"}:               -40.000000,
+	{"J", "egrave"}:                   -40.000000,
+	{"J", "emacron"}:                  -40.000000,
+	{"J", "eogonek"}:                  -40.000000,
+	{"J", "o"}:                        -40.000000,
+	{"J", "oacute"}:                   -40.000000,
+	{"J", "ocircumflex"}:              -40.000000,
+	{"J", "odieresis"}:                -40.000000,
+	{"J", "ograve"}:                   -40.000000,
+	{"J", "ohungarumlaut"}:            -40.000000,
+	{"J", "omacron"}:                  -40.000000,
+	{"J", "oslash"}:                   -40.000000,
+	{"J", "otilde"}:                   -40.000000,
+	{"J", "period"}:                   -10.000000,
+	{"J", "u"}:                        -40.000000,
+	{"J", "uacute"}:                   -40.000000,
+	{"J", "ucircumflex"}:              -40.000000,
+	{"J", "udieresis"}:                -40.000000,
+	{"J", "ugrave"}:                   -40.000000,
+	{"J", "uhungarumlaut"}:            -40.000000,
+	{"J", "umacron"}:                  -40.000000,
+	{"J", "uogonek"}:                  -40.000000,
+	{"J", "uring"}:                    -40.000000,
+	{"K", "O"}:                        -30.000000,
+	{"K", "Oacute"}:                   -30.000000,
+	{"K", "Ocircumflex"}:              -30.000000,
+	{"K", "Odieresis"}:                -30.000000,
+	{"K", "Ograve"}:                   -30.000000,
+	{"K", "Ohungarumlaut"}:            -30.000000,
+	{"K", "Omacron"}:                  -30.000000,
+	{"K", "Oslash"}:                   -30.000000,
+	{"K", "Otilde"}:                   -30.000000,
+	{"K", "e"}:                        -25.000000,
+	{"K", "eacute"}:                   -25.000000,
+	{"K", "ecaron"}:                   -25.000000,
+	{"K", "ecircumflex"}:              -25.000000,
+	{"K", "edieresis"}:                -25.000000,
+	{"K", "edotaccent"}:               -25.000000,
+	{"K", "egrave"}:                   -25.000000,
+	{"K", "emacron"}:                  -25.000000,
+	{"K", "eogonek"}:                  -25.000000,
+	{"K", "o"}:                        -25.000000,
+	{"K", "oacute"}:                   -25.000000,
+	{"K", "ocircumflex"}:              -25.000000,
+	{"K", "odieresis"}:                -25.000000,
+	{"K", "ograve"}:                   -25.000000,
+	{"K", "ohungarumlaut"}:            -25.000000,
+	{"K", "omacron"}:                  -25.000000,
+	{"K", "oslash"}:                   -25.000000,
+	{"K", "otilde"}:                   -25.000000,
+	{"K", "u"}:                        -20.000000,
+	{"K", "uacute"}:                   -20.000000,
+	{"K", "ucircumflex"}:              -20.000000,
+	{"K", "udieresis"}:                -20.000000,
+	{"K", "ugrave"}:                   -20.000000,
+	{"K", "uhungarumlaut"}:            -20.000000,
+	{"K", "umacron"}:                  -20.000000,
+	{"K", "uogonek"}:                  -20.000000,
+	{"K", "uring"}:                    -20.000000,
+	{"K", "y"}:                        -20.000000,
+	{"K", "yacute"}:                   -20.000000,
+	{"K", "ydieresis"}:                -20.000000,
+	{"Kcommaaccent", "O"}:             -30.000000,
+	{"Kcommaaccent", "Oacute"}:        -30.000000,
+	{"Kcommaaccent", "Ocircumflex"}:   -30.000000,
+	{"Kcommaaccent", "Odieresis"}:     -30.000000,
+	{"Kcommaaccent", "Ograve"}:        -30.000000,
+	{"Kcommaaccent", "Ohungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "Omacron"}:       -30.000000,
+	{"Kcommaaccent", "Oslash"}:        -30.000000,
+	{"Kcommaaccent", "Otilde"}:        -30.000000,
+	{"Kcommaaccent", "e"}:             -25.000000,
+	{"Kcommaaccent", "eacute"}:        -25.000000,
+	{"Kcommaaccent", "ecaron"}:        -25.000000,
+	{"Kcommaaccent", "ecircumflex"}:   -25.000000,
+	{"Kcommaaccent", "edieresis"}:     -25.000000,
+	{"Kcommaaccent", "edotaccent"}:    -25.000000,
+	{"Kcommaaccent", "egrave"}:        -25.000000,
+	{"Kcommaaccent", "emacron"}:       -25.000000,
+	{"Kcommaaccent", "eogonek"}:       -25.000000,
+	{"Kcommaaccent", "o"}:             -25.000000,
+	{"Kcommaaccent", "oacute"}:        -25.000000,
+	{"Kcommaaccent", "ocircumflex"}:   -25.000000,
+	{"Kcommaaccent", "odieresis"}:     -25.000000,
+	{"Kcommaaccent", "ograve"}:        -25.000000,
+	{"Kcommaaccent", "ohungarumlaut"}: -25.000000,
+	{"Kcommaaccent", "omacron"}:       -25.000000,
+	{"Kcommaaccent", "oslash"}:        -25.000000,
+	{"Kcommaaccent", "otilde"}:        -25.000000,
+	{"Kcommaaccent", "u"}:             -20.000000,
+	{"Kcommaaccent", "uacute"}:        -20.000000,
+	{"Kcommaaccent", "ucircumflex"}:   -20.000000,
+	{"Kcommaaccent", "udieresis"}:     -20.000000,
+	{"Kcommaaccent", "ugrave"}:        -20.000000,
+	{"Kcommaaccent", "uhungarumlaut"}: -20.000000,
+	{"Kcommaaccent", "umacron"}:       -20.000000,
+	{"Kcommaaccent", "uogonek"}:       -20.000000,
+	{"Kcommaaccent", "uring"}:         -20.000000,
+	{"Kcommaaccent", "y"}:             -20.000000,
+	{"Kcommaaccent", "yacute"}:        -20.000000,
+	{"Kcommaaccent", "ydieresis"}:     -20.000000,
+	{"L", "T"}:                        -18.000000,
+	{"L", "Tcaron"}:                   -18.000000,
+	{"L", "Tcommaaccent"}:             -18.000000,
+	{"L", "V"}:                        -37.000000,
+	{"L", "W"}:                        -37.000000,
+	{"L", "Y"}:                        -37.000000,
+	{"L", "Yacute"}:                   -37.000000,
+	{"L", "Ydieresis"}:                -37.000000,
+	{"L", "quoteright"}:               -55.000000,
+	{"L", "y"}:                        -37.000000,
+	{"L", "yacute"}:                   -37.000000,
+	{"L", "ydieresis"}:                -37.000000,
+	{"Lacute", "T"}:                   -18.000000,
+	{"Lacute", "Tcaron"}:              -18.000000,
+	{"Lacute", "Tcommaaccent"}:        -18.000000,
+	{"Lacute", "V"}:                   -37.000000,
+	{"Lacute", "W"}:                   -37.000000,
+	{"Lacute", "Y"}:                   -37.000000,
+	{"Lacute", "Yacute"}:              -37.000000,
+	{"Lacute", "Ydieresis"}:           -37.000000,
+	{"Lacute", "quoteright"}:          -55.000000,
+	{"Lacute", "y"}:                   -37.000000,
+	{"Lacute", "yacute"}:              -37.000000,
+	{"Lacute", "ydieresis"}:           -37.000000,
+	{"Lcommaaccent", "T"}:             -18.000000,
+	{"Lcommaaccent", "Tcaron"}:        -18.000000,
+	{"Lcommaaccent", "Tcommaaccent"}:  -18.000000,
+	{"Lcommaaccent", "V"}:             -37.000000,
+	{"Lcommaaccent", "W"}:             -37.000000,
+	{"Lcommaaccent", "Y"}:             -37.000000,
+	{"Lcommaaccent", "Yacute"}:        -37.000000,
+	{"Lcommaaccent", "Ydieresis"}:     -37.000000,
+	{"Lcommaaccent", "quoteright"}:    -55.000000,
+	{"Lcommaaccent", "y"}:             -37.000000,
+	{"Lcommaaccent", "yacute"}:        -37.000000,
+	{"Lcommaaccent", "ydieresis"}:     -37.000000,
+	{"Lslash", "T"}:                   -18.000000,
+	{"Lslash", "Tcaron"}:              -18.000000,
+	{"Lslash", "Tcommaaccent"}:        -18.000000,
+	{"Lslash", "V"}:                   -37.000000,
+	{"Lslash", "W"}:                   -37.000000,
+	{"Lslash", "Y"}:                   -37.000000,
+	{"Lslash", "Yacute"}:              -37.000000,
+	{"Lslash", "Ydieresis"}:           -37.000000,
+	{"Lslash", "quoteright"}:          -55.000000,
+	{"Lslash", "y"}:                   -37.000000,
+	{"Lslash", "yacute"}:              -37.000000,
+	{"Lslash", "ydieresis"}:           -37.000000,
+	{"N", "A"}:                        -30.000000,
+	{"N", "Aacute"}:                   -30.000000,
+	{"N", "Abreve"}:                   -30.000000,
+	{"N", "Acircumflex"}:              -30.000000,
+	{"N", "Adieresis"}:                -30.000000,
+	{"N", "Agrave"}:                   -30.000000,
+	{"N", "Amacron"}:                  -30.000000,
+	{"N", "Aogonek"}:                  -30.000000,
+	{"N", "Aring"}:                    -30.000000,
+	{"N", "Atilde"}:                   -30.000000,
+	{"Nacute", "A"}:                   -30.000000,
+	{"Nacute", "Aacute"}:              -30.000000,
+	{"Nacute", "Abreve"}:              -30.000000,
+	{"Nacute", "Acircumflex"}:         -30.000000,
+	{"Nacute", "Adieresis"}:           -30.000000,
+	{"Nacute", "Agrave"}:              -30.000000,
+	{"Nacute", "Amacron"}:             -30.000000,
+	{"Nacute", "Aogonek"}:             -30.000000,
+	{"Nacute", "Aring"}:               -30.000000,
+	{"Nacute", "Atilde"}:              -30.000000,
+	{"Ncaron", "A"}:                   -30.000000,
+	{"Ncaron", "Aacute"}:              -30.000000,
+	{"Ncaron", "Abreve"}:              -30.000000,
+	{"Ncaron", "Acircumflex"}:         -30.000000,
+	{"Ncaron", "Adieresis"}:           -30.000000,
+	{"Ncaron", "Agrave"}:              -30.000000,
+	{"Ncaron", "Amacron"}:             -30.000000,
+	{"Ncaron", "Aogonek"}:             -30.000000,
+	{"Ncaron", "Aring"}:               -30.000000,
+	{"Ncaron", "Atilde"}:              -30.000000,
+	{"Ncommaaccent", "A"}:             -30.000000,
+	{"Ncommaaccent", "Aacute"}:        -30.000000,
+	{"Ncommaaccent", "Abreve"}:        -30.000000,
+	{"Ncommaaccent", "Acircumflex"}:   -30.000000,
+	{"Ncommaaccent", "Adieresis"}:     -30.000000,
+	{"Ncommaaccent", "Agrave"}:        -30.000000,
+	{"Ncommaaccent", "Amacron"}:       -30.000000,
+	{"Ncommaaccent", "Aogonek"}:       -30.000000,
+	{"Ncommaaccent", "Aring"}:         -30.000000,
+	{"Ncommaaccent", "Atilde"}:        -30.000000,
+	{"Ntilde", "A"}:                   -30.000000,
+	{"Ntilde", "Aacute"}:              -30.000000,
+	{"Ntilde", "Abreve"}:              -30.000000,
+	{"Ntilde", "Acircumflex"}:         -30.000000,
+	{"Ntilde", "Adieresis"}:           -30.000000,
+	{"Ntilde", "Agrave"}:              -30.000000,
+	{"Ntilde", "Amacron"}:             -30.000000,
+	{"Ntilde", "Aogonek"}:             -30.000000,
+	{"Ntilde", "Aring"}:               -30.000000,
+	{"Ntilde", "Atilde"}:              -30.000000,
+	{"O", "A"}:                        -40.000000,
+	{"O", "Aacute"}:                   -40.000000,
+	{"O", "Abreve"}:                   -40.000000,
+	{"O", "Acircumflex"}:              -40.000000,
+	{"O", "Adieresis"}:                -40.000000,
+	{"O", "Agrave"}:                   -40.000000,
+	{"O", "Amacron"}:                  -40.000000,
+	{"O", "Aogonek"}:                  -40.000000,
+	{"O", "Aring"}:                    -40.000000,
+	{"O", "Atilde"}:                   -40.000000,
+	{"O", "T"}:                        -40.000000,
+	{"O", "Tcaron"}:                   -40.000000,
+	{"O", "Tcommaaccent"}:             -40.000000,
+	{"O", "V"}:                        -50.000000,
+	{"O", "W"}:                        -50.000000,
+	{"O", "X"}:                        -40.000000,
+	{"O", "Y"}:                        -50.000000,
+	{"O", "Yacute"}:                   -50.000000,
+	{"O", "Ydieresis"}:                -50.000000,
+	{"Oacute", "A"}:                   -40.000000,
+	{"Oacute", "Aacute"}:              -40.000000,
+	{"Oacute", "Abreve"}:              -40.000000,
+	{"Oacute", "Acircumflex"}:         -40.000000,
+	{"Oacute", "Adieresis"}:           -40.000000,
+	{"Oacute", "Agrave"}:              -40.000000,
+	{"Oacute", "Amacron"}:             -40.000000,
+	{"Oacute", "Aogonek"}:             -40.000000,
+	{"Oacute", "Aring"}:               -40.000000,
+	{"Oacute", "Atilde"}:              -40.000000,
+	{"Oacute", "T"}:                   -40.000000,
+	{"Oacute", "Tcaron"}:              -40.000000,
+	{"Oacute", "Tcommaaccent"}:        -40.000000,
+	{"Oacute", "V"}:                   -50.000000,
+	{"Oacute", "W"}:                   -50.000000,
+	{"Oacute", "X"}:                   -40.000000,
+	{"Oacute", "Y"}:                   -50.000000,
+	{"Oacute", "Yacute"}:              -50.000000,
+	{"Oacute", "Ydieresis"}:           -50.000000,
+	{"Ocircumflex", "A"}:              -40.000000,
+	{"Ocircumflex", "Aacute"}:         -40.000000,
+	{"Ocircumflex", "Abreve"}:         -40.000000,
+	{"Ocircumflex", "Acircumflex"}:    -40.000000,
+	{"Ocircumflex", "Adieresis"}:      -40.000000,
+	{"Ocircumflex", "Agrave"}:         -40.000000,
+	{"Ocircumflex", "Amacron"}:        -40.000000,
+	{"Ocircumflex", "Aogonek"}:        -40.000000,
+	{"Ocircumflex", "Aring"}:          -40.000000,
+	{"Ocircumflex", "Atilde"}:         -40.000000,
+	{"Ocircumflex", "T"}:              -40.000000,
+	{"Ocircumflex", "Tcaron"}:         -40.000000,
+	{"Ocircumflex", "Tcommaaccent"}:   -40.000000,
+	{"Ocircumflex", "V"}:              -50.000000,
+	{"Ocircumflex", "W"}:              -50.000000,
+	{"Ocircumflex", "X"}:              -40.000000,
+	{"Ocircumflex", "Y"}:              -50.000000,
+	{"Ocircumflex", "Yacute"}:         -50.000000,
+	{"Ocircumflex", "Ydieresis"}:      -50.000000,
+	{"Odieresis", "A"}:                -40.000000,
+	{"Odieresis", "Aacute"}:           -40.000000,
+	{"Odieresis", "Abreve"}:           -40.000000,
+	{"Odieresis", "Acircumflex"}:      -40.000000,
+	{"Odieresis", "Adieresis"}:        -40.000000,
+	{"Odieresis", "Agrave"}:           -40.000000,
+	{"Odieresis", "Amacron"}:          -40.000000,
+	{"Odieresis", "Aogonek"}:          -40.000000,
+	{"Odieresis", "Aring"}:            -40.000000,
+	{"Odieresis", "Atilde"}:           -40.000000,
+	{"Odieresis", "T"}:                -40.000000,
+	{"Odieresis", "Tcaron"}:           -40.000000,
+	{"Odieresis", "Tcommaaccent"}:     -40.000000,
+	{"Odieresis", "V"}:                -50.000000,
+	{"Odieresis", "W"}:                -50.000000,
+	{"Odieresis", "X"}:                -40.000000,
+	{"Odieresis", "Y"}:                -50.000000,
+	{"Odieresis", "Yacute"}:           -50.000000,
+	{"Odieresis", "Ydieresis"}:        -50.000000,
+	{"Ograve", "A"}:                   -40.000000,
+	{"Ograve", "Aacute"}:              -40.000000,
+	{"Ograve", "Abreve"}:              -40.000000,
+	{"Ograve", "Acircumflex"}:         -40.000000,
+	{"Ograve", "Adieresis"}:           -40.000000,
+	{"Ograve", "Agrave"}:              -40.000000,
+	{"Ograve", "Amacron"}:             -40.000000,
+	{"Ograve", "Aogonek"}:             -40.000000,
+	{"Ograve", "Aring"}:               -40.000000,
+	{"Ograve", "Atilde"}:              -40.000000,
+	{"Ograve", "T"}:                   -40.000000,
+	{"Ograve", "Tcaron"}:              -40.000000,
+	{"Ograve", "Tcommaaccent"}:        -40.000000,
+	{"Ograve", "V"}:                   -50.000000,
+	{"Ograve", "W"}:                   -50.000000,
+	{"Ograve", "X"}:                   -40.000000,
+	{"Ograve", "Y"}:                   -50.000000,
+	{"Ograve", "Yacute"}:              -50.000000,
+	{"Ograve", "Ydieresis"}:           -50.000000,
+	{"Ohungarumlaut", "A"}:            -40.000000,
+	{"Ohungarumlaut", "Aacute"}:       -40.000000,
+	{"Ohungarumlaut", "Abreve"}:       -40.000000,
+	{"Ohungarumlaut", "Acircumflex"}:  -40.000000,
+	{"Ohungarumlaut", "Adieresis"}:    -40.000000,
+	{"Ohungarumlaut", "Agrave"}:       -40.000000,
+	{"Ohungarumlaut", "Amacron"}:      -40.000000,
+	{"Ohungarumlaut", "Aogonek"}:      -40.000000,
+	{"Ohungarumlaut", "Aring"}:        -40.000000,
+	{"Ohungarumlaut", "Atilde"}:       -40.000000,
+	{"Ohungarumlaut", "T"}:            -40.000000,
+	{"Ohungarumlaut", "Tcaron"}:       -40.000000,
+	{"Ohungarumlaut", "Tcommaaccent"}: -40.000000,
+	{"Ohungarumlaut", "V"}:            -50.000000,
+	{"Ohungarumlaut", "W"}:            -50.000000,
+	{"Ohungarumlaut", "X"}:            -40.000000,
+	{"Ohungarumlaut", "Y"}:            -50.000000,
+	{"Ohungarumlaut", "Yacute"}:       -50.000000,
+	{"Ohungarumlaut", "Ydieresis"}:    -50.000000,
+	{"Omacron", "A"}:                  -40.000000,
+	{"Omacron", "Aacute"}:             -40.000000,
+	{"Omacron", "Abreve"}:             -40.000000,
+	{"Omacron", "Acircumflex"}:        -40.000000,
+	{"Omacron", "Adieresis"}:          -40.000000,
+	{"Omacron", "Agrave"}:             -40.000000,
+	{"Omacron", "Amacron"}:            -40.000000,
+	{"Omacron", "Aogonek"}:            -40.000000,
+	{"Omacron", "Aring"}:              -40.000000,
+	{"Omacron", "Atilde"}:             -40.000000,
+	{"Omacron", "T"}:                  -40.000000,
+	{"Omacron", "Tcaron"}:             -40.000000,
+	{"Omacron", "Tcommaaccent"}:       -40.000000,
+	{"Omacron", "V"}:                  -50.000000,
+	{"Omacron", "W"}:                  -50.000000,
+	{"Omacron", "X"}:                  -40.000000,
+	{"Omacron", "Y"}:                  -50.000000,
+	{"Omacron", "Yacute"}:             -50.000000,
+	{"Omacron", "Ydieresis"}:          -50.000000,
+	{"Oslash", "A"}:                   -40.000000,
+	{"Oslash", "Aacute"}:              -40.000000,
+	{"Oslash", "Abreve"}:              -40.000000,
+	{"Oslash", "Acircumflex"}:         -40.000000,
+	{"Oslash", "Adieresis"}:           -40.000000,
+	{"Oslash", "Agrave"}:              -40.000000,
+	{"Oslash", "Amacron"}:             -40.000000,
+	{"Oslash", "Aogonek"}:             -40.000000,
+	{"Oslash", "Aring"}:               -40.000000,
+	{"Oslash", "Atilde"}:              -40.000000,
+	{"Oslash", "T"}:                   -40.000000,
+	{"Oslash", "Tcaron"}:              -40.000000,
+	{"Oslash", "Tcommaaccent"}:        -40.000000,
+	{"Oslash", "V"}:                   -50.000000,
+	{"Oslash", "W"}:                   -50.000000,
+	{"Oslash", "X"}:                   -40.000000,
+	{"Oslash", "Y"}:                   -50.000000,
+	{"Oslash", "Yacute"}:              -50.000000,
+	{"Oslash", "Ydieresis"}:           -50.000000,
+	{"Otilde", "A"}:                   -40.000000,
+	{"Otilde", "Aacute"}:              -40.000000,
+	{"Otilde", "Abreve"}:              -40.000000,
+	{"Otilde", "Acircumflex"}:         -40.000000,
+	{"Otilde", "Adieresis"}:           -40.000000,
+	{"Otilde", "Agrave"}:              -40.000000,
+	{"Otilde", "Amacron"}:             -40.000000,
+	{"Otilde", "Aogonek"}:             -40.000000,
+	{"Otilde", "Aring"}:               -40.000000,
+	{"Otilde", "Atilde"}:              -40.000000,
+	{"Otilde", "T"}:                   -40.000000,
+	{"Otilde", "Tcaron"}:              -40.000000,
+	{"Otilde", "Tcommaaccent"}:        -40.000000,
+	{"Otilde", "V"}:                   -50.000000,
+	{"Otilde", "W"}:                   -50.000000,
+	{"Otilde", "X"}:                   -40.000000,
+	{"Otilde", "Y"}:                   -50.000000,
+	{"Otilde", "Yacute"}:              -50.000000,
+	{"Otilde", "Ydieresis"}:           -50.000000,
+	{"P", "A"}:                        -85.000000,
+	{"P", "Aacute"}:                   -85.000000,
+	{"P", "Abreve"}:                   -85.000000,
+	{"P", "Acircumflex"}:              -85.000000,
+	{"P", "Adieresis"}:                -85.000000,
+	{"P", "Agrave"}:                   -85.000000,
+	{"P", "Amacron"}:                  -85.000000,
+	{"P", "Aogonek"}:                  -85.000000,
+	{"P", "Aring"}:                    -85.000000,
+	{"P", "Atilde"}:                   -85.000000,
+	{"P", "a"}:                        -40.000000,
+	{"P", "aacute"}:                   -40.000000,
+	{"P", "abreve"}:                   -40.000000,
+	{"P", "acircumflex"}:              -40.000000,
+	{"P", "adieresis"}:                -40.000000,
+	{"P", "agrave"}:                   -40.000000,
+	{"P", "amacron"}:                  -40.000000,
+	{"P", "aogonek"}:                  -40.000000,
+	{"P", "aring"}:                    -40.000000,
+	{"P", "atilde"}:                   -40.000000,
+	{"P", "comma"}:                    -129.000000,
+	{"P", "e"}:                        -50.000000,
+	{"P", "eacute"}:                   -50.000000,
+	{"P", "ecaron"}:                   -50.000000,
+	{"P", "ecircumflex"}:              -50.000000,
+	{"P", "edieresis"}:                -50.000000,
+	{"P", "edotaccent"}:               -50.000000,
+	{"P", "egrave"}:                   -50.000000,
+	{"P", "emacron"}:                  -50.000000,
+	{"P", "eogonek"}:                  -50.000000,
+	{"P", "o"}:                        -55.000000,
+	{"P", "oacute"}:                   -55.000000,
+	{"P", "ocircumflex"}:              -55.000000,
+	{"P", "odieresis"}:                -55.000000,
+	{"P", "ograve"}:                   -55.000000,
+	{"P", "ohungarumlaut"}:            -55.000000,
+	{"P", "omacron"}:                  -55.000000,
+	{"P", "oslash"}:                   -55.000000,
+	{"P", "otilde"}:                   -55.000000,
+	{"P", "period"}:                   -129.000000,
+	{"Q", "U"}:                        -10.000000,
+	{"Q", "Uacute"}:                   -10.000000,
+	{"Q", "Ucircumflex"}:              -10.000000,
+	{"Q", "Udieresis"}:                -10.000000,
+	{"Q", "Ugrave"}:                   -10.000000,
+	{"Q", "Uhungarumlaut"}:            -10.000000,
+	{"Q", "Umacron"}:                  -10.000000,
+	{"Q", "Uogonek"}:                  -10.000000,
+	{"Q", "Uring"}:                    -10.000000,
+	{"R", "O"}:                        -40.000000,
+	{"R", "Oacute"}:                   -40.000000,
+	{"R", "Ocircumflex"}:              -40.000000,
+	{"R", "Odieresis"}:                -40.000000,
+	{"R", "Ograve"}:                   -40.000000,
+	{"R", "Ohungarumlaut"}:            -40.000000,
+	{"R", "Omacron"}:                  -40.000000,
+	{"R", "Oslash"}:                   -40.000000,
+	{"R", "Otilde"}:                   -40.000000,
+	{"R", "T"}:                        -30.000000,
+	{"R", "Tcaron"}:                   -30.000000,
+	{"R", "Tcommaaccent"}:             -30.000000,
+	{"R", "U"}:                        -40.000000,
+	{"R", "Uacute"}:                   -40.000000,
+	{"R", "Ucircumflex"}:              -40.000000,
+	{"R", "Udieresis"}:                -40.000000,
+	{"R", "Ugrave"}:                   -40.000000,
+	{"R", "Uhungarumlaut"}:            -40.000000,
+	{"R", "Umacron"}:                  -40.000000,
+	{"R", "Uogonek"}:                  -40.000000,
+	{"R", "Uring"}:                    -40.000000,
+	{"R", "V"}:                        -18.000000,
+	{"R", "W"}:                        -18.000000,
+	{"R", "Y"}:                        -18.000000,
+	{"R", "Yacute"}:                   -18.000000,
+	{"R", "Ydieresis"}:                -18.000000,
+	{"Racute", "O"}:                   -40.000000,
+	{"Racute", "Oacute"}:              -40.000000,
+	{"Racute", "Ocircumflex"}:         -40.000000,
+	{"Racute", "Odieresis"}:           -40.000000,
+	{"Racute", "Ograve"}:              -40.000000,
+	{"Racute", "Ohungarumlaut"}:       -40.000000,
+	{"Racute", "Omacron"}:             -40.000000,
+	{"Racute", "Oslash"}:              -40.000000,
+	{"Racute", "Otilde"}:              -40.000000,
+	{"Racute", "T"}:                   -30.000000,
+	{"Racute", "Tcaron"}:              -30.000000,
+	{"Racute", "Tcommaaccent"}:        -30.000000,
+	{"Racute", "U"}:                   -40.000000,
+	{"Racute", "Uacute"}:              -40.000000,
+	{"Racute", "Ucircumflex"}:         -40.000000,
+	{"Racute", "Udieresis"}:           -40.000000,
+	{"Racute", "Ugrave"}:              -40.000000,
+	{"Racute", "Uhungarumlaut"}:       -40.000000,
+	{"Racute", "Umacron"}:             -40.000000,
+	{"Racute", "Uogonek"}:             -40.000000,
+	{"Racute", "Uring"}:               -40.000000,
+	{"Racute", "V"}:                   -18.000000,
+	{"Racute", "W"}:                   -18.000000,
+	{"Racute", "Y"}:                   -18.000000,
+	{"Racute", "Yacute"}:              -18.000000,
+	{"Racute", "Ydieresis"}:           -18.000000,
+	{"Rcaron", "O"}:                   -40.000000,
+	{"Rcaron", "Oacute"}:              -40.000000,
+	{"Rcaron", "Ocircumflex"}:         -40.000000,
+	{"Rcaron", "Odieresis"}:           -40.000000,
+	{"Rcaron", "Ograve"}:              -40.000000,
+	{"Rcaron", "Ohungarumlaut"}:       -40.000000,
+	{"Rcaron", "Omacron"}:             -40.000000,
+	{"Rcaron", "Oslash"}:              -40.000000,
+	{"Rcaron", "Otilde"}:              -40.000000,
+	{"Rcaron", "T"}:                   -30.000000,
+	{"Rcaron", "Tcaron"}:              -30.000000,
+	{"Rcaron", "Tcommaaccent"}:        -30.000000,
+	{"Rcaron", "U"}:                   -40.000000,
+	{"Rcaron", "Uacute"}:              -40.000000,
+	{"Rcaron", "Ucircumflex"}:         -40.000000,
+	{"Rcaron", "Udieresis"}:           -40.000000,
+	{"Rcaron", "Ugrave"}:              -40.000000,
+	{"Rcaron", "Uhungarumlaut"}:       -40.000000,
+	{"Rcaron", "Umacron"}:             -40.000000,
+	{"Rcaron", "Uogonek"}:             -40.000000,
+	{"Rcaron", "Uring"}:               -40.000000,
+	{"Rcaron", "V"}:                   -18.000000,
+	{"Rcaron", "W"}:                   -18.000000,
+	{"Rcaron", "Y"}:                   -18.000000,
+	{"Rcaron", "Yacute"}:              -18.000000,
+	{"Rcaron", "Ydieresis"}:           -18.000000,
+	{"Rcommaaccent", "O"}:             -40.000000,
+	{"Rcommaaccent", "Oacute"}:        -40.000000,
+	{"Rcommaaccent", "Ocircumflex"}:   -40.000000,
+	{"Rcommaaccent", "Odieresis"}:     -40.000000,
+	{"Rcommaaccent", "Ograve"}:        -40.000000,
+	{"Rcommaaccent", "Ohungarumlaut"}: -40.000000,
+	{"Rcommaaccent", "Omacron"}:       -40.000000,
+	{"Rcommaaccent", "Oslash"}:        -40.000000,
+	{"Rcommaaccent", "Otilde"}:        -40.000000,
+	{"Rcommaaccent", "T"}:             -30.000000,
+	{"Rcommaaccent", "Tcaron"}:        -30.000000,
+	{"Rcommaaccent", "Tcommaaccent"}:  -30.000000,
+	{"Rcommaaccent", "U"}:             -40.000000,
+	{"Rcommaaccent", "Uacute"}:        -40.000000,
+	{"Rcommaaccent", "Ucircumflex"}:   -40.000000,
+	{"Rcommaaccent", "Udieresis"}:     -40.000000,
+	{"Rcommaaccent", "Ugrave"}:        -40.000000,
+	{"Rcommaaccent", "Uhungarumlaut"}: -40.000000,
+	{"Rcommaaccent", "Umacron"}:       -40.000000,
+	{"Rcommaaccent", "Uogonek"}:       -40.000000,
+	{"Rcommaaccent", "Uring"}:         -40.000000,
+	{"Rcommaaccent", "V"}:             -18.000000,
+	{"Rcommaaccent", "W"}:             -18.000000,
+	{"Rcommaaccent", "Y"}:             -18.000000,
+	{"Rcommaaccent", "Yacute"}:        -18.000000,
+	{"Rcommaaccent", "Ydieresis"}:     -18.000000,
+	{"T", "A"}:                        -55.000000,
+	{"T", "Aacute"}:                   -55.000000,
+	{"T", "Abreve"}:                   -55.000000,
+	{"T", "Acircumflex"}:              -55.000000,
+	{"T", "Adieresis"}:                -55.000000,
+	{"T", "Agrave"}:                   -55.000000,
+	{"T", "Amacron"}:                  -55.000000,
+	{"T", "Aogonek"}:                  -55.000000,
+	{"T", "Aring"}:                    -55.000000,
+	{"T", "Atilde"}:                   -55.000000,
+	{"T", "O"}:                        -18.000000,
+	{"T", "Oacute"}:                   -18.000000,
+	{"T", "Ocircumflex"}:              -18.000000,
+	{"T", "Odieresis"}:                -18.000000,
+	{"T", "Ograve"}:                   -18.000000,
+	{"T", "Ohungarumlaut"}:            -18.000000,
+	{"T", "Omacron"}:                  -18.000000,
+	{"T", "Oslash"}:                   -18.000000,
+	{"T", "Otilde"}:                   -18.000000,
+	{"T", "a"}:                        -92.000000,
+	{"T", "aacute"}:                   -92.000000,
+	{"T", "abreve"}:                   -92.000000,
+	{"T", "acircumflex"}:              -92.000000,
+	{"T", "adieresis"}:                -92.000000,
+	{"T", "agrave"}:                   -92.000000,
+	{"T", "amacron"}:                  -92.000000,
+	{"T", "aogonek"}:                  -92.000000,
+	{"T", "aring"}:                    -92.000000,
+	{"T", "atilde"}:                   -92.000000,
+	{"T", "colon"}:                    -74.000000,
+	{"T", "comma"}:                    -92.000000,
+	{"T", "e"}:                        -92.000000,
+	{"T", "eacute"}:                   -92.000000,
+	{"T", "ecaron"}:                   -92.000000,
+	{"T", "ecircumflex"}:              -92.000000,
+	{"T", "edieresis"}:                -52.000000,
+	{"T", "edotaccent"}:               -92.000000,
+	{"T", "egrave"}:                   -52.000000,
+	{"T", "emacron"}:                  -52.000000,
+	{"T", "eogonek"}:                  -92.000000,
+	{"T", "hyphen"}:                   -92.000000,
+	{"T", "i"}:                        -37.000000,
+	{"T", "iacute"}:                   -37.000000,
+	{"T", "iogonek"}:                  -37.000000,
+	{"T", "o"}:                        -95.000000,
+	{"T", "oacute"}:                   -95.000000,
+	{"T", "ocircumflex"}:              -95.000000,
+	{"T", "odieresis"}:                -95.000000,
+	{"T", "ograve"}:                   -95.000000,
+	{"T", "ohungarumlaut"}:            -95.000000,
+	{"T", "omacron"}:                  -95.000000,
+	{"T", "oslash"}:                   -95.000000,
+	{"T", "otilde"}:                   -95.000000,
+	{"T", "period"}:                   -92.000000,
+	{"T", "r"}:                        -37.000000,
+	{"T", "racute"}:                   -37.000000,
+	{"T", "rcaron"}:                   -37.000000,
+	{"T", "rcommaaccent"}:             -37.000000,
+	{"T", "semicolon"}:                -74.000000,
+	{"T", "u"}:                        -37.000000,
+	{"T", "uacute"}:                   -37.000000,
+	{"T", "ucircumflex"}:              -37.000000,
+	{"T", "udieresis"}:                -37.000000,
+	{"T", "ugrave"}:                   -37.000000,
+	{"T", "uhungarumlaut"}:            -37.000000,
+	{"T", "umacron"}:                  -37.000000,
+	{"T", "uogonek"}:                  -37.000000,
+	{"T", "uring"}:                    -37.000000,
+	{"T", "w"}:                        -37.000000,
+	{"T", "y"}:                        -37.000000,
+	{"T", "yacute"}:                   -37.000000,
+	{"T", "ydieresis"}:                -37.000000,
+	{"Tcaron", "A"}:                   -55.000000,
+	{"Tcaron", "Aacute"}:              -55.000000,
+	{"Tcaron", "Abreve"}:              -55.000000,
+	{"Tcaron", "Acircumflex"}:         -55.000000,
+	{"Tcaron", "Adieresis"}:           -55.000000,
+	{"Tcaron", "Agrave"}:              -55.000000,
+	{"Tcaron", "Amacron"}:             -55.000000,
+	{"Tcaron", "Aogonek"}:             -55.000000,
+	{"Tcaron", "Aring"}:               -55.000000,
+	{"Tcaron", "Atilde"}:              -55.000000,
+	{"Tcaron", "O"}:                   -18.000000,
+	{"Tcaron", "Oacute"}:              -18.000000,
+	{"Tcaron", "Ocircumflex"}:         -18.000000,
+	{"Tcaron", "Odieresis"}:           -18.000000,
+	{"Tcaron", "Ograve"}:              -18.000000,
+	{"Tcaron", "Ohungarumlaut"}:       -18.000000,
+	{"Tcaron", "Omacron"}:             -18.000000,
+	{"Tcaron", "Oslash"}:              -18.000000,
+	{"Tcaron", "Otilde"}:              -18.000000,
+	{"Tcaron", "a"}:                   -92.000000,
+	{"Tcaron", "aacute"}:              -92.000000,
+	{"Tcaron", "abreve"}:              -92.000000,
+	{"Tcaron", "acircumflex"}:         -92.000000,
+	{"Tcaron", "adieresis"}:           -92.000000,
+	{"Tcaron", "agrave"}:              -92.000000,
+	{"Tcaron", "amacron"}:             -92.000000,
+	{"Tcaron", "aogonek"}:             -92.000000,
+	{"Tcaron", "aring"}:               -92.000000,
+	{"Tcaron", "atilde"}:              -92.000000,
+	{"Tcaron", "colon"}:               -74.000000,
+	{"Tcaron", "comma"}:               -92.000000,
+	{"Tcaron", "e"}:                   -92.000000,
+	{"Tcaron", "eacute"}:              -92.000000,
+	{"Tcaron", "ecaron"}:              -92.000000,
+	{"Tcaron", "ecircumflex"}:         -92.000000,
+	{"Tcaron", "edieresis"}:           -52.000000,
+	{"Tcaron", "edotaccent"}:          -92.000000,
+	{"Tcaron", "egrave"}:              -52.000000,
+	{"Tcaron", "emacron"}:             -52.000000,
+	{"Tcaron", "eogonek"}:             -92.000000,
+	{"Tcaron", "hyphen"}:              -92.000000,
+	{"Tcaron", "i"}:                   -37.000000,
+	{"Tcaron", "iacute"}:              -37.000000,
+	{"Tcaron", "iogonek"}:             -37.000000,
+	{"Tcaron", "o"}:                   -95.000000,
+	{"Tcaron", "oacute"}:              -95.000000,
+	{"Tcaron", "ocircumflex"}:         -95.000000,
+	{"Tcaron", "odieresis"}:           -95.000000,
+	{"Tcaron", "ograve"}:              -95.000000,
+	{"Tcaron", "ohungarumlaut"}:       -95.000000,
+	{"Tcaron", "omacron"}:             -95.000000,
+	{"Tcaron", "oslash"}:              -95.000000,
+	{"Tcaron", "otilde"}:              -95.000000,
+	{"Tcaron", "period"}:              -92.000000,
+	{"Tcaron", "r"}:                   -37.000000,
+	{"Tcaron", "racute"}:              -37.000000,
+	{"Tcaron", "rcaron"}:              -37.000000,
+	{"Tcaron", "rcommaaccent"}:        -37.000000,
+	{"Tcaron", "semicolon"}:           -74.000000,
+	{"Tcaron", "u"}:                   -37.000000,
+	{"Tcaron", "uacute"}:              -37.000000,
+	{"Tcaron", "ucircumflex"}:         -37.000000,
+	{"Tcaron", "udieresis"}:           -37.000000,
+	{"Tcaron", "ugrave"}:              -37.000000,
+	{"Tcaron", "uhungarumlaut"}:       -37.000000,
+	{"Tcaron", "umacron"}:             -37.000000,
+	{"Tcaron", "uogonek"}:             -37.000000,
+	{"Tcaron", "uring"}:               -37.000000,
+	{"Tcaron", "w"}:                   -37.000000,
+	{"Tcaron", "y"}:                   -37.000000,
+	{"Tcaron", "yacute"}:              -37.000000,
+	{"Tcaron", "ydieresis"}:           -37.000000,
+	{"Tcommaaccent", "A"}:             -55.000000,
+	{"Tcommaaccent", "Aacute"}:        -55.000000,
+	{"Tcommaaccent", "Abreve"}:        -55.000000,
+	{"Tcommaaccent", "Acircumflex"}:   -55.000000,
+	{"Tcommaaccent", "Adieresis"}:     -55.000000,
+	{"Tcommaaccent", "Agrave"}:        -55.000000,
+	{"Tcommaaccent", "Amacron"}:       -55.000000,
+	{"Tcommaaccent", "Aogonek"}:       -55.000000,
+	{"Tcommaaccent", "Aring"}:         -55.000000,
+	{"Tcommaaccent", "Atilde"}:        -55.000000,
+	{"Tcommaaccent", "O"}:             -18.000000,
+	{"Tcommaaccent", "Oacute"}:        -18.000000,
+	{"Tcommaaccent", "Ocircumflex"}:   -18.000000,
+	{"Tcommaaccent", "Odieresis"}:     -18.000000,
+	{"Tcommaaccent", "Ograve"}:        -18.000000,
+	{"Tcommaaccent", "Ohungarumlaut"}: -18.000000,
+	{"Tcommaaccent", "Omacron"}:       -18.000000,
+	{"Tcommaaccent", "Oslash"}:        -18.000000,
+	{"Tcommaaccent", "Otilde"}:        -18.000000,
+	{"Tcommaaccent", "a"}:             -92.000000,
+	{"Tcommaaccent", "aacute"}:        -92.000000,
+	{"Tcommaaccent", "abreve"}:        -92.000000,
+	{"Tcommaaccent", "acircumflex"}:   -92.000000,
+	{"Tcommaaccent", "adieresis"}:     -92.000000,
+	{"Tcommaaccent", "agrave"}:        -92.000000,
+	{"Tcommaaccent", "amacron"}:       -92.000000,
+	{"Tcommaaccent", "aogonek"}:       -92.000000,
+	{"Tcommaaccent", "aring"}:         -92.000000,
+	{"Tcommaaccent", "atilde"}:        -92.000000,
+	{"Tcommaaccent", "colon"}:         -74.000000,
+	{"Tcommaaccent", "comma"}:         -92.000000,
+	{"Tcommaaccent", "e"}:             -92.000000,
+	{"Tcommaaccent", "eacute"}:        -92.000000,
+	{"Tcommaaccent", "ecaron"}:        -92.000000,
+	{"Tcommaaccent", "ecircumflex"}:   -92.000000,
+	{"Tcommaaccent", "edieresis"}:     -52.000000,
+	{"Tcommaaccent", "edotaccent"}:    -92.000000,
+	{"Tcommaaccent", "egrave"}:        -52.000000,
+	{"Tcommaaccent", "emacron"}:       -52.000000,
+	{"Tcommaaccent", "eogonek"}:       -92.000000,
+	{"Tcommaaccent", "hyphen"}:        -92.000000,
+	{"Tcommaaccent", "i"}:             -37.000000,
+	{"Tcommaaccent", "iacute"}:        -37.000000,
+	{"Tcommaaccent", "iogonek"}:       -37.000000,
+	{"Tcommaaccent", "o"}:             -95.000000,
+	{"Tcommaaccent", "oacute"}:        -95.000000,
+	{"Tcommaaccent", "ocircumflex"}:   -95.000000,
+	{"Tcommaaccent", "odieresis"}:     -95.000000,
+	{"Tcommaaccent", "ograve"}:        -95.000000,
+	{"Tcommaaccent", "ohungarumlaut"}: -95.000000,
+	{"Tcommaaccent", "omacron"}:       -95.000000,
+	{"Tcommaaccent", "oslash"}:        -95.000000,
+	{"Tcommaaccent", "otilde"}:        -95.000000,
+	{"Tcommaaccent", "period"}:        -92.000000,
+	{"Tcommaaccent", "r"}:             -37.000000,
+	{"Tcommaaccent", "racute"}:        -37.000000,
+	{"Tcommaaccent", "rcaron"}:        -37.000000,
+	{"Tcommaaccent", "rcommaaccent"}:  -37.000000,
+	{"Tcommaaccent", "semicolon"}:     -74.000000,
+	{"Tcommaaccent", "u"}:             -37.000000,
+	{"Tcommaaccent", "uacute"}:        -37.000000,
+	{"Tcommaaccent", "ucircumflex"}:   -37.000000,
+	{"Tcommaaccent", "udieresis"}:     -37.000000,
+	{"Tcommaaccent", "ugrave"}:        -37.000000,
+	{"Tcommaaccent", "uhungarumlaut"}: -37.000000,
+	{"Tcommaaccent", "umacron"}:       -37.000000,
+	{"Tcommaaccent", "uogonek"}:       -37.000000,
+	{"Tcommaaccent", "uring"}:         -37.000000,
+	{"Tcommaaccent", "w"}:             -37.000000,
+	{"Tcommaaccent", "y"}:             -37.000000,
+	{"Tcommaaccent", "yacute"}:        -37.000000,
+	{"Tcommaaccent", "ydieresis"}:     -37.000000,
+	{"U", "A"}:                        -45.000000,
+	{"U", "Aacute"}:                   -45.000000,
+	{"U", "Abreve"}:                   -45.000000,
+	{"U", "Acircumflex"}:              -45.000000,
+	{"U", "Adieresis"}:                -45.000000,
+	{"U", "Agrave"}:                   -45.000000,
+	{"U", "Amacron"}:                  -45.000000,
+	{"U", "Aogonek"}:                  -45.000000,
+	{"U", "Aring"}:                    -45.000000,
+	{"U", "Atilde"}:                   -45.000000,
+	{"Uacute", "A"}:                   -45.000000,
+	{"Uacute", "Aacute"}:              -45.000000,
+	{"Uacute", "Abreve"}:              -45.000000,
+	{"Uacute", "Acircumflex"}:         -45.000000,
+	{"Uacute", "Adieresis"}:           -45.000000,
+	{"Uacute", "Agrave"}:              -45.000000,
+	{"Uacute", "Amacron"}:             -45.000000,
+	{"Uacute", "Aogonek"}:             -45.000000,
+	{"Uacute", "Aring"}:               -45.000000,
+	{"Uacute", "Atilde"}:              -45.000000,
+	{"Ucircumflex", "A"}:              -45.000000,
+	{"Ucircumflex", "Aacute"}:         -45.000000,
+	{"Ucircumflex", "Abreve"}:         -45.000000,
+	{"Ucircumflex", "Acircumflex"}:    -45.000000,
+	{"Ucircumflex", "Adieresis"}:      -45.000000,
+	{"Ucircumflex", "Agrave"}:         -45.000000,
+	{"Ucircumflex", "Amacron"}:        -45.000000,
+	{"Ucircumflex", "Aogonek"}:        -45.000000,
+	{"Ucircumflex", "Aring"}:          -45.000000,
+	{"Ucircumflex", "Atilde"}:         -45.000000,
+	{"Udieresis", "A"}:                -45.000000,
+	{"Udieresis", "Aacute"}:           -45.000000,
+	{"Udieresis", "Abreve"}:           -45.000000,
+	{"Udieresis", "Acircumflex"}:      -45.000000,
+	{"Udieresis", "Adieresis"}:        -45.000000,
+	{"Udieresis", "Agrave"}:           -45.000000,
+	{"Udieresis", "Amacron"}:          -45.000000,
+	{"Udieresis", "Aogonek"}:          -45.000000,
+	{"Udieresis", "Aring"}:            -45.000000,
+	{"Udieresis", "Atilde"}:           -45.000000,
+	{"Ugrave", "A"}:                   -45.000000,
+	{"Ugrave", "Aacute"}:              -45.000000,
+	{"Ugrave", "Abreve"}:              -45.000000,
+	{"Ugrave", "Acircumflex"}:         -45.000000,
+	{"Ugrave", "Adieresis"}:           -45.000000,
+	{"Ugrave", "Agrave"}:              -45.000000,
+	{"Ugrave", "Amacron"}:             -45.000000,
+	{"Ugrave", "Aogonek"}:             -45.000000,
+	{"Ugrave", "Aring"}:               -45.000000,
+	{"Ugrave", "Atilde"}:              -45.000000,
+	{"Uhungarumlaut", "A"}:            -45.000000,
+	{"Uhungarumlaut", "Aacute"}:       -45.000000,
+	{"Uhungarumlaut", "Abreve"}:       -45.000000,
+	{"Uhungarumlaut", "Acircumflex"}:  -45.000000,
+	{"Uhungarumlaut", "Adieresis"}:    -45.000000,
+	{"Uhungarumlaut", "Agrave"}:       -45.000000,
+	{"Uhungarumlaut", "Amacron"}:      -45.000000,
+	{"Uhungarumlaut", "Aogonek"}:      -45.000000,
+	{"Uhungarumlaut", "Aring"}:        -45.000000,
+	{"Uhungarumlaut", "Atilde"}:       -45.000000,
+	{"Umacron", "A"}:                  -45.000000,
+	{"Umacron", "Aacute"}:             -45.000000,
+	{"Umacron", "Abreve"}:             -45.000000,
+	{"Umacron", "Acircumflex"}:        -45.000000,
+	{"Umacron", "Adieresis"}:          -45.000000,
+	{"Umacron", "Agrave"}:             -45.000000,
+	{"Umacron", "Amacron"}:            -45.000000,
+	{"Umacron", "Aogonek"}:            -45.000000,
+	{"Umacron", "Aring"}:              -45.000000,
+	{"Umacron", "Atilde"}:             -45.000000,
+	{"Uogonek", "A"}:                  -45.000000,
+	{"Uogonek", "Aacute"}:             -45.000000,
+	{"Uogonek", "Abreve"}:             -45.000000,
+	{"Uogonek", "Acircumflex"}:        -45.000000,
+	{"Uogonek", "Adieresis"}:          -45.000000,
+	{"Uogonek", "Agrave"}:             -45.000000,
+	{"Uogonek", "Amacron"}:            -45.000000,
+	{"Uogonek", "Aogonek"}:            -45.000000,
+	{"Uogonek", "Aring"}:              -45.000000,
+	{"Uogonek", "Atilde"}:             -45.000000,
+	{"Uring", "A"}:                    -45.000000,
+	{"Uring", "Aacute"}:               -45.000000,
+	{"Uring", "Abreve"}:               -45.000000,
+	{"Uring", "Acircumflex"}:          -45.000000,
+	{"Uring", "Adieresis"}:            -45.000000,
+	{"Uring", "Agrave"}:               -45.000000,
+	{"Uring", "Amacron"}:              -45.000000,
+	{"Uring", "Aogonek"}:              -45.000000,
+	{"Uring", "Aring"}:                -45.000000,
+	{"Uring", "Atilde"}:               -45.000000,
+	{"V", "A"}:                        -85.000000,
+	{"V", "Aacute"}:                   -85.000000,
+	{"V", "Abreve"}:                   -85.000000,
+	{"V", "Acircumflex"}:              -85.000000,
+	{"V", "Adieresis"}:                -85.000000,
+	{"V", "Agrave"}:                   -85.000000,
+	{"V", "Amacron"}:                  -85.000000,
+	{"V", "Aogonek"}:                  -85.000000,
+	{"V", "Aring"}:                    -85.000000,
+	{"V", "Atilde"}:                   -85.000000,
+	{"V", "G"}:                        -10.000000,
+	{"V", "Gbreve"}:                   -10.000000,
+	{"V", "Gcommaaccent"}:             -10.000000,
+	{"V", "O"}:                        -30.000000,
+	{"V", "Oacute"}:                   -30.000000,
+	{"V", "Ocircumflex"}:              -30.000000,
+	{"V", "Odieresis"}:                -30.000000,
+	{"V", "Ograve"}:                   -30.000000,
+	{"V", "Ohungarumlaut"}:            -30.000000,
+	{"V", "Omacron"}:                  -30.000000,
+	{"V", "Oslash"}:                   -30.000000,
+	{"V", "Otilde"}:                   -30.000000,
+	{"V", "a"}:                        -111.000000,
+	{"V", "aacute"}:                   -111.000000,
+	{"V", "abreve"}:                   -111.000000,
+	{"V", "acircumflex"}:              -111.000000,
+	{"V", "adieresis"}:                -111.000000,
+	{"V", "agrave"}:                   -111.000000,
+	{"V", "amacron"}:                  -111.000000,
+	{"V", "aogonek"}:                  -111.000000,
+	{"V", "aring"}:                    -111.000000,
+	{"V", "atilde"}:                   -111.000000,
+	{"V", "colon"}:                    -74.000000,
+	{"V", "comma"}:                    -129.000000,
+	{"V", "e"}:                        -111.000000,
+	{"V", "eacute"}:                   -111.000000,
+	{"V", "ecaron"}:                   -111.000000,
+	{"V", "ecircumflex"}:              -111.000000,
+	{"V", "edieresis"}:                -71.000000,
+	{"V", "edotaccent"}:               -111.000000,
+	{"V", "egrave"}:                   -71.000000,
+	{"V", "emacron"}:                  -71.000000,
+	{"V", "eogonek"}:                  -111.000000,
+	{"V", "hyphen"}:                   -70.000000,
+	{"V", "i"}:                        -55.000000,
+	{"V", "iacute"}:                   -55.000000,
+	{"V", "iogonek"}:                  -55.000000,
+	{"V", "o"}:                        -111.000000,
+	{"V", "oacute"}:                   -111.000000,
+	{"V", "ocircumflex"}:              -111.000000,
+	{"V", "odieresis"}:                -111.000000,
+	{"V", "ograve"}:                   -111.000000,
+	{"V", "ohungarumlaut"}:            -111.000000,
+	{"V", "omacron"}:                  -111.000000,
+	{"V", "oslash"}:                   -111.000000,
+	{"V", "otilde"}:                   -111.000000,
+	{"V", "period"}:                   -129.000000,
+	{"V", "semicolon"}:                -74.000000,
+	{"V", "u"}:                        -55.000000,
+	{"V", "uacute"}:                   -55.000000,
+	{"V", "ucircumflex"}:              -55.000000,
+	{"V", "udieresis"}:                -55.000000,
+	{"V", "ugrave"}:                   -55.000000,
+	{"V", "uhungarumlaut"}:            -55.000000,
+	{"V", "umacron"}:                  -55.000000,
+	{"V", "uogonek"}:                  -55.000000,
+	{"V", "uring"}:                    -55.000000,
+	{"W", "A"}:                        -74.000000,
+	{"W", "Aacute"}:                   -74.000000,
+	{"W", "Abreve"}:                   -74.000000,
+	{"W", "Acircumflex"}:              -74.000000,
+	{"W", "Adieresis"}:                -74.000000,
+	{"W", "Agrave"}:                   -74.000000,
+	{"W", "Amacron"}:                  -74.000000,
+	{"W", "Aogonek"}:                  -74.000000,
+	{"W", "Aring"}:                    -74.000000,
+	{"W", "Atilde"}:                   -74.000000,
+	{"W", "O"}:                        -15.000000,
+	{"W", "Oacute"}:                   -15.000000,
+	{"W", "Ocircumflex"}:              -15.000000,
+	{"W", "Odieresis"}:                -15.000000,
+	{"W", "Ograve"}:                   -15.000000,
+	{"W", "Ohungarumlaut"}:            -15.000000,
+	{"W", "Omacron"}:                  -15.000000,
+	{"W", "Oslash"}:                   -15.000000,
+	{"W", "Otilde"}:                   -15.000000,
+	{"W", "a"}:                        -85.000000,
+	{"W", "aacute"}:                   -85.000000,
+	{"W", "abreve"}:                   -85.000000,
+	{"W", "acircumflex"}:              -85.000000,
+	{"W", "adieresis"}:                -85.000000,
+	{"W", "agrave"}:                   -85.000000,
+	{"W", "amacron"}:                  -85.000000,
+	{"W", "aogonek"}:                  -85.000000,
+	{"W", "aring"}:                    -85.000000,
+	{"W", "atilde"}:                   -85.000000,
+	{"W", "colon"}:                    -55.000000,
+	{"W", "comma"}:                    -74.000000,
+	{"W", "e"}:                        -90.000000,
+	{"W", "eacute"}:                   -90.000000,
+	{"W", "ecaron"}:                   -90.000000,
+	{"W", "ecircumflex"}:              -90.000000,
+	{"W", "edieresis"}:                -50.000000,
+	{"W", "edotaccent"}:               -90.000000,
+	{"W", "egrave"}:                   -50.000000,
+	{"W", "emacron"}:                  -50.000000,
+	{"W", "eogonek"}:                  -90.000000,
+	{"W", "hyphen"}:                   -50.000000,
+	{"W", "i"}:                        -37.000000,
+	{"W", "iacute"}:                   -37.000000,
+	{"W", "iogonek"}:                  -37.000000,
+	{"W", "o"}:                        -80.000000,
+	{"W", "oacute"}:                   -80.000000,
+	{"W", "ocircumflex"}:              -80.000000,
+	{"W", "odieresis"}:                -80.000000,
+	{"W", "ograve"}:                   -80.000000,
+	{"W", "ohungarumlaut"}:            -80.000000,
+	{"W", "omacron"}:                  -80.000000,
+	{"W", "oslash"}:                   -80.000000,
+	{"W", "otilde"}:                   -80.000000,
+	{"W", "period"}:                   -74.000000,
+	{"W", "semicolon"}:                -55.000000,
+	{"W", "u"}:                        -55.000000,
+	{"W", "uacute"}:                   -55.000000,
+	{"W", "ucircumflex"}:              -55.000000,
+	{"W", "udieresis"}:                -55.000000,
+	{"W", "ugrave"}:                   -55.000000,
+	{"W", "uhungarumlaut"}:            -55.000000,
+	{"W", "umacron"}:                  -55.000000,
+	{"W", "uogonek"}:                  -55.000000,
+	{"W", "uring"}:                    -55.000000,
+	{"W", "y"}:                        -55.000000,
+	{"W", "yacute"}:                   -55.000000,
+	{"W", "ydieresis"}:                -55.000000,
+	{"Y", "A"}:                        -74.000000,
+	{"Y", "Aacute"}:                   -74.000000,
+	{"Y", "Abreve"}:                   -74.000000,
+	{"Y", "Acircumflex"}:              -74.000000,
+	{"Y", "Adieresis"}:                -74.000000,
+	{"Y", "Agrave"}:                   -74.000000,
+	{"Y", "Amacron"}:                  -74.000000,
+	{"Y", "Aogonek"}:                  -74.000000,
+	{"Y", "Aring"}:                    -74.000000,
+	{"Y", "Atilde"}:                   -74.000000,
+	{"Y", "O"}:                        -25.000000,
+	{"Y", "Oacute"}:                   -25.000000,
+	{"Y", "Ocircumflex"}:              -25.000000,
+	{"Y", "Odieresis"}:                -25.000000,
+	{"Y", "Ograve"}:                   -25.000000,
+	{"Y", "Ohungarumlaut"}:            -25.000000,
+	{"Y", "Omacron"}:                  -25.000000,
+	{"Y", "Oslash"}:                   -25.000000,
+	{"Y", "Otilde"}:                   -25.000000,
+	{"Y", "a"}:                        -92.000000,
+	{"Y", "aacute"}:                   -92.000000,
+	{"Y", "abreve"}:                   -92.000000,
+	{"Y", "acircumflex"}:              -92.000000,
+	{"Y", "adieresis"}:                -92.000000,
+	{"Y", "agrave"}:                   -92.000000,
+	{"Y", "amacron"}:                  -92.000000,
+	{"Y", "aogonek"}:                  -92.000000,
+	{"Y", "aring"}:                    -92.000000,
+	{"Y", "atilde"}:                   -92.000000,
+	{"Y", "colon"}:                    -92.000000,
+	{"Y", "comma"}:                    -92.000000,
+	{"Y", "e"}:                        -111.000000,
+	{"Y", "eacute"}:                   -111.000000,
+	{"Y", "ecaron"}:                   -111.000000,
+	{"Y", "ecircumflex"}:              -71.000000,
+	{"Y", "edieresis"}:                -71.000000,
+	{"Y", "edotaccent"}:               -111.000000,
+	{"Y", "egrave"}:                   -71.000000,
+	{"Y", "emacron"}:                  -71.000000,
+	{"Y", "eogonek"}:                  -111.000000,
+	{"Y", "hyphen"}:                   -92.000000,
+	{"Y", "i"}:                        -55.000000,
+	{"Y", "iacute"}:                   -55.000000,
+	{"Y", "iogonek"}:                  -55.000000,
+	{"Y", "o"}:                        -111.000000,
+	{"Y", "oacute"}:                   -111.000000,
+	{"Y", "ocircumflex"}:              -111.000000,
+	{"Y", "odieresis"}:                -111.000000,
+	{"Y", "ograve"}:                   -111.000000,
+	{"Y", "ohungarumlaut"}:            -111.000000,
+	{"Y", "omacron"}:                  -111.000000,
+	{"Y", "oslash"}:                   -111.000000,
+	{"Y", "otilde"}:                   -111.000000,
+	{"Y", "period"}:                   -74.000000,
+	{"Y", "semicolon"}:                -92.000000,
+	{"Y", "u"}:                        -92.000000,
+	{"Y", "uacute"}:                   -92.000000,
+	{"Y", "ucircumflex"}:              -92.000000,
+	{"Y", "udieresis"}:                -92.000000,
+	{"Y", "ugrave"}:                   -92.000000,
+	{"Y", "uhungarumlaut"}:            -92.000000,
+	{"Y", "umacron"}:                  -92.000000,
+	{"Y", "uogonek"}:                  -92.000000,
+	{"Y", "uring"}:                    -92.000000,
+	{"Yacute", "A"}:                   -74.000000,
+	{"Yacute", "Aacute"}:              -74.000000,
+	{"Yacute", "Abreve"}:              -74.000000,
+	{"Yacute", "Acircumflex"}:         -74.000000,
+	{"Yacute", "Adieresis"}:           -74.000000,
+	{"Yacute", "Agrave"}:              -74.000000,
+	{"Yacute", "Amacron"}:             -74.000000,
+	{"Yacute", "Aogonek"}:             -74.000000,
+	{"Yacute", "Aring"}:               -74.000000,
+	{"Yacute", "Atilde"}:              -74.000000,
+	{"Yacute", "O"}:                   -25.000000,
+	{"Yacute", "Oacute"}:              -25.000000,
+	{"Yacute", "Ocircumflex"}:         -25.000000,
+	{"Yacute", "Odieresis"}:           -25.000000,
+	{"Yacute", "Ograve"}:              -25.000000,
+	{"Yacute", "Ohungarumlaut"}:       -25.000000,
+	{"Yacute", "Omacron"}:             -25.000000,
+	{"Yacute", "Oslash"}:              -25.000000,
+	{"Yacute", "Otilde"}:              -25.000000,
+	{"Yacute", "a"}:                   -92.000000,
+	{"Yacute", "aacute"}:              -92.000000,
+	{"Yacute", "abreve"}:              -92.000000,
+	{"Yacute", "acircumflex"}:         -92.000000,
+	{"Yacute", "adieresis"}:           -92.000000,
+	{"Yacute", "agrave"}:              -92.000000,
+	{"Yacute", "amacron"}:             -92.000000,
+	{"Yacute", "aogonek"}:             -92.000000,
+	{"Yacute", "aring"}:               -92.000000,
+	{"Yacute", "atilde"}:              -92.000000,
+	{"Yacute", "colon"}:               -92.000000,
+	{"Yacute", "comma"}:               -92.000000,
+	{"Yacute", "e"}:                   -111.000000,
+	{"Yacute", "eacute"}:              -111.000000,
+	{"Yacute", "ecaron"}:              -111.000000,
+	{"Yacute", "ecircumflex"}:         -71.000000,
+	{"Yacute", "edieresis"}:           -71.000000,
+	{"Yacute", "edotaccent"}:          -111.000000,
+	{"Yacute", "egrave"}:              -71.000000,
+	{"Yacute", "emacron"}:             -71.000000,
+	{"Yacute", "eogonek"}:             -111.000000,
+	{"Yacute", "hyphen"}:              -92.000000,
+	{"Yacute", "i"}:                   -55.000000,
+	{"Yacute", "iacute"}:              -55.000000,
+	{"Yacute", "iogonek"}:             -55.000000,
+	{"Yacute", "o"}:                   -111.000000,
+	{"Yacute", "oacute"}:              -111.000000,
+	{"Yacute", "ocircumflex"}:         -111.000000,
+	{"Yacute", "odieresis"}:           -111.000000,
+	{"Yacute", "ograve"}:              -111.000000,
+	{"Yacute", "ohungarumlaut"}:       -111.000000,
+	{"Yacute", "omacron"}:             -111.000000,
+	{"Yacute", "oslash"}:              -111.000000,
+	{"Yacute", "otilde"}:              -111.000000,
+	{"Yacute", "period"}:              -74.000000,
+	{"Yacute", "semicolon"}:           -92.000000,
+	{"Yacute", "u"}:                   -92.000000,
+	{"Yacute", "uacute"}:              -92.000000,
+	{"Yacute", "ucircumflex"}:         -92.000000,
+	{"Yacute", "udieresis"}:           -92.000000,
+	{"Yacute", "ugrave"}:              -92.000000,
+	{"Yacute", "uhungarumlaut"}:       -92.000000,
+	{"Yacute", "umacron"}:             -92.000000,
+	{"Yacute", "uogonek"}:             -92.000000,
+	{"Yacute", "uring"}:               -92.000000,
+	{"Ydieresis", "A"}:                -74.000000,
+	{"Ydieresis", "Aacute"}:           -74.000000,
+	{"Ydieresis", "Abreve"}:           -74.000000,
+	{"Ydieresis", "Acircumflex"}:      -74.000000,
+	{"Ydieresis", "Adieresis"}:        -74.000000,
+	{"Ydieresis", "Agrave"}:           -74.000000,
+	{"Ydieresis", "Amacron"}:          -74.000000,
+	{"Ydieresis", "Aogonek"}:          -74.000000,
+	{"Ydieresis", "Aring"}:            -74.000000,
+	{"Ydieresis", "Atilde"}:           -74.000000,
+	{"Ydieresis", "O"}:                -25.000000,
+	{"Ydieresis", "Oacute"}:           -25.000000,
+	{"Ydieresis", "Ocircumflex"}:      -25.000000,
+	{"Ydieresis", "Odieresis"}:        -25.000000,
+	{"Ydieresis", "Ograve"}:           -25.000000,
+	{"Ydieresis", "Ohungarumlaut"}:    -25.000000,
+	{"Ydieresis", "Omacron"}:          -25.000000,
+	{"Ydieresis", "Oslash"}:           -25.000000,
+	{"Ydieresis", "Otilde"}:           -25.000000,
+	{"Ydieresis", "a"}:                -92.000000,
+	{"Ydieresis", "aacute"}:           -92.000000,
+	{"Ydieresis", "abreve"}:           -92.000000,
+	{"Ydieresis", "acircumflex"}:      -92.000000,
+	{"Ydieresis", "adieresis"}:        -92.000000,
+	{"Ydieresis", "agrave"}:           -92.000000,
+	{"Ydieresis", "amacron"}:          -92.000000,
+	{"Ydieresis", "aogonek"}:          -92.000000,
+	{"Ydieresis", "aring"}:            -92.000000,
+	{"Ydieresis", "atilde"}:           -92.000000,
+	{"Ydieresis", "colon"}:            -92.000000,
+	{"Ydieresis", "comma"}:            -92.000000,
+	{"Ydieresis", "e"}:                -111.000000,
+	{"Ydieresis", "eacute"}:           -111.000000,
+	{"Ydieresis", "ecaron"}:           -111.000000,
+	{"Ydieresis", "ecircumflex"}:      -71.000000,
+	{"Ydieresis", "edieresis"}:        -71.000000,
+	{"Ydieresis", "edotaccent"}:       -111.000000,
+	{"Ydieresis", "egrave"}:           -71.000000,
+	{"Ydieresis", "emacron"}:          -71.000000,
+	{"Ydieresis", "eogonek"}:          -111.000000,
+	{"Ydieresis", "hyphen"}:           -92.000000,
+	{"Ydieresis", "i"}:                -55.000000,
+	{"Ydieresis", "iacute"}:           -55.000000,
+	{"Ydieresis", "iogonek"}:          -55.000000,
+	{"Ydieresis", "o"}:                -111.000000,
+	{"Ydieresis", "oacute"}:           -111.000000,
+	{"Ydieresis", "ocircumflex"}:      -111.000000,
+	{"Ydieresis", "odieresis"}:        -111.000000,
+	{"Ydieresis", "ograve"}:           -111.000000,
+	{"Ydieresis", "ohungarumlaut"}:    -111.000000,
+	{"Ydieresis", "omacron"}:          -111.000000,
+	{"Ydieresis", "oslash"}:           -111.000000,
+	{"Ydieresis", "otilde"}:           -111.000000,
+	{"Ydieresis", "period"}:           -74.000000,
+	{"Ydieresis", "semicolon"}:        -92.000000,
+	{"Ydieresis", "u"}:                -92.000000,
+	{"Ydieresis", "uacute"}:           -92.000000,
+	{"Ydieresis", "ucircumflex"}:      -92.000000,
+	{"Ydieresis", "udieresis"}:        -92.000000,
+	{"Ydieresis", "ugrave"}:           -92.000000,
+	{"Ydieresis", "uhungarumlaut"}:    -92.000000,
+	{"Ydieresis", "umacron"}:          -92.000000,
+	{"Ydieresis", "uogonek"}:          -92.000000,
+	{"Ydieresis", "uring"}:            -92.000000,
+	{"b", "b"}:                        -10.000000,
+	{"b", "period"}:                   -40.000000,
+	{"b", "u"}:                        -20.000000,
+	{"b", "uacute"}:                   -20.000000,
+	{"b", "ucircumflex"}:              -20.000000,
+	{"b", "udieresis"}:                -20.000000,
+	{"b", "ugrave"}:                   -20.000000,
+	{"b", "uhungarumlaut"}:            -20.000000,
+	{"b", "umacron"}:                  -20.000000,
+	{"b", "uogonek"}:                  -20.000000,
+	{"b", "uring"}:                    -20.000000,
+	{"c", "h"}:                        -10.000000,
+	{"c", "k"}:                        -10.000000,
+	{"c", "kcommaaccent"}:             -10.000000,
+	{"cacute", "h"}:                   -10.000000,
+	{"cacute", "k"}:                   -10.000000,
+	{"cacute", "kcommaaccent"}:        -10.000000,
+	{"ccaron", "h"}:                   -10.000000,
+	{"ccaron", "k"}:                   -10.000000,
+	{"ccaron", "kcommaaccent"}:        -10.000000,
+	{"ccedilla", "h"}:                 -10.000000,
+	{"ccedilla", "k"}:                 -10.000000,
+	{"ccedilla", "kcommaaccent"}:      -10.000000,
+	{"comma", "quotedblright"}:        -95.000000,
+	{"comma", "quoteright"}:           -95.000000,
+	{"e", "b"}:                        -10.000000,
+	{"eacute", "b"}:                   -10.000000,
+	{"ecaron", "b"}:                   -10.000000,
+	{"ecircumflex", "b"}:              -10.000000,
+	{"edieresis", "b"}:                -10.000000,
+	{"edotaccent", "b"}:               -10.000000,
+	{"egrave", "b"}:                   -10.000000,
+	{"emacron", "b"}:                  -10.000000,
+	{"eogonek", "b"}:                  -10.000000,
+	{"f", "comma"}:                    -10.000000,
+	{"f", "dotlessi"}:                 -30.000000,
+	{"f", "e"}:                        -10.000000,
+	{"f", "eacute"}:                   -10.000000,
+	{"f", "edotaccent"}:               -10.000000,
+	{"f", "eogonek"}:                  -10.000000,
+	{"f", "f"}:                        -18.000000,
+	{"f", "o"}:                        -10.000000,
+	{"f", "oacute"}:                   -10.000000,
+	{"f", "ocircumflex"}:              -10.000000,
+	{"f", "ograve"}:                   -10.000000,
+	{"f", "ohungarumlaut"}:            -10.000000,
+	{"f", "oslash"}:                   -10.000000,
+	{"f", "otilde"}:                   -10.000000,
+	{"f", "period"}:                   -10.000000,
+	{"f", "quoteright"}:               55.000000,
+	{"k", "e"}:                        -30.000000,
+	{"k", "eacute"}:                   -30.000000,
+	{"k", "ecaron"}:                   -30.000000,
+	{"k", "ecircumflex"}:              -30.000000,
+	{"k", "edieresis"}:                -30.000000,
+	{"k", "edotaccent"}:               -30.000000,
+	{"k", "egrave"}:                   -30.000000,
+	{"k", "emacron"}:                  -30.000000,
+	{"k", "eogonek"}:                  -30.000000,
+	{"k", "o"}:                        -10.000000,
+	{"k", "oacute"}:                   -10.000000,
+	{"k", "ocircumflex"}:              -10.000000,
+	{"k", "odieresis"}:                -10.000000,
+	{"k", "ograve"}:                   -10.000000,
+	{"k", "ohungarumlaut"}:            -10.000000,
+	{"k", "omacron"}:                  -10.000000,
+	{"k", "oslash"}:                   -10.000000,
+	{"k", "otilde"}:                   -10.000000,
+	{"kcommaaccent", "e"}:             -30.000000,
+	{"kcommaaccent", "eacute"}:        -30.000000,
+	{"kcommaaccent", "ecaron"}:        -30.000000,
+	{"kcommaaccent", "ecircumflex"}:   -30.000000,
+	{"kcommaaccent", "edieresis"}:     -30.000000,
+	{"kcommaaccent", "edotaccent"}:    -30.000000,
+	{"kcommaaccent", "egrave"}:        -30.000000,
+	{"kcommaaccent", "emacron"}:       -30.000000,
+	{"kcommaaccent", "eogonek"}:       -30.000000,
+	{"kcommaaccent", "o"}:             -10.000000,
+	{"kcommaaccent", "oacute"}:        -10.000000,
+	{"kcommaaccent", "ocircumflex"}:   -10.000000,
+	{"kcommaaccent", "odieresis"}:     -10.000000,
+	{"kcommaaccent", "ograve"}:        -10.000000,
+	{"kcommaaccent", "ohungarumlaut"}: -10.000000,
+	{"kcommaaccent", "omacron"}:       -10.000000,
+	{"kcommaaccent", "oslash"}:        -10.000000,
+	{"kcommaaccent", "otilde"}:        -10.000000,
+	{"n", "v"}:                        -40.000000,
+	{"nacute", "v"}:                   -40.000000,
+	{"ncaron", "v"}:                   -40.000000,
+	{"ncommaaccent", "v"}:             -40.000000,
+	{"ntilde", "v"}:                   -40.000000,
+	{"o", "v"}:                        -15.000000,
+	{"o", "w"}:                        -25.000000,
+	{"o", "x"}:                        -10.000000,
+	{"o", "y"}:                        -10.000000,
+	{"o", "yacute"}:                   -10.000000,
+	{"o", "ydieresis"}:                -10.000000,
+	{"oacute", "v"}:                   -15.000000,
+	{"oacute", "w"}:                   -25.000000,
+	{"oacute", "x"}:                   -10.000000,
+	{"oacute", "y"}:                   -10.000000,
+	{"oacute", "yacute"}:              -10.000000,
+	{"oacute", "ydieresis"}:           -10.000000,
+	{"ocircumflex", "v"}:              -15.000000,
+	{"ocircumflex", "w"}:              -25.000000,
+	{"ocircumflex", "x"}:              -10.000000,
+	{"ocircumflex", "y"}:              -10.000000,
+	{"ocircumflex", "yacute"}:         -10.000000,
+	{"ocircumflex", "ydieresis"}:      -10.000000,
+	{"odieresis", "v"}:                -15.000000,
+	{"odieresis", "w"}:                -25.000000,
+	{"odieresis", "x"}:                -10.000000,
+	{"odieresis", "y"}:                -10.000000,
+	{"odieresis", "yacute"}:           -10.000000,
+	{"odieresis", "ydieresis"}:        -10.000000,
+	{"ograve", "v"}:                   -15.000000,
+	{"ograve", "w"}:                   -25.000000,
+	{"ograve", "x"}:                   -10.000000,
+	{"ograve", "y"}:                   -10.000000,
+	{"ograve", "yacute"}:              -10.000000,
+	{"ograve", "ydieresis"}:           -10.000000,
+	{"ohungarumlaut", "v"}:            -15.000000,
+	{"ohungarumlaut", "w"}:            -25.000000,
+	{"ohungarumlaut", "x"}:            -10.000000,
+	{"ohungarumlaut", "y"}:            -10.000000,
+	{"ohungarumlaut", "yacute"}:       -10.000000,
+	{"ohungarumlaut", "ydieresis"}:    -10.000000,
+	{"omacron", "v"}:                  -15.000000,
+	{"omacron", "w"}:                  -25.000000,
+	{"omacron", "x"}:                  -10.000000,
+	{"omacron", "y"}:                  -10.000000,
+	{"omacron", "yacute"}:             -10.000000,
+	{"omacron", "ydieresis"}:          -10.000000,
+	{"oslash", "v"}:                   -15.000000,
+	{"oslash", "w"}:                   -25.000000,
+	{"oslash", "x"}:                   -10.000000,
+	{"oslash", "y"}:                   -10.000000,
+	{"oslash", "yacute"}:              -10.000000,
+	{"oslash", "ydieresis"}:           -10.000000,
+	{"otilde", "v"}:                   -15.000000,
+	{"otilde", "w"}:                   -25.000000,
+	{"otilde", "x"}:                   -10.000000,
+	{"otilde", "y"}:                   -10.000000,
+	{"otilde", "yacute"}:              -10.000000,
+	{"otilde", "ydieresis"}:           -10.000000,
+	{"period", "quotedblright"}:       -95.000000,
+	{"period", "quoteright"}:          -95.000000,
+	{"quoteleft", "quoteleft"}:        -74.000000,
+	{"quoteright", "d"}:               -15.000000,
+	{"quoteright", "dcroat"}:          -15.000000,
+	{"quoteright", "quoteright"}:      -74.000000,
+	{"quoteright", "r"}:               -15.000000,
+	{"quoteright", "racute"}:          -15.000000,
+	{"quoteright", "rcaron"}:          -15.000000,
+	{"quoteright", "rcommaaccent"}:    -15.000000,
+	{"quoteright", "s"}:               -74.000000,
+	{"quoteright", "sacute"}:          -74.000000,
+	{"quoteright", "scaron"}:          -74.000000,
+	{"quoteright", "scedilla"}:        -74.000000,
+	{"quoteright", "scommaaccent"}:    -74.000000,
+	{"quoteright", "space"}:           -74.000000,
+	{"quoteright", "t"}:               -37.000000,
+	{"quoteright", "tcommaaccent"}:    -37.000000,
+	{"quoteright", "v"}:               -15.000000,
+	{"r", "comma"}:                    -65.000000,
+	{"r", "period"}:                   -65.000000,
+	{"racute", "comma"}:               -65.000000,
+	{"racute", "period"}:              -65.000000,
+	{"rcaron", "comma"}:               -65.000000,
+	{"rcaron", "period"}:              -65.000000,
+	{"rcommaaccent", "comma"}:         -65.000000,
+	{"rcommaaccent", "period"}:        -65.000000,
+	{"space", "A"}:                    -37.000000,
+	{"space", "Aacute"}:               -37.000000,
+	{"space", "Abreve"}:               -37.000000,
+	{"space", "Acircumflex"}:          -37.000000,
+	{"space", "Adieresis"}:            -37.000000,
+	{"space", "Agrave"}:               -37.000000,
+	{"space", "Amacron"}:              -37.000000,
+	{"space", "Aogonek"}:              -37.000000,
+	{"space", "Aring"}:                -37.000000,
+	{"space", "Atilde"}:               -37.000000,
+	{"space", "V"}:                    -70.000000,
+	{"space", "W"}:                    -70.000000,
+	{"space", "Y"}:                    -70.000000,
+	{"space", "Yacute"}:               -70.000000,
+	{"space", "Ydieresis"}:            -70.000000,
+	{"v", "comma"}:                    -37.000000,
+	{"v", "e"}:                        -15.000000,
+	{"v", "eacute"}:                   -15.000000,
+	{"v", "ecaron"}:                   -15.000000,
+	{"v", "ecircumflex"}:              -15.000000,
+	{"v", "edieresis"}:                -15.000000,
+	{"v", "edotaccent"}:               -15.000000,
+	{"v", "egrave"}:                   -15.000000,
+	{"v", "emacron"}:                  -15.000000,
+	{"v", "eogonek"}:                  -15.000000,
+	{"v", "o"}:                        -15.000000,
+	{"v", "oacute"}:                   -15.000000,
+	{"v", "ocircumflex"}:              -15.000000,
+	{"v", "odieresis"}:                -15.000000,
+	{"v", "ograve"}:                   -15.000000,
+	{"v", "ohungarumlaut"}:            -15.000000,
+	{"v", "omacron"}:                  -15.000000,
+	{"v", "oslash"}:                   -15.000000,
+	{"v", "otilde"}:                   -15.000000,
+	{"v", "period"}:                   -37.000000,
+	{"w", "a"}:                        -10.000000,
+	{"w", "aacute"}:                   -10.000000,
+	{"w", "abreve"}:                   -10.000000,
+	{"w", "acircumflex"}:              -10.000000,
+	{"w", "adieresis"}:                -10.000000,
+	{"w", "agrave"}:                   -10.000000,
+	{"w", "amacron"}:                  -10.000000,
+	{"w", "aogonek"}:                  -10.000000,
+	{"w", "aring"}:                    -10.000000,
+	{"w", "atilde"}:                   -10.000000,
+	{"w", "comma"}:                    -37.000000,
+	{"w", "e"}:                        -10.000000,
+	{"w", "eacute"}:                   -10.000000,
+	{"w", "ecaron"}:                   -10.000000,
+	{"w", "ecircumflex"}:              -10.000000,
+	{"w", "edieresis"}:                -10.000000,
+	{"w", "edotaccent"}:               -10.000000,
+	{"w", "egrave"}:                   -10.000000,
+	{"w", "emacron"}:                  -10.000000,
+	{"w", "eogonek"}:                  -10.000000,
+	{"w", "o"}:                        -15.000000,
+	{"w", "oacute"}:                   -15.000000,
+	{"w", "ocircumflex"}:              -15.000000,
+	{"w", "odieresis"}:                -15.000000,
+	{"w", "ograve"}:                   -15.000000,
+	{"w", "ohungarumlaut"}:            -15.000000,
+	{"w", "omacron"}:                  -15.000000,
+	{"w", "oslash"}:                   -15.000000,
+	{"w", "otilde"}:                   -15.000000,
+	{"w", "period"}:                   -37.000000,
+	{"x", "e"}:                        -10.000000,
+	{"x", "eacute"}:                   -10.000000,
+	{"x", "ecaron"}:                   -10.000000,
+	{"x", "ecircumflex"}:              -10.000000,
+	{"x", "edieresis"}:                -10.000000,
+	{"x", "edotaccent"}:               -10.000000,
+	{"x", "egrave"}:                   -10.000000,
+	{"x", "emacron"}:                  -10.000000,
+	{"x", "eogonek"}:                  -10.000000,
+	{"y", "comma"}:                    -37.000000,
+	{"y", "period"}:                   -37.000000,
+	{"yacute", "comma"}:               -37.000000,
+	{"yacute", "period"}:              -37.000000,
+	{"ydieresis", "comma"}:            -37.000000,
+	{"ydieresis", "period"}:           -37.000000,
+}
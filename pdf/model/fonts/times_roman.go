@@ -39,6 +39,22 @@ func (font fontTimesRoman) GetGlyphCharMetrics(glyph string) (CharMetrics, bool)
 	return metrics, true
 }
 
+// GetGlyphKerning returns the kerning of glyph `left` followed by glyph `right` in glyph space units.
+func (font fontTimesRoman) GetGlyphKerning(left, right string) (float64, bool) {
+	kx, has := timesRomanKerning[GlyphPair{left, right}]
+	return kx, has
+}
+
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the AFM).
+func (font fontTimesRoman) GetAscentDescent() (float64, float64) {
+	return 683, -217
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`, including kerning.
+func (font fontTimesRoman) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontTimesRoman) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
@@ -370,3 +386,2080 @@ var timesRomanCharMetrics map[string]CharMetrics = map[string]CharMetrics{
 	"zdotaccent":     {GlyphName: "zdotaccent", Wx: 444.000000, Wy: 0.000000},
 	"zero":           {GlyphName: "zero", Wx: 500.000000, Wy: 0.000000},
 }
+
+// Times-Roman kerning pairs loaded from afms/Times-Roman.afm.  See afms/MustRead.html for license information.
+var timesRomanKerning map[GlyphPair]float64 = map[GlyphPair]float64{
+	{"A", "C"}:                        -40.000000,
+	{"A", "Cacute"}:                   -40.000000,
+	{"A", "Ccaron"}:                   -40.000000,
+	{"A", "Ccedilla"}:                 -40.000000,
+	{"A", "G"}:                        -40.000000,
+	{"A", "Gbreve"}:                   -40.000000,
+	{"A", "Gcommaaccent"}:             -40.000000,
+	{"A", "O"}:                        -55.000000,
+	{"A", "Oacute"}:                   -55.000000,
+	{"A", "Ocircumflex"}:              -55.000000,
+	{"A", "Odieresis"}:                -55.000000,
+	{"A", "Ograve"}:                   -55.000000,
+	{"A", "Ohungarumlaut"}:            -55.000000,
+	{"A", "Omacron"}:                  -55.000000,
+	{"A", "Oslash"}:                   -55.000000,
+	{"A", "Otilde"}:                   -55.000000,
+	{"A", "Q"}:                        -55.000000,
+	{"A", "T"}:                        -111.000000,
+	{"A", "Tcaron"}:                   -111.000000,
+	{"A", "Tcommaaccent"}:             -111.000000,
+	{"A", "U"}:                        -55.000000,
+	{"A", "Uacute"}:                   -55.000000,
+	{"A", "Ucircumflex"}:              -55.000000,
+	{"A", "Udieresis"}:                -55.000000,
+	{"A", "Ugrave"}:                   -55.000000,
+	{"A", "Uhungarumlaut"}:            -55.000000,
+	{"A", "Umacron"}:                  -55.000000,
+	{"A", "Uogonek"}:                  -55.000000,
+	{"A", "Uring"}:                    -55.000000,
+	{"A", "V"}:                        -135.000000,
+	{"A", "W"}:                        -90.000000,
+	{"A", "Y"}:                        -105.000000,
+	{"A", "Yacute"}:                   -105.000000,
+	{"A", "Ydieresis"}:                -105.000000,
+	{"A", "quoteright"}:               -111.000000,
+	{"A", "v"}:                        -74.000000,
+	{"A", "w"}:                        -92.000000,
+	{"A", "y"}:                        -92.000000,
+	{"A", "yacute"}:                   -92.000000,
+	{"A", "ydieresis"}:                -92.000000,
+	{"Aacute", "C"}:                   -40.000000,
+	{"Aacute", "Cacute"}:              -40.000000,
+	{"Aacute", "Ccaron"}:              -40.000000,
+	{"Aacute", "Ccedilla"}:            -40.000000,
+	{"Aacute", "G"}:                   -40.000000,
+	{"Aacute", "Gbreve"}:              -40.000000,
+	{"Aacute", "Gcommaaccent"}:        -40.000000,
+	{"Aacute", "O"}:                   -55.000000,
+	{"Aacute", "Oacute"}:              -55.000000,
+	{"Aacute", "Ocircumflex"}:         -55.000000,
+	{"Aacute", "Odieresis"}:           -55.000000,
+	{"Aacute", "Ograve"}:              -55.000000,
+	{"Aacute", "Ohungarumlaut"}:       -55.000000,
+	{"Aacute", "Omacron"}:             -55.000000,
+	{"Aacute", "Oslash"}:              -55.000000,
+	{"Aacute", "Otilde"}:              -55.000000,
+	{"Aacute", "Q"}:                   -55.000000,
+	{"Aacute", "T"}:                   -111.000000,
+	{"Aacute", "Tcaron"}:              -111.000000,
+	{"Aacute", "Tcommaaccent"}:        -111.000000,
+	{"Aacute", "U"}:                   -55.000000,
+	{"Aacute", "Uacute"}:              -55.000000,
+	{"Aacute", "Ucircumflex"}:         -55.000000,
+	{"Aacute", "Udieresis"}:           -55.000000,
+	{"Aacute", "Ugrave"}:              -55.000000,
+	{"Aacute", "Uhungarumlaut"}:       -55.000000,
+	{"Aacute", "Umacron"}:             -55.000000,
+	{"Aacute", "Uogonek"}:             -55.000000,
+	{"Aacute", "Uring"}:               -55.000000,
+	{"Aacute", "V"}:                   -135.000000,
+	{"Aacute", "W"}:                   -90.000000,
+	{"Aacute", "Y"}:                   -105.000000,
+	{"Aacute", "Yacute"}:              -105.000000,
+	{"Aacute", "Ydieresis"}:           -105.000000,
+	{"Aacute", "quoteright"}:          -111.000000,
+	{"Aacute", "v"}:                   -74.000000,
+	{"Aacute", "w"}:                   -92.000000,
+	{"Aacute", "y"}:                   -92.000000,
+	{"Aacute", "yacute"}:              -92.000000,
+	{"Aacute", "ydieresis"}:           -92.000000,
+	{"Abreve", "C"}:                   -40.000000,
+	{"Abreve", "Cacute"}:              -40.000000,
+	{"Abreve", "Ccaron"}:              -40.000000,
+	{"Abreve", "Ccedilla"}:            -40.000000,
+	{"Abreve", "G"}:                   -40.000000,
+	{"Abreve", "Gbreve"}:              -40.000000,
+	{"Abreve", "Gcommaaccent"}:        -40.000000,
+	{"Abreve", "O"}:                   -55.000000,
+	{"Abreve", "Oacute"}:              -55.000000,
+	{"Abreve", "Ocircumflex"}:         -55.000000,
+	{"Abreve", "Odieresis"}:           -55.000000,
+	{"Abreve", "Ograve"}:              -55.000000,
+	{"Abreve", "Ohungarumlaut"}:       -55.000000,
+	{"Abreve", "Omacron"}:             -55.000000,
+	{"Abreve", "Oslash"}:              -55.000000,
+	{"Abreve", "Otilde"}:              -55.000000,
+	{"Abreve", "Q"}:                   -55.000000,
+	{"Abreve", "T"}:                   -111.000000,
+	{"Abreve", "Tcaron"}:              -111.000000,
+	{"Abreve", "Tcommaaccent"}:        -111.000000,
+	{"Abreve", "U"}:                   -55.000000,
+	{"Abreve", "Uacute"}:              -55.000000,
+	{"Abreve", "Ucircumflex"}:         -55.000000,
+	{"Abreve", "Udieresis"}:           -55.000000,
+	{"Abreve", "Ugrave"}:              -55.000000,
+	{"Abreve", "Uhungarumlaut"}:       -55.000000,
+	{"Abreve", "Umacron"}:             -55.000000,
+	{"Abreve", "Uogonek"}:             -55.000000,
+	{"Abreve", "Uring"}:               -55.000000,
+	{"Abreve", "V"}:                   -135.000000,
+	{"Abreve", "W"}:                   -90.000000,
+	{"Abreve", "Y"}:                   -105.000000,
+	{"Abreve", "Yacute"}:              -105.000000,
+	{"Abreve", "Ydieresis"}:           -105.000000,
+	{"Abreve", "quoteright"}:          -111.000000,
+	{"Abreve", "v"}:                   -74.000000,
+	{"Abreve", "w"}:                   -92.000000,
+	{"Abreve", "y"}:                   -92.000000,
+	{"Abreve", "yacute"}:              -92.000000,
+	{"Abreve", "ydieresis"}:           -92.000000,
+	{"Acircumflex", "C"}:              -40.000000,
+	{"Acircumflex", "Cacute"}:         -40.000000,
+	{"Acircumflex", "Ccaron"}:         -40.000000,
+	{"Acircumflex", "Ccedilla"}:       -40.000000,
+	{"Acircumflex", "G"}:              -40.000000,
+	{"Acircumflex", "Gbreve"}:         -40.000000,
+	{"Acircumflex", "Gcommaaccent"}:   -40.000000,
+	{"Acircumflex", "O"}:              -55.000000,
+	{"Acircumflex", "Oacute"}:         -55.000000,
+	{"Acircumflex", "Ocircumflex"}:    -55.000000,
+	{"Acircumflex", "Odieresis"}:      -55.000000,
+	{"Acircumflex", "Ograve"}:         -55.000000,
+	{"Acircumflex", "Ohungarumlaut"}:  -55.000000,
+	{"Acircumflex", "Omacron"}:        -55.000000,
+	{"Acircumflex", "Oslash"}:         -55.000000,
+	{"Acircumflex", "Otilde"}:         -55.000000,
+	{"Acircumflex", "Q"}:              -55.000000,
+	{"Acircumflex", "T"}:              -111.000000,
+	{"Acircumflex", "Tcaron"}:         -111.000000,
+	{"Acircumflex", "Tcommaaccent"}:   -111.000000,
+	{"Acircumflex", "U"}:              -55.000000,
+	{"Acircumflex", "Uacute"}:         -55.000000,
+	{"Acircumflex", "Ucircumflex"}:    -55.000000,
+	{"Acircumflex", "Udieresis"}:      -55.000000,
+	{"Acircumflex", "Ugrave"}:         -55.000000,
+	{"Acircumflex", "Uhungarumlaut"}:  -55.000000,
+	{"Acircumflex", "Umacron"}:        -55.000000,
+	{"Acircumflex", "Uogonek"}:        -55.000000,
+	{"Acircumflex", "Uring"}:          -55.000000,
+	{"Acircumflex", "V"}:              -135.000000,
+	{"Acircumflex", "W"}:              -90.000000,
+	{"Acircumflex", "Y"}:              -105.000000,
+	{"Acircumflex", "Yacute"}:         -105.000000,
+	{"Acircumflex", "Ydieresis"}:      -105.000000,
+	{"Acircumflex", "quoteright"}:     -111.000000,
+	{"Acircumflex", "v"}:              -74.000000,
+	{"Acircumflex", "w"}:              -92.000000,
+	{"Acircumflex", "y"}:              -92.000000,
+	{"Acircumflex", "yacute"}:         -92.000000,
+	{"Acircumflex", "ydieresis"}:      -92.000000,
+	{"Adieresis", "C"}:                -40.000000,
+	{"Adieresis", "Cacute"}:           -40.000000,
+	{"Adieresis", "Ccaron"}:           -40.000000,
+	{"Adieresis", "Ccedilla"}:         -40.000000,
+	{"Adieresis", "G"}:                -40.000000,
+	{"Adieresis", "Gbreve"}:           -40.000000,
+	{"Adieresis", "Gcommaaccent"}:     -40.000000,
+	{"Adieresis", "O"}:                -55.000000,
+	{"Adieresis", "Oacute"}:           -55.000000,
+	{"Adieresis", "Ocircumflex"}:      -55.000000,
+	{"Adieresis", "Odieresis"}:        -55.000000,
+	{"Adieresis", "Ograve"}:           -55.000000,
+	{"Adieresis", "Ohungarumlaut"}:    -55.000000,
+	{"Adieresis", "Omacron"}:          -55.000000,
+	{"Adieresis", "Oslash"}:           -55.000000,
+	{"Adieresis", "Otilde"}:           -55.000000,
+	{"Adieresis", "Q"}:                -55.000000,
+	{"Adieresis", "T"}:                -111.000000,
+	{"Adieresis", "Tcaron"}:           -111.000000,
+	{"Adieresis", "Tcommaaccent"}:     -111.000000,
+	{"Adieresis", "U"}:                -55.000000,
+	{"Adieresis", "Uacute"}:           -55.000000,
+	{"Adieresis", "Ucircumflex"}:      -55.000000,
+	{"Adieresis", "Udieresis"}:        -55.000000,
+	{"Adieresis", "Ugrave"}:           -55.000000,
+	{"Adieresis", "Uhungarumlaut"}:    -55.000000,
+	{"Adieresis", "Umacron"}:          -55.000000,
+	{"Adieresis", "Uogonek"}:          -55.000000,
+	{"Adieresis", "Uring"}:            -55.000000,
+	{"Adieresis", "V"}:                -135.000000,
+	{"Adieresis", "W"}:                -90.000000,
+	{"Adieresis", "Y"}:                -105.000000,
+	{"Adieresis", "Yacute"}:           -105.000000,
+	{"Adieresis", "Ydieresis"}:        -105.000000,
+	{"Adieresis", "quoteright"}:       -111.000000,
+	{"Adieresis", "v"}:                -74.000000,
+	{"Adieresis", "w"}:                -92.000000,
+	{"Adieresis", "y"}:                -92.000000,
+	{"Adieresis", "yacute"}:           -92.000000,
+	{"Adieresis", "ydieresis"}:        -92.000000,
+	{"Agrave", "C"}:                   -40.000000,
+	{"Agrave", "Cacute"}:              -40.000000,
+	{"Agrave", "Ccaron"}:              -40.000000,
+	{"Agrave", "Ccedilla"}:            -40.000000,
+	{"Agrave", "G"}:                   -40.000000,
+	{"Agrave", "Gbreve"}:              -40.000000,
+	{"Agrave", "Gcommaaccent"}:        -40.000000,
+	{"Agrave", "O"}:                   -55.000000,
+	{"Agrave", "Oacute"}:              -55.000000,
+	{"Agrave", "Ocircumflex"}:         -55.000000,
+	{"Agrave", "Odieresis"}:           -55.000000,
+	{"Agrave", "Ograve"}:              -55.000000,
+	{"Agrave", "Ohungarumlaut"}:       -55.000000,
+	{"Agrave", "Omacron"}:             -55.000000,
+	{"Agrave", "Oslash"}:              -55.000000,
+	{"Agrave", "Otilde"}:              -55.000000,
+	{"Agrave", "Q"}:                   -55.000000,
+	{"Agrave", "T"}:                   -111.000000,
+	{"Agrave", "Tcaron"}:              -111.000000,
+	{"Agrave", "Tcommaaccent"}:        -111.000000,
+	{"Agrave", "U"}:                   -55.000000,
+	{"Agrave", "Uacute"}:              -55.000000,
+	{"Agrave", "Ucircumflex"}:         -55.000000,
+	{"Agrave", "Udieresis"}:           -55.000000,
+	{"Agrave", "Ugrave"}:              -55.000000,
+	{"Agrave", "Uhungarumlaut"}:       -55.000000,
+	{"Agrave", "Umacron"}:             -55.000000,
+	{"Agrave", "Uogonek"}:             -55.000000,
+	{"Agrave", "Uring"}:               -55.000000,
+	{"Agrave", "V"}:                   -135.000000,
+	{"Agrave", "W"}:                   -90.000000,
+	{"Agrave", "Y"}:                   -105.000000,
+	{"Agrave", "Yacute"}:              -105.000000,
+	{"Agrave", "Ydieresis"}:           -105.000000,
+	{"Agrave", "quoteright"}:          -111.000000,
+	{"Agrave", "v"}:                   -74.000000,
+	{"Agrave", "w"}:                   -92.000000,
+	{"Agrave", "y"}:                   -92.000000,
+	{"Agrave", "yacute"}:              -92.000000,
+	{"Agrave", "ydieresis"}:           -92.000000,
+	{"Amacron", "C"}:                  -40.000000,
+	{"Amacron", "Cacute"}:             -40.000000,
+	{"Amacron", "Ccaron"}:             -40.000000,
+	{"Amacron", "Ccedilla"}:           -40.000000,
+	{"Amacron", "G"}:                  -40.000000,
+	{"Amacron", "Gbreve"}:             -40.000000,
+	{"Amacron", "Gcommaaccent"}:       -40.000000,
+	{"Amacron", "O"}:                  -55.000000,
+	{"Amacron", "Oacute"}:             -55.000000,
+	{"Amacron", "Ocircumflex"}:        -55.000000,
+	{"Amacron", "Odieresis"}:          -55.000000,
+	{"Amacron", "Ograve"}:             -55.000000,
+	{"Amacron", "Ohungarumlaut"}:      -55.000000,
+	{"Amacron", "Omacron"}:            -55.000000,
+	{"Amacron", "Oslash"}:             -55.000000,
+	{"Amacron", "Otilde"}:             -55.000000,
+	{"Amacron", "Q"}:                  -55.000000,
+	{"Amacron", "T"}:                  -111.000000,
+	{"Amacron", "Tcaron"}:             -111.000000,
+	{"Amacron", "Tcommaaccent"}:       -111.000000,
+	{"Amacron", "U"}:                  -55.000000,
+	{"Amacron", "Uacute"}:             -55.000000,
+	{"Amacron", "Ucircumflex"}:        -55.000000,
+	{"Amacron", "Udieresis"}:          -55.000000,
+	{"Amacron", "Ugrave"}:             -55.000000,
+	{"Amacron", "Uhungarumlaut"}:      -55.000000,
+	{"Amacron", "Umacron"}:            -55.000000,
+	{"Amacron", "Uogonek"}:            -55.000000,
+	{"Amacron", "Uring"}:              -55.000000,
+	{"Amacron", "V"}:                  -135.000000,
+	{"Amacron", "W"}:                  -90.000000,
+	{"Amacron", "Y"}:                  -105.000000,
+	{"Amacron", "Yacute"}:             -105.000000,
+	{"Amacron", "Ydieresis"}:          -105.000000,
+	{"Amacron", "quoteright"}:         -111.000000,
+	{"Amacron", "v"}:                  -74.000000,
+	{"Amacron", "w"}:                  -92.000000,
+	{"Amacron", "y"}:                  -92.000000,
+	{"Amacron", "yacute"}:             -92.000000,
+	{"Amacron", "ydieresis"}:          -92.000000,
+	{"Aogonek", "C"}:                  -40.000000,
+	{"Aogonek", "Cacute"}:             -40.000000,
+	{"Aogonek", "Ccaron"}:             -40.000000,
+	{"Aogonek", "Ccedilla"}:           -40.000000,
+	{"Aogonek", "G"}:                  -40.000000,
+	{"Aogonek", "Gbreve"}:             -40.000000,
+	{"Aogonek", "Gcommaaccent"}:       -40.000000,
+	{"Aogonek", "O"}:                  -55.000000,
+	{"Aogonek", "Oacute"}:             -55.000000,
+	{"Aogonek", "Ocircumflex"}:        -55.000000,
+	{"Aogonek", "Odieresis"}:          -55.000000,
+	{"Aogonek", "Ograve"}:             -55.000000,
+	{"Aogonek", "Ohungarumlaut"}:      -55.000000,
+	{"Aogonek", "Omacron"}:            -55.000000,
+	{"Aogonek", "Oslash"}:             -55.000000,
+	{"Aogonek", "Otilde"}:             -55.000000,
+	{"Aogonek", "Q"}:                  -55.000000,
+	{"Aogonek", "T"}:                  -111.000000,
+	{"Aogonek", "Tcaron"}:             -111.000000,
+	{"Aogonek", "Tcommaaccent"}:       -111.000000,
+	{"Aogonek", "U"}:                  -55.000000,
+	{"Aogonek", "Uacute"}:             -55.000000,
+	{"Aogonek", "Ucircumflex"}:        -55.000000,
+	{"Aogonek", "Udieresis"}:          -55.000000,
+	{"Aogonek", "Ugrave"}:             -55.000000,
+	{"Aogonek", "Uhungarumlaut"}:      -55.000000,
+	{"Aogonek", "Umacron"}:            -55.000000,
+	{"Aogonek", "Uogonek"}:            -55.000000,
+	{"Aogonek", "Uring"}:              -55.000000,
+	{"Aogonek", "V"}:                  -135.000000,
+	{"Aogonek", "W"}:                  -90.000000,
+	{"Aogonek", "Y"}:                  -105.000000,
+	{"Aogonek", "Yacute"}:             -105.000000,
+	{"Aogonek", "Ydieresis"}:          -105.000000,
+	{"Aogonek", "quoteright"}:         -111.000000,
+	{"Aogonek", "v"}:                  -74.000000,
+	{"Aogonek", "w"}:                  -52.000000,
+	{"Aogonek", "y"}:                  -52.000000,
+	{"Aogonek", "yacute"}:             -52.000000,
+	{"Aogonek", "ydieresis"}:          -52.000000,
+	{"Aring", "C"}:                    -40.000000,
+	{"Aring", "Cacute"}:               -40.000000,
+	{"Aring", "Ccaron"}:               -40.000000,
+	{"Aring", "Ccedilla"}:             -40.000000,
+	{"Aring", "G"}:                    -40.000000,
+	{"Aring", "Gbreve"}:               -40.000000,
+	{"Aring", "Gcommaaccent"}:         -40.000000,
+	{"Aring", "O"}:                    -55.000000,
+	{"Aring", "Oacute"}:               -55.000000,
+	{"Aring", "Ocircumflex"}:          -55.000000,
+	{"Aring", "Odieresis"}:            -55.000000,
+	{"Aring", "Ograve"}:               -55.000000,
+	{"Aring", "Ohungarumlaut"}:        -55.000000,
+	{"Aring", "Omacron"}:              -55.000000,
+	{"Aring", "Oslash"}:               -55.000000,
+	{"Aring", "Otilde"}:               -55.000000,
+	{"Aring", "Q"}:                    -55.000000,
+	{"Aring", "T"}:                    -111.000000,
+	{"Aring", "Tcaron"}:               -111.000000,
+	{"Aring", "Tcommaaccent"}:         -111.000000,
+	{"Aring", "U"}:                    -55.000000,
+	{"Aring", "Uacute"}:               -55.000000,
+	{"Aring", "Ucircumflex"}:          -55.000000,
+	{"Aring", "Udieresis"}:            -55.000000,
+	{"Aring", "Ugrave"}:               -55.000000,
+	{"Aring", "Uhungarumlaut"}:        -55.000000,
+	{"Aring", "Umacron"}:              -55.000000,
+	{"Aring", "Uogonek"}:              -55.000000,
+	{"Aring", "Uring"}:                -55.000000,
+	{"Aring", "V"}:                    -135.000000,
+	{"Aring", "W"}:                    -90.000000,
+	{"Aring", "Y"}:                    -105.000000,
+	{"Aring", "Yacute"}:               -105.000000,
+	{"Aring", "Ydieresis"}:            -105.000000,
+	{"Aring", "quoteright"}:           -111.000000,
+	{"Aring", "v"}:                    -74.000000,
+	{"Aring", "w"}:                    -92.000000,
+	{"Aring", "y"}:                    -92.000000,
+	{"Aring", "yacute"}:               -92.000000,
+	{"Aring", "ydieresis"}:            -92.000000,
+	{"Atilde", "C"}:                   -40.000000,
+	{"Atilde", "Cacute"}:              -40.000000,
+	{"Atilde", "Ccaron"}:              -40.000000,
+	{"Atilde", "Ccedilla"}:            -40.000000,
+	{"Atilde", "G"}:                   -40.000000,
+	{"Atilde", "Gbreve"}:              -40.000000,
+	{"Atilde", "Gcommaaccent"}:        -40.000000,
+	{"Atilde", "O"}:                   -55.000000,
+	{"Atilde", "Oacute"}:              -55.000000,
+	{"Atilde", "Ocircumflex"}:         -55.000000,
+	{"Atilde", "Odieresis"}:           -55.000000,
+	{"Atilde", "Ograve"}:              -55.000000,
+	{"Atilde", "Ohungarumlaut"}:       -55.000000,
+	{"Atilde", "Omacron"}:             -55.000000,
+	{"Atilde", "Oslash"}:              -55.000000,
+	{"Atilde", "Otilde"}:              -55.000000,
+	{"Atilde", "Q"}:                   -55.000000,
+	{"Atilde", "T"}:                   -111.000000,
+	{"Atilde", "Tcaron"}:              -111.000000,
+	{"Atilde", "Tcommaaccent"}:        -111.000000,
+	{"Atilde", "U"}:                   -55.000000,
+	{"Atilde", "Uacute"}:              -55.000000,
+	{"Atilde", "Ucircumflex"}:         -55.000000,
+	{"Atilde", "Udieresis"}:           -55.000000,
+	{"Atilde", "Ugrave"}:              -55.000000,
+	{"Atilde", "Uhungarumlaut"}:       -55.000000,
+	{"Atilde", "Umacron"}:             -55.000000,
+	{"Atilde", "Uogonek"}:             -55.000000,
+	{"Atilde", "Uring"}:               -55.000000,
+	{"Atilde", "V"}:                   -135.000000,
+	{"Atilde", "W"}:                   -90.000000,
+	{"Atilde", "Y"}:                   -105.000000,
+	{"Atilde", "Yacute"}:              -105.000000,
+	{"Atilde", "Ydieresis"}:           -105.000000,
+	{"Atilde", "quoteright"}:          -111.000000,
+	{"Atilde", "v"}:                   -74.000000,
+	{"Atilde", "w"}:                   -92.000000,
+	{"Atilde", "y"}:                   -92.000000,
+	{"Atilde", "yacute"}:              -92.000000,
+	{"Atilde", "ydieresis"}:           -92.000000,
+	{"B", "A"}:                        -35.000000,
+	{"B", "Aacute"}:                   -35.000000,
+	{"B", "Abreve"}:                   -35.000000,
+	{"B", "Acircumflex"}:              -35.000000,
+	{"B", "Adieresis"}:                -35.000000,
+	{"B", "Agrave"}:                   -35.000000,
+	{"B", "Amacron"}:                  -35.000000,
+	{"B", "Aogonek"}:                  -35.000000,
+	{"B", "Aring"}:                    -35.000000,
+	{"B", "Atilde"}:                   -35.000000,
+	{"B", "U"}:                        -10.000000,
+	{"B", "Uacute"}:                   -10.000000,
+	{"B", "Ucircumflex"}:              -10.000000,
+	{"B", "Udieresis"}:                -10.000000,
+	{"B", "Ugrave"}:                   -10.000000,
+	{"B", "Uhungarumlaut"}:            -10.000000,
+	{"B", "Umacron"}:                  -10.000000,
+	{"B", "Uogonek"}:                  -10.000000,
+	{"B", "Uring"}:                    -10.000000,
+	{"D", "A"}:                        -40.000000,
+	{"D", "Aacute"}:                   -40.000000,
+	{"D", "Abreve"}:                   -40.000000,
+	{"D", "Acircumflex"}:              -40.000000,
+	{"D", "Adieresis"}:                -40.000000,
+	{"D", "Agrave"}:                   -40.000000,
+	{"D", "Amacron"}:                  -40.000000,
+	{"D", "Aogonek"}:                  -40.000000,
+	{"D", "Aring"}:                    -40.000000,
+	{"D", "Atilde"}:                   -40.000000,
+	{"D", "V"}:                        -40.000000,
+	{"D", "W"}:                        -30.000000,
+	{"D", "Y"}:                        -55.000000,
+	{"D", "Yacute"}:                   -55.000000,
+	{"D", "Ydieresis"}:                -55.000000,
+	{"Dcaron", "A"}:                   -40.000000,
+	{"Dcaron", "Aacute"}:              -40.000000,
+	{"Dcaron", "Abreve"}:              -40.000000,
+	{"Dcaron", "Acircumflex"}:         -40.000000,
+	{"Dcaron", "Adieresis"}:           -40.000000,
+	{"Dcaron", "Agrave"}:              -40.000000,
+	{"Dcaron", "Amacron"}:             -40.000000,
+	{"Dcaron", "Aogonek"}:             -40.000000,
+	{"Dcaron", "Aring"}:               -40.000000,
+	{"Dcaron", "Atilde"}:              -40.000000,
+	{"Dcaron", "V"}:                   -40.000000,
+	{"Dcaron", "W"}:                   -30.000000,
+	{"Dcaron", "Y"}:                   -55.000000,
+	{"Dcaron", "Yacute"}:              -55.000000,
+	{"Dcaron", "Ydieresis"}:           -55.000000,
+	{"Dcroat", "A"}:                   -40.000000,
+	{"Dcroat", "Aacute"}:              -40.000000,
+	{"Dcroat", "Abreve"}:              -40.000000,
+	{"Dcroat", "Acircumflex"}:         -40.000000,
+	{"Dcroat", "Adieresis"}:           -40.000000,
+	{"Dcroat", "Agrave"}:              -40.000000,
+	{"Dcroat", "Amacron"}:             -40.000000,
+	{"Dcroat", "Aogonek"}:             -40.000000,
+	{"Dcroat", "Aring"}:               -40.000000,
+	{"Dcroat", "Atilde"}:              -40.000000,
+	{"Dcroat", "V"}:                   -40.000000,
+	{"Dcroat", "W"}:                   -30.000000,
+	{"Dcroat", "Y"}:                   -55.000000,
+	{"Dcroat", "Yacute"}:              -55.000000,
+	{"Dcroat", "Ydieresis"}:           -55.000000,
+	{"F", "A"}:                        -74.000000,
+	{"F", "Aacute"}:                   -74.000000,
+	{"F", "Abreve"}:                   -74.000000,
+	{"F", "Acircumflex"}:              -74.000000,
+	{"F", "Adieresis"}:                -74.000000,
+	{"F", "Agrave"}:                   -74.000000,
+	{"F", "Amacron"}:                  -74.000000,
+	{"F", "Aogonek"}:                  -74.000000,
+	{"F", "Aring"}:                    -74.000000,
+	{"F", "Atilde"}:                   -74.000000,
+	{"F", "a"}:                        -15.000000,
+	{"F", "aacute"}:                   -15.000000,
+	{"F", "abreve"}:                   -15.000000,
+	{"F", "acircumflex"}:              -15.000000,
+	{"F", "adieresis"}:                -15.000000,
+	{"F", "agrave"}:                   -15.000000,
+	{"F", "amacron"}:                  -15.000000,
+	{"F", "aogonek"}:                  -15.000000,
+	{"F", "aring"}:                    -15.000000,
+	{"F", "atilde"}:                   -15.000000,
+	{"F", "comma"}:                    -80.000000,
+	{"F", "o"}:                        -15.000000,
+	{"F", "oacute"}:                   -15.000000,
+	{"F", "ocircumflex"}:              -15.000000,
+	{"F", "odieresis"}:                -15.000000,
+	{"F", "ograve"}:                   -15.000000,
+	{"F", "ohungarumlaut"}:            -15.000000,
+	{"F", "omacron"}:                  -15.000000,
+	{"F", "oslash"}:                   -15.000000,
+	{"F", "otilde"}:                   -15.000000,
+	{"F", "period"}:                   -80.000000,
+	{"J", "A"}:                        -60.000000,
+	{"J", "Aacute"}:                   -60.000000,
+	{"J", "Abreve"}:                   -60.000000,
+	{"J", "Acircumflex"}:              -60.000000,
+	{"J", "Adieresis"}:                -60.000000,
+	{"J", "Agrave"}:                   -60.000000,
+	{"J", "Amacron"}:                  -60.000000,
+	{"J", "Aogonek"}:                  -60.000000,
+	{"J", "Aring"}:                    -60.000000,
+	{"J", "Atilde"}:                   -60.000000,
+	{"K", "O"}:                        -30.000000,
+	{"K", "Oacute"}:                   -30.000000,
+	{"K", "Ocircumflex"}:              -30.000000,
+	{"K", "Odieresis"}:                -30.000000,
+	{"K", "Ograve"}:                   -30.000000,
+	{"K", "Ohungarumlaut"}:            -30.000000,
+	{"K", "Omacron"}:                  -30.000000,
+	{"K", "Oslash"}:                   -30.000000,
+	{"K", "Otilde"}:                   -30.000000,
+	{"K", "e"}:                        -25.000000,
+	{"K", "eacute"}:                   -25.000000,
+	{"K", "ecaron"}:                   -25.000000,
+	{"K", "ecircumflex"}:              -25.000000,
+	{"K", "edieresis"}:                -25.000000,
+	{"K", "edotaccent"}:               -25.000000,
+	{"K", "egrave"}:                   -25.000000,
+	{"K", "emacron"}:                  -25.000000,
+	{"K", "eogonek"}:                  -25.000000,
+	{"K", "o"}:                        -35.000000,
+	{"K", "oacute"}:                   -35.000000,
+	{"K", "ocircumflex"}:              -35.000000,
+	{"K", "odieresis"}:                -35.000000,
+	{"K", "ograve"}:                   -35.000000,
+	{"K", "ohungarumlaut"}:            -35.000000,
+	{"K", "omacron"}:                  -35.000000,
+	{"K", "oslash"}:                   -35.000000,
+	{"K", "otilde"}:                   -35.000000,
+	{"K", "u"}:                        -15.000000,
+	{"K", "uacute"}:                   -15.000000,
+	{"K", "ucircumflex"}:              -15.000000,
+	{"K", "udieresis"}:                -15.000000,
+	{"K", "ugrave"}:                   -15.000000,
+	{"K", "uhungarumlaut"}:            -15.000000,
+	{"K", "umacron"}:                  -15.000000,
+	{"K", "uogonek"}:                  -15.000000,
+	{"K", "uring"}:                    -15.000000,
+	{"K", "y"}:                        -25.000000,
+	{"K", "yacute"}:                   -25.000000,
+	{"K", "ydieresis"}:                -25.000000,
+	{"Kcommaaccent", "O"}:             -30.000000,
+	{"Kcommaaccent", "Oacute"}:        -30.000000,
+	{"Kcommaaccent", "Ocircumflex"}:   -30.000000,
+	{"Kcommaaccent", "Odieresis"}:     -30.000000,
+	{"Kcommaaccent", "Ograve"}:        -30.000000,
+	{"Kcommaaccent", "Ohungarumlaut"}: -30.000000,
+	{"Kcommaaccent", "Omacron"}:       -30.000000,
+	{"Kcommaaccent", "Oslash"}:        -30.000000,
+	{"Kcommaaccent", "Otilde"}:        -30.000000,
+	{"Kcommaaccent", "e"}:             -25.000000,
+	{"Kcommaaccent", "eacute"}:        -25.000000,
+	{"Kcommaaccent", "ecaron"}:        -25.000000,
+	{"Kcommaaccent", "ecircumflex"}:   -25.000000,
+	{"Kcommaaccent", "edieresis"}:     -25.000000,
+	{"Kcommaaccent", "edotaccent"}:    -25.000000,
+	{"Kcommaaccent", "egrave"}:        -25.000000,
+	{"Kcommaaccent", "emacron"}:       -25.000000,
+	{"Kcommaaccent", "eogonek"}:       -25.000000,
+	{"Kcommaaccent", "o"}:             -35.000000,
+	{"Kcommaaccent", "oacute"}:        -35.000000,
+	{"Kcommaaccent", "ocircumflex"}:   -35.000000,
+	{"Kcommaaccent", "odieresis"}:     -35.000000,
+	{"Kcommaaccent", "ograve"}:        -35.000000,
+	{"Kcommaaccent", "ohungarumlaut"}: -35.000000,
+	{"Kcommaaccent", "omacron"}:       -35.000000,
+	{"Kcommaaccent", "oslash"}:        -35.000000,
+	{"Kcommaaccent", "otilde"}:        -35.000000,
+	{"Kcommaaccent", "u"}:             -15.000000,
+	{"Kcommaaccent", "uacute"}:        -15.000000,
+	{"Kcommaaccent", "ucircumflex"}:   -15.000000,
+	{"Kcommaaccent", "udieresis"}:     -15.000000,
+	{"Kcommaaccent", "ugrave"}:        -15.000000,
+	{"Kcommaaccent", "uhungarumlaut"}: -15.000000,
+	{"Kcommaaccent", "umacron"}:       -15.000000,
+	{"Kcommaaccent", "uogonek"}:       -15.000000,
+	{"Kcommaaccent", "uring"}:         -15.000000,
+	{"Kcommaaccent", "y"}:             -25.000000,
+	{"Kcommaaccent", "yacute"}:        -25.000000,
+	{"Kcommaaccent", "ydieresis"}:     -25.000000,
+	{"L", "T"}:                        -92.000000,
+	{"L", "Tcaron"}:                   -92.000000,
+	{"L", "Tcommaaccent"}:             -92.000000,
+	{"L", "V"}:                        -100.000000,
+	{"L", "W"}:                        -74.000000,
+	{"L", "Y"}:                        -100.000000,
+	{"L", "Yacute"}:                   -100.000000,
+	{"L", "Ydieresis"}:                -100.000000,
+	{"L", "quoteright"}:               -92.000000,
+	{"L", "y"}:                        -55.000000,
+	{"L", "yacute"}:                   -55.000000,
+	{"L", "ydieresis"}:                -55.000000,
+	{"Lacute", "T"}:                   -92.000000,
+	{"Lacute", "Tcaron"}:              -92.000000,
+	{"Lacute", "Tcommaaccent"}:        -92.000000,
+	{"Lacute", "V"}:                   -100.000000,
+	{"Lacute", "W"}:                   -74.000000,
+	{"Lacute", "Y"}:                   -100.000000,
+	{"Lacute", "Yacute"}:              -100.000000,
+	{"Lacute", "Ydieresis"}:           -100.000000,
+	{"Lacute", "quoteright"}:          -92.000000,
+	{"Lacute", "y"}:                   -55.000000,
+	{"Lacute", "yacute"}:              -55.000000,
+	{"Lacute", "ydieresis"}:           -55.000000,
+	{"Lcaron", "quoteright"}:          -92.000000,
+	{"Lcaron", "y"}:                   -55.000000,
+	{"Lcaron", "yacute"}:              -55.000000,
+	{"Lcaron", "ydieresis"}:           -55.000000,
+	{"Lcommaaccent", "T"}:             -92.000000,
+	{"Lcommaaccent", "Tcaron"}:        -92.000000,
+	{"Lcommaaccent", "Tcommaaccent"}:  -92.000000,
+	{"Lcommaaccent", "V"}:             -100.000000,
+	{"Lcommaaccent", "W"}:             -74.000000,
+	{"Lcommaaccent", "Y"}:             -100.000000,
+	{"Lcommaaccent", "Yacute"}:        -100.000000,
+	{"Lcommaaccent", "Ydieresis"}:     -100.000000,
+	{"Lcommaaccent", "quoteright"}:    -92.000000,
+	{"Lcommaaccent", "y"}:             -55.000000,
+	{"Lcommaaccent", "yacute"}:        -55.000000,
+	{"Lcommaaccent", "ydieresis"}:     -55.000000,
+	{"Lslash", "T"}:                   -92.000000,
+	{"Lslash", "Tcaron"}:              -92.000000,
+	{"Lslash", "Tcommaaccent"}:        -92.000000,
+	{"Lslash", "V"}:                   -100.000000,
+	{"Lslash", "W"}:                   -74.000000,
+	{"Lslash", "Y"}:                   -100.000000,
+	{"Lslash", "Yacute"}:              -100.000000,
+	{"Lslash", "Ydieresis"}:           -100.000000,
+	{"Lslash", "quoteright"}:          -92.000000,
+	{"Lslash", "y"}:                   -55.000000,
+	{"Lslash", "yacute"}:              -55.000000,
+	{"Lslash", "ydieresis"}:           -55.000000,
+	{"N", "A"}:                        -35.000000,
+	{"N", "Aacute"}:                   -35.000000,
+	{"N", "Abreve"}:                   -35.000000,
+	{"N", "Acircumflex"}:              -35.000000,
+	{"N", "Adieresis"}:                -35.000000,
+	{"N", "Agrave"}:                   -35.000000,
+	{"N", "Amacron"}:                  -35.000000,
+	{"N", "Aogonek"}:                  -35.000000,
+	{"N", "Aring"}:                    -35.000000,
+	{"N", "Atilde"}:                   -35.000000,
+	{"Nacute", "A"}:                   -35.000000,
+	{"Nacute", "Aacute"}:              -35.000000,
+	{"Nacute", "Abreve"}:              -35.000000,
+	{"Nacute", "Acircumflex"}:         -35.000000,
+	{"Nacute", "Adieresis"}:           -35.000000,
+	{"Nacute", "Agrave"}:              -35.000000,
+	{"Nacute", "Amacron"}:             -35.000000,
+	{"Nacute", "Aogonek"}:             -35.000000,
+	{"Nacute", "Aring"}:               -35.000000,
+	{"Nacute", "Atilde"}:              -35.000000,
+	{"Ncaron", "A"}:                   -35.000000,
+	{"Ncaron", "Aacute"}:              -35.000000,
+	{"Ncaron", "Abreve"}:              -35.000000,
+	{"Ncaron", "Acircumflex"}:         -35.000000,
+	{"Ncaron", "Adieresis"}:           -35.000000,
+	{"Ncaron", "Agrave"}:              -35.000000,
+	{"Ncaron", "Amacron"}:             -35.000000,
+	{"Ncaron", "Aogonek"}:             -35.000000,
+	{"Ncaron", "Aring"}:               -35.000000,
+	{"Ncaron", "Atilde"}:              -35.000000,
+	{"Ncommaaccent", "A"}:             -35.000000,
+	{"Ncommaaccent", "Aacute"}:        -35.000000,
+	{"Ncommaaccent", "Abreve"}:        -35.000000,
+	{"Ncommaaccent", "Acircumflex"}:   -35.000000,
+	{"Ncommaaccent", "Adieresis"}:     -35.000000,
+	{"Ncommaaccent", "Agrave"}:        -35.000000,
+	{"Ncommaaccent", "Amacron"}:       -35.000000,
+	{"Ncommaaccent", "Aogonek"}:       -35.000000,
+	{"Ncommaaccent", "Aring"}:         -35.000000,
+	{"Ncommaaccent", "Atilde"}:        -35.000000,
+	{"Ntilde", "A"}:                   -35.000000,
+	{"Ntilde", "Aacute"}:              -35.000000,
+	{"Ntilde", "Abreve"}:              -35.000000,
+	{"Ntilde", "Acircumflex"}:         -35.000000,
+	{"Ntilde", "Adieresis"}:           -35.000000,
+	{"Ntilde", "Agrave"}:              -35.000000,
+	{"Ntilde", "Amacron"}:             -35.000000,
+	{"Ntilde", "Aogonek"}:             -35.000000,
+	{"Ntilde", "Aring"}:               -35.000000,
+	{"Ntilde", "Atilde"}:              -35.000000,
+	{"O", "A"}:                        -35.000000,
+	{"O", "Aacute"}:                   -35.000000,
+	{"O", "Abreve"}:                   -35.000000,
+	{"O", "Acircumflex"}:              -35.000000,
+	{"O", "Adieresis"}:                -35.000000,
+	{"O", "Agrave"}:                   -35.000000,
+	{"O", "Amacron"}:                  -35.000000,
+	{"O", "Aogonek"}:                  -35.000000,
+	{"O", "Aring"}:                    -35.000000,
+	{"O", "Atilde"}:                   -35.000000,
+	{"O", "T"}:                        -40.000000,
+	{"O", "Tcaron"}:                   -40.000000,
+	{"O", "Tcommaaccent"}:             -40.000000,
+	{"O", "V"}:                        -50.000000,
+	{"O", "W"}:                        -35.000000,
+	{"O", "X"}:                        -40.000000,
+	{"O", "Y"}:                        -50.000000,
+	{"O", "Yacute"}:                   -50.000000,
+	{"O", "Ydieresis"}:                -50.000000,
+	{"Oacute", "A"}:                   -35.000000,
+	{"Oacute", "Aacute"}:              -35.000000,
+	{"Oacute", "Abreve"}:              -35.000000,
+	{"Oacute", "Acircumflex"}:         -35.000000,
+	{"Oacute", "Adieresis"}:           -35.000000,
+	{"Oacute", "Agrave"}:              -35.000000,
+	{"Oacute", "Amacron"}:             -35.000000,
+	{"Oacute", "Aogonek"}:             -35.000000,
+	{"Oacute", "Aring"}:               -35.000000,
+	{"Oacute", "Atilde"}:              -35.000000,
+	{"Oacute", "T"}:                   -40.000000,
+	{"Oacute", "Tcaron"}:              -40.000000,
+	{"Oacute", "Tcommaaccent"}:        -40.000000,
+	{"Oacute", "V"}:                   -50.000000,
+	{"Oacute", "W"}:                   -35.000000,
+	{"Oacute", "X"}:                   -40.000000,
+	{"Oacute", "Y"}:                   -50.000000,
+	{"Oacute", "Yacute"}:              -50.000000,
+	{"Oacute", "Ydieresis"}:           -50.000000,
+	{"Ocircumflex", "A"}:              -35.000000,
+	{"Ocircumflex", "Aacute"}:         -35.000000,
+	{"Ocircumflex", "Abreve"}:         -35.000000,
+	{"Ocircumflex", "Acircumflex"}:    -35.000000,
+	{"Ocircumflex", "Adieresis"}:      -35.000000,
+	{"Ocircumflex", "Agrave"}:         -35.000000,
+	{"Ocircumflex", "Amacron"}:        -35.000000,
+	{"Ocircumflex", "Aogonek"}:        -35.000000,
+	{"Ocircumflex", "Aring"}:          -35.000000,
+	{"Ocircumflex", "Atilde"}:         -35.000000,
+	{"Ocircumflex", "T"}:              -40.000000,
+	{"Ocircumflex", "Tcaron"}:         -40.000000,
+	{"Ocircumflex", "Tcommaaccent"}:   -40.000000,
+	{"Ocircumflex", "V"}:              -50.000000,
+	{"Ocircumflex", "W"}:              -35.000000,
+	{"Ocircumflex", "X"}:              -40.000000,
+	{"Ocircumflex", "Y"}:              -50.000000,
+	{"Ocircumflex", "Yacute"}:         -50.000000,
+	{"Ocircumflex", "Ydieresis"}:      -50.000000,
+	{"Odieresis", "A"}:                -35.000000,
+	{"Odieresis", "Aacute"}:           -35.000000,
+	{"Odieresis", "Abreve"}:           -35.000000,
+	{"Odieresis", "Acircumflex"}:      -35.000000,
+	{"Odieresis", "Adieresis"}:        -35.000000,
+	{"Odieresis", "Agrave"}:           -35.000000,
+	{"Odieresis", "Amacron"}:          -35.000000,
+	{"Odieresis", "Aogonek"}:          -35.000000,
+	{"Odieresis", "Aring"}:            -35.000000,
+	{"Odieresis", "Atilde"}:           -35.000000,
+	{"Odieresis", "T"}:                -40.000000,
+	{"Odieresis", "Tcaron"}:           -40.000000,
+	{"Odieresis", "Tcommaaccent"}:     -40.000000,
+	{"Odieresis", "V"}:                -50.000000,
+	{"Odieresis", "W"}:                -35.000000,
+	{"Odieresis", "X"}:                -40.000000,
+	{"Odieresis", "Y"}:                -50.000000,
+	{"Odieresis", "Yacute"}:           -50.000000,
+	{"Odieresis", "Ydieresis"}:        -50.000000,
+	{"Ograve", "A"}:                   -35.000000,
+	{"Ograve", "Aacute"}:              -35.000000,
+	{"Ograve", "Abreve"}:              -35.000000,
+	{"Ograve", "Acircumflex"}:         -35.000000,
+	{"Ograve", "Adieresis"}:           -35.000000,
+	{"Ograve", "Agrave"}:              -35.000000,
+	{"Ograve", "Amacron"}:             -35.000000,
+	{"Ograve", "Aogonek"}:             -35.000000,
+	{"Ograve", "Aring"}:               -35.000000,
+	{"Ograve", "Atilde"}:              -35.000000,
+	{"Ograve", "T"}:                   -40.000000,
+	{"Ograve", "Tcaron"}:              -40.000000,
+	{"Ograve", "Tcommaaccent"}:        -40.000000,
+	{"Ograve", "V"}:                   -50.000000,
+	{"Ograve", "W"}:                   -35.000000,
+	{"Ograve", "X"}:                   -40.000000,
+	{"Ograve", "Y"}:                   -50.000000,
+	{"Ograve", "Yacute"}:              -50.000000,
+	{"Ograve", "Ydieresis"}:           -50.000000,
+	{"Ohungarumlaut", "A"}:            -35.000000,
+	{"Ohungarumlaut", "Aacute"}:       -35.000000,
+	{"Ohungarumlaut", "Abreve"}:       -35.000000,
+	{"Ohungarumlaut", "Acircumflex"}:  -35.000000,
+	{"Ohungarumlaut", "Adieresis"}:    -35.000000,
+	{"Ohungarumlaut", "Agrave"}:       -35.000000,
+	{"Ohungarumlaut", "Amacron"}:      -35.000000,
+	{"Ohungarumlaut", "Aogonek"}:      -35.000000,
+	{"Ohungarumlaut", "Aring"}:        -35.000000,
+	{"Ohungarumlaut", "Atilde"}:       -35.000000,
+	{"Ohungarumlaut", "T"}:            -40.000000,
+	{"Ohungarumlaut", "Tcaron"}:       -40.000000,
+	{"Ohungarumlaut", "Tcommaaccent"}: -40.000000,
+	{"Ohungarumlaut", "V"}:            -50.000000,
+	{"Ohungarumlaut", "W"}:            -35.000000,
+	{"Ohungarumlaut", "X"}:            -40.000000,
+	{"Ohungarumlaut", "Y"}:            -50.000000,
+	{"Ohungarumlaut", "Yacute"}:       -50.000000,
+	{"Ohungarumlaut", "Ydieresis"}:    -50.000000,
+	{"Omacron", "A"}:                  -35.000000,
+	{"Omacron", "Aacute"}:             -35.000000,
+	{"Omacron", "Abreve"}:             -35.000000,
+	{"Omacron", "Acircumflex"}:        -35.000000,
+	{"Omacron", "Adieresis"}:          -35.000000,
+	{"Omacron", "Agrave"}:             -35.000000,
+	{"Omacron", "Amacron"}:            -35.000000,
+	{"Omacron", "Aogonek"}:            -35.000000,
+	{"Omacron", "Aring"}:              -35.000000,
+	{"Omacron", "Atilde"}:             -35.000000,
+	{"Omacron", "T"}:                  -40.000000,
+	{"Omacron", "Tcaron"}:             -40.000000,
+	{"Omacron", "Tcommaaccent"}:       -40.000000,
+	{"Omacron", "V"}:                  -50.000000,
+	{"Omacron", "W"}:                  -35.000000,
+	{"Omacron", "X"}:                  -40.000000,
+	{"Omacron", "Y"}:                  -50.000000,
+	{"Omacron", "Yacute"}:             -50.000000,
+	{"Omacron", "Ydieresis"}:          -50.000000,
+	{"Oslash", "A"}:                   -35.000000,
+	{"Oslash", "Aacute"}:              -35.000000,
+	{"Oslash", "Abreve"}:              -35.000000,
+	{"Oslash", "Acircumflex"}:         -35.000000,
+	{"Oslash", "Adieresis"}:           -35.000000,
+	{"Oslash", "Agrave"}:              -35.000000,
+	{"Oslash", "Amacron"}:             -35.000000,
+	{"Oslash", "Aogonek"}:             -35.000000,
+	{"Oslash", "Aring"}:               -35.000000,
+	{"Oslash", "Atilde"}:              -35.000000,
+	{"Oslash", "T"}:                   -40.000000,
+	{"Oslash", "Tcaron"}:              -40.000000,
+	{"Oslash", "Tcommaaccent"}:        -40.000000,
+	{"Oslash", "V"}:                   -50.000000,
+	{"Oslash", "W"}:                   -35.000000,
+	{"Oslash", "X"}:                   -40.000000,
+	{"Oslash", "Y"}:                   -50.000000,
+	{"Oslash", "Yacute"}:              -50.000000,
+	{"Oslash", "Ydieresis"}:           -50.000000,
+	{"Otilde", "A"}:                   -35.000000,
+	{"Otilde", "Aacute"}:              -35.000000,
+	{"Otilde", "Abreve"}:              -35.000000,
+	{"Otilde", "Acircumflex"}:         -35.000000,
+	{"Otilde", "Adieresis"}:           -35.000000,
+	{"Otilde", "Agrave"}:              -35.000000,
+	{"Otilde", "Amacron"}:             -35.000000,
+	{"Otilde", "Aogonek"}:             -35.000000,
+	{"Otilde", "Aring"}:               -35.000000,
+	{"Otilde", "Atilde"}:              -35.000000,
+	{"Otilde", "T"}:                   -40.000000,
+	{"Otilde", "Tcaron"}:              -40.000000,
+	{"Otilde", "Tcommaaccent"}:        -40.000000,
+	{"Otilde", "V"}:                   -50.000000,
+	{"Otilde", "W"}:                   -35.000000,
+	{"Otilde", "X"}:                   -40.000000,
+	{"Otilde", "Y"}:                   -50.000000,
+	{"Otilde", "Yacute"}:              -50.000000,
+	{"Otilde", "Ydieresis"}:           -50.000000,
+	{"P", "A"}:                        -92.000000,
+	{"P", "Aacute"}:                   -92.000000,
+	{"P", "Abreve"}:                   -92.000000,
+	{"P", "Acircumflex"}:              -92.000000,
+	{"P", "Adieresis"}:                -92.000000,
+	{"P", "Agrave"}:                   -92.000000,
+	{"P", "Amacron"}:                  -92.000000,
+	{"P", "Aogonek"}:                  -92.000000,
+	{"P", "Aring"}:                    -92.000000,
+	{"P", "Atilde"}:                   -92.000000,
+	{"P", "a"}:                        -15.000000,
+	{"P", "aacute"}:                   -15.000000,
+	{"P", "abreve"}:                   -15.000000,
+	{"P", "acircumflex"}:              -15.000000,
+	{"P", "adieresis"}:                -15.000000,
+	{"P", "agrave"}:                   -15.000000,
+	{"P", "amacron"}:                  -15.000000,
+	{"P", "aogonek"}:                  -15.000000,
+	{"P", "aring"}:                    -15.000000,
+	{"P", "atilde"}:                   -15.000000,
+	{"P", "comma"}:                    -111.000000,
+	{"P", "period"}:                   -111.000000,
+	{"Q", "U"}:                        -10.000000,
+	{"Q", "Uacute"}:                   -10.000000,
+	{"Q", "Ucircumflex"}:              -10.000000,
+	{"Q", "Udieresis"}:                -10.000000,
+	{"Q", "Ugrave"}:                   -10.000000,
+	{"Q", "Uhungarumlaut"}:            -10.000000,
+	{"Q", "Umacron"}:                  -10.000000,
+	{"Q", "Uogonek"}:                  -10.000000,
+	{"Q", "Uring"}:                    -10.000000,
+	{"R", "O"}:                        -40.000000,
+	{"R", "Oacute"}:                   -40.000000,
+	{"R", "Ocircumflex"}:              -40.000000,
+	{"R", "Odieresis"}:                -40.000000,
+	{"R", "Ograve"}:                   -40.000000,
+	{"R", "Ohungarumlaut"}:            -40.000000,
+	{"R", "Omacron"}:                  -40.000000,
+	{"R", "Oslash"}:                   -40.000000,
+	{"R", "Otilde"}:                   -40.000000,
+	{"R", "T"}:                        -60.000000,
+	{"R", "Tcaron"}:                   -60.000000,
+	{"R", "Tcommaaccent"}:             -60.000000,
+	{"R", "U"}:                        -40.000000,
+	{"R", "Uacute"}:                   -40.000000,
+	{"R", "Ucircumflex"}:              -40.000000,
+	{"R", "Udieresis"}:                -40.000000,
+	{"R", "Ugrave"}:                   -40.000000,
+	{"R", "Uhungarumlaut"}:            -40.000000,
+	{"R", "Umacron"}:                  -40.000000,
+	{"R", "Uogonek"}:                  -40.000000,
+	{"R", "Uring"}:                    -40.000000,
+	{"R", "V"}:                        -80.000000,
+	{"R", "W"}:                        -55.000000,
+	{"R", "Y"}:                        -65.000000,
+	{"R", "Yacute"}:                   -65.000000,
+	{"R", "Ydieresis"}:                -65.000000,
+	{"Racute", "O"}:                   -40.000000,
+	{"Racute", "Oacute"}:              -40.000000,
+	{"Racute", "Ocircumflex"}:         -40.000000,
+	{"Racute", "Odieresis"}:           -40.000000,
+	{"Racute", "Ograve"}:              -40.000000,
+	{"Racute", "Ohungarumlaut"}:       -40.000000,
+	{"Racute", "Omacron"}:             -40.000000,
+	{"Racute", "Oslash"}:              -40.000000,
+	{"Racute", "Otilde"}:              -40.000000,
+	{"Racute", "T"}:                   -60.000000,
+	{"Racute", "Tcaron"}:              -60.000000,
+	{"Racute", "Tcommaaccent"}:        -60.000000,
+	{"Racute", "U"}:                   -40.000000,
+	{"Racute", "Uacute"}:              -40.000000,
+	{"Racute", "Ucircumflex"}:         -40.000000,
+	{"Racute", "Udieresis"}:           -40.000000,
+	{"Racute", "Ugrave"}:              -40.000000,
+	{"Racute", "Uhungarumlaut"}:       -40.000000,
+	{"Racute", "Umacron"}:             -40.000000,
+	{"Racute", "Uogonek"}:             -40.000000,
+	{"Racute", "Uring"}:               -40.000000,
+	{"Racute", "V"}:                   -80.000000,
+	{"Racute", "W"}:                   -55.000000,
+	{"Racute", "Y"}:                   -65.000000,
+	{"Racute", "Yacute"}:              -65.000000,
+	{"Racute", "Ydieresis"}:           -65.000000,
+	{"Rcaron", "O"}:                   -40.000000,
+	{"Rcaron", "Oacute"}:              -40.000000,
+	{"Rcaron", "Ocircumflex"}:         -40.000000,
+	{"Rcaron", "Odieresis"}:           -40.000000,
+	{"Rcaron", "Ograve"}:              -40.000000,
+	{"Rcaron", "Ohungarumlaut"}:       -40.000000,
+	{"Rcaron", "Omacron"}:             -40.000000,
+	{"Rcaron", "Oslash"}:              -40.000000,
+	{"Rcaron", "Otilde"}:              -40.000000,
+	{"Rcaron", "T"}:                   -60.000000,
+	{"Rcaron", "Tcaron"}:              -60.000000,
+	{"Rcaron", "Tcommaaccent"}:        -60.000000,
+	{"Rcaron", "U"}:                   -40.000000,
+	{"Rcaron", "Uacute"}:              -40.000000,
+	{"Rcaron", "Ucircumflex"}:         -40.000000,
+	{"Rcaron", "Udieresis"}:           -40.000000,
+	{"Rcaron", "Ugrave"}:              -40.000000,
+	{"Rcaron", "Uhungarumlaut"}:       -40.000000,
+	{"Rcaron", "Umacron"}:             -40.000000,
+	{"Rcaron", "Uogonek"}:             -40.000000,
+	{"Rcaron", "Uring"}:               -40.000000,
+	{"Rcaron", "V"}:                   -80.000000,
+	{"Rcaron", "W"}:                   -55.000000,
+	{"Rcaron", "Y"}:                   -65.000000,
+	{"Rcaron", "Yacute"}:              -65.000000,
+	{"Rcaron", "Ydieresis"}:           -65.000000,
+	{"Rcommaaccent", "O"}:             -40.000000,
+	{"Rcommaaccent", "Oacute"}:        -40.000000,
+	{"Rcommaaccent", "Ocircumflex"}:   -40.000000,
+	{"Rcommaaccent", "Odieresis"}:     -40.000000,
+	{"Rcommaaccent", "Ograve"}:        -40.000000,
+	{"Rcommaaccent", "Ohungarumlaut"}: -40.000000,
+	{"Rcommaaccent", "Omacron"}:       -40.000000,
+	{"Rcommaaccent", "Oslash"}:        -40.000000,
+	{"Rcommaaccent", "Otilde"}:        -40.000000,
+	{"Rcommaaccent", "T"}:             -60.000000,
+	{"Rcommaaccent", "Tcaron"}:        -60.000000,
+	{"Rcommaaccent", "Tcommaaccent"}:  -60.000000,
+	{"Rcommaaccent", "U"}:             -40.000000,
+	{"Rcommaaccent", "Uacute"}:        -40.000000,
+	{"Rcommaaccent", "Ucircumflex"}:   -40.000000,
+	{"Rcommaaccent", "Udieresis"}:     -40.000000,
+	{"Rcommaaccent", "Ugrave"}:        -40.000000,
+	{"Rcommaaccent", "Uhungarumlaut"}: -40.000000,
+	{"Rcommaaccent", "Umacron"}:       -40.000000,
+	{"Rcommaaccent", "Uogonek"}:       -40.000000,
+	{"Rcommaaccent", "Uring"}:         -40.000000,
+	{"Rcommaaccent", "V"}:             -80.000000,
+	{"Rcommaaccent", "W"}:             -55.000000,
+	{"Rcommaaccent", "Y"}:             -65.000000,
+	{"Rcommaaccent", "Yacute"}:        -65.000000,
+	{"Rcommaaccent", "Ydieresis"}:     -65.000000,
+	{"T", "A"}:                        -93.000000,
+	{"T", "Aacute"}:                   -93.000000,
+	{"T", "Abreve"}:                   -93.000000,
+	{"T", "Acircumflex"}:              -93.000000,
+	{"T", "Adieresis"}:                -93.000000,
+	{"T", "Agrave"}:                   -93.000000,
+	{"T", "Amacron"}:                  -93.000000,
+	{"T", "Aogonek"}:                  -93.000000,
+	{"T", "Aring"}:                    -93.000000,
+	{"T", "Atilde"}:                   -93.000000,
+	{"T", "O"}:                        -18.000000,
+	{"T", "Oacute"}:                   -18.000000,
+	{"T", "Ocircumflex"}:              -18.000000,
+	{"T", "Odieresis"}:                -18.000000,
+	{"T", "Ograve"}:                   -18.000000,
+	{"T", "Ohungarumlaut"}:            -18.000000,
+	{"T", "Omacron"}:                  -18.000000,
+	{"T", "Oslash"}:                   -18.000000,
+	{"T", "Otilde"}:                   -18.000000,
+	{"T", "a"}:                        -80.000000,
+	{"T", "aacute"}:                   -80.000000,
+	{"T", "abreve"}:                   -80.000000,
+	{"T", "acircumflex"}:              -80.000000,
+	{"T", "adieresis"}:                -40.000000,
+	{"T", "agrave"}:                   -40.000000,
+	{"T", "amacron"}:                  -40.000000,
+	{"T", "aogonek"}:                  -80.000000,
+	{"T", "aring"}:                    -80.000000,
+	{"T", "atilde"}:                   -40.000000,
+	{"T", "colon"}:                    -50.000000,
+	{"T", "comma"}:                    -74.000000,
+	{"T", "e"}:                        -70.000000,
+	{"T", "eacute"}:                   -70.000000,
+	{"T", "ecaron"}:                   -70.000000,
+	{"T", "ecircumflex"}:              -70.000000,
+	{"T", "edieresis"}:                -30.000000,
+	{"T", "edotaccent"}:               -70.000000,
+	{"T", "egrave"}:                   -70.000000,
+	{"T", "emacron"}:                  -30.000000,
+	{"T", "eogonek"}:                  -70.000000,
+	{"T", "hyphen"}:                   -92.000000,
+	{"T", "i"}:                        -35.000000,
+	{"T", "iacute"}:                   -35.000000,
+	{"T", "iogonek"}:                  -35.000000,
+	{"T", "o"}:                        -80.000000,
+	{"T", "oacute"}:                   -80.000000,
+	{"T", "ocircumflex"}:              -80.000000,
+	{"T", "odieresis"}:                -80.000000,
+	{"T", "ograve"}:                   -80.000000,
+	{"T", "ohungarumlaut"}:            -80.000000,
+	{"T", "omacron"}:                  -80.000000,
+	{"T", "oslash"}:                   -80.000000,
+	{"T", "otilde"}:                   -80.000000,
+	{"T", "period"}:                   -74.000000,
+	{"T", "r"}:                        -35.000000,
+	{"T", "racute"}:                   -35.000000,
+	{"T", "rcaron"}:                   -35.000000,
+	{"T", "rcommaaccent"}:             -35.000000,
+	{"T", "semicolon"}:                -55.000000,
+	{"T", "u"}:                        -45.000000,
+	{"T", "uacute"}:                   -45.000000,
+	{"T", "ucircumflex"}:              -45.000000,
+	{"T", "udieresis"}:                -45.000000,
+	{"T", "ugrave"}:                   -45.000000,
+	{"T", "uhungarumlaut"}:            -45.000000,
+	{"T", "umacron"}:                  -45.000000,
+	{"T", "uogonek"}:                  -45.000000,
+	{"T", "uring"}:                    -45.000000,
+	{"T", "w"}:                        -80.000000,
+	{"T", "y"}:                        -80.000000,
+	{"T", "yacute"}:                   -80.000000,
+	{"T", "ydieresis"}:                -80.000000,
+	{"Tcaron", "A"}:                   -93.000000,
+	{"Tcaron", "Aacute"}:              -93.000000,
+	{"Tcaron", "Abreve"}:              -93.000000,
+	{"Tcaron", "Acircumflex"}:         -93.000000,
+	{"Tcaron", "Adieresis"}:           -93.000000,
+	{"Tcaron", "Agrave"}:              -93.000000,
+	{"Tcaron", "Amacron"}:             -93.000000,
+	{"Tcaron", "Aogonek"}:             -93.000000,
+	{"Tcaron", "Aring"}:               -93.000000,
+	{"Tcaron", "Atilde"}:              -93.000000,
+	{"Tcaron", "O"}:                   -18.000000,
+	{"Tcaron", "Oacute"}:              -18.000000,
+	{"Tcaron", "Ocircumflex"}:         -18.000000,
+	{"Tcaron", "Odieresis"}:           -18.000000,
+	{"Tcaron", "Ograve"}:              -18.000000,
+	{"Tcaron", "Ohungarumlaut"}:       -18.000000,
+	{"Tcaron", "Omacron"}:             -18.000000,
+	{"Tcaron", "Oslash"}:              -18.000000,
+	{"Tcaron", "Otilde"}:              -18.000000,
+	{"Tcaron", "a"}:                   -80.000000,
+	{"Tcaron", "aacute"}:              -80.000000,
+	{"Tcaron", "abreve"}:              -80.000000,
+	{"Tcaron", "acircumflex"}:         -80.000000,
+	{"Tcaron", "adieresis"}:           -40.000000,
+	{"Tcaron", "agrave"}:              -40.000000,
+	{"Tcaron", "amacron"}:             -40.000000,
+	{"Tcaron", "aogonek"}:             -80.000000,
+	{"Tcaron", "aring"}:               -80.000000,
+	{"Tcaron", "atilde"}:              -40.000000,
+	{"Tcaron", "colon"}:               -50.000000,
+	{"Tcaron", "comma"}:               -74.000000,
+	{"Tcaron", "e"}:                   -70.000000,
+	{"Tcaron", "eacute"}:              -70.000000,
+	{"Tcaron", "ecaron"}:              -70.000000,
+	{"Tcaron", "ecircumflex"}:         -30.000000,
+	{"Tcaron", "edieresis"}:           -30.000000,
+	{"Tcaron", "edotaccent"}:          -70.000000,
+	{"Tcaron", "egrave"}:              -70.000000,
+	{"Tcaron", "emacron"}:             -30.000000,
+	{"Tcaron", "eogonek"}:             -70.000000,
+	{"Tcaron", "hyphen"}:              -92.000000,
+	{"Tcaron", "i"}:                   -35.000000,
+	{"Tcaron", "iacute"}:              -35.000000,
+	{"Tcaron", "iogonek"}:             -35.000000,
+	{"Tcaron", "o"}:                   -80.000000,
+	{"Tcaron", "oacute"}:              -80.000000,
+	{"Tcaron", "ocircumflex"}:         -80.000000,
+	{"Tcaron", "odieresis"}:           -80.000000,
+	{"Tcaron", "ograve"}:              -80.000000,
+	{"Tcaron", "ohungarumlaut"}:       -80.000000,
+	{"Tcaron", "omacron"}:             -80.000000,
+	{"Tcaron", "oslash"}:              -80.000000,
+	{"Tcaron", "otilde"}:              -80.000000,
+	{"Tcaron", "period"}:              -74.000000,
+	{"Tcaron", "r"}:                   -35.000000,
+	{"Tcaron", "racute"}:              -35.000000,
+	{"Tcaron", "rcaron"}:              -35.000000,
+	{"Tcaron", "rcommaaccent"}:        -35.000000,
+	{"Tcaron", "semicolon"}:           -55.000000,
+	{"Tcaron", "u"}:                   -45.000000,
+	{"Tcaron", "uacute"}:              -45.000000,
+	{"Tcaron", "ucircumflex"}:         -45.000000,
+	{"Tcaron", "udieresis"}:           -45.000000,
+	{"Tcaron", "ugrave"}:              -45.000000,
+	{"Tcaron", "uhungarumlaut"}:       -45.000000,
+	{"Tcaron", "umacron"}:             -45.000000,
+	{"Tcaron", "uogonek"}:             -45.000000,
+	{"Tcaron", "uring"}:               -45.000000,
+	{"Tcaron", "w"}:                   -80.000000,
+	{"Tcaron", "y"}:                   -80.000000,
+	{"Tcaron", "yacute"}:              -80.000000,
+	{"Tcaron", "ydieresis"}:           -80.000000,
+	{"Tcommaaccent", "A"}:             -93.000000,
+	{"Tcommaaccent", "Aacute"}:        -93.000000,
+	{"Tcommaaccent", "Abreve"}:        -93.000000,
+	{"Tcommaaccent", "Acircumflex"}:   -93.000000,
+	{"Tcommaaccent", "Adieresis"}:     -93.000000,
+	{"Tcommaaccent", "Agrave"}:        -93.000000,
+	{"Tcommaaccent", "Amacron"}:       -93.000000,
+	{"Tcommaaccent", "Aogonek"}:       -93.000000,
+	{"Tcommaaccent", "Aring"}:         -93.000000,
+	{"Tcommaaccent", "Atilde"}:        -93.000000,
+	{"Tcommaaccent", "O"}:             -18.000000,
+	{"Tcommaaccent", "Oacute"}:        -18.000000,
+	{"Tcommaaccent", "Ocircumflex"}:   -18.000000,
+	{"Tcommaaccent", "Odieresis"}:     -18.000000,
+	{"Tcommaaccent", "Ograve"}:        -18.000000,
+	{"Tcommaaccent", "Ohungarumlaut"}: -18.000000,
+	{"Tcommaaccent", "Omacron"}:       -18.000000,
+	{"Tcommaaccent", "Oslash"}:        -18.000000,
+	{"Tcommaaccent", "Otilde"}:        -18.000000,
+	{"Tcommaaccent", "a"}:             -80.000000,
+	{"Tcommaaccent", "aacute"}:        -80.000000,
+	{"Tcommaaccent", "abreve"}:        -80.000000,
+	{"Tcommaaccent", "acircumflex"}:   -80.000000,
+	{"Tcommaaccent", "adieresis"}:     -40.000000,
+	{"Tcommaaccent", "agrave"}:        -40.000000,
+	{"Tcommaaccent", "amacron"}:       -40.000000,
+	{"Tcommaaccent", "aogonek"}:       -80.000000,
+	{"Tcommaaccent", "aring"}:         -80.000000,
+	{"Tcommaaccent", "atilde"}:        -40.000000,
+	{"Tcommaaccent", "colon"}:         -50.000000,
+	{"Tcommaaccent", "comma"}:         -74.000000,
+	{"Tcommaaccent", "e"}:             -70.000000,
+	{"Tcommaaccent", "eacute"}:        -70.000000,
+	{"Tcommaaccent", "ecaron"}:        -70.000000,
+	{"Tcommaaccent", "ecircumflex"}:   -30.000000,
+	{"Tcommaaccent", "edieresis"}:     -30.000000,
+	{"Tcommaaccent", "edotaccent"}:    -70.000000,
+	{"Tcommaaccent", "egrave"}:        -30.000000,
+	{"Tcommaaccent", "emacron"}:       -70.000000,
+	{"Tcommaaccent", "eogonek"}:       -70.000000,
+	{"Tcommaaccent", "hyphen"}:        -92.000000,
+	{"Tcommaaccent", "i"}:             -35.000000,
+	{"Tcommaaccent", "iacute"}:        -35.000000,
+	{"Tcommaaccent", "iogonek"}:       -35.000000,
+	{"Tcommaaccent", "o"}:             -80.000000,
+	{"Tcommaaccent", "oacute"}:        -80.000000,
+	{"Tcommaaccent", "ocircumflex"}:   -80.000000,
+	{"Tcommaaccent", "odieresis"}:     -80.000000,
+	{"Tcommaaccent", "ograve"}:        -80.000000,
+	{"Tcommaaccent", "ohungarumlaut"}: -80.000000,
+	{"Tcommaaccent", "omacron"}:       -80.000000,
+	{"Tcommaaccent", "oslash"}:        -80.000000,
+	{"Tcommaaccent", "otilde"}:        -80.000000,
+	{"Tcommaaccent", "period"}:        -74.000000,
+	{"Tcommaaccent", "r"}:             -35.000000,
+	{"Tcommaaccent", "racute"}:        -35.000000,
+	{"Tcommaaccent", "rcaron"}:        -35.000000,
+	{"Tcommaaccent", "rcommaaccent"}:  -35.000000,
+	{"Tcommaaccent", "semicolon"}:     -55.000000,
+	{"Tcommaaccent", "u"}:             -45.000000,
+	{"Tcommaaccent", "uacute"}:        -45.000000,
+	{"Tcommaaccent", "ucircumflex"}:   -45.000000,
+	{"Tcommaaccent", "udieresis"}:     -45.000000,
+	{"Tcommaaccent", "ugrave"}:        -45.000000,
+	{"Tcommaaccent", "uhungarumlaut"}: -45.000000,
+	{"Tcommaaccent", "umacron"}:       -45.000000,
+	{"Tcommaaccent", "uogonek"}:       -45.000000,
+	{"Tcommaaccent", "uring"}:         -45.000000,
+	{"Tcommaaccent", "w"}:             -80.000000,
+	{"Tcommaaccent", "y"}:             -80.000000,
+	{"Tcommaaccent", "yacute"}:        -80.000000,
+	{"Tcommaaccent", "ydieresis"}:     -80.000000,
+	{"U", "A"}:                        -40.000000,
+	{"U", "Aacute"}:                   -40.000000,
+	{"U", "Abreve"}:                   -40.000000,
+	{"U", "Acircumflex"}:              -40.000000,
+	{"U", "Adieresis"}:                -40.000000,
+	{"U", "Agrave"}:                   -40.000000,
+	{"U", "Amacron"}:                  -40.000000,
+	{"U", "Aogonek"}:                  -40.000000,
+	{"U", "Aring"}:                    -40.000000,
+	{"U", "Atilde"}:                   -40.000000,
+	{"Uacute", "A"}:                   -40.000000,
+	{"Uacute", "Aacute"}:              -40.000000,
+	{"Uacute", "Abreve"}:              -40.000000,
+	{"Uacute", "Acircumflex"}:         -40.000000,
+	{"Uacute", "Adieresis"}:           -40.000000,
+	{"Uacute", "Agrave"}:              -40.000000,
+	{"Uacute", "Amacron"}:             -40.000000,
+	{"Uacute", "Aogonek"}:             -40.000000,
+	{"Uacute", "Aring"}:               -40.000000,
+	{"Uacute", "Atilde"}:              -40.000000,
+	{"Ucircumflex", "A"}:              -40.000000,
+	{"Ucircumflex", "Aacute"}:         -40.000000,
+	{"Ucircumflex", "Abreve"}:         -40.000000,
+	{"Ucircumflex", "Acircumflex"}:    -40.000000,
+	{"Ucircumflex", "Adieresis"}:      -40.000000,
+	{"Ucircumflex", "Agrave"}:         -40.000000,
+	{"Ucircumflex", "Amacron"}:        -40.000000,
+	{"Ucircumflex", "Aogonek"}:        -40.000000,
+	{"Ucircumflex", "Aring"}:          -40.000000,
+	{"Ucircumflex", "Atilde"}:         -40.000000,
+	{"Udieresis", "A"}:                -40.000000,
+	{"Udieresis", "Aacute"}:           -40.000000,
+	{"Udieresis", "Abreve"}:           -40.000000,
+	{"Udieresis", "Acircumflex"}:      -40.000000,
+	{"Udieresis", "Adieresis"}:        -40.000000,
+	{"Udieresis", "Agrave"}:           -40.000000,
+	{"Udieresis", "Amacron"}:          -40.000000,
+	{"Udieresis", "Aogonek"}:          -40.000000,
+	{"Udieresis", "Aring"}:            -40.000000,
+	{"Udieresis", "Atilde"}:           -40.000000,
+	{"Ugrave", "A"}:                   -40.000000,
+	{"Ugrave", "Aacute"}:              -40.000000,
+	{"Ugrave", "Abreve"}:              -40.000000,
+	{"Ugrave", "Acircumflex"}:         -40.000000,
+	{"Ugrave", "Adieresis"}:           -40.000000,
+	{"Ugrave", "Agrave"}:              -40.000000,
+	{"Ugrave", "Amacron"}:             -40.000000,
+	{"Ugrave", "Aogonek"}:             -40.000000,
+	{"Ugrave", "Aring"}:               -40.000000,
+	{"Ugrave", "Atilde"}:              -40.000000,
+	{"Uhungarumlaut", "A"}:            -40.000000,
+	{"Uhungarumlaut", "Aacute"}:       -40.000000,
+	{"Uhungarumlaut", "Abreve"}:       -40.000000,
+	{"Uhungarumlaut", "Acircumflex"}:  -40.000000,
+	{"Uhungarumlaut", "Adieresis"}:    -40.000000,
+	{"Uhungarumlaut", "Agrave"}:       -40.000000,
+	{"Uhungarumlaut", "Amacron"}:      -40.000000,
+	{"Uhungarumlaut", "Aogonek"}:      -40.000000,
+	{"Uhungarumlaut", "Aring"}:        -40.000000,
+	{"Uhungarumlaut", "Atilde"}:       -40.000000,
+	{"Umacron", "A"}:                  -40.000000,
+	{"Umacron", "Aacute"}:             -40.000000,
+	{"Umacron", "Abreve"}:             -40.000000,
+	{"Umacron", "Acircumflex"}:        -40.000000,
+	{"Umacron", "Adieresis"}:          -40.000000,
+	{"Umacron", "Agrave"}:             -40.000000,
+	{"Umacron", "Amacron"}:            -40.000000,
+	{"Umacron", "Aogonek"}:            -40.000000,
+	{"Umacron", "Aring"}:              -40.000000,
+	{"Umacron", "Atilde"}:             -40.000000,
+	{"Uogonek", "A"}:                  -40.000000,
+	{"Uogonek", "Aacute"}:             -40.000000,
+	{"Uogonek", "Abreve"}:             -40.000000,
+	{"Uogonek", "Acircumflex"}:        -40.000000,
+	{"Uogonek", "Adieresis"}:          -40.000000,
+	{"Uogonek", "Agrave"}:             -40.000000,
+	{"Uogonek", "Amacron"}:            -40.000000,
+	{"Uogonek", "Aogonek"}:            -40.000000,
+	{"Uogonek", "Aring"}:              -40.000000,
+	{"Uogonek", "Atilde"}:             -40.000000,
+	{"Uring", "A"}:                    -40.000000,
+	{"Uring", "Aacute"}:               -40.000000,
+	{"Uring", "Abreve"}:               -40.000000,
+	{"Uring", "Acircumflex"}:          -40.000000,
+	{"Uring", "Adieresis"}:            -40.000000,
+	{"Uring", "Agrave"}:               -40.000000,
+	{"Uring", "Amacron"}:              -40.000000,
+	{"Uring", "Aogonek"}:              -40.000000,
+	{"Uring", "Aring"}:                -40.000000,
+	{"Uring", "Atilde"}:               -40.000000,
+	{"V", "A"}:                        -135.000000,
+	{"V", "Aacute"}:                   -135.000000,
+	{"V", "Abreve"}:                   -135.000000,
+	{"V", "Acircumflex"}:              -135.000000,
+	{"V", "Adieresis"}:                -135.000000,
+	{"V", "Agrave"}:                   -135.000000,
+	{"V", "Amacron"}:                  -135.000000,
+	{"V", "Aogonek"}:                  -135.000000,
+	{"V", "Aring"}:                    -135.000000,
+	{"V", "Atilde"}:                   -135.000000,
+	{"V", "G"}:                        -15.000000,
+	{"V", "Gbreve"}:                   -15.000000,
+	{"V", "Gcommaaccent"}:             -15.000000,
+	{"V", "O"}:                        -40.000000,
+	{"V", "Oacute"}:                   -40.000000,
+	{"V", "Ocircumflex"}:              -40.000000,
+	{"V", "Odieresis"}:                -40.000000,
+	{"V", "Ograve"}:                   -40.000000,
+	{"V", "Ohungarumlaut"}:            -40.000000,
+	{"V", "Omacron"}:                  -40.000000,
+	{"V", "Oslash"}:                   -40.000000,
+	{"V", "Otilde"}:                   -40.000000,
+	{"V", "a"}:                        -111.000000,
+	{"V", "aacute"}:                   -111.000000,
+	{"V", "abreve"}:                   -111.000000,
+	{"V", "acircumflex"}:              -71.000000,
+	{"V", "adieresis"}:                -71.000000,
+	{"V", "agrave"}:                   -71.000000,
+	{"V", "amacron"}:                  -71.000000,
+	{"V", "aogonek"}:                  -111.000000,
+	{"V", "aring"}:                    -111.000000,
+	{"V", "atilde"}:                   -71.000000,
+	{"V", "colon"}:                    -74.000000,
+	{"V", "comma"}:                    -129.000000,
+	{"V", "e"}:                        -111.000000,
+	{"V", "eacute"}:                   -111.000000,
+	{"V", "ecaron"}:                   -71.000000,
+	{"V", "ecircumflex"}:              -71.000000,
+	{"V", "edieresis"}:                -71.000000,
+	{"V", "edotaccent"}:               -111.000000,
+	{"V", "egrave"}:                   -71.000000,
+	{"V", "emacron"}:                  -71.000000,
+	{"V", "eogonek"}:                  -111.000000,
+	{"V", "hyphen"}:                   -100.000000,
+	{"V", "i"}:                        -60.000000,
+	{"V", "iacute"}:                   -60.000000,
+	{"V", "icircumflex"}:              -20.000000,
+	{"V", "idieresis"}:                -20.000000,
+	{"V", "igrave"}:                   -20.000000,
+	{"V", "imacron"}:                  -20.000000,
+	{"V", "iogonek"}:                  -60.000000,
+	{"V", "o"}:                        -129.000000,
+	{"V", "oacute"}:                   -129.000000,
+	{"V", "ocircumflex"}:              -129.000000,
+	{"V", "odieresis"}:                -89.000000,
+	{"V", "ograve"}:                   -89.000000,
+	{"V", "ohungarumlaut"}:            -129.000000,
+	{"V", "omacron"}:                  -89.000000,
+	{"V", "oslash"}:                   -129.000000,
+	{"V", "otilde"}:                   -89.000000,
+	{"V", "period"}:                   -129.000000,
+	{"V", "semicolon"}:                -74.000000,
+	{"V", "u"}:                        -75.000000,
+	{"V", "uacute"}:                   -75.000000,
+	{"V", "ucircumflex"}:              -75.000000,
+	{"V", "udieresis"}:                -75.000000,
+	{"V", "ugrave"}:                   -75.000000,
+	{"V", "uhungarumlaut"}:            -75.000000,
+	{"V", "umacron"}:                  -75.000000,
+	{"V", "uogonek"}:                  -75.000000,
+	{"V", "uring"}:                    -75.000000,
+	{"W", "A"}:                        -120.000000,
+	{"W", "Aacute"}:                   -120.000000,
+	{"W", "Abreve"}:                   -120.000000,
+	{"W", "Acircumflex"}:              -120.000000,
+	{"W", "Adieresis"}:                -120.000000,
+	{"W", "Agrave"}:                   -120.000000,
+	{"W", "Amacron"}:                  -120.000000,
+	{"W", "Aogonek"}:                  -120.000000,
+	{"W", "Aring"}:                    -120.000000,
+	{"W", "Atilde"}:                   -120.000000,
+	{"W", "O"}:                        -10.000000,
+	{"W", "Oacute"}:                   -10.000000,
+	{"W", "Ocircumflex"}:              -10.000000,
+	{"W", "Odieresis"}:                -10.000000,
+	{"W", "Ograve"}:                   -10.000000,
+	{"W", "Ohungarumlaut"}:            -10.000000,
+	{"W", "Omacron"}:                  -10.000000,
+	{"W", "Oslash"}:                   -10.000000,
+	{"W", "Otilde"}:                   -10.000000,
+	{"W", "a"}:                        -80.000000,
+	{"W", "aacute"}:                   -80.000000,
+	{"W", "abreve"}:                   -80.000000,
+	{"W", "acircumflex"}:              -80.000000,
+	{"W", "adieresis"}:                -80.000000,
+	{"W", "agrave"}:                   -80.000000,
+	{"W", "amacron"}:                  -80.000000,
+	{"W", "aogonek"}:                  -80.000000,
+	{"W", "aring"}:                    -80.000000,
+	{"W", "atilde"}:                   -80.000000,
+	{"W", "colon"}:                    -37.000000,
+	{"W", "comma"}:                    -92.000000,
+	{"W", "e"}:                        -80.000000,
+	{"W", "eacute"}:                   -80.000000,
+	{"W", "ecaron"}:                   -80.000000,
+	{"W", "ecircumflex"}:              -80.000000,
+	{"W", "edieresis"}:                -40.000000,
+	{"W", "edotaccent"}:               -80.000000,
+	{"W", "egrave"}:                   -40.000000,
+	{"W", "emacron"}:                  -40.000000,
+	{"W", "eogonek"}:                  -80.000000,
+	{"W", "hyphen"}:                   -65.000000,
+	{"W", "i"}:                        -40.000000,
+	{"W", "iacute"}:                   -40.000000,
+	{"W", "iogonek"}:                  -40.000000,
+	{"W", "o"}:                        -80.000000,
+	{"W", "oacute"}:                   -80.000000,
+	{"W", "ocircumflex"}:              -80.000000,
+	{"W", "odieresis"}:                -80.000000,
+	{"W", "ograve"}:                   -80.000000,
+	{"W", "ohungarumlaut"}:            -80.000000,
+	{"W", "omacron"}:                  -80.000000,
+	{"W", "oslash"}:                   -80.000000,
+	{"W", "otilde"}:                   -80.000000,
+	{"W", "period"}:                   -92.000000,
+	{"W", "semicolon"}:                -37.000000,
+	{"W", "u"}:                        -50.000000,
+	{"W", "uacute"}:                   -50.000000,
+	{"W", "ucircumflex"}:              -50.000000,
+	{"W", "udieresis"}:                -50.000000,
+	{"W", "ugrave"}:                   -50.000000,
+	{"W", "uhungarumlaut"}:            -50.000000,
+	{"W", "umacron"}:                  -50.000000,
+	{"W", "uogonek"}:                  -50.000000,
+	{"W", "uring"}:                    -50.000000,
+	{"W", "y"}:                        -73.000000,
+	{"W", "yacute"}:                   -73.000000,
+	{"W", "ydieresis"}:                -73.000000,
+	{"Y", "A"}:                        -120.000000,
+	{"Y", "Aacute"}:                   -120.000000,
+	{"Y", "Abreve"}:                   -120.000000,
+	{"Y", "Acircumflex"}:              -120.000000,
+	{"Y", "Adieresis"}:                -120.000000,
+	{"Y", "Agrave"}:                   -120.000000,
+	{"Y", "Amacron"}:                  -120.000000,
+	{"Y", "Aogonek"}:                  -120.000000,
+	{"Y", "Aring"}:                    -120.000000,
+	{"Y", "Atilde"}:                   -120.000000,
+	{"Y", "O"}:                        -30.000000,
+	{"Y", "Oacute"}:                   -30.000000,
+	{"Y", "Ocircumflex"}:              -30.000000,
+	{"Y", "Odieresis"}:                -30.000000,
+	{"Y", "Ograve"}:                   -30.000000,
+	{"Y", "Ohungarumlaut"}:            -30.000000,
+	{"Y", "Omacron"}:                  -30.000000,
+	{"Y", "Oslash"}:                   -30.000000,
+	{"Y", "Otilde"}:                   -30.000000,
+	{"Y", "a"}:                        -100.000000,
+	{"Y", "aacute"}:                   -100.000000,
+	{"Y", "abreve"}:                   -100.000000,
+	{"Y", "acircumflex"}:              -100.000000,
+	{"Y", "adieresis"}:                -60.000000,
+	{"Y", "agrave"}:                   -60.000000,
+	{"Y", "amacron"}:                  -60.000000,
+	{"Y", "aogonek"}:                  -100.000000,
+	{"Y", "aring"}:                    -100.000000,
+	{"Y", "atilde"}:                   -60.000000,
+	{"Y", "colon"}:                    -92.000000,
+	{"Y", "comma"}:                    -129.000000,
+	{"Y", "e"}:                        -100.000000,
+	{"Y", "eacute"}:                   -100.000000,
+	{"Y", "ecaron"}:                   -100.000000,
+	{"Y", "ecircumflex"}:              -100.000000,
+	{"Y", "edieresis"}:                -60.000000,
+	{"Y", "edotaccent"}:               -100.000000,
+	{"Y", "egrave"}:                   -60.000000,
+	{"Y", "emacron"}:                  -60.000000,
+	{"Y", "eogonek"}:                  -100.000000,
+	{"Y", "hyphen"}:                   -111.000000,
+	{"Y", "i"}:                        -55.000000,
+	{"Y", "iacute"}:                   -55.000000,
+	{"Y", "iogonek"}:                  -55.000000,
+	{"Y", "o"}:                        -110.000000,
+	{"Y", "oacute"}:                   -110.000000,
+	{"Y", "ocircumflex"}:              -110.000000,
+	{"Y", "odieresis"}:                -70.000000,
+	{"Y", "ograve"}:                   -70.000000,
+	{"Y", "ohungarumlaut"}:            -110.000000,
+	{"Y", "omacron"}:                  -70.000000,
+	{"Y", "oslash"}:                   -110.000000,
+	{"Y", "otilde"}:                   -70.000000,
+	{"Y", "period"}:                   -129.000000,
+	{"Y", "semicolon"}:                -92.000000,
+	{"Y", "u"}:                        -111.000000,
+	{"Y", "uacute"}:                   -111.000000,
+	{"Y", "ucircumflex"}:              -111.000000,
+	{"Y", "udieresis"}:                -71.000000,
+	{"Y", "ugrave"}:                   -71.000000,
+	{"Y", "uhungarumlaut"}:            -111.000000,
+	{"Y", "umacron"}:                  -71.000000,
+	{"Y", "uogonek"}:                  -111.000000,
+	{"Y", "uring"}:                    -111.000000,
+	{"Yacute", "A"}:                   -120.000000,
+	{"Yacute", "Aacute"}:              -120.000000,
+	{"Yacute", "Abreve"}:              -120.000000,
+	{"Yacute", "Acircumflex"}:         -120.000000,
+	{"Yacute", "Adieresis"}:           -120.000000,
+	{"Yacute", "Agrave"}:              -120.000000,
+	{"Yacute", "Amacron"}:             -120.000000,
+	{"Yacute", "Aogonek"}:             -120.000000,
+	{"Yacute", "Aring"}:               -120.000000,
+	{"Yacute", "Atilde"}:              -120.000000,
+	{"Yacute", "O"}:                   -30.000000,
+	{"Yacute", "Oacute"}:              -30.000000,
+	{"Yacute", "Ocircumflex"}:         -30.000000,
+	{"Yacute", "Odieresis"}:           -30.000000,
+	{"Yacute", "Ograve"}:              -30.000000,
+	{"Yacute", "Ohungarumlaut"}:       -30.000000,
+	{"Yacute", "Omacron"}:             -30.000000,
+	{"Yacute", "Oslash"}:              -30.000000,
+	{"Yacute", "Otilde"}:              -30.000000,
+	{"Yacute", "a"}:                   -100.000000,
+	{"Yacute", "aacute"}:              -100.000000,
+	{"Yacute", "abreve"}:              -100.000000,
+	{"Yacute", "acircumflex"}:         -100.000000,
+	{"Yacute", "adieresis"}:           -60.000000,
+	{"Yacute", "agrave"}:              -60.000000,
+	{"Yacute", "amacron"}:             -60.000000,
+	{"Yacute", "aogonek"}:             -100.000000,
+	{"Yacute", "aring"}:               -100.000000,
+	{"Yacute", "atilde"}:              -60.000000,
+	{"Yacute", "colon"}:               -92.000000,
+	{"Yacute", "comma"}:               -129.000000,
+	{"Yacute", "e"}:                   -100.000000,
+	{"Yacute", "eacute"}:              -100.000000,
+	{"Yacute", "ecaron"}:              -100.000000,
+	{"Yacute", "ecircumflex"}:         -100.000000,
+	{"Yacute", "edieresis"}:           -60.000000,
+	{"Yacute", "edotaccent"}:          -100.000000,
+	{"Yacute", "egrave"}:              -60.000000,
+	{"Yacute", "emacron"}:             -60.000000,
+	{"Yacute", "eogonek"}:             -100.000000,
+	{"Yacute", "hyphen"}:              -111.000000,
+	{"Yacute", "i"}:                   -55.000000,
+	{"Yacute", "iacute"}:              -55.000000,
+	{"Yacute", "iogonek"}:             -55.000000,
+	{"Yacute", "o"}:                   -110.000000,
+	{"Yacute", "oacute"}:              -110.000000,
+	{"Yacute", "ocircumflex"}:         -110.000000,
+	{"Yacute", "odieresis"}:           -70.000000,
+	{"Yacute", "ograve"}:              -70.000000,
+	{"Yacute", "ohungarumlaut"}:       -110.000000,
+	{"Yacute", "omacron"}:             -70.000000,
+	{"Yacute", "oslash"}:              -110.000000,
+	{"Yacute", "otilde"}:              -70.000000,
+	{"Yacute", "period"}:              -129.000000,
+	{"Yacute", "semicolon"}:           -92.000000,
+	{"Yacute", "u"}:                   -111.000000,
+	{"Yacute", "uacute"}:              -111.000000,
+	{"Yacute", "ucircumflex"}:         -111.000000,
+	{"Yacute", "udieresis"}:           -71.000000,
+	{"Yacute", "ugrave"}:              -71.000000,
+	{"Yacute", "uhungarumlaut"}:       -111.000000,
+	{"Yacute", "umacron"}:             -71.000000,
+	{"Yacute", "uogonek"}:             -111.000000,
+	{"Yacute", "uring"}:               -111.000000,
+	{"Ydieresis", "A"}:                -120.000000,
+	{"Ydieresis", "Aacute"}:           -120.000000,
+	{"Ydieresis", "Abreve"}:           -120.000000,
+	{"Ydieresis", "Acircumflex"}:      -120.000000,
+	{"Ydieresis", "Adieresis"}:        -120.000000,
+	{"Ydieresis", "Agrave"}:           -120.000000,
+	{"Ydieresis", "Amacron"}:          -120.000000,
+	{"Ydieresis", "Aogonek"}:          -120.000000,
+	{"Ydieresis", "Aring"}:            -120.000000,
+	{"Ydieresis", "Atilde"}:           -120.000000,
+	{"Ydieresis", "O"}:                -30.000000,
+	{"Ydieresis", "Oacute"}:           -30.000000,
+	{"Ydieresis", "Ocircumflex"}:      -30.000000,
+	{"Ydieresis", "Odieresis"}:        -30.000000,
+	{"Ydieresis", "Ograve"}:           -30.000000,
+	{"Ydieresis", "Ohungarumlaut"}:    -30.000000,
+	{"Ydieresis", "Omacron"}:          -30.000000,
+	{"Ydieresis", "Oslash"}:           -30.000000,
+	{"Ydieresis", "Otilde"}:           -30.000000,
+	{"Ydieresis", "a"}:                -100.000000,
+	{"Ydieresis", "aacute"}:           -100.000000,
+	{"Ydieresis", "abreve"}:           -100.000000,
+	{"Ydieresis", "acircumflex"}:      -100.000000,
+	{"Ydieresis", "adieresis"}:        -60.000000,
+	{"Ydieresis", "agrave"}:           -60.000000,
+	{"Ydieresis", "amacron"}:          -60.000000,
+	{"Ydieresis", "aogonek"}:          -100.000000,
+	{"Ydieresis", "aring"}:            -100.000000,
+	{"Ydieresis", "atilde"}:           -100.000000,
+	{"Ydieresis", "colon"}:            -92.000000,
+	{"Ydieresis", "comma"}:            -129.000000,
+	{"Ydieresis", "e"}:                -100.000000,
+	{"Ydieresis", "eacute"}:           -100.000000,
+	{"Ydieresis", "ecaron"}:           -100.000000,
+	{"Ydieresis", "ecircumflex"}:      -100.000000,
+	{"Ydieresis", "edieresis"}:        -60.000000,
+	{"Ydieresis", "edotaccent"}:       -100.000000,
+	{"Ydieresis", "egrave"}:           -60.000000,
+	{"Ydieresis", "emacron"}:          -60.000000,
+	{"Ydieresis", "eogonek"}:          -100.000000,
+	{"Ydieresis", "hyphen"}:           -111.000000,
+	{"Ydieresis", "i"}:                -55.000000,
+	{"Ydieresis", "iacute"}:           -55.000000,
+	{"Ydieresis", "iogonek"}:          -55.000000,
+	{"Ydieresis", "o"}:                -110.000000,
+	{"Ydieresis", "oacute"}:           -110.000000,
+	{"Ydieresis", "ocircumflex"}:      -110.000000,
+	{"Ydieresis", "odieresis"}:        -70.000000,
+	{"Ydieresis", "ograve"}:           -70.000000,
+	{"Ydieresis", "ohungarumlaut"}:    -110.000000,
+	{"Ydieresis", "omacron"}:          -70.000000,
+	{"Ydieresis", "oslash"}:           -110.000000,
+	{"Ydieresis", "otilde"}:           -70.000000,
+	{"Ydieresis", "period"}:           -129.000000,
+	{"Ydieresis", "semicolon"}:        -92.000000,
+	{"Ydieresis", "u"}:                -111.000000,
+	{"Ydieresis", "uacute"}:           -111.000000,
+	{"Ydieresis", "ucircumflex"}:      -111.000000,
+	{"Ydieresis", "udieresis"}:        -71.000000,
+	{"Ydieresis", "ugrave"}:           -71.000000,
+	{"Ydieresis", "uhungarumlaut"}:    -111.000000,
+	{"Ydieresis", "umacron"}:          -71.000000,
+	{"Ydieresis", "uogonek"}:          -111.000000,
+	{"Ydieresis", "uring"}:            -111.000000,
+	{"a", "v"}:                        -20.000000,
+	{"a", "w"}:                        -15.000000,
+	{"aacute", "v"}:                   -20.000000,
+	{"aacute", "w"}:                   -15.000000,
+	{"abreve", "v"}:                   -20.000000,
+	{"abreve", "w"}:                   -15.000000,
+	{"acircumflex", "v"}:              -20.000000,
+	{"acircumflex", "w"}:              -15.000000,
+	{"adieresis", "v"}:                -20.000000,
+	{"adieresis", "w"}:                -15.000000,
+	{"agrave", "v"}:                   -20.000000,
+	{"agrave", "w"}:                   -15.000000,
+	{"amacron", "v"}:                  -20.000000,
+	{"amacron", "w"}:                  -15.000000,
+	{"aogonek", "v"}:                  -20.000000,
+	{"aogonek", "w"}:                  -15.000000,
+	{"aring", "v"}:                    -20.000000,
+	{"aring", "w"}:                    -15.000000,
+	{"atilde", "v"}:                   -20.000000,
+	{"atilde", "w"}:                   -15.000000,
+	{"b", "period"}:                   -40.000000,
+	{"b", "u"}:                        -20.000000,
+	{"b", "uacute"}:                   -20.000000,
+	{"b", "ucircumflex"}:              -20.000000,
+	{"b", "udieresis"}:                -20.000000,
+	{"b", "ugrave"}:                   -20.000000,
+	{"b", "uhungarumlaut"}:            -20.000000,
+	{"b", "umacron"}:                  -20.000000,
+	{"b", "uogonek"}:                  -20.000000,
+	{"b", "uring"}:                    -20.000000,
+	{"b", "v"}:                        -15.000000,
+	{"c", "y"}:                        -15.000000,
+	{"c", "yacute"}:                   -15.000000,
+	{"c", "ydieresis"}:                -15.000000,
+	{"cacute", "y"}:                   -15.000000,
+	{"cacute", "yacute"}:              -15.000000,
+	{"cacute", "ydieresis"}:           -15.000000,
+	{"ccaron", "y"}:                   -15.000000,
+	{"ccaron", "yacute"}:              -15.000000,
+	{"ccaron", "ydieresis"}:           -15.000000,
+	{"ccedilla", "y"}:                 -15.000000,
+	{"ccedilla", "yacute"}:            -15.000000,
+	{"ccedilla", "ydieresis"}:         -15.000000,
+	{"comma", "quotedblright"}:        -70.000000,
+	{"comma", "quoteright"}:           -70.000000,
+	{"e", "g"}:                        -15.000000,
+	{"e", "gbreve"}:                   -15.000000,
+	{"e", "gcommaaccent"}:             -15.000000,
+	{"e", "v"}:                        -25.000000,
+	{"e", "w"}:                        -25.000000,
+	{"e", "x"}:                        -15.000000,
+	{"e", "y"}:                        -15.000000,
+	{"e", "yacute"}:                   -15.000000,
+	{"e", "ydieresis"}:                -15.000000,
+	{"eacute", "g"}:                   -15.000000,
+	{"eacute", "gbreve"}:              -15.000000,
+	{"eacute", "gcommaaccent"}:        -15.000000,
+	{"eacute", "v"}:                   -25.000000,
+	{"eacute", "w"}:                   -25.000000,
+	{"eacute", "x"}:                   -15.000000,
+	{"eacute", "y"}:                   -15.000000,
+	{"eacute", "yacute"}:              -15.000000,
+	{"eacute", "ydieresis"}:           -15.000000,
+	{"ecaron", "g"}:                   -15.000000,
+	{"ecaron", "gbreve"}:              -15.000000,
+	{"ecaron", "gcommaaccent"}:        -15.000000,
+	{"ecaron", "v"}:                   -25.000000,
+	{"ecaron", "w"}:                   -25.000000,
+	{"ecaron", "x"}:                   -15.000000,
+	{"ecaron", "y"}:                   -15.000000,
+	{"ecaron", "yacute"}:              -15.000000,
+	{"ecaron", "ydieresis"}:           -15.000000,
+	{"ecircumflex", "g"}:              -15.000000,
+	{"ecircumflex", "gbreve"}:         -15.000000,
+	{"ecircumflex", "gcommaaccent"}:   -15.000000,
+	{"ecircumflex", "v"}:              -25.000000,
+	{"ecircumflex", "w"}:              -25.000000,
+	{"ecircumflex", "x"}:              -15.000000,
+	{"ecircumflex", "y"}:              -15.000000,
+	{"ecircumflex", "yacute"}:         -15.000000,
+	{"ecircumflex", "ydieresis"}:      -15.000000,
+	{"edieresis", "g"}:                -15.000000,
+	{"edieresis", "gbreve"}:           -15.000000,
+	{"edieresis", "gcommaaccent"}:     -15.000000,
+	{"edieresis", "v"}:                -25.000000,
+	{"edieresis", "w"}:                -25.000000,
+	{"edieresis", "x"}:                -15.000000,
+	{"edieresis", "y"}:                -15.000000,
+	{"edieresis", "yacute"}:           -15.000000,
+	{"edieresis", "ydieresis"}:        -15.000000,
+	{"edotaccent", "g"}:               -15.000000,
+	{"edotaccent", "gbreve"}:          -15.000000,
+	{"edotaccent", "gcommaaccent"}:    -15.000000,
+	{"edotaccent", "v"}:               -25.000000,
+	{"edotaccent", "w"}:               -25.000000,
+	{"edotaccent", "x"}:               -15.000000,
+	{"edotaccent", "y"}:               -15.000000,
+	{"edotaccent", "yacute"}:          -15.000000,
+	{"edotaccent", "ydieresis"}:       -15.000000,
+	{"egrave", "g"}:                   -15.000000,
+	{"egrave", "gbreve"}:              -15.000000,
+	{"egrave", "gcommaaccent"}:        -15.000000,
+	{"egrave", "v"}:                   -25.000000,
+	{"egrave", "w"}:                   -25.000000,
+	{"egrave", "x"}:                   -15.000000,
+	{"egrave", "y"}:                   -15.000000,
+	{"egrave", "yacute"}:              -15.000000,
+	{"egrave", "ydieresis"}:           -15.000000,
+	{"emacron", "g"}:                  -15.000000,
+	{"emacron", "gbreve"}:             -15.000000,
+	{"emacron", "gcommaaccent"}:       -15.000000,
+	{"emacron", "v"}:                  -25.000000,
+	{"emacron", "w"}:                  -25.000000,
+	{"emacron", "x"}:                  -15.000000,
+	{"emacron", "y"}:                  -15.000000,
+	{"emacron", "yacute"}:             -15.000000,
+	{"emacron", "ydieresis"}:          -15.000000,
+	{"eogonek", "g"}:                  -15.000000,
+	{"eogonek", "gbreve"}:             -15.000000,
+	{"eogonek", "gcommaaccent"}:       -15.000000,
+	{"eogonek", "v"}:                  -25.000000,
+	{"eogonek", "w"}:                  -25.000000,
+	{"eogonek", "x"}:                  -15.000000,
+	{"eogonek", "y"}:                  -15.000000,
+	{"eogonek", "yacute"}:             -15.000000,
+	{"eogonek", "ydieresis"}:          -15.000000,
+	{"f", "a"}:                        -10.000000,
+	{"f", "aacute"}:                   -10.000000,
+	{"f", "abreve"}:                   -10.000000,
+	{"f", "acircumflex"}:              -10.000000,
+	{"f", "adieresis"}:                -10.000000,
+	{"f", "agrave"}:                   -10.000000,
+	{"f", "amacron"}:                  -10.000000,
+	{"f", "aogonek"}:                  -10.000000,
+	{"f", "aring"}:                    -10.000000,
+	{"f", "atilde"}:                   -10.000000,
+	{"f", "dotlessi"}:                 -50.000000,
+	{"f", "f"}:                        -25.000000,
+	{"f", "i"}:                        -20.000000,
+	{"f", "iacute"}:                   -20.000000,
+	{"f", "quoteright"}:               55.000000,
+	{"g", "a"}:                        -5.000000,
+	{"g", "aacute"}:                   -5.000000,
+	{"g", "abreve"}:                   -5.000000,
+	{"g", "acircumflex"}:              -5.000000,
+	{"g", "adieresis"}:                -5.000000,
+	{"g", "agrave"}:                   -5.000000,
+	{"g", "amacron"}:                  -5.000000,
+	{"g", "aogonek"}:                  -5.000000,
+	{"g", "aring"}:                    -5.000000,
+	{"g", "atilde"}:                   -5.000000,
+	{"gbreve", "a"}:                   -5.000000,
+	{"gbreve", "aacute"}:              -5.000000,
+	{"gbreve", "abreve"}:              -5.000000,
+	{"gbreve", "acircumflex"}:         -5.000000,
+	{"gbreve", "adieresis"}:           -5.000000,
+	{"gbreve", "agrave"}:              -5.000000,
+	{"gbreve", "amacron"}:             -5.000000,
+	{"gbreve", "aogonek"}:             -5.000000,
+	{"gbreve", "aring"}:               -5.000000,
+	{"gbreve", "atilde"}:              -5.000000,
+	{"gcommaaccent", "a"}:             -5.000000,
+	{"gcommaaccent", "aacute"}:        -5.000000,
+	{"gcommaaccent", "abreve"}:        -5.000000,
+	{"gcommaaccent", "acircumflex"}:   -5.000000,
+	{"gcommaaccent", "adieresis"}:     -5.000000,
+	{"gcommaaccent", "agrave"}:        -5.000000,
+	{"gcommaaccent", "amacron"}:       -5.000000,
+	{"gcommaaccent", "aogonek"}:       -5.000000,
+	{"gcommaaccent", "aring"}:         -5.000000,
+	{"gcommaaccent", "atilde"}:        -5.000000,
+	{"h", "y"}:                        -5.000000,
+	{"h", "yacute"}:                   -5.000000,
+	{"h", "ydieresis"}:                -5.000000,
+	{"i", "v"}:                        -25.000000,
+	{"iacute", "v"}:                   -25.000000,
+	{"icircumflex", "v"}:              -25.000000,
+	{"idieresis", "v"}:                -25.000000,
+	{"igrave", "v"}:                   -25.000000,
+	{"imacron", "v"}:                  -25.000000,
+	{"iogonek", "v"}:                  -25.000000,
+	{"k", "e"}:                        -10.000000,
+	{"k", "eacute"}:                   -10.000000,
+	{"k", "ecaron"}:                   -10.000000,
+	{"k", "ecircumflex"}:              -10.000000,
+	{"k", "edieresis"}:                -10.000000,
+	{"k", "edotaccent"}:               -10.000000,
+	{"k", "egrave"}:                   -10.000000,
+	{"k", "emacron"}:                  -10.000000,
+	{"k", "eogonek"}:                  -10.000000,
+	{"k", "o"}:                        -10.000000,
+	{"k", "oacute"}:                   -10.000000,
+	{"k", "ocircumflex"}:              -10.000000,
+	{"k", "odieresis"}:                -10.000000,
+	{"k", "ograve"}:                   -10.000000,
+	{"k", "ohungarumlaut"}:            -10.000000,
+	{"k", "omacron"}:                  -10.000000,
+	{"k", "oslash"}:                   -10.000000,
+	{"k", "otilde"}:                   -10.000000,
+	{"k", "y"}:                        -15.000000,
+	{"k", "yacute"}:                   -15.000000,
+	{"k", "ydieresis"}:                -15.000000,
+	{"kcommaaccent", "e"}:             -10.000000,
+	{"kcommaaccent", "eacute"}:        -10.000000,
+	{"kcommaaccent", "ecaron"}:        -10.000000,
+	{"kcommaaccent", "ecircumflex"}:   -10.000000,
+	{"kcommaaccent", "edieresis"}:     -10.000000,
+	{"kcommaaccent", "edotaccent"}:    -10.000000,
+	{"kcommaaccent", "egrave"}:        -10.000000,
+	{"kcommaaccent", "emacron"}:       -10.000000,
+	{"kcommaaccent", "eogonek"}:       -10.000000,
+	{"kcommaaccent", "o"}:             -10.000000,
+	{"kcommaaccent", "oacute"}:        -10.000000,
+	{"kcommaaccent", "ocircumflex"}:   -10.000000,
+	{"kcommaaccent", "odieresis"}:     -10.000000,
+	{"kcommaaccent", "ograve"}:        -10.000000,
+	{"kcommaaccent", "ohungarumlaut"}: -10.000000,
+	{"kcommaaccent", "omacron"}:       -10.000000,
+	{"kcommaaccent", "oslash"}:        -10.000000,
+	{"kcommaaccent", "otilde"}:        -10.000000,
+	{"kcommaaccent", "y"}:             -15.000000,
+	{"kcommaaccent", "yacute"}:        -15.000000,
+	{"kcommaaccent", "ydieresis"}:     -15.000000,
+	{"l", "w"}:                        -10.000000,
+	{"lacute", "w"}:                   -10.000000,
+	{"lcommaaccent", "w"}:             -10.000000,
+	{"lslash", "w"}:                   -10.000000,
+	{"n", "v"}:                        -40.000000,
+	{"n", "y"}:                        -15.000000,
+	{"n", "yacute"}:                   -15.000000,
+	{"n", "ydieresis"}:                -15.000000,
+	{"nacute", "v"}:                   -40.000000,
+	{"nacute", "y"}:                   -15.000000,
+	{"nacute", "yacute"}:              -15.000000,
+	{"nacute", "ydieresis"}:           -15.000000,
+	{"ncaron", "v"}:                   -40.000000,
+	{"ncaron", "y"}:                   -15.000000,
+	{"ncaron", "yacute"}:              -15.000000,
+	{"ncaron", "ydieresis"}:           -15.000000,
+	{"ncommaaccent", "v"}:             -40.000000,
+	{"ncommaaccent", "y"}:             -15.000000,
+	{"ncommaaccent", "yacute"}:        -15.000000,
+	{"ncommaaccent", "ydieresis"}:     -15.000000,
+	{"ntilde", "v"}:                   -40.000000,
+	{"ntilde", "y"}:                   -15.000000,
+	{"ntilde", "yacute"}:              -15.000000,
+	{"ntilde", "ydieresis"}:           -15.000000,
+	{"o", "v"}:                        -15.000000,
+	{"o", "w"}:                        -25.000000,
+	{"o", "y"}:                        -10.000000,
+	{"o", "yacute"}:                   -10.000000,
+	{"o", "ydieresis"}:                -10.000000,
+	{"oacute", "v"}:                   -15.000000,
+	{"oacute", "w"}:                   -25.000000,
+	{"oacute", "y"}:                   -10.000000,
+	{"oacute", "yacute"}:              -10.000000,
+	{"oacute", "ydieresis"}:           -10.000000,
+	{"ocircumflex", "v"}:              -15.000000,
+	{"ocircumflex", "w"}:              -25.000000,
+	{"ocircumflex", "y"}:              -10.000000,
+	{"ocircumflex", "yacute"}:         -10.000000,
+	{"ocircumflex", "ydieresis"}:      -10.000000,
+	{"odieresis", "v"}:                -15.000000,
+	{"odieresis", "w"}:                -25.000000,
+	{"odieresis", "y"}:                -10.000000,
+	{"odieresis", "yacute"}:           -10.000000,
+	{"odieresis", "ydieresis"}:        -10.000000,
+	{"ograve", "v"}:                   -15.000000,
+	{"ograve", "w"}:                   -25.000000,
+	{"ograve", "y"}:                   -10.000000,
+	{"ograve", "yacute"}:              -10.000000,
+	{"ograve", "ydieresis"}:           -10.000000,
+	{"ohungarumlaut", "v"}:            -15.000000,
+	{"ohungarumlaut", "w"}:            -25.000000,
+	{"ohungarumlaut", "y"}:            -10.000000,
+	{"ohungarumlaut", "yacute"}:       -10.000000,
+	{"ohungarumlaut", "ydieresis"}:    -10.000000,
+	{"omacron", "v"}:                  -15.000000,
+	{"omacron", "w"}:                  -25.000000,
+	{"omacron", "y"}:                  -10.000000,
+	{"omacron", "yacute"}:             -10.000000,
+	{"omacron", "ydieresis"}:          -10.000000,
+	{"oslash", "v"}:                   -15.000000,
+	{"oslash", "w"}:                   -25.000000,
+	{"oslash", "y"}:                   -10.000000,
+	{"oslash", "yacute"}:              -10.000000,
+	{"oslash", "ydieresis"}:           -10.000000,
+	{"otilde", "v"}:                   -15.000000,
+	{"otilde", "w"}:                   -25.000000,
+	{"otilde", "y"}:                   -10.000000,
+	{"otilde", "yacute"}:              -10.000000,
+	{"otilde", "ydieresis"}:           -10.000000,
+	{"p", "y"}:                        -10.000000,
+	{"p", "yacute"}:                   -10.000000,
+	{"p", "ydieresis"}:                -10.000000,
+	{"period", "quotedblright"}:       -70.000000,
+	{"period", "quoteright"}:          -70.000000,
+	{"quotedblleft", "A"}:             -80.000000,
+	{"quotedblleft", "Aacute"}:        -80.000000,
+	{"quotedblleft", "Abreve"}:        -80.000000,
+	{"quotedblleft", "Acircumflex"}:   -80.000000,
+	{"quotedblleft", "Adieresis"}:     -80.000000,
+	{"quotedblleft", "Agrave"}:        -80.000000,
+	{"quotedblleft", "Amacron"}:       -80.000000,
+	{"quotedblleft", "Aogonek"}:       -80.000000,
+	{"quotedblleft", "Aring"}:         -80.000000,
+	{"quotedblleft", "Atilde"}:        -80.000000,
+	{"quoteleft", "A"}:                -80.000000,
+	{"quoteleft", "Aacute"}:           -80.000000,
+	{"quoteleft", "Abreve"}:           -80.000000,
+	{"quoteleft", "Acircumflex"}:      -80.000000,
+	{"quoteleft", "Adieresis"}:        -80.000000,
+	{"quoteleft", "Agrave"}:           -80.000000,
+	{"quoteleft", "Amacron"}:          -80.000000,
+	{"quoteleft", "Aogonek"}:          -80.000000,
+	{"quoteleft", "Aring"}:            -80.000000,
+	{"quoteleft", "Atilde"}:           -80.000000,
+	{"quoteleft", "quoteleft"}:        -74.000000,
+	{"quoteright", "d"}:               -50.000000,
+	{"quoteright", "dcroat"}:          -50.000000,
+	{"quoteright", "l"}:               -10.000000,
+	{"quoteright", "lacute"}:          -10.000000,
+	{"quoteright", "lcommaaccent"}:    -10.000000,
+	{"quoteright", "lslash"}:          -10.000000,
+	{"quoteright", "quoteright"}:      -74.000000,
+	{"quoteright", "r"}:               -50.000000,
+	{"quoteright", "racute"}:          -50.000000,
+	{"quoteright", "rcaron"}:          -50.000000,
+	{"quoteright", "rcommaaccent"}:    -50.000000,
+	{"quoteright", "s"}:               -55.000000,
+	{"quoteright", "sacute"}:          -55.000000,
+	{"quoteright", "scaron"}:          -55.000000,
+	{"quoteright", "scedilla"}:        -55.000000,
+	{"quoteright", "scommaaccent"}:    -55.000000,
+	{"quoteright", "space"}:           -74.000000,
+	{"quoteright", "t"}:               -18.000000,
+	{"quoteright", "tcommaaccent"}:    -18.000000,
+	{"quoteright", "v"}:               -50.000000,
+	{"r", "comma"}:                    -40.000000,
+	{"r", "g"}:                        -18.000000,
+	{"r", "gbreve"}:                   -18.000000,
+	{"r", "gcommaaccent"}:             -18.000000,
+	{"r", "hyphen"}:                   -20.000000,
+	{"r", "period"}:                   -55.000000,
+	{"racute", "comma"}:               -40.000000,
+	{"racute", "g"}:                   -18.000000,
+	{"racute", "gbreve"}:              -18.000000,
+	{"racute", "gcommaaccent"}:        -18.000000,
+	{"racute", "hyphen"}:              -20.000000,
+	{"racute", "period"}:              -55.000000,
+	{"rcaron", "comma"}:               -40.000000,
+	{"rcaron", "g"}:                   -18.000000,
+	{"rcaron", "gbreve"}:              -18.000000,
+	{"rcaron", "gcommaaccent"}:        -18.000000,
+	{"rcaron", "hyphen"}:              -20.000000,
+	{"rcaron", "period"}:              -55.000000,
+	{"rcommaaccent", "comma"}:         -40.000000,
+	{"rcommaaccent", "g"}:             -18.000000,
+	{"rcommaaccent", "gbreve"}:        -18.000000,
+	{"rcommaaccent", "gcommaaccent"}:  -18.000000,
+	{"rcommaaccent", "hyphen"}:        -20.000000,
+	{"rcommaaccent", "period"}:        -55.000000,
+	{"space", "A"}:                    -55.000000,
+	{"space", "Aacute"}:               -55.000000,
+	{"space", "Abreve"}:               -55.000000,
+	{"space", "Acircumflex"}:          -55.000000,
+	{"space", "Adieresis"}:            -55.000000,
+	{"space", "Agrave"}:               -55.000000,
+	{"space", "Amacron"}:              -55.000000,
+	{"space", "Aogonek"}:              -55.000000,
+	{"space", "Aring"}:                -55.000000,
+	{"space", "Atilde"}:               -55.000000,
+	{"space", "T"}:                    -18.000000,
+	{"space", "Tcaron"}:               -18.000000,
+	{"space", "Tcommaaccent"}:         -18.000000,
+	{"space", "V"}:                    -50.000000,
+	{"space", "W"}:                    -30.000000,
+	{"space", "Y"}:                    -90.000000,
+	{"space", "Yacute"}:               -90.000000,
+	{"space", "Ydieresis"}:            -90.000000,
+	{"v", "a"}:                        -25.000000,
+	{"v", "aacute"}:                   -25.000000,
+	{"v", "abreve"}:                   -25.000000,
+	{"v", "acircumflex"}:              -25.000000,
+	{"v", "adieresis"}:                -25.000000,
+	{"v", "agrave"}:                   -25.000000,
+	{"v", "amacron"}:                  -25.000000,
+	{"v", "aogonek"}:                  -25.000000,
+	{"v", "aring"}:                    -25.000000,
+	{"v", "atilde"}:                   -25.000000,
+	{"v", "comma"}:                    -65.000000,
+	{"v", "e"}:                        -15.000000,
+	{"v", "eacute"}:                   -15.000000,
+	{"v", "ecaron"}:                   -15.000000,
+	{"v", "ecircumflex"}:              -15.000000,
+	{"v", "edieresis"}:                -15.000000,
+	{"v", "edotaccent"}:               -15.000000,
+	{"v", "egrave"}:                   -15.000000,
+	{"v", "emacron"}:                  -15.000000,
+	{"v", "eogonek"}:                  -15.000000,
+	{"v", "o"}:                        -20.000000,
+	{"v", "oacute"}:                   -20.000000,
+	{"v", "ocircumflex"}:              -20.000000,
+	{"v", "odieresis"}:                -20.000000,
+	{"v", "ograve"}:                   -20.000000,
+	{"v", "ohungarumlaut"}:            -20.000000,
+	{"v", "omacron"}:                  -20.000000,
+	{"v", "oslash"}:                   -20.000000,
+	{"v", "otilde"}:                   -20.000000,
+	{"v", "period"}:                   -65.000000,
+	{"w", "a"}:                        -10.000000,
+	{"w", "aacute"}:                   -10.000000,
+	{"w", "abreve"}:                   -10.000000,
+	{"w", "acircumflex"}:              -10.000000,
+	{"w", "adieresis"}:                -10.000000,
+	{"w", "agrave"}:                   -10.000000,
+	{"w", "amacron"}:                  -10.000000,
+	{"w", "aogonek"}:                  -10.000000,
+	{"w", "aring"}:                    -10.000000,
+	{"w", "atilde"}:                   -10.000000,
+	{"w", "comma"}:                    -65.000000,
+	{"w", "o"}:                        -10.000000,
+	{"w", "oacute"}:                   -10.000000,
+	{"w", "ocircumflex"}:              -10.000000,
+	{"w", "odieresis"}:                -10.000000,
+	{"w", "ograve"}:                   -10.000000,
+	{"w", "ohungarumlaut"}:            -10.000000,
+	{"w", "omacron"}:                  -10.000000,
+	{"w", "oslash"}:                   -10.000000,
+	{"w", "otilde"}:                   -10.000000,
+	{"w", "period"}:                   -65.000000,
+	{"x", "e"}:                        -15.000000,
+	{"x", "eacute"}:                   -15.000000,
+	{"x", "ecaron"}:                   -15.000000,
+	{"x", "ecircumflex"}:              -15.000000,
+	{"x", "edieresis"}:                -15.000000,
+	{"x", "edotaccent"}:               -15.000000,
+	{"x", "egrave"}:                   -15.000000,
+	{"x", "emacron"}:                  -15.000000,
+	{"x", "eogonek"}:                  -15.000000,
+	{"y", "comma"}:                    -65.000000,
+	{"y", "period"}:                   -65.000000,
+	{"yacute", "comma"}:               -65.000000,
+	{"yacute", "period"}:              -65.000000,
+	{"ydieresis", "comma"}:            -65.000000,
+	{"ydieresis", "period"}:           -65.000000,
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// ttcTag is the tag at the start of TrueType collection files.
+const ttcTag = "ttcf"
+
+// IsTtc returns true if `data` is a TrueType collection (.ttc).
+func IsTtc(data []byte) bool {
+	return len(data) >= 4 && string(data[:4]) == ttcTag
+}
+
+// TtcNumFaces returns the number of faces in the TrueType collection or TrueType font file `fileStr`
+// (1 for a font file).
+func TtcNumFaces(fileStr string) (int, error) {
+	data, err := ioutil.ReadFile(fileStr)
+	if err != nil {
+		return 0, err
+	}
+	offsets, err := getTtcFaceOffsets(data)
+	if err != nil {
+		return 0, err
+	}
+	return len(offsets), nil
+}
+
+// getTtcFaceOffsets returns the offsets of the offset tables of the faces in font file `data`, which is either
+// a TrueType collection or a single font.
+func getTtcFaceOffsets(data []byte) ([]uint32, error) {
+	if !IsTtc(data) {
+		return []uint32{0}, nil
+	}
+	if len(data) < 12 {
+		return nil, errors.New("truncated collection header")
+	}
+	numFonts := binary.BigEndian.Uint32(data[8:12])
+	if uint64(len(data)) < 12+4*uint64(numFonts) {
+		return nil, errors.New("truncated collection header")
+	}
+	offsets := make([]uint32, numFonts)
+	for i := range offsets {
+		offsets[i] = binary.BigEndian.Uint32(data[12+4*i:])
+	}
+	return offsets, nil
+}
+
+// ExtractTtcFace returns face `faceIndex` of the TrueType collection `data` as a standalone TrueType font, e.g.
+// for embedding in PDF (collections cannot be embedded). If `data` is not a collection, it is returned as is for
+// face index 0.
+func ExtractTtcFace(data []byte, faceIndex int) ([]byte, error) {
+	offsets, err := getTtcFaceOffsets(data)
+	if err != nil {
+		return nil, err
+	}
+	if faceIndex < 0 || faceIndex >= len(offsets) {
+		return nil, fmt.Errorf("face index %d out of range (%d faces)", faceIndex, len(offsets))
+	}
+	if !IsTtc(data) {
+		return data, nil
+	}
+
+	start := uint64(offsets[faceIndex])
+	if uint64(len(data)) < start+12 {
+		return nil, errors.New("truncated offset table")
+	}
+	numTables := int(binary.BigEndian.Uint16(data[start+4:]))
+	dirEnd := start + 12 + 16*uint64(numTables)
+	if uint64(len(data)) < dirEnd {
+		return nil, errors.New("truncated table directory")
+	}
+
+	// Offset table (sfnt version, numTables, searchRange, entrySelector, rangeShift) and table directory,
+	// followed by the tables aligned to 4 bytes.
+	out := make([]byte, dirEnd-start)
+	copy(out, data[start:dirEnd])
+	for i := 0; i < numTables; i++ {
+		entry := out[12+16*i:]
+		offset := uint64(binary.BigEndian.Uint32(entry[8:]))
+		length := uint64(binary.BigEndian.Uint32(entry[12:]))
+		if uint64(len(data)) < offset+length {
+			return nil, fmt.Errorf("table %s out of range", string(entry[:4]))
+		}
+		binary.BigEndian.PutUint32(entry[8:], uint32(len(out)))
+		out = append(out, data[offset:offset+length]...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,421 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// TtfKerning contains the horizontal kerning of the glyph pairs of a TrueType font, loaded from the pair
+// adjustment lookups of the 'kern' feature of the GPOS table, or from the legacy 'kern' table if the font has no
+// GPOS kerning.
+type TtfKerning struct {
+	// Lookups, each with subtables in lookup order. The adjustments of the lookups add up.
+	lookups [][]ttfPairSubtable
+}
+
+// ttfPairSubtable is a pair adjustment subtable.
+type ttfPairSubtable interface {
+	// lookup returns the adjustment of the advance of glyph `left` followed by `right` in font units. The bool
+	// flag is true if the subtable applies to the pair, in which case the following subtables of the lookup are
+	// not used.
+	lookup(left, right uint16) (int16, bool)
+}
+
+// IsEmpty returns true if the font has no kerning information.
+func (kern TtfKerning) IsEmpty() bool {
+	return len(kern.lookups) == 0
+}
+
+// GetKerning returns the adjustment of the advance of the glyph with index `left` followed by the glyph with
+// index `right` in font units, where negative values move the glyphs closer together.
+// The bool flag is true if the font has kerning for the pair.
+func (kern TtfKerning) GetKerning(left, right uint16) (int16, bool) {
+	var total int16
+	found := false
+	for _, subtables := range kern.lookups {
+		for _, subtable := range subtables {
+			if kx, ok := subtable.lookup(left, right); ok {
+				total += kx
+				found = true
+				break
+			}
+		}
+	}
+	return total, found
+}
+
+// ttfPairs is a pair adjustment subtable with individual glyph pairs (GPOS PairPos format 1 or 'kern' format 0).
+type ttfPairs map[uint32]int16
+
+func (pairs ttfPairs) lookup(left, right uint16) (int16, bool) {
+	kx, has := pairs[uint32(left)<<16|uint32(right)]
+	return kx, has
+}
+
+// ttfClassPairs is a pair adjustment subtable with glyph classes (GPOS PairPos format 2).
+type ttfClassPairs struct {
+	coverage    map[uint16]bool
+	classDef1   map[uint16]uint16
+	classDef2   map[uint16]uint16
+	class2Count int
+	values      []int16 // Indexed by class1*class2Count + class2.
+}
+
+func (pairs ttfClassPairs) lookup(left, right uint16) (int16, bool) {
+	if !pairs.coverage[left] {
+		return 0, false
+	}
+	i := int(pairs.classDef1[left])*pairs.class2Count + int(pairs.classDef2[right])
+	if i >= len(pairs.values) {
+		return 0, false
+	}
+	return pairs.values[i], true
+}
+
+// errTtfTruncated is returned for tables that are too short for their contents.
+var errTtfTruncated = errors.New("truncated table")
+
+// ttfTable is the data of a table with bounds checked reads.
+type ttfTable []byte
+
+func (data ttfTable) u16(offset int) (uint16, error) {
+	if offset < 0 || offset+2 > len(data) {
+		return 0, errTtfTruncated
+	}
+	return binary.BigEndian.Uint16(data[offset:]), nil
+}
+
+func (data ttfTable) u32(offset int) (uint32, error) {
+	if offset < 0 || offset+4 > len(data) {
+		return 0, errTtfTruncated
+	}
+	return binary.BigEndian.Uint32(data[offset:]), nil
+}
+
+// u16s reads `n` consecutive uint16 values at `offset`.
+func (data ttfTable) u16s(offset, n int) ([]uint16, error) {
+	if offset < 0 || offset+2*n > len(data) {
+		return nil, errTtfTruncated
+	}
+	vals := make([]uint16, n)
+	for i := range vals {
+		vals[i] = binary.BigEndian.Uint16(data[offset+2*i:])
+	}
+	return vals, nil
+}
+
+// parseGposKerning loads the pair adjustment lookups of the 'kern' feature of GPOS table `data`.
+func parseGposKerning(data ttfTable) (TtfKerning, error) {
+	kern := TtfKerning{}
+
+	header, err := data.u16s(0, 5)
+	if err != nil {
+		return kern, err
+	}
+	featureList, lookupList := int(header[3]), int(header[4])
+
+	// Indices of the lookups of the 'kern' feature (for all scripts and languages).
+	featureCount, err := data.u16(featureList)
+	if err != nil {
+		return kern, err
+	}
+	lookupIndices := map[uint16]bool{}
+	for i := 0; i < int(featureCount); i++ {
+		record := featureList + 2 + 6*i
+		if record+4 > len(data) {
+			return kern, errTtfTruncated
+		}
+		if string(data[record:record+4]) != "kern" {
+			continue
+		}
+		offset, err := data.u16(record + 4)
+		if err != nil {
+			return kern, err
+		}
+		feature := featureList + int(offset)
+		n, err := data.u16(feature + 2)
+		if err != nil {
+			return kern, err
+		}
+		indices, err := data.u16s(feature+4, int(n))
+		if err != nil {
+			return kern, err
+		}
+		for _, index := range indices {
+			lookupIndices[index] = true
+		}
+	}
+
+	lookupCount, err := data.u16(lookupList)
+	if err != nil {
+		return kern, err
+	}
+	lookupOffsets, err := data.u16s(lookupList+2, int(lookupCount))
+	if err != nil {
+		return kern, err
+	}
+	for index, offset := range lookupOffsets {
+		if !lookupIndices[uint16(index)] {
+			continue
+		}
+		lookup := lookupList + int(offset)
+		vals, err := data.u16s(lookup, 3)
+		if err != nil {
+			return kern, err
+		}
+		lookupType, subtableCount := vals[0], int(vals[2])
+		subtableOffsets, err := data.u16s(lookup+6, subtableCount)
+		if err != nil {
+			return kern, err
+		}
+
+		subtables := []ttfPairSubtable{}
+		for _, subtableOffset := range subtableOffsets {
+			subtable := lookup + int(subtableOffset)
+			subtableType := lookupType
+			if lookupType == 9 {
+				// Extension positioning: the actual subtable is at a 32 bit offset.
+				extType, err := data.u16(subtable + 2)
+				if err != nil {
+					return kern, err
+				}
+				extOffset, err := data.u32(subtable + 4)
+				if err != nil {
+					return kern, err
+				}
+				subtableType = extType
+				subtable += int(extOffset)
+			}
+			if subtableType != 2 {
+				continue
+			}
+			pairs, err := parsePairPos(data, subtable)
+			if err != nil {
+				return kern, err
+			}
+			if pairs != nil {
+				subtables = append(subtables, pairs)
+			}
+		}
+		if len(subtables) > 0 {
+			kern.lookups = append(kern.lookups, subtables)
+		}
+	}
+
+	return kern, nil
+}
+
+// parsePairPos loads the horizontal advance adjustments of the first glyphs of the pair adjustment positioning
+// subtable at `offset`. Returns nil if the subtable does not adjust the advance.
+func parsePairPos(data ttfTable, offset int) (ttfPairSubtable, error) {
+	vals, err := data.u16s(offset, 4)
+	if err != nil {
+		return nil, err
+	}
+	format, coverageOffset, valueFormat1, valueFormat2 := vals[0], int(vals[1]), vals[2], vals[3]
+	size1, size2 := 2*countBits(valueFormat1&0x00ff), 2*countBits(valueFormat2&0x00ff)
+
+	// Offset of XAdvance in the first value record, present if bit 2 (0x0004) of the value format is set.
+	if valueFormat1&0x0004 == 0 {
+		return nil, nil
+	}
+	xAdvance := 2 * countBits(valueFormat1&0x0003)
+
+	coverage, err := parseCoverage(data, offset+coverageOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case 1:
+		pairSetCount, err := data.u16(offset + 8)
+		if err != nil {
+			return nil, err
+		}
+		pairSetOffsets, err := data.u16s(offset+10, int(pairSetCount))
+		if err != nil {
+			return nil, err
+		}
+		pairs := ttfPairs{}
+		for _, first := range coverage {
+			if int(first.index) >= len(pairSetOffsets) {
+				continue
+			}
+			pairSet := offset + int(pairSetOffsets[first.index])
+			n, err := data.u16(pairSet)
+			if err != nil {
+				return nil, err
+			}
+			recordSize := 2 + size1 + size2
+			for i := 0; i < int(n); i++ {
+				record := pairSet + 2 + i*recordSize
+				second, err := data.u16(record)
+				if err != nil {
+					return nil, err
+				}
+				kx, err := data.u16(record + 2 + xAdvance)
+				if err != nil {
+					return nil, err
+				}
+				pairs[uint32(first.glyph)<<16|uint32(second)] = int16(kx)
+			}
+		}
+		return pairs, nil
+	case 2:
+		vals, err := data.u16s(offset+8, 4)
+		if err != nil {
+			return nil, err
+		}
+		pairs := ttfClassPairs{coverage: map[uint16]bool{}, class2Count: int(vals[3])}
+		for _, first := range coverage {
+			pairs.coverage[first.glyph] = true
+		}
+		if pairs.classDef1, err = parseClassDef(data, offset+int(vals[0])); err != nil {
+			return nil, err
+		}
+		if pairs.classDef2, err = parseClassDef(data, offset+int(vals[1])); err != nil {
+			return nil, err
+		}
+		class1Count := int(vals[2])
+		recordSize := size1 + size2
+		pairs.values = make([]int16, class1Count*pairs.class2Count)
+		for i := range pairs.values {
+			kx, err := data.u16(offset + 16 + i*recordSize + xAdvance)
+			if err != nil {
+				return nil, err
+			}
+			pairs.values[i] = int16(kx)
+		}
+		return pairs, nil
+	}
+	return nil, nil
+}
+
+// ttfCoverageEntry is a glyph of a coverage table with its coverage index.
+type ttfCoverageEntry struct {
+	glyph uint16
+	index uint16
+}
+
+// parseCoverage loads the coverage table at `offset`.
+func parseCoverage(data ttfTable, offset int) ([]ttfCoverageEntry, error) {
+	vals, err := data.u16s(offset, 2)
+	if err != nil {
+		return nil, err
+	}
+	entries := []ttfCoverageEntry{}
+	switch vals[0] {
+	case 1:
+		glyphs, err := data.u16s(offset+4, int(vals[1]))
+		if err != nil {
+			return nil, err
+		}
+		for i, glyph := range glyphs {
+			entries = append(entries, ttfCoverageEntry{glyph: glyph, index: uint16(i)})
+		}
+	case 2:
+		for i := 0; i < int(vals[1]); i++ {
+			r, err := data.u16s(offset+4+6*i, 3)
+			if err != nil {
+				return nil, err
+			}
+			for glyph := int(r[0]); glyph <= int(r[1]); glyph++ {
+				entries = append(entries, ttfCoverageEntry{glyph: uint16(glyph), index: r[2] + uint16(glyph-int(r[0]))})
+			}
+		}
+	default:
+		return nil, errors.New("unsupported coverage format")
+	}
+	return entries, nil
+}
+
+// parseClassDef loads the class definition table at `offset` as a glyph to class map. Glyphs that are not in the
+// map are in class 0.
+func parseClassDef(data ttfTable, offset int) (map[uint16]uint16, error) {
+	vals, err := data.u16s(offset, 3)
+	if err != nil {
+		return nil, err
+	}
+	classes := map[uint16]uint16{}
+	switch vals[0] {
+	case 1:
+		values, err := data.u16s(offset+6, int(vals[2]))
+		if err != nil {
+			return nil, err
+		}
+		for i, class := range values {
+			if class != 0 {
+				classes[vals[1]+uint16(i)] = class
+			}
+		}
+	case 2:
+		for i := 0; i < int(vals[1]); i++ {
+			r, err := data.u16s(offset+4+6*i, 3)
+			if err != nil {
+				return nil, err
+			}
+			for glyph := int(r[0]); glyph <= int(r[1]); glyph++ {
+				classes[uint16(glyph)] = r[2]
+			}
+		}
+	default:
+		return nil, errors.New("unsupported class definition format")
+	}
+	return classes, nil
+}
+
+// parseKernTable loads the horizontal format 0 subtables of legacy 'kern' table `data`.
+func parseKernTable(data ttfTable) (TtfKerning, error) {
+	kern := TtfKerning{}
+
+	nTables, err := data.u16(2)
+	if err != nil {
+		return kern, err
+	}
+	pairs := ttfPairs{}
+	offset := 4
+	for i := 0; i < int(nTables); i++ {
+		vals, err := data.u16s(offset, 3)
+		if err != nil {
+			return kern, err
+		}
+		length, coverage := int(vals[1]), vals[2]
+		// Format 0 (high byte), horizontal, not minimum values or cross-stream.
+		if coverage>>8 == 0 && coverage&0x0007 == 0x0001 {
+			n, err := data.u16(offset + 6)
+			if err != nil {
+				return kern, err
+			}
+			for j := 0; j < int(n); j++ {
+				vals, err := data.u16s(offset+14+6*j, 3)
+				if err != nil {
+					return kern, err
+				}
+				key := uint32(vals[0])<<16 | uint32(vals[1])
+				pairs[key] += int16(vals[2])
+			}
+		}
+		if length < 6 {
+			break
+		}
+		offset += length
+	}
+	if len(pairs) > 0 {
+		kern.lookups = [][]ttfPairSubtable{{pairs}}
+	}
+	return kern, nil
+}
+
+// countBits returns the number of bits set in `v`.
+func countBits(v uint16) int {
+	n := 0
+	for ; v != 0; v &= v - 1 {
+		n++
+	}
+	return n
+}
@@ -25,9 +25,11 @@ package fonts
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"strings"
+	"unicode"
 )
 
 // TtfType contains metrics of a TrueType font.
@@ -46,27 +48,67 @@ type TtfType struct {
 	CapHeight              int16
 	Widths                 []uint16
 	Chars                  map[uint16]uint16
+
+	// CharsFull maps the Unicode code points to glyph indices (GID) as Chars, including code points outside the
+	// Basic Multilingual Plane when the font has a format 12 cmap subtable.
+	CharsFull map[rune]uint16
+
+	// Kerning of the glyph pairs, empty if the font has no kerning.
+	Kerning TtfKerning
 }
 
 type ttfParser struct {
 	rec              TtfType
-	f                *os.File
+	f                io.ReadSeeker
 	tables           map[string]uint32
+	tableLengths     map[string]uint32
 	numberOfHMetrics uint16
 	numGlyphs        uint16
 }
 
 // TtfParse extracts various metrics from a TrueType font file.
 func TtfParse(fileStr string) (TtfRec TtfType, err error) {
-	var t ttfParser
-	t.f, err = os.Open(fileStr)
+	return TtfParseFace(fileStr, 0)
+}
+
+// TtfParseFace extracts various metrics from face `faceIndex` of a TrueType collection (.ttc) file, or from a
+// TrueType font file if `faceIndex` is 0.
+func TtfParseFace(fileStr string, faceIndex int) (TtfRec TtfType, err error) {
+	f, err := os.Open(fileStr)
 	if err != nil {
 		return
 	}
+	defer f.Close()
+	return TtfParseFaceReader(f, faceIndex)
+}
+
+// TtfParseFaceReader extracts various metrics from face `faceIndex` of the TrueType collection or TrueType font
+// read from `r` (see TtfParseFace), e.g. for fonts loaded from memory where no file system is available.
+func TtfParseFaceReader(r io.ReadSeeker, faceIndex int) (TtfRec TtfType, err error) {
+	var t ttfParser
+	t.f = r
 	version, err := t.ReadStr(4)
 	if err != nil {
 		return
 	}
+	if version == ttcTag {
+		// Collection: skip to the offset table of the face.
+		t.Skip(4) // version
+		numFonts := int(t.ReadULong())
+		if faceIndex < 0 || faceIndex >= numFonts {
+			err = fmt.Errorf("face index %d out of range (%d faces)", faceIndex, numFonts)
+			return
+		}
+		t.Skip(4 * faceIndex)
+		t.f.Seek(int64(t.ReadULong()), os.SEEK_SET)
+		version, err = t.ReadStr(4)
+		if err != nil {
+			return
+		}
+	} else if faceIndex != 0 {
+		err = fmt.Errorf("face index %d out of range (not a collection)", faceIndex)
+		return
+	}
 	if version == "OTTO" {
 		err = fmt.Errorf("fonts based on PostScript outlines are not supported")
 		return
@@ -78,6 +120,7 @@ func TtfParse(fileStr string) (TtfRec TtfType, err error) {
 	numTables := int(t.ReadUShort())
 	t.Skip(3 * 2) // searchRange, entrySelector, rangeShift
 	t.tables = make(map[string]uint32)
+	t.tableLengths = make(map[string]uint32)
 	var tag string
 	for j := 0; j < numTables; j++ {
 		tag, err = t.ReadStr(4)
@@ -86,14 +129,13 @@ func TtfParse(fileStr string) (TtfRec TtfType, err error) {
 		}
 		t.Skip(4) // checkSum
 		offset := t.ReadULong()
-		t.Skip(4) // length
 		t.tables[tag] = offset
+		t.tableLengths[tag] = t.ReadULong()
 	}
 	err = t.ParseComponents()
 	if err != nil {
 		return
 	}
-	t.f.Close()
 	TtfRec = t.rec
 	return
 }
@@ -114,6 +156,9 @@ func (t *ttfParser) ParseComponents() (err error) {
 							err = t.ParseOS2()
 							if err == nil {
 								err = t.ParsePost()
+								if err == nil {
+									t.ParseKerning()
+								}
 							}
 						}
 					}
@@ -186,14 +231,23 @@ func (t *ttfParser) ParseCmap() (err error) {
 	t.Skip(2) // version
 	numTables := int(t.ReadUShort())
 	offset31 := int64(0)
+	offset310 := int64(0)
 	for j := 0; j < numTables; j++ {
 		platformID := t.ReadUShort()
 		encodingID := t.ReadUShort()
 		offset = int64(t.ReadULong())
 		if platformID == 3 && encodingID == 1 {
 			offset31 = offset
+		} else if platformID == 3 && encodingID == 10 {
+			// Unicode full repertoire (format 12).
+			offset310 = offset
 		}
 	}
+	t.rec.Chars = make(map[uint16]uint16)
+	t.rec.CharsFull = make(map[rune]uint16)
+	if offset310 != 0 {
+		return t.parseCmapFormat12(offset310)
+	}
 	if offset31 == 0 {
 		err = fmt.Errorf("no Unicode encoding found")
 		return
@@ -202,7 +256,6 @@ func (t *ttfParser) ParseCmap() (err error) {
 	endCount := make([]uint16, 0, 8)
 	idDelta := make([]int16, 0, 8)
 	idRangeOffset := make([]uint16, 0, 8)
-	t.rec.Chars = make(map[uint16]uint16)
 	t.f.Seek(int64(t.tables["cmap"])+offset31, os.SEEK_SET)
 	format := t.ReadUShort()
 	if format != 4 {
@@ -252,6 +305,40 @@ func (t *ttfParser) ParseCmap() (err error) {
 			}
 			if gid > 0 {
 				t.rec.Chars[c] = uint16(gid)
+				t.rec.CharsFull[rune(c)] = uint16(gid)
+			}
+		}
+	}
+	return
+}
+
+// parseCmapFormat12 parses the segmented coverage (format 12) cmap subtable at `offset` within the cmap table,
+// which maps the full Unicode range including supplementary planes (e.g. emoji, CJK Extension B).
+func (t *ttfParser) parseCmapFormat12(offset int64) (err error) {
+	t.f.Seek(int64(t.tables["cmap"])+offset, os.SEEK_SET)
+	format := t.ReadUShort()
+	if format != 12 {
+		err = fmt.Errorf("unexpected subtable format: %d", format)
+		return
+	}
+	t.Skip(2)     // reserved
+	t.Skip(2 * 4) // length, language
+	numGroups := int(t.ReadULong())
+	for j := 0; j < numGroups; j++ {
+		startCharCode := t.ReadULong()
+		endCharCode := t.ReadULong()
+		startGlyphID := t.ReadULong()
+		if endCharCode < startCharCode || endCharCode > unicode.MaxRune {
+			err = fmt.Errorf("invalid cmap group: %d-%d", startCharCode, endCharCode)
+			return
+		}
+		for c := startCharCode; c <= endCharCode; c++ {
+			gid := startGlyphID + (c - startCharCode)
+			if gid > 0 && gid < 65536 {
+				t.rec.CharsFull[rune(c)] = uint16(gid)
+				if c <= 0xFFFF {
+					t.rec.Chars[uint16(c)] = uint16(gid)
+				}
 			}
 		}
 	}
@@ -330,6 +417,39 @@ func (t *ttfParser) ParsePost() (err error) {
 	return
 }
 
+// ParseKerning loads the kerning from the GPOS table, or from the legacy kern table if GPOS has no kerning.
+// Kerning is optional, the font has no kerning if neither can be loaded.
+func (t *ttfParser) ParseKerning() {
+	for _, tag := range []string{"GPOS", "kern"} {
+		data, err := t.ReadTable(tag)
+		if err != nil {
+			continue
+		}
+		var kern TtfKerning
+		if tag == "GPOS" {
+			kern, err = parseGposKerning(data)
+		} else {
+			kern, err = parseKernTable(data)
+		}
+		if err == nil && !kern.IsEmpty() {
+			t.rec.Kerning = kern
+			return
+		}
+	}
+}
+
+// ReadTable returns the data of table `tag`.
+func (t *ttfParser) ReadTable(tag string) (ttfTable, error) {
+	if err := t.Seek(tag); err != nil {
+		return nil, err
+	}
+	data := make([]byte, t.tableLengths[tag])
+	if _, err := io.ReadFull(t.f, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 func (t *ttfParser) Seek(tag string) (err error) {
 	ofs, ok := t.tables[tag]
 	if ok {
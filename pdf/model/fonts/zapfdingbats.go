@@ -39,6 +39,16 @@ func (font fontZapfDingbats) GetGlyphCharMetrics(glyph string) (CharMetrics, boo
 	return metrics, true
 }
 
+// GetAscentDescent returns the ascent and descent of the font in glyph space units (from the font bounding box).
+func (font fontZapfDingbats) GetAscentDescent() (float64, float64) {
+	return 820, -143
+}
+
+// MeasureString returns the metrics of string `s` at font size `size`.
+func (font fontZapfDingbats) MeasureString(s string, size float64) StringMetrics {
+	return MeasureString(font, font.encoder, s, size, TextSpacing{})
+}
+
 func (font fontZapfDingbats) ToPdfObject() core.PdfObject {
 	obj := &core.PdfIndirectObject{}
 
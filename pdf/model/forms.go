@@ -7,6 +7,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/unidoc/unidoc/common"
 	. "github.com/unidoc/unidoc/pdf/core"
@@ -25,6 +26,16 @@ type PdfAcroForm struct {
 	Q               *PdfObjectInteger
 	XFA             PdfObject
 
+	// Page numbers of the widget annotations and of the page objects, set when loaded by a reader.
+	widgetPages map[*PdfAnnotation]int
+	pageNumbers map[PdfObject]int
+
+	// Pages of the documents of the form, from which widgets are removed with the fields.
+	pages []*PdfPage
+
+	// Permissions policy enforced by the mutation methods, if set.
+	policy *PermissionsPolicy
+
 	primitive *PdfIndirectObject
 }
 
@@ -145,6 +156,181 @@ func (r *PdfReader) newPdfAcroFormFromDict(d *PdfObjectDictionary) (*PdfAcroForm
 	return acroForm, nil
 }
 
+// PdfTerminalField is a terminal field of an interactive form, i.e. a field whose kids are widget annotations,
+// with its fully qualified name and widgets.
+type PdfTerminalField struct {
+	Field *PdfField
+
+	// FullName is the fully qualified field name (12.7.3.2): the partial names of the field and its ancestors
+	// separated by periods, e.g. "address.city".
+	FullName string
+
+	// Widget annotations of the field and the page number (starting from 1) of each widget, 0 if not known,
+	// e.g. for forms that were not loaded by a reader.
+	Widgets     []*PdfAnnotation
+	WidgetPages []int
+}
+
+// FieldsFlattened returns the terminal fields of the form in depth first order, with their fully qualified
+// names, widgets and the pages of the widgets. Unlike Fields, which contains the top level fields of the field
+// hierarchy, non-terminal fields are traversed and not included.
+func (this *PdfAcroForm) FieldsFlattened() []*PdfTerminalField {
+	terminals := []*PdfTerminalField{}
+	if this.Fields == nil {
+		return terminals
+	}
+
+	var flatten func(field *PdfField)
+	flatten = func(field *PdfField) {
+		if field.IsTerminal() {
+			terminal := &PdfTerminalField{
+				Field:    field,
+				FullName: field.FullName(),
+				Widgets:  field.GetWidgets(),
+			}
+			for _, widget := range terminal.Widgets {
+				terminal.WidgetPages = append(terminal.WidgetPages, this.getWidgetPage(widget))
+			}
+			terminals = append(terminals, terminal)
+			return
+		}
+		for _, kid := range field.KidsF {
+			if kidField, ok := kid.(*PdfField); ok && !kidField.isWidget() {
+				flatten(kidField)
+			}
+		}
+	}
+	for _, field := range *this.Fields {
+		flatten(field)
+	}
+	return terminals
+}
+
+// getWidgetPage returns the number of the page of `widget`, from the page annotations or from the P entry of the
+// widget. Returns 0 if not known.
+func (this *PdfAcroForm) getWidgetPage(widget *PdfAnnotation) int {
+	if num, has := this.widgetPages[widget]; has {
+		return num
+	}
+	if widget.P != nil {
+		if num, has := this.pageNumbers[widget.P]; has {
+			return num
+		}
+	}
+	return 0
+}
+
+// RemoveField removes the field with fully qualified name `name` and its descendants from the form, removes their
+// widget annotations from the pages of the documents the form was loaded from and removes the fields from the
+// calculation order (CO). Returns ErrFieldNotFound if the form has no such field, ErrPermissionDenied if
+// modifying the form is not allowed by the enforced permissions policy, or ErrFieldLocked if the field is locked
+// by a signature.
+func (this *PdfAcroForm) RemoveField(name string) error {
+	if err := this.policy.check("Removing fields", (*PermissionsPolicy).CanModifyForm); err != nil {
+		return err
+	}
+	if err := this.CheckFieldEdit(name); err != nil {
+		return err
+	}
+	return this.removeField(name)
+}
+
+// removeField removes the field with fully qualified name `name`, see RemoveField.
+func (this *PdfAcroForm) removeField(name string) error {
+	var field *PdfField
+	if this.Fields != nil {
+		for _, top := range *this.Fields {
+			walkFields(top, func(f *PdfField) {
+				if field == nil && !f.isWidget() && f.FullName() == name {
+					field = f
+				}
+			})
+		}
+	}
+	if field == nil {
+		common.Log.Debug("Field %s not found", name)
+		return ErrFieldNotFound
+	}
+
+	// Remove from the field hierarchy.
+	if field.Parent == nil {
+		fields := []*PdfField{}
+		for _, f := range *this.Fields {
+			if f != field {
+				fields = append(fields, f)
+			}
+		}
+		*this.Fields = fields
+	} else {
+		kids := []PdfModel{}
+		for _, kid := range field.Parent.KidsF {
+			if kid != PdfModel(field) {
+				kids = append(kids, kid)
+			}
+		}
+		field.Parent.KidsF = kids
+	}
+
+	// Objects of the removed fields and widgets.
+	removed := map[PdfObject]bool{}
+	widgets := map[*PdfAnnotation]bool{}
+	walkFields(field, func(f *PdfField) {
+		removed[f.primitive] = true
+		if f.source != nil {
+			removed[f.source] = true
+		}
+		for _, widget := range f.KidsA {
+			widgets[widget] = true
+			removed[widget.primitive] = true
+		}
+	})
+
+	for _, page := range this.pages {
+		annotations := []*PdfAnnotation{}
+		for _, annot := range page.Annotations {
+			if !widgets[annot] {
+				annotations = append(annotations, annot)
+			}
+		}
+		page.Annotations = annotations
+	}
+
+	if this.CO != nil {
+		co := MakeArray()
+		for _, obj := range *this.CO {
+			if !removed[obj] {
+				co.Append(obj)
+			}
+		}
+		this.CO = co
+	}
+
+	return nil
+}
+
+// getCalculationOrder returns the calculation order (CO) with the fields loaded from a document referred to by
+// the objects of the fields in the form, which are written in their place.
+func (this *PdfAcroForm) getCalculationOrder() *PdfObjectArray {
+	sources := map[PdfObject]PdfObject{}
+	if this.Fields != nil {
+		for _, field := range *this.Fields {
+			walkFields(field, func(f *PdfField) {
+				if f.source != nil {
+					sources[f.source] = f.primitive
+				}
+			})
+		}
+	}
+	co := MakeArray()
+	for _, obj := range *this.CO {
+		if fieldObj, has := sources[obj]; has {
+			obj = fieldObj
+		}
+		co.Append(obj)
+	}
+	return co
+}
+
 func (this *PdfAcroForm) GetContainingPdfObject() PdfObject {
 	return this.primitive
 }
@@ -169,7 +355,7 @@ func (this *PdfAcroForm) ToPdfObject() PdfObject {
 
 	}
 	if this.CO != nil {
-		dict.Set("CO", this.CO)
+		dict.Set("CO", this.getCalculationOrder())
 	}
 	if this.DR != nil {
 		dict.Set("DR", this.DR.ToPdfObject())
@@ -212,9 +398,18 @@ type PdfField struct {
 	DS PdfObject
 	RV PdfObject
 
+	// Field lock dictionary of a signature field, see GetLock.
+	Lock PdfObject
+
 	primitive *PdfIndirectObject
+
+	// The object the field was loaded from, e.g. referred to by the calculation order (CO) of the form.
+	source *PdfIndirectObject
 }
 
+// fieldOnlyKeys are the entries of field dictionaries that do not apply to widget annotations (12.7.3.1).
+var fieldOnlyKeys = []PdfObjectName{"FT", "T", "TU", "TM", "Ff", "V", "DV", "DA", "Q", "DS", "RV", "Lock"}
+
 func NewPdfField() *PdfField {
 	field := &PdfField{}
 
@@ -233,6 +428,7 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *PdfIndirectObject,
 	}
 
 	field := NewPdfField()
+	field.source = container
 
 	// Field type (required in terminal fields).
 	// Can be /Btn /Tx /Ch /Sig
@@ -272,6 +468,18 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *PdfIndirectObject,
 	field.DS = d.Get("DS")
 	field.RV = d.Get("RV")
 
+	// Signature field lock (Optional).
+	if obj := d.Get("Lock"); obj != nil {
+		obj, err = r.traceToObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.traverseObjectData(obj); err != nil {
+			return nil, err
+		}
+		field.Lock = obj
+	}
+
 	// In a non-terminal field, the Kids array shall refer to field dictionaries that are immediate descendants of this field.
 	// In a terminal field, the Kids array ordinarily shall refer to one or more separate widget annotations that are associated
 	// with this field. However, if there is only one associated widget annotation, and its contents have been merged into the field
@@ -348,6 +556,62 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *PdfIndirectObject,
 	return field, nil
 }
 
+// PartialName returns the partial field name (T), or an empty string if not set.
+func (this *PdfField) PartialName() string {
+	str, ok := TraceToDirectObject(this.T).(*PdfObjectString)
+	if !ok {
+		return ""
+	}
+	return DecodeTextString(*str)
+}
+
+// FullName returns the fully qualified field name: the partial names of the field and its ancestors separated by
+// periods (12.7.3.2). Fields without a partial name are not included.
+func (this *PdfField) FullName() string {
+	names := []string{}
+	for field := this; field != nil; field = field.Parent {
+		if name := field.PartialName(); name != "" {
+			names = append([]string{name}, names...)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// isWidget returns true if the field represents a separate widget annotation of its parent field, i.e. a widget
+// annotation dictionary without field entries, rather than a field.
+func (this *PdfField) isWidget() bool {
+	return this.T == nil && len(this.KidsA) > 0 && len(this.KidsF) == 0
+}
+
+// IsTerminal returns true if the field is a terminal field, i.e. its kids (if any) are widget annotations rather
+// than fields.
+func (this *PdfField) IsTerminal() bool {
+	for _, kid := range this.KidsF {
+		if kidField, ok := kid.(*PdfField); ok && !kidField.isWidget() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWidgets returns the widget annotations of a terminal field: the widget merged into the field dictionary
+// and the widgets in Kids.
+func (this *PdfField) GetWidgets() []*PdfAnnotation {
+	widgets := []*PdfAnnotation{}
+	widgets = append(widgets, this.KidsA...)
+	for _, kid := range this.KidsF {
+		switch t := kid.(type) {
+		case *PdfField:
+			if t.isWidget() {
+				widgets = append(widgets, t.KidsA...)
+			}
+		case *PdfAnnotation:
+			widgets = append(widgets, t)
+		}
+	}
+	return widgets
+}
+
 func (this *PdfField) GetContainingPdfObject() PdfObject {
 	return this.primitive
 }
@@ -379,7 +643,17 @@ func (this *PdfField) ToPdfObject() PdfObject {
 		}
 		arr := dict.Get("Kids").(*PdfObjectArray)
 		for _, child := range this.KidsA {
-			*arr = append(*arr, child.GetContext().ToPdfObject())
+			widget := child.GetContext().ToPdfObject()
+			// Widgets that were merged with the field when loaded still contain the field entries, which are
+			// written in the field dictionary. Remove them, as the widget would otherwise be a field of its own.
+			if ind, ok := widget.(*PdfIndirectObject); ok && ind != container {
+				if widgetDict, ok := ind.PdfObject.(*PdfObjectDictionary); ok {
+					for _, key := range fieldOnlyKeys {
+						widgetDict.Remove(key)
+					}
+				}
+			}
+			*arr = append(*arr, widget)
 		}
 	}
 
@@ -414,6 +688,7 @@ func (this *PdfField) ToPdfObject() PdfObject {
 	dict.SetIfNotNil("Q", this.Q)
 	dict.SetIfNotNil("DS", this.DS)
 	dict.SetIfNotNil("RV", this.RV)
+	dict.SetIfNotNil("Lock", this.Lock)
 
 	return container
 }
@@ -0,0 +1,238 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfAppearanceCharacteristics is the appearance characteristics dictionary of a widget annotation (MK entry,
+// 12.5.6.19 - Table 189), used by viewers to construct the appearance of the widget when generating it.
+// Colors have 0 (transparent), 1 (gray), 3 (RGB) or 4 (CMYK) components in the range 0 to 1.
+type PdfAppearanceCharacteristics struct {
+	R  int       // Rotation of the widget in degrees counterclockwise, a multiple of 90.
+	BC []float64 // Border color.
+	BG []float64 // Background color.
+	CA string    // Normal caption of button fields.
+}
+
+// NewPdfAppearanceCharacteristicsFromPdfObject loads appearance characteristics from an appearance
+// characteristics dictionary.
+func NewPdfAppearanceCharacteristicsFromPdfObject(obj PdfObject) (*PdfAppearanceCharacteristics, error) {
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: Appearance characteristics not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	mk := &PdfAppearanceCharacteristics{}
+	if r, ok := TraceToDirectObject(dict.Get("R")).(*PdfObjectInteger); ok {
+		mk.R = int(*r)
+	}
+	var err error
+	if mk.BC, err = getAppearanceColor(dict, "BC"); err != nil {
+		return nil, err
+	}
+	if mk.BG, err = getAppearanceColor(dict, "BG"); err != nil {
+		return nil, err
+	}
+	if ca, ok := TraceToDirectObject(dict.Get("CA")).(*PdfObjectString); ok {
+		mk.CA = DecodeTextString(*ca)
+	}
+	return mk, nil
+}
+
+// getAppearanceColor returns the color of entry `key` of appearance characteristics dictionary `dict`, or nil if
+// not set.
+func getAppearanceColor(dict *PdfObjectDictionary, key PdfObjectName) ([]float64, error) {
+	arr, ok := TraceToDirectObject(dict.Get(key)).(*PdfObjectArray)
+	if !ok {
+		return nil, nil
+	}
+	color, err := arr.ToFloat64Array()
+	if err != nil || !isAppearanceColor(color) {
+		common.Log.Debug("ERROR: Invalid appearance characteristics %s: %v", key, arr)
+		return nil, ErrInvalidAttribute
+	}
+	return color, nil
+}
+
+// isAppearanceColor returns true if `color` has a valid number of components for appearance characteristics.
+func isAppearanceColor(color []float64) bool {
+	switch len(color) {
+	case 0, 1, 3, 4:
+		return true
+	}
+	return false
+}
+
+// ToPdfObject returns the appearance characteristics dictionary.
+func (mk *PdfAppearanceCharacteristics) ToPdfObject() PdfObject {
+	dict := MakeDict()
+	mk.setEntries(dict)
+	return dict
+}
+
+// setEntries sets the entries of the appearance characteristics in `dict`, removing those not set.
+func (mk *PdfAppearanceCharacteristics) setEntries(dict *PdfObjectDictionary) {
+	if mk.R != 0 {
+		dict.Set("R", MakeInteger(int64(mk.R)))
+	} else {
+		dict.Remove("R")
+	}
+	if mk.BC != nil {
+		dict.Set("BC", MakeArrayFromFloats(mk.BC))
+	} else {
+		dict.Remove("BC")
+	}
+	if mk.BG != nil {
+		dict.Set("BG", MakeArrayFromFloats(mk.BG))
+	} else {
+		dict.Remove("BG")
+	}
+	if mk.CA != "" {
+		dict.Set("CA", EncodeTextString(mk.CA))
+	} else {
+		dict.Remove("CA")
+	}
+}
+
+// GetAppearanceCharacteristics returns the appearance characteristics of the widget, or nil if it has none.
+func (this *PdfAnnotationWidget) GetAppearanceCharacteristics() (*PdfAppearanceCharacteristics, error) {
+	if this.MK == nil {
+		return nil, nil
+	}
+	return NewPdfAppearanceCharacteristicsFromPdfObject(this.MK)
+}
+
+// SetAppearanceCharacteristics sets the appearance characteristics of the widget. The entries of the existing
+// dictionary not represented in PdfAppearanceCharacteristics, e.g. the icons of buttons, are kept. A nil `mk`
+// removes the appearance characteristics.
+func (this *PdfAnnotationWidget) SetAppearanceCharacteristics(mk *PdfAppearanceCharacteristics) {
+	if mk == nil {
+		this.MK = nil
+		if dict, ok := this.primitive.PdfObject.(*PdfObjectDictionary); ok {
+			dict.Remove("MK")
+		}
+		return
+	}
+	if dict, ok := TraceToDirectObject(this.MK).(*PdfObjectDictionary); ok {
+		mk.setEntries(dict)
+		return
+	}
+	this.MK = mk.ToPdfObject()
+}
+
+// SetNeedAppearances sets the NeedAppearances flag of the form, which requests viewers to generate the appearances
+// of the widgets from the field values, e.g. after filling fields without generating their appearances.
+func (this *PdfAcroForm) SetNeedAppearances(need bool) {
+	this.NeedAppearances = MakeBool(need)
+}
+
+// FormViewerCompatibility selects how the appearances of form fields are handled, as viewers differ in how they
+// render forms: Adobe Acrobat regenerates all appearances when NeedAppearances is set, and then prompts to save
+// the document on closing, while other viewers ignore NeedAppearances and render the existing appearance streams.
+type FormViewerCompatibility int
+
+const (
+	// FormViewerDefault keeps the NeedAppearances flag and the widget appearances as they are.
+	FormViewerDefault FormViewerCompatibility = iota
+
+	// FormViewerStatic relies on the appearance streams of the widgets: NeedAppearances is cleared, so that
+	// viewers show the document as is, without regenerating appearances or prompting to save it. Suited for
+	// documents whose appearances are up to date, e.g. signed documents.
+	FormViewerStatic
+
+	// FormViewerRegenerate sets NeedAppearances and removes the appearance streams of the widgets of text and
+	// choice fields, so that no viewer shows appearances out of date with the field values: viewers generating
+	// appearances show the values, others show empty widgets.
+	FormViewerRegenerate
+)
+
+// FormAppearanceOptions are the options of PdfAcroForm.SetAppearanceOptions.
+type FormAppearanceOptions struct {
+	Viewer FormViewerCompatibility
+
+	// Border and background colors (MK BC and BG entries) set on the widgets not specifying them, used by viewers
+	// generating appearances. Not set if nil. An empty color is transparent.
+	BorderColor     []float64
+	BackgroundColor []float64
+}
+
+// SetAppearanceOptions applies `opts` to the form and the widgets of its fields. Returns ErrInvalidAttribute if
+// a color of `opts` has an invalid number of components, ErrPermissionDenied if modifying the form is not
+// allowed by the enforced permissions policy, or ErrFieldLocked if FormViewerRegenerate would remove the
+// appearances of a field locked by a signature (see CheckFieldEdit).
+func (this *PdfAcroForm) SetAppearanceOptions(opts FormAppearanceOptions) error {
+	if err := this.policy.check("Setting form appearance options", (*PermissionsPolicy).CanModifyForm); err != nil {
+		return err
+	}
+
+	for _, color := range [][]float64{opts.BorderColor, opts.BackgroundColor} {
+		if color != nil && !isAppearanceColor(color) {
+			common.Log.Debug("ERROR: Invalid appearance color %v", color)
+			return ErrInvalidAttribute
+		}
+	}
+
+	// Removing the appearances of fields locked by a signature invalidates the signature.
+	if opts.Viewer == FormViewerRegenerate {
+		for _, terminal := range this.FieldsFlattened() {
+			ft := terminal.Field.getFieldType()
+			if ft != "Tx" && ft != "Ch" {
+				continue
+			}
+			if err := this.CheckFieldEdit(terminal.FullName); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch opts.Viewer {
+	case FormViewerStatic:
+		this.SetNeedAppearances(false)
+	case FormViewerRegenerate:
+		this.SetNeedAppearances(true)
+	}
+
+	for _, terminal := range this.FieldsFlattened() {
+		ft := terminal.Field.getFieldType()
+		for _, annot := range terminal.Widgets {
+			widget, ok := annot.GetContext().(*PdfAnnotationWidget)
+			if !ok {
+				common.Log.Debug("Widget of %s not a widget annotation (%T)", terminal.FullName, annot.GetContext())
+				continue
+			}
+
+			if opts.Viewer == FormViewerRegenerate && (ft == "Tx" || ft == "Ch") {
+				widget.AP = nil
+				if dict, ok := widget.primitive.PdfObject.(*PdfObjectDictionary); ok {
+					dict.Remove("AP")
+				}
+			}
+
+			if opts.BorderColor == nil && opts.BackgroundColor == nil {
+				continue
+			}
+			mk, err := widget.GetAppearanceCharacteristics()
+			if err != nil {
+				return err
+			}
+			if mk == nil {
+				mk = &PdfAppearanceCharacteristics{}
+			}
+			if mk.BC == nil && opts.BorderColor != nil {
+				mk.BC = opts.BorderColor
+			}
+			if mk.BG == nil && opts.BackgroundColor != nil {
+				mk.BG = opts.BackgroundColor
+			}
+			widget.SetAppearanceCharacteristics(mk)
+		}
+	}
+	return nil
+}
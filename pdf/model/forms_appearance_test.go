@@ -0,0 +1,114 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+var testFormAppearanceObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R >>",
+	"<< /Type /Pages /Kids [4 0 R] /Count 1 >>",
+	"<< /Fields [5 0 R 6 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [5 0 R 6 0 R] >>",
+	"<< /T (name) /FT /Tx /V (Jane) /Type /Annot /Subtype /Widget /Rect [0 0 100 20] /P 4 0 R /AP << /N 7 0 R >> " +
+		"/MK << /BC [1 0 0] /TP 1 >> >>",
+	"<< /T (agree) /FT /Btn /Type /Annot /Subtype /Widget /Rect [0 30 20 50] /P 4 0 R /AP << /N << /Yes 7 0 R >> >> " +
+		"/AS /Yes >>",
+	"<< >>",
+}
+
+// getTestWidget returns the widget of field `name` of `form`.
+func getTestWidget(t *testing.T, form *PdfAcroForm, name string) *PdfAnnotationWidget {
+	for _, terminal := range form.FieldsFlattened() {
+		if terminal.FullName == name && len(terminal.Widgets) == 1 {
+			if widget, ok := terminal.Widgets[0].GetContext().(*PdfAnnotationWidget); ok {
+				return widget
+			}
+		}
+	}
+	t.Fatalf("Widget of %s not found", name)
+	return nil
+}
+
+func TestSetAppearanceOptions(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testFormAppearanceObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	form := reader.AcroForm
+
+	if err := form.SetAppearanceOptions(FormAppearanceOptions{BorderColor: []float64{0, 0}}); err != ErrInvalidAttribute {
+		t.Errorf("Expected invalid color to be refused, got %v", err)
+	}
+	err = form.SetAppearanceOptions(FormAppearanceOptions{
+		Viewer:          FormViewerRegenerate,
+		BorderColor:     []float64{0},
+		BackgroundColor: []float64{1, 1, 1},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	w := NewPdfWriter()
+	for _, page := range reader.PageList {
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+	if err := w.SetForms(form); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	reader, err = NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	form = reader.AcroForm
+	if form.NeedAppearances == nil || !bool(*form.NeedAppearances) {
+		t.Errorf("NeedAppearances not set")
+	}
+
+	expected := map[string]struct {
+		HasAP bool
+		MK    PdfAppearanceCharacteristics
+	}{
+		// The text field keeps its border color and its appearance is removed.
+		"name":  {false, PdfAppearanceCharacteristics{BC: []float64{1, 0, 0}, BG: []float64{1, 1, 1}}},
+		"agree": {true, PdfAppearanceCharacteristics{BC: []float64{0}, BG: []float64{1, 1, 1}}},
+	}
+	for name, exp := range expected {
+		widget := getTestWidget(t, form, name)
+		if hasAP := widget.AP != nil; hasAP != exp.HasAP {
+			t.Errorf("%s: appearance %v, expected %v", name, hasAP, exp.HasAP)
+		}
+		mk, err := widget.GetAppearanceCharacteristics()
+		if err != nil || mk == nil {
+			t.Fatalf("%s: appearance characteristics %v (%v)", name, mk, err)
+		}
+		if !reflect.DeepEqual(*mk, exp.MK) {
+			t.Errorf("%s: %+v != %+v", name, *mk, exp.MK)
+		}
+	}
+	mk, _ := TraceToDirectObject(getTestWidget(t, form, "name").MK).(*PdfObjectDictionary)
+	if mk == nil || mk.Get("TP") == nil {
+		t.Errorf("Other appearance characteristics not kept: %v", mk)
+	}
+
+	if err := form.SetAppearanceOptions(FormAppearanceOptions{Viewer: FormViewerStatic}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if form.NeedAppearances == nil || bool(*form.NeedAppearances) {
+		t.Errorf("NeedAppearances not cleared")
+	}
+}
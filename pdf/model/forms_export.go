@@ -0,0 +1,159 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// FieldValueSeparator separates the values of fields with several values, e.g. multiple selection list boxes, in
+// the field values returned by FieldValues.
+const FieldValueSeparator = "; "
+
+// FieldValues returns the values of the terminal fields of the form as text, keyed by fully qualified field name.
+// The values of check boxes and radio buttons are the names of their states, e.g. "Off", and the values of fields
+// with several values are joined with FieldValueSeparator. Fields without a value have an empty value.
+func (this *PdfAcroForm) FieldValues() map[string]string {
+	values := map[string]string{}
+	for _, terminal := range this.FieldsFlattened() {
+		values[terminal.FullName] = fieldValueString(terminal.Field.getInheritedV())
+	}
+	return values
+}
+
+// fieldValueString returns field value `obj` as text.
+func fieldValueString(obj PdfObject) string {
+	switch t := TraceToDirectObject(obj).(type) {
+	case *PdfObjectString:
+		return DecodeTextString(*t)
+	case *PdfObjectName:
+		return string(*t)
+	case *PdfObjectInteger:
+		return fmt.Sprintf("%d", *t)
+	case *PdfObjectFloat:
+		return fmt.Sprintf("%v", float64(*t))
+	case *PdfObjectArray:
+		parts := []string{}
+		for _, elem := range *t {
+			parts = append(parts, fieldValueString(elem))
+		}
+		return strings.Join(parts, FieldValueSeparator)
+	case nil, *PdfObjectNull:
+		return ""
+	}
+	// E.g. the signature dictionaries of signature fields, which have no text value.
+	common.Log.Trace("Field value without text (%T)", obj)
+	return ""
+}
+
+// FormDataRecord is the form data of a document in a FormDataBatch.
+type FormDataRecord struct {
+	// Document name, e.g. the file name.
+	Document string
+
+	// Field values keyed by fully qualified field name, see PdfAcroForm.FieldValues.
+	Values map[string]string
+}
+
+// FormDataBatch collects the field values of a batch of documents with filled forms, typically based on the same
+// template, for export as a table with a column per field (CSV) or as JSON objects keyed by field name.
+type FormDataBatch struct {
+	// Fully qualified names of the fields of all the records, in the order they are first found in the forms.
+	Fields []string
+
+	Records []*FormDataRecord
+
+	fieldSet map[string]bool
+}
+
+// NewFormDataBatch returns a new empty batch.
+func NewFormDataBatch() *FormDataBatch {
+	return &FormDataBatch{fieldSet: map[string]bool{}}
+}
+
+// AddForm adds the field values of `form` (which may be nil for documents without a form) as a record for document
+// `name`.
+func (b *FormDataBatch) AddForm(name string, form *PdfAcroForm) {
+	record := &FormDataRecord{Document: name, Values: map[string]string{}}
+	if form != nil {
+		for _, terminal := range form.FieldsFlattened() {
+			if !b.fieldSet[terminal.FullName] {
+				b.fieldSet[terminal.FullName] = true
+				b.Fields = append(b.Fields, terminal.FullName)
+			}
+			record.Values[terminal.FullName] = fieldValueString(terminal.Field.getInheritedV())
+		}
+	}
+	b.Records = append(b.Records, record)
+}
+
+// AddDocument reads the document from `rs` and adds its field values as a record for document `name`. Encrypted
+// documents are decrypted with the empty user password.
+func (b *FormDataBatch) AddDocument(name string, rs io.ReadSeeker) error {
+	reader, err := NewPdfReader(rs)
+	if err != nil {
+		return err
+	}
+	isEncrypted, err := reader.IsEncrypted()
+	if err != nil {
+		return err
+	}
+	if isEncrypted {
+		auth, err := reader.Decrypt([]byte(""))
+		if err != nil {
+			return err
+		}
+		if !auth {
+			common.Log.Debug("ERROR: Unable to decrypt %s", name)
+			return fmt.Errorf("unable to decrypt %s", name)
+		}
+	}
+	b.AddForm(name, reader.AcroForm)
+	return nil
+}
+
+// WriteCSV writes the records as CSV to `w`: a header row with "document" and the field names followed by a row
+// per record. Fields that a document does not have are written as empty cells.
+func (b *FormDataBatch) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"document"}, b.Fields...)); err != nil {
+		return err
+	}
+	for _, record := range b.Records {
+		row := []string{record.Document}
+		for _, name := range b.Fields {
+			row = append(row, record.Values[name])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the records as a JSON array to `w`, with an object per record containing the document name and
+// the field values keyed by fully qualified field name, e.g. [{"document": "a.pdf", "fields": {"city": "Oslo"}}].
+func (b *FormDataBatch) WriteJSON(w io.Writer) error {
+	type jsonRecord struct {
+		Document string            `json:"document"`
+		Fields   map[string]string `json:"fields"`
+	}
+	records := []jsonRecord{}
+	for _, record := range b.Records {
+		records = append(records, jsonRecord{Document: record.Document, Fields: record.Values})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
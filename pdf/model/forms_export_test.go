@@ -0,0 +1,67 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// makeFilledFormPdf returns a document with a text field, a check box and a multiple selection list box with
+// values `name`, `state` and `choices`.
+func makeFilledFormPdf(name, state, choices string) []byte {
+	return makeTestPdf([]string{
+		"<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R >>",
+		"<< /Type /Pages /Kids [4 0 R] /Count 1 >>",
+		"<< /Fields [5 0 R 6 0 R] >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [5 0 R 7 0 R 8 0 R] >>",
+		fmt.Sprintf("<< /T (name) /FT /Tx /V (%s) /Type /Annot /Subtype /Widget /Rect [0 0 10 10] /P 4 0 R >>", name),
+		"<< /T (options) /Kids [7 0 R 8 0 R] >>",
+		fmt.Sprintf("<< /T (agree) /FT /Btn /V /%s /Parent 6 0 R /Type /Annot /Subtype /Widget /Rect [0 20 10 30] /P 4 0 R >>", state),
+		fmt.Sprintf("<< /T (colors) /FT /Ch /Ff 2097152 /V [%s] /Parent 6 0 R /Type /Annot /Subtype /Widget /Rect [0 40 10 50] /P 4 0 R >>", choices),
+	})
+}
+
+func TestFormDataBatch(t *testing.T) {
+	batch := NewFormDataBatch()
+	if err := batch.AddDocument("a.pdf", bytes.NewReader(makeFilledFormPdf("Doe, Jane", "Yes", "(red) (blue)"))); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := batch.AddDocument("b.pdf", bytes.NewReader(makeFilledFormPdf("John", "Off", ""))); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	batch.AddForm("c.pdf", nil)
+
+	var buf bytes.Buffer
+	if err := batch.WriteCSV(&buf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	expected := "document,name,options.agree,options.colors\n" +
+		"a.pdf,\"Doe, Jane\",Yes,red; blue\n" +
+		"b.pdf,John,Off,\n" +
+		"c.pdf,,,\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := batch.WriteJSON(&buf); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	var records []struct {
+		Document string
+		Fields   map[string]string
+	}
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(records) != 3 || records[0].Fields["options.colors"] != "red; blue" || records[1].Fields["name"] != "John" ||
+		len(records[2].Fields) != 0 {
+		t.Errorf("Unexpected JSON: %s", buf.String())
+	}
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// FieldMergeStrategy specifies how the fields of a form merged into another form are kept apart from the fields
+// of the other form. Fields with the same fully qualified name are the same field (12.7.3.2) and share values,
+// so colliding names need to be resolved for both forms to stay functional.
+type FieldMergeStrategy int
+
+const (
+	// FieldMergePrefix prefixes the partial names of colliding top level fields with the affix.
+	FieldMergePrefix FieldMergeStrategy = iota
+
+	// FieldMergeSuffix appends the affix to the partial names of colliding top level fields.
+	FieldMergeSuffix
+
+	// FieldMergeReparent places all the top level fields of the merged form under a new non-terminal field,
+	// i.e. the fully qualified names become parent.name.
+	FieldMergeReparent
+)
+
+// FieldMergeOptions contains the options for merging forms.
+type FieldMergeOptions struct {
+	Strategy FieldMergeStrategy
+
+	// Affix for FieldMergePrefix and FieldMergeSuffix, applied repeatedly until the name is unique.
+	// Defaults to "copy_" for prefixes and "_copy" for suffixes.
+	Affix string
+
+	// Partial name of the parent field for FieldMergeReparent, made unique with a number suffix if it collides.
+	// Defaults to "merged".
+	ParentName string
+}
+
+// Merge adds the fields of form `other` to the form, e.g. when the pages of several documents with forms are
+// combined in a writer. Colliding field names of `other` are resolved according to `opts`. The fonts of the default
+// resources (DR) of `other` are added to the form's default resources, renaming fonts whose names collide, and the
+// default appearance strings (DA) of the fields of `other` are updated accordingly.
+// The fields of `other` are modified and become part of the form, `other` should not be used afterwards.
+// Returns ErrPermissionDenied if modifying the form is not allowed by the enforced permissions policy, or
+// ErrFieldLocked if a field of `other` to be renamed is locked by a signature (see CheckFieldEdit).
+func (this *PdfAcroForm) Merge(other *PdfAcroForm, opts FieldMergeOptions) error {
+	if other == nil || other.Fields == nil {
+		return nil
+	}
+	if err := this.policy.check("Merging forms", (*PermissionsPolicy).CanModifyForm); err != nil {
+		return err
+	}
+	if this.Fields == nil {
+		this.Fields = &[]*PdfField{}
+	}
+
+	used := map[string]bool{}
+	for _, field := range *this.Fields {
+		used[field.PartialName()] = true
+	}
+
+	// Partial names of the top level fields of `other` after the merge.
+	names := make([]string, len(*other.Fields))
+	switch opts.Strategy {
+	case FieldMergePrefix, FieldMergeSuffix:
+		affix := opts.Affix
+		if affix == "" {
+			affix = "copy_"
+			if opts.Strategy == FieldMergeSuffix {
+				affix = "_copy"
+			}
+		}
+		for i, field := range *other.Fields {
+			name := field.PartialName()
+			if name != "" {
+				for used[name] {
+					if opts.Strategy == FieldMergePrefix {
+						name = affix + name
+					} else {
+						name += affix
+					}
+				}
+			}
+			names[i] = name
+			used[name] = true
+		}
+	case FieldMergeReparent:
+	default:
+		common.Log.Debug("Invalid field merge strategy %d", opts.Strategy)
+		return ErrRangeError
+	}
+
+	// Renaming a field locked by a signature invalidates the signature. Reparenting renames all the fields.
+	for i, field := range *other.Fields {
+		if opts.Strategy == FieldMergeReparent || names[i] != field.PartialName() {
+			if err := other.CheckFieldEdit(field.PartialName()); err != nil {
+				return err
+			}
+		}
+	}
+
+	fontRenames, err := this.mergeDefaultResources(other)
+	if err != nil {
+		return err
+	}
+
+	// Default appearance: fields of the other form inherit its DA rather than this form's DA.
+	inheritDA := other.DA != nil && (this.DA == nil || string(*this.DA) != string(*other.DA))
+	for _, field := range *other.Fields {
+		if inheritDA && field.DA == nil {
+			field.DA = MakeString(string(*other.DA))
+		}
+		walkFields(field, func(f *PdfField) {
+			if str, ok := TraceToDirectObject(f.DA).(*PdfObjectString); ok && len(fontRenames) > 0 {
+				f.DA = MakeString(renameDAFonts(string(*str), fontRenames))
+			}
+		})
+	}
+
+	if opts.Strategy == FieldMergeReparent {
+		name := opts.ParentName
+		if name == "" {
+			name = "merged"
+		}
+		base := name
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		parent := NewPdfField()
+		parent.T = EncodeTextString(name)
+		parent.KidsF = []PdfModel{}
+		for _, field := range *other.Fields {
+			field.Parent = parent
+			parent.KidsF = append(parent.KidsF, field)
+		}
+		*this.Fields = append(*this.Fields, parent)
+	} else {
+		for i, field := range *other.Fields {
+			if names[i] != field.PartialName() {
+				common.Log.Trace("Renaming field %s to %s", field.PartialName(), names[i])
+				field.T = EncodeTextString(names[i])
+			}
+			*this.Fields = append(*this.Fields, field)
+		}
+	}
+
+	if other.NeedAppearances != nil && bool(*other.NeedAppearances) {
+		this.NeedAppearances = MakeBool(true)
+	}
+	if other.SigFlags != nil {
+		flags := *other.SigFlags
+		if this.SigFlags != nil {
+			flags |= *this.SigFlags
+		}
+		this.SigFlags = MakeInteger(int64(flags))
+	}
+	if other.CO != nil {
+		if this.CO == nil {
+			this.CO = MakeArray()
+		}
+		for _, obj := range *other.CO {
+			this.CO.Append(obj)
+		}
+	}
+
+	// Page numbers refer to the documents of the forms, and are not valid for the merged form.
+	this.widgetPages = nil
+	this.pageNumbers = nil
+	this.pages = append(this.pages, other.pages...)
+
+	return nil
+}
+
+// mergeDefaultResources adds the fonts of the default resources of `other` to the default resources of the form.
+// Returns the fonts of `other` that were renamed as their names are used by other fonts in the form.
+func (this *PdfAcroForm) mergeDefaultResources(other *PdfAcroForm) (map[PdfObjectName]PdfObjectName, error) {
+	renames := map[PdfObjectName]PdfObjectName{}
+	if other.DR == nil {
+		return renames, nil
+	}
+	otherFonts, ok := TraceToDirectObject(other.DR.Font).(*PdfObjectDictionary)
+	if !ok {
+		return renames, nil
+	}
+	if this.DR == nil {
+		this.DR = NewPdfPageResources()
+	}
+
+	for _, name := range otherFonts.Keys() {
+		font := otherFonts.Get(name)
+		newName := name
+		for i := 2; ; i++ {
+			existing, has := this.DR.GetFontByName(newName)
+			if !has || existing == font {
+				break
+			}
+			newName = PdfObjectName(fmt.Sprintf("%s_%d", name, i))
+		}
+		if newName != name {
+			renames[name] = newName
+		}
+		if err := this.DR.SetFontByName(newName, font); err != nil {
+			return nil, err
+		}
+	}
+	return renames, nil
+}
+
+// walkFields calls `fn` for `field` and its descendant fields, including the fields representing widgets.
+func walkFields(field *PdfField, fn func(field *PdfField)) {
+	fn(field)
+	for _, kid := range field.KidsF {
+		if kidField, ok := kid.(*PdfField); ok {
+			walkFields(kidField, fn)
+		}
+	}
+}
+
+// renameDAFonts returns default appearance string `da` with the font names of its Tf operators renamed according
+// to `renames`.
+func renameDAFonts(da string, renames map[PdfObjectName]PdfObjectName) string {
+	tokens := strings.Fields(da)
+	changed := false
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i+2] != "Tf" || !strings.HasPrefix(tokens[i], "/") {
+			continue
+		}
+		if newName, has := renames[PdfObjectName(tokens[i][1:])]; has {
+			tokens[i] = "/" + string(newName)
+			changed = true
+		}
+	}
+	if !changed {
+		return da
+	}
+	return strings.Join(tokens, " ")
+}
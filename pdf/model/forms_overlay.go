@@ -0,0 +1,147 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// FieldOverlayOptions are the options of PdfReader.FieldOverlay.
+type FieldOverlayOptions struct {
+	// Opacity of the rectangles filling the widgets. Defaults to 0.25.
+	Opacity float64
+
+	// Font size of the field names. Defaults to 6.
+	FontSize float64
+}
+
+// fieldOverlayColors are the colors of the widget rectangles by field type.
+var fieldOverlayColors = map[string][3]float64{
+	"Tx":  {0, 0.4, 1},
+	"Btn": {0, 0.7, 0},
+	"Ch":  {1, 0.5, 0},
+	"Sig": {0.9, 0, 0},
+}
+
+// FieldOverlay returns a writer with the pages of the document and its form, where each widget of the form fields
+// is stamped with a translucent rectangle colored by field type (blue for text, green for buttons, orange for
+// choices, red for signatures, gray otherwise) and the fully qualified name of its field. Meant for debugging the
+// coordinates and names used by form filling integrations. The overlay of a page is an annotation added after the
+// widgets, so that it is shown above them. The pages of the reader are not modified: the writer has copies of
+// them, to which the references of the document to the pages are remapped (SetReferenceRemap).
+// A nil `opts` uses the default options.
+func (this *PdfReader) FieldOverlay(opts *FieldOverlayOptions) (*PdfWriter, error) {
+	if opts == nil {
+		opts = &FieldOverlayOptions{}
+	}
+	opacity := opts.Opacity
+	if opacity <= 0 {
+		opacity = 0.25
+	}
+	fontSize := opts.FontSize
+	if fontSize <= 0 {
+		fontSize = 6
+	}
+
+	// Overlay content by page number.
+	overlays := map[int]*bytes.Buffer{}
+	if this.AcroForm != nil {
+		encoder := textencoding.NewWinAnsiTextEncoder()
+		for _, terminal := range this.AcroForm.FieldsFlattened() {
+			color, ok := fieldOverlayColors[terminal.Field.getFieldType()]
+			if !ok {
+				color = [3]float64{0.5, 0.5, 0.5}
+			}
+			label := MakeString(encoder.Encode(terminal.FullName))
+			for i, widget := range terminal.Widgets {
+				pageNum := terminal.WidgetPages[i]
+				rect, ok := annotationRect(widget)
+				if pageNum < 1 || pageNum > len(this.PageList) || !ok {
+					common.Log.Debug("Widget %d of %s not on a page", i, terminal.FullName)
+					continue
+				}
+				buf, ok := overlays[pageNum]
+				if !ok {
+					buf = &bytes.Buffer{}
+					overlays[pageNum] = buf
+				}
+				width, height := rect.Urx-rect.Llx, rect.Ury-rect.Lly
+				fmt.Fprintf(buf, "q /GSFieldOverlay gs %.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f Q\n",
+					color[0], color[1], color[2], rect.Llx, rect.Lly, width, height)
+				fmt.Fprintf(buf, "q %.3f %.3f %.3f RG 0.5 w %.2f %.2f %.2f %.2f re S Q\n",
+					color[0], color[1], color[2], rect.Llx, rect.Lly, width, height)
+				fmt.Fprintf(buf, "BT /FFieldOverlay %.2f Tf 0 g %.2f %.2f Td %s Tj ET\n",
+					fontSize, rect.Llx+1, rect.Ury-fontSize, label.DefaultWriteString())
+			}
+		}
+	}
+
+	gs := MakeDict()
+	gs.Set("Type", MakeName("ExtGState"))
+	gs.Set("ca", MakeFloat(opacity))
+	resources := NewPdfPageResources()
+	if err := resources.AddExtGState("GSFieldOverlay", gs); err != nil {
+		return nil, err
+	}
+	if err := resources.SetFontByName("FFieldOverlay", fonts.NewFontHelvetica().ToPdfObject()); err != nil {
+		return nil, err
+	}
+
+	w := NewPdfWriter()
+	remap := map[PdfObject]PdfObject{}
+	for i, page := range this.PageList {
+		dup := page.Duplicate()
+		remap[page.GetPageAsIndirectObject()] = dup.GetPageAsIndirectObject()
+		if buf, ok := overlays[i+1]; ok {
+			annot, err := newFieldOverlayAnnotation(dup, resources, buf.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			dup.Annotations = append(append([]*PdfAnnotation{}, page.Annotations...), annot)
+		}
+		if err := w.AddPage(dup); err != nil {
+			return nil, err
+		}
+	}
+	w.SetReferenceRemap(remap)
+	if this.AcroForm != nil && this.AcroForm.Fields != nil && len(*this.AcroForm.Fields) > 0 {
+		if err := w.SetForms(this.AcroForm); err != nil {
+			return nil, err
+		}
+	}
+	return &w, nil
+}
+
+// newFieldOverlayAnnotation returns an annotation covering the media box of `page`, whose appearance is the
+// overlay `content` with `resources`.
+func newFieldOverlayAnnotation(page *PdfPage, resources *PdfPageResources, content []byte) (*PdfAnnotation, error) {
+	mbox, err := page.GetMediaBox()
+	if err != nil {
+		return nil, err
+	}
+	bbox := MakeArrayFromFloats([]float64{mbox.Llx, mbox.Lly, mbox.Urx, mbox.Ury})
+
+	xform := NewXObjectForm()
+	xform.BBox = bbox
+	xform.Resources = resources
+	if err := xform.SetContentStream(content, nil); err != nil {
+		return nil, err
+	}
+	ap := MakeDict()
+	ap.Set("N", xform.ToPdfObject())
+
+	stamp := NewPdfAnnotationStamp()
+	stamp.Rect = bbox
+	stamp.F = MakeInteger(annotFlagPrint)
+	stamp.AP = ap
+	return stamp.PdfAnnotation, nil
+}
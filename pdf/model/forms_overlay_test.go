@@ -0,0 +1,91 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestFieldOverlay(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testFieldHierarchyObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	numAnnots := []int{len(reader.PageList[0].Annotations), len(reader.PageList[1].Annotations)}
+
+	w, err := reader.FieldOverlay(nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	// The pages of the reader are not modified.
+	for i, page := range reader.PageList {
+		if len(page.Annotations) != numAnnots[i] || page.HasExtGState("GSFieldOverlay") {
+			t.Errorf("Page %d of the reader modified", i+1)
+		}
+	}
+
+	output, err := NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if output.AcroForm == nil {
+		t.Fatalf("Form not kept")
+	}
+
+	expected := [][]string{
+		{"(address.city) Tj", "(address.zip) Tj", "0.00 0.00 10.00 10.00 re f"},
+		{"(name) Tj", "(address.zip) Tj"},
+	}
+	for i, labels := range expected {
+		// The overlay is the last annotation, above the widgets.
+		annots := output.PageList[i].Annotations
+		if len(annots) != numAnnots[i]+1 {
+			t.Fatalf("Page %d: %d annotations", i+1, len(annots))
+		}
+		stamp, ok := annots[len(annots)-1].GetContext().(*PdfAnnotationStamp)
+		if !ok {
+			t.Fatalf("Page %d: overlay annotation missing", i+1)
+		}
+		ap, ok := TraceToDirectObject(stamp.AP).(*PdfObjectDictionary)
+		if !ok {
+			t.Fatalf("Page %d: overlay appearance missing", i+1)
+		}
+		xform, err := NewXObjectFormFromStream(TraceToDirectObject(ap.Get("N")).(*PdfObjectStream))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		contents, err := xform.GetContentStream()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		for _, label := range labels {
+			if !strings.Contains(string(contents), label) {
+				t.Errorf("Page %d: %q missing from %q", i+1, label, contents)
+			}
+		}
+		if xform.Resources == nil || !xform.Resources.HasFontByName("FFieldOverlay") {
+			t.Fatalf("Page %d: overlay resources missing", i+1)
+		}
+		if _, ok := xform.Resources.GetExtGState("GSFieldOverlay"); !ok {
+			t.Errorf("Page %d: overlay resources missing", i+1)
+		}
+	}
+
+	// Widgets refer to the pages of the output.
+	widget := output.PageList[0].Annotations[0].GetContext().(*PdfAnnotationWidget)
+	if widget.P != output.PageList[0].GetPageAsIndirectObject() {
+		t.Errorf("Widget page not remapped: %v", widget.P)
+	}
+}
@@ -0,0 +1,267 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// makeTestPdf returns a PDF file with objects `objects` (numbered from 1) and the catalog as object 1.
+func makeTestPdf(objects []string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	offsets := []int{}
+	for i, obj := range objects {
+		offsets = append(offsets, buf.Len())
+		buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+	xref := buf.Len()
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objects)+1))
+	for _, offset := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", offset))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref))
+	return buf.Bytes()
+}
+
+// testFieldHierarchyObjects are the objects of a document with a field hierarchy of terminal and non-terminal
+// fields on two pages.
+var testFieldHierarchyObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R >>",
+	"<< /Type /Pages /Kids [4 0 R 5 0 R] /Count 2 >>",
+	"<< /Fields [6 0 R 9 0 R] /CO [11 0 R 7 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [7 0 R 8 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [10 0 R] >>",
+	// Non-terminal field.
+	"<< /T (address) /Kids [7 0 R 11 0 R] >>",
+	// Terminal field with merged widget.
+	"<< /T (city) /FT /Tx /Parent 6 0 R /Type /Annot /Subtype /Widget /Rect [0 0 10 10] /P 4 0 R >>",
+	"<< /Type /Annot /Subtype /Widget /Parent 11 0 R /Rect [0 20 10 30] >>",
+	// Merged widget that is not in the page annotations, the page is found from P.
+	"<< /T (name) /FT /Tx /Type /Annot /Subtype /Widget /Rect [0 0 10 10] /P 5 0 R >>",
+	"<< /Type /Annot /Subtype /Widget /Parent 11 0 R /Rect [0 40 10 50] >>",
+	// Terminal field with two widgets on different pages.
+	"<< /T (zip) /FT /Tx /Parent 6 0 R /Kids [8 0 R 10 0 R] >>",
+}
+
+func TestFieldsFlattened(t *testing.T) {
+	data := makeTestPdf(testFieldHierarchyObjects)
+
+	reader, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	if reader.AcroForm == nil {
+		t.Fatalf("No AcroForm")
+	}
+
+	terminals := reader.AcroForm.FieldsFlattened()
+	expected := []struct {
+		name  string
+		pages []int
+	}{
+		{"address.city", []int{1}},
+		{"address.zip", []int{1, 2}},
+		{"name", []int{2}},
+	}
+	if len(terminals) != len(expected) {
+		t.Fatalf("Expected %d terminal fields, got %d", len(expected), len(terminals))
+	}
+	for i, terminal := range terminals {
+		if terminal.FullName != expected[i].name {
+			t.Errorf("Field %d: name %q != %q", i, terminal.FullName, expected[i].name)
+		}
+		if len(terminal.Widgets) != len(expected[i].pages) || fmt.Sprint(terminal.WidgetPages) != fmt.Sprint(expected[i].pages) {
+			t.Errorf("Field %s: %d widgets on pages %v, expected %v", terminal.FullName, len(terminal.Widgets),
+				terminal.WidgetPages, expected[i].pages)
+		}
+		if !terminal.Field.IsTerminal() {
+			t.Errorf("Field %s should be terminal", terminal.FullName)
+		}
+	}
+
+	if (*reader.AcroForm.Fields)[0].IsTerminal() {
+		t.Errorf("address should not be terminal")
+	}
+}
+
+// makeTestFormPdf returns a single page PDF with a text field `name` and default appearance `da`.
+func makeTestFormPdf(name, da string) []byte {
+	return makeTestPdf([]string{
+		"<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R >>",
+		"<< /Type /Pages /Kids [4 0 R] /Count 1 >>",
+		"<< /Fields [5 0 R] /DR << /Font << /Helv 6 0 R >> >> /DA (" + da + ") >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Annots [5 0 R] >>",
+		"<< /T (" + name + ") /FT /Tx /Type /Annot /Subtype /Widget /Rect [0 0 10 10] /P 4 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	})
+}
+
+func TestMergeForms(t *testing.T) {
+	testcases := []struct {
+		opts  FieldMergeOptions
+		names []string
+	}{
+		{FieldMergeOptions{Strategy: FieldMergePrefix}, []string{"name", "copy_name"}},
+		{FieldMergeOptions{Strategy: FieldMergeSuffix, Affix: "_2"}, []string{"name", "name_2"}},
+		{FieldMergeOptions{Strategy: FieldMergeReparent, ParentName: "doc2"}, []string{"name", "doc2.name"}},
+	}
+
+	for _, tcase := range testcases {
+		reader1, err := NewPdfReader(bytes.NewReader(makeTestFormPdf("name", "/Helv 0 Tf 0 g")))
+		if err != nil {
+			t.Fatalf("Error reading: %v", err)
+		}
+		reader2, err := NewPdfReader(bytes.NewReader(makeTestFormPdf("name", "/Helv 12 Tf 1 0 0 rg")))
+		if err != nil {
+			t.Fatalf("Error reading: %v", err)
+		}
+
+		form := reader1.AcroForm
+		if err := form.Merge(reader2.AcroForm, tcase.opts); err != nil {
+			t.Fatalf("Error merging: %v", err)
+		}
+
+		// Write the pages of both documents with the merged form and check the fields of the output.
+		w := NewPdfWriter()
+		for _, reader := range []*PdfReader{reader1, reader2} {
+			page, err := reader.GetPage(1)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if err := w.AddPage(page); err != nil {
+				t.Fatalf("Error adding page: %v", err)
+			}
+		}
+		if err := w.SetForms(form); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		f, err := ioutil.TempFile("", "forms")
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		if err := w.Write(f); err != nil {
+			t.Fatalf("Error writing: %v", err)
+		}
+		f.Seek(0, os.SEEK_SET)
+		merged, err := NewPdfReader(f)
+		if err != nil {
+			t.Fatalf("Error reading output: %v", err)
+		}
+
+		terminals := merged.AcroForm.FieldsFlattened()
+		if len(terminals) != len(tcase.names) {
+			t.Fatalf("Expected %d fields, got %d", len(tcase.names), len(terminals))
+		}
+		for i, terminal := range terminals {
+			if terminal.FullName != tcase.names[i] {
+				t.Errorf("Field %d: %q != %q", i, terminal.FullName, tcase.names[i])
+			}
+			if len(terminal.WidgetPages) != 1 || terminal.WidgetPages[0] != i+1 {
+				t.Errorf("Field %s: widget pages %v", terminal.FullName, terminal.WidgetPages)
+			}
+		}
+
+		// The font of the second form is renamed and its fields use the DA of the second form.
+		if !merged.AcroForm.DR.HasFontByName("Helv") || !merged.AcroForm.DR.HasFontByName("Helv_2") {
+			t.Errorf("Fonts not merged: %s", merged.AcroForm.DR.Font)
+		}
+		da := ""
+		for field := terminals[1].Field; field != nil && da == ""; field = field.Parent {
+			if str, ok := field.DA.(*core.PdfObjectString); ok {
+				da = string(*str)
+			}
+		}
+		if da != "/Helv_2 12 Tf 1 0 0 rg" {
+			t.Errorf("Unexpected DA of merged field: %q", da)
+		}
+	}
+}
+
+func TestRemoveField(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testFieldHierarchyObjects)))
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	form := reader.AcroForm
+
+	if err := form.RemoveField("zip"); err != ErrFieldNotFound {
+		t.Errorf("Expected ErrFieldNotFound, got %v", err)
+	}
+	if err := form.RemoveField("address.zip"); err != nil {
+		t.Fatalf("Error removing field: %v", err)
+	}
+
+	terminals := form.FieldsFlattened()
+	if len(terminals) != 2 || terminals[0].FullName != "address.city" || terminals[1].FullName != "name" {
+		t.Errorf("Unexpected fields after removal: %d", len(terminals))
+	}
+	if form.CO == nil || len(*form.CO) != 1 {
+		t.Errorf("Calculation order not updated: %v", form.CO)
+	}
+	for i, n := range []int{1, 0} {
+		page, err := reader.GetPage(i + 1)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if len(page.Annotations) != n {
+			t.Errorf("Page %d: %d annotations, expected %d", i+1, len(page.Annotations), n)
+		}
+	}
+
+	// Removing a non-terminal field removes its descendants.
+	if err := form.RemoveField("address"); err != nil {
+		t.Fatalf("Error removing field: %v", err)
+	}
+	if len(*form.Fields) != 1 || len(*form.CO) != 0 {
+		t.Errorf("Unexpected form after removal: %d fields, CO %v", len(*form.Fields), form.CO)
+	}
+
+	// The calculation order refers to the written fields.
+	w := NewPdfWriter()
+	for i := 1; i <= 2; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error adding page: %v", err)
+		}
+	}
+	form.CO.Append((*form.Fields)[0].source)
+	if err := w.SetForms(form); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	f, err := ioutil.TempFile("", "forms")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+	written, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading output: %v", err)
+	}
+	terminals = written.AcroForm.FieldsFlattened()
+	if len(terminals) != 1 || terminals[0].FullName != "name" {
+		t.Fatalf("Unexpected fields in output")
+	}
+	if written.AcroForm.CO == nil || len(*written.AcroForm.CO) != 1 || (*written.AcroForm.CO)[0] != terminals[0].Field.source {
+		t.Errorf("Invalid calculation order in output: %v", written.AcroForm.CO)
+	}
+}
@@ -46,6 +46,47 @@ func (this Image) AlphaMap(mapFunc AlphaMapFunc) {
 	}
 }
 
+// HasAlpha returns true if the image has alpha channel (transparency) data.
+func (this *Image) HasAlpha() bool {
+	return this.hasAlpha
+}
+
+// GetStencilMask returns a 1 bit image mask from the alpha channel of the image, for use as a stencil mask
+// (XObjectImage.SetStencilMask) for hard transparency without a soft mask. Pixels with an alpha value (scaled to
+// 0-255) of at least `threshold` are opaque (sample 0), others are masked out (sample 1).
+// Returns nil if the image has no alpha channel.
+func (this *Image) GetStencilMask(threshold byte) *Image {
+	if !this.hasAlpha {
+		return nil
+	}
+
+	alpha := sampling.ResampleBytes(this.alphaData, int(this.BitsPerComponent))
+	maxVal := uint32(1)<<uint(this.BitsPerComponent) - 1
+
+	// Rows are padded to a byte boundary.
+	rowBytes := (this.Width + 7) / 8
+	data := make([]byte, rowBytes*this.Height)
+	for y := int64(0); y < this.Height; y++ {
+		for x := int64(0); x < this.Width; x++ {
+			idx := y*this.Width + x
+			if idx >= int64(len(alpha)) {
+				break
+			}
+			if alpha[idx]*255/maxVal < uint32(threshold) {
+				data[y*rowBytes+x/8] |= 0x80 >> uint(x%8)
+			}
+		}
+	}
+
+	mask := &Image{}
+	mask.Width = this.Width
+	mask.Height = this.Height
+	mask.BitsPerComponent = 1
+	mask.ColorComponents = 1
+	mask.Data = data
+	return mask
+}
+
 // Convert the raw byte slice into samples which are stored in a uint32 bit array.
 // Each sample is represented by BitsPerComponent consecutive bits in the raw data.
 func (this *Image) GetSamples() []uint32 {
@@ -6,7 +6,11 @@
 package model
 
 import (
+	goimage "image"
+	gocolor "image/color"
 	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
 )
 
 func TestImageResampling(t *testing.T) {
@@ -64,3 +68,78 @@ func TestImageResampling(t *testing.T) {
 		t.Errorf("Value != 64 (%d)", img.Data[1])
 	}
 }
+
+func TestImageStencilMask(t *testing.T) {
+	// 10x2 image, transparent on the left half of the first row.
+	goimg := goimage.NewNRGBA(goimage.Rect(0, 0, 10, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 10; x++ {
+			alpha := uint8(255)
+			if y == 0 && x < 5 {
+				alpha = 100
+			}
+			goimg.Set(x, y, gocolor.NRGBA{R: 255, A: alpha})
+		}
+	}
+	img, err := ImageHandling.NewImageFromGoImage(goimg)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !img.HasAlpha() {
+		t.Fatalf("Image should have alpha")
+	}
+
+	mask := img.GetStencilMask(128)
+	expected := []byte{0xf8, 0x00, 0x00, 0x00}
+	if mask == nil || string(mask.Data) != string(expected) {
+		t.Fatalf("Mask data mismatch: %v != %v", mask, expected)
+	}
+	if img.GetStencilMask(50).Data[0] != 0 {
+		t.Errorf("Alpha above threshold should be opaque")
+	}
+
+	ximgMask, err := NewXObjectImageMask(mask, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	maskDict := ximgMask.ToPdfObject().(*PdfObjectStream).PdfObjectDictionary
+	if maskDict.Get("ColorSpace") != nil {
+		t.Errorf("Image mask should not have a colorspace")
+	}
+	if b, ok := maskDict.Get("ImageMask").(*PdfObjectBool); !ok || !bool(*b) {
+		t.Errorf("ImageMask not set")
+	}
+	if _, err := NewXObjectImageMask(img, nil); err == nil {
+		t.Errorf("8 bit RGB image should not be a valid image mask")
+	}
+
+	ximg, err := NewXObjectImageFromImage(img, nil, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if ximg.SMask == nil {
+		t.Fatalf("Image should have a soft mask")
+	}
+	if err := ximg.SetStencilMask(ximg); err == nil {
+		t.Errorf("Should not accept a non image mask")
+	}
+	if err := ximg.SetStencilMask(ximgMask); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if ximg.SMask != nil || ximg.Mask != ximgMask.GetContainingPdfObject() {
+		t.Errorf("Stencil mask not set")
+	}
+
+	if err := ximg.SetColorKeyMask([]int64{250, 255, 0, 0}); err != ErrRangeError {
+		t.Errorf("Expected range error for too few ranges, got %v", err)
+	}
+	if err := ximg.SetColorKeyMask([]int64{250, 256, 0, 0, 0, 0}); err != ErrRangeError {
+		t.Errorf("Expected range error for value above 255, got %v", err)
+	}
+	if err := ximg.SetColorKeyMask([]int64{250, 255, 0, 5, 0, 5}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if arr, ok := ximg.Mask.(*PdfObjectArray); !ok || len(*arr) != 6 {
+		t.Errorf("Color key mask not set: %v", ximg.Mask)
+	}
+}
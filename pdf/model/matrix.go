@@ -0,0 +1,141 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Matrix is a 2D affine transformation matrix [a b c d e f] as used for the current transformation matrix (CTM),
+// text matrices and form XObject matrices (section 8.3.4 p. 118). It represents the 3x3 matrix
+//
+//	| a b 0 |
+//	| c d 0 |
+//	| e f 1 |
+//
+// which maps a point (x, y) to (a*x + c*y + e, b*x + d*y + f).
+type Matrix [6]float64
+
+// IdentityMatrix returns the identity transformation.
+func IdentityMatrix() Matrix {
+	return Matrix{1, 0, 0, 1, 0, 0}
+}
+
+// NewMatrix returns the matrix [a b c d e f].
+func NewMatrix(a, b, c, d, e, f float64) Matrix {
+	return Matrix{a, b, c, d, e, f}
+}
+
+// TranslationMatrix returns a matrix translating by (tx, ty).
+func TranslationMatrix(tx, ty float64) Matrix {
+	return Matrix{1, 0, 0, 1, tx, ty}
+}
+
+// ScalingMatrix returns a matrix scaling by sx horizontally and sy vertically.
+func ScalingMatrix(sx, sy float64) Matrix {
+	return Matrix{sx, 0, 0, sy, 0, 0}
+}
+
+// RotationMatrix returns a matrix rotating counter-clockwise by `angle` degrees.
+func RotationMatrix(angle float64) Matrix {
+	rad := angle * math.Pi / 180.0
+	sin, cos := math.Sin(rad), math.Cos(rad)
+	return Matrix{cos, sin, -sin, cos, 0, 0}
+}
+
+// NewMatrixFromPdfObject loads a matrix from a PDF array of 6 numbers, e.g. the Matrix entry of a form XObject.
+func NewMatrixFromPdfObject(obj PdfObject) (Matrix, error) {
+	arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+	if !ok {
+		return IdentityMatrix(), ErrTypeError
+	}
+	if len(*arr) != 6 {
+		common.Log.Debug("ERROR: Matrix array length != 6 (%d)", len(*arr))
+		return IdentityMatrix(), errors.New("Range check error")
+	}
+	vals, err := arr.GetAsFloat64Slice()
+	if err != nil {
+		return IdentityMatrix(), err
+	}
+	return Matrix{vals[0], vals[1], vals[2], vals[3], vals[4], vals[5]}, nil
+}
+
+// Mult returns the product m x b, i.e. the transformation applying m first and then b.
+// For example, a `cm` operation with matrix m sets CTM' = m.Mult(CTM).
+func (m Matrix) Mult(b Matrix) Matrix {
+	return Matrix{
+		m[0]*b[0] + m[1]*b[2],
+		m[0]*b[1] + m[1]*b[3],
+		m[2]*b[0] + m[3]*b[2],
+		m[2]*b[1] + m[3]*b[3],
+		m[4]*b[0] + m[5]*b[2] + b[4],
+		m[4]*b[1] + m[5]*b[3] + b[5],
+	}
+}
+
+// Transform applies the transformation to the point (x, y).
+func (m Matrix) Transform(x, y float64) (float64, float64) {
+	return m[0]*x + m[2]*y + m[4], m[1]*x + m[3]*y + m[5]
+}
+
+// TransformRect returns the bounding box of the rectangle `rect` after transformation.
+func (m Matrix) TransformRect(rect PdfRectangle) PdfRectangle {
+	xs := [4]float64{}
+	ys := [4]float64{}
+	xs[0], ys[0] = m.Transform(rect.Llx, rect.Lly)
+	xs[1], ys[1] = m.Transform(rect.Urx, rect.Lly)
+	xs[2], ys[2] = m.Transform(rect.Urx, rect.Ury)
+	xs[3], ys[3] = m.Transform(rect.Llx, rect.Ury)
+
+	bbox := PdfRectangle{Llx: xs[0], Lly: ys[0], Urx: xs[0], Ury: ys[0]}
+	for i := 1; i < 4; i++ {
+		bbox.Llx = math.Min(bbox.Llx, xs[i])
+		bbox.Lly = math.Min(bbox.Lly, ys[i])
+		bbox.Urx = math.Max(bbox.Urx, xs[i])
+		bbox.Ury = math.Max(bbox.Ury, ys[i])
+	}
+	return bbox
+}
+
+// Inverse returns the inverse transformation. Returns false if the matrix is not invertible.
+func (m Matrix) Inverse() (Matrix, bool) {
+	det := m[0]*m[3] - m[1]*m[2]
+	if math.Abs(det) < 1e-12 {
+		return IdentityMatrix(), false
+	}
+	a := m[3] / det
+	b := -m[1] / det
+	c := -m[2] / det
+	d := m[0] / det
+	e := -(m[4]*a + m[5]*c)
+	f := -(m[4]*b + m[5]*d)
+	return Matrix{a, b, c, d, e, f}, true
+}
+
+// ScalingFactorX returns the horizontal scaling of the transformation, i.e. the length of a unit vector in the x
+// direction after transformation.
+func (m Matrix) ScalingFactorX() float64 {
+	return math.Hypot(m[0], m[1])
+}
+
+// ScalingFactorY returns the vertical scaling of the transformation.
+func (m Matrix) ScalingFactorY() float64 {
+	return math.Hypot(m[2], m[3])
+}
+
+// Angle returns the rotation angle of the transformation in degrees, in the range (-180, 180].
+func (m Matrix) Angle() float64 {
+	return math.Atan2(m[1], m[0]) * 180.0 / math.Pi
+}
+
+// ToPdfObject returns the matrix as a PDF array of 6 numbers.
+func (m Matrix) ToPdfObject() PdfObject {
+	return MakeArrayFromFloats(m[:])
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+// readerConfig is the configuration of a reader built from ReaderOption values.
+type readerConfig struct {
+	opts     ReaderOptions
+	decrypt  bool
+	password []byte
+}
+
+// ReaderOption is an option of NewPdfReaderWith.
+type ReaderOption func(c *readerConfig)
+
+// WithPassword decrypts an encrypted document with `password` when opened. Opening fails if the password is
+// not valid.
+func WithPassword(password string) ReaderOption {
+	return func(c *readerConfig) {
+		c.decrypt = true
+		c.password = []byte(password)
+	}
+}
+
+// WithReaderOptions loads the parts of the document structure selected by `opts`.
+func WithReaderOptions(opts ReaderOptions) ReaderOption {
+	return func(c *readerConfig) {
+		c.opts = opts
+	}
+}
+
+// WithoutAcroForm skips loading the interactive form (see ReaderOptions.SkipAcroForm).
+func WithoutAcroForm() ReaderOption {
+	return func(c *readerConfig) {
+		c.opts.SkipAcroForm = true
+	}
+}
+
+// WithoutOutlines skips loading the outlines (see ReaderOptions.SkipOutlines).
+func WithoutOutlines() ReaderOption {
+	return func(c *readerConfig) {
+		c.opts.SkipOutlines = true
+	}
+}
+
+// WithoutAnnotations skips loading the page annotations (see ReaderOptions.SkipAnnotations).
+func WithoutAnnotations() ReaderOption {
+	return func(c *readerConfig) {
+		c.opts.SkipAnnotations = true
+	}
+}
+
+// WriterOption is an option of NewPdfWriterWith.
+type WriterOption func(w *PdfWriter)
+
+// WithVersion sets the PDF version of the output (see PdfWriter.SetVersion).
+func WithVersion(majorVersion, minorVersion int) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetVersion(majorVersion, minorVersion)
+	}
+}
+
+// WithCompression compresses the streams at write time (see PdfWriter.SetCompression).
+func WithCompression(options *CompressionOptions) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetCompression(options)
+	}
+}
+
+// WithProducer sets the Producer entry of the document information.
+func WithProducer(producer string) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetDocInfo("Producer", producer)
+	}
+}
+
+// WithCreator sets the Creator entry of the document information.
+func WithCreator(creator string) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetDocInfo("Creator", creator)
+	}
+}
+
+// WithGarbageCollection removes unreferenced objects at write time (see PdfWriter.SetGarbageCollection).
+func WithGarbageCollection() WriterOption {
+	return func(w *PdfWriter) {
+		w.SetGarbageCollection(true)
+	}
+}
+
+// WithStreamDeduplication writes identical streams once (see PdfWriter.SetStreamDeduplication).
+func WithStreamDeduplication() WriterOption {
+	return func(w *PdfWriter) {
+		w.SetStreamDeduplication(true)
+	}
+}
+
+// WithProgressCallback calls `fn` after each object written (see PdfWriter.SetProgressCallback).
+func WithProgressCallback(fn func(WriteProgress)) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetProgressCallback(fn)
+	}
+}
+
+// WithHooks adds writer hooks `hooks` (see PdfWriter.AddHooks).
+func WithHooks(hooks WriterHooks) WriterOption {
+	return func(w *PdfWriter) {
+		w.AddHooks(hooks)
+	}
+}
+
+// WithDocumentIDPolicy sets how the file identifier is generated (see PdfWriter.SetDocumentIDPolicy).
+func WithDocumentIDPolicy(policy DocumentIDPolicy) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetDocumentIDPolicy(policy)
+	}
+}
+
+// WithDocumentID sets the file identifier (see PdfWriter.SetDocumentID).
+func WithDocumentID(permanent, changing []byte) WriterOption {
+	return func(w *PdfWriter) {
+		w.SetDocumentID(permanent, changing)
+	}
+}
+
+// NewPdfWriterWith returns a new PdfWriter like NewPdfWriter, configured by `opts`.
+func NewPdfWriterWith(opts ...WriterOption) PdfWriter {
+	w := NewPdfWriter()
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return w
+}
@@ -0,0 +1,71 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestWriterReaderOptions(t *testing.T) {
+	w := NewPdfWriterWith(
+		WithVersion(1, 5),
+		WithProducer("Test Producer"),
+		WithCreator("Test Creator"),
+		WithGarbageCollection(),
+	)
+	page := NewPdfPage()
+	page.Resources = NewPdfPageResources()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := w.Encrypt([]byte("secret"), []byte("owner"), nil); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !bytes.HasPrefix(ws.buf, []byte("%PDF-1.5")) {
+		t.Errorf("Unexpected header %q", ws.buf[:8])
+	}
+
+	if _, err := NewPdfReaderWith(bytes.NewReader(ws.buf), WithPassword("wrong")); err == nil {
+		t.Errorf("Expected an error with a wrong password")
+	}
+
+	reader, err := NewPdfReaderWith(bytes.NewReader(ws.buf), WithPassword("secret"), WithoutAnnotations())
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil || numPages != 1 {
+		t.Fatalf("Unexpected page count %d (%v)", numPages, err)
+	}
+	if !reader.opts.SkipAnnotations {
+		t.Errorf("Annotations not skipped")
+	}
+	reader, err = NewPdfReaderWith(bytes.NewReader(ws.buf), WithPassword("secret"),
+		WithReaderOptions(ReaderOptions{SkipOutlines: true}))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if reader.opts != (ReaderOptions{SkipOutlines: true}) {
+		t.Errorf("Unexpected reader options %+v", reader.opts)
+	}
+	info, err := reader.GetDocInfo()
+	if err != nil || info == nil {
+		t.Fatalf("Missing Info dictionary (%v)", err)
+	}
+	for key, expected := range map[PdfObjectName]string{"Producer": "Test Producer", "Creator": "Test Creator"} {
+		s, ok := TraceToDirectObject(info.Get(key)).(*PdfObjectString)
+		if !ok || string(*s) != expected {
+			t.Errorf("%s: got %v, expected %q", key, info.Get(key), expected)
+		}
+	}
+}
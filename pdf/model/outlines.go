@@ -57,7 +57,7 @@ func NewPdfOutline() *PdfOutline {
 
 func NewPdfOutlineTree() *PdfOutline {
 	outlineTree := NewPdfOutline()
-	outlineTree.context = &outlineTree
+	outlineTree.context = outlineTree
 	return outlineTree
 }
 
@@ -68,6 +68,7 @@ func NewPdfOutlineItem() *PdfOutlineItem {
 	container.PdfObject = MakeDict()
 
 	outlineItem.primitive = container
+	outlineItem.context = outlineItem
 	return outlineItem
 }
 
@@ -235,6 +236,9 @@ func (this *PdfOutline) ToPdfObject() PdfObject {
 	dict := container.PdfObject.(*PdfObjectDictionary)
 
 	dict.Set("Type", MakeName("Outlines"))
+	if this.Count != nil {
+		dict.Set("Count", MakeInteger(*this.Count))
+	}
 
 	if this.First != nil {
 		dict.Set("First", this.First.ToPdfObject())
@@ -0,0 +1,180 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// OutlineEntry is an outline item (bookmark) in a simple form for exchanging outlines as JSON with other tools,
+// e.g. {"title": "Chapter 1", "page": 3, "zoom": 1.5, "children": [...]}.
+type OutlineEntry struct {
+	Title string `json:"title"`
+
+	// Page number of the destination (starting from 1), 0 if the item has no destination in the document, e.g.
+	// a named destination or a destination in another document.
+	Page int `json:"page,omitempty"`
+
+	// Zoom factor of the destination, where 1 is 100%. 0 means that the page is fitted in the window.
+	Zoom float64 `json:"zoom,omitempty"`
+
+	// Open is true if the children of the item are shown.
+	Open bool `json:"open,omitempty"`
+
+	Children []*OutlineEntry `json:"children,omitempty"`
+}
+
+// GetOutlineEntries returns the outline tree of the document as outline entries.
+func (this *PdfReader) GetOutlineEntries() []*OutlineEntry {
+	pageNumbers := map[PdfObject]int{}
+	for i, page := range this.pageList {
+		pageNumbers[page] = i + 1
+	}
+
+	var getEntries func(node *PdfOutlineTreeNode) []*OutlineEntry
+	getEntries = func(node *PdfOutlineTreeNode) []*OutlineEntry {
+		var entries []*OutlineEntry
+		for node != nil {
+			item, ok := node.context.(*PdfOutlineItem)
+			if !ok {
+				common.Log.Debug("ERROR: Outline node not an item (%T)", node.context)
+				break
+			}
+			entry := &OutlineEntry{
+				Title:    DecodeTextString(*item.Title),
+				Open:     item.Count != nil && *item.Count > 0,
+				Children: getEntries(item.First),
+			}
+			entry.Page, entry.Zoom = getOutlineDestination(item, pageNumbers)
+			entries = append(entries, entry)
+			node = item.Next
+		}
+		return entries
+	}
+
+	entries := []*OutlineEntry{}
+	if this.outlineTree != nil {
+		entries = append(entries, getEntries(this.outlineTree.First)...)
+	}
+	return entries
+}
+
+// getOutlineDestination returns the page number and zoom of the explicit destination of `item`, either its Dest
+// or the destination of its GoTo action. `pageNumbers` maps the page objects of the document to page numbers.
+func getOutlineDestination(item *PdfOutlineItem, pageNumbers map[PdfObject]int) (int, float64) {
+	dest := TraceToDirectObject(item.Dest)
+	if action, ok := TraceToDirectObject(item.A).(*PdfObjectDictionary); ok && dest == nil {
+		if s, ok := TraceToDirectObject(action.Get("S")).(*PdfObjectName); ok && *s == "GoTo" {
+			dest = TraceToDirectObject(action.Get("D"))
+		}
+	}
+
+	arr, ok := dest.(*PdfObjectArray)
+	if !ok || len(*arr) == 0 {
+		// Named destinations are not resolved.
+		common.Log.Trace("Outline item without explicit destination (%T)", dest)
+		return 0, 0
+	}
+	page := pageNumbers[(*arr)[0]]
+	zoom := 0.0
+	if len(*arr) < 5 {
+		return page, zoom
+	}
+	if name, ok := TraceToDirectObject((*arr)[1]).(*PdfObjectName); ok && *name == "XYZ" {
+		if z, err := getNumberAsFloat(TraceToDirectObject((*arr)[4])); err == nil && z > 0 {
+			zoom = z
+		}
+	}
+	return page, zoom
+}
+
+// NewOutlineTreeFromEntries returns an outline tree with the items of `entries`, with destinations on `pages`,
+// e.g. for PdfWriter.AddOutlineTree. The page numbers of the entries refer to `pages`. Returns ErrRangeError if an
+// entry refers to a page not in `pages`.
+func NewOutlineTreeFromEntries(entries []*OutlineEntry, pages []*PdfPage) (*PdfOutlineTreeNode, error) {
+	outline := NewPdfOutlineTree()
+	items, visible, err := newOutlineItems(entries, &outline.PdfOutlineTreeNode, pages)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > 0 {
+		outline.First = &items[0].PdfOutlineTreeNode
+		outline.Last = &items[len(items)-1].PdfOutlineTreeNode
+		outline.Count = &visible
+	}
+	return &outline.PdfOutlineTreeNode, nil
+}
+
+// newOutlineItems returns the linked outline items of `entries` under `parent` and the number of visible
+// descendants of `parent`, i.e. the items and the visible descendants of open items.
+func newOutlineItems(entries []*OutlineEntry, parent *PdfOutlineTreeNode, pages []*PdfPage) ([]*PdfOutlineItem, int64, error) {
+	items := []*PdfOutlineItem{}
+	visible := int64(0)
+	for _, entry := range entries {
+		item := NewPdfOutlineItem()
+		item.Title = EncodeTextString(entry.Title)
+		item.Parent = parent
+
+		if entry.Page != 0 {
+			if entry.Page < 1 || entry.Page > len(pages) {
+				common.Log.Debug("ERROR: Outline item %q page %d out of range", entry.Title, entry.Page)
+				return nil, 0, ErrRangeError
+			}
+			page := pages[entry.Page-1].GetContainingPdfObject()
+			if entry.Zoom > 0 {
+				item.Dest = MakeArray(page, MakeName("XYZ"), MakeNull(), MakeNull(), MakeFloat(entry.Zoom))
+			} else {
+				item.Dest = MakeArray(page, MakeName("Fit"))
+			}
+		}
+
+		children, count, err := newOutlineItems(entry.Children, &item.PdfOutlineTreeNode, pages)
+		if err != nil {
+			return nil, 0, err
+		}
+		visible++
+		if len(children) > 0 {
+			item.First = &children[0].PdfOutlineTreeNode
+			item.Last = &children[len(children)-1].PdfOutlineTreeNode
+			// The count is negative for closed items.
+			if entry.Open {
+				visible += count
+			} else {
+				count = -count
+			}
+			item.Count = &count
+		}
+
+		if len(items) > 0 {
+			prev := items[len(items)-1]
+			prev.Next = &item.PdfOutlineTreeNode
+			item.Prev = &prev.PdfOutlineTreeNode
+		}
+		items = append(items, item)
+	}
+	return items, visible, nil
+}
+
+// ReadOutlineEntriesJSON reads a JSON array of outline entries from `r`.
+func ReadOutlineEntriesJSON(r io.Reader) ([]*OutlineEntry, error) {
+	entries := []*OutlineEntry{}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		common.Log.Debug("ERROR: Invalid outline JSON: %v", err)
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WriteOutlineEntriesJSON writes `entries` as an indented JSON array to `w`.
+func WriteOutlineEntriesJSON(w io.Writer, entries []*OutlineEntry) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
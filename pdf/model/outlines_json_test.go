@@ -0,0 +1,99 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testOutlineObjects are the objects of a two page document with an outline with an XYZ destination and a GoTo
+// action.
+var testOutlineObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /Outlines 5 0 R >>",
+	"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] >>",
+	"<< /Type /Outlines /First 6 0 R /Last 7 0 R /Count 3 >>",
+	"<< /Title (Intro) /Parent 5 0 R /Next 7 0 R /Dest [3 0 R /XYZ 0 792 1.5] >>",
+	"<< /Title (Part) /Parent 5 0 R /Prev 6 0 R /First 8 0 R /Last 8 0 R /Count 1 >>",
+	"<< /Title (Chapter) /Parent 7 0 R /A << /S /GoTo /D [4 0 R /Fit] >> >>",
+}
+
+func TestGetOutlineEntries(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testOutlineObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	expected := []*OutlineEntry{
+		{Title: "Intro", Page: 1, Zoom: 1.5},
+		{Title: "Part", Open: true, Children: []*OutlineEntry{{Title: "Chapter", Page: 2}}},
+	}
+	if entries := reader.GetOutlineEntries(); !reflect.DeepEqual(entries, expected) {
+		var buf bytes.Buffer
+		WriteOutlineEntriesJSON(&buf, entries)
+		t.Errorf("Unexpected entries: %s", buf.String())
+	}
+}
+
+func TestOutlineJSONRoundtrip(t *testing.T) {
+	json := `[
+		{"title": "One", "page": 2, "zoom": 2, "open": true, "children": [
+			{"title": "One.One", "page": 1},
+			{"title": "One.Two", "children": [{"title": "Deep", "page": 2}]}
+		]},
+		{"title": "Two", "page": 1}
+	]`
+	entries, err := ReadOutlineEntriesJSON(strings.NewReader(json))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testOutlineObjects[:4])))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if _, err := NewOutlineTreeFromEntries(entries, reader.PageList[:1]); err != ErrRangeError {
+		t.Errorf("Expected ErrRangeError, got %v", err)
+	}
+	tree, err := NewOutlineTreeFromEntries(entries, reader.PageList)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	w := NewPdfWriter()
+	for _, page := range reader.PageList {
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+	w.AddOutlineTree(tree)
+
+	f, err := ioutil.TempFile("", "outlines")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+
+	written, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got := written.GetOutlineEntries(); !reflect.DeepEqual(got, entries) {
+		var buf bytes.Buffer
+		WriteOutlineEntriesJSON(&buf, got)
+		t.Errorf("Unexpected entries after roundtrip: %s", buf.String())
+	}
+}
@@ -15,6 +15,7 @@ package model
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/unidoc/unidoc/common"
@@ -54,6 +55,12 @@ type PdfPage struct {
 
 	Annotations []*PdfAnnotation
 
+	// Annots entry kept as is when loading the annotations is skipped (see ReaderOptions).
+	annots PdfObject
+
+	// Page template (Type Template) outside the page tree, see PdfWriter.AddTemplate.
+	isTemplate bool
+
 	// Primitive container.
 	pageDict  *PdfObjectDictionary
 	primitive *PdfIndirectObject
@@ -97,7 +104,9 @@ func (reader *PdfReader) newPdfPageFromDict(p *PdfObjectDictionary) (*PdfPage, e
 	if !ok {
 		return nil, errors.New("Missing/Invalid Page dictionary Type")
 	}
-	if *pType != "Page" {
+	if *pType == "Template" {
+		page.isTemplate = true
+	} else if *pType != "Page" {
 		return nil, errors.New("Page dictionary Type != Page")
 	}
 
@@ -300,6 +309,11 @@ func (reader *PdfReader) newPdfPageFromDict(p *PdfObjectDictionary) (*PdfPage, e
 		page.VP = obj
 	}
 
+	if reader.opts.SkipAnnotations {
+		page.annots = d.Get("Annots")
+		return page, nil
+	}
+
 	var err error
 	page.Annotations, err = reader.LoadAnnotations(&d)
 	if err != nil {
@@ -400,6 +414,75 @@ func (this *PdfPage) GetMediaBox() (*PdfRectangle, error) {
 	return nil, errors.New("Media box not defined")
 }
 
+// MaxPageDimension is the maximum width and height of a page in default user space units (Annex C.2).
+// Larger pages are represented with a UserUnit greater than 1.
+const MaxPageDimension = 14400.0
+
+// GetUserUnit returns the size of a default user space unit of the page in multiples of 1/72 inch (UserUnit entry,
+// PDF 1.6). Returns 1 if not set or invalid.
+func (this *PdfPage) GetUserUnit() float64 {
+	if this.UserUnit == nil {
+		return 1
+	}
+	unit, err := getNumberAsFloat(TraceToDirectObject(this.UserUnit))
+	if err != nil || unit <= 0 {
+		common.Log.Debug("Invalid UserUnit %v - using 1", this.UserUnit)
+		return 1
+	}
+	return unit
+}
+
+// SetUserUnit sets the size of a default user space unit of the page in multiples of 1/72 inch. The UserUnit entry
+// is removed if `unit` is 1. Requires PDF 1.6, see PdfWriter.AddPage.
+func (this *PdfPage) SetUserUnit(unit float64) error {
+	if unit <= 0 {
+		common.Log.Debug("ERROR: UserUnit must be positive (%f)", unit)
+		return ErrRangeError
+	}
+	if unit == 1 {
+		this.UserUnit = nil
+		return nil
+	}
+	this.UserUnit = MakeFloat(unit)
+	return nil
+}
+
+// GetUserUnitMatrix returns the matrix transforming default user space coordinates of the page to points
+// (1/72 inch), i.e. scaling by the UserUnit.
+func (this *PdfPage) GetUserUnitMatrix() Matrix {
+	unit := this.GetUserUnit()
+	return ScalingMatrix(unit, unit)
+}
+
+// GetPhysicalMediaBox returns the media box of the page in points (1/72 inch), i.e. scaled by the UserUnit.
+func (this *PdfPage) GetPhysicalMediaBox() (*PdfRectangle, error) {
+	mbox, err := this.GetMediaBox()
+	if err != nil {
+		return nil, err
+	}
+	unit := this.GetUserUnit()
+	return &PdfRectangle{Llx: mbox.Llx * unit, Lly: mbox.Lly * unit, Urx: mbox.Urx * unit, Ury: mbox.Ury * unit}, nil
+}
+
+// SetPhysicalMediaBox sets the media box of the page to `width` x `height` points (1/72 inch) with the origin at
+// (0,0). Dimensions above MaxPageDimension, e.g. for engineering drawings, are represented by setting the
+// UserUnit to the smallest integer for which the media box fits, otherwise the UserUnit is removed.
+// Returns the UserUnit, which scales all coordinates of the page contents.
+func (this *PdfPage) SetPhysicalMediaBox(width, height float64) (float64, error) {
+	if width <= 0 || height <= 0 {
+		common.Log.Debug("ERROR: Invalid page size %f x %f", width, height)
+		return 0, ErrRangeError
+	}
+
+	unit := math.Ceil(math.Max(width, height) / MaxPageDimension)
+	if unit < 1 {
+		unit = 1
+	}
+	this.SetUserUnit(unit)
+	this.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: width / unit, Ury: height / unit}
+	return unit, nil
+}
+
 // Get the inheritable resources, either from the page or or a higher up page/pages struct.
 func (this *PdfPage) getResources() (*PdfPageResources, error) {
 	if this.Resources != nil {
@@ -443,8 +526,13 @@ func (this *PdfPage) getResources() (*PdfPageResources, error) {
 // Convert the Page to a PDF object dictionary.
 func (this *PdfPage) GetPageDict() *PdfObjectDictionary {
 	p := this.pageDict
-	p.Set("Type", MakeName("Page"))
-	p.Set("Parent", this.Parent)
+	if this.isTemplate {
+		p.Set("Type", MakeName("Template"))
+		p.SetIfNotNil("Parent", this.Parent)
+	} else {
+		p.Set("Type", MakeName("Page"))
+		p.Set("Parent", this.Parent)
+	}
 
 	if this.LastModified != nil {
 		p.Set("LastModified", this.LastModified.ToPdfObject())
@@ -503,6 +591,8 @@ func (this *PdfPage) GetPageDict() *PdfObjectDictionary {
 			}
 		}
 		p.Set("Annots", &arr)
+	} else if this.annots != nil {
+		p.Set("Annots", this.annots)
 	}
 
 	return p
@@ -754,6 +844,16 @@ func (this *PdfPage) AddContentStreamByString(contentStr string) {
 // encoded using the encoding specified by the StreamEncoder, if empty, will
 // use identity encoding (raw data).
 func (this *PdfPage) SetContentStreams(cStreams []string, encoder StreamEncoder) error {
+	bStreams := make([][]byte, len(cStreams))
+	for i, cStream := range cStreams {
+		bStreams[i] = []byte(cStream)
+	}
+	return this.SetContentStreamsBytes(bStreams, encoder)
+}
+
+// SetContentStreamsBytes sets the content streams of the page from decoded content stream data. One stream object
+// is made for each entry, encoded with `encoder` (raw encoding if nil).
+func (this *PdfPage) SetContentStreamsBytes(cStreams [][]byte, encoder StreamEncoder) error {
 	if len(cStreams) == 0 {
 		this.Contents = nil
 		return nil
@@ -766,21 +866,10 @@ func (this *PdfPage) SetContentStreams(cStreams []string, encoder StreamEncoder)
 
 	streamObjs := []*PdfObjectStream{}
 	for _, cStream := range cStreams {
-		stream := &PdfObjectStream{}
-
-		// Make a new stream dict based on the encoding parameters.
-		sDict := encoder.MakeStreamDict()
-
-		encoded, err := encoder.EncodeBytes([]byte(cStream))
+		stream, err := makeContentStreamObject(cStream, encoder)
 		if err != nil {
 			return err
 		}
-
-		sDict.Set("Length", MakeInteger(int64(len(encoded))))
-
-		stream.PdfObjectDictionary = sDict
-		stream.Stream = []byte(encoded)
-
 		streamObjs = append(streamObjs, stream)
 	}
 
@@ -799,6 +888,205 @@ func (this *PdfPage) SetContentStreams(cStreams []string, encoder StreamEncoder)
 	return nil
 }
 
+// NormalizeContentStreams replaces the content streams of the page with the concatenated content, re-encoded with
+// `encoder` (raw encoding if nil). If `maxStreamSize` is positive, the content is split into several streams of
+// at most approximately that size (decoded), divided at lexical token boundaries as required by the standard
+// (7.8.2), otherwise a single content stream is made.
+func (this *PdfPage) NormalizeContentStreams(encoder StreamEncoder, maxStreamSize int) error {
+	if this.Contents == nil {
+		return nil
+	}
+
+	content, err := this.GetAllContentStreams()
+	if err != nil {
+		return err
+	}
+
+	cStreams := [][]byte{[]byte(content)}
+	if maxStreamSize > 0 {
+		cStreams = splitContentStream([]byte(content), maxStreamSize)
+	}
+	return this.SetContentStreamsBytes(cStreams, encoder)
+}
+
+// WrapContentStreams wraps the existing page content in a q/Q pair, so that graphics state changes made by it
+// (e.g. CTM, colors or clipping) do not affect content added afterwards. Unbalanced q operators in the
+// existing content are closed as well. The existing content streams are kept as is, the q and Q operators are
+// added as separate content streams.
+func (this *PdfPage) WrapContentStreams() error {
+	if this.Contents == nil {
+		return nil
+	}
+
+	content, err := this.GetAllContentStreams()
+	if err != nil {
+		return err
+	}
+
+	depth := 0
+	for _, token := range contentStreamTokens([]byte(content)) {
+		if token == "q" {
+			depth++
+		} else if token == "Q" && depth > 0 {
+			depth--
+		}
+	}
+
+	pre, err := makeContentStreamObject([]byte("q\n"), NewRawEncoder())
+	if err != nil {
+		return err
+	}
+	post, err := makeContentStreamObject([]byte("\n"+strings.Repeat("Q\n", depth+1)), NewRawEncoder())
+	if err != nil {
+		return err
+	}
+
+	contArray := PdfObjectArray{pre}
+	if arr, isArray := TraceToDirectObject(this.Contents).(*PdfObjectArray); isArray {
+		contArray = append(contArray, *arr...)
+	} else {
+		contArray = append(contArray, this.Contents)
+	}
+	contArray = append(contArray, post)
+	this.Contents = &contArray
+
+	return nil
+}
+
+func makeContentStreamObject(content []byte, encoder StreamEncoder) (*PdfObjectStream, error) {
+	stream := &PdfObjectStream{}
+
+	// Make a new stream dict based on the encoding parameters.
+	sDict := encoder.MakeStreamDict()
+
+	encoded, err := encoder.EncodeBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	sDict.Set("Length", MakeInteger(int64(len(encoded))))
+
+	stream.PdfObjectDictionary = sDict
+	stream.Stream = encoded
+
+	return stream, nil
+}
+
+// contentStreamTokenBoundaries scans content stream data and calls `fn` with the start and end offset of each
+// lexical token. Strings, hex strings, dictionary delimiters and inline image data are treated as single tokens and
+// comments are skipped.
+func contentStreamTokenBoundaries(data []byte, fn func(start, end int)) {
+	isWhiteSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+	}
+	isDelimiter := func(c byte) bool {
+		return strings.IndexByte("()<>[]{}/%", c) >= 0
+	}
+
+	i := 0
+	for i < len(data) {
+		c := data[i]
+		start := i
+		switch {
+		case isWhiteSpace(c):
+			i++
+			continue
+		case c == '%':
+			for i < len(data) && data[i] != '\r' && data[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '(':
+			depth := 0
+			for i < len(data) {
+				if data[i] == '\\' {
+					i += 2
+					continue
+				}
+				if data[i] == '(' {
+					depth++
+				} else if data[i] == ')' {
+					depth--
+					if depth == 0 {
+						i++
+						break
+					}
+				}
+				i++
+			}
+		case c == '<' && i+1 < len(data) && data[i+1] == '<', c == '>' && i+1 < len(data) && data[i+1] == '>':
+			i += 2
+		case c == '<':
+			for i < len(data) && data[i] != '>' {
+				i++
+			}
+			i++
+		case c == '[' || c == ']' || c == '{' || c == '}' || c == ')' || c == '>':
+			i++
+		default:
+			// Regular token (including names starting with '/').
+			i++
+			for i < len(data) && !isWhiteSpace(data[i]) && !isDelimiter(data[i]) {
+				i++
+			}
+		}
+		if i > len(data) {
+			i = len(data)
+		}
+		fn(start, i)
+
+		// Inline image data follows the ID operator, up to the EI operator.
+		if i-start == 2 && string(data[start:i]) == "ID" {
+			// Single white space after ID.
+			i++
+			for i < len(data) {
+				if data[i] == 'E' && i+1 < len(data) && data[i+1] == 'I' && isWhiteSpace(data[i-1]) &&
+					(i+2 == len(data) || isWhiteSpace(data[i+2])) {
+					break
+				}
+				i++
+			}
+			if i > len(data) {
+				i = len(data)
+			}
+		}
+	}
+}
+
+// contentStreamTokens returns the regular tokens of content stream data (operators and operands).
+func contentStreamTokens(data []byte) []string {
+	tokens := []string{}
+	contentStreamTokenBoundaries(data, func(start, end int) {
+		tokens = append(tokens, string(data[start:end]))
+	})
+	return tokens
+}
+
+// splitContentStream splits content stream data into chunks of approximately `maxSize` bytes, dividing between
+// lexical tokens outside of inline images only.
+func splitContentStream(data []byte, maxSize int) [][]byte {
+	chunks := [][]byte{}
+	chunkStart := 0
+	inInlineImage := false
+	contentStreamTokenBoundaries(data, func(start, end int) {
+		token := string(data[start:end])
+		if !inInlineImage && start-chunkStart >= maxSize && start > chunkStart {
+			chunks = append(chunks, data[chunkStart:start])
+			chunkStart = start
+		}
+		// Inline images cannot be split.
+		if token == "BI" {
+			inInlineImage = true
+		} else if token == "EI" {
+			inInlineImage = false
+		}
+	})
+	if chunkStart < len(data) || len(chunks) == 0 {
+		chunks = append(chunks, data[chunkStart:])
+	}
+	return chunks
+}
+
 func getContentStreamAsString(cstreamObj PdfObject) (string, error) {
 	if cstream, ok := TraceToDirectObject(cstreamObj).(*PdfObjectString); ok {
 		return string(*cstream), nil
@@ -0,0 +1,180 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Annotation flags (F entry, 12.5.3).
+const (
+	annotFlagHidden = 1 << 1
+	annotFlagPrint  = 1 << 2
+	annotFlagNoView = 1 << 5
+)
+
+// GetCropBox returns the inheritable crop box of the page, the region to which the contents are clipped when
+// displayed. Defaults to the media box if not set, and is limited to the media box.
+func (this *PdfPage) GetCropBox() (*PdfRectangle, error) {
+	mbox, err := this.GetMediaBox()
+	if err != nil {
+		return nil, err
+	}
+
+	cbox := this.CropBox
+	if cbox == nil {
+		node := this.Parent
+		for node != nil && cbox == nil {
+			dictObj, ok := node.(*PdfIndirectObject)
+			if !ok {
+				return nil, errors.New("Invalid parent object")
+			}
+			dict, ok := dictObj.PdfObject.(*PdfObjectDictionary)
+			if !ok {
+				return nil, errors.New("Invalid parent objects dictionary")
+			}
+			if arr, ok := TraceToDirectObject(dict.Get("CropBox")).(*PdfObjectArray); ok {
+				cbox, err = NewPdfRectangle(*arr)
+				if err != nil {
+					return nil, err
+				}
+			}
+			node = dict.Get("Parent")
+		}
+	}
+	if cbox == nil {
+		return mbox, nil
+	}
+
+	box := normalizeRect(*cbox)
+	media := normalizeRect(*mbox)
+	box.Llx = math.Max(box.Llx, media.Llx)
+	box.Lly = math.Max(box.Lly, media.Lly)
+	box.Urx = math.Min(box.Urx, media.Urx)
+	box.Ury = math.Min(box.Ury, media.Ury)
+	if box.Llx >= box.Urx || box.Lly >= box.Ury {
+		common.Log.Debug("Crop box outside of media box - using media box")
+		return mbox, nil
+	}
+	return &box, nil
+}
+
+// GetRotation returns the clockwise rotation of the page when displayed in degrees (Rotate entry), normalized to
+// 0, 90, 180 or 270.
+func (this *PdfPage) GetRotation() int {
+	if this.Rotate == nil {
+		return 0
+	}
+	rotate := int(*this.Rotate) % 360
+	if rotate < 0 {
+		rotate += 360
+	}
+	return rotate / 90 * 90
+}
+
+// GetDeviceMatrix returns the matrix transforming user space coordinates of the page to the device space of a
+// viewer displaying the crop box of the page with `scale` device units per point (e.g. dpi/72 for pixels), taking
+// the Rotate and UserUnit entries into account. Device space has its origin at the top left corner of the
+// displayed page with y increasing downwards.
+func (this *PdfPage) GetDeviceMatrix(scale float64) (Matrix, error) {
+	if scale <= 0 {
+		common.Log.Debug("ERROR: Invalid scale %f", scale)
+		return IdentityMatrix(), ErrRangeError
+	}
+	cbox, err := this.GetCropBox()
+	if err != nil {
+		return IdentityMatrix(), err
+	}
+
+	// Move the crop box to the origin, scale to points and rotate clockwise.
+	m := TranslationMatrix(-cbox.Llx, -cbox.Lly).
+		Mult(this.GetUserUnitMatrix()).
+		Mult(RotationMatrix(-float64(this.GetRotation())))
+	// Move the rotated box back to the origin and flip it to have y downwards.
+	bbox := m.TransformRect(*cbox)
+	m = m.Mult(TranslationMatrix(-bbox.Llx, -bbox.Lly)).
+		Mult(NewMatrix(1, 0, 0, -1, 0, bbox.Ury-bbox.Lly)).
+		Mult(ScalingMatrix(scale, scale))
+	return m, nil
+}
+
+// UserToDevice converts the point (x, y) in user space of the page to device space (see GetDeviceMatrix).
+func (this *PdfPage) UserToDevice(x, y, scale float64) (float64, float64, error) {
+	m, err := this.GetDeviceMatrix(scale)
+	if err != nil {
+		return 0, 0, err
+	}
+	dx, dy := m.Transform(x, y)
+	return dx, dy, nil
+}
+
+// DeviceToUser converts the point (x, y) in device space (see GetDeviceMatrix) to user space of the page, e.g.
+// the position of a mouse click in a viewer.
+func (this *PdfPage) DeviceToUser(x, y, scale float64) (float64, float64, error) {
+	m, err := this.GetDeviceMatrix(scale)
+	if err != nil {
+		return 0, 0, err
+	}
+	inv, ok := m.Inverse()
+	if !ok {
+		return 0, 0, errors.New("Device matrix not invertible")
+	}
+	ux, uy := inv.Transform(x, y)
+	return ux, uy, nil
+}
+
+// AnnotationsAt returns the annotations of the page whose rectangle contains the point (x, y) in user space,
+// topmost first. Annotations flagged Hidden or NoView are skipped.
+func (this *PdfPage) AnnotationsAt(x, y float64) []*PdfAnnotation {
+	var hits []*PdfAnnotation
+	for i := len(this.Annotations) - 1; i >= 0; i-- {
+		annot := this.Annotations[i]
+		if annot == nil {
+			continue
+		}
+		if flags, ok := TraceToDirectObject(annot.F).(*PdfObjectInteger); ok {
+			if int64(*flags)&(annotFlagHidden|annotFlagNoView) != 0 {
+				continue
+			}
+		}
+		rect, ok := annotationRect(annot)
+		if !ok {
+			continue
+		}
+		if x >= rect.Llx && x <= rect.Urx && y >= rect.Lly && y <= rect.Ury {
+			hits = append(hits, annot)
+		}
+	}
+	return hits
+}
+
+// annotationRect returns the normalized rectangle of annotation `annot`.
+func annotationRect(annot *PdfAnnotation) (PdfRectangle, bool) {
+	arr, ok := TraceToDirectObject(annot.Rect).(*PdfObjectArray)
+	if !ok {
+		return PdfRectangle{}, false
+	}
+	rect, err := NewPdfRectangle(*arr)
+	if err != nil {
+		common.Log.Debug("Invalid annotation Rect: %v", err)
+		return PdfRectangle{}, false
+	}
+	return normalizeRect(*rect), true
+}
+
+// normalizeRect returns `rect` with the lower left corner below and left of the upper right corner.
+func normalizeRect(rect PdfRectangle) PdfRectangle {
+	return PdfRectangle{
+		Llx: math.Min(rect.Llx, rect.Urx),
+		Lly: math.Min(rect.Lly, rect.Ury),
+		Urx: math.Max(rect.Llx, rect.Urx),
+		Ury: math.Max(rect.Lly, rect.Ury),
+	}
+}
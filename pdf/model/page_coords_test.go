@@ -0,0 +1,90 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestPageDeviceCoordinates(t *testing.T) {
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+
+	testcases := []struct {
+		rotate     int64
+		cropBox    *PdfRectangle
+		userUnit   float64
+		scale      float64
+		user       [2]float64
+		expectedDx float64
+		expectedDy float64
+	}{
+		{0, nil, 1, 1, [2]float64{0, 792}, 0, 0},
+		{0, nil, 1, 2, [2]float64{100, 692}, 200, 200},
+		{90, nil, 1, 1, [2]float64{0, 0}, 0, 0},
+		{90, nil, 1, 1, [2]float64{612, 0}, 0, 612},
+		{90, nil, 1, 1, [2]float64{0, 792}, 792, 0},
+		{-90, nil, 1, 1, [2]float64{612, 792}, 0, 0},
+		{180, nil, 1, 1, [2]float64{612, 0}, 0, 0},
+		{0, &PdfRectangle{Llx: 100, Lly: 100, Urx: 500, Ury: 700}, 1, 1, [2]float64{100, 700}, 0, 0},
+		{0, nil, 2, 1, [2]float64{10, 782}, 20, 20},
+	}
+
+	for _, tc := range testcases {
+		rotate := tc.rotate
+		page.Rotate = &rotate
+		page.CropBox = tc.cropBox
+		page.SetUserUnit(tc.userUnit)
+
+		dx, dy, err := page.UserToDevice(tc.user[0], tc.user[1], tc.scale)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if math.Abs(dx-tc.expectedDx) > 1e-9 || math.Abs(dy-tc.expectedDy) > 1e-9 {
+			t.Errorf("Rotate %d: %v -> (%f, %f), expected (%f, %f)", tc.rotate, tc.user, dx, dy,
+				tc.expectedDx, tc.expectedDy)
+		}
+
+		ux, uy, err := page.DeviceToUser(dx, dy, tc.scale)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if math.Abs(ux-tc.user[0]) > 1e-9 || math.Abs(uy-tc.user[1]) > 1e-9 {
+			t.Errorf("Rotate %d: round trip %v -> (%f, %f)", tc.rotate, tc.user, ux, uy)
+		}
+	}
+}
+
+func TestAnnotationsAt(t *testing.T) {
+	makeAnnot := func(rect []float64, flags int64) *PdfAnnotation {
+		annot := NewPdfAnnotation()
+		annot.Rect = MakeArrayFromFloats(rect)
+		if flags != 0 {
+			annot.F = MakeInteger(flags)
+		}
+		return annot
+	}
+	below := makeAnnot([]float64{0, 0, 200, 200}, 0)
+	above := makeAnnot([]float64{150, 150, 100, 100}, 0) // Unnormalized rectangle.
+	hidden := makeAnnot([]float64{0, 0, 200, 200}, annotFlagHidden)
+
+	page := NewPdfPage()
+	page.Annotations = []*PdfAnnotation{below, above, hidden}
+
+	hits := page.AnnotationsAt(120, 120)
+	if len(hits) != 2 || hits[0] != above || hits[1] != below {
+		t.Errorf("Unexpected hits %v", hits)
+	}
+	if hits := page.AnnotationsAt(50, 50); len(hits) != 1 || hits[0] != below {
+		t.Errorf("Unexpected hits %v", hits)
+	}
+	if hits := page.AnnotationsAt(300, 300); len(hits) != 0 {
+		t.Errorf("Unexpected hits %v", hits)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// inheritablePageAttributes are the page attributes that can be inherited from the page tree (section 7.7.3.4).
+var inheritablePageAttributes = []PdfObjectName{"Resources", "MediaBox", "CropBox", "Rotate"}
+
+// GetPageDependencies returns the numbers (in ascending order) of the indirect objects that page `pageNum`
+// (starting at 1) depends on: the page object itself and every object reachable from it, including attributes
+// inherited from the page tree. Other page and page tree nodes are not followed, e.g. the destinations of
+// links to other pages, so the result is the set of objects to copy when extracting the page on its own.
+func (this *PdfReader) GetPageDependencies(pageNum int) ([]int64, error) {
+	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
+		return nil, ErrEncrypted
+	}
+	if pageNum < 1 || pageNum > len(this.pageList) {
+		common.Log.Debug("ERROR: Page %d out of range (%d pages)", pageNum, len(this.pageList))
+		return nil, ErrRangeError
+	}
+	page := this.pageList[pageNum-1]
+	pageDict, ok := page.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		return nil, errors.New("Page not a dictionary")
+	}
+
+	deps := map[int64]bool{}
+	if page.ObjectNumber > 0 {
+		deps[page.ObjectNumber] = true
+	}
+
+	for _, key := range pageDict.Keys() {
+		if key == "Parent" {
+			continue
+		}
+		err := this.collectDependencies(pageDict.Get(key), deps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Inherited attributes are taken from the nearest ancestor defining them.
+	for _, attr := range inheritablePageAttributes {
+		if pageDict.Get(attr) != nil {
+			continue
+		}
+		visited := map[*PdfObjectDictionary]bool{}
+		node := pageDict
+		for node != nil && !visited[node] {
+			visited[node] = true
+			parentObj, err := this.traceToObject(node.Get("Parent"))
+			if err != nil {
+				return nil, err
+			}
+			node, _ = TraceToDirectObject(parentObj).(*PdfObjectDictionary)
+			if node == nil {
+				break
+			}
+			if val := node.Get(attr); val != nil {
+				err = this.collectDependencies(val, deps)
+				if err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	}
+
+	nums := make([]int64, 0, len(deps))
+	for num := range deps {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+	return nums, nil
+}
+
+// collectDependencies adds the numbers of the indirect objects reachable from `obj` to `deps`, without following
+// page tree nodes.
+func (this *PdfReader) collectDependencies(obj PdfObject, deps map[int64]bool) error {
+	var num int64
+	switch t := obj.(type) {
+	case *PdfObjectReference:
+		num = t.ObjectNumber
+		if deps[num] {
+			return nil
+		}
+		resolved, _, err := this.resolveReference(t)
+		if err != nil {
+			return err
+		}
+		obj = resolved
+	case *PdfIndirectObject:
+		num = t.ObjectNumber
+	case *PdfObjectStream:
+		num = t.ObjectNumber
+	}
+
+	if num > 0 {
+		if deps[num] || isPageTreeNode(obj) {
+			return nil
+		}
+		deps[num] = true
+	}
+
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		return this.collectDependencies(t.PdfObject, deps)
+	case *PdfObjectStream:
+		return this.collectDependencies(t.PdfObjectDictionary, deps)
+	case *PdfObjectDictionary:
+		for _, key := range t.Keys() {
+			err := this.collectDependencies(t.Get(key), deps)
+			if err != nil {
+				return err
+			}
+		}
+	case *PdfObjectArray:
+		for _, val := range *t {
+			err := this.collectDependencies(val, deps)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isPageTreeNode returns true if `obj` is a page or page tree node (Type Page or Pages).
+func isPageTreeNode(obj PdfObject) bool {
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return false
+	}
+	objType, ok := TraceToDirectObject(dict.Get("Type")).(*PdfObjectName)
+	return ok && (*objType == "Page" || *objType == "Pages")
+}
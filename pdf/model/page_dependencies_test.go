@@ -0,0 +1,55 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestGetPageDependencies(t *testing.T) {
+	data := makeTestPdf([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		// Resources inherited by both pages.
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 6 0 R /Annots [8 0 R] >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 7 0 R " +
+			"/Resources << /XObject << /Im1 9 0 R >> >> >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Length 0 >>\nstream\n\nendstream",
+		"<< /Length 0 >>\nstream\n\nendstream",
+		// Link to the second page, which is not a dependency.
+		"<< /Type /Annot /Subtype /Link /Rect [0 0 10 10] /Dest [4 0 R /Fit] >>",
+		"<< /Type /XObject /Subtype /Form /BBox [0 0 1 1] /Length 0 >>\nstream\n\nendstream",
+	})
+
+	reader, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	testcases := []struct {
+		pageNum  int
+		expected []int64
+	}{
+		{1, []int64{3, 5, 6, 8}},
+		{2, []int64{4, 7, 9}},
+	}
+	for _, tcase := range testcases {
+		deps, err := reader.GetPageDependencies(tcase.pageNum)
+		if err != nil {
+			t.Fatalf("Page %d: %v", tcase.pageNum, err)
+		}
+		if !reflect.DeepEqual(deps, tcase.expected) {
+			t.Errorf("Page %d: dependencies %v, expected %v", tcase.pageNum, deps, tcase.expected)
+		}
+	}
+
+	if _, err := reader.GetPageDependencies(3); err != ErrRangeError {
+		t.Errorf("Expected range error, got %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfNamedPage is a page object named in the Pages or Templates name tree of the document (12.7.6). Named pages
+// are visible pages of the page tree, templates are invisible pages outside of the page tree from which form
+// systems spawn new pages, e.g. an additional page of rows for an invoice.
+type PdfNamedPage struct {
+	Name string
+	Page *PdfPage
+}
+
+// GetNamedPages returns the named pages of the document (Pages name tree), in name order. The pages are pages of
+// PageList.
+func (this *PdfReader) GetNamedPages() ([]*PdfNamedPage, error) {
+	named := []*PdfNamedPage{}
+	err := this.collectNamedPages("Pages", func(name string, obj PdfObject) error {
+		for i, pageObj := range this.pageList {
+			if pageObj == obj {
+				named = append(named, &PdfNamedPage{Name: name, Page: this.PageList[i]})
+				return nil
+			}
+		}
+		common.Log.Debug("Named page %q not in the page tree - skipping", name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return named, nil
+}
+
+// GetTemplates returns the page templates of the document (Templates name tree), in name order. Templates are
+// not part of the page tree and are not listed in PageList.
+func (this *PdfReader) GetTemplates() ([]*PdfNamedPage, error) {
+	templates := []*PdfNamedPage{}
+	err := this.collectNamedPages("Templates", func(name string, obj PdfObject) error {
+		container, ok := obj.(*PdfIndirectObject)
+		if !ok {
+			common.Log.Debug("Template %q not an indirect object - skipping", name)
+			return nil
+		}
+		dict, ok := container.PdfObject.(*PdfObjectDictionary)
+		if !ok {
+			common.Log.Debug("Template %q not a dictionary - skipping", name)
+			return nil
+		}
+		page, err := this.newPdfPageFromDict(dict)
+		if err != nil {
+			return err
+		}
+		page.setContainer(container)
+		templates = append(templates, &PdfNamedPage{Name: name, Page: page})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// collectNamedPages calls `fn` with each name and page object of name tree `key` of the catalog Names
+// dictionary.
+func (this *PdfReader) collectNamedPages(key PdfObjectName, fn func(name string, obj PdfObject) error) error {
+	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
+		return ErrEncrypted
+	}
+
+	obj, err := this.traceToObject(this.catalog.Get("Names"))
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return nil
+	}
+	if err := this.traverseObjectData(obj); err != nil {
+		return err
+	}
+	names, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	tree, ok := TraceToDirectObject(names.Get(key)).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+
+	return collectNameTreeValues(tree, func(name PdfObjectString, val PdfObject) error {
+		return fn(DecodeTextString(name), val)
+	}, 0)
+}
+
+// Spawn returns a new page instantiated from the template (TemplateInstantiated entry), for adding to a document
+// with PdfWriter.AddPage. The new page shares the contents and resources of the template and has copies of its
+// annotations. Widget annotations of form fields keep referring to their fields: giving the fields of the new
+// page their own values requires adding renamed copies of the fields to the form.
+func (this *PdfNamedPage) Spawn() *PdfPage {
+	page := this.Page.Duplicate()
+	page.isTemplate = false
+	page.Parent = nil
+	page.TemplateInstantiated = MakeName(this.Name)
+
+	// Appending content streams to the new page must not change the template.
+	if arr, ok := TraceToDirectObject(page.Contents).(*PdfObjectArray); ok {
+		contents := append(PdfObjectArray{}, *arr...)
+		page.Contents = &contents
+	}
+
+	// An annotation belongs to a single page.
+	annots := this.Page.annots
+	if this.Page.Annotations != nil {
+		arr := PdfObjectArray{}
+		for _, annot := range this.Page.Annotations {
+			if subannot := annot.GetContext(); subannot != nil {
+				arr = append(arr, subannot.ToPdfObject())
+			} else {
+				arr = append(arr, annot.ToPdfObject())
+			}
+		}
+		annots = &arr
+	}
+	page.Annotations = nil
+	page.annots = nil
+	if arr, ok := TraceToDirectObject(annots).(*PdfObjectArray); ok {
+		copies := PdfObjectArray{}
+		for _, obj := range *arr {
+			dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			annotDict := MakeDict()
+			annotDict.Merge(dict)
+			annotDict.Remove("P")
+			copies = append(copies, MakeIndirectObject(annotDict))
+		}
+		page.annots = &copies
+	}
+
+	return page
+}
+
+// AddNamedPage adds page `page` to the document like AddPage and names it `name` in the Pages name tree.
+func (this *PdfWriter) AddNamedPage(name string, page *PdfPage) error {
+	if err := this.AddPage(page); err != nil {
+		return err
+	}
+	if this.namedPages == nil {
+		this.namedPages = map[string]PdfObject{}
+	}
+	this.namedPages[name] = page.GetPageAsIndirectObject()
+	return nil
+}
+
+// AddTemplate adds page `page` to the document as the invisible page template `name` (Templates name tree),
+// outside of the page tree. Template pages are not inherited from a parent, so the MediaBox and Resources must
+// be set. Pages are instantiated from templates with PdfNamedPage.Spawn.
+func (this *PdfWriter) AddTemplate(name string, page *PdfPage) error {
+	if page.MediaBox == nil {
+		common.Log.Debug("ERROR: Template %q without MediaBox", name)
+		return errors.New("Template MediaBox missing")
+	}
+	page.isTemplate = true
+	page.Parent = nil
+
+	container, ok := page.ToPdfObject().(*PdfIndirectObject)
+	if !ok {
+		return errors.New("Template should be an indirect object")
+	}
+	this.addObject(container)
+	if err := this.addObjects(container.PdfObject); err != nil {
+		return err
+	}
+
+	if this.templates == nil {
+		this.templates = map[string]PdfObject{}
+	}
+	this.templates[name] = container
+	return nil
+}
+
+// makeNameTree returns a name tree (7.9.6) with a single node with the key/value pairs of `values`.
+func makeNameTree(values map[string]PdfObject) *PdfObjectDictionary {
+	// The keys of name trees are sorted.
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	namesArr := MakeArray()
+	for _, key := range keys {
+		namesArr.Append(EncodeTextString(key))
+		namesArr.Append(values[key])
+	}
+	tree := MakeDict()
+	tree.Set("Names", namesArr)
+	return tree
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestPageTemplates(t *testing.T) {
+	newPage := func() *PdfPage {
+		page := NewPdfPage()
+		page.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+		page.Resources = NewPdfPageResources()
+		return page
+	}
+
+	w := NewPdfWriter()
+	if err := w.AddPage(newPage()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := w.AddNamedPage("summary", newPage()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	template := newPage()
+	annot := NewPdfAnnotationSquare()
+	annot.Rect = MakeArrayFromFloats([]float64{10, 10, 100, 100})
+	template.Annotations = []*PdfAnnotation{annot.PdfAnnotation}
+	if err := w.AddTemplate("rows", template); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err := NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if numPages, _ := reader.GetNumPages(); numPages != 2 {
+		t.Fatalf("Expected 2 pages in the page tree, got %d", numPages)
+	}
+
+	named, err := reader.GetNamedPages()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(named) != 1 || named[0].Name != "summary" || named[0].Page != reader.PageList[1] {
+		t.Fatalf("Unexpected named pages %v", named)
+	}
+
+	templates, err := reader.GetTemplates()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(templates) != 1 || templates[0].Name != "rows" {
+		t.Fatalf("Unexpected templates %v", templates)
+	}
+	if typ, _ := templates[0].Page.GetPageDict().Get("Type").(*PdfObjectName); typ == nil || *typ != "Template" {
+		t.Errorf("Template type not kept: %v", templates[0].Page.GetPageDict().Get("Type"))
+	}
+
+	// Spawn two pages from the template and keep the template.
+	w = NewPdfWriter()
+	for i := 0; i < 2; i++ {
+		if err := w.AddPage(templates[0].Spawn()); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+	if err := w.AddTemplate(templates[0].Name, templates[0].Page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws = &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err = NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(reader.PageList) != 2 {
+		t.Fatalf("Expected 2 spawned pages, got %d", len(reader.PageList))
+	}
+	annotObjs := map[*PdfIndirectObject]bool{}
+	for _, page := range reader.PageList {
+		name, ok := page.TemplateInstantiated.(*PdfObjectName)
+		if !ok || *name != "rows" {
+			t.Errorf("Unexpected TemplateInstantiated %v", page.TemplateInstantiated)
+		}
+		if len(page.Annotations) != 1 {
+			t.Fatalf("Expected 1 annotation, got %d", len(page.Annotations))
+		}
+		annotObjs[page.Annotations[0].GetContainingPdfObject().(*PdfIndirectObject)] = true
+	}
+	if len(annotObjs) != 2 {
+		t.Errorf("Spawned pages share annotations")
+	}
+	if templates, err := reader.GetTemplates(); err != nil || len(templates) != 1 {
+		t.Errorf("Template not kept (%v)", err)
+	}
+}
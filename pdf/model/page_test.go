@@ -6,6 +6,8 @@
 package model
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	. "github.com/unidoc/unidoc/pdf/core"
@@ -308,3 +310,116 @@ func TestRect(t *testing.T) {
 		return
 	}
 }
+
+// Test splitting content streams at token boundaries.
+func TestSplitContentStream(t *testing.T) {
+	content := "q 1 0 0 1 10 10 cm BT /F1 12 Tf (Hello (nested) world) Tj ET % comment q\n" +
+		"BI /W 2 /H 1 /BPC 8 /CS /G ID \x00\xff EI Q <0a0b> Tj"
+
+	tokens := contentStreamTokens([]byte(content))
+	expected := []string{"q", "1", "0", "0", "1", "10", "10", "cm", "BT", "/F1", "12", "Tf",
+		"(Hello (nested) world)", "Tj", "ET", "BI", "/W", "2", "/H", "1", "/BPC", "8", "/CS", "/G", "ID", "EI",
+		"Q", "<0a0b>", "Tj"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Token mismatch: %q", tokens)
+	}
+	for i := range tokens {
+		if tokens[i] != expected[i] {
+			t.Errorf("Token %d mismatch: %q != %q", i, tokens[i], expected[i])
+		}
+	}
+
+	chunks := splitContentStream([]byte(content), 10)
+	if len(chunks) < 2 {
+		t.Fatalf("Content should be split")
+	}
+	joined := ""
+	for _, chunk := range chunks {
+		joined += string(chunk)
+		if bytes.Contains(chunk, []byte("BI")) && !bytes.Contains(chunk, []byte(" EI")) {
+			t.Errorf("Inline image split: %q", chunk)
+		}
+	}
+	if joined != content {
+		t.Errorf("Split content does not add up")
+	}
+}
+
+// Test wrapping page content in q/Q.
+func TestWrapContentStreams(t *testing.T) {
+	page := NewPdfPage()
+	err := page.SetContentStreamsBytes([][]byte{[]byte("q 1 0 0 RG"), []byte("0 0 10 10 re S")}, NewFlateEncoder())
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	err = page.WrapContentStreams()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	cstreams, err := page.GetContentStreams()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(cstreams) != 4 {
+		t.Fatalf("Expected 4 content streams, got %d", len(cstreams))
+	}
+	tokens := contentStreamTokens([]byte(strings.Join(cstreams, " ")))
+	numQ := 0
+	for _, token := range tokens {
+		if token == "Q" {
+			numQ++
+		}
+	}
+	if tokens[0] != "q" || numQ != 2 {
+		t.Errorf("Invalid wrapping: %q", tokens)
+	}
+
+	err = page.NormalizeContentStreams(nil, 0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if _, isStream := page.Contents.(*PdfObjectStream); !isStream {
+		t.Errorf("Expected a single content stream (%T)", page.Contents)
+	}
+}
+
+func TestPageUserUnit(t *testing.T) {
+	page := NewPdfPage()
+	if page.GetUserUnit() != 1 {
+		t.Errorf("Default UserUnit should be 1")
+	}
+
+	// 500 x 100 inch drawing.
+	unit, err := page.SetPhysicalMediaBox(500*72, 100*72)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if unit != 3 || page.GetUserUnit() != 3 {
+		t.Fatalf("UserUnit mismatch: %f", unit)
+	}
+	if page.MediaBox.Urx != 12000 || page.MediaBox.Ury != 2400 {
+		t.Errorf("MediaBox mismatch: %+v", page.MediaBox)
+	}
+	pbox, err := page.GetPhysicalMediaBox()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if pbox.Urx != 36000 || pbox.Ury != 7200 {
+		t.Errorf("Physical MediaBox mismatch: %+v", pbox)
+	}
+	if x, y := page.GetUserUnitMatrix().Transform(100, 10); x != 300 || y != 30 {
+		t.Errorf("UserUnit matrix mismatch: %f %f", x, y)
+	}
+	if v, ok := page.GetPageDict().Get("UserUnit").(*PdfObjectFloat); !ok || *v != 3 {
+		t.Errorf("UserUnit not written: %v", page.GetPageDict().Get("UserUnit"))
+	}
+
+	// Fits without UserUnit.
+	if unit, _ := page.SetPhysicalMediaBox(612, 792); unit != 1 || page.UserUnit != nil {
+		t.Errorf("UserUnit should be removed: %f %v", unit, page.UserUnit)
+	}
+	if err := page.SetUserUnit(0); err != ErrRangeError {
+		t.Errorf("Expected range error, got %v", err)
+	}
+}
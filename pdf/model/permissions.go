@@ -0,0 +1,161 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// CertificationLevel is the access permissions level of a certification signature, i.e. the P entry of the DocMDP
+// transform parameters (12.8.2.2), which specifies the changes allowed to a certified document.
+type CertificationLevel int
+
+const (
+	// NotCertified means that the document has no certification signature.
+	NotCertified CertificationLevel = 0
+
+	// CertifiedNoChanges allows no changes to the document.
+	CertifiedNoChanges CertificationLevel = 1
+
+	// CertifiedFormFilling allows filling in forms, instantiating page templates and signing.
+	CertifiedFormFilling CertificationLevel = 2
+
+	// CertifiedAnnotations allows form filling and signing, and creating, deleting and modifying annotations.
+	CertifiedAnnotations CertificationLevel = 3
+)
+
+// PermissionsPolicy combines the user access permissions of a document (the P entry of the encryption
+// dictionary) with the level of its certification signature, so that applications can check what the document
+// allows to be done with it. When enforced with PdfReader.EnforcePermissions, the mutation methods of the reader
+// and its form refuse operations that the policy does not allow by returning ErrPermissionDenied.
+type PermissionsPolicy struct {
+	Permissions        AccessPermissions
+	CertificationLevel CertificationLevel
+}
+
+// NewPermissionsPolicy returns a policy for access permissions `perms` and certification level `level`, e.g. with
+// the permissions returned by PdfReader.CheckAccessRights when the document was opened with the owner password.
+func NewPermissionsPolicy(perms AccessPermissions, level CertificationLevel) *PermissionsPolicy {
+	return &PermissionsPolicy{Permissions: perms, CertificationLevel: level}
+}
+
+// CanModify returns true if the contents of the document may be modified, other than by filling forms,
+// annotating and assembling.
+func (p *PermissionsPolicy) CanModify() bool {
+	return p.Permissions.Modify && p.CertificationLevel == NotCertified
+}
+
+// CanModifyForm returns true if form fields may be created, removed or modified other than by filling them in.
+func (p *PermissionsPolicy) CanModifyForm() bool {
+	return p.Permissions.Modify && p.Permissions.Annotate && p.CertificationLevel == NotCertified
+}
+
+// CanFillForms returns true if form fields may be filled in, including signature fields.
+func (p *PermissionsPolicy) CanFillForms() bool {
+	return (p.Permissions.FillForms || p.Permissions.Annotate) && p.CertificationLevel != CertifiedNoChanges
+}
+
+// CanAnnotate returns true if annotations may be added, removed or modified.
+func (p *PermissionsPolicy) CanAnnotate() bool {
+	return p.Permissions.Annotate &&
+		(p.CertificationLevel == NotCertified || p.CertificationLevel == CertifiedAnnotations)
+}
+
+// CanAssemble returns true if the document may be assembled: pages inserted, removed or rotated and bookmarks
+// created. Assembling is allowed by the assemble permission (bit 11), or by the modify permission (bit 4) which
+// includes it (7.6.3.2 - Table 22).
+func (p *PermissionsPolicy) CanAssemble() bool {
+	return (p.Permissions.RotateInsert || p.Permissions.Modify) && p.CertificationLevel == NotCertified
+}
+
+// check returns ErrPermissionDenied if operation `op` is not `allowed` by an enforced policy. A nil policy is not
+// enforced and allows everything.
+func (p *PermissionsPolicy) check(op string, allowed func(p *PermissionsPolicy) bool) error {
+	if p == nil || allowed(p) {
+		return nil
+	}
+	common.Log.Debug("ERROR: %s not allowed by the document permissions (%+v, certification level %d)", op,
+		p.Permissions, p.CertificationLevel)
+	return ErrPermissionDenied
+}
+
+// GetPermissionsPolicy returns the permissions policy of the document, from the user access permissions (P) of
+// an encrypted document, or all permissions if not encrypted, and the level of its certification signature.
+func (this *PdfReader) GetPermissionsPolicy() *PermissionsPolicy {
+	perms := AccessPermissions{
+		Printing:          true,
+		Modify:            true,
+		ExtractGraphics:   true,
+		Annotate:          true,
+		FillForms:         true,
+		DisabilityExtract: true,
+		RotateInsert:      true,
+		FullPrintQuality:  true,
+	}
+	if crypter := this.parser.GetCrypter(); crypter != nil {
+		perms = crypter.GetAccessPermissions()
+	}
+	return NewPermissionsPolicy(perms, this.GetCertificationLevel())
+}
+
+// GetCertificationLevel returns the access permissions level of the certification signature of the document
+// (DocMDP in the Perms dictionary of the catalog), or NotCertified if the document is not certified.
+func (this *PdfReader) GetCertificationLevel() CertificationLevel {
+	resolve := func(obj PdfObject) PdfObject {
+		traced, err := this.traceToObject(obj)
+		if err != nil {
+			common.Log.Debug("Unable to resolve %v: %v", obj, err)
+			return nil
+		}
+		return TraceToDirectObject(traced)
+	}
+
+	perms, ok := resolve(this.catalog.Get("Perms")).(*PdfObjectDictionary)
+	if !ok {
+		return NotCertified
+	}
+	sig, ok := resolve(perms.Get("DocMDP")).(*PdfObjectDictionary)
+	if !ok {
+		return NotCertified
+	}
+
+	// The signature references of the signature dictionary contain the DocMDP transform parameters.
+	level := CertifiedFormFilling
+	if refs, ok := resolve(sig.Get("Reference")).(*PdfObjectArray); ok {
+		for _, obj := range *refs {
+			ref, ok := resolve(obj).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			if method, ok := resolve(ref.Get("TransformMethod")).(*PdfObjectName); !ok || *method != "DocMDP" {
+				continue
+			}
+			params, ok := resolve(ref.Get("TransformParams")).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			if p, ok := resolve(params.Get("P")).(*PdfObjectInteger); ok {
+				if *p < 1 || *p > 3 {
+					common.Log.Debug("Invalid DocMDP permissions %d, assuming 2", *p)
+				} else {
+					level = CertificationLevel(*p)
+				}
+			}
+		}
+	}
+	return level
+}
+
+// EnforcePermissions makes the mutation methods of the reader and its form, e.g. ExtractPages and
+// PdfAcroForm.RemoveField, refuse the operations not allowed by `policy` by returning ErrPermissionDenied.
+// Use GetPermissionsPolicy for the policy of the document itself. A nil policy disables the enforcement.
+func (this *PdfReader) EnforcePermissions(policy *PermissionsPolicy) {
+	this.policy = policy
+	if this.AcroForm != nil {
+		this.AcroForm.policy = policy
+	}
+}
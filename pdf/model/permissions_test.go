@@ -0,0 +1,99 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// makeCertifiedTestPdf returns the test document with signed fields certified with DocMDP permissions `p`.
+func makeCertifiedTestPdf(p int) []byte {
+	objects := append([]string{}, testSignedObjects...)
+	objects[0] = "<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R /Perms << /DocMDP 7 0 R >> >>"
+	objects[6] = fmt.Sprintf("<< /Type /Sig /Filter /Adobe.PPKLite /SubFilter /adbe.pkcs7.detached "+
+		"/Reference [<< /Type /SigRef /TransformMethod /DocMDP /TransformParams << /Type /TransformParams /P %d /V /1.2 >> >>] "+
+		"/ByteRange [0 10 20 10] /Contents <00> >>", p)
+	return makeTestPdf(objects)
+}
+
+func TestPermissionsPolicy(t *testing.T) {
+	all := AccessPermissions{Modify: true, Annotate: true, FillForms: true, RotateInsert: true}
+	testcases := []struct {
+		perms                         AccessPermissions
+		level                         CertificationLevel
+		modify, fill, annotate, assem bool
+	}{
+		{all, NotCertified, true, true, true, true},
+		{all, CertifiedNoChanges, false, false, false, false},
+		{all, CertifiedFormFilling, false, true, false, false},
+		{all, CertifiedAnnotations, false, true, true, false},
+		{AccessPermissions{FillForms: true}, NotCertified, false, true, false, false},
+		{AccessPermissions{Annotate: true}, NotCertified, false, true, true, false},
+		{AccessPermissions{RotateInsert: true}, NotCertified, false, false, false, true},
+		{AccessPermissions{Modify: true}, NotCertified, false, false, false, true},
+	}
+	for i, tcase := range testcases {
+		policy := NewPermissionsPolicy(tcase.perms, tcase.level)
+		if policy.CanModifyForm() != tcase.modify || policy.CanFillForms() != tcase.fill ||
+			policy.CanAnnotate() != tcase.annotate || policy.CanAssemble() != tcase.assem {
+			t.Errorf("%d: unexpected policy %v %v %v %v", i, policy.CanModifyForm(), policy.CanFillForms(),
+				policy.CanAnnotate(), policy.CanAssemble())
+		}
+	}
+}
+
+func TestCertificationLevel(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(testSignedObjects)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	policy := reader.GetPermissionsPolicy()
+	if policy.CertificationLevel != NotCertified || !policy.CanModifyForm() || !policy.CanAssemble() {
+		t.Errorf("Unexpected policy of uncertified document: %+v", policy)
+	}
+
+	for _, level := range []CertificationLevel{CertifiedNoChanges, CertifiedFormFilling, CertifiedAnnotations} {
+		reader, err := NewPdfReader(bytes.NewReader(makeCertifiedTestPdf(int(level))))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if got := reader.GetCertificationLevel(); got != level {
+			t.Errorf("Certification level %d, expected %d", got, level)
+		}
+	}
+}
+
+func TestEnforcePermissions(t *testing.T) {
+	reader, err := NewPdfReader(bytes.NewReader(makeCertifiedTestPdf(2)))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	// Not enforced by default.
+	if err := reader.AcroForm.RemoveField("text"); err != nil {
+		t.Errorf("Error removing field: %v", err)
+	}
+
+	reader.EnforcePermissions(reader.GetPermissionsPolicy())
+	if err := reader.AcroForm.RemoveField("sig"); err != ErrPermissionDenied {
+		t.Errorf("Expected ErrPermissionDenied removing field, got %v", err)
+	}
+	if _, _, err := reader.ExtractPages([]int{1}, SignaturesRemove); err != ErrPermissionDenied {
+		t.Errorf("Expected ErrPermissionDenied extracting pages, got %v", err)
+	}
+	if len(reader.AcroForm.FieldsFlattened()) != 1 {
+		t.Errorf("Form modified")
+	}
+
+	reader.EnforcePermissions(nil)
+	if _, _, err := reader.ExtractPages([]int{1}, SignaturesRemove); err != nil {
+		t.Errorf("Error extracting pages: %v", err)
+	}
+}
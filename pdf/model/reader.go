@@ -30,15 +30,54 @@ type PdfReader struct {
 
 	modelManager *ModelManager
 
+	// Permissions policy enforced by the mutation methods, if set.
+	policy *PermissionsPolicy
+
+	// Parts of the structure to load.
+	opts ReaderOptions
+
 	// For tracking traversal (cache).
 	traversed map[PdfObject]bool
 }
 
+// ReaderOptions define which parts of the document structure are loaded by NewPdfReaderWithOptions. Skipping
+// structures that are not needed (e.g. for text extraction or counting pages) reduces the time to open
+// documents with large forms, outlines or many annotations.
+type ReaderOptions struct {
+	// SkipAcroForm disables loading the interactive form, PdfReader.AcroForm is nil.
+	SkipAcroForm bool
+
+	// SkipOutlines disables loading the outlines, GetOutlineTree returns nil.
+	SkipOutlines bool
+
+	// SkipAnnotations disables loading the page annotations, PdfPage.Annotations is nil. The Annots entries of
+	// the pages are kept as is when the pages are written. If the AcroForm is loaded, the pages of its widgets
+	// are not known.
+	SkipAnnotations bool
+}
+
 // NewPdfReader returns a new PdfReader for an input io.ReadSeeker interface. Can be used to read PDF from
 // memory or file. Immediately loads and traverses the PDF structure including pages and page contents (if
 // not encrypted).
 func NewPdfReader(rs io.ReadSeeker) (*PdfReader, error) {
+	return NewPdfReaderWith(rs)
+}
+
+// NewPdfReaderWithOptions returns a new PdfReader for `rs` like NewPdfReader, loading only the parts of the
+// structure selected by `opts`.
+func NewPdfReaderWithOptions(rs io.ReadSeeker, opts ReaderOptions) (*PdfReader, error) {
+	return NewPdfReaderWith(rs, WithReaderOptions(opts))
+}
+
+// NewPdfReaderWith returns a new PdfReader for `rs` like NewPdfReader, configured by `opts`.
+func NewPdfReaderWith(rs io.ReadSeeker, opts ...ReaderOption) (*PdfReader, error) {
+	c := &readerConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	pdfReader := &PdfReader{}
+	pdfReader.opts = c.opts
 	pdfReader.traversed = map[PdfObject]bool{}
 
 	pdfReader.modelManager = NewModelManager()
@@ -55,12 +94,21 @@ func NewPdfReader(rs io.ReadSeeker) (*PdfReader, error) {
 		return nil, err
 	}
 
-	// Load pdf doc structure if not encrypted.
+	// Load pdf doc structure if not encrypted, or when decrypted.
 	if !isEncrypted {
 		err = pdfReader.loadStructure()
 		if err != nil {
 			return nil, err
 		}
+	} else if c.decrypt {
+		auth, err := pdfReader.Decrypt(c.password)
+		if err != nil {
+			return nil, err
+		}
+		if !auth {
+			common.Log.Debug("ERROR: Unable to decrypt with the password")
+			return nil, errors.New("Unable to decrypt - invalid password")
+		}
 	}
 
 	return pdfReader, nil
@@ -135,7 +183,7 @@ func (this *PdfReader) CheckAccessRights(password []byte) (bool, AccessPermissio
 // Loads the structure of the pdf file: pages, outlines, etc.
 func (this *PdfReader) loadStructure() error {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return fmt.Errorf("File need to be decrypted first")
+		return ErrEncrypted
 	}
 
 	trailerDict := this.parser.GetTrailer()
@@ -209,16 +257,20 @@ func (this *PdfReader) loadStructure() error {
 	common.Log.Trace("%d: %s", len(this.pageList), this.pageList)
 
 	// Outlines.
-	this.outlineTree, err = this.loadOutlines()
-	if err != nil {
-		common.Log.Debug("ERROR: Failed to build outline tree (%s)", err)
-		return err
+	if !this.opts.SkipOutlines {
+		this.outlineTree, err = this.loadOutlines()
+		if err != nil {
+			common.Log.Debug("ERROR: Failed to build outline tree (%s)", err)
+			return err
+		}
 	}
 
 	// Load interactive forms and fields.
-	this.AcroForm, err = this.loadForms()
-	if err != nil {
-		return err
+	if !this.opts.SkipAcroForm {
+		this.AcroForm, err = this.loadForms()
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -258,7 +310,7 @@ func (this *PdfReader) traceToObject(obj PdfObject) (PdfObject, error) {
 
 func (this *PdfReader) loadOutlines() (*PdfOutlineTreeNode, error) {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return nil, fmt.Errorf("File need to be decrypted first")
+		return nil, ErrEncrypted
 	}
 
 	// Has outlines? Otherwise return an empty outlines structure.
@@ -450,7 +502,7 @@ func (this *PdfReader) GetOutlinesFlattened() ([]*PdfOutlineTreeNode, []string,
 // loadForms loads the AcroForm.
 func (this *PdfReader) loadForms() (*PdfAcroForm, error) {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return nil, fmt.Errorf("File need to be decrypted first")
+		return nil, ErrEncrypted
 	}
 
 	// Has forms?
@@ -494,6 +546,18 @@ func (this *PdfReader) loadForms() (*PdfAcroForm, error) {
 		return nil, err
 	}
 
+	// Pages of the widgets, see FieldsFlattened.
+	acroForm.widgetPages = map[*PdfAnnotation]int{}
+	acroForm.pageNumbers = map[PdfObject]int{}
+	acroForm.pages = append([]*PdfPage{}, this.PageList...)
+	acroForm.policy = this.policy
+	for i, page := range this.PageList {
+		acroForm.pageNumbers[this.pageList[i]] = i + 1
+		for _, annot := range page.Annotations {
+			acroForm.widgetPages[annot] = i + 1
+		}
+	}
+
 	return acroForm, nil
 }
 
@@ -597,7 +661,7 @@ func (this *PdfReader) buildPageList(node *PdfIndirectObject, parent *PdfIndirec
 // GetNumPages returns the number of pages in the document.
 func (this *PdfReader) GetNumPages() (int, error) {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return 0, fmt.Errorf("File need to be decrypted first")
+		return 0, ErrEncrypted
 	}
 	return len(this.pageList), nil
 }
@@ -703,7 +767,7 @@ func (this *PdfReader) traverseObjectData(o PdfObject) error {
 // GetPageAsIndirectObject returns an indirect object containing the page dictionary for a specified page number.
 func (this *PdfReader) GetPageAsIndirectObject(pageNumber int) (PdfObject, error) {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return nil, fmt.Errorf("File needs to be decrypted first")
+		return nil, ErrEncrypted
 	}
 	if len(this.pageList) < pageNumber {
 		return nil, errors.New("Invalid page number (page count too short)")
@@ -724,7 +788,7 @@ func (this *PdfReader) GetPageAsIndirectObject(pageNumber int) (PdfObject, error
 // GetPage returns the PdfPage model for the specified page number.
 func (this *PdfReader) GetPage(pageNumber int) (*PdfPage, error) {
 	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
-		return nil, fmt.Errorf("File needs to be decrypted first")
+		return nil, ErrEncrypted
 	}
 	if len(this.pageList) < pageNumber {
 		return nil, errors.New("Invalid page number (page count too short)")
@@ -738,6 +802,30 @@ func (this *PdfReader) GetPage(pageNumber int) (*PdfPage, error) {
 	return page, nil
 }
 
+// GetLanguage returns the default natural language of the document as specified by the catalog Lang
+// entry, e.g. "en-US". Returns an empty string if not specified.
+func (this *PdfReader) GetLanguage() string {
+	obj, err := this.traceToObject(this.catalog.Get("Lang"))
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to trace Lang (%s)", err)
+		return ""
+	}
+	lang, ok := TraceToDirectObject(obj).(*PdfObjectString)
+	if !ok {
+		return ""
+	}
+	return string(*lang)
+}
+
+// GetStructTreeRoot returns the structure tree root of a tagged document, giving access to the structure
+// elements and their Lang, Alt and ActualText entries. Returns nil if the document has no structure tree.
+func (this *PdfReader) GetStructTreeRoot() (*PdfStructTreeRoot, error) {
+	if this.parser.GetCrypter() != nil && !this.parser.IsAuthenticated() {
+		return nil, ErrEncrypted
+	}
+	return this.loadStructTreeRoot()
+}
+
 // GetOCProperties returns the optional content properties PdfObject.
 func (this *PdfReader) GetOCProperties() (PdfObject, error) {
 	dict := this.catalog
@@ -760,6 +848,42 @@ func (this *PdfReader) GetOCProperties() (PdfObject, error) {
 	return obj, nil
 }
 
+// GetOutputIntents returns the output intents array of the document (catalog OutputIntents entry) with all
+// references resolved. Returns nil if not present.
+func (this *PdfReader) GetOutputIntents() (PdfObject, error) {
+	obj, err := this.traceToObject(this.catalog.Get("OutputIntents"))
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+
+	err = this.traverseObjectData(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// GetDocInfo returns the document information dictionary (trailer Info entry). Returns nil if not present.
+func (this *PdfReader) GetDocInfo() (*PdfObjectDictionary, error) {
+	trailer := this.parser.GetTrailer()
+	if trailer == nil {
+		return nil, errors.New("Trailer missing")
+	}
+	obj, err := this.traceToObject(trailer.Get("Info"))
+	if err != nil {
+		return nil, err
+	}
+	info, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return nil, nil
+	}
+	return info, nil
+}
+
 // Inspect inspects the object types, subtypes and content in the PDF file returning a map of
 // object type to number of instances of each.
 func (this *PdfReader) Inspect() (map[string]int, error) {
@@ -790,3 +914,38 @@ func (this *PdfReader) GetTrailer() (*PdfObjectDictionary, error) {
 
 	return trailerDict, nil
 }
+
+// Release drops the caches of the reader and its parser, see PdfParser.Release. Intended for long-running services
+// to reclaim memory when done with the reader; objects and pages obtained before remain valid.
+func (this *PdfReader) Release() {
+	this.parser.Release()
+	this.traversed = map[PdfObject]bool{}
+}
+
+// GetObjectRawBytes returns the serialization of object `objNum` exactly as stored in the file, e.g. for forensic
+// and diffing tools. See PdfParser.GetObjectRawBytes.
+func (this *PdfReader) GetObjectRawBytes(objNum int) ([]byte, error) {
+	return this.parser.GetObjectRawBytes(objNum)
+}
+
+// GetNumRevisions returns the number of revisions of the file (original document plus incremental updates).
+func (this *PdfReader) GetNumRevisions() int {
+	return this.parser.GetNumRevisions()
+}
+
+// GetRevisionBytes returns the file contents of revision `n` (0 being the original document) exactly as stored.
+func (this *PdfReader) GetRevisionBytes(n int) ([]byte, error) {
+	return this.parser.GetRevisionBytes(n)
+}
+
+// VerifyXrefChain returns the problems found in the chain of cross-reference sections of the file, see
+// PdfParser.VerifyXrefChain.
+func (this *PdfReader) VerifyXrefChain() []XrefChainIssue {
+	return this.parser.VerifyXrefChain()
+}
+
+// RepairXrefChain writes the file with a consistent cross-reference section appended to `w`, see
+// PdfParser.RepairXrefChain.
+func (this *PdfReader) RepairXrefChain(w io.Writer) error {
+	return this.parser.RepairXrefChain(w)
+}
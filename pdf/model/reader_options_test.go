@@ -0,0 +1,57 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestReaderOptions(t *testing.T) {
+	objects := append([]string{}, testFieldHierarchyObjects...)
+	objects[0] = "<< /Type /Catalog /Pages 2 0 R /AcroForm 3 0 R /Outlines 12 0 R >>"
+	objects = append(objects,
+		"<< /Type /Outlines /First 13 0 R /Last 13 0 R /Count 1 >>",
+		"<< /Title (Chapter) /Parent 12 0 R >>",
+	)
+	data := makeTestPdf(objects)
+
+	reader, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if reader.AcroForm == nil || reader.GetOutlineTree() == nil || len(reader.PageList[0].Annotations) != 2 {
+		t.Fatalf("Expected the full structure to be loaded by default")
+	}
+
+	reader, err = NewPdfReaderWithOptions(bytes.NewReader(data),
+		ReaderOptions{SkipAcroForm: true, SkipOutlines: true, SkipAnnotations: true})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if reader.AcroForm != nil {
+		t.Errorf("AcroForm loaded")
+	}
+	if reader.GetOutlineTree() != nil {
+		t.Errorf("Outlines loaded")
+	}
+	numPages, err := reader.GetNumPages()
+	if err != nil || numPages != 2 {
+		t.Errorf("Unexpected page count %d (%v)", numPages, err)
+	}
+
+	page := reader.PageList[0]
+	if page.Annotations != nil {
+		t.Errorf("Annotations loaded")
+	}
+	// The Annots entry is kept when the page is written.
+	annots, ok := TraceToDirectObject(page.GetPageDict().Get("Annots")).(*PdfObjectArray)
+	if !ok || len(*annots) != 2 {
+		t.Errorf("Annots not kept: %v", page.GetPageDict().Get("Annots"))
+	}
+}
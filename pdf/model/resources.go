@@ -125,6 +125,21 @@ func (r *PdfPageResources) GetExtGState(keyName PdfObjectName) (PdfObject, bool)
 	}
 }
 
+// GetExtGStateByName returns the graphics state parameter dictionary `keyName` as a PdfExtGState.
+// Returns nil if not found.
+func (r *PdfPageResources) GetExtGStateByName(keyName PdfObjectName) (*PdfExtGState, error) {
+	obj, has := r.GetExtGState(keyName)
+	if !has {
+		return nil, nil
+	}
+	return NewPdfExtGStateFromPdfObject(obj)
+}
+
+// SetExtGStateByName sets the graphics state parameter dictionary `keyName` to `egs`.
+func (r *PdfPageResources) SetExtGStateByName(keyName PdfObjectName, egs *PdfExtGState) error {
+	return r.AddExtGState(keyName, egs.ToPdfObject())
+}
+
 // Check whether a font is defined by the specified keyName.
 func (r *PdfPageResources) HasExtGState(keyName PdfObjectName) bool {
 	_, has := r.GetFontByName(keyName)
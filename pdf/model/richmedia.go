@@ -0,0 +1,223 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfRichMediaSettings represents the activation and deactivation settings of a rich media annotation
+// (RichMediaSettings, RichMediaActivation and RichMediaDeactivation dictionaries in ISO 32000-2 13.7.2).
+type PdfRichMediaSettings struct {
+	ActivationCondition   string // XA (explicit activation), PO (page opened) or PV (page visible).
+	DeactivationCondition string // XD (explicit deactivation), PC (page closed) or PI (page invisible).
+
+	Style          string // Presentation style: Embedded or Windowed.
+	Toolbar        *bool  // Show the playback toolbar.
+	NavigationPane *bool  // Show the navigation pane.
+}
+
+// ToPdfObject returns the settings as a RichMediaSettings dictionary.
+func (s *PdfRichMediaSettings) ToPdfObject() PdfObject {
+	activation := MakeDict()
+	activation.Set("Type", MakeName("RichMediaActivation"))
+	if len(s.ActivationCondition) > 0 {
+		activation.Set("Condition", MakeName(s.ActivationCondition))
+	}
+
+	presentation := MakeDict()
+	presentation.Set("Type", MakeName("RichMediaPresentation"))
+	if len(s.Style) > 0 {
+		presentation.Set("Style", MakeName(s.Style))
+	}
+	if s.Toolbar != nil {
+		presentation.Set("Toolbar", MakeBool(*s.Toolbar))
+	}
+	if s.NavigationPane != nil {
+		presentation.Set("NavigationPane", MakeBool(*s.NavigationPane))
+	}
+	activation.Set("Presentation", presentation)
+
+	deactivation := MakeDict()
+	deactivation.Set("Type", MakeName("RichMediaDeactivation"))
+	if len(s.DeactivationCondition) > 0 {
+		deactivation.Set("Condition", MakeName(s.DeactivationCondition))
+	}
+
+	d := MakeDict()
+	d.Set("Type", MakeName("RichMediaSettings"))
+	d.Set("Activation", activation)
+	d.Set("Deactivation", deactivation)
+	return d
+}
+
+func newPdfRichMediaSettingsFromDict(d *PdfObjectDictionary) *PdfRichMediaSettings {
+	s := &PdfRichMediaSettings{}
+
+	if activation, ok := TraceToDirectObject(d.Get("Activation")).(*PdfObjectDictionary); ok {
+		if name, ok := TraceToDirectObject(activation.Get("Condition")).(*PdfObjectName); ok {
+			s.ActivationCondition = string(*name)
+		}
+		if presentation, ok := TraceToDirectObject(activation.Get("Presentation")).(*PdfObjectDictionary); ok {
+			if name, ok := TraceToDirectObject(presentation.Get("Style")).(*PdfObjectName); ok {
+				s.Style = string(*name)
+			}
+			if b, ok := TraceToDirectObject(presentation.Get("Toolbar")).(*PdfObjectBool); ok {
+				val := bool(*b)
+				s.Toolbar = &val
+			}
+			if b, ok := TraceToDirectObject(presentation.Get("NavigationPane")).(*PdfObjectBool); ok {
+				val := bool(*b)
+				s.NavigationPane = &val
+			}
+		}
+	}
+	if deactivation, ok := TraceToDirectObject(d.Get("Deactivation")).(*PdfObjectDictionary); ok {
+		if name, ok := TraceToDirectObject(deactivation.Get("Condition")).(*PdfObjectName); ok {
+			s.DeactivationCondition = string(*name)
+		}
+	}
+
+	return s
+}
+
+// SetRichMediaSettings sets the activation settings of the annotation.
+func (this *PdfAnnotationRichMedia) SetRichMediaSettings(s *PdfRichMediaSettings) {
+	this.RichMediaSettings = s.ToPdfObject()
+}
+
+// GetRichMediaSettings returns the activation settings of the annotation or nil if not set.
+func (this *PdfAnnotationRichMedia) GetRichMediaSettings() *PdfRichMediaSettings {
+	d, ok := TraceToDirectObject(this.RichMediaSettings).(*PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	return newPdfRichMediaSettingsFromDict(d)
+}
+
+// SetVideo sets the content of the annotation to the embedded video file `fs` (e.g. MP4), which is played with
+// the video player of the viewer.
+func (this *PdfAnnotationRichMedia) SetVideo(fs *PdfFileSpec) {
+	fsObj := fs.ToPdfObject()
+
+	assets := MakeDict()
+	assets.Set("Names", MakeArray(EncodeTextString(fs.GetFileName()), fsObj))
+
+	instance := MakeDict()
+	instance.Set("Type", MakeName("RichMediaInstance"))
+	instance.Set("Subtype", MakeName("Video"))
+	instance.Set("Asset", fsObj)
+
+	config := MakeDict()
+	config.Set("Type", MakeName("RichMediaConfiguration"))
+	config.Set("Subtype", MakeName("Video"))
+	config.Set("Instances", MakeArray(instance))
+
+	content := MakeDict()
+	content.Set("Type", MakeName("RichMediaContent"))
+	content.Set("Assets", assets)
+	content.Set("Configurations", MakeArray(config))
+
+	this.RichMediaContent = content
+}
+
+// GetAssets returns the files embedded as rich media content assets, e.g. to extract the video.
+func (this *PdfAnnotationRichMedia) GetAssets() ([]*PdfFileSpec, error) {
+	content, ok := TraceToDirectObject(this.RichMediaContent).(*PdfObjectDictionary)
+	if !ok {
+		return nil, nil
+	}
+	assets, ok := TraceToDirectObject(content.Get("Assets")).(*PdfObjectDictionary)
+	if !ok {
+		return nil, nil
+	}
+
+	files := []*PdfFileSpec{}
+	err := collectNameTreeValues(assets, func(name PdfObjectString, val PdfObject) error {
+		fs, err := NewPdfFileSpecFromPdfObject(val)
+		if err != nil {
+			return err
+		}
+		files = append(files, fs)
+		return nil
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// SetPosterImage sets the normal appearance of the annotation to the image `ximg` scaled to the annotation
+// rectangle. The poster is shown while the content is not activated. The annotation Rect must be set.
+func (this *PdfAnnotationRichMedia) SetPosterImage(ximg *XObjectImage) error {
+	arr, ok := TraceToDirectObject(this.Rect).(*PdfObjectArray)
+	if !ok {
+		common.Log.Debug("ERROR: Rich media annotation Rect not set")
+		return ErrRequiredAttributeMissing
+	}
+	rect, err := NewPdfRectangle(*arr)
+	if err != nil {
+		return err
+	}
+	width := rect.Urx - rect.Llx
+	height := rect.Ury - rect.Lly
+
+	xform := NewXObjectForm()
+	xform.BBox = MakeArrayFromFloats([]float64{0, 0, width, height})
+	xform.Resources = NewPdfPageResources()
+	err = xform.Resources.SetXObjectImageByName("Poster", ximg)
+	if err != nil {
+		return err
+	}
+	content := fmt.Sprintf("q %.4f 0 0 %.4f 0 0 cm /Poster Do Q", width, height)
+	err = xform.SetContentStream([]byte(content), nil)
+	if err != nil {
+		return err
+	}
+
+	ap := MakeDict()
+	ap.Set("N", xform.ToPdfObject())
+	this.AP = ap
+	return nil
+}
+
+// collectNameTreeValues calls `fn` for each key/value pair of the name tree node `node` and its kids.
+func collectNameTreeValues(node *PdfObjectDictionary, fn func(name PdfObjectString, val PdfObject) error, depth int) error {
+	if depth > TraceMaxDepth {
+		return errors.New("Name tree too deep")
+	}
+
+	if names, ok := TraceToDirectObject(node.Get("Names")).(*PdfObjectArray); ok {
+		for i := 0; i+1 < len(*names); i += 2 {
+			name, ok := TraceToDirectObject((*names)[i]).(*PdfObjectString)
+			if !ok {
+				common.Log.Debug("ERROR: Name tree key not a string (%T)", (*names)[i])
+				continue
+			}
+			if err := fn(*name, (*names)[i+1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if kids, ok := TraceToDirectObject(node.Get("Kids")).(*PdfObjectArray); ok {
+		for _, kid := range *kids {
+			kidDict, ok := TraceToDirectObject(kid).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			if err := collectNameTreeValues(kidDict, fn, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
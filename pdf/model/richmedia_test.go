@@ -0,0 +1,121 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Test embedding a video in a rich media annotation with a poster and extracting it again.
+func TestRichMediaVideoRoundtrip(t *testing.T) {
+	video := []byte("\x00\x00\x00\x18ftypmp42 dummy video data")
+
+	ef, err := NewPdfEmbeddedFile(video, "video/mp4")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	fs := NewPdfFileSpecFromEmbeddedFile("Einführung – intro.mp4", ef)
+
+	annot := NewPdfAnnotationRichMedia()
+	annot.Rect = MakeArrayFromFloats([]float64{50, 400, 370, 580})
+	annot.SetVideo(fs)
+	toolbar := true
+	annot.SetRichMediaSettings(&PdfRichMediaSettings{
+		ActivationCondition:   "PV",
+		DeactivationCondition: "PI",
+		Style:                 "Embedded",
+		Toolbar:               &toolbar,
+	})
+
+	img := &Image{Width: 2, Height: 1, BitsPerComponent: 8, ColorComponents: 3, Data: []byte{255, 0, 0, 0, 0, 255}}
+	ximg, err := NewXObjectImageFromImage(img, NewPdfColorspaceDeviceRGB(), nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := annot.SetPosterImage(ximg); err != nil {
+		t.Fatalf("Error setting poster: %v", err)
+	}
+
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{0, 0, 612, 792}
+	page.Resources = NewPdfPageResources()
+	page.Annotations = append(page.Annotations, annot.PdfAnnotation)
+
+	w := NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+
+	f, err := ioutil.TempFile("", "richmedia")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+
+	reader, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	rpage, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(rpage.Annotations) != 1 {
+		t.Fatalf("Expected 1 annotation, got %d", len(rpage.Annotations))
+	}
+	rannot, ok := rpage.Annotations[0].GetContext().(*PdfAnnotationRichMedia)
+	if !ok {
+		t.Fatalf("Not a rich media annotation (%T)", rpage.Annotations[0].GetContext())
+	}
+
+	content := TraceToDirectObject(rannot.RichMediaContent).(*PdfObjectDictionary)
+	names := TraceToDirectObject(content.Get("Assets")).(*PdfObjectDictionary).Get("Names").(*PdfObjectArray)
+	if key, ok := (*names)[0].(*PdfObjectString); !ok || DecodeTextString(*key) != "Einführung – intro.mp4" {
+		t.Errorf("Asset name mismatch: %v", (*names)[0])
+	}
+
+	assets, err := rannot.GetAssets()
+	if err != nil {
+		t.Fatalf("Error loading assets: %v", err)
+	}
+	if len(assets) != 1 || assets[0].GetFileName() != "Einführung – intro.mp4" || assets[0].EF == nil {
+		t.Fatalf("Assets mismatch: %+v", assets)
+	}
+	if assets[0].EF.Subtype == nil || *assets[0].EF.Subtype != "video/mp4" {
+		t.Errorf("MIME type mismatch: %v", assets[0].EF.Subtype)
+	}
+	data, err := assets[0].EF.GetData()
+	if err != nil {
+		t.Fatalf("Error decoding: %v", err)
+	}
+	if string(data) != string(video) {
+		t.Errorf("Video data mismatch")
+	}
+
+	settings := rannot.GetRichMediaSettings()
+	if settings == nil || settings.ActivationCondition != "PV" || settings.DeactivationCondition != "PI" ||
+		settings.Style != "Embedded" || settings.Toolbar == nil || !*settings.Toolbar {
+		t.Errorf("Settings mismatch: %+v", settings)
+	}
+
+	ap, ok := TraceToDirectObject(rannot.AP).(*PdfObjectDictionary)
+	if !ok {
+		t.Fatalf("Missing appearance dictionary")
+	}
+	if _, ok := TraceToDirectObject(ap.Get("N")).(*PdfObjectStream); !ok {
+		t.Errorf("Poster appearance not a stream (%T)", ap.Get("N"))
+	}
+}
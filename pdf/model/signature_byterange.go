@@ -0,0 +1,166 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// SignatureByteRangeCheck is the result of checking the byte range of a signature (12.8.1): the signed bytes
+// must be the entire revision of the file that was signed except for the Contents hex string, so that no data can
+// hide in unsigned gaps.
+type SignatureByteRangeCheck struct {
+	// Fully qualified name of the signature field.
+	FieldName string
+
+	// ByteRange of the signature: offset and length of the bytes before and after the Contents hex string.
+	ByteRange []int64
+
+	// Revision signed (0 for the original document), i.e. the revision ending where the byte range ends, -1 if
+	// the byte range does not end at the end of a revision.
+	Revision int
+
+	// CoversFile is true if the byte range extends to the end of the file. Otherwise the file has incremental
+	// updates that the signature does not cover.
+	CoversFile bool
+
+	// Problems found, empty if the byte range is valid.
+	Issues []string
+}
+
+// Valid returns true if no problems were found.
+func (c *SignatureByteRangeCheck) Valid() bool {
+	return len(c.Issues) == 0
+}
+
+// CheckSignatureByteRanges checks the byte ranges of the signed signature fields of the form of the document:
+// the ranges must start at the beginning of the file and end at the end of a revision, and the gap between them
+// must be exactly the Contents hex string of the signature, holding the signature value padded with zeros.
+func (this *PdfReader) CheckSignatureByteRanges() ([]*SignatureByteRangeCheck, error) {
+	checks := []*SignatureByteRangeCheck{}
+	if this.AcroForm == nil {
+		return checks, nil
+	}
+
+	// End offsets of the revisions, the last one is the end of the file.
+	revisionEnds := []int64{}
+	var data []byte
+	for i := 0; i < this.GetNumRevisions(); i++ {
+		revision, err := this.GetRevisionBytes(i)
+		if err != nil {
+			return nil, err
+		}
+		revisionEnds = append(revisionEnds, int64(len(revision)))
+		if len(revision) > len(data) {
+			data = revision
+		}
+	}
+
+	for _, terminal := range this.AcroForm.FieldsFlattened() {
+		if terminal.Field.getFieldType() != "Sig" {
+			continue
+		}
+		sigDict, ok := TraceToDirectObject(terminal.Field.getInheritedV()).(*PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+
+		check := &SignatureByteRangeCheck{FieldName: terminal.FullName, Revision: -1}
+		checks = append(checks, check)
+
+		arr, ok := TraceToDirectObject(sigDict.Get("ByteRange")).(*PdfObjectArray)
+		if !ok {
+			check.Issues = append(check.Issues, "ByteRange missing")
+			continue
+		}
+		for _, obj := range *arr {
+			n, ok := TraceToDirectObject(obj).(*PdfObjectInteger)
+			if !ok {
+				check.Issues = append(check.Issues, fmt.Sprintf("ByteRange entry not an integer (%T)", obj))
+				break
+			}
+			check.ByteRange = append(check.ByteRange, int64(*n))
+		}
+		if len(check.Issues) > 0 {
+			continue
+		}
+		contents, _ := TraceToDirectObject(sigDict.Get("Contents")).(*PdfObjectString)
+
+		check.Issues = checkByteRange(data, check.ByteRange, contents)
+		if len(check.ByteRange) == 4 {
+			end := check.ByteRange[2] + check.ByteRange[3]
+			for i, revisionEnd := range revisionEnds {
+				if end == revisionEnd {
+					check.Revision = i
+				}
+			}
+			check.CoversFile = end == int64(len(data))
+			if check.Revision < 0 && len(check.Issues) == 0 {
+				check.Issues = append(check.Issues, fmt.Sprintf("byte range ends at %d, not at the end of a revision", end))
+			}
+		}
+		if len(check.Issues) > 0 {
+			common.Log.Debug("Signature %s byte range issues: %v", check.FieldName, check.Issues)
+		}
+	}
+	return checks, nil
+}
+
+// checkByteRange returns the problems of signature byte range `byteRange` in file `data` with signature value
+// `contents`. The ranges must start at the beginning of the file and the gap between them must be exactly the
+// Contents hex string "<...>" with the hex encoded signature value padded with zeros.
+func checkByteRange(data []byte, byteRange []int64, contents *PdfObjectString) []string {
+	issues := []string{}
+	if len(byteRange) != 4 {
+		return append(issues, fmt.Sprintf("ByteRange has %d entries, expected 4", len(byteRange)))
+	}
+	for _, n := range byteRange {
+		if n < 0 {
+			return append(issues, fmt.Sprintf("negative ByteRange entry %d", n))
+		}
+	}
+	start1, len1, start2, len2 := byteRange[0], byteRange[1], byteRange[2], byteRange[3]
+	if start1 != 0 {
+		issues = append(issues, fmt.Sprintf("byte range starts at %d, not at the beginning of the file", start1))
+	}
+	// The entries are compared by subtraction, as hostile entries can make the sums overflow.
+	size := int64(len(data))
+	if start1 > size || len1 > size-start1 || start2 > size || len2 > size-start2 {
+		return append(issues, fmt.Sprintf("byte range %v past the end of the file (%d)", byteRange, len(data)))
+	}
+	gapStart, gapEnd := start1+len1, start2
+	if gapEnd < gapStart {
+		return append(issues, "byte ranges overlap")
+	}
+
+	// The gap is the Contents hex string and nothing else.
+	gap := data[gapStart:gapEnd]
+	if len(gap) < 2 || gap[0] != '<' || gap[len(gap)-1] != '>' {
+		return append(issues, fmt.Sprintf("gap %d-%d is not a hex string", gapStart, gapEnd))
+	}
+	digits := gap[1 : len(gap)-1]
+	value := make([]byte, hex.DecodedLen(len(digits)))
+	if len(digits)%2 != 0 {
+		issues = append(issues, "odd number of hex digits in the gap")
+	} else if _, err := hex.Decode(value, digits); err != nil {
+		issues = append(issues, fmt.Sprintf("gap contains data other than hex digits: %v", err))
+	} else if contents == nil {
+		issues = append(issues, "Contents missing")
+	} else {
+		// The signature value is padded with zeros to the reserved length.
+		signature := []byte(*contents)
+		if len(signature) > len(value) || !bytes.Equal(value[:len(signature)], signature) ||
+			len(bytes.Trim(value[len(signature):], "\x00")) > 0 {
+			issues = append(issues, "gap is not the Contents of the signature")
+		}
+	}
+	return issues
+}
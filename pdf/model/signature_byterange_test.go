@@ -0,0 +1,103 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// makeSignedTestPdf returns the signed test document with its ByteRange filled in to cover the file except the
+// Contents hex string, adjusted by `adjust`, e.g. to leave a gap.
+func makeSignedTestPdf(adjust func(byteRange []int)) []byte {
+	objects := append([]string{}, testSignedObjects...)
+	objects[6] = "<< /Type /Sig /Filter /Adobe.PPKLite /SubFilter /adbe.pkcs7.detached " +
+		"/ByteRange [0 ********** ********** **********] /Contents <ABCD00000000> >>"
+	data := makeTestPdf(objects)
+
+	gapStart := bytes.Index(data, []byte("/Contents <")) + len("/Contents ")
+	gapEnd := gapStart + bytes.IndexByte(data[gapStart:], '>') + 1
+	byteRange := []int{0, gapStart, gapEnd, len(data) - gapEnd}
+	adjust(byteRange)
+
+	placeholder := []byte("0 ********** ********** **********")
+	filled := []byte(fmt.Sprintf("%d %10d %10d %10d", byteRange[0], byteRange[1], byteRange[2], byteRange[3]))
+	return bytes.Replace(data, placeholder, filled, 1)
+}
+
+// appendTestUpdate returns `data` with an incremental update adding an object.
+func appendTestUpdate(data []byte) []byte {
+	startxref, _ := strconv.Atoi(regexp.MustCompile(`startxref\s+(\d+)`).FindAllStringSubmatch(string(data), -1)[0][1])
+	var buf bytes.Buffer
+	buf.Write(data)
+	xref := NewXrefSection()
+	xref.Incremental = true
+	xref.AddObject(len(testSignedObjects)+1, 0, int64(buf.Len()))
+	buf.WriteString(fmt.Sprintf("%d 0 obj\n(update)\nendobj\n", len(testSignedObjects)+1))
+	offset := int64(buf.Len())
+	xref.WriteTo(&buf)
+	prev := int64(startxref)
+	trailer := &XrefTrailer{Size: xref.Size(), Root: &PdfObjectReference{ObjectNumber: 1}, Prev: &prev}
+	WriteTrailer(&buf, trailer.ToPdfObject(), offset)
+	return buf.Bytes()
+}
+
+func TestCheckSignatureByteRanges(t *testing.T) {
+	valid := makeSignedTestPdf(func(byteRange []int) {})
+	testcases := []struct {
+		name       string
+		data       []byte
+		valid      bool
+		revision   int
+		coversFile bool
+	}{
+		{"valid", valid, true, 0, true},
+		{"updated", appendTestUpdate(valid), true, 0, false},
+		{"hidden data", makeSignedTestPdf(func(byteRange []int) { byteRange[2] += 20; byteRange[3] -= 20 }), false, 0, true},
+		{"short", makeSignedTestPdf(func(byteRange []int) { byteRange[3] -= 5 }), false, -1, false},
+		{"not at start", makeSignedTestPdf(func(byteRange []int) { byteRange[0] = 5; byteRange[1] -= 5 }), false, 0, true},
+	}
+
+	for _, tcase := range testcases {
+		reader, err := NewPdfReader(bytes.NewReader(tcase.data))
+		if err != nil {
+			t.Fatalf("%s: error: %v", tcase.name, err)
+		}
+		checks, err := reader.CheckSignatureByteRanges()
+		if err != nil {
+			t.Fatalf("%s: error: %v", tcase.name, err)
+		}
+		if len(checks) != 1 {
+			t.Fatalf("%s: %d checks", tcase.name, len(checks))
+		}
+		check := checks[0]
+		if check.FieldName != "sig" || check.Valid() != tcase.valid || check.Revision != tcase.revision ||
+			check.CoversFile != tcase.coversFile {
+			t.Errorf("%s: unexpected check %+v", tcase.name, check)
+		}
+	}
+}
+
+// Byte ranges whose sums overflow are reported instead of panicking.
+func TestCheckByteRangeOverflow(t *testing.T) {
+	data := makeSignedTestPdf(func(byteRange []int) {})
+	for _, byteRange := range [][]int64{
+		{0, 9, math.MaxInt64, 1},
+		{0, math.MaxInt64, 9, 1},
+		{math.MaxInt64, 1, 0, 1},
+	} {
+		issues := checkByteRange(data, byteRange, nil)
+		if len(issues) == 0 {
+			t.Errorf("%v: no issues", byteRange)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Object identifiers of the CMS (RFC 5652) structures and attributes used in signatures.
+var (
+	oidSignedData        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidRSASSAPSS         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	oidAttrMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidAttrSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+)
+
+// cmsDigestHashes are the hash functions of the digest algorithms by object identifier.
+var cmsDigestHashes = map[string]crypto.Hash{
+	"1.3.14.3.2.26":          crypto.SHA1,
+	"2.16.840.1.101.3.4.2.1": crypto.SHA256,
+	"2.16.840.1.101.3.4.2.2": crypto.SHA384,
+	"2.16.840.1.101.3.4.2.3": crypto.SHA512,
+}
+
+type cmsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type cmsEncapContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type cmsSignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo cmsEncapContentInfo
+	Certificates     asn1.RawValue   `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue   `asn1:"optional,tag:1"`
+	SignerInfos      []cmsSignerInfo `asn1:"set"`
+}
+
+type cmsSignerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type cmsIssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type cmsAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+// cmsSignature is the first signer of a CMS SignedData structure.
+type cmsSignature struct {
+	certificates []*x509.Certificate
+	signer       *x509.Certificate
+	hash         crypto.Hash
+	pss          bool   // RSASSA-PSS signature rather than PKCS #1 v1.5 or ECDSA.
+	content      []byte // Encapsulated content, nil if detached.
+
+	// DER encoding of the signed attributes (as a SET), nil if there are none, and their values.
+	signedAttrs   []byte
+	messageDigest []byte
+	signingTime   time.Time
+	revocation    *RevocationInfo // Revocation information archived by the signer, nil if none.
+
+	signature []byte
+}
+
+// parseCMSSignature parses the DER encoded CMS SignedData `der` (the Contents of a signature), possibly followed
+// by zero padding.
+func parseCMSSignature(der []byte) (*cmsSignature, error) {
+	var ci cmsContentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("invalid CMS content info: %v", err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("CMS content type %s is not signed data", ci.ContentType)
+	}
+	var sd cmsSignedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("invalid CMS signed data: %v", err)
+	}
+	if len(sd.SignerInfos) == 0 {
+		return nil, errors.New("CMS signed data without signer")
+	}
+
+	sig := &cmsSignature{}
+	if len(sd.EncapContentInfo.Content.Bytes) > 0 {
+		var content []byte
+		if _, err := asn1.Unmarshal(sd.EncapContentInfo.Content.Bytes, &content); err != nil {
+			return nil, fmt.Errorf("invalid CMS content: %v", err)
+		}
+		sig.content = content
+	}
+	if len(sd.Certificates.Bytes) > 0 {
+		certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CMS certificates: %v", err)
+		}
+		sig.certificates = certs
+	}
+
+	si := sd.SignerInfos[0]
+	hash, ok := cmsDigestHashes[si.DigestAlgorithm.Algorithm.String()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported digest algorithm %s", si.DigestAlgorithm.Algorithm)
+	}
+	sig.hash = hash
+	sig.pss = si.SignatureAlgorithm.Algorithm.Equal(oidRSASSAPSS)
+	sig.signature = si.Signature
+	sig.signer = findCMSSigner(si.SID, sig.certificates)
+
+	if len(si.SignedAttrs.FullBytes) > 0 {
+		// The signature is computed over the DER encoding of the attributes with the SET tag rather than the
+		// implicit [0] tag.
+		attrs := append([]byte{}, si.SignedAttrs.FullBytes...)
+		attrs[0] = 0x31
+		sig.signedAttrs = attrs
+
+		var parsed []cmsAttribute
+		if _, err := asn1.UnmarshalWithParams(attrs, &parsed, "set"); err != nil {
+			return nil, fmt.Errorf("invalid CMS signed attributes: %v", err)
+		}
+		for _, attr := range parsed {
+			switch {
+			case attr.Type.Equal(oidAttrMessageDigest):
+				if _, err := asn1.Unmarshal(attr.Values.Bytes, &sig.messageDigest); err != nil {
+					return nil, fmt.Errorf("invalid message digest attribute: %v", err)
+				}
+			case attr.Type.Equal(oidAttrSigningTime):
+				if _, err := asn1.Unmarshal(attr.Values.Bytes, &sig.signingTime); err != nil {
+					return nil, fmt.Errorf("invalid signing time attribute: %v", err)
+				}
+			case attr.Type.Equal(oidAttrRevocationInfoArchival):
+				info, err := parseAdbeRevocationInfoArchival(attr.Values.Bytes)
+				if err != nil {
+					return nil, err
+				}
+				sig.revocation = info
+			}
+		}
+		if sig.messageDigest == nil {
+			return nil, errors.New("CMS signed attributes without message digest")
+		}
+	}
+	return sig, nil
+}
+
+// findCMSSigner returns the certificate of `certs` identified by signer identifier `sid`, either the issuer and
+// serial number or the subject key identifier ([0] tagged). Returns nil if not found.
+func findCMSSigner(sid asn1.RawValue, certs []*x509.Certificate) *x509.Certificate {
+	if sid.Class == asn1.ClassContextSpecific && sid.Tag == 0 {
+		for _, cert := range certs {
+			if bytes.Equal(cert.SubjectKeyId, sid.Bytes) {
+				return cert
+			}
+		}
+		return nil
+	}
+	var ias cmsIssuerAndSerialNumber
+	if _, err := asn1.Unmarshal(sid.FullBytes, &ias); err != nil || ias.SerialNumber == nil {
+		return nil
+	}
+	for _, cert := range certs {
+		if bytes.Equal(cert.RawIssuer, ias.Issuer.FullBytes) && cert.SerialNumber.Cmp(ias.SerialNumber) == 0 {
+			return cert
+		}
+	}
+	return nil
+}
+
+// verifySignature checks that `signature` is the signature of `message` hashed with `hash` by the key of
+// certificate `cert`, an RSASSA-PSS signature if `pss` is true.
+func verifySignature(cert *x509.Certificate, hash crypto.Hash, pss bool, message, signature []byte) error {
+	if pss {
+		// The salt length of the PSS parameters is not checked, any length is accepted.
+		pub, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("RSASSA-PSS signature with a %T public key", cert.PublicKey)
+		}
+		return rsa.VerifyPSS(pub, hash, hashBytes(hash, message), signature,
+			&rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+	}
+
+	algos := map[crypto.Hash][2]x509.SignatureAlgorithm{
+		crypto.SHA1:   {x509.SHA1WithRSA, x509.ECDSAWithSHA1},
+		crypto.SHA256: {x509.SHA256WithRSA, x509.ECDSAWithSHA256},
+		crypto.SHA384: {x509.SHA384WithRSA, x509.ECDSAWithSHA384},
+		crypto.SHA512: {x509.SHA512WithRSA, x509.ECDSAWithSHA512},
+	}
+	var algo x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		algo = algos[hash][0]
+	case *ecdsa.PublicKey:
+		algo = algos[hash][1]
+	case ed25519.PublicKey:
+		algo = x509.PureEd25519
+	default:
+		return fmt.Errorf("unsupported public key type %T", cert.PublicKey)
+	}
+	return cert.CheckSignature(algo, message, signature)
+}
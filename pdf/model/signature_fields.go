@@ -0,0 +1,84 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Signature flags of the form (SigFlags entry, 12.7.2 - Table 219).
+const (
+	sigFlagSignaturesExist = 1 << 0
+)
+
+// AddSignatureField adds an unsigned signature field (without value) named `name` to the form, with a printable
+// widget with rectangle `rect` on `page`, e.g. obtained with PdfPage.NextSignatureSlot. The widget is added to the
+// annotations of the page and has an empty appearance, so that the document designates a signing location to be
+// filled by signers with their own tools. Returns ErrInvalidAttribute if `name` is not a valid partial name or is
+// already used by a top level field, or ErrPermissionDenied if modifying the form is not allowed by the enforced
+// permissions policy.
+func (this *PdfAcroForm) AddSignatureField(name string, page *PdfPage, rect PdfRectangle) (*PdfField, error) {
+	if err := this.policy.check("Adding signature fields", (*PermissionsPolicy).CanModifyForm); err != nil {
+		return nil, err
+	}
+	if name == "" || strings.Contains(name, ".") {
+		common.Log.Debug("ERROR: Invalid signature field name %q", name)
+		return nil, ErrInvalidAttribute
+	}
+	if this.Fields == nil {
+		this.Fields = &[]*PdfField{}
+	}
+	for _, field := range *this.Fields {
+		if field.PartialName() == name {
+			common.Log.Debug("ERROR: Field %s already exists", name)
+			return nil, ErrInvalidAttribute
+		}
+	}
+	rect = normalizeRect(rect)
+
+	field := NewPdfField()
+	field.FT = MakeName("Sig")
+	field.T = EncodeTextString(name)
+
+	appearance := NewXObjectForm()
+	if err := appearance.SetContentStream([]byte{}, nil); err != nil {
+		return nil, err
+	}
+	appearance.BBox = MakeArrayFromFloats([]float64{0, 0, rect.Urx - rect.Llx, rect.Ury - rect.Lly})
+	ap := MakeDict()
+	ap.Set("N", appearance.ToPdfObject())
+
+	widget := NewPdfAnnotationWidget()
+	widget.Rect = MakeArrayFromFloats([]float64{rect.Llx, rect.Lly, rect.Urx, rect.Ury})
+	widget.F = MakeInteger(annotFlagPrint)
+	widget.P = page.GetContainingPdfObject()
+	widget.AP = ap
+	widget.Parent = field.GetContainingPdfObject()
+	field.KidsA = []*PdfAnnotation{widget.PdfAnnotation}
+
+	*this.Fields = append(*this.Fields, field)
+	page.Annotations = append(page.Annotations, widget.PdfAnnotation)
+
+	flags := int64(sigFlagSignaturesExist)
+	if this.SigFlags != nil {
+		flags |= int64(*this.SigFlags)
+	}
+	this.SigFlags = MakeInteger(flags)
+
+	// Track the page so that RemoveField also removes the widget from it.
+	hasPage := false
+	for _, p := range this.pages {
+		hasPage = hasPage || p == page
+	}
+	if !hasPage {
+		this.pages = append(this.pages, page)
+	}
+
+	return field, nil
+}
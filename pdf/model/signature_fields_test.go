@@ -0,0 +1,70 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestAddSignatureField(t *testing.T) {
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+	page.Resources = NewPdfPageResources()
+
+	form := NewPdfAcroForm()
+	if _, err := form.AddSignatureField("approver", page, PdfRectangle{Llx: 400, Lly: 100, Urx: 550, Ury: 50}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if _, err := form.AddSignatureField("approver", page, PdfRectangle{}); err != ErrInvalidAttribute {
+		t.Errorf("Expected the duplicate name to be refused, got %v", err)
+	}
+	if _, err := form.AddSignatureField("a.b", page, PdfRectangle{}); err != ErrInvalidAttribute {
+		t.Errorf("Expected the qualified name to be refused, got %v", err)
+	}
+
+	w := NewPdfWriter()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := w.SetForms(form); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	reader, err := NewPdfReader(bytes.NewReader(ws.buf))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if reader.AcroForm == nil || reader.AcroForm.SigFlags == nil || *reader.AcroForm.SigFlags != 1 {
+		t.Fatalf("Unexpected form %+v", reader.AcroForm)
+	}
+	terminals := reader.AcroForm.FieldsFlattened()
+	if len(terminals) != 1 || terminals[0].FullName != "approver" || terminals[0].Field.getFieldType() != "Sig" {
+		t.Fatalf("Unexpected fields %v", terminals)
+	}
+	if terminals[0].Field.getInheritedV() != nil {
+		t.Errorf("Signature field should not be signed")
+	}
+	if len(terminals[0].Widgets) != 1 || len(terminals[0].WidgetPages) != 1 || terminals[0].WidgetPages[0] != 1 {
+		t.Fatalf("Unexpected widgets %v on pages %v", terminals[0].Widgets, terminals[0].WidgetPages)
+	}
+	rect, ok := annotationRect(terminals[0].Widgets[0])
+	if !ok || rect != (PdfRectangle{Llx: 400, Lly: 50, Urx: 550, Ury: 100}) {
+		t.Errorf("Unexpected widget rectangle %v", rect)
+	}
+	if _, ok := TraceToDirectObject(terminals[0].Widgets[0].AP).(*PdfObjectDictionary); !ok {
+		t.Errorf("Widget appearance missing")
+	}
+	if len(reader.PageList[0].Annotations) != 1 {
+		t.Errorf("Expected the widget in the page annotations, got %d annotations", len(reader.PageList[0].Annotations))
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// SignatureRectBottomRight returns the rectangle of a `width` x `height` signature widget in the bottom right
+// corner of the visible area (crop box) of the page, `margin` away from the edges. Typically used on the last
+// page of the document.
+func (this *PdfPage) SignatureRectBottomRight(width, height, margin float64) (*PdfRectangle, error) {
+	if width <= 0 || height <= 0 || margin < 0 {
+		common.Log.Debug("ERROR: Invalid signature size %f x %f (margin %f)", width, height, margin)
+		return nil, ErrRangeError
+	}
+	cbox, err := this.GetCropBox()
+	if err != nil {
+		return nil, err
+	}
+	rect := &PdfRectangle{
+		Llx: cbox.Urx - margin - width,
+		Lly: cbox.Lly + margin,
+		Urx: cbox.Urx - margin,
+		Ury: cbox.Lly + margin + height,
+	}
+	if rect.Llx < cbox.Llx || rect.Ury > cbox.Ury {
+		common.Log.Debug("ERROR: Signature %f x %f does not fit on the page", width, height)
+		return nil, ErrRangeError
+	}
+	return rect, nil
+}
+
+// NextSignatureSlot returns the rectangle of the first free slot for a `width` x `height` signature widget in
+// signature block `block` of the page. The slots are laid out from left to right and top to bottom with `gap`
+// between them; a slot is free if it does not overlap the rectangle of any annotation of the page, e.g. the
+// widgets of previous signatures.
+func (this *PdfPage) NextSignatureSlot(block PdfRectangle, width, height, gap float64) (*PdfRectangle, error) {
+	if width <= 0 || height <= 0 || gap < 0 {
+		common.Log.Debug("ERROR: Invalid signature size %f x %f (gap %f)", width, height, gap)
+		return nil, ErrRangeError
+	}
+	block = normalizeRect(block)
+
+	var occupied []PdfRectangle
+	for _, annot := range this.Annotations {
+		if annot == nil {
+			continue
+		}
+		if rect, ok := annotationRect(annot); ok {
+			occupied = append(occupied, rect)
+		}
+	}
+
+	for ury := block.Ury; ury-height >= block.Lly; ury -= height + gap {
+		for llx := block.Llx; llx+width <= block.Urx; llx += width + gap {
+			slot := PdfRectangle{Llx: llx, Lly: ury - height, Urx: llx + width, Ury: ury}
+			free := true
+			for _, rect := range occupied {
+				if rectsOverlap(slot, rect) {
+					free = false
+					break
+				}
+			}
+			if free {
+				return &slot, nil
+			}
+		}
+	}
+
+	common.Log.Debug("No free %f x %f signature slot in %+v", width, height, block)
+	return nil, errors.New("No free signature slot")
+}
+
+// SignatureRectNextTo returns the rectangle of a `width` x `height` signature widget placed `gap` to the right of
+// rectangle `anchor`, e.g. the bounding box of a "Signature:" label found with the text extractor, with the bottom
+// of the widget aligned with the bottom of the anchor.
+func SignatureRectNextTo(anchor PdfRectangle, width, height, gap float64) PdfRectangle {
+	anchor = normalizeRect(anchor)
+	return PdfRectangle{
+		Llx: anchor.Urx + gap,
+		Lly: anchor.Lly,
+		Urx: anchor.Urx + gap + width,
+		Ury: anchor.Lly + height,
+	}
+}
+
+// rectsOverlap returns true if rectangles `a` and `b` overlap by more than an edge.
+func rectsOverlap(a, b PdfRectangle) bool {
+	return a.Llx < b.Urx && b.Llx < a.Urx && a.Lly < b.Ury && b.Lly < a.Ury
+}
@@ -0,0 +1,62 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestSignaturePlacement(t *testing.T) {
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+
+	rect, err := page.SignatureRectBottomRight(200, 50, 36)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if *rect != (PdfRectangle{Llx: 376, Lly: 36, Urx: 576, Ury: 86}) {
+		t.Errorf("Unexpected bottom right rectangle %+v", rect)
+	}
+	if _, err := page.SignatureRectBottomRight(600, 50, 36); err == nil {
+		t.Errorf("Expected an error for a signature wider than the page")
+	}
+
+	// Signature block with two slots per row, the first one taken.
+	block := PdfRectangle{Llx: 50, Lly: 100, Urx: 470, Ury: 300}
+	taken := NewPdfAnnotation()
+	taken.Rect = MakeArrayFromFloats([]float64{50, 250, 250, 300})
+	page.Annotations = []*PdfAnnotation{taken}
+
+	slot, err := page.NextSignatureSlot(block, 200, 50, 20)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if *slot != (PdfRectangle{Llx: 270, Lly: 250, Urx: 470, Ury: 300}) {
+		t.Errorf("Unexpected slot %+v", slot)
+	}
+
+	second := NewPdfAnnotation()
+	second.Rect = slot.ToPdfObject()
+	page.Annotations = append(page.Annotations, second)
+	slot, err = page.NextSignatureSlot(block, 200, 50, 20)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if *slot != (PdfRectangle{Llx: 50, Lly: 180, Urx: 250, Ury: 230}) {
+		t.Errorf("Unexpected slot %+v", slot)
+	}
+
+	if _, err := page.NextSignatureSlot(PdfRectangle{Llx: 50, Lly: 250, Urx: 470, Ury: 300}, 200, 50, 20); err == nil {
+		t.Errorf("Expected an error for a full block")
+	}
+
+	next := SignatureRectNextTo(PdfRectangle{Llx: 50, Lly: 58, Urx: 100, Ury: 70}, 150, 40, 10)
+	if next != (PdfRectangle{Llx: 110, Lly: 58, Urx: 260, Ury: 98}) {
+		t.Errorf("Unexpected rectangle next to anchor %+v", next)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// FieldLockAction specifies the fields locked by a signature field lock.
+type FieldLockAction string
+
+const (
+	// FieldLockAll locks all the fields of the form.
+	FieldLockAll FieldLockAction = "All"
+
+	// FieldLockInclude locks the fields listed in the lock.
+	FieldLockInclude FieldLockAction = "Include"
+
+	// FieldLockExclude locks all the fields except those listed in the lock.
+	FieldLockExclude FieldLockAction = "Exclude"
+)
+
+// PdfSignatureFieldLock is the field lock dictionary of a signature field (Lock entry, 12.7.5.5 - Table 233): the
+// form fields that become read-only once the signature field is signed.
+type PdfSignatureFieldLock struct {
+	Action FieldLockAction
+
+	// Fully qualified names of the fields included or excluded, for FieldLockInclude and FieldLockExclude.
+	// A non-terminal field stands for its descendants.
+	Fields []string
+
+	// Access permissions granted for the document after signing (P entry, PDF 2.0), 1 to 3 as for
+	// CertificationLevel, or 0 if not set.
+	P int
+}
+
+// NewPdfSignatureFieldLockFromPdfObject loads a field lock from a field lock dictionary.
+func NewPdfSignatureFieldLockFromPdfObject(obj PdfObject) (*PdfSignatureFieldLock, error) {
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("ERROR: Field lock not a dictionary (%T)", obj)
+		return nil, ErrTypeError
+	}
+
+	lock := &PdfSignatureFieldLock{}
+	action, ok := TraceToDirectObject(dict.Get("Action")).(*PdfObjectName)
+	if !ok {
+		common.Log.Debug("ERROR: Field lock Action missing")
+		return nil, ErrRequiredAttributeMissing
+	}
+	lock.Action = FieldLockAction(*action)
+	switch lock.Action {
+	case FieldLockAll, FieldLockInclude, FieldLockExclude:
+	default:
+		common.Log.Debug("ERROR: Invalid field lock Action %s", *action)
+		return nil, ErrInvalidAttribute
+	}
+
+	if fields, ok := TraceToDirectObject(dict.Get("Fields")).(*PdfObjectArray); ok {
+		for _, obj := range *fields {
+			if str, ok := TraceToDirectObject(obj).(*PdfObjectString); ok {
+				lock.Fields = append(lock.Fields, DecodeTextString(*str))
+			}
+		}
+	}
+	if p, ok := TraceToDirectObject(dict.Get("P")).(*PdfObjectInteger); ok {
+		lock.P = int(*p)
+	}
+	return lock, nil
+}
+
+// ToPdfObject returns the field lock dictionary.
+func (lock *PdfSignatureFieldLock) ToPdfObject() PdfObject {
+	dict := MakeDict()
+	dict.Set("Type", MakeName("SigFieldLock"))
+	dict.Set("Action", MakeName(string(lock.Action)))
+	if lock.Action != FieldLockAll {
+		fields := MakeArray()
+		for _, name := range lock.Fields {
+			fields.Append(EncodeTextString(name))
+		}
+		dict.Set("Fields", fields)
+	}
+	if lock.P != 0 {
+		dict.Set("P", MakeInteger(int64(lock.P)))
+	}
+	return dict
+}
+
+// locks returns true if the lock applies to the field with fully qualified name `name`.
+func (lock *PdfSignatureFieldLock) locks(name string) bool {
+	listed := false
+	for _, field := range lock.Fields {
+		if name == field || strings.HasPrefix(name, field+".") {
+			listed = true
+			break
+		}
+	}
+	switch lock.Action {
+	case FieldLockAll:
+		return true
+	case FieldLockInclude:
+		return listed
+	case FieldLockExclude:
+		return !listed
+	}
+	return false
+}
+
+// GetLock returns the field lock of a signature field, or nil if it has none.
+func (this *PdfField) GetLock() (*PdfSignatureFieldLock, error) {
+	if this.Lock == nil {
+		return nil, nil
+	}
+	return NewPdfSignatureFieldLockFromPdfObject(this.Lock)
+}
+
+// SetLock sets the field lock of a signature field, locking the fields specified by `lock` when the field is
+// signed. A nil `lock` removes the field lock.
+func (this *PdfField) SetLock(lock *PdfSignatureFieldLock) {
+	if lock == nil {
+		this.Lock = nil
+		if dict, ok := this.primitive.PdfObject.(*PdfObjectDictionary); ok {
+			dict.Remove("Lock")
+		}
+		return
+	}
+	this.Lock = MakeIndirectObject(lock.ToPdfObject())
+}
+
+// LockedFields returns the fully qualified names of the terminal fields of the form locked by the field locks of
+// its signed signature fields, in sorted order. The signature fields themselves are locked once signed.
+func (this *PdfAcroForm) LockedFields() []string {
+	terminals := this.FieldsFlattened()
+
+	locks := []*PdfSignatureFieldLock{}
+	locked := map[string]bool{}
+	for _, terminal := range terminals {
+		if terminal.Field.getFieldType() != "Sig" {
+			continue
+		}
+		if _, signed := TraceToDirectObject(terminal.Field.getInheritedV()).(*PdfObjectDictionary); !signed {
+			continue
+		}
+		locked[terminal.FullName] = true
+		lock, err := terminal.Field.GetLock()
+		if err != nil {
+			common.Log.Debug("Ignoring invalid field lock of %s: %v", terminal.FullName, err)
+			continue
+		}
+		if lock != nil {
+			locks = append(locks, lock)
+		}
+	}
+
+	for _, terminal := range terminals {
+		for _, lock := range locks {
+			if lock.locks(terminal.FullName) {
+				locked[terminal.FullName] = true
+				break
+			}
+		}
+	}
+
+	names := make([]string, 0, len(locked))
+	for name := range locked {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckFieldEdit returns ErrFieldLocked if the field with fully qualified name `name`, or one of its descendants,
+// is locked by a signature (see LockedFields), e.g. before changing its value.
+func (this *PdfAcroForm) CheckFieldEdit(name string) error {
+	for _, locked := range this.LockedFields() {
+		if locked == name || strings.HasPrefix(locked, name+".") {
+			common.Log.Debug("ERROR: Field %s locked by a signature", locked)
+			return ErrFieldLocked
+		}
+	}
+	return nil
+}
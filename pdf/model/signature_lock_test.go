@@ -0,0 +1,130 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSignatureFieldLock(t *testing.T) {
+	testcases := []struct {
+		lock   string
+		locked []string
+	}{
+		{"", []string{"sig"}},
+		{"<< /Type /SigFieldLock /Action /All >>", []string{"sig", "text"}},
+		{"<< /Type /SigFieldLock /Action /Include /Fields [(text)] >>", []string{"sig", "text"}},
+		{"<< /Type /SigFieldLock /Action /Exclude /Fields [(text)] >>", []string{"sig"}},
+	}
+
+	for i, tc := range testcases {
+		objects := append([]string{}, testSignedObjects...)
+		if tc.lock != "" {
+			objects[5] = "<< /T (sig) /FT /Sig /V 7 0 R /Lock 11 0 R /Type /Annot /Subtype /Widget " +
+				"/Rect [0 0 100 50] /P 4 0 R >>"
+			objects = append(objects, tc.lock)
+		}
+		reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(objects)))
+		if err != nil {
+			t.Fatalf("Case %d: error: %v", i, err)
+		}
+		form := reader.AcroForm
+
+		if locked := form.LockedFields(); !reflect.DeepEqual(locked, tc.locked) {
+			t.Errorf("Case %d: locked fields %v, expected %v", i, locked, tc.locked)
+		}
+		err = form.RemoveField("text")
+		if len(tc.locked) > 1 && err != ErrFieldLocked {
+			t.Errorf("Case %d: expected the locked field not to be removed, got %v", i, err)
+		} else if len(tc.locked) == 1 && err != nil {
+			t.Errorf("Case %d: error removing: %v", i, err)
+		}
+		if err := form.RemoveField("sig"); err != ErrFieldLocked {
+			t.Errorf("Case %d: expected the signed field not to be removed, got %v", i, err)
+		}
+	}
+}
+
+func TestSignatureFieldLockSetLock(t *testing.T) {
+	field := NewPdfField()
+	lock := &PdfSignatureFieldLock{Action: FieldLockInclude, Fields: []string{"address", "name"}, P: 2}
+	field.SetLock(lock)
+
+	parsed, err := field.GetLock()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, lock) {
+		t.Errorf("Lock %+v, expected %+v", parsed, lock)
+	}
+	if !parsed.locks("address.city") || parsed.locks("addresses") || parsed.locks("zip") {
+		t.Errorf("Unexpected locked fields")
+	}
+
+	field.SetLock(nil)
+	if parsed, err := field.GetLock(); parsed != nil || err != nil {
+		t.Errorf("Expected no lock, got %v (%v)", parsed, err)
+	}
+}
+
+func TestSignatureFieldLockFormEdits(t *testing.T) {
+	loadForm := func(lockAll bool) *PdfAcroForm {
+		objects := append([]string{}, testSignedObjects...)
+		if lockAll {
+			objects[5] = "<< /T (sig) /FT /Sig /V 7 0 R /Lock 11 0 R /Type /Annot /Subtype /Widget " +
+				"/Rect [0 0 100 50] /P 4 0 R >>"
+			objects = append(objects, "<< /Type /SigFieldLock /Action /All >>")
+		}
+		reader, err := NewPdfReader(bytes.NewReader(makeTestPdf(objects)))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return reader.AcroForm
+	}
+	newForm := func(name string) *PdfAcroForm {
+		form := NewPdfAcroForm()
+		field := NewPdfField()
+		field.T = EncodeTextString(name)
+		form.Fields = &[]*PdfField{field}
+		return form
+	}
+
+	// The colliding locked field is not renamed.
+	form, other := newForm("text"), loadForm(true)
+	if err := form.Merge(other, FieldMergeOptions{Strategy: FieldMergePrefix}); err != ErrFieldLocked {
+		t.Errorf("Expected the locked field not to be renamed, got %v", err)
+	}
+	if len(*form.Fields) != 1 || (*other.Fields)[1].PartialName() != "text" {
+		t.Errorf("Forms modified")
+	}
+	// Reparenting renames the signed field.
+	form, other = newForm("name"), loadForm(false)
+	if err := form.Merge(other, FieldMergeOptions{Strategy: FieldMergeReparent}); err != ErrFieldLocked {
+		t.Errorf("Expected the signed field not to be reparented, got %v", err)
+	}
+	// Unlocked fields are renamed.
+	form, other = newForm("text"), loadForm(false)
+	if err := form.Merge(other, FieldMergeOptions{Strategy: FieldMergeSuffix}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(*form.Fields) != 3 || (*form.Fields)[2].PartialName() != "text_copy" {
+		t.Errorf("Unexpected merged fields")
+	}
+
+	form = loadForm(true)
+	if err := form.SetAppearanceOptions(FormAppearanceOptions{Viewer: FormViewerRegenerate}); err != ErrFieldLocked {
+		t.Errorf("Expected the appearances of the locked field not to be removed, got %v", err)
+	}
+	if form.NeedAppearances != nil {
+		t.Errorf("Form modified")
+	}
+	form = loadForm(false)
+	if err := form.SetAppearanceOptions(FormAppearanceOptions{Viewer: FormViewerRegenerate}); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+}
@@ -0,0 +1,375 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Object identifiers of the OCSP (RFC 6960) structures and the Adobe revocation information attribute.
+var (
+	oidSHA1                       = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidOCSPBasic                  = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+	oidAttrRevocationInfoArchival = asn1.ObjectIdentifier{1, 2, 840, 113583, 1, 1, 8}
+)
+
+type ocspCertID struct {
+	HashAlgorithm  pkix.AlgorithmIdentifier
+	IssuerNameHash []byte
+	IssuerKeyHash  []byte
+	SerialNumber   *big.Int
+}
+
+type ocspRequest struct {
+	TBSRequest struct {
+		RequestList []struct {
+			ReqCert ocspCertID
+		}
+	}
+}
+
+type ocspResponse struct {
+	Status        asn1.Enumerated
+	ResponseBytes struct {
+		ResponseType asn1.ObjectIdentifier
+		Response     []byte
+	} `asn1:"explicit,optional,tag:0"`
+}
+
+// crlTBSCertList is the beginning of the TBSCertList of a CRL, up to the DER encoded issuer name.
+type crlTBSCertList struct {
+	Version   int `asn1:"optional,default:0"`
+	Signature pkix.AlgorithmIdentifier
+	Issuer    asn1.RawValue
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certs              []asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type ocspResponseData struct {
+	Version     int `asn1:"optional,explicit,default:0,tag:0"`
+	ResponderID asn1.RawValue
+	ProducedAt  time.Time `asn1:"generalized"`
+	Responses   []ocspSingleResponse
+	Extensions  asn1.RawValue `asn1:"optional,explicit,tag:1"`
+}
+
+type ocspSingleResponse struct {
+	CertID     ocspCertID
+	CertStatus asn1.RawValue // [0] good, [1] revoked or [2] unknown.
+	ThisUpdate time.Time     `asn1:"generalized"`
+	NextUpdate time.Time     `asn1:"generalized,explicit,optional,tag:0"`
+	Extensions asn1.RawValue `asn1:"explicit,optional,tag:1"`
+}
+
+// adbeRevocationInfoArchival is the value of the adbe-revocationInfoArchival signed attribute (PDF 1.7, 12.8.3.3.2).
+type adbeRevocationInfoArchival struct {
+	CRLs  []asn1.RawValue `asn1:"explicit,optional,tag:0"`
+	OCSPs []asn1.RawValue `asn1:"explicit,optional,tag:1"`
+	Other []asn1.RawValue `asn1:"explicit,optional,tag:2"`
+}
+
+// RevocationInfo is revocation information of certificates: DER encoded OCSP responses and CRLs, e.g. fetched with
+// FetchRevocationInfo, to be embedded into documents for validating their signatures offline.
+type RevocationInfo struct {
+	OCSPs [][]byte
+	CRLs  [][]byte
+}
+
+// RevocationFetchOptions are the options of FetchRevocationInfo.
+type RevocationFetchOptions struct {
+	// HTTP client for the requests, http.DefaultClient if nil.
+	Client *http.Client
+
+	// Timeout of each request, 10 seconds if 0.
+	Timeout time.Duration
+
+	// FetchAll fetches both OCSP responses and CRLs for each certificate. By default, CRLs are only fetched for
+	// certificates without OCSP responder or whose OCSP responders fail.
+	FetchAll bool
+}
+
+// FetchRevocationInfo fetches revocation information for the certificates of `chain`, ordered from the signer
+// certificate to the root, each certificate being issued by the next one. OCSP responses are requested from the
+// OCSP responders of the certificates (Authority Information Access extension) and CRLs downloaded from their
+// CRL distribution points. The last certificate of the chain, normally a trust anchor, is not checked.
+//
+// The revocation information can be added to the document security store with PdfDSS.AddRevocationInfo, or
+// embedded into the signed attributes of a CMS signature with AdbeRevocationAttribute. The OCSP responses and CRLs
+// are checked to be successful and issued for the certificates, but their signatures are not verified. Returns
+// an error if a certificate is revoked, or if no revocation information is obtained for a certificate with OCSP
+// responders or CRL distribution points.
+func FetchRevocationInfo(chain []*x509.Certificate, opts *RevocationFetchOptions) (*RevocationInfo, error) {
+	if opts == nil {
+		opts = &RevocationFetchOptions{}
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	// A copy of the client, so that the timeout does not affect the caller's client.
+	c := *client
+	c.Timeout = timeout
+
+	info := &RevocationInfo{}
+	for i := 0; i+1 < len(chain); i++ {
+		cert, issuer := chain[i], chain[i+1]
+		if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+			common.Log.Debug("No revocation information for %s", cert.Subject.CommonName)
+			continue
+		}
+
+		var errs []string
+		found := false
+		for _, url := range cert.OCSPServer {
+			resp, err := fetchOCSPResponse(&c, url, cert, issuer)
+			if err != nil {
+				if err == errCertificateRevoked {
+					return nil, fmt.Errorf("certificate %s revoked", cert.Subject.CommonName)
+				}
+				errs = append(errs, fmt.Sprintf("OCSP %s: %v", url, err))
+				continue
+			}
+			info.OCSPs = append(info.OCSPs, resp)
+			found = true
+			break
+		}
+		if found && !opts.FetchAll {
+			continue
+		}
+		for _, url := range cert.CRLDistributionPoints {
+			crl, err := fetchCRL(&c, url, cert, issuer)
+			if err != nil {
+				if err == errCertificateRevoked {
+					return nil, fmt.Errorf("certificate %s revoked", cert.Subject.CommonName)
+				}
+				errs = append(errs, fmt.Sprintf("CRL %s: %v", url, err))
+				continue
+			}
+			info.CRLs = append(info.CRLs, crl)
+			found = true
+			break
+		}
+		if !found {
+			common.Log.Debug("ERROR: No revocation information for %s: %v", cert.Subject.CommonName, errs)
+			return nil, fmt.Errorf("no revocation information for %s: %v", cert.Subject.CommonName, errs)
+		}
+	}
+	return info, nil
+}
+
+// errCertificateRevoked is returned by fetchOCSPResponse and fetchCRL when the certificate is revoked.
+var errCertificateRevoked = errors.New("certificate revoked")
+
+// maxRevocationResponseSize is the maximum size of the OCSP responses and CRLs downloaded by FetchRevocationInfo.
+const maxRevocationResponseSize = 8 << 20
+
+// readRevocationResponse reads the body `r` of an OCSP or CRL response, up to maxRevocationResponseSize bytes.
+func readRevocationResponse(r io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxRevocationResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRevocationResponseSize {
+		common.Log.Debug("ERROR: Revocation response larger than %d bytes", maxRevocationResponseSize)
+		return nil, fmt.Errorf("response larger than %d bytes", maxRevocationResponseSize)
+	}
+	return data, nil
+}
+
+// fetchOCSPResponse requests the OCSP response for `cert` issued by `issuer` from the OCSP responder at `url`.
+func fetchOCSPResponse(client *http.Client, url string, cert, issuer *x509.Certificate) ([]byte, error) {
+	id, err := newOCSPCertID(cert, issuer)
+	if err != nil {
+		return nil, err
+	}
+	var req ocspRequest
+	req.TBSRequest.RequestList = append(req.TBSRequest.RequestList, struct{ ReqCert ocspCertID }{*id})
+	der, err := asn1.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Post(url, "application/ocsp-request", bytes.NewReader(der))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status %s", resp.Status)
+	}
+	data, err := readRevocationResponse(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOCSPResponse(data, id); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// newOCSPCertID returns the OCSP certificate identifier of `cert` issued by `issuer`, with SHA-1 hashes.
+func newOCSPCertID(cert, issuer *x509.Certificate) (*ocspCertID, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, err
+	}
+	nameHash := sha1.Sum(issuer.RawSubject)
+	keyHash := sha1.Sum(spki.PublicKey.RightAlign())
+	return &ocspCertID{
+		HashAlgorithm:  pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+		IssuerNameHash: nameHash[:],
+		IssuerKeyHash:  keyHash[:],
+		SerialNumber:   cert.SerialNumber,
+	}, nil
+}
+
+// checkOCSPResponse checks that DER encoded OCSP response `der` is successful and has a response for the
+// certificate identified by `id`, returning errCertificateRevoked if the certificate is revoked.
+func checkOCSPResponse(der []byte, id *ocspCertID) error {
+	var resp ocspResponse
+	if _, err := asn1.Unmarshal(der, &resp); err != nil {
+		return fmt.Errorf("invalid OCSP response: %v", err)
+	}
+	if resp.Status != 0 {
+		return fmt.Errorf("OCSP response status %d", resp.Status)
+	}
+	if !resp.ResponseBytes.ResponseType.Equal(oidOCSPBasic) {
+		return fmt.Errorf("unsupported OCSP response type %s", resp.ResponseBytes.ResponseType)
+	}
+	var basic ocspBasicResponse
+	if _, err := asn1.Unmarshal(resp.ResponseBytes.Response, &basic); err != nil {
+		return fmt.Errorf("invalid OCSP basic response: %v", err)
+	}
+	var data ocspResponseData
+	if _, err := asn1.Unmarshal(basic.TBSResponseData.FullBytes, &data); err != nil {
+		return fmt.Errorf("invalid OCSP response data: %v", err)
+	}
+
+	for _, single := range data.Responses {
+		if single.CertID.SerialNumber == nil || single.CertID.SerialNumber.Cmp(id.SerialNumber) != 0 ||
+			!bytes.Equal(single.CertID.IssuerKeyHash, id.IssuerKeyHash) {
+			continue
+		}
+		switch single.CertStatus.Tag {
+		case 0:
+			return nil
+		case 1:
+			return errCertificateRevoked
+		default:
+			return errors.New("certificate status unknown")
+		}
+	}
+	return errors.New("no OCSP response for the certificate")
+}
+
+// fetchCRL downloads the CRL at `url`, checking that it is issued by `issuer`, and returns it DER encoded.
+// Returns errCertificateRevoked if `cert` is listed.
+func fetchCRL(client *http.Client, url string, cert, issuer *x509.Certificate) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status %s", resp.Status)
+	}
+	data, err := readRevocationResponse(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	crl, err := x509.ParseCRL(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CRL: %v", err)
+	}
+	var tbs crlTBSCertList
+	if _, err := asn1.Unmarshal(crl.TBSCertList.Raw, &tbs); err != nil {
+		return nil, fmt.Errorf("invalid CRL: %v", err)
+	}
+	if !bytes.Equal(tbs.Issuer.FullBytes, issuer.RawSubject) {
+		return nil, errors.New("CRL not issued by the certificate issuer")
+	}
+	for _, entry := range crl.TBSCertList.RevokedCertificates {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return nil, errCertificateRevoked
+		}
+	}
+	return data, nil
+}
+
+// AddRevocationInfo adds the certificates of `chain` and the revocation information `info` to the document
+// security store, as AddValidationData, e.g. with the revocation information fetched for the chain of a signature
+// with FetchRevocationInfo.
+func (dss *PdfDSS) AddRevocationInfo(contents []byte, chain []*x509.Certificate, info *RevocationInfo) {
+	certs := make([][]byte, 0, len(chain))
+	for _, cert := range chain {
+		certs = append(certs, cert.Raw)
+	}
+	dss.AddValidationData(contents, certs, info.OCSPs, info.CRLs, time.Now())
+}
+
+// AdbeRevocationAttribute returns the DER encoded adbe-revocationInfoArchival attribute (OID 1.2.840.113583.1.1.8)
+// with the revocation information, to be included in the signed attributes of a CMS signature for validating it
+// offline, e.g. when signing with an external signer.
+func (info *RevocationInfo) AdbeRevocationAttribute() ([]byte, error) {
+	var archival adbeRevocationInfoArchival
+	for _, crl := range info.CRLs {
+		archival.CRLs = append(archival.CRLs, asn1.RawValue{FullBytes: crl})
+	}
+	for _, resp := range info.OCSPs {
+		archival.OCSPs = append(archival.OCSPs, asn1.RawValue{FullBytes: resp})
+	}
+	value, err := asn1.Marshal(archival)
+	if err != nil {
+		return nil, err
+	}
+	values, err := asn1.MarshalWithParams([]asn1.RawValue{{FullBytes: value}}, "set")
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(cmsAttribute{Type: oidAttrRevocationInfoArchival, Values: asn1.RawValue{FullBytes: values}})
+}
+
+// parseAdbeRevocationInfoArchival parses the value of an adbe-revocationInfoArchival attribute.
+func parseAdbeRevocationInfoArchival(der []byte) (*RevocationInfo, error) {
+	var archival adbeRevocationInfoArchival
+	if _, err := asn1.Unmarshal(der, &archival); err != nil {
+		return nil, fmt.Errorf("invalid revocation information attribute: %v", err)
+	}
+	info := &RevocationInfo{}
+	for _, crl := range archival.CRLs {
+		info.CRLs = append(info.CRLs, crl.FullBytes)
+	}
+	for _, resp := range archival.OCSPs {
+		info.OCSPs = append(info.OCSPs, resp.FullBytes)
+	}
+	return info, nil
+}
@@ -0,0 +1,227 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// makeTestOCSPResponse returns a successful OCSP response for `id` with certificate status tag `status` (0 good,
+// 1 revoked), signed with `key`.
+func makeTestOCSPResponse(t *testing.T, id ocspCertID, status int, key *rsa.PrivateKey) []byte {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	certStatus := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: status}
+	if status == 1 {
+		revocationTime, _ := asn1.MarshalWithParams(now, "generalized")
+		certStatus.IsCompound = true
+		certStatus.Bytes = revocationTime
+	}
+	tbs, err := asn1.Marshal(ocspResponseData{
+		ResponderID: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: []byte{4, 0}},
+		ProducedAt:  now,
+		Responses:   []ocspSingleResponse{{CertID: id, CertStatus: certStatus, ThisUpdate: now}},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	digest := sha256.Sum256(tbs)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	basic, err := asn1.Marshal(ocspBasicResponse{
+		TBSResponseData:    asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}},
+		Signature:          asn1.BitString{Bytes: signature, BitLength: 8 * len(signature)},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	var resp ocspResponse
+	resp.ResponseBytes.ResponseType = oidOCSPBasic
+	resp.ResponseBytes.Response = basic
+	der, err := asn1.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return der
+}
+
+func TestFetchRevocationInfo(t *testing.T) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	ca, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	// Certificates with serial numbers 7 and 8 are revoked, by OCSP and CRL respectively.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ocsp", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req ocspRequest
+		if _, err := asn1.Unmarshal(body, &req); err != nil || len(req.TBSRequest.RequestList) != 1 {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		id := req.TBSRequest.RequestList[0].ReqCert
+		status := 0
+		if id.SerialNumber.Int64() == 7 {
+			status = 1
+		}
+		w.Write(makeTestOCSPResponse(t, id, status, caKey))
+	})
+	mux.HandleFunc("/crl", func(w http.ResponseWriter, r *http.Request) {
+		revoked := []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(8), RevocationTime: time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		crl, err := ca.CreateCRL(rand.Reader, caKey, revoked, time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(crl)
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, maxRevocationResponseSize+1))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	makeCert := func(serial int64, ocsp, crl string) *x509.Certificate {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "Test Signer"},
+			NotBefore:    time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			NotAfter:     time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		if ocsp != "" {
+			template.OCSPServer = []string{server.URL + ocsp}
+		}
+		if crl != "" {
+			template.CRLDistributionPoints = []string{server.URL + crl}
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return cert
+	}
+
+	testcases := []struct {
+		Cert         *x509.Certificate
+		OCSPs, CRLs  int
+		ExpectsError bool
+	}{
+		{makeCert(5, "/ocsp", "/crl"), 1, 0, false},
+		{makeCert(6, "", "/crl"), 0, 1, false},
+		{makeCert(9, "/missing", "/crl"), 0, 1, false},
+		{makeCert(10, "", ""), 0, 0, false},
+		{makeCert(7, "/ocsp", ""), 0, 0, true},
+		{makeCert(8, "", "/crl"), 0, 0, true},
+		{makeCert(11, "/missing", ""), 0, 0, true},
+		{makeCert(13, "", "/large"), 0, 0, true},
+	}
+	for _, tcase := range testcases {
+		serial := tcase.Cert.SerialNumber
+		info, err := FetchRevocationInfo([]*x509.Certificate{tcase.Cert, ca}, &RevocationFetchOptions{Timeout: 5 * time.Second})
+		if tcase.ExpectsError {
+			if err == nil {
+				t.Errorf("Serial %s: should fail", serial)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Serial %s: error: %v", serial, err)
+			continue
+		}
+		if len(info.OCSPs) != tcase.OCSPs || len(info.CRLs) != tcase.CRLs {
+			t.Errorf("Serial %s: %d OCSP responses and %d CRLs, expected %d and %d", serial, len(info.OCSPs),
+				len(info.CRLs), tcase.OCSPs, tcase.CRLs)
+		}
+	}
+
+	chain := []*x509.Certificate{makeCert(12, "/ocsp", "/crl"), ca}
+	info, err := FetchRevocationInfo(chain, &RevocationFetchOptions{FetchAll: true})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(info.OCSPs) != 1 || len(info.CRLs) != 1 {
+		t.Fatalf("Expected both OCSP response and CRL, got %d and %d", len(info.OCSPs), len(info.CRLs))
+	}
+
+	// Embedding into the signed attributes of a signature.
+	attrDer, err := info.AdbeRevocationAttribute()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	var attr cmsAttribute
+	if _, err := asn1.Unmarshal(attrDer, &attr); err != nil || !attr.Type.Equal(oidAttrRevocationInfoArchival) {
+		t.Fatalf("Invalid attribute %v (%v)", attr.Type, err)
+	}
+	parsed, err := parseAdbeRevocationInfoArchival(attr.Values.Bytes)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, info) {
+		t.Errorf("Revocation information not preserved")
+	}
+
+	// Embedding into the document security store.
+	dss := NewPdfDSS()
+	dss.AddRevocationInfo([]byte("contents"), chain, info)
+	if len(dss.Certs) != 2 || len(dss.OCSPs) != 1 || len(dss.CRLs) != 1 || len(dss.VRI) != 1 {
+		t.Errorf("Unexpected DSS %+v", dss)
+	}
+}
+
+func TestReadRevocationResponseLimit(t *testing.T) {
+	data, err := readRevocationResponse(bytes.NewReader(make([]byte, maxRevocationResponseSize)))
+	if err != nil || len(data) != maxRevocationResponseSize {
+		t.Errorf("Expected %d bytes, got %d (%v)", maxRevocationResponseSize, len(data), err)
+	}
+	if _, err := readRevocationResponse(bytes.NewReader(make([]byte, maxRevocationResponseSize+1))); err == nil {
+		t.Errorf("Expected an error for a response past the limit")
+	}
+}
@@ -0,0 +1,320 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// SignatureValidationOptions are the options of ValidateSignatures.
+type SignatureValidationOptions struct {
+	// Trusted root certificates for verifying the certificate chains, the system roots if nil.
+	Roots *x509.CertPool
+
+	// Additional intermediate certificates, besides those embedded in the signatures and in the document
+	// security store.
+	Intermediates []*x509.Certificate
+
+	// Time at which the certificate chains are verified. Defaults to the signing time if known, otherwise the
+	// current time.
+	VerificationTime time.Time
+}
+
+// SignatureValidation is the result of validating a signature of the document with ValidateSignatures.
+type SignatureValidation struct {
+	// Fully qualified name of the signature field.
+	FieldName string
+
+	// SubFilter of the signature dictionary, e.g. adbe.pkcs7.detached.
+	SubFilter string
+
+	// Name of the signer, the certificate subject common name if the signature dictionary has no Name.
+	Name string
+
+	// Signing time, from the signed attributes of the signature if present, otherwise the M entry of the
+	// signature dictionary (which is not protected by the signature). Zero if not known.
+	SigningTime time.Time
+
+	// Certificate of the signer, nil if not found.
+	Signer *x509.Certificate
+
+	// Revocation information embedded in the signed attributes of the signature (adbe-revocationInfoArchival
+	// attribute), nil if none.
+	RevocationInfo *RevocationInfo
+
+	// Result of checking the byte range.
+	ByteRange *SignatureByteRangeCheck
+
+	// DigestValid is true if the digest of the signed bytes matches the digest protected by the signature.
+	DigestValid bool
+
+	// SignatureValid is true if the signature value verifies with the public key of the signer.
+	SignatureValid bool
+
+	// ChainValid is true if the certificate of the signer chains to a trusted root.
+	ChainValid bool
+
+	// ModifiedAfterSigning is true if the file has incremental updates after the signed revision. Such updates
+	// can be legitimate, e.g. further signatures or form filling.
+	ModifiedAfterSigning bool
+
+	// Problems found, empty if the signature is valid.
+	Issues []string
+}
+
+// Valid returns true if the signature is valid: the byte range, digest, signature value and certificate chain
+// are valid. It does not tell whether the document was modified after signing.
+func (v *SignatureValidation) Valid() bool {
+	return len(v.Issues) == 0 && v.ByteRange.Valid() && v.DigestValid && v.SignatureValid && v.ChainValid
+}
+
+// ValidateSignatures validates the signatures of the signed signature fields of the document: it checks the byte
+// range (see PdfReader.CheckSignatureByteRanges), verifies the digest and the value of the signature and the
+// certificate chain of the signer. Supported subfilters are adbe.pkcs7.detached, ETSI.CAdES.detached,
+// adbe.pkcs7.sha1 and adbe.x509.rsa_sha1. Timestamps and revocation are not checked.
+// A nil `opts` uses the default options.
+func ValidateSignatures(reader *PdfReader, opts *SignatureValidationOptions) ([]*SignatureValidation, error) {
+	if opts == nil {
+		opts = &SignatureValidationOptions{}
+	}
+	validations := []*SignatureValidation{}
+	if reader.AcroForm == nil {
+		return validations, nil
+	}
+
+	checks, err := reader.CheckSignatureByteRanges()
+	if err != nil {
+		return nil, err
+	}
+	byteRangeChecks := map[string]*SignatureByteRangeCheck{}
+	for _, check := range checks {
+		byteRangeChecks[check.FieldName] = check
+	}
+	data, err := reader.GetRevisionBytes(reader.GetNumRevisions() - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Certificates of the document security store complete the chains. An invalid DSS does not prevent
+	// validating the signatures, whose chains may be complete without it.
+	dss, err := reader.GetDSS()
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to load the DSS, validating without its certificates: %v", err)
+		dss = nil
+	}
+	if dss != nil && len(dss.Certs) > 0 {
+		intermediates := append([]*x509.Certificate{}, opts.Intermediates...)
+		for _, der := range dss.Certs {
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				common.Log.Debug("Invalid DSS certificate: %v", err)
+				continue
+			}
+			intermediates = append(intermediates, cert)
+		}
+		optsCopy := *opts
+		optsCopy.Intermediates = intermediates
+		opts = &optsCopy
+	}
+
+	for _, terminal := range reader.AcroForm.FieldsFlattened() {
+		if terminal.Field.getFieldType() != "Sig" {
+			continue
+		}
+		sigDict, ok := TraceToDirectObject(terminal.Field.getInheritedV()).(*PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+		check, ok := byteRangeChecks[terminal.FullName]
+		if !ok {
+			continue
+		}
+
+		v := &SignatureValidation{FieldName: terminal.FullName, ByteRange: check}
+		validations = append(validations, v)
+		v.ModifiedAfterSigning = len(check.ByteRange) == 4 && !check.CoversFile
+
+		if name, ok := TraceToDirectObject(sigDict.Get("SubFilter")).(*PdfObjectName); ok {
+			v.SubFilter = string(*name)
+		}
+		if str, ok := TraceToDirectObject(sigDict.Get("Name")).(*PdfObjectString); ok {
+			v.Name = DecodeTextString(*str)
+		}
+		if str, ok := TraceToDirectObject(sigDict.Get("M")).(*PdfObjectString); ok {
+			if date, err := NewPdfDate(string(*str)); err == nil {
+				v.SigningTime = date.ToGoTime()
+			}
+		}
+
+		if !check.Valid() {
+			v.Issues = append(v.Issues, "invalid byte range")
+		} else {
+			signed := signedBytes(data, check.ByteRange)
+			contents, _ := TraceToDirectObject(sigDict.Get("Contents")).(*PdfObjectString)
+			v.validate(sigDict, signed, contents, opts)
+		}
+
+		if v.Name == "" && v.Signer != nil {
+			v.Name = v.Signer.Subject.CommonName
+		}
+		if len(v.Issues) > 0 {
+			common.Log.Debug("Signature %s issues: %v", v.FieldName, v.Issues)
+		}
+	}
+	return validations, nil
+}
+
+// validate verifies the digest and value of the signature with Contents `contents` of the bytes `signed`, and the
+// certificate chain of the signer.
+func (v *SignatureValidation) validate(sigDict *PdfObjectDictionary, signed []byte, contents *PdfObjectString,
+	opts *SignatureValidationOptions) {
+	if contents == nil {
+		v.Issues = append(v.Issues, "Contents missing")
+		return
+	}
+
+	var certs []*x509.Certificate
+	switch v.SubFilter {
+	case "adbe.x509.rsa_sha1":
+		// PKCS #1 signature of the SHA-1 digest, with the certificates in the Cert entry.
+		certs = parseSignatureCerts(sigDict.Get("Cert"))
+		if len(certs) == 0 {
+			v.Issues = append(v.Issues, "Cert missing")
+			return
+		}
+		v.Signer = certs[0]
+		var signature []byte
+		if _, err := asn1.Unmarshal([]byte(*contents), &signature); err != nil {
+			v.Issues = append(v.Issues, fmt.Sprintf("invalid signature value: %v", err))
+			return
+		}
+		if err := verifySignature(v.Signer, crypto.SHA1, false, signed, signature); err != nil {
+			v.Issues = append(v.Issues, fmt.Sprintf("signature verification failed: %v", err))
+			return
+		}
+		v.DigestValid = true
+		v.SignatureValid = true
+
+	case "adbe.pkcs7.detached", "ETSI.CAdES.detached", "adbe.pkcs7.sha1":
+		sig, err := parseCMSSignature([]byte(*contents))
+		if err != nil {
+			v.Issues = append(v.Issues, err.Error())
+			return
+		}
+		certs = sig.certificates
+		v.Signer = sig.signer
+		v.RevocationInfo = sig.revocation
+		if v.Signer == nil {
+			v.Issues = append(v.Issues, "signer certificate not found")
+			return
+		}
+		if !sig.signingTime.IsZero() {
+			v.SigningTime = sig.signingTime
+		}
+
+		// The message is the signed bytes, or their SHA-1 digest encapsulated in the signature (adbe.pkcs7.sha1).
+		message := signed
+		if v.SubFilter == "adbe.pkcs7.sha1" {
+			digest := hashBytes(crypto.SHA1, signed)
+			if !bytes.Equal(sig.content, digest) {
+				v.Issues = append(v.Issues, "digest mismatch")
+				return
+			}
+			message = sig.content
+		}
+
+		if sig.signedAttrs != nil {
+			if !bytes.Equal(sig.messageDigest, hashBytes(sig.hash, message)) {
+				v.Issues = append(v.Issues, "digest mismatch")
+				return
+			}
+			v.DigestValid = true
+			message = sig.signedAttrs
+		}
+		if err := verifySignature(v.Signer, sig.hash, sig.pss, message, sig.signature); err != nil {
+			v.Issues = append(v.Issues, fmt.Sprintf("signature verification failed: %v", err))
+			return
+		}
+		v.SignatureValid = true
+		// Without signed attributes, the signature is computed over the message itself.
+		v.DigestValid = true
+
+	default:
+		v.Issues = append(v.Issues, fmt.Sprintf("unsupported SubFilter %q", v.SubFilter))
+		return
+	}
+
+	verifyOpts := x509.VerifyOptions{
+		Roots:         opts.Roots,
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   opts.VerificationTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, cert := range opts.Intermediates {
+		verifyOpts.Intermediates.AddCert(cert)
+	}
+	for _, cert := range certs {
+		if cert != v.Signer {
+			verifyOpts.Intermediates.AddCert(cert)
+		}
+	}
+	if verifyOpts.CurrentTime.IsZero() {
+		verifyOpts.CurrentTime = v.SigningTime
+	}
+	if _, err := v.Signer.Verify(verifyOpts); err != nil {
+		v.Issues = append(v.Issues, fmt.Sprintf("certificate chain verification failed: %v", err))
+		return
+	}
+	v.ChainValid = true
+}
+
+// signedBytes returns the bytes of `data` covered by the valid byte range `byteRange`.
+func signedBytes(data []byte, byteRange []int64) []byte {
+	signed := make([]byte, 0, byteRange[1]+byteRange[3])
+	signed = append(signed, data[byteRange[0]:byteRange[0]+byteRange[1]]...)
+	return append(signed, data[byteRange[2]:byteRange[2]+byteRange[3]]...)
+}
+
+// hashBytes returns the digest of `data` with hash function `hash`.
+func hashBytes(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// parseSignatureCerts returns the certificates of the Cert entry `obj` of a signature dictionary, a string or an
+// array of strings with DER encoded certificates, the signer certificate first.
+func parseSignatureCerts(obj PdfObject) []*x509.Certificate {
+	var strs []PdfObject
+	switch t := TraceToDirectObject(obj).(type) {
+	case *PdfObjectString:
+		strs = append(strs, t)
+	case *PdfObjectArray:
+		strs = *t
+	}
+	certs := []*x509.Certificate{}
+	for _, s := range strs {
+		str, ok := TraceToDirectObject(s).(*PdfObjectString)
+		if !ok {
+			continue
+		}
+		cert, err := x509.ParseCertificate([]byte(*str))
+		if err != nil {
+			common.Log.Debug("Invalid signature certificate: %v", err)
+			continue
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
@@ -0,0 +1,217 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// makeTestCertificate returns a self-signed certificate valid in 2018 and its key.
+func makeTestCertificate(t *testing.T) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(42),
+		Subject:               pkix.Name{CommonName: "Test Signer"},
+		NotBefore:             time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return cert, key
+}
+
+// makeTestCMS returns a detached CMS signature of `data` with signed attributes (message digest and signing time
+// `signingTime`).
+func makeTestCMS(t *testing.T, data []byte, cert *x509.Certificate, key *rsa.PrivateKey, signingTime time.Time,
+	pss bool) []byte {
+	mustMarshal := func(val interface{}, params string) []byte {
+		der, err := asn1.MarshalWithParams(val, params)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return der
+	}
+	attrValue := func(val interface{}, params string) asn1.RawValue {
+		return asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: mustMarshal(val, params)}
+	}
+
+	digest := sha256.Sum256(data)
+	attrs := mustMarshal([]cmsAttribute{
+		{Type: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}, Values: attrValue(asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}, "")},
+		{Type: oidAttrSigningTime, Values: attrValue(signingTime, "utc")},
+		{Type: oidAttrMessageDigest, Values: attrValue(digest[:], "")},
+	}, "set")
+	attrsDigest := sha256.Sum256(attrs)
+	signatureAlgorithm := pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}}
+	var signature []byte
+	var err error
+	if pss {
+		signatureAlgorithm.Algorithm = oidRSASSAPSS
+		signature, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, attrsDigest[:], nil)
+	} else {
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, attrsDigest[:])
+	}
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	signedAttrs := append([]byte{}, attrs...)
+	signedAttrs[0] = 0xa0
+
+	sha256ID := pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}}
+	sid := mustMarshal(cmsIssuerAndSerialNumber{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, SerialNumber: cert.SerialNumber}, "")
+	sd := cmsSignedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{sha256ID},
+		EncapContentInfo: cmsEncapContentInfo{ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: cert.Raw},
+		SignerInfos: []cmsSignerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    sha256ID,
+			SignedAttrs:        asn1.RawValue{FullBytes: signedAttrs},
+			SignatureAlgorithm: signatureAlgorithm,
+			Signature:          signature,
+		}},
+	}
+	content := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: mustMarshal(sd, "")}
+	return mustMarshal(cmsContentInfo{ContentType: oidSignedData, Content: content}, "")
+}
+
+// makeCMSSignedTestPdf returns the signed test document with a CMS signature by `cert`, RSASSA-PSS if `pss` is
+// true. `tamper` is called with the file data after signing.
+func makeCMSSignedTestPdf(t *testing.T, cert *x509.Certificate, key *rsa.PrivateKey, pss bool,
+	tamper func(data []byte)) []byte {
+	objects := append([]string{}, testSignedObjects...)
+	objects[6] = "<< /Type /Sig /Filter /Adobe.PPKLite /SubFilter /adbe.pkcs7.detached /Name (Jane Doe) " +
+		"/M (D:20180101120000Z) /ByteRange [0 ********** ********** **********] /Contents <" +
+		strings.Repeat("0", 8192) + "> >>"
+	data := makeTestPdf(objects)
+
+	gapStart := bytes.Index(data, []byte("/Contents <")) + len("/Contents ")
+	gapEnd := gapStart + bytes.IndexByte(data[gapStart:], '>') + 1
+	placeholder := []byte("0 ********** ********** **********")
+	filled := []byte(fmt.Sprintf("%d %10d %10d %10d", 0, gapStart, gapEnd, len(data)-gapEnd))
+	data = bytes.Replace(data, placeholder, filled, 1)
+
+	signed := append(append([]byte{}, data[:gapStart]...), data[gapEnd:]...)
+	cms := makeTestCMS(t, signed, cert, key, time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC), pss)
+	hex.Encode(data[gapStart+1:], cms)
+	tamper(data)
+	return data
+}
+
+func TestValidateSignatures(t *testing.T) {
+	cert, key := makeTestCertificate(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	opts := &SignatureValidationOptions{Roots: roots}
+
+	validate := func(data []byte, opts *SignatureValidationOptions) *SignatureValidation {
+		reader, err := NewPdfReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		validations, err := ValidateSignatures(reader, opts)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if len(validations) != 1 {
+			t.Fatalf("Expected 1 signature, got %d", len(validations))
+		}
+		return validations[0]
+	}
+
+	signed := makeCMSSignedTestPdf(t, cert, key, false, func(data []byte) {})
+	v := validate(signed, opts)
+	if !v.Valid() || v.ModifiedAfterSigning || v.FieldName != "sig" || v.Name != "Jane Doe" {
+		t.Errorf("Expected a valid signature: %+v", v)
+	}
+	if !v.SigningTime.Equal(time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected signing time %v", v.SigningTime)
+	}
+	if v.Signer == nil || v.Signer.Subject.CommonName != "Test Signer" {
+		t.Errorf("Unexpected signer %v", v.Signer)
+	}
+
+	// Untrusted signer.
+	v = validate(signed, &SignatureValidationOptions{Roots: x509.NewCertPool()})
+	if v.Valid() || !v.DigestValid || !v.SignatureValid || v.ChainValid {
+		t.Errorf("Expected an untrusted signature: %+v", v)
+	}
+
+	// Signed bytes changed.
+	tampered := makeCMSSignedTestPdf(t, cert, key, false, func(data []byte) {
+		i := bytes.Index(data, []byte("Jane Doe"))
+		data[i] = 'X'
+	})
+	v = validate(tampered, opts)
+	if v.Valid() || v.DigestValid {
+		t.Errorf("Expected a digest mismatch: %+v", v)
+	}
+
+	// RSASSA-PSS signature.
+	v = validate(makeCMSSignedTestPdf(t, cert, key, true, func(data []byte) {}), opts)
+	if !v.Valid() {
+		t.Errorf("Expected a valid RSASSA-PSS signature: %+v", v)
+	}
+
+	// Incremental update after signing.
+	v = validate(appendTestUpdate(signed), opts)
+	if !v.Valid() || !v.ModifiedAfterSigning {
+		t.Errorf("Expected a valid signature of a modified document: %+v", v)
+	}
+
+	// A DSS that cannot be loaded is skipped.
+	reader, err := NewPdfReader(bytes.NewReader(signed))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	badCert, err := MakeStream([]byte("certificate"), NewRawEncoder())
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	badCert.Set("Filter", MakeName("UnknownDecode"))
+	dss := MakeDict()
+	dss.Set("Certs", MakeArray(badCert))
+	reader.catalog.Set("DSS", dss)
+	if _, err := reader.GetDSS(); err == nil {
+		t.Fatalf("Expected an invalid DSS")
+	}
+	validations, err := ValidateSignatures(reader, opts)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(validations) != 1 || !validations[0].Valid() {
+		t.Errorf("Expected a valid signature with an invalid DSS: %+v", validations)
+	}
+}
@@ -0,0 +1,377 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfStructTreeRoot represents the structure tree root of a tagged PDF (14.7.2 - Table 322).
+// Only the parts of the logical structure needed for accessibility (natural language, alternate
+// descriptions and replacement text) are modelled, the remaining entries are kept as is.
+type PdfStructTreeRoot struct {
+	K          []*PdfStructKid
+	IDTree     PdfObject
+	ParentTree PdfObject
+	RoleMap    PdfObject
+	ClassMap   PdfObject
+
+	ParentTreeNextKey *int64
+
+	primitive *PdfIndirectObject
+}
+
+// PdfStructElement represents a structure element dictionary (14.7.2 - Table 323).
+type PdfStructElement struct {
+	S  *PdfObjectName // Structure type.
+	P  PdfModel       // Parent structure element or the structure tree root.
+	ID PdfObject
+	Pg PdfObject // Page on which some or all of the content items are rendered.
+	K  []*PdfStructKid
+	A  PdfObject
+	C  PdfObject
+	R  *int64
+	T  *PdfObjectString // Title.
+
+	// Accessibility related entries.
+	Lang       *PdfObjectString // Natural language (BCP 47 language tag).
+	Alt        *PdfObjectString // Alternate description, e.g. for figures and formulas.
+	E          *PdfObjectString // Expanded form of an abbreviation.
+	ActualText *PdfObjectString // Exact replacement text for the element content.
+
+	primitive *PdfIndirectObject
+}
+
+// PdfStructKid is an entry in the K array of a structure element or the structure tree root.
+// It is either a child structure element, or a reference to content (marked-content identifier,
+// marked-content reference or object reference dictionary) which is kept as a primitive object.
+type PdfStructKid struct {
+	Element *PdfStructElement
+	Content PdfObject
+}
+
+// NewPdfStructTreeRoot returns a new empty structure tree root.
+func NewPdfStructTreeRoot() *PdfStructTreeRoot {
+	root := &PdfStructTreeRoot{}
+	root.primitive = MakeIndirectObject(MakeDict())
+	return root
+}
+
+// NewPdfStructElement returns a new structure element with structure type `s`, e.g. "P", "H1" or "Figure".
+func NewPdfStructElement(s string) *PdfStructElement {
+	elem := &PdfStructElement{}
+	elem.S = MakeName(s)
+	elem.primitive = MakeIndirectObject(MakeDict())
+	return elem
+}
+
+// AddKid appends a child structure element to the structure tree root.
+func (this *PdfStructTreeRoot) AddKid(elem *PdfStructElement) {
+	elem.P = this
+	this.K = append(this.K, &PdfStructKid{Element: elem})
+}
+
+// AddKid appends a child structure element.
+func (this *PdfStructElement) AddKid(elem *PdfStructElement) {
+	elem.P = this
+	this.K = append(this.K, &PdfStructKid{Element: elem})
+}
+
+// AddMCID appends a marked-content identifier referring to a marked-content sequence with the specified MCID
+// on the element's page (Pg).
+func (this *PdfStructElement) AddMCID(mcid int64) {
+	this.K = append(this.K, &PdfStructKid{Content: MakeInteger(mcid)})
+}
+
+// SetLang sets the natural language of the element content as a language tag, e.g. "en-US".
+func (this *PdfStructElement) SetLang(lang string) {
+	this.Lang = EncodeTextString(lang)
+}
+
+// SetAlt sets the alternate description of the element.
+func (this *PdfStructElement) SetAlt(alt string) {
+	this.Alt = EncodeTextString(alt)
+}
+
+// SetActualText sets the exact replacement text of the element.
+func (this *PdfStructElement) SetActualText(text string) {
+	this.ActualText = EncodeTextString(text)
+}
+
+// GetLang returns the natural language specified for the element, walking up the structure hierarchy if the
+// element itself does not specify one. Returns an empty string if not specified.
+func (this *PdfStructElement) GetLang() string {
+	var node PdfModel = this
+	for node != nil {
+		elem, ok := node.(*PdfStructElement)
+		if !ok {
+			break
+		}
+		if elem.Lang != nil {
+			return DecodeTextString(*elem.Lang)
+		}
+		node = elem.P
+	}
+	return ""
+}
+
+// GetContainingPdfObject returns the container of the structure tree root (indirect object).
+func (this *PdfStructTreeRoot) GetContainingPdfObject() PdfObject {
+	return this.primitive
+}
+
+// ToPdfObject recursively builds the structure tree as PDF primitives.
+func (this *PdfStructTreeRoot) ToPdfObject() PdfObject {
+	container := this.primitive
+	dict := container.PdfObject.(*PdfObjectDictionary)
+
+	dict.Set("Type", MakeName("StructTreeRoot"))
+	if len(this.K) > 0 {
+		dict.Set("K", structKidsToPdfObject(this.K))
+	}
+	dict.SetIfNotNil("IDTree", this.IDTree)
+	dict.SetIfNotNil("ParentTree", this.ParentTree)
+	if this.ParentTreeNextKey != nil {
+		dict.Set("ParentTreeNextKey", MakeInteger(*this.ParentTreeNextKey))
+	}
+	dict.SetIfNotNil("RoleMap", this.RoleMap)
+	dict.SetIfNotNil("ClassMap", this.ClassMap)
+
+	return container
+}
+
+// GetContainingPdfObject returns the container of the structure element (indirect object).
+func (this *PdfStructElement) GetContainingPdfObject() PdfObject {
+	return this.primitive
+}
+
+// ToPdfObject recursively builds the structure element and its kids as PDF primitives.
+func (this *PdfStructElement) ToPdfObject() PdfObject {
+	container := this.primitive
+	dict := container.PdfObject.(*PdfObjectDictionary)
+
+	dict.Set("Type", MakeName("StructElem"))
+	dict.SetIfNotNil("S", this.S)
+	if this.P != nil {
+		dict.Set("P", this.P.GetContainingPdfObject())
+	}
+	dict.SetIfNotNil("ID", this.ID)
+	dict.SetIfNotNil("Pg", this.Pg)
+	if len(this.K) > 0 {
+		dict.Set("K", structKidsToPdfObject(this.K))
+	}
+	dict.SetIfNotNil("A", this.A)
+	dict.SetIfNotNil("C", this.C)
+	if this.R != nil {
+		dict.Set("R", MakeInteger(*this.R))
+	}
+	dict.SetIfNotNil("T", this.T)
+	dict.SetIfNotNil("Lang", this.Lang)
+	dict.SetIfNotNil("Alt", this.Alt)
+	dict.SetIfNotNil("E", this.E)
+	dict.SetIfNotNil("ActualText", this.ActualText)
+
+	return container
+}
+
+func structKidsToPdfObject(kids []*PdfStructKid) PdfObject {
+	arr := PdfObjectArray{}
+	for _, kid := range kids {
+		if kid.Element != nil {
+			arr = append(arr, kid.Element.ToPdfObject())
+		} else if kid.Content != nil {
+			arr = append(arr, kid.Content)
+		}
+	}
+	if len(arr) == 1 {
+		return arr[0]
+	}
+	return &arr
+}
+
+// loadStructTreeRoot loads the structure tree root from the catalog. Returns nil if the document is not tagged.
+func (this *PdfReader) loadStructTreeRoot() (*PdfStructTreeRoot, error) {
+	obj := this.catalog.Get("StructTreeRoot")
+	if obj == nil {
+		return nil, nil
+	}
+
+	obj, err := this.traceToObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	if _, isNull := obj.(*PdfObjectNull); isNull {
+		return nil, nil
+	}
+	container, ok := obj.(*PdfIndirectObject)
+	if !ok {
+		container = MakeIndirectObject(obj)
+	}
+
+	// Resolve all references within the structure tree, so it can be written out again.
+	err = this.traverseObjectData(container)
+	if err != nil {
+		return nil, err
+	}
+
+	dict, ok := container.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		return nil, fmt.Errorf("StructTreeRoot not a dictionary (%T)", container.PdfObject)
+	}
+
+	root := &PdfStructTreeRoot{}
+	root.primitive = container
+
+	traversed := map[PdfObject]bool{container: true}
+	if kObj := dict.Get("K"); kObj != nil {
+		root.K, err = this.loadStructKids(kObj, root, traversed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	root.IDTree = dict.Get("IDTree")
+	root.ParentTree = dict.Get("ParentTree")
+	root.RoleMap = dict.Get("RoleMap")
+	root.ClassMap = dict.Get("ClassMap")
+	if obj := dict.Get("ParentTreeNextKey"); obj != nil {
+		key, err := getNumberAsInt64(TraceToDirectObject(obj))
+		if err != nil {
+			common.Log.Debug("ERROR: Invalid ParentTreeNextKey (%T)", obj)
+		} else {
+			root.ParentTreeNextKey = &key
+		}
+	}
+
+	return root, nil
+}
+
+// loadStructKids loads the K entry of a structure element or the structure tree root.
+func (this *PdfReader) loadStructKids(kObj PdfObject, parent PdfModel, traversed map[PdfObject]bool) ([]*PdfStructKid, error) {
+	kObj, err := this.traceToObject(kObj)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []PdfObject
+	if arr, isArr := TraceToDirectObject(kObj).(*PdfObjectArray); isArr {
+		items = *arr
+	} else {
+		items = []PdfObject{kObj}
+	}
+
+	kids := []*PdfStructKid{}
+	for _, item := range items {
+		item, err = this.traceToObject(item)
+		if err != nil {
+			return nil, err
+		}
+		if _, isNull := item.(*PdfObjectNull); isNull {
+			continue
+		}
+
+		dict, isDict := TraceToDirectObject(item).(*PdfObjectDictionary)
+		if isDict {
+			// Marked-content references (MCR) and object references (OBJR) are content items.
+			if t, ok := dict.Get("Type").(*PdfObjectName); ok && (*t == "MCR" || *t == "OBJR") {
+				kids = append(kids, &PdfStructKid{Content: item})
+				continue
+			}
+
+			container, isIndirect := item.(*PdfIndirectObject)
+			if !isIndirect {
+				container = MakeIndirectObject(dict)
+			}
+			if traversed[container] {
+				common.Log.Debug("ERROR: Circular structure tree reference - skipping")
+				continue
+			}
+			traversed[container] = true
+
+			elem, err := this.newPdfStructElementFromIndirectObject(container, parent, traversed)
+			if err != nil {
+				return nil, err
+			}
+			kids = append(kids, &PdfStructKid{Element: elem})
+			continue
+		}
+
+		// Marked-content identifier (integer).
+		kids = append(kids, &PdfStructKid{Content: item})
+	}
+
+	return kids, nil
+}
+
+func (this *PdfReader) newPdfStructElementFromIndirectObject(container *PdfIndirectObject, parent PdfModel, traversed map[PdfObject]bool) (*PdfStructElement, error) {
+	dict, ok := container.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		return nil, errors.New("Structure element not a dictionary")
+	}
+
+	elem := &PdfStructElement{}
+	elem.primitive = container
+	elem.P = parent
+
+	if obj := dict.Get("S"); obj != nil {
+		name, ok := TraceToDirectObject(obj).(*PdfObjectName)
+		if !ok {
+			return nil, fmt.Errorf("Invalid structure type S (%T)", obj)
+		}
+		elem.S = name
+	} else {
+		common.Log.Debug("ERROR: Structure element missing S (required)")
+	}
+
+	elem.ID = dict.Get("ID")
+	elem.Pg = dict.Get("Pg")
+	elem.A = dict.Get("A")
+	elem.C = dict.Get("C")
+	if obj := dict.Get("R"); obj != nil {
+		r, err := getNumberAsInt64(TraceToDirectObject(obj))
+		if err == nil {
+			elem.R = &r
+		}
+	}
+
+	strEntries := map[PdfObjectName]**PdfObjectString{
+		"T":          &elem.T,
+		"Lang":       &elem.Lang,
+		"Alt":        &elem.Alt,
+		"E":          &elem.E,
+		"ActualText": &elem.ActualText,
+	}
+	for key, field := range strEntries {
+		obj := dict.Get(key)
+		if obj == nil {
+			continue
+		}
+		obj, err := this.traceToObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := TraceToDirectObject(obj).(*PdfObjectString)
+		if !ok {
+			common.Log.Debug("ERROR: Structure element %s not a string (%T)", key, obj)
+			continue
+		}
+		*field = str
+	}
+
+	if kObj := dict.Get("K"); kObj != nil {
+		kids, err := this.loadStructKids(kObj, elem, traversed)
+		if err != nil {
+			return nil, err
+		}
+		elem.K = kids
+	}
+
+	return elem, nil
+}
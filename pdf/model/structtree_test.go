@@ -0,0 +1,104 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// Test writing and reading back the document language and structure element accessibility entries.
+func TestStructTreeLanguageRoundtrip(t *testing.T) {
+	w := NewPdfWriter()
+	w.SetLanguage("en-US")
+
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{0, 0, 612, 792}
+	page.Resources = NewPdfPageResources()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+
+	root := NewPdfStructTreeRoot()
+	doc := NewPdfStructElement("Document")
+	doc.SetLang("de-DE")
+	root.AddKid(doc)
+
+	fig := NewPdfStructElement("Figure")
+	fig.SetAlt("Firmenlogo der Müller GmbH")
+	fig.Pg = page.GetPageAsIndirectObject()
+	fig.AddMCID(0)
+	doc.AddKid(fig)
+
+	abbr := NewPdfStructElement("Span")
+	abbr.SetActualText("Doctor – Dr.")
+	abbr.SetLang("en-GB")
+	doc.AddKid(abbr)
+
+	w.SetStructTreeRoot(root)
+
+	f, err := ioutil.TempFile("", "structtree")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+
+	reader, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	if lang := reader.GetLanguage(); lang != "en-US" {
+		t.Fatalf("Lang mismatch: %q", lang)
+	}
+
+	root, err = reader.GetStructTreeRoot()
+	if err != nil {
+		t.Fatalf("Error loading structure tree: %v", err)
+	}
+	if root == nil || len(root.K) != 1 || root.K[0].Element == nil {
+		t.Fatalf("Invalid structure tree root: %+v", root)
+	}
+	doc = root.K[0].Element
+	if len(doc.K) != 2 {
+		t.Fatalf("Expected 2 kids, got %d", len(doc.K))
+	}
+	fig = doc.K[0].Element
+	abbr = doc.K[1].Element
+	if fig == nil || abbr == nil {
+		t.Fatalf("Kids should be structure elements")
+	}
+	if fig.Alt == nil || DecodeTextString(*fig.Alt) != "Firmenlogo der Müller GmbH" {
+		t.Errorf("Alt mismatch: %v", fig.Alt)
+	}
+	if fig.GetLang() != "de-DE" {
+		t.Errorf("Inherited Lang mismatch: %q", fig.GetLang())
+	}
+	if len(fig.K) != 1 || fig.K[0].Content == nil {
+		t.Errorf("Figure should have one MCID content item")
+	}
+	if abbr.ActualText == nil || DecodeTextString(*abbr.ActualText) != "Doctor – Dr." {
+		t.Errorf("ActualText mismatch: %v", abbr.ActualText)
+	}
+	if abbr.GetLang() != "en-GB" {
+		t.Errorf("Lang mismatch: %q", abbr.GetLang())
+	}
+}
+
+func TestDecodeTextString(t *testing.T) {
+	if s := DecodeTextString("Caf\xe9"); s != "Café" {
+		t.Errorf("PDFDocEncoding mismatch: %q", s)
+	}
+	if s := DecodeTextString("\xfe\xff\x00C\x00a\x00f\x00\xe9"); s != "Café" {
+		t.Errorf("UTF-16BE mismatch: %q", s)
+	}
+}
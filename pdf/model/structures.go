@@ -13,6 +13,8 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
+	"unicode/utf16"
 
 	. "github.com/unidoc/unidoc/pdf/core"
 )
@@ -124,6 +126,38 @@ func NewPdfDate(dateStr string) (PdfDate, error) {
 	return d, nil
 }
 
+// NewPdfDateFromTime returns the PdfDate of time `t`, with second precision.
+func NewPdfDateFromTime(t time.Time) PdfDate {
+	_, offset := t.Zone()
+	d := PdfDate{
+		year:         int64(t.Year()),
+		month:        int64(t.Month()),
+		day:          int64(t.Day()),
+		hour:         int64(t.Hour()),
+		minute:       int64(t.Minute()),
+		second:       int64(t.Second()),
+		utOffsetSign: '+',
+	}
+	if offset < 0 {
+		d.utOffsetSign = '-'
+		offset = -offset
+	}
+	d.utOffsetHours = int64(offset / 3600)
+	d.utOffsetMins = int64(offset % 3600 / 60)
+	return d
+}
+
+// ToGoTime returns the date as a time.Time.
+func (date *PdfDate) ToGoTime() time.Time {
+	offset := int(date.utOffsetHours*3600 + date.utOffsetMins*60)
+	if date.utOffsetSign == '-' {
+		offset = -offset
+	}
+	loc := time.FixedZone("", offset)
+	return time.Date(int(date.year), time.Month(date.month), int(date.day), int(date.hour), int(date.minute),
+		int(date.second), 0, loc)
+}
+
 // Convert to a PDF string object.
 func (date *PdfDate) ToPdfObject() PdfObject {
 	str := fmt.Sprintf("D:%.4d%.2d%.2d%.2d%.2d%.2d%c%.2d'%.2d'",
@@ -132,3 +166,49 @@ func (date *PdfDate) ToPdfObject() PdfObject {
 	pdfStr := PdfObjectString(str)
 	return &pdfStr
 }
+
+// DecodeTextString decodes a PDF text string (7.9.2.2), which is either encoded as UTF-16BE with a leading
+// byte order marker, or in PDFDocEncoding. PDFDocEncoding is treated as Latin-1, which matches for all
+// printable characters commonly used.
+func DecodeTextString(str PdfObjectString) string {
+	b := []byte(str)
+	if len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF {
+		b = b[2:]
+		codes := make([]uint16, len(b)/2)
+		for i := range codes {
+			codes[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+		}
+		return string(utf16.Decode(codes))
+	}
+
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// EncodeTextString encodes `s` as a PDF text string (7.9.2.2). Strings consisting of ASCII characters only
+// are stored as is, otherwise the string is encoded as UTF-16BE with a leading byte order marker.
+func EncodeTextString(s string) *PdfObjectString {
+	isASCII := true
+	for _, r := range s {
+		if r > 0x7f {
+			isASCII = false
+			break
+		}
+	}
+	if isASCII {
+		return MakeString(s)
+	}
+
+	codes := utf16.Encode([]rune(s))
+	b := make([]byte, 2+2*len(codes))
+	b[0] = 0xFE
+	b[1] = 0xFF
+	for i, code := range codes {
+		b[2+2*i] = byte(code >> 8)
+		b[3+2*i] = byte(code)
+	}
+	return MakeString(string(b))
+}
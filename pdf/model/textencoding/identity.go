@@ -0,0 +1,137 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package textencoding
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// IdentityEncoder is the Identity-H encoding of composite fonts (Type0) with a CIDFontType2 descendant font and
+// an Identity CIDToGIDMap: each character is encoded as a 2 byte (big-endian) CID equal to the glyph index (GID)
+// of the rune in the TrueType font. Runes outside the Basic Multilingual Plane are supported.
+//
+// The single byte methods of the TextEncoder interface (CharcodeToGlyph, GlyphToCharcode, RuneToCharcode and
+// CharcodeToRune) do not apply and always return false; use RuneToCID and CIDToRune instead.
+type IdentityEncoder struct {
+	runeToCID map[rune]uint16
+	cidToRune map[uint16]rune
+}
+
+// NewIdentityTextEncoder returns a new encoder for the glyphs of a font with the rune to glyph index map
+// `runeToGID` (e.g. fonts.TtfType.CharsFull).
+func NewIdentityTextEncoder(runeToGID map[rune]uint16) IdentityEncoder {
+	enc := IdentityEncoder{
+		runeToCID: map[rune]uint16{},
+		cidToRune: map[uint16]rune{},
+	}
+	for r, gid := range runeToGID {
+		enc.runeToCID[r] = gid
+		// Several runes can map to the same glyph, use the lowest for a deterministic mapping.
+		if r0, has := enc.cidToRune[gid]; !has || r < r0 {
+			enc.cidToRune[gid] = r
+		}
+	}
+	return enc
+}
+
+func (enc IdentityEncoder) ToPdfObject() core.PdfObject {
+	return core.MakeName("Identity-H")
+}
+
+// Convert a raw utf8 string (series of runes) to an encoded string (series of 2 byte character codes) to be used
+// in PDF. Runes that are not in the font are skipped.
+func (enc IdentityEncoder) Encode(raw string) string {
+	encoded := []byte{}
+	for _, r := range raw {
+		cid, has := enc.runeToCID[r]
+		if !has {
+			continue
+		}
+		encoded = append(encoded, byte(cid>>8), byte(cid&0xff))
+	}
+	return string(encoded)
+}
+
+// Not applicable to 2 byte codes, always returns false.
+func (enc IdentityEncoder) CharcodeToGlyph(code byte) (string, bool) {
+	return "", false
+}
+
+// Not applicable to 2 byte codes, always returns false.
+func (enc IdentityEncoder) GlyphToCharcode(glyph string) (byte, bool) {
+	return 0, false
+}
+
+// Not applicable to 2 byte codes, always returns false.
+func (enc IdentityEncoder) RuneToCharcode(val rune) (byte, bool) {
+	return 0, false
+}
+
+// Not applicable to 2 byte codes, always returns false.
+func (enc IdentityEncoder) CharcodeToRune(charcode byte) (rune, bool) {
+	return 0, false
+}
+
+// RuneToCID converts a rune to its 2 byte character code (CID).
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) RuneToCID(val rune) (uint16, bool) {
+	cid, has := enc.runeToCID[val]
+	return cid, has
+}
+
+// CIDToRune converts a 2 byte character code (CID) to a rune.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) CIDToRune(cid uint16) (rune, bool) {
+	r, has := enc.cidToRune[cid]
+	return r, has
+}
+
+// CIDToRuneMap returns the CID to rune map of the encoding, e.g. to build a ToUnicode CMap.
+func (enc IdentityEncoder) CIDToRuneMap() map[uint16]rune {
+	return enc.cidToRune
+}
+
+// Convert rune to glyph name. Returns the name from the Adobe glyph list, or the uniXXXX (BMP) or uXXXXX names
+// otherwise. The bool return flag is true if the rune is in the font, and false otherwise.
+func (enc IdentityEncoder) RuneToGlyph(val rune) (string, bool) {
+	if _, has := enc.runeToCID[val]; !has {
+		return "", false
+	}
+	if glyph, found := runeToGlyph(val, glyphlistRuneToGlyphMap); found {
+		return glyph, true
+	}
+	if val <= 0xffff {
+		return fmt.Sprintf("uni%04X", val), true
+	}
+	return fmt.Sprintf("u%X", val), true
+}
+
+// Convert glyph to rune. Supports the glyph names of the Adobe glyph list and uniXXXX and uXXXX[XX] names.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) GlyphToRune(glyph string) (rune, bool) {
+	if r, found := glyphToRune(glyph, glyphlistGlyphToRuneMap); found {
+		return r, true
+	}
+
+	hex := ""
+	if strings.HasPrefix(glyph, "uni") && len(glyph) == 7 {
+		hex = glyph[3:]
+	} else if strings.HasPrefix(glyph, "u") && len(glyph) >= 5 && len(glyph) <= 7 {
+		hex = glyph[1:]
+	}
+	if hex == "" {
+		return 0, false
+	}
+	val, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rune(val), true
+}
@@ -10,13 +10,13 @@ package model
 
 import (
 	"bufio"
+	"context"
 	"crypto/md5"
-	"crypto/rand"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"os"
-	"time"
 
 	"github.com/unidoc/unidoc/common"
 	"github.com/unidoc/unidoc/common/license"
@@ -63,6 +63,10 @@ type PdfWriter struct {
 	encryptObj  *PdfIndirectObject
 	ids         *PdfObjectArray
 
+	// Generation of the file identifier, and the identifier set by the caller.
+	idPolicy DocumentIDPolicy
+	fixedID  *PdfObjectArray
+
 	// PDF version
 	majorVersion int
 	minorVersion int
@@ -76,6 +80,41 @@ type PdfWriter struct {
 
 	// Forms.
 	acroForm *PdfAcroForm
+
+	// Logical structure.
+	structTreeRoot *PdfStructTreeRoot
+
+	// Files associated with the document.
+	associatedFiles []*PdfFileSpec
+
+	// Named pages (Pages name tree) and page templates (Templates name tree) by name.
+	namedPages map[string]PdfObject
+	templates  map[string]PdfObject
+
+	// Document security store of the signatures.
+	dss *PdfDSS
+
+	// References replaced at write time and the references to pages not in the page tree.
+	referenceRemap    map[PdfObject]PdfObject
+	removeLeakedDests bool
+	referenceLeaks    []*ReferenceLeak
+
+	// Garbage collection of unreferenced objects at write time.
+	garbageCollection bool
+	gcReport          *GarbageCollectionReport
+
+	// Deduplication of identical streams at write time.
+	deduplicateStreams bool
+	dedupReport        *StreamDeduplicationReport
+
+	// Compression of streams at write time, nil to write streams as they are.
+	compression *CompressionOptions
+
+	// Called after each object written, if set.
+	progress func(WriteProgress)
+
+	// Hooks called at points of the document assembly.
+	hooks []WriterHooks
 }
 
 func NewPdfWriter() PdfWriter {
@@ -84,6 +123,7 @@ func NewPdfWriter() PdfWriter {
 	w.objectsMap = map[PdfObject]bool{}
 	w.objects = []PdfObject{}
 	w.pendingObjects = map[PdfObject]*PdfObjectDictionary{}
+	w.hooks = getRegisteredWriterHooks()
 
 	// PDF Version.  Can be changed if using more advanced features in PDF.
 	// By default it is set to 1.3.
@@ -148,6 +188,40 @@ func (this *PdfWriter) SetOCProperties(ocProperties PdfObject) error {
 	return nil
 }
 
+// SetLanguage sets the default natural language of the document (catalog Lang entry) as a language
+// tag, e.g. "en-US". Used by screen readers and other assistive technology.
+func (this *PdfWriter) SetLanguage(lang string) {
+	this.catalog.Set("Lang", MakeString(lang))
+}
+
+// SetDocInfo sets a text entry of the document information dictionary (14.3.3), e.g. Title, Author, Subject
+// or Keywords.
+func (this *PdfWriter) SetDocInfo(key, value string) {
+	infoDict := this.infoObj.PdfObject.(*PdfObjectDictionary)
+	infoDict.Set(PdfObjectName(key), EncodeTextString(value))
+}
+
+// SetDocInfoName sets a name entry of the document information dictionary, e.g. Trapped.
+func (this *PdfWriter) SetDocInfoName(key, value string) {
+	infoDict := this.infoObj.PdfObject.(*PdfObjectDictionary)
+	infoDict.Set(PdfObjectName(key), MakeName(value))
+}
+
+// SetOutputIntents sets the output intents of the document (catalog OutputIntents entry, 14.11.5), describing the
+// intended output device as required by PDF/X and PDF/A. Typically obtained from PdfReader.GetOutputIntents.
+func (this *PdfWriter) SetOutputIntents(outputIntents PdfObject) error {
+	if outputIntents == nil {
+		return nil
+	}
+	this.catalog.Set("OutputIntents", outputIntents)
+	return this.addObjects(outputIntents)
+}
+
+// SetStructTreeRoot sets the structure tree of the document and marks the document as tagged.
+func (this *PdfWriter) SetStructTreeRoot(root *PdfStructTreeRoot) {
+	this.structTreeRoot = root
+}
+
 func (this *PdfWriter) hasObject(obj PdfObject) bool {
 	// Check if already added.
 	for _, o := range this.objects {
@@ -263,6 +337,20 @@ func (this *PdfWriter) addObjects(obj PdfObject) error {
 // Add a page to the PDF file. The new page should be an indirect
 // object.
 func (this *PdfWriter) AddPage(page *PdfPage) error {
+	if len(this.hooks) > 0 {
+		pagesDict, ok := this.pages.PdfObject.(*PdfObjectDictionary)
+		if !ok {
+			return errors.New("Invalid Pages obj (not a dict)")
+		}
+		pageNum := 1
+		if kids, ok := pagesDict.Get("Kids").(*PdfObjectArray); ok {
+			pageNum += len(*kids)
+		}
+		if err := this.onPageAdded(page, pageNum); err != nil {
+			return err
+		}
+	}
+
 	obj := page.ToPdfObject()
 	common.Log.Trace("==========")
 	common.Log.Trace("Appending to page list %T", obj)
@@ -318,6 +406,12 @@ func (this *PdfWriter) AddPage(page *PdfPage) error {
 
 	common.Log.Trace("Traversal done")
 
+	// UserUnit requires PDF 1.6.
+	if page.GetUserUnit() != 1 && this.majorVersion == 1 && this.minorVersion < 6 {
+		common.Log.Debug("Page UserUnit set - updating version to 1.6")
+		this.minorVersion = 6
+	}
+
 	// Update the dictionary.
 	// Reuses the input object, updating the fields.
 	pDict.Set("Parent", this.pages)
@@ -499,15 +593,24 @@ func (this *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptio
 	}
 
 	// Prepare the ID object for the trailer.
-	hashcode := md5.Sum([]byte(time.Now().Format(time.RFC850)))
-	id0 := PdfObjectString(hashcode[:])
-	b := make([]byte, 100)
-	rand.Read(b)
-	hashcode = md5.Sum(b)
-	id1 := PdfObjectString(hashcode[:])
-	common.Log.Trace("Random b: % x", b)
-
-	this.ids = &PdfObjectArray{&id0, &id1}
+	switch this.idPolicy {
+	case DocumentIDContentHash:
+		common.Log.Debug("ERROR: Content hash document ID not supported with encryption")
+		return errors.New("Content hash document ID not supported with encryption")
+	case DocumentIDFixed:
+		if this.fixedID == nil {
+			common.Log.Debug("ERROR: Document ID not set")
+			return errors.New("Document ID not set")
+		}
+		this.ids = this.fixedID
+	default:
+		ids, err := newRandomDocumentID()
+		if err != nil {
+			return err
+		}
+		this.ids = ids
+	}
+	id0 := *(*this.ids)[0].(*PdfObjectString)
 	common.Log.Trace("Gen Id 0: % x", id0)
 
 	crypter.Id0 = string(id0)
@@ -550,6 +653,12 @@ func (this *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptio
 
 // Write the pdf out.
 func (this *PdfWriter) Write(ws io.WriteSeeker) error {
+	return this.WriteWithContext(context.Background(), ws)
+}
+
+// WriteWithContext writes out the PDF like Write. The write is aborted with the error of `ctx` once it is done,
+// which is checked before each object is written, leaving `ws` with an incomplete PDF.
+func (this *PdfWriter) WriteWithContext(ctx context.Context, ws io.WriteSeeker) error {
 	common.Log.Trace("Write()")
 
 	lk := license.GetLicenseKey()
@@ -570,6 +679,19 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 		}
 	}
 
+	// Logical structure.
+	if this.structTreeRoot != nil {
+		structTreeRoot := this.structTreeRoot.ToPdfObject()
+		this.catalog.Set("StructTreeRoot", structTreeRoot)
+		markInfo := MakeDict()
+		markInfo.Set("Marked", MakeBool(true))
+		this.catalog.Set("MarkInfo", markInfo)
+		err := this.addObjects(structTreeRoot)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Form fields.
 	if this.acroForm != nil {
 		common.Log.Trace("Writing acro forms")
@@ -582,6 +704,42 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 		}
 	}
 
+	// Document security store.
+	if this.dss != nil {
+		dss := this.dss.ToPdfObject()
+		this.catalog.Set("DSS", dss)
+		if err := this.addObjects(dss); err != nil {
+			return err
+		}
+	}
+
+	// Name trees: associated files, named pages and page templates.
+	names := MakeDict()
+	if len(this.associatedFiles) > 0 {
+		af, tree := this.getAssociatedFiles()
+		this.catalog.Set("AF", af)
+		names.Set("EmbeddedFiles", tree)
+		if err := this.addObjects(af); err != nil {
+			return err
+		}
+	}
+	if len(this.namedPages) > 0 {
+		names.Set("Pages", makeNameTree(this.namedPages))
+	}
+	if len(this.templates) > 0 {
+		names.Set("Templates", makeNameTree(this.templates))
+	}
+	if len(names.Keys()) > 0 {
+		this.catalog.Set("Names", names)
+		if err := this.addObjects(names); err != nil {
+			return err
+		}
+	}
+
+	if err := this.fixupReferences(); err != nil {
+		return err
+	}
+
 	// Check pending objects prior to write.
 	for pendingObj, pendingObjDict := range this.pendingObjects {
 		if !this.hasObject(pendingObj) {
@@ -596,10 +754,33 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 			}
 		}
 	}
+	if this.garbageCollection {
+		this.collectGarbage()
+	}
+	if this.deduplicateStreams {
+		this.deduplicateObjectStreams()
+	}
+	if this.compression != nil {
+		if err := this.compressStreams(); err != nil {
+			return err
+		}
+	}
+
 	// Set version in the catalog.
 	this.catalog.Set("Version", MakeName(fmt.Sprintf("%d.%d", this.majorVersion, this.minorVersion)))
 
-	w := bufio.NewWriter(ws)
+	// The output is hashed as written for content hash document IDs.
+	var out io.Writer = ws
+	var hasher hash.Hash
+	if this.idPolicy == DocumentIDContentHash {
+		if this.crypter != nil {
+			common.Log.Debug("ERROR: Content hash document ID not supported with encryption")
+			return errors.New("Content hash document ID not supported with encryption")
+		}
+		hasher = md5.New()
+		out = io.MultiWriter(ws, hasher)
+	}
+	w := bufio.NewWriter(out)
 	this.writer = w
 
 	w.WriteString(fmt.Sprintf("%%PDF-%d.%d\n", this.majorVersion, this.minorVersion))
@@ -613,11 +794,17 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 	// Write objects
 	common.Log.Trace("Writing %d obj", len(this.objects))
 	for idx, obj := range this.objects {
+		if err := ctx.Err(); err != nil {
+			common.Log.Debug("ERROR: Write aborted (%s)", err)
+			return err
+		}
 		common.Log.Trace("Writing %d", idx)
 		this.writer.Flush()
 		offset, _ := ws.Seek(0, os.SEEK_CUR)
 		offsets = append(offsets, offset)
 
+		this.onObjectWriting(obj)
+
 		// Encrypt prior to writing.
 		// Encrypt dictionary should not be encrypted.
 		if this.crypter != nil && obj != this.encryptObj {
@@ -629,40 +816,50 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 
 		}
 		this.writeObject(idx+1, obj)
+		this.onObjectWritten(idx+1, obj)
+
+		if this.progress != nil {
+			this.writer.Flush()
+			written, _ := ws.Seek(0, os.SEEK_CUR)
+			this.progress(WriteProgress{ObjectsWritten: idx + 1, NumObjects: len(this.objects), BytesWritten: written})
+		}
 	}
 	w.Flush()
 
 	xrefOffset, _ := ws.Seek(0, os.SEEK_CUR)
 	// Write xref table.
-	this.writer.WriteString("xref\r\n")
-	outStr := fmt.Sprintf("%d %d\r\n", 0, len(this.objects)+1)
-	this.writer.WriteString(outStr)
-	outStr = fmt.Sprintf("%.10d %.5d f\r\n", 0, 65535)
-	this.writer.WriteString(outStr)
-	for _, offset := range offsets {
-		outStr = fmt.Sprintf("%.10d %.5d n\r\n", offset, 0)
-		this.writer.WriteString(outStr)
+	xref := NewXrefSection()
+	for idx, offset := range offsets {
+		if err := xref.AddObject(idx+1, 0, offset); err != nil {
+			return err
+		}
+	}
+	if _, err := xref.WriteTo(this.writer); err != nil {
+		return err
 	}
 
 	// Generate & write trailer
-	trailer := MakeDict()
-	trailer.Set("Info", this.infoObj)
-	trailer.Set("Root", this.root)
-	trailer.Set("Size", MakeInteger(int64(len(this.objects)+1)))
+	trailer := &XrefTrailer{
+		Size: xref.Size(),
+		Root: this.root,
+		Info: this.infoObj,
+	}
 	// If encrypted!
 	if this.crypter != nil {
-		trailer.Set("Encrypt", this.encryptObj)
-		trailer.Set("ID", this.ids)
+		trailer.Encrypt = this.encryptObj
+		trailer.ID = this.ids
 		common.Log.Trace("Ids: %s", this.ids)
+	} else {
+		w.Flush()
+		ids, err := this.newDocumentID(hasher)
+		if err != nil {
+			return err
+		}
+		trailer.ID = ids
+	}
+	if err := WriteTrailer(this.writer, trailer.ToPdfObject(), xrefOffset); err != nil {
+		return err
 	}
-	this.writer.WriteString("trailer\n")
-	this.writer.WriteString(trailer.DefaultWriteString())
-	this.writer.WriteString("\n")
-
-	// Make offset reference.
-	outStr = fmt.Sprintf("startxref\n%d\n", xrefOffset)
-	this.writer.WriteString(outStr)
-	this.writer.WriteString("%%EOF\n")
 	w.Flush()
 
 	return nil
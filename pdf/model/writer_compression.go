@@ -0,0 +1,162 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"compress/zlib"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// CompressionOptions control the (re)compression of streams when writing.
+type CompressionOptions struct {
+	// Flate compression level as in compress/zlib, from zlib.BestSpeed to zlib.BestCompression or
+	// zlib.DefaultCompression. The zero value is zlib.DefaultCompression (see FlateEncoder.CompressionLevel).
+	FlateLevel int
+
+	// FlateDCT additionally Flate compresses DCT (JPEG) encoded streams, which often saves a few percent. When
+	// false, DCT streams are left untouched.
+	FlateDCT bool
+
+	// StreamEncoder optionally selects the encoder of individual streams, overriding the default handling. It
+	// returns nil to apply the default handling, or the encoder to re-encode the stream with, e.g. a RawEncoder to
+	// store the stream uncompressed.
+	StreamEncoder func(stream *PdfObjectStream) StreamEncoder
+}
+
+// NewCompressionOptions returns compression options with the default Flate compression level.
+func NewCompressionOptions() *CompressionOptions {
+	return &CompressionOptions{FlateLevel: zlib.DefaultCompression}
+}
+
+// SetCompression sets the options for compressing streams when writing. By default (nil options) streams are
+// written as they are. With compression options, all streams are Flate compressed at the configured level,
+// decoding them first if encoded with other filters, except:
+//   - streams encoded with image specific filters (DCTDecode, JPXDecode, JBIG2Decode, CCITTFaxDecode), which are
+//     left as they are or, for DCT with FlateDCT, Flate compressed on top,
+//   - XMP metadata streams, which are kept uncompressed to be readable by tools unaware of PDF,
+//   - streams with filters that cannot be decoded, which are left as they are.
+func (this *PdfWriter) SetCompression(options *CompressionOptions) {
+	this.compression = options
+}
+
+// imageFilters are the filters that are specific to images and not replaced by Flate compression.
+var imageFilters = map[PdfObjectName]bool{
+	StreamEncodingFilterNameDCT:      true,
+	StreamEncodingFilterNameJPX:      true,
+	StreamEncodingFilterNameJBIG2:    true,
+	StreamEncodingFilterNameCCITTFax: true,
+}
+
+// compressStreams compresses the streams to be written according to the compression options.
+func (this *PdfWriter) compressStreams() error {
+	options := this.compression
+	if options.FlateLevel < zlib.HuffmanOnly || options.FlateLevel > zlib.BestCompression {
+		common.Log.Debug("ERROR: Invalid Flate compression level %d", options.FlateLevel)
+		return ErrRangeError
+	}
+
+	for _, obj := range this.objects {
+		stream, ok := obj.(*PdfObjectStream)
+		if !ok {
+			continue
+		}
+
+		if options.StreamEncoder != nil {
+			if encoder := options.StreamEncoder(stream); encoder != nil {
+				if err := recodeStream(stream, encoder); err != nil {
+					common.Log.Debug("ERROR: Unable to re-encode stream: %v", err)
+					return err
+				}
+				continue
+			}
+		}
+
+		if name, ok := TraceToDirectObject(stream.PdfObjectDictionary.Get("Type")).(*PdfObjectName); ok && *name == "Metadata" {
+			continue
+		}
+
+		filters := getStreamFilters(stream)
+		if len(filters) > 0 && imageFilters[filters[len(filters)-1]] {
+			if options.FlateDCT && len(filters) == 1 && filters[0] == StreamEncodingFilterNameDCT {
+				if err := flateCompressDCT(stream, options.FlateLevel); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		encoder := NewFlateEncoder()
+		encoder.CompressionLevel = options.FlateLevel
+		if err := recodeStream(stream, encoder); err != nil {
+			common.Log.Debug("Leaving stream as is, unable to recompress: %v", err)
+		}
+	}
+	return nil
+}
+
+// getStreamFilters returns the names of the filters of `stream` in the order of decoding.
+func getStreamFilters(stream *PdfObjectStream) []PdfObjectName {
+	filters := []PdfObjectName{}
+	switch t := TraceToDirectObject(stream.PdfObjectDictionary.Get("Filter")).(type) {
+	case *PdfObjectName:
+		filters = append(filters, *t)
+	case *PdfObjectArray:
+		for _, obj := range *t {
+			if name, ok := TraceToDirectObject(obj).(*PdfObjectName); ok {
+				filters = append(filters, *name)
+			}
+		}
+	}
+	return filters
+}
+
+// recodeStream decodes `stream` and encodes it with `encoder`, updating the Filter, DecodeParms and Length
+// entries. The stream is not modified if it cannot be decoded.
+func recodeStream(stream *PdfObjectStream, encoder StreamEncoder) error {
+	decoded, err := DecodeStream(stream)
+	if err != nil {
+		return err
+	}
+	encoded, err := encoder.EncodeBytes(decoded)
+	if err != nil {
+		return err
+	}
+
+	encDict := encoder.MakeStreamDict()
+	dict := stream.PdfObjectDictionary
+	dict.Remove("Filter")
+	dict.Remove("DecodeParms")
+	if filter := encDict.Get("Filter"); filter != nil {
+		dict.Set("Filter", filter)
+	}
+	if decodeParms := encDict.Get("DecodeParms"); decodeParms != nil {
+		dict.Set("DecodeParms", decodeParms)
+	}
+	stream.Stream = encoded
+	dict.Set("Length", MakeInteger(int64(len(encoded))))
+	return nil
+}
+
+// flateCompressDCT Flate compresses DCT encoded `stream` at compression level `level`, keeping the DCT encoding.
+func flateCompressDCT(stream *PdfObjectStream, level int) error {
+	encoder := NewFlateEncoder()
+	encoder.CompressionLevel = level
+	encoded, err := encoder.EncodeBytes(stream.Stream)
+	if err != nil {
+		return err
+	}
+
+	dict := stream.PdfObjectDictionary
+	dict.Set("Filter", MakeArray(MakeName(StreamEncodingFilterNameFlate), MakeName(StreamEncodingFilterNameDCT)))
+	if decodeParms := dict.Get("DecodeParms"); decodeParms != nil {
+		dict.Set("DecodeParms", MakeArray(MakeNull(), decodeParms))
+	}
+	stream.Stream = encoded
+	dict.Set("Length", MakeInteger(int64(len(encoded))))
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// writeTestStreams writes a document with `streams` as form XObjects of a page with compression options `options`.
+func writeTestStreams(t *testing.T, options *CompressionOptions, streams ...*PdfObjectStream) error {
+	w := NewPdfWriter()
+	w.SetCompression(options)
+	page := NewPdfPage()
+	page.Resources = NewPdfPageResources()
+	for i, stream := range streams {
+		page.Resources.SetXObjectByName(PdfObjectName("X"+string('A'+rune(i))), stream)
+	}
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	f, err := ioutil.TempFile("", "compression")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	return w.Write(f)
+}
+
+func makeTestStream(data string, entries ...string) *PdfObjectStream {
+	dict := MakeDict()
+	for i := 0; i+1 < len(entries); i += 2 {
+		dict.Set(PdfObjectName(entries[i]), MakeName(entries[i+1]))
+	}
+	dict.Set("Length", MakeInteger(int64(len(data))))
+	return &PdfObjectStream{PdfObjectDictionary: dict, Stream: []byte(data)}
+}
+
+func TestWriterCompression(t *testing.T) {
+	content := string(bytes.Repeat([]byte("0 0 10 10 re f\n"), 50))
+	jpeg := "\xff\xd8 not really a JPEG \xff\xd9"
+	metadata := "<x:xmpmeta xmlns:x='adobe:ns:meta/'></x:xmpmeta>"
+
+	form := makeTestStream(content, "Subtype", "Form")
+	dct := makeTestStream(jpeg, "Subtype", "Image", "Filter", "DCTDecode")
+	meta := makeTestStream(metadata, "Type", "Metadata", "Subtype", "XML")
+	hex := makeTestStream("48656c6c6f>", "Subtype", "Form", "Filter", "ASCIIHexDecode")
+
+	options := NewCompressionOptions()
+	options.FlateLevel = zlib.BestCompression
+	options.StreamEncoder = func(stream *PdfObjectStream) StreamEncoder {
+		if stream == hex {
+			return NewRawEncoder()
+		}
+		return nil
+	}
+	if err := writeTestStreams(t, options, form, dct, meta, hex); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+
+	if filter, ok := form.Get("Filter").(*PdfObjectName); !ok || *filter != StreamEncodingFilterNameFlate {
+		t.Errorf("Form not Flate compressed: %v", form.Get("Filter"))
+	}
+	if decoded, err := DecodeStream(form); err != nil || string(decoded) != content {
+		t.Errorf("Form content mismatch (%v)", err)
+	}
+	if length, ok := form.Get("Length").(*PdfObjectInteger); !ok || int(*length) != len(form.Stream) ||
+		len(form.Stream) >= len(content) {
+		t.Errorf("Invalid compressed form (%d bytes, Length %v)", len(form.Stream), form.Get("Length"))
+	}
+	if string(dct.Stream) != jpeg || string(meta.Stream) != metadata || meta.Get("Filter") != nil {
+		t.Errorf("DCT or metadata stream modified")
+	}
+	if hex.Get("Filter") != nil || string(hex.Stream) != "Hello" {
+		t.Errorf("Override not applied: %v %q", hex.Get("Filter"), hex.Stream)
+	}
+
+	// Flate on top of DCT.
+	dct = makeTestStream(jpeg, "Subtype", "Image", "Filter", "DCTDecode")
+	options = NewCompressionOptions()
+	options.FlateDCT = true
+	if err := writeTestStreams(t, options, dct); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	if filter := dct.Get("Filter"); filter.DefaultWriteString() != "[/FlateDecode /DCTDecode]" {
+		t.Errorf("Invalid DCT filter %v", filter)
+	}
+	if decoded, err := NewFlateEncoder().DecodeBytes(dct.Stream); err != nil || string(decoded) != jpeg {
+		t.Errorf("DCT data mismatch (%v)", err)
+	}
+
+	options = NewCompressionOptions()
+	options.FlateLevel = 12
+	if err := writeTestStreams(t, options, makeTestStream(content)); err != ErrRangeError {
+		t.Errorf("Expected range error for invalid level, got %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// StreamDeduplicationReport describes the duplicate streams merged when writing with stream deduplication enabled.
+type StreamDeduplicationReport struct {
+	// Number of duplicate streams replaced by a single copy.
+	NumRemoved int
+
+	// Total length of the (encoded) data of the removed streams.
+	BytesSaved int64
+}
+
+// SetStreamDeduplication enables or disables stream deduplication at write time. When enabled, streams with
+// identical dictionaries and data, e.g. the same images and embedded fonts of documents being merged, are written
+// only once and all references point to the single copy.
+func (this *PdfWriter) SetStreamDeduplication(enable bool) {
+	this.deduplicateStreams = enable
+}
+
+// GetStreamDeduplicationReport returns the report of the stream deduplication of the last Write. Returns nil if
+// deduplication is not enabled or the writer has not been written.
+func (this *PdfWriter) GetStreamDeduplicationReport() *StreamDeduplicationReport {
+	return this.dedupReport
+}
+
+// deduplicateObjectStreams replaces duplicate streams by a single copy. Stream dictionaries are compared by their
+// serialization, in which references are written with the current object numbers. Streams that reference
+// duplicates themselves (e.g. images with the same soft mask) only become equal once those are merged, so the
+// process is repeated until no more duplicates are found.
+func (this *PdfWriter) deduplicateObjectStreams() {
+	report := &StreamDeduplicationReport{}
+
+	for {
+		this.updateObjectNumbers()
+
+		replacements := map[PdfObject]PdfObject{}
+		candidates := map[[sha256.Size]byte][]*PdfObjectStream{}
+		for _, obj := range this.objects {
+			stream, ok := obj.(*PdfObjectStream)
+			if !ok {
+				continue
+			}
+			dictStr := stream.PdfObjectDictionary.DefaultWriteString()
+			h := sha256.New()
+			h.Write([]byte(dictStr))
+			h.Write([]byte{0})
+			h.Write(stream.Stream)
+			var key [sha256.Size]byte
+			copy(key[:], h.Sum(nil))
+
+			var original *PdfObjectStream
+			for _, candidate := range candidates[key] {
+				// Guard against hash collisions.
+				if candidate.PdfObjectDictionary.DefaultWriteString() == dictStr &&
+					bytes.Equal(candidate.Stream, stream.Stream) {
+					original = candidate
+					break
+				}
+			}
+			if original == nil {
+				candidates[key] = append(candidates[key], stream)
+				continue
+			}
+			replacements[stream] = original
+			report.NumRemoved++
+			report.BytesSaved += int64(len(stream.Stream))
+		}
+		if len(replacements) == 0 {
+			break
+		}
+
+		kept := make([]PdfObject, 0, len(this.objects)-len(replacements))
+		for _, obj := range this.objects {
+			if _, isDuplicate := replacements[obj]; isDuplicate {
+				continue
+			}
+			replaceObjects(obj, replacements)
+			kept = append(kept, obj)
+		}
+		this.objects = kept
+	}
+
+	common.Log.Trace("Stream deduplication: removed %d streams (%d bytes)", report.NumRemoved, report.BytesSaved)
+	this.dedupReport = report
+}
+
+// replaceObjects replaces the references to the keys of `replacements` in the direct objects contained in `obj`
+// by the corresponding values.
+func replaceObjects(obj PdfObject, replacements map[PdfObject]PdfObject) {
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		replaceObjects(t.PdfObject, replacements)
+	case *PdfObjectStream:
+		replaceObjects(t.PdfObjectDictionary, replacements)
+	case *PdfObjectDictionary:
+		for _, key := range t.Keys() {
+			val := t.Get(key)
+			if replacement, has := replacements[val]; has {
+				t.Set(key, replacement)
+				continue
+			}
+			if isDirectContainer(val) {
+				replaceObjects(val, replacements)
+			}
+		}
+	case *PdfObjectArray:
+		for i, val := range *t {
+			if replacement, has := replacements[val]; has {
+				(*t)[i] = replacement
+				continue
+			}
+			if isDirectContainer(val) {
+				replaceObjects(val, replacements)
+			}
+		}
+	}
+}
+
+// isDirectContainer returns true if `obj` is a direct dictionary or array.
+func isDirectContainer(obj PdfObject) bool {
+	switch obj.(type) {
+	case *PdfObjectDictionary, *PdfObjectArray:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestWriterStreamDeduplication(t *testing.T) {
+	makeForm := func(content string) *PdfObjectStream {
+		dict := MakeDict()
+		dict.Set("Type", MakeName("XObject"))
+		dict.Set("Subtype", MakeName("Form"))
+		dict.Set("BBox", MakeArrayFromIntegers([]int{0, 0, 10, 10}))
+		dict.Set("Length", MakeInteger(int64(len(content))))
+		return &PdfObjectStream{PdfObjectDictionary: dict, Stream: []byte(content)}
+	}
+
+	w := NewPdfWriter()
+	w.SetStreamDeduplication(true)
+	// Two pages with the same "letterhead" and a third one with different content.
+	for _, content := range []string{"0 0 10 10 re f", "0 0 10 10 re f", "0 0 5 5 re f"} {
+		page := NewPdfPage()
+		page.Resources = NewPdfPageResources()
+		page.Resources.SetXObjectByName("Fm1", makeForm(content))
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+
+	f, err := ioutil.TempFile("", "dedup")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+
+	// Streams added to each page when unlicensed are deduplicated as well.
+	report := w.GetStreamDeduplicationReport()
+	if report == nil || report.NumRemoved < 1 || report.BytesSaved < 14 {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+
+	f.Seek(0, os.SEEK_SET)
+	reader, err := NewPdfReader(f)
+	if err != nil {
+		t.Fatalf("Error reading: %v", err)
+	}
+	objNums := []int64{}
+	for i := 1; i <= 3; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		form, _ := page.Resources.GetXObjectByName("Fm1")
+		if form == nil {
+			t.Fatalf("Page %d: missing form", i)
+		}
+		objNums = append(objNums, form.ObjectNumber)
+	}
+	if objNums[0] != objNums[1] || objNums[0] == objNums[2] {
+		t.Errorf("Form object numbers %v", objNums)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// GarbageCollectionReport describes the unreferenced objects dropped when writing with garbage collection enabled.
+type GarbageCollectionReport struct {
+	// Number of objects before garbage collection.
+	NumObjects int
+
+	// Number of unreferenced objects dropped.
+	NumRemoved int
+
+	// Number of dropped objects per Type entry, "Stream" for streams without a Type and "Other" for other objects.
+	RemovedTypes map[string]int
+}
+
+// SetGarbageCollection enables or disables garbage collection at write time. When enabled, the objects that are
+// not reachable from the trailer (document catalog, information dictionary and encryption dictionary) are not
+// written, e.g. objects of removed pages or page trees of source documents referenced by annotations.
+func (this *PdfWriter) SetGarbageCollection(enable bool) {
+	this.garbageCollection = enable
+}
+
+// GetGarbageCollectionReport returns the report of the garbage collection of the last Write. Returns nil if
+// garbage collection is not enabled or the writer has not been written.
+func (this *PdfWriter) GetGarbageCollectionReport() *GarbageCollectionReport {
+	return this.gcReport
+}
+
+// collectGarbage removes the objects not reachable from the trailer from the objects to write.
+func (this *PdfWriter) collectGarbage() {
+	reachable := map[PdfObject]bool{}
+	var mark func(obj PdfObject)
+	mark = func(obj PdfObject) {
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			if reachable[t] {
+				return
+			}
+			reachable[t] = true
+			mark(t.PdfObject)
+		case *PdfObjectStream:
+			if reachable[t] {
+				return
+			}
+			reachable[t] = true
+			mark(t.PdfObjectDictionary)
+		case *PdfObjectDictionary:
+			for _, key := range t.Keys() {
+				mark(t.Get(key))
+			}
+		case *PdfObjectArray:
+			for _, val := range *t {
+				mark(val)
+			}
+		}
+	}
+
+	mark(this.root)
+	mark(this.infoObj)
+	if this.crypter != nil {
+		mark(this.encryptObj)
+	}
+
+	report := &GarbageCollectionReport{NumObjects: len(this.objects), RemovedTypes: map[string]int{}}
+	kept := make([]PdfObject, 0, len(this.objects))
+	for _, obj := range this.objects {
+		if reachable[obj] {
+			kept = append(kept, obj)
+			continue
+		}
+		report.NumRemoved++
+		report.RemovedTypes[garbageObjectType(obj)]++
+	}
+	common.Log.Trace("Garbage collection: removed %d of %d objects", report.NumRemoved, report.NumObjects)
+
+	this.objects = kept
+	this.gcReport = report
+}
+
+// garbageObjectType returns the type of `obj` for the garbage collection report.
+func garbageObjectType(obj PdfObject) string {
+	var dict *PdfObjectDictionary
+	typeName := "Other"
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		dict, _ = t.PdfObject.(*PdfObjectDictionary)
+	case *PdfObjectStream:
+		dict = t.PdfObjectDictionary
+		typeName = "Stream"
+	}
+	if dict != nil {
+		if name, ok := TraceToDirectObject(dict.Get("Type")).(*PdfObjectName); ok {
+			typeName = string(*name)
+		}
+	}
+	return typeName
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestWriterGarbageCollection(t *testing.T) {
+	makeOCProperties := func() *PdfIndirectObject {
+		ocgDict := MakeDict()
+		ocgDict.Set("Type", MakeName("OCG"))
+		ocgDict.Set("Name", MakeString("Layer"))
+		ocg := MakeIndirectObject(ocgDict)
+		props := MakeDict()
+		props.Set("OCGs", MakeArray(ocg))
+		return MakeIndirectObject(props)
+	}
+
+	for _, gc := range []bool{false, true} {
+		w := NewPdfWriter()
+		w.SetGarbageCollection(gc)
+		page := NewPdfPage()
+		page.Resources = NewPdfPageResources()
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		// The first optional content properties are replaced and become unreferenced.
+		w.SetOCProperties(makeOCProperties())
+		w.SetOCProperties(makeOCProperties())
+
+		f, err := ioutil.TempFile("", "gc")
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		err = w.Write(f)
+		f.Close()
+		os.Remove(f.Name())
+		if err != nil {
+			t.Fatalf("Error writing: %v", err)
+		}
+
+		report := w.GetGarbageCollectionReport()
+		if !gc {
+			if report != nil {
+				t.Errorf("Unexpected report without garbage collection: %+v", report)
+			}
+			continue
+		}
+		if report == nil {
+			t.Fatalf("Missing garbage collection report")
+		}
+		if report.NumRemoved != 2 || report.NumObjects != len(w.objects)+2 {
+			t.Errorf("Removed %d of %d objects, %d written", report.NumRemoved, report.NumObjects, len(w.objects))
+		}
+		if expected := map[string]int{"OCG": 1, "Other": 1}; !reflect.DeepEqual(report.RemovedTypes, expected) {
+			t.Errorf("Removed types %v, expected %v", report.RemovedTypes, expected)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"sync"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// WriterHooks are the functions called by a PdfWriter at points of the document assembly, letting external
+// packages extend the writer, e.g. to stamp pages for compliance or collect telemetry. Unset hooks are not called.
+type WriterHooks struct {
+	// OnPageAdded is called by AddPage before adding `page`, which will be page number `pageNum` (1-based) of the
+	// output. The hook can modify the page. Returning an error aborts AddPage with this error.
+	OnPageAdded func(w *PdfWriter, page *PdfPage, pageNum int) error
+
+	// OnFontEmbedded is called by Write before writing a font dictionary `font` with an embedded font program,
+	// once for composite fonts (for the Type0 font, not its CIDFont).
+	OnFontEmbedded func(w *PdfWriter, font *PdfObjectDictionary)
+
+	// OnObjectWritten is called by Write after writing object `obj` with object number `objNum`.
+	OnObjectWritten func(w *PdfWriter, objNum int, obj PdfObject)
+}
+
+var (
+	registeredWriterHooks   []WriterHooks
+	registeredWriterHooksMu sync.Mutex
+)
+
+// RegisterWriterHooks registers `hooks` for all writers created afterwards with NewPdfWriter. Typically called
+// from the init function of a plugin package.
+func RegisterWriterHooks(hooks WriterHooks) {
+	registeredWriterHooksMu.Lock()
+	defer registeredWriterHooksMu.Unlock()
+	registeredWriterHooks = append(registeredWriterHooks, hooks)
+}
+
+// getRegisteredWriterHooks returns a copy of the registered writer hooks.
+func getRegisteredWriterHooks() []WriterHooks {
+	registeredWriterHooksMu.Lock()
+	defer registeredWriterHooksMu.Unlock()
+	return append([]WriterHooks{}, registeredWriterHooks...)
+}
+
+// AddHooks adds `hooks` to the writer, called after the hooks registered with RegisterWriterHooks and previously
+// added hooks.
+func (this *PdfWriter) AddHooks(hooks WriterHooks) {
+	this.hooks = append(this.hooks, hooks)
+}
+
+// onPageAdded calls the OnPageAdded hooks, returning the first error.
+func (this *PdfWriter) onPageAdded(page *PdfPage, pageNum int) error {
+	for _, hooks := range this.hooks {
+		if hooks.OnPageAdded != nil {
+			if err := hooks.OnPageAdded(this, page, pageNum); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// onObjectWriting calls the OnFontEmbedded hooks if `obj` is a font with an embedded font program.
+func (this *PdfWriter) onObjectWriting(obj PdfObject) {
+	font, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok || !isEmbeddedFont(font) {
+		return
+	}
+	for _, hooks := range this.hooks {
+		if hooks.OnFontEmbedded != nil {
+			hooks.OnFontEmbedded(this, font)
+		}
+	}
+}
+
+// onObjectWritten calls the OnObjectWritten hooks.
+func (this *PdfWriter) onObjectWritten(objNum int, obj PdfObject) {
+	for _, hooks := range this.hooks {
+		if hooks.OnObjectWritten != nil {
+			hooks.OnObjectWritten(this, objNum, obj)
+		}
+	}
+}
+
+// isEmbeddedFont returns true if `dict` is a font dictionary whose font descriptor, or that of its descendant
+// font for composite fonts, has a font program (FontFile, FontFile2 or FontFile3 entry). Returns false for the
+// CIDFonts descending from composite fonts, which are reported with their composite font.
+func isEmbeddedFont(dict *PdfObjectDictionary) bool {
+	if name, ok := TraceToDirectObject(dict.Get("Type")).(*PdfObjectName); !ok || *name != "Font" {
+		return false
+	}
+	if subtype, ok := TraceToDirectObject(dict.Get("Subtype")).(*PdfObjectName); ok &&
+		(*subtype == "CIDFontType0" || *subtype == "CIDFontType2") {
+		return false
+	}
+	if descendants, ok := TraceToDirectObject(dict.Get("DescendantFonts")).(*PdfObjectArray); ok {
+		if len(*descendants) == 0 {
+			return false
+		}
+		descendant, ok := TraceToDirectObject((*descendants)[0]).(*PdfObjectDictionary)
+		if !ok {
+			return false
+		}
+		dict = descendant
+	}
+	descriptor, ok := TraceToDirectObject(dict.Get("FontDescriptor")).(*PdfObjectDictionary)
+	if !ok {
+		return false
+	}
+	for _, key := range []PdfObjectName{"FontFile", "FontFile2", "FontFile3"} {
+		if descriptor.Get(key) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestWriterHooks(t *testing.T) {
+	// A TrueType font and a composite font with an embedded font program and a standard font without.
+	fontFile, err := MakeStream([]byte("font program"), NewRawEncoder())
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	descriptor := MakeDict()
+	descriptor.Set("Type", MakeName("FontDescriptor"))
+	descriptor.Set("FontFile2", fontFile)
+	embedded := MakeDict()
+	embedded.Set("Type", MakeName("Font"))
+	embedded.Set("Subtype", MakeName("TrueType"))
+	embedded.Set("FontDescriptor", MakeIndirectObject(descriptor))
+	cidFont := MakeDict()
+	cidFont.Set("Type", MakeName("Font"))
+	cidFont.Set("Subtype", MakeName("CIDFontType2"))
+	cidFont.Set("FontDescriptor", MakeIndirectObject(descriptor))
+	composite := MakeDict()
+	composite.Set("Type", MakeName("Font"))
+	composite.Set("Subtype", MakeName("Type0"))
+	composite.Set("DescendantFonts", MakeArray(MakeIndirectObject(cidFont)))
+	standard := MakeDict()
+	standard.Set("Type", MakeName("Font"))
+	standard.Set("Subtype", MakeName("Type1"))
+	standard.Set("BaseFont", MakeName("Helvetica"))
+
+	var pageNums []int
+	var fonts []*PdfObjectDictionary
+	objNums := map[int]bool{}
+	errTooMany := errors.New("too many pages")
+
+	w := NewPdfWriter()
+	w.AddHooks(WriterHooks{
+		OnPageAdded: func(w *PdfWriter, page *PdfPage, pageNum int) error {
+			if pageNum > 2 {
+				return errTooMany
+			}
+			pageNums = append(pageNums, pageNum)
+			return nil
+		},
+		OnFontEmbedded: func(w *PdfWriter, font *PdfObjectDictionary) {
+			fonts = append(fonts, font)
+		},
+		OnObjectWritten: func(w *PdfWriter, objNum int, obj PdfObject) {
+			objNums[objNum] = true
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		page := NewPdfPage()
+		page.Resources = NewPdfPageResources()
+		font := standard
+		if i == 1 {
+			font = embedded
+		}
+		if err := page.Resources.SetFontByName("F1", MakeIndirectObject(font)); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if i == 0 {
+			if err := page.Resources.SetFontByName("F2", MakeIndirectObject(composite)); err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+		}
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+	page := NewPdfPage()
+	page.Resources = NewPdfPageResources()
+	if err := w.AddPage(page); err != errTooMany {
+		t.Errorf("Expected the hook error, got %v", err)
+	}
+	if len(pageNums) != 2 || pageNums[0] != 1 || pageNums[1] != 2 {
+		t.Errorf("Unexpected page numbers %v", pageNums)
+	}
+
+	if err := w.Write(&memWriteSeeker{}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	// The composite font is reported once, not again for its CIDFont.
+	if len(fonts) != 2 || !(fonts[0] == embedded && fonts[1] == composite ||
+		fonts[0] == composite && fonts[1] == embedded) {
+		t.Errorf("Expected the embedded fonts only, got %v", fonts)
+	}
+	if len(objNums) != len(w.objects) {
+		t.Errorf("%d objects written, %d reported", len(w.objects), len(objNums))
+	}
+}
+
+func TestRegisterWriterHooks(t *testing.T) {
+	defer func(hooks []WriterHooks) {
+		registeredWriterHooks = hooks
+	}(registeredWriterHooks)
+
+	added := 0
+	RegisterWriterHooks(WriterHooks{
+		OnPageAdded: func(w *PdfWriter, page *PdfPage, pageNum int) error {
+			added++
+			return nil
+		},
+	})
+	w := NewPdfWriter()
+	page := NewPdfPage()
+	page.Resources = NewPdfPageResources()
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if added != 1 {
+		t.Errorf("Registered hook called %d times", added)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"crypto/rand"
+	"hash"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// DocumentIDPolicy specifies how PdfWriter generates the file identifier of the document (ID entry of the
+// trailer, 14.4), which some systems use to recognize documents, e.g. for deduplication.
+type DocumentIDPolicy int
+
+const (
+	// DocumentIDDefault writes a random identifier for encrypted documents and no identifier otherwise.
+	DocumentIDDefault DocumentIDPolicy = iota
+
+	// DocumentIDRandom writes a random identifier, different for each write.
+	DocumentIDRandom
+
+	// DocumentIDContentHash writes the MD5 digest of the document up to the trailer as identifier, so that
+	// identical documents get identical identifiers. Not supported for encrypted documents, whose encryption key depends on
+	// the identifier.
+	DocumentIDContentHash
+
+	// DocumentIDFixed writes the identifier set with PdfWriter.SetDocumentID.
+	DocumentIDFixed
+)
+
+// SetDocumentIDPolicy sets how the file identifier of the document is generated. The policy applies to
+// encrypted documents if set prior to calling Encrypt.
+func (this *PdfWriter) SetDocumentIDPolicy(policy DocumentIDPolicy) {
+	this.idPolicy = policy
+}
+
+// SetDocumentID sets the file identifier of the document and the DocumentIDFixed policy. `permanent` is the
+// identifier of the document, normally kept by further versions, and `changing` that of this version of the
+// document, the same as `permanent` if nil. Set prior to calling Encrypt for encrypted documents.
+func (this *PdfWriter) SetDocumentID(permanent, changing []byte) {
+	if changing == nil {
+		changing = permanent
+	}
+	id0 := PdfObjectString(permanent)
+	id1 := PdfObjectString(changing)
+	this.idPolicy = DocumentIDFixed
+	this.fixedID = &PdfObjectArray{&id0, &id1}
+}
+
+// newDocumentID returns the file identifier of the document following the policy, or nil if none is written.
+// `hasher` has the digest of the document written for DocumentIDContentHash.
+func (this *PdfWriter) newDocumentID(hasher hash.Hash) (*PdfObjectArray, error) {
+	switch this.idPolicy {
+	case DocumentIDRandom:
+		return newRandomDocumentID()
+	case DocumentIDContentHash:
+		if hasher == nil {
+			return nil, nil
+		}
+		digest := hasher.Sum(nil)
+		id := PdfObjectString(digest)
+		return &PdfObjectArray{&id, &id}, nil
+	case DocumentIDFixed:
+		return this.fixedID, nil
+	}
+	return nil, nil
+}
+
+// newRandomDocumentID returns a random file identifier, both parts of which are 16 random bytes.
+func newRandomDocumentID() (*PdfObjectArray, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		common.Log.Debug("ERROR: Unable to generate a random document ID: %v", err)
+		return nil, err
+	}
+	common.Log.Trace("Random b: % x", b)
+	id0 := PdfObjectString(b[:16])
+	id1 := PdfObjectString(b[16:])
+	return &PdfObjectArray{&id0, &id1}, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestDocumentIDPolicy(t *testing.T) {
+	// writeID writes a one page document with contents `content` and returns the elements of its file identifier.
+	writeID := func(content string, opts ...WriterOption) []string {
+		w := NewPdfWriterWith(opts...)
+		page := NewPdfPage()
+		page.Resources = NewPdfPageResources()
+		page.AddContentStreamByString(content)
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		ws := &memWriteSeeker{}
+		if err := w.Write(ws); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+
+		parser, err := NewParser(bytes.NewReader(ws.buf))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		arr, ok := parser.GetTrailer().Get("ID").(*PdfObjectArray)
+		if !ok {
+			return nil
+		}
+		ids := []string{}
+		for _, obj := range *arr {
+			ids = append(ids, string(*obj.(*PdfObjectString)))
+		}
+		return ids
+	}
+
+	if ids := writeID("0 0 10 10 re f"); ids != nil {
+		t.Errorf("Unexpected ID %q by default", ids)
+	}
+
+	random1 := writeID("0 0 10 10 re f", WithDocumentIDPolicy(DocumentIDRandom))
+	random2 := writeID("0 0 10 10 re f", WithDocumentIDPolicy(DocumentIDRandom))
+	if len(random1) != 2 || len(random2) != 2 || len(random1[0]) != 16 || len(random1[1]) != 16 ||
+		random1[0] == random2[0] || random1[1] == random2[1] {
+		t.Errorf("Random IDs %q and %q", random1, random2)
+	}
+
+	hash1 := writeID("0 0 10 10 re f", WithDocumentIDPolicy(DocumentIDContentHash))
+	hash2 := writeID("0 0 10 10 re f", WithDocumentIDPolicy(DocumentIDContentHash))
+	hash3 := writeID("0 0 20 20 re f", WithDocumentIDPolicy(DocumentIDContentHash))
+	if len(hash1) != 2 || len(hash1[0]) != 16 || hash1[0] != hash1[1] {
+		t.Fatalf("Invalid content hash ID %q", hash1)
+	}
+	if hash1[0] != hash2[0] || hash1[0] == hash3[0] {
+		t.Errorf("Content hash IDs %q, %q and %q", hash1, hash2, hash3)
+	}
+
+	fixed := writeID("0 0 10 10 re f", WithDocumentID([]byte("permanent"), nil))
+	if len(fixed) != 2 || fixed[0] != "permanent" || fixed[1] != "permanent" {
+		t.Errorf("Unexpected fixed ID %q", fixed)
+	}
+
+	w := NewPdfWriterWith(WithDocumentIDPolicy(DocumentIDContentHash))
+	if err := w.Encrypt([]byte("user"), []byte("owner"), nil); err == nil {
+		t.Errorf("Content hash ID should not be supported with encryption")
+	}
+	w = NewPdfWriterWith(WithDocumentID([]byte("permanent"), []byte("changing")))
+	if err := w.Encrypt([]byte("user"), []byte("owner"), nil); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if id0, ok := (*w.ids)[0].(*PdfObjectString); !ok || string(*id0) != "permanent" {
+		t.Errorf("Fixed ID not used for encryption: %v", w.ids)
+	}
+}
@@ -0,0 +1,22 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+// WriteProgress describes the progress of a write, as passed to the progress callback.
+type WriteProgress struct {
+	// Number of objects written so far, out of NumObjects.
+	ObjectsWritten int
+	NumObjects     int
+
+	// Number of bytes of output written so far.
+	BytesWritten int64
+}
+
+// SetProgressCallback sets `fn` to be called after each object written by Write and WriteWithContext, e.g. for
+// monitoring long-running writes of large documents. Set to nil to disable.
+func (this *PdfWriter) SetProgressCallback(fn func(WriteProgress)) {
+	this.progress = fn
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+func makeProgressTestWriter(t *testing.T) PdfWriter {
+	w := NewPdfWriter()
+	for i := 0; i < 3; i++ {
+		page := NewPdfPage()
+		page.Resources = NewPdfPageResources()
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+	return w
+}
+
+func TestWriteProgress(t *testing.T) {
+	w := makeProgressTestWriter(t)
+	updates := []WriteProgress{}
+	w.SetProgressCallback(func(p WriteProgress) {
+		updates = append(updates, p)
+	})
+
+	ws := &memWriteSeeker{}
+	if err := w.Write(ws); err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+
+	if len(updates) == 0 || len(updates) != len(w.objects) {
+		t.Fatalf("Got %d updates for %d objects", len(updates), len(w.objects))
+	}
+	for i, p := range updates {
+		if p.ObjectsWritten != i+1 || p.NumObjects != len(w.objects) {
+			t.Errorf("Update %d: %+v", i, p)
+		}
+		if i > 0 && p.BytesWritten <= updates[i-1].BytesWritten {
+			t.Errorf("Update %d: bytes written not increasing (%+v)", i, p)
+		}
+	}
+	if last := updates[len(updates)-1].BytesWritten; last >= int64(len(ws.buf)) {
+		t.Errorf("Objects end at %d, output length %d", last, len(ws.buf))
+	}
+}
+
+func TestWriteWithContextCancel(t *testing.T) {
+	w := makeProgressTestWriter(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	w.SetProgressCallback(func(p WriteProgress) {
+		if p.ObjectsWritten == 2 {
+			cancel()
+		}
+	})
+
+	err := w.WriteWithContext(ctx, &memWriteSeeker{})
+	if err != context.Canceled {
+		t.Errorf("Expected write to be canceled, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// ReferenceLeak is a reference of the output document to a page that is not in its page tree, typically a link
+// destination, GoTo action or outline item referring to a page of the source document that was not copied.
+// Viewers cannot go to such pages, and writing them copies their contents and resources into the output.
+type ReferenceLeak struct {
+	// Object with the reference (indirect object or stream) and the path of the reference in it, e.g. "A/D[0]".
+	Object PdfObject
+	Path   string
+
+	// Page referred to.
+	Page PdfObject
+}
+
+func (l *ReferenceLeak) String() string {
+	return fmt.Sprintf("%s in %s -> page %s", l.Path, l.Object, l.Page)
+}
+
+// SetReferenceRemap sets the table of references replaced at write time: each reference of the written objects
+// to a key of `remap` is replaced by a reference to its value, e.g. to point destinations to copies of pages
+// (PdfPage.Duplicate, PdfNamedPage.Spawn) rather than to the source pages. Replaced objects that are no longer
+// referenced are dropped with garbage collection (SetGarbageCollection).
+func (this *PdfWriter) SetReferenceRemap(remap map[PdfObject]PdfObject) {
+	this.referenceRemap = remap
+}
+
+// SetRemoveLeakedDestinations enables or disables removing at write time the destinations (Dest entries) and
+// GoTo actions referring to pages that are not in the page tree of the output. With garbage collection
+// (SetGarbageCollection), the pages that are no longer referenced are then not written.
+func (this *PdfWriter) SetRemoveLeakedDestinations(enable bool) {
+	this.removeLeakedDests = enable
+}
+
+// GetReferenceLeaks returns the references to pages not in the page tree found by the last Write, after applying
+// the reference remap table and removing leaked destinations. Returns nil if there are none.
+func (this *PdfWriter) GetReferenceLeaks() []*ReferenceLeak {
+	return this.referenceLeaks
+}
+
+// fixupReferences applies the reference remap table, then finds the references to pages not in the page tree,
+// removing leaked destinations if enabled.
+func (this *PdfWriter) fixupReferences() error {
+	if len(this.referenceRemap) > 0 {
+		for _, obj := range this.objects {
+			replaceObjects(obj, this.referenceRemap)
+		}
+		for _, target := range this.referenceRemap {
+			if err := this.addObjects(target); err != nil {
+				return err
+			}
+		}
+	}
+
+	pages := map[PdfObject]bool{}
+	if pagesDict, ok := this.pages.PdfObject.(*PdfObjectDictionary); ok {
+		if kids, ok := pagesDict.Get("Kids").(*PdfObjectArray); ok {
+			for _, kid := range *kids {
+				pages[kid] = true
+			}
+		}
+	}
+	isLeak := func(obj PdfObject) bool {
+		if pages[obj] {
+			return false
+		}
+		ind, ok := obj.(*PdfIndirectObject)
+		if !ok {
+			return false
+		}
+		dict, ok := ind.PdfObject.(*PdfObjectDictionary)
+		if !ok {
+			return false
+		}
+		name, ok := dict.Get("Type").(*PdfObjectName)
+		return ok && *name == "Page"
+	}
+
+	// Find the leaks reachable from the document structure, without following the leaked pages.
+	this.referenceLeaks = nil
+	visited := map[PdfObject]bool{}
+	var walk func(container, obj PdfObject, path string)
+	walk = func(container, obj PdfObject, path string) {
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			if visited[t] {
+				return
+			}
+			visited[t] = true
+			walk(t, t.PdfObject, "")
+		case *PdfObjectStream:
+			if visited[t] {
+				return
+			}
+			visited[t] = true
+			walk(t, t.PdfObjectDictionary, "")
+		case *PdfObjectDictionary:
+			// The keys are copied, as removing leaked destinations modifies them.
+			for _, key := range append([]PdfObjectName{}, t.Keys()...) {
+				val := t.Get(key)
+				if this.removeLeakedDests && isLeakedDestination(key, val, isLeak) {
+					common.Log.Debug("Removing %s%s referring to a page not in the page tree", path, key)
+					t.Remove(key)
+					continue
+				}
+				keyPath := path + string(key)
+				if isLeak(val) {
+					this.referenceLeaks = append(this.referenceLeaks, &ReferenceLeak{Object: container, Path: keyPath, Page: val})
+					continue
+				}
+				walk(container, val, keyPath+"/")
+			}
+		case *PdfObjectArray:
+			if len(path) > 0 && path[len(path)-1] == '/' {
+				path = path[:len(path)-1]
+			}
+			for i, val := range *t {
+				elemPath := fmt.Sprintf("%s[%d]", path, i)
+				if isLeak(val) {
+					this.referenceLeaks = append(this.referenceLeaks, &ReferenceLeak{Object: container, Path: elemPath, Page: val})
+					continue
+				}
+				walk(container, val, elemPath+"/")
+			}
+		}
+	}
+	walk(nil, this.root, "")
+	walk(nil, this.infoObj, "")
+
+	for _, leak := range this.referenceLeaks {
+		common.Log.Debug("Reference to a page not in the page tree: %s", leak)
+	}
+	return nil
+}
+
+// isLeakedDestination returns true if entry `key` with value `val` is a destination (Dest) or GoTo action (A)
+// referring to a page for which `isLeak` is true.
+func isLeakedDestination(key PdfObjectName, val PdfObject, isLeak func(obj PdfObject) bool) bool {
+	leakedDest := func(dest PdfObject) bool {
+		arr, ok := TraceToDirectObject(dest).(*PdfObjectArray)
+		return ok && len(*arr) > 0 && isLeak((*arr)[0])
+	}
+	switch key {
+	case "Dest":
+		return leakedDest(val)
+	case "A":
+		action, ok := TraceToDirectObject(val).(*PdfObjectDictionary)
+		if !ok {
+			return false
+		}
+		s, ok := TraceToDirectObject(action.Get("S")).(*PdfObjectName)
+		return ok && *s == "GoTo" && leakedDest(action.Get("D"))
+	}
+	return false
+}
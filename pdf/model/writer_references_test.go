@@ -0,0 +1,123 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// testLinkObjects are the objects of a document with links on pages 1 and 2 to page 3.
+var testLinkObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R >>",
+	"<< /Type /Pages /Kids [3 0 R 4 0 R 5 0 R] /Count 3 >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << >> /Annots [6 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << >> /Annots [7 0 R] >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << >> >>",
+	"<< /Type /Annot /Subtype /Link /Rect [0 0 10 10] /Dest [5 0 R /Fit] >>",
+	"<< /Type /Annot /Subtype /Link /Rect [0 0 10 10] /A << /S /GoTo /D [5 0 R /XYZ 0 792 0] >> >>",
+}
+
+func TestWriterReferenceLeaks(t *testing.T) {
+	data := makeTestPdf(testLinkObjects)
+	writePages := func(setup func(w *PdfWriter, reader *PdfReader) error) (*PdfWriter, *PdfReader) {
+		reader, err := NewPdfReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		w := NewPdfWriter()
+		for _, page := range reader.PageList[:2] {
+			if err := w.AddPage(page); err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+		}
+		if err := setup(&w, reader); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		w.SetGarbageCollection(true)
+		ws := &memWriteSeeker{}
+		if err := w.Write(ws); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		out, err := NewPdfReader(bytes.NewReader(ws.buf))
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return &w, out
+	}
+	countPageObjects := func(reader *PdfReader) int {
+		types, err := reader.Inspect()
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return types["Page"]
+	}
+
+	// Page 3 is not copied: both links leak.
+	w, out := writePages(func(w *PdfWriter, reader *PdfReader) error { return nil })
+	leaks := w.GetReferenceLeaks()
+	if len(leaks) != 2 || leaks[0].Path != "Dest[0]" || leaks[1].Path != "A/D[0]" {
+		t.Fatalf("Unexpected leaks %v", leaks)
+	}
+	if n := countPageObjects(out); n != 3 {
+		t.Errorf("Expected the leaked page to be written, got %d pages", n)
+	}
+
+	// Removing the leaked destinations.
+	w, out = writePages(func(w *PdfWriter, reader *PdfReader) error {
+		w.SetRemoveLeakedDestinations(true)
+		return nil
+	})
+	if leaks := w.GetReferenceLeaks(); len(leaks) != 0 {
+		t.Errorf("Unexpected leaks %v", leaks)
+	}
+	if n := countPageObjects(out); n != 2 {
+		t.Errorf("Expected 2 pages, got %d", n)
+	}
+	for _, page := range out.PageList {
+		link := page.Annotations[0].GetContext().(*PdfAnnotationLink)
+		if link.Dest != nil || link.A != nil {
+			t.Errorf("Leaked destination not removed")
+		}
+	}
+
+	// The entries following a removed destination are still checked.
+	objects := append([]string{}, testLinkObjects...)
+	objects[5] = "<< /Type /Annot /Subtype /Link /Rect [0 0 10 10] /Dest [5 0 R /Fit] /Pg 5 0 R /H /I >>"
+	data = makeTestPdf(objects)
+	w, _ = writePages(func(w *PdfWriter, reader *PdfReader) error {
+		w.SetRemoveLeakedDestinations(true)
+		return nil
+	})
+	if leaks := w.GetReferenceLeaks(); len(leaks) != 1 || leaks[0].Path != "Pg" {
+		t.Errorf("Unexpected leaks %v", leaks)
+	}
+	data = makeTestPdf(testLinkObjects)
+
+	// Remapping page 3 to a copy of it.
+	w, out = writePages(func(w *PdfWriter, reader *PdfReader) error {
+		source := reader.PageList[2]
+		dup := source.Duplicate()
+		if err := w.AddPage(dup); err != nil {
+			return err
+		}
+		w.SetReferenceRemap(map[PdfObject]PdfObject{source.GetPageAsIndirectObject(): dup.GetPageAsIndirectObject()})
+		return nil
+	})
+	if leaks := w.GetReferenceLeaks(); len(leaks) != 0 {
+		t.Errorf("Unexpected leaks %v", leaks)
+	}
+	if n := countPageObjects(out); n != 3 {
+		t.Errorf("Expected 3 pages, got %d", n)
+	}
+	link := out.PageList[0].Annotations[0].GetContext().(*PdfAnnotationLink)
+	dest, ok := TraceToDirectObject(link.Dest).(*PdfObjectArray)
+	if !ok || (*dest)[0] != out.PageList[2].GetPageAsIndirectObject() {
+		t.Errorf("Destination not remapped: %v", link.Dest)
+	}
+}
@@ -305,6 +305,74 @@ func UpdateXObjectImageFromImage(xobjIn *XObjectImage, img *Image, cs PdfColorsp
 	return xobj, nil
 }
 
+// NewXObjectImageMask creates a new image mask XObject (section 8.9.6.2) from the 1 bit, 1 component image `mask`.
+// An image mask is painted in the current fill color where its samples are 0 and leaves the page unchanged where
+// they are 1, e.g. the output of Image.GetStencilMask. If `encoder` is nil, uses raw encoding (none).
+func NewXObjectImageMask(mask *Image, encoder StreamEncoder) (*XObjectImage, error) {
+	if mask.BitsPerComponent != 1 || mask.ColorComponents != 1 {
+		common.Log.Debug("ERROR: Image mask must have 1 bit and 1 component (got %d bits, %d components)",
+			mask.BitsPerComponent, mask.ColorComponents)
+		return nil, ErrRangeError
+	}
+
+	ximg, err := NewXObjectImageFromImage(mask, NewPdfColorspaceDeviceGray(), encoder)
+	if err != nil {
+		return nil, err
+	}
+	ximg.ImageMask = MakeBool(true)
+	return ximg, nil
+}
+
+// IsImageMask returns true if the image is an image mask (ImageMask true), i.e. a stencil mask painted with the
+// current fill color. The ColorSpace of an image mask is ignored.
+func (ximg *XObjectImage) IsImageMask() bool {
+	mask, ok := TraceToDirectObject(ximg.ImageMask).(*PdfObjectBool)
+	return ok && bool(*mask)
+}
+
+// SetStencilMask sets image mask `mask` as the stencil mask (Mask entry) of the image: the image is painted where
+// the samples of `mask` are 0 and masked out where they are 1 (section 8.9.6.3).
+// The mask may have a different resolution than the image, but covers the same area.
+// Removes any soft mask (SMask), which would take precedence over the mask.
+func (ximg *XObjectImage) SetStencilMask(mask *XObjectImage) error {
+	if !mask.IsImageMask() {
+		common.Log.Debug("ERROR: Stencil mask is not an image mask")
+		return ErrTypeError
+	}
+	ximg.Mask = mask.ToPdfObject()
+	ximg.SMask = nil
+	return nil
+}
+
+// SetColorKeyMask sets color key masking (section 8.9.6.4) for the image: samples whose color components all fall
+// within the given ranges are masked out. `ranges` contains a [min max] pair per color component of the
+// image colorspace, in the range 0 to 2^BitsPerComponent-1.
+// Removes any soft mask (SMask), which would take precedence over the mask.
+func (ximg *XObjectImage) SetColorKeyMask(ranges []int64) error {
+	if ximg.ColorSpace == nil || ximg.BitsPerComponent == nil || ximg.IsImageMask() {
+		common.Log.Debug("ERROR: Color key mask requires a colorspace and bits per component")
+		return ErrTypeError
+	}
+	if len(ranges) != 2*ximg.ColorSpace.GetNumComponents() {
+		common.Log.Debug("ERROR: Color key mask ranges length %d != 2*%d", len(ranges),
+			ximg.ColorSpace.GetNumComponents())
+		return ErrRangeError
+	}
+
+	maxVal := int64(1)<<uint(*ximg.BitsPerComponent) - 1
+	for i := 0; i < len(ranges); i += 2 {
+		min, max := ranges[i], ranges[i+1]
+		if min < 0 || max > maxVal || min > max {
+			common.Log.Debug("ERROR: Invalid color key mask range [%d %d] (max %d)", min, max, maxVal)
+			return ErrRangeError
+		}
+	}
+
+	ximg.Mask = MakeArrayFromIntegers64(ranges)
+	ximg.SMask = nil
+	return nil
+}
+
 // smaskMatteToGray converts to gray the Matte value in the SMask image referenced by `xobj` (if
 // there is one)
 func smaskMatteToGray(xobj *XObjectImage) error {
@@ -563,7 +631,7 @@ func (ximg *XObjectImage) ToPdfObject() PdfObject {
 		dict.Set("BitsPerComponent", MakeInteger(*(ximg.BitsPerComponent)))
 	}
 
-	if ximg.ColorSpace != nil {
+	if ximg.ColorSpace != nil && !ximg.IsImageMask() {
 		dict.SetIfNotNil("ColorSpace", ximg.ColorSpace.ToPdfObject())
 	}
 	dict.SetIfNotNil("Intent", ximg.Intent)
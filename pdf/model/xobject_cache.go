@@ -0,0 +1,101 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// XObjectFormCache keeps a single form XObject per unique appearance, so that the same appearance stamped on many
+// pages (e.g. watermarks, annotation appearances) is written once and referenced from all pages rather than
+// copied for each page. Appearances are identified by a hash of the content stream and the dictionary of the
+// form, including its resources.
+type XObjectFormCache struct {
+	forms    map[[sha256.Size]byte]*XObjectForm
+	numReuse int
+}
+
+// NewXObjectFormCache returns a new empty form XObject cache.
+func NewXObjectFormCache() *XObjectFormCache {
+	return &XObjectFormCache{forms: map[[sha256.Size]byte]*XObjectForm{}}
+}
+
+// Get returns the cached form XObject with the same appearance as `form`, or adds `form` to the cache and returns
+// it if there is none. The returned form should not be modified as it may be shared.
+func (c *XObjectFormCache) Get(form *XObjectForm) *XObjectForm {
+	h := sha256.New()
+	writeObjectContent(h, form.ToPdfObject(), map[PdfObject]int{})
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+
+	if cached, has := c.forms[key]; has {
+		c.numReuse++
+		return cached
+	}
+	c.forms[key] = form
+	return form
+}
+
+// Len returns the number of unique appearances in the cache.
+func (c *XObjectFormCache) Len() int {
+	return len(c.forms)
+}
+
+// NumReused returns the number of times Get returned a cached form instead of the form passed in.
+func (c *XObjectFormCache) NumReused() int {
+	return c.numReuse
+}
+
+// writeObjectContent writes the content of `obj` to `h`, following indirect objects (other than references to
+// objects of a parsed file, which are written as references), so that objects with identical content produce
+// the same output. `visited` numbers the indirect objects and streams in the order they are first written: later
+// occurrences, whether cycles or shared objects, are written as a reference to that number, so that objects only
+// hash the same if they also share the same objects.
+func writeObjectContent(h hash.Hash, obj PdfObject, visited map[PdfObject]int) {
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		if id, has := visited[t]; has {
+			fmt.Fprintf(h, "ref%d;", id)
+			return
+		}
+		visited[t] = len(visited)
+		h.Write([]byte("obj{"))
+		writeObjectContent(h, t.PdfObject, visited)
+		h.Write([]byte("}"))
+	case *PdfObjectStream:
+		if id, has := visited[t]; has {
+			fmt.Fprintf(h, "ref%d;", id)
+			return
+		}
+		visited[t] = len(visited)
+		h.Write([]byte("stream{"))
+		writeObjectContent(h, t.PdfObjectDictionary, visited)
+		fmt.Fprintf(h, "%d:", len(t.Stream))
+		h.Write(t.Stream)
+		h.Write([]byte("}"))
+	case *PdfObjectDictionary:
+		h.Write([]byte("<<"))
+		for _, key := range t.Keys() {
+			fmt.Fprintf(h, "/%s ", key)
+			writeObjectContent(h, t.Get(key), visited)
+		}
+		h.Write([]byte(">>"))
+	case *PdfObjectArray:
+		h.Write([]byte("["))
+		for _, o := range *t {
+			writeObjectContent(h, o, visited)
+		}
+		h.Write([]byte("]"))
+	case nil:
+		h.Write([]byte("null "))
+	default:
+		fmt.Fprintf(h, "%s ", obj.DefaultWriteString())
+	}
+}
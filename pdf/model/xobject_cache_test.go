@@ -0,0 +1,84 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestXObjectFormCache(t *testing.T) {
+	makeForm := func(content string, opacity float64) *XObjectForm {
+		form := NewXObjectForm()
+		form.Resources = NewPdfPageResources()
+		gs := MakeDict()
+		gs.Set("ca", MakeFloat(opacity))
+		form.Resources.AddExtGState("gs1", MakeIndirectObject(gs))
+		form.BBox = MakeArrayFromFloats([]float64{0, 0, 100, 20})
+		if err := form.SetContentStream([]byte(content), NewFlateEncoder()); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return form
+	}
+
+	cache := NewXObjectFormCache()
+	first := makeForm("/gs1 gs 0 0 100 20 re f", 0.5)
+	if cache.Get(first) != first {
+		t.Fatalf("First form should be cached as is")
+	}
+	for i := 0; i < 10; i++ {
+		if cache.Get(makeForm("/gs1 gs 0 0 100 20 re f", 0.5)) != first {
+			t.Fatalf("Identical appearance not reused")
+		}
+	}
+
+	// Different content and different resources are different appearances.
+	other := makeForm("/gs1 gs 0 0 50 20 re f", 0.5)
+	if cache.Get(other) != other {
+		t.Errorf("Different content reused")
+	}
+	opaque := makeForm("/gs1 gs 0 0 100 20 re f", 1)
+	if cache.Get(opaque) != opaque {
+		t.Errorf("Different resources reused")
+	}
+
+	if cache.Len() != 3 || cache.NumReused() != 10 {
+		t.Errorf("Unexpected cache state: %d forms, %d reused", cache.Len(), cache.NumReused())
+	}
+}
+
+func TestXObjectFormCacheSharing(t *testing.T) {
+	// Forms using identical graphics states in the same order, but sharing different ones.
+	makeForm := func(shared int) *XObjectForm {
+		gs := []PdfObject{}
+		for i := 0; i < 2; i++ {
+			dict := MakeDict()
+			dict.Set("ca", MakeFloat(0.5))
+			gs = append(gs, MakeIndirectObject(dict))
+		}
+		form := NewXObjectForm()
+		form.Resources = NewPdfPageResources()
+		form.Resources.AddExtGState("gs1", gs[0])
+		form.Resources.AddExtGState("gs2", gs[1])
+		form.Resources.AddExtGState("gs3", gs[shared])
+		form.BBox = MakeArrayFromFloats([]float64{0, 0, 100, 20})
+		if err := form.SetContentStream([]byte("/gs1 gs /gs3 gs 0 0 100 20 re f"), nil); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		return form
+	}
+
+	cache := NewXObjectFormCache()
+	first := makeForm(0)
+	second := makeForm(1)
+	if cache.Get(first) != first || cache.Get(second) != second {
+		t.Errorf("Forms sharing different objects reused")
+	}
+	if cache.Get(makeForm(1)) != second {
+		t.Errorf("Identical appearance not reused")
+	}
+}
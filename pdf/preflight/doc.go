@@ -0,0 +1,13 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package preflight checks PDF documents against the requirements of prepress standards and applies automated
+// fixes where it is safe to do so.
+//
+// Currently offers PDF/X-1a, PDF/X-3 and PDF/X-4 checks, see CheckPdfX and PdfXFixer.
+// Reports can be embedded in the checked document as evidence with EmbedReport.
+//
+// The preflight package uses the core, model and contentstream packages.
+package preflight
@@ -0,0 +1,59 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package preflight
+
+import (
+	"encoding/json"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// ReportFileName is the default file name of reports embedded with EmbedReport.
+const ReportFileName = "preflight-report.json"
+
+// JSON returns the report as JSON, e.g.
+// {"version": "PDF/X-1a", "conforms": false, "violations": [{"rule": "trim-box", "page": 1, ...}]}.
+func (r *Report) JSON() ([]byte, error) {
+	type jsonViolation struct {
+		Rule    string `json:"rule"`
+		Page    int    `json:"page,omitempty"`
+		Message string `json:"message"`
+		Fixable bool   `json:"fixable"`
+	}
+	type jsonReport struct {
+		Version    string          `json:"version"`
+		Conforms   bool            `json:"conforms"`
+		Violations []jsonViolation `json:"violations"`
+	}
+
+	jr := jsonReport{
+		Version:    r.Version.String(),
+		Conforms:   r.Conforms(),
+		Violations: []jsonViolation{},
+	}
+	for _, v := range r.Violations {
+		jr.Violations = append(jr.Violations, jsonViolation{Rule: v.Rule, Page: v.Page, Message: v.Message, Fixable: v.Fixable})
+	}
+	return json.MarshalIndent(jr, "", "  ")
+}
+
+// EmbedReport embeds the report as a JSON file named `name` (ReportFileName if empty) associated with the document
+// written by `w`, so that the preflight evidence travels with the document.
+func EmbedReport(w *model.PdfWriter, r *Report, name string) error {
+	data, err := r.JSON()
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = ReportFileName
+	}
+	fs, err := model.NewReportFileSpec(name, data, r.Version.String()+" preflight report")
+	if err != nil {
+		return err
+	}
+	w.AddAssociatedFile(fs)
+	return nil
+}
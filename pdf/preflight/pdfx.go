@@ -0,0 +1,507 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package preflight
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Rules identify the requirements checked by the PDF/X preflight.
+const (
+	RuleEncryption     = "encryption"      // Encryption is not allowed.
+	RuleOutputIntent   = "output-intent"   // A GTS_PDFX output intent is required.
+	RuleVersionKey     = "version-key"     // The GTS_PDFXVersion info entry is required.
+	RuleTrapped        = "trapped"         // The Trapped info entry must be True or False.
+	RuleTrimBox        = "trim-box"        // Each page must have a TrimBox (or ArtBox).
+	RuleBleedBox       = "bleed-box"       // Each page should have a BleedBox enclosing the TrimBox.
+	RuleFontEmbedding  = "font-embedding"  // All fonts must be embedded.
+	RuleColor          = "color"           // RGB and device-independent color is not allowed (PDF/X-1a).
+	RuleTransparency   = "transparency"    // Transparency is not allowed (PDF/X-1a and PDF/X-3).
+	RuleGraphicsState  = "graphics-state"  // Restrictions on ExtGState entries, e.g. transfer functions.
+	RuleContentProcess = "content-process" // The content could not be processed for checking.
+	RuleXrefChain      = "xref-chain"      // The chain of cross-reference sections must be consistent.
+)
+
+// Violation is a failed requirement found by a preflight check.
+type Violation struct {
+	Rule    string // One of the Rule constants.
+	Page    int    // Page number (1-based), or 0 for document level violations.
+	Message string
+
+	// Whether the violation is fixed by the automated fixes (PdfXFixer).
+	Fixable bool
+}
+
+func (v Violation) String() string {
+	if v.Page > 0 {
+		return fmt.Sprintf("[%s] page %d: %s", v.Rule, v.Page, v.Message)
+	}
+	return fmt.Sprintf("[%s] %s", v.Rule, v.Message)
+}
+
+// Report is the result of a preflight check.
+type Report struct {
+	Version    model.PdfXVersion
+	Violations []Violation
+}
+
+// Conforms returns true if no violations were found.
+func (r *Report) Conforms() bool {
+	return len(r.Violations) == 0
+}
+
+// Unfixable returns the violations which are not fixed by the automated fixes.
+func (r *Report) Unfixable() []Violation {
+	violations := []Violation{}
+	for _, v := range r.Violations {
+		if !v.Fixable {
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+func (r *Report) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s preflight: %d violation(s)\n", r.Version, len(r.Violations)))
+	for _, v := range r.Violations {
+		buf.WriteString(v.String())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func (r *Report) add(rule string, page int, fixable bool, format string, a ...interface{}) {
+	r.Violations = append(r.Violations, Violation{Rule: rule, Page: page, Message: fmt.Sprintf(format, a...), Fixable: fixable})
+}
+
+// CheckXrefChain checks the chain of cross-reference sections of the file loaded by `reader` (the Prev entries of
+// incremental updates) for loops, offsets past the end of the file and overlapping or broken sections. Violations
+// are fixed when the document is written with a PdfWriter, or with PdfReader.RepairXrefChain to keep the file
+// contents as they are.
+func CheckXrefChain(reader *model.PdfReader) []Violation {
+	violations := []Violation{}
+	for _, issue := range reader.VerifyXrefChain() {
+		violations = append(violations, Violation{Rule: RuleXrefChain, Message: issue.String(), Fixable: true})
+	}
+	return violations
+}
+
+// pdfXVersionKey returns the value of the GTS_PDFXVersion info entry for `version`.
+func pdfXVersionKey(version model.PdfXVersion) string {
+	switch version {
+	case model.PdfX1a:
+		return "PDF/X-1:2001"
+	case model.PdfX3:
+		return "PDF/X-3:2003"
+	}
+	return "PDF/X-4"
+}
+
+// CheckPdfX checks the document loaded by `reader` against the requirements of PDF/X conformance level `version`:
+// output intent and info entries, no encryption, trim and bleed boxes, embedded fonts, no RGB or device-independent
+// color (PDF/X-1a) and no transparency (PDF/X-1a and PDF/X-3), as well as the file structure (CheckXrefChain).
+// Annotation appearances are not checked.
+func CheckPdfX(reader *model.PdfReader, version model.PdfXVersion) (*Report, error) {
+	report := &Report{Version: version}
+
+	isEncrypted, err := reader.IsEncrypted()
+	if err != nil {
+		return nil, err
+	}
+	if isEncrypted {
+		report.add(RuleEncryption, 0, false, "document is encrypted")
+		return report, nil
+	}
+
+	report.Violations = append(report.Violations, CheckXrefChain(reader)...)
+
+	outputIntents, err := reader.GetOutputIntents()
+	if err != nil {
+		return nil, err
+	}
+	if !hasPdfXOutputIntent(outputIntents) {
+		report.add(RuleOutputIntent, 0, false, "no GTS_PDFX output intent")
+	}
+
+	info, err := reader.GetDocInfo()
+	if err != nil {
+		return nil, err
+	}
+	var versionKey, trapped core.PdfObject
+	if info != nil {
+		versionKey = info.Get("GTS_PDFXVersion")
+		trapped = core.TraceToDirectObject(info.Get("Trapped"))
+	}
+	if versionKey == nil {
+		report.add(RuleVersionKey, 0, true, "GTS_PDFXVersion not set")
+	}
+	if name, ok := trapped.(*core.PdfObjectName); !ok || (*name != "True" && *name != "False") {
+		report.add(RuleTrapped, 0, true, "Trapped not set to True or False")
+	}
+
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i <= numPages; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			return nil, err
+		}
+		checkPdfXPage(report, page, i, version)
+	}
+
+	return report, nil
+}
+
+// hasPdfXOutputIntent returns true if `outputIntents` contains an output intent with subtype GTS_PDFX.
+func hasPdfXOutputIntent(outputIntents core.PdfObject) bool {
+	arr, ok := core.TraceToDirectObject(outputIntents).(*core.PdfObjectArray)
+	if !ok {
+		return false
+	}
+	for _, obj := range *arr {
+		dict, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+		if s, ok := core.TraceToDirectObject(dict.Get("S")).(*core.PdfObjectName); ok && *s == "GTS_PDFX" {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPdfXPage checks page `page` (number `pageNum`) and adds the violations to `report`.
+func checkPdfXPage(report *Report, page *model.PdfPage, pageNum int, version model.PdfXVersion) {
+	mediaBox, err := page.GetMediaBox()
+	if err != nil {
+		report.add(RuleTrimBox, pageNum, false, "MediaBox not defined")
+		return
+	}
+
+	if page.TrimBox == nil && page.ArtBox == nil {
+		report.add(RuleTrimBox, pageNum, true, "TrimBox not set")
+	}
+	if page.BleedBox == nil {
+		report.add(RuleBleedBox, pageNum, true, "BleedBox not set")
+	} else {
+		if !rectContains(*mediaBox, *page.BleedBox) {
+			report.add(RuleBleedBox, pageNum, false, "BleedBox exceeds MediaBox")
+		}
+		if page.TrimBox != nil && !rectContains(*page.BleedBox, *page.TrimBox) {
+			report.add(RuleBleedBox, pageNum, false, "TrimBox exceeds BleedBox")
+		}
+	}
+
+	if version != model.PdfX4 && isTransparencyGroup(page.Group) {
+		report.add(RuleTransparency, pageNum, false, "page transparency group")
+	}
+
+	// Resources of the page and its forms.
+	visited := map[core.PdfObject]bool{}
+	walkResources(page.Resources, visited, func(resources *model.PdfPageResources) {
+		checkPdfXResources(report, resources, pageNum, version)
+	})
+
+	if version == model.PdfX1a {
+		checkPdfXColors(report, page, pageNum)
+	}
+}
+
+// checkPdfXResources checks the fonts, graphics states and images of `resources`.
+func checkPdfXResources(report *Report, resources *model.PdfPageResources, pageNum int, version model.PdfXVersion) {
+	if fonts, ok := core.TraceToDirectObject(resources.Font).(*core.PdfObjectDictionary); ok {
+		for _, name := range fonts.Keys() {
+			if !isFontEmbedded(fonts.Get(name)) {
+				report.add(RuleFontEmbedding, pageNum, false, "font %s (%s) not embedded", name, getFontName(fonts.Get(name)))
+			}
+		}
+	}
+
+	if gsDict, ok := core.TraceToDirectObject(resources.ExtGState).(*core.PdfObjectDictionary); ok {
+		for _, name := range gsDict.Keys() {
+			egs, err := model.NewPdfExtGStateFromPdfObject(gsDict.Get(name))
+			if err != nil {
+				report.add(RuleGraphicsState, pageNum, false, "invalid ExtGState %s: %v", name, err)
+				continue
+			}
+			for _, warning := range egs.ValidatePdfX(version) {
+				report.add(RuleGraphicsState, pageNum, false, "ExtGState %s: %s", name, warning)
+			}
+		}
+	}
+
+	if xobjDict, ok := core.TraceToDirectObject(resources.XObject).(*core.PdfObjectDictionary); ok {
+		for _, name := range xobjDict.Keys() {
+			stream, xtype := resources.GetXObjectByName(name)
+			if stream == nil {
+				continue
+			}
+			dict := stream.PdfObjectDictionary
+			switch xtype {
+			case model.XObjectTypeImage:
+				if version == model.PdfX4 {
+					continue
+				}
+				if dict.Get("SMask") != nil {
+					report.add(RuleTransparency, pageNum, false, "image %s has a soft mask", name)
+				}
+				if smaskInData, ok := core.TraceToDirectObject(dict.Get("SMaskInData")).(*core.PdfObjectInteger); ok && *smaskInData != 0 {
+					report.add(RuleTransparency, pageNum, false, "image %s has soft mask data", name)
+				}
+			case model.XObjectTypeForm:
+				if version != model.PdfX4 && isTransparencyGroup(dict.Get("Group")) {
+					report.add(RuleTransparency, pageNum, false, "form %s is a transparency group", name)
+				}
+			}
+		}
+	}
+}
+
+// checkPdfXColors checks the colors used by the page contents (including forms) for PDF/X-1a.
+func checkPdfXColors(report *Report, page *model.PdfPage, pageNum int) {
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		report.add(RuleContentProcess, pageNum, false, "unable to load contents: %v", err)
+		return
+	}
+	operations, err := contentstream.NewContentStreamParser(contents).Parse()
+	if err != nil {
+		report.add(RuleContentProcess, pageNum, false, "unable to parse contents: %v", err)
+		return
+	}
+
+	found := map[string]bool{}
+	checkColorspace := func(cs model.PdfColorspace, what string) {
+		if desc, disallowed := getPdfX1aColorspaceViolation(cs); disallowed {
+			key := what + desc
+			if !found[key] {
+				found[key] = true
+				report.add(RuleColor, pageNum, true, "%s in %s colorspace", what, desc)
+			}
+		}
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.SetFormXObjectRecursion(true)
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			switch op.Operand {
+			case "CS", "SC", "SCN", "RG":
+				checkColorspace(gs.ColorspaceStroking, "stroke color")
+			case "cs", "sc", "scn", "rg":
+				checkColorspace(gs.ColorspaceNonStroking, "fill color")
+			}
+			return nil
+		})
+	processor.AddHandler(contentstream.HandlerConditionEnumImage, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			switch param := op.Params[0].(type) {
+			case *contentstream.ContentStreamInlineImage:
+				if cs, err := param.GetColorSpace(resources); err == nil {
+					checkColorspace(cs, "inline image")
+				}
+			case *core.PdfObjectName:
+				if ximg, err := resources.GetXObjectImageByName(*param); err == nil && ximg != nil && ximg.ColorSpace != nil {
+					checkColorspace(ximg.ColorSpace, "image")
+				}
+			}
+			return nil
+		})
+
+	err = processor.Process(page.Resources)
+	if err != nil {
+		report.add(RuleContentProcess, pageNum, false, "unable to process contents: %v", err)
+	}
+}
+
+// getPdfX1aColorspaceViolation returns a description of colorspace `cs` if it is not allowed in PDF/X-1a, which
+// is restricted to DeviceCMYK, DeviceGray and spot colors.
+func getPdfX1aColorspaceViolation(cs model.PdfColorspace) (string, bool) {
+	switch t := cs.(type) {
+	case *model.PdfColorspaceDeviceRGB:
+		return "DeviceRGB", true
+	case *model.PdfColorspaceCalRGB:
+		return "CalRGB", true
+	case *model.PdfColorspaceCalGray:
+		return "CalGray", true
+	case *model.PdfColorspaceLab:
+		return "Lab", true
+	case *model.PdfColorspaceICCBased:
+		return "ICCBased", true
+	case *model.PdfColorspaceSpecialIndexed:
+		if t.Base != nil {
+			if desc, disallowed := getPdfX1aColorspaceViolation(t.Base); disallowed {
+				return "Indexed " + desc, true
+			}
+		}
+	}
+	return "", false
+}
+
+// walkResources calls `fn` for `resources` and the resources of the form XObjects used, recursively.
+func walkResources(resources *model.PdfPageResources, visited map[core.PdfObject]bool, fn func(*model.PdfPageResources)) {
+	if resources == nil {
+		return
+	}
+	fn(resources)
+
+	xobjDict, ok := core.TraceToDirectObject(resources.XObject).(*core.PdfObjectDictionary)
+	if !ok {
+		return
+	}
+	for _, name := range xobjDict.Keys() {
+		stream, xtype := resources.GetXObjectByName(name)
+		if stream == nil || xtype != model.XObjectTypeForm || visited[stream] {
+			continue
+		}
+		visited[stream] = true
+		xform, err := model.NewXObjectFormFromStream(stream)
+		if err != nil {
+			common.Log.Debug("Unable to load form %s: %v", name, err)
+			continue
+		}
+		walkResources(xform.Resources, visited, fn)
+	}
+}
+
+// isTransparencyGroup returns true if `group` is a transparency group attributes dictionary.
+func isTransparencyGroup(group core.PdfObject) bool {
+	dict, ok := core.TraceToDirectObject(group).(*core.PdfObjectDictionary)
+	if !ok {
+		return false
+	}
+	s, ok := core.TraceToDirectObject(dict.Get("S")).(*core.PdfObjectName)
+	return ok && *s == "Transparency"
+}
+
+// isFontEmbedded returns true if the font program of font dictionary `obj` is embedded.
+// Type3 fonts are defined by content streams and are always considered embedded.
+func isFontEmbedded(obj core.PdfObject) bool {
+	dict, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary)
+	if !ok {
+		return false
+	}
+	subtype, _ := core.TraceToDirectObject(dict.Get("Subtype")).(*core.PdfObjectName)
+	if subtype != nil && *subtype == "Type3" {
+		return true
+	}
+	if subtype != nil && *subtype == "Type0" {
+		descendants, ok := core.TraceToDirectObject(dict.Get("DescendantFonts")).(*core.PdfObjectArray)
+		if !ok || len(*descendants) == 0 {
+			return false
+		}
+		return isFontEmbedded((*descendants)[0])
+	}
+
+	descriptor, ok := core.TraceToDirectObject(dict.Get("FontDescriptor")).(*core.PdfObjectDictionary)
+	if !ok {
+		return false
+	}
+	for _, key := range []core.PdfObjectName{"FontFile", "FontFile2", "FontFile3"} {
+		if descriptor.Get(key) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// getFontName returns the BaseFont name of font dictionary `obj`.
+func getFontName(obj core.PdfObject) string {
+	dict, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary)
+	if !ok {
+		return ""
+	}
+	if name, ok := core.TraceToDirectObject(dict.Get("BaseFont")).(*core.PdfObjectName); ok {
+		return string(*name)
+	}
+	return ""
+}
+
+// rectContains returns true if `outer` contains `inner` (with a small tolerance).
+func rectContains(outer, inner model.PdfRectangle) bool {
+	const tol = 0.01
+	return inner.Llx >= outer.Llx-tol && inner.Lly >= outer.Lly-tol &&
+		inner.Urx <= outer.Urx+tol && inner.Ury <= outer.Ury+tol
+}
+
+// PdfXFixer applies the automated fixes for the violations reported as fixable by CheckPdfX.
+//
+// Page fixes (FixPage) set missing TrimBox and BleedBox entries to the crop box (i.e. no bleed), and for
+// PDF/X-1a convert RGB and device-independent colors and images to DeviceCMYK, keeping spot colors. Document fixes
+// (FixDocument) set the GTS_PDFXVersion and Trapped (False if not set) info entries.
+// Output intents cannot be created automatically as they require the ICC profile of the output condition.
+type PdfXFixer struct {
+	version   model.PdfXVersion
+	converter *contentstream.ColorConverter
+}
+
+// NewPdfXFixer returns a new fixer for PDF/X conformance level `version`. The same fixer should be used for all
+// pages of a document so that shared resources are converted once.
+func NewPdfXFixer(version model.PdfXVersion) *PdfXFixer {
+	fixer := &PdfXFixer{version: version}
+	if version == model.PdfX1a {
+		// Cannot fail with a supported target.
+		fixer.converter, _ = contentstream.NewColorConverter(model.NewPdfColorspaceDeviceCMYK())
+		fixer.converter.SetKeepSpotColors(true)
+	}
+	return fixer
+}
+
+// FixPage applies the page level fixes to `page`.
+func (fixer *PdfXFixer) FixPage(page *model.PdfPage) error {
+	box := page.CropBox
+	if box == nil {
+		var err error
+		box, err = page.GetMediaBox()
+		if err != nil {
+			return err
+		}
+	}
+
+	if page.TrimBox == nil && page.ArtBox == nil {
+		trimBox := *box
+		page.TrimBox = &trimBox
+	}
+	if page.BleedBox == nil {
+		bleedBox := *box
+		page.BleedBox = &bleedBox
+	}
+
+	if fixer.converter != nil {
+		err := fixer.converter.ConvertPage(page)
+		if err != nil {
+			common.Log.Debug("ERROR: Unable to convert page colors: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// FixDocument applies the document level fixes to the output written by `w`. The document info `info` of the
+// input document (PdfReader.GetDocInfo) is used to retain its Trapped entry, and can be nil.
+func (fixer *PdfXFixer) FixDocument(w *model.PdfWriter, info *core.PdfObjectDictionary) {
+	w.SetDocInfo("GTS_PDFXVersion", pdfXVersionKey(fixer.version))
+	if fixer.version == model.PdfX1a {
+		w.SetDocInfo("GTS_PDFXConformance", "PDF/X-1a:2001")
+	}
+
+	trapped := "False"
+	if info != nil {
+		if name, ok := core.TraceToDirectObject(info.Get("Trapped")).(*core.PdfObjectName); ok && *name == "True" {
+			trapped = "True"
+		}
+	}
+	w.SetDocInfoName("Trapped", trapped)
+}
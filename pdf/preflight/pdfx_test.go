@@ -0,0 +1,197 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package preflight
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// writeAndRead writes the pages with `w` and loads the output.
+func writeAndRead(t *testing.T, w *model.PdfWriter) (*model.PdfReader, func()) {
+	f, err := ioutil.TempFile("", "pdfx")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	if err := w.Write(f); err != nil {
+		cleanup()
+		t.Fatalf("Error writing: %v", err)
+	}
+	f.Seek(0, os.SEEK_SET)
+
+	reader, err := model.NewPdfReader(f)
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error reading: %v", err)
+	}
+	return reader, cleanup
+}
+
+func countRule(report *Report, rule string) int {
+	n := 0
+	for _, v := range report.Violations {
+		if v.Rule == rule {
+			n++
+		}
+	}
+	return n
+}
+
+func newTestPage(t *testing.T) *model.PdfPage {
+	page := model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 612, Ury: 792}
+	page.Resources = model.NewPdfPageResources()
+
+	font := core.MakeDict()
+	font.Set("Type", core.MakeName("Font"))
+	font.Set("Subtype", core.MakeName("Type1"))
+	font.Set("BaseFont", core.MakeName("Helvetica"))
+	fonts := core.MakeDict()
+	fonts.Set("F1", font)
+	page.Resources.Font = fonts
+
+	err := page.SetContentStreams([]string{"1 0 0 rg 0 0 100 100 re f BT /F1 12 Tf (Hi) Tj ET"}, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	return page
+}
+
+func TestCheckPdfX(t *testing.T) {
+	w := model.NewPdfWriter()
+	if err := w.AddPage(newTestPage(t)); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+	reader, cleanup := writeAndRead(t, &w)
+	defer cleanup()
+
+	report, err := CheckPdfX(reader, model.PdfX1a)
+	if err != nil {
+		t.Fatalf("Error checking: %v", err)
+	}
+	if report.Conforms() {
+		t.Fatalf("Should not conform")
+	}
+	// The writer may add a watermark with a non-embedded font to unlicensed output, hence at least one.
+	for _, rule := range []string{RuleOutputIntent, RuleVersionKey, RuleTrapped, RuleTrimBox, RuleBleedBox,
+		RuleFontEmbedding, RuleColor} {
+		if countRule(report, rule) < 1 {
+			t.Errorf("Expected %s violation:\n%s", rule, report)
+		}
+	}
+	if countRule(report, RuleXrefChain) != 0 {
+		t.Errorf("Unexpected xref chain violation:\n%s", report)
+	}
+	for _, v := range report.Unfixable() {
+		if v.Rule != RuleOutputIntent && v.Rule != RuleFontEmbedding {
+			t.Errorf("Unexpected unfixable violation: %s", v)
+		}
+	}
+
+	// RGB is allowed in PDF/X-4.
+	report, err = CheckPdfX(reader, model.PdfX4)
+	if err != nil {
+		t.Fatalf("Error checking: %v", err)
+	}
+	if countRule(report, RuleColor) != 0 {
+		t.Errorf("RGB should be allowed in PDF/X-4:\n%s", report)
+	}
+}
+
+func TestPdfXFixer(t *testing.T) {
+	reader, cleanup := func() (*model.PdfReader, func()) {
+		w := model.NewPdfWriter()
+		if err := w.AddPage(newTestPage(t)); err != nil {
+			t.Fatalf("Error adding page: %v", err)
+		}
+		return writeAndRead(t, &w)
+	}()
+	defer cleanup()
+
+	fixer := NewPdfXFixer(model.PdfX1a)
+	w := model.NewPdfWriter()
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := fixer.FixPage(page); err != nil {
+		t.Fatalf("Error fixing page: %v", err)
+	}
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !strings.HasPrefix(contents, "0.000000 1.000000 1.000000 0.000000 k\n") || strings.Contains(contents, " rg") {
+		t.Errorf("Colors not converted: %q", contents)
+	}
+	if err := w.AddPage(page); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+	info, err := reader.GetDocInfo()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	fixer.FixDocument(&w, info)
+
+	fixed, cleanupFixed := writeAndRead(t, &w)
+	defer cleanupFixed()
+
+	report, err := CheckPdfX(fixed, model.PdfX1a)
+	if err != nil {
+		t.Fatalf("Error checking: %v", err)
+	}
+	for _, rule := range []string{RuleVersionKey, RuleTrapped, RuleTrimBox, RuleBleedBox} {
+		if countRule(report, rule) != 0 {
+			t.Errorf("Violation %s not fixed:\n%s", rule, report)
+		}
+	}
+	if countRule(report, RuleOutputIntent) != 1 {
+		t.Errorf("Output intent cannot be fixed:\n%s", report)
+	}
+}
+
+func TestEmbedReport(t *testing.T) {
+	report := &Report{Version: model.PdfX4}
+	report.add(RuleTrimBox, 1, true, "Missing TrimBox")
+
+	w := model.NewPdfWriter()
+	if err := w.AddPage(newTestPage(t)); err != nil {
+		t.Fatalf("Error adding page: %v", err)
+	}
+	if err := EmbedReport(&w, report, ""); err != nil {
+		t.Fatalf("Error embedding report: %v", err)
+	}
+	reader, cleanup := writeAndRead(t, &w)
+	defer cleanup()
+
+	files, err := reader.GetAssociatedFiles()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(files) != 1 || files[0].GetFileName() != ReportFileName || files[0].EF == nil ||
+		files[0].AFRelationship == nil || *files[0].AFRelationship != model.AFRelationshipSupplement {
+		t.Fatalf("Unexpected associated files: %v", files)
+	}
+	data, err := files[0].EF.GetData()
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !strings.Contains(string(data), `"rule": "trim-box"`) || !strings.Contains(string(data), `"conforms": false`) {
+		t.Errorf("Unexpected report: %s", data)
+	}
+	if files[0].EF.Subtype == nil || *files[0].EF.Subtype != "application/json" {
+		t.Errorf("Unexpected MIME type %v", files[0].EF.Subtype)
+	}
+}
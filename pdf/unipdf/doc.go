@@ -0,0 +1,13 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package unipdf offers the common PDF workflows (opening documents, extracting text, reading form values and
+// merging documents) through a small, stable interface with option structs, without requiring knowledge of the
+// PDF structure.
+//
+// The packages it builds on remain available for advanced use: pdf/core (primitive objects and parsing),
+// pdf/model (the document model with its reader and writer), pdf/creator (generating and assembling documents)
+// and pdf/extractor (content extraction). Document.Reader gives access to the underlying model.
+package unipdf
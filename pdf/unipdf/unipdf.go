@@ -0,0 +1,183 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package unipdf
+
+import (
+	"io"
+	"os"
+
+	"github.com/unidoc/unidoc/pdf/creator"
+	"github.com/unidoc/unidoc/pdf/extractor"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// OpenOptions are the options for opening a document.
+type OpenOptions struct {
+	// Password of an encrypted document. The empty password is tried if not set.
+	Password string
+
+	// Parts of the document structure that are not loaded, see model.ReaderOptions.
+	Skip model.ReaderOptions
+}
+
+// Document is an opened PDF document.
+type Document struct {
+	reader *model.PdfReader
+	file   *os.File // Set when opened by Open.
+}
+
+// Open opens the PDF document at `path`. The options may be nil for the defaults. The document must be closed
+// with Close when done.
+func Open(path string, opts *OpenOptions) (*Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := OpenReader(f, opts)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	doc.file = f
+	return doc, nil
+}
+
+// OpenReader opens the PDF document read from `rs`, which must stay available while the document is used. The
+// options may be nil for the defaults.
+func OpenReader(rs io.ReadSeeker, opts *OpenOptions) (*Document, error) {
+	if opts == nil {
+		opts = &OpenOptions{}
+	}
+
+	reader, err := model.NewPdfReaderWith(rs, model.WithPassword(opts.Password), model.WithReaderOptions(opts.Skip))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{reader: reader}, nil
+}
+
+// Close closes the file of a document opened with Open. Does nothing for documents opened with OpenReader.
+func (d *Document) Close() error {
+	if d.file == nil {
+		return nil
+	}
+	err := d.file.Close()
+	d.file = nil
+	return err
+}
+
+// Reader returns the model reader of the document, for advanced use.
+func (d *Document) Reader() *model.PdfReader {
+	return d.reader
+}
+
+// NumPages returns the number of pages of the document.
+func (d *Document) NumPages() (int, error) {
+	return d.reader.GetNumPages()
+}
+
+// ExtractOptions are the options for extracting text.
+type ExtractOptions struct {
+	// Pages to extract (1-based), all pages if empty.
+	Pages []int
+
+	// Number of pages processed concurrently, runtime.NumCPU() if 0.
+	Workers int
+}
+
+// ExtractText returns the text of the pages of the document selected by `opts`, in order. The options may be nil
+// for the defaults.
+func (d *Document) ExtractText(opts *ExtractOptions) ([]string, error) {
+	if opts == nil {
+		opts = &ExtractOptions{}
+	}
+
+	pageNums := opts.Pages
+	if len(pageNums) == 0 {
+		numPages, err := d.NumPages()
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i <= numPages; i++ {
+			pageNums = append(pageNums, i)
+		}
+	}
+
+	pages := []*model.PdfPage{}
+	for _, pageNum := range pageNums {
+		page, err := d.reader.GetPage(pageNum)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, page)
+	}
+	return extractor.ExtractTextPages(pages, opts.Workers)
+}
+
+// FormValues returns the values of the form fields of the document by their full names. Returns an empty map
+// if the document has no form.
+func (d *Document) FormValues() map[string]string {
+	if d.reader.AcroForm == nil {
+		return map[string]string{}
+	}
+	return d.reader.AcroForm.FieldValues()
+}
+
+// MergeInput is an input document of Merge.
+type MergeInput struct {
+	// Path of the document, or Reader for a document in memory.
+	Path   string
+	Reader io.ReadSeeker
+
+	// Password of an encrypted document.
+	Password string
+
+	// Pages to take from the document, as a comma separated list of page numbers and ranges, e.g. "1-3,5,9-".
+	// All pages are taken if empty.
+	Pages string
+}
+
+// MergeOptions are the options for merging documents.
+type MergeOptions struct {
+	// Document information entries of the output, e.g. Title or Author.
+	Metadata map[string]string
+}
+
+// Merge writes the pages of `inputs` in order to a new document written to `ws`. The options may be nil for
+// the defaults. See creator.AssemblySpec for more advanced assembly jobs.
+func Merge(inputs []MergeInput, ws io.WriteSeeker, opts *MergeOptions) error {
+	if opts == nil {
+		opts = &MergeOptions{}
+	}
+
+	spec := &creator.AssemblySpec{Metadata: opts.Metadata}
+	for _, input := range inputs {
+		spec.Inputs = append(spec.Inputs, &creator.AssemblyInput{
+			Path:     input.Path,
+			Reader:   input.Reader,
+			Password: input.Password,
+			Pages:    input.Pages,
+		})
+	}
+	return creator.Assemble(spec, ws)
+}
+
+// MergeFiles merges the documents at `inputPaths` into a new document written to `outputPath` (see Merge).
+func MergeFiles(inputPaths []string, outputPath string, opts *MergeOptions) error {
+	inputs := []MergeInput{}
+	for _, path := range inputPaths {
+		inputs = append(inputs, MergeInput{Path: path})
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return Merge(inputs, f, opts)
+}
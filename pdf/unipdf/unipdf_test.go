@@ -0,0 +1,108 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package unipdf
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+const (
+	testMinimalFile = "../../testfiles/minimal.pdf"
+	testLoremFile   = "../../testfiles/lorem.pdf"
+)
+
+func TestOpenExtractText(t *testing.T) {
+	doc, err := Open(testLoremFile, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer doc.Close()
+
+	numPages, err := doc.NumPages()
+	if err != nil || numPages == 0 {
+		t.Fatalf("Invalid page count %d (%v)", numPages, err)
+	}
+
+	texts, err := doc.ExtractText(nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(texts) != numPages || !strings.Contains(texts[0], "Lorem") {
+		t.Errorf("Unexpected text of %d pages: %q", len(texts), texts)
+	}
+
+	texts, err = doc.ExtractText(&ExtractOptions{Pages: []int{1}, Workers: 1})
+	if err != nil || len(texts) != 1 {
+		t.Errorf("Unexpected text of selected page: %q (%v)", texts, err)
+	}
+	if _, err := doc.ExtractText(&ExtractOptions{Pages: []int{numPages + 1}}); err == nil {
+		t.Errorf("Expected error for page out of range")
+	}
+
+	if values := doc.FormValues(); len(values) != 0 {
+		t.Errorf("Unexpected form values: %v", values)
+	}
+}
+
+func TestOpenReaderSkip(t *testing.T) {
+	data, err := ioutil.ReadFile(testMinimalFile)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	opts := &OpenOptions{Skip: model.ReaderOptions{SkipAcroForm: true, SkipOutlines: true}}
+	doc, err := OpenReader(bytes.NewReader(data), opts)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if doc.Reader().GetOutlineTree() != nil {
+		t.Errorf("Outlines loaded")
+	}
+	if err := doc.Close(); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+}
+
+func TestMergeFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unipdf")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	output := filepath.Join(dir, "merged.pdf")
+
+	err = MergeFiles([]string{testMinimalFile, testLoremFile}, output,
+		&MergeOptions{Metadata: map[string]string{"Title": "Merged"}})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	expected := 0
+	for _, path := range []string{testMinimalFile, testLoremFile} {
+		doc, err := Open(path, nil)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		n, _ := doc.NumPages()
+		expected += n
+		doc.Close()
+	}
+
+	merged, err := Open(output, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer merged.Close()
+	if n, _ := merged.NumPages(); n != expected {
+		t.Errorf("Merged %d pages, expected %d", n, expected)
+	}
+}